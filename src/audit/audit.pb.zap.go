@@ -0,0 +1,37 @@
+// Code generated by protoc-gen-zap (etc/proto/protoc-gen-zap). DO NOT EDIT.
+//
+// source: audit/audit.proto
+
+package audit
+
+import (
+	protoextensions "github.com/pachyderm/pachyderm/v2/src/protoextensions"
+	zapcore "go.uber.org/zap/zapcore"
+)
+
+func (x *Event) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddInt64("id", x.Id)
+	protoextensions.AddTimestamp(enc, "time", x.Time)
+	enc.AddString("principal", x.Principal)
+	enc.AddString("method", x.Method)
+	enc.AddString("resource", x.Resource)
+	enc.AddString("peer", x.Peer)
+	enc.AddString("code", x.Code)
+	enc.AddString("error", x.Error)
+	return nil
+}
+
+func (x *ListEventRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddString("principal", x.Principal)
+	enc.AddString("resource", x.Resource)
+	protoextensions.AddTimestamp(enc, "since", x.Since)
+	protoextensions.AddTimestamp(enc, "until", x.Until)
+	enc.AddInt64("limit", x.Limit)
+	return nil
+}
@@ -0,0 +1,138 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: audit/audit.proto
+
+package audit
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	API_ListEvent_FullMethodName = "/audit_v2.API/ListEvent"
+)
+
+// APIClient is the client API for API service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type APIClient interface {
+	// ListEvent returns events from the audit log, newest first.
+	ListEvent(ctx context.Context, in *ListEventRequest, opts ...grpc.CallOption) (API_ListEventClient, error)
+}
+
+type aPIClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAPIClient(cc grpc.ClientConnInterface) APIClient {
+	return &aPIClient{cc}
+}
+
+func (c *aPIClient) ListEvent(ctx context.Context, in *ListEventRequest, opts ...grpc.CallOption) (API_ListEventClient, error) {
+	stream, err := c.cc.NewStream(ctx, &API_ServiceDesc.Streams[0], API_ListEvent_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIListEventClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type API_ListEventClient interface {
+	Recv() (*Event, error)
+	grpc.ClientStream
+}
+
+type aPIListEventClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIListEventClient) Recv() (*Event, error) {
+	m := new(Event)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// APIServer is the server API for API service.
+// All implementations must embed UnimplementedAPIServer
+// for forward compatibility
+type APIServer interface {
+	// ListEvent returns events from the audit log, newest first.
+	ListEvent(*ListEventRequest, API_ListEventServer) error
+	mustEmbedUnimplementedAPIServer()
+}
+
+// UnimplementedAPIServer must be embedded to have forward compatible implementations.
+type UnimplementedAPIServer struct {
+}
+
+func (UnimplementedAPIServer) ListEvent(*ListEventRequest, API_ListEventServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListEvent not implemented")
+}
+func (UnimplementedAPIServer) mustEmbedUnimplementedAPIServer() {}
+
+// UnsafeAPIServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to APIServer will
+// result in compilation errors.
+type UnsafeAPIServer interface {
+	mustEmbedUnimplementedAPIServer()
+}
+
+func RegisterAPIServer(s grpc.ServiceRegistrar, srv APIServer) {
+	s.RegisterService(&API_ServiceDesc, srv)
+}
+
+func _API_ListEvent_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListEventRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServer).ListEvent(m, &aPIListEventServer{stream})
+}
+
+type API_ListEventServer interface {
+	Send(*Event) error
+	grpc.ServerStream
+}
+
+type aPIListEventServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIListEventServer) Send(m *Event) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// API_ServiceDesc is the grpc.ServiceDesc for API service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var API_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "audit_v2.API",
+	HandlerType: (*APIServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListEvent",
+			Handler:       _API_ListEvent_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "audit/audit.proto",
+}
@@ -101,6 +101,18 @@ const (
 	// PachdLogReaderRole is a role which grants the ability to pull pachd logs
 	PachdLogReaderRole = "pachdLogReader"
 
+	// WebhookAdminRole is a role which grants the ability to create, list and delete webhooks
+	WebhookAdminRole = "webhookAdmin"
+
+	// PJSUserRole is a role which grants the ability to create, inspect and cancel PJS jobs
+	PJSUserRole = "pjsUser"
+
+	// PJSWorkerRole is a role which grants the ability to process PJS queues
+	PJSWorkerRole = "pjsWorker"
+
+	// AuditLogReaderRole is a role which grants the ability to read the audit log
+	AuditLogReaderRole = "auditLogReader"
+
 	// ProjectViewerRole is a role which grants the ability to view resources under a project, such as repos and pipelines
 	ProjectViewerRole = "projectViewer"
 
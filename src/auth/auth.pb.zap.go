@@ -172,6 +172,57 @@ func (x *GetRolesForPermissionResponse) MarshalLogObject(enc zapcore.ObjectEncod
 	return nil
 }
 
+func (x *CreateRoleRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddObject("role", x.Role)
+	return nil
+}
+
+func (x *CreateRoleResponse) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	return nil
+}
+
+func (x *ListRoleRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	return nil
+}
+
+func (x *ListRoleResponse) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	rolesArrMarshaller := func(enc zapcore.ArrayEncoder) error {
+		for _, v := range x.Roles {
+			enc.AppendObject(v)
+		}
+		return nil
+	}
+	enc.AddArray("roles", zapcore.ArrayMarshalerFunc(rolesArrMarshaller))
+	return nil
+}
+
+func (x *DeleteRoleRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddString("name", x.Name)
+	return nil
+}
+
+func (x *DeleteRoleResponse) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	return nil
+}
+
 func (x *Roles) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	if x == nil {
 		return nil
@@ -259,6 +310,7 @@ func (x *Role) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 		return nil
 	}
 	enc.AddArray("returned_for", zapcore.ArrayMarshalerFunc(returned_forArrMarshaller))
+	enc.AddBool("custom", x.Custom)
 	return nil
 }
 
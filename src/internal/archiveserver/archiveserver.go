@@ -5,8 +5,8 @@ package archiveserver
 
 import (
 	"archive/tar"
-	"archive/zip"
 	"bufio"
+	"bytes"
 	"context"
 	"fmt"
 	"io"
@@ -44,16 +44,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 		http.Error(w, fmt.Sprintf("invalid URL: %v", err), http.StatusBadRequest)
 		return
 	}
-	// For now we make no effort to be flexible about the output format.  downloadZip would need
-	// to be refactored, because 99% of it is not related to the ZIP format.
-	if got, want := archive.Format, ArchiveFormatZip; got != want {
-		log.Debug(ctx, "unsupported archive format requested", zap.String("format", string(got)))
-		http.Error(w, fmt.Sprintf("unknown archive format %v requested; only .zip is supported", string(got)), http.StatusBadRequest)
-		return
-	}
 
 	pachClient := s.pachClientFromRequest(ctx, req)
-	if err := s.downloadZip(pachClient.Ctx(), w, pachClient, archive); err != nil {
+	if err := s.downloadArchive(pachClient.Ctx(), w, pachClient, archive); err != nil {
 		log.Info(ctx, "problem encountered mid-download", zap.Error(err))
 		return
 	}
@@ -85,8 +78,8 @@ func (w *writeFlusher) Write(p []byte) (int, error) {
 	return n, nil
 }
 
-func (s *Server) downloadZip(ctx context.Context, rw http.ResponseWriter, pachClient *client.APIClient, req *ArchiveRequest) (retErr error) {
-	ctx, done := log.SpanContext(ctx, "downloadZip")
+func (s *Server) downloadArchive(ctx context.Context, rw http.ResponseWriter, pachClient *client.APIClient, req *ArchiveRequest) (retErr error) {
+	ctx, done := log.SpanContext(ctx, "downloadArchive")
 	defer done(log.Errorp(&retErr))
 
 	// Make sure we don't have to buffer the entire response; this should always be ok.
@@ -102,12 +95,16 @@ func (s *Server) downloadZip(ctx context.Context, rw http.ResponseWriter, pachCl
 			meters.Inc(ctx, "archive_download_tx_bytes", i)
 		},
 	}
-	// Create a ZIP writer backed by a (chunked) buffer.
+	// Create an archive writer backed by a (chunked) buffer.
 	bw := bufio.NewWriterSize(wf, units.MB) // Send an HTTP chunk this often.
-	zw := zip.NewWriter(bw)
+	now := time.Now()
+	aw, err := newArchiveWriter(req.Format, bw, now)
+	if err != nil {
+		http.Error(rw, fmt.Sprintf("create archive: %v", err), http.StatusInternalServerError)
+		return errors.Wrap(err, "create archive writer")
+	}
 
 	// Setup headers for a download based on the current time.
-	now := time.Now()
 	destPath := fmt.Sprintf("pachyderm-download-%s.%s", now.Format(time.RFC3339), req.Format)
 	rw.Header().Add("transfer-encoding", "chunked")
 	rw.Header().Add("content-disposition", "attachment; filename="+destPath)
@@ -168,7 +165,7 @@ func (s *Server) downloadZip(ctx context.Context, rw http.ResponseWriter, pachCl
 		return nil
 	})
 
-	// Now try to download the resolved files, appending each to the ZIP.
+	// Now try to download the resolved files, appending each to the archive.
 	var downloadErrs error
 	if resolveErr == nil {
 		for _, file := range files {
@@ -197,7 +194,7 @@ func (s *Server) downloadZip(ctx context.Context, rw http.ResponseWriter, pachCl
 						return errors.Wrapf(err, "path %v: read TAR header", path)
 					}
 
-					// Skip directories, as they do not need to be in the resulting ZIP.
+					// Skip directories, as they do not need to be in the resulting archive.
 					if h.Typeflag == tar.TypeDir {
 						continue
 					}
@@ -207,19 +204,15 @@ func (s *Server) downloadZip(ctx context.Context, rw http.ResponseWriter, pachCl
 
 					// Create a path in the archive <project>/<repo>/commit/<actual
 					// path, including directories>.
-					zp := filepath.Join(file.Commit.Repo.Project.Name, file.Commit.Repo.Name, file.Commit.Id, h.Name)
-					w, err := zw.CreateHeader(&zip.FileHeader{
-						Name:     zp,
-						Method:   zip.Deflate,
-						Modified: now,
-					})
+					ap := filepath.Join(file.Commit.Repo.Project.Name, file.Commit.Repo.Name, file.Commit.Id, h.Name)
+					w, err := aw.Create(ap, h.Size, false)
 					if err != nil {
-						return errors.Wrapf(err, "create zip path %v (for %v in %v)", zp, h.Name, path)
+						return errors.Wrapf(err, "create archive path %v (for %v in %v)", ap, h.Name, path)
 					}
-					// Copy the data for this file into the zip.
+					// Copy the data for this file into the archive.
 					n, err := io.Copy(w, r)
 					if err != nil {
-						return errors.Wrapf(err, "write data for zip path %v (for %v in %v)", zp, h.Name, path)
+						return errors.Wrapf(err, "write data for archive path %v (for %v in %v)", ap, h.Name, path)
 					}
 					meters.Inc(ctx, "archive_download_added_bytes", n)
 				}
@@ -231,57 +224,52 @@ func (s *Server) downloadZip(ctx context.Context, rw http.ResponseWriter, pachCl
 	}
 
 	// If there is an error generated by a per-file callback, try writing it to a file called
-	// @error.txt and then completing the ZIP normally.  We'll return this error after flushing
-	// the ZIP, so that logs indiciate an error, but the user will have a valid partial ZIP to
-	// look at.
+	// @error.txt and then completing the archive normally.  We'll return this error after
+	// flushing the archive, so that logs indiciate an error, but the user will have a valid
+	// partial archive to look at.
 	//
 	// We choose the name @error.txt because PFS cannot contain a file called @error.txt, so no
 	// confusion with actual files is possible.  (Note that the root directory contains
 	// projects, and you can't call a project errors.txt either, but the @ hopefully draws
 	// attention to the problem.)
-	var w io.Writer
+	//
+	// TAR needs the size of each file up front, so the errors are formatted before creating
+	// @error.txt.
 	var finalErr error
-	if resolveErr != nil || downloadErrs != nil {
-		// If there are errors to write out, create @error.txt in the ZIP file.
-		var zerr error
-		w, zerr = zw.CreateHeader(&zip.FileHeader{
-			Name:     "@error.txt",
-			Method:   zip.Store, // So the actual bytes of the error appear on the wire.
-			Modified: now,
-		})
-		if zerr != nil {
-			// Now we have the exciting situation of an error while handling the error.
-			// Bail out with both errors; print both to the HTTP stream (sorry ZIP
-			// enjoyers), and return an error containing the text of each.
-			fmt.Fprintf(bw, "\n\ncreate @error.txt: %v\n\ncaused by: %v\n", zerr, resolveErr)
-			bw.Flush()
-			return errors.Errorf("create @error.txt: %v; caused by %v", zerr, resolveErr)
-		}
-	}
+	errText := new(bytes.Buffer)
 	if resolveErr != nil {
-		if _, werr := fmt.Fprintf(w, "%v\n", resolveErr); werr != nil {
-			// See above; error handling the error.
-			fmt.Fprintf(bw, "\n\nwrite @error.txt: %v\n\ncaused by: %v\n", werr, resolveErr)
-			bw.Flush()
-			return errors.Errorf("write @error.txt: %v; caused by %v", werr, resolveErr)
-		}
-
+		fmt.Fprintf(errText, "%v\n", resolveErr)
 		// We will eventually return finalErr to the caller.
 		errors.JoinInto(&finalErr, errors.Wrap(resolveErr, "resolve files (reported via @error.txt)"))
 	}
 	if downloadErrs != nil {
-		if _, werr := fmt.Fprintf(w, "%v\n", downloadErrs); werr != nil {
-			// Error handling the error, bail out.
-			fmt.Fprintf(bw, "\n\nwrite @error.txt: %v\n\ncaused by: %v\n", werr, downloadErrs)
+		fmt.Fprintf(errText, "%v\n", downloadErrs)
+		errors.JoinInto(&finalErr, errors.Wrap(downloadErrs, "download files (reported via @error.txt)"))
+	}
+	if finalErr != nil {
+		// If there are errors to write out, create @error.txt in the archive.  It's stored
+		// rather than compressed where possible, so the actual bytes of the error appear on
+		// the wire.
+		w, aerr := aw.Create("@error.txt", int64(errText.Len()), true)
+		if aerr != nil {
+			// Now we have the exciting situation of an error while handling the error.
+			// Bail out with both errors; print both to the HTTP stream (sorry archive
+			// enjoyers), and return an error containing the text of each.
+			fmt.Fprintf(bw, "\n\ncreate @error.txt: %v\n\ncaused by: %v\n", aerr, finalErr)
 			bw.Flush()
-			return errors.Errorf("write @error.txt: %v; cause by %v", werr, downloadErrs)
+			return errors.Errorf("create @error.txt: %v; caused by %v", aerr, finalErr)
+		}
+		if _, werr := w.Write(errText.Bytes()); werr != nil {
+			// See above; error handling the error.
+			fmt.Fprintf(bw, "\n\nwrite @error.txt: %v\n\ncaused by: %v\n", werr, finalErr)
+			bw.Flush()
+			return errors.Errorf("write @error.txt: %v; caused by %v", werr, finalErr)
 		}
-		errors.JoinInto(&finalErr, errors.Wrap(downloadErrs, "download files (reported via @error.txt)"))
 	}
 
-	// Finish the ZIP file.
-	if err := zw.Close(); err != nil {
-		return errors.Wrapf(err, "zip.Writer.Close()")
+	// Finish the archive.
+	if err := aw.Close(); err != nil {
+		return errors.Wrap(err, "close archive")
 	}
 
 	// Flush any data in the buffered writer.
@@ -5,6 +5,7 @@ import (
 	"archive/zip"
 	"bufio"
 	"bytes"
+	"compress/gzip"
 	"context"
 	"fmt"
 	"io"
@@ -15,6 +16,7 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/klauspost/compress/zstd"
 	"github.com/pachyderm/pachyderm/v2/src/internal/client"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/log"
@@ -193,6 +195,61 @@ var testData = []struct {
 	},
 }
 
+// readArchive returns the content of each file in an archive.
+func readArchive(t *testing.T, format ArchiveFormat, bs []byte) map[string]string {
+	t.Helper()
+	got := map[string]string{}
+	if format == ArchiveFormatZip {
+		r, err := zip.NewReader(bytes.NewReader(bs), int64(len(bs))) // zip needs a ReaderAt, which Body isn't.
+		if err != nil {
+			t.Fatalf("create zip reader: %v", err)
+		}
+		for _, fileinfo := range r.File {
+			file, err := r.Open(fileinfo.Name)
+			if err != nil {
+				t.Fatalf("open %v: %v", file, err)
+			}
+			buf := new(bytes.Buffer)
+			if _, err := io.Copy(buf, file); err != nil {
+				t.Fatalf("read %v: %v", file, err)
+			}
+			got[fileinfo.Name] = buf.String()
+		}
+		return got
+	}
+	var r io.Reader = bytes.NewReader(bs)
+	switch format {
+	case ArchiveFormatTarGz:
+		gr, err := gzip.NewReader(r)
+		if err != nil {
+			t.Fatalf("create gzip reader: %v", err)
+		}
+		r = gr
+	case ArchiveFormatTarZst:
+		zr, err := zstd.NewReader(r)
+		if err != nil {
+			t.Fatalf("create zstd reader: %v", err)
+		}
+		defer zr.Close()
+		r = zr
+	}
+	tr := tar.NewReader(r)
+	for {
+		h, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return got
+		}
+		if err != nil {
+			t.Fatalf("read tar header: %v", err)
+		}
+		buf := new(bytes.Buffer)
+		if _, err := io.Copy(buf, tr); err != nil {
+			t.Fatalf("read %v: %v", h.Name, err)
+		}
+		got[h.Name] = buf.String()
+	}
+}
+
 func TestHTTP(t *testing.T) {
 	for _, test := range testData {
 		t.Run(test.name, func(t *testing.T) {
@@ -202,23 +259,7 @@ func TestHTTP(t *testing.T) {
 			}
 
 			if test.wantFiles != nil {
-				bs := body.Bytes() // zip needs a ReaderAt, which Body isn't.
-				r, err := zip.NewReader(bytes.NewReader(bs), int64(len(bs)))
-				if err != nil {
-					t.Fatalf("create zip reader: %v", err)
-				}
-				got := map[string]string{}
-				for _, fileinfo := range r.File {
-					file, err := r.Open(fileinfo.Name)
-					if err != nil {
-						t.Fatalf("open %v: %v", file, err)
-					}
-					buf := new(bytes.Buffer)
-					if _, err := io.Copy(buf, file); err != nil {
-						t.Fatalf("read %v: %v", file, err)
-					}
-					got[fileinfo.Name] = buf.String()
-				}
+				got := readArchive(t, ArchiveFormatZip, body.Bytes())
 				if diff := cmp.Diff(got, test.wantFiles); diff != "" {
 					t.Errorf("downloaded files (-got +want):\n%s", diff)
 				}
@@ -227,6 +268,27 @@ func TestHTTP(t *testing.T) {
 	}
 }
 
+func TestHTTPFormats(t *testing.T) {
+	for _, test := range testData {
+		if test.wantFiles == nil {
+			continue
+		}
+		for _, format := range ArchiveFormats {
+			t.Run(test.name+"/"+string(format), func(t *testing.T) {
+				u := strings.Replace(test.url, ".zip", "."+string(format), 1)
+				code, body := doTest(t, test.method, u)
+				if got, want := code, test.wantCode; got != want {
+					t.Errorf("response code:\n  got: %v\n want: %v", got, want)
+				}
+				got := readArchive(t, format, body.Bytes())
+				if diff := cmp.Diff(got, test.wantFiles); diff != "" {
+					t.Errorf("downloaded files (-got +want):\n%s", diff)
+				}
+			})
+		}
+	}
+}
+
 func FuzzHTTP(f *testing.F) {
 	for _, test := range testData {
 		f.Add(test.url)
@@ -11,14 +11,26 @@ import (
 type ArchiveFormat string
 
 const (
-	ArchiveFormatZip ArchiveFormat = "zip" // A ZIP file.
+	ArchiveFormatZip    ArchiveFormat = "zip"     // A ZIP file.
+	ArchiveFormatTar    ArchiveFormat = "tar"     // An uncompressed TAR file.
+	ArchiveFormatTarGz  ArchiveFormat = "tar.gz"  // A gzip-compressed TAR file.
+	ArchiveFormatTarZst ArchiveFormat = "tar.zst" // A zstd-compressed TAR file.
 )
 
+// ArchiveFormats is every supported archive format.
+var ArchiveFormats = []ArchiveFormat{ArchiveFormatZip, ArchiveFormatTar, ArchiveFormatTarGz, ArchiveFormatTarZst}
+
 func (f ArchiveFormat) ContentType() string {
 	//exhaustive:enforce
 	switch f {
 	case ArchiveFormatZip:
 		return "application/zip"
+	case ArchiveFormatTar:
+		return "application/x-tar"
+	case ArchiveFormatTarGz:
+		return "application/gzip"
+	case ArchiveFormatTarZst:
+		return "application/zstd"
 	}
 	panic("unknown archive format")
 }
@@ -50,11 +62,11 @@ func ArchiveFromURL(u *url.URL) (*ArchiveRequest, error) {
 		return nil, errors.New("no extension on provided archive filename")
 	}
 	rawFormat := fileParts[1]
-	switch ArchiveFormat(rawFormat) {
-	case ArchiveFormatZip:
+	switch format := ArchiveFormat(rawFormat); format {
+	case ArchiveFormatZip, ArchiveFormatTar, ArchiveFormatTarGz, ArchiveFormatTarZst:
 		return &ArchiveRequest{
 			rawFiles: fileParts[0],
-			Format:   ArchiveFormatZip,
+			Format:   format,
 		}, nil
 	}
 	return nil, errors.Errorf("unknown archive format %v", rawFormat)
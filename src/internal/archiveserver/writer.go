@@ -0,0 +1,135 @@
+package archiveserver
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"io"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+)
+
+// archiveWriter adds files to an archive in one of the supported formats.
+type archiveWriter interface {
+	// Create starts a new file in the archive, and returns a writer for exactly size bytes
+	// of content.  If store is true, the file is not compressed individually, for formats
+	// where that is possible.
+	Create(name string, size int64, store bool) (io.Writer, error)
+	// Close finishes the archive.  It does not close the underlying writer.
+	Close() error
+}
+
+// newArchiveWriter returns an archiveWriter that writes an archive of the given format to w.
+// Files in the archive are marked as modified at the given time.
+func newArchiveWriter(format ArchiveFormat, w io.Writer, modified time.Time) (archiveWriter, error) {
+	//exhaustive:enforce
+	switch format {
+	case ArchiveFormatZip:
+		return &zipWriter{w: zip.NewWriter(w), modified: modified}, nil
+	case ArchiveFormatTar:
+		return &tarWriter{w: tar.NewWriter(w), modified: modified}, nil
+	case ArchiveFormatTarGz:
+		gw := gzip.NewWriter(w)
+		return &tarWriter{w: tar.NewWriter(gw), compressor: gw, modified: modified}, nil
+	case ArchiveFormatTarZst:
+		zw, err := zstd.NewWriter(w)
+		if err != nil {
+			return nil, errors.Wrap(err, "zstd.NewWriter")
+		}
+		return &tarWriter{w: tar.NewWriter(zw), compressor: zw, modified: modified}, nil
+	}
+	return nil, errors.Errorf("unknown archive format %v", format)
+}
+
+type zipWriter struct {
+	w        *zip.Writer
+	modified time.Time
+}
+
+// Create implements archiveWriter.
+func (z *zipWriter) Create(name string, size int64, store bool) (io.Writer, error) {
+	method := zip.Deflate
+	if store {
+		method = zip.Store
+	}
+	w, err := z.w.CreateHeader(&zip.FileHeader{
+		Name:     name,
+		Method:   method,
+		Modified: z.modified,
+	})
+	return w, errors.Wrap(err, "zip.Writer.CreateHeader")
+}
+
+// Close implements archiveWriter.
+func (z *zipWriter) Close() error {
+	return errors.Wrap(z.w.Close(), "zip.Writer.Close()")
+}
+
+// tarWriter writes a TAR file, optionally through a stream compressor.  Unlike ZIP, TAR has no
+// central directory, so nothing has to be held back until the end of the download.
+type tarWriter struct {
+	w          *tar.Writer
+	compressor io.WriteCloser
+	modified   time.Time
+	remaining  int64 // Bytes of the current file that have not been written yet.
+}
+
+// Create implements archiveWriter.
+func (t *tarWriter) Create(name string, size int64, _ bool) (io.Writer, error) {
+	if err := t.pad(); err != nil {
+		return nil, err
+	}
+	if err := t.w.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     name,
+		Size:     size,
+		Mode:     0o644,
+		ModTime:  t.modified,
+	}); err != nil {
+		return nil, errors.Wrap(err, "tar.Writer.WriteHeader")
+	}
+	t.remaining = size
+	return t, nil
+}
+
+// Write writes content to the current file.
+func (t *tarWriter) Write(p []byte) (int, error) {
+	n, err := t.w.Write(p)
+	t.remaining -= int64(n)
+	return n, errors.Wrap(err, "tar.Writer.Write")
+}
+
+// pad fills the rest of a file that failed to download part way through with zeros, so that
+// the archive stays readable.  The failure is reported in @error.txt.
+func (t *tarWriter) pad() error {
+	if t.remaining <= 0 {
+		return nil
+	}
+	_, err := io.CopyN(t, zeros{}, t.remaining)
+	return errors.Wrap(err, "pad incomplete file")
+}
+
+// Close implements archiveWriter.
+func (t *tarWriter) Close() error {
+	if err := t.pad(); err != nil {
+		return err
+	}
+	if err := t.w.Close(); err != nil {
+		return errors.Wrap(err, "tar.Writer.Close()")
+	}
+	if t.compressor != nil {
+		return errors.Wrap(t.compressor.Close(), "close compressor")
+	}
+	return nil
+}
+
+// zeros is an io.Reader of infinite zeros.
+type zeros struct{}
+
+// Read implements io.Reader.
+func (zeros) Read(p []byte) (int, error) {
+	clear(p)
+	return len(p), nil
+}
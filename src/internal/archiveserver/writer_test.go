@@ -0,0 +1,38 @@
+package archiveserver
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestTarWriterPadsIncompleteFiles(t *testing.T) {
+	buf := new(bytes.Buffer)
+	aw, err := newArchiveWriter(ArchiveFormatTar, buf, time.Now())
+	if err != nil {
+		t.Fatalf("newArchiveWriter: %v", err)
+	}
+	w, err := aw.Create("partial.txt", 10, false)
+	if err != nil {
+		t.Fatalf("create partial.txt: %v", err)
+	}
+	fmt.Fprint(w, "part")
+	w, err = aw.Create("@error.txt", 5, true)
+	if err != nil {
+		t.Fatalf("create @error.txt: %v", err)
+	}
+	fmt.Fprint(w, "oops\n")
+	if err := aw.Close(); err != nil {
+		t.Fatalf("close: %v", err)
+	}
+	want := map[string]string{
+		"partial.txt": "part\x00\x00\x00\x00\x00\x00",
+		"@error.txt":  "oops\n",
+	}
+	if diff := cmp.Diff(readArchive(t, ArchiveFormatTar, buf.Bytes()), want); diff != "" {
+		t.Errorf("archive files (-got +want):\n%s", diff)
+	}
+}
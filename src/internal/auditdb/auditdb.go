@@ -0,0 +1,209 @@
+// Package auditdb contains the database functions backing the audit log.
+//
+// Events are stored in the audit.events table, which is append-only: rows are never updated or
+// deleted.  The audit.exports table tracks how far the log has been copied into each export repo,
+// and the audit.export_gaps table the IDs below that position whose events were not yet
+// committed when the log was copied.
+package auditdb
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/jmoiron/sqlx"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pachyderm/pachyderm/v2/src/audit"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+)
+
+// Event is a row in the audit.events table.
+type Event struct {
+	ID        int64     `db:"id"`
+	Time      time.Time `db:"time"`
+	Principal string    `db:"principal"`
+	Method    string    `db:"method"`
+	Resource  string    `db:"resource"`
+	Peer      string    `db:"peer"`
+	Code      string    `db:"code"`
+	Error     string    `db:"error"`
+}
+
+const eventColumns = "id, time, principal, method, resource, peer, code, error"
+
+// ToProto converts the row to an audit.Event.
+func (e *Event) ToProto() *audit.Event {
+	return &audit.Event{
+		Id:        e.ID,
+		Time:      timestamppb.New(e.Time),
+		Principal: e.Principal,
+		Method:    e.Method,
+		Resource:  e.Resource,
+		Peer:      e.Peer,
+		Code:      e.Code,
+		Error:     e.Error,
+	}
+}
+
+// InsertEvent appends an event to the audit log and returns its ID.  The ID field of e is
+// ignored.
+func InsertEvent(ctx context.Context, q sqlx.QueryerContext, e *Event) (int64, error) {
+	var id int64
+	if err := sqlx.GetContext(ctx, q, &id, `
+		INSERT INTO audit.events (time, principal, method, resource, peer, code, error)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id`,
+		e.Time, e.Principal, e.Method, e.Resource, e.Peer, e.Code, e.Error); err != nil {
+		return 0, errors.Wrap(err, "insert audit event")
+	}
+	return id, nil
+}
+
+// resourceSeparators are the characters that may follow the name of a resource in the name of
+// a resource beneath it: "project/repo", "repo@branch", "commit:/path" and "repo.spec".
+var resourceSeparators = []string{"/", "@", ":", "."}
+
+// escapeLike escapes the LIKE wildcards in s.
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
+
+// ListEvents calls cb with each event matching the request, newest first.
+func ListEvents(ctx context.Context, q sqlx.QueryerContext, req *audit.ListEventRequest, cb func(*Event) error) error {
+	var where []string
+	var args []any
+	arg := func(v any) string {
+		args = append(args, v)
+		return "$" + strconv.Itoa(len(args))
+	}
+	if req.Principal != "" {
+		where = append(where, "principal = "+arg(req.Principal))
+	}
+	if r := req.Resource; r != "" {
+		var seps []string
+		for _, sep := range resourceSeparators {
+			seps = append(seps, arg(sep))
+		}
+		where = append(where, "(resource = "+arg(r)+
+			" OR (resource LIKE "+arg(escapeLike(r)+"%")+
+			" AND substr(resource, "+arg(utf8.RuneCountInString(r)+1)+", 1) IN ("+strings.Join(seps, ", ")+")))")
+	}
+	if req.Since != nil {
+		where = append(where, "time >= "+arg(req.Since.AsTime()))
+	}
+	if req.Until != nil {
+		where = append(where, "time < "+arg(req.Until.AsTime()))
+	}
+	query := `SELECT ` + eventColumns + ` FROM audit.events`
+	if len(where) > 0 {
+		query += ` WHERE ` + strings.Join(where, " AND ")
+	}
+	query += ` ORDER BY time DESC, id DESC`
+	if req.Limit > 0 {
+		query += ` LIMIT ` + arg(req.Limit)
+	}
+	rows, err := q.QueryxContext(ctx, query, args...)
+	if err != nil {
+		return errors.Wrap(err, "list audit events")
+	}
+	defer rows.Close()
+	for rows.Next() {
+		e := &Event{}
+		if err := rows.StructScan(e); err != nil {
+			return errors.Wrap(err, "scan audit event")
+		}
+		if err := cb(e); err != nil {
+			return err
+		}
+	}
+	return errors.Wrap(rows.Err(), "iterate audit events")
+}
+
+// ListEventsAfter returns up to limit events whose IDs are greater than id or in gaps, oldest
+// first.
+func ListEventsAfter(ctx context.Context, q sqlx.QueryerContext, id int64, gaps []int64, limit int) ([]*Event, error) {
+	args := []any{id, limit}
+	where := "id > $1"
+	if len(gaps) > 0 {
+		var in []string
+		for _, gap := range gaps {
+			args = append(args, gap)
+			in = append(in, "$"+strconv.Itoa(len(args)))
+		}
+		where += " OR id IN (" + strings.Join(in, ", ") + ")"
+	}
+	var events []*Event
+	if err := sqlx.SelectContext(ctx, q, &events, `
+		SELECT `+eventColumns+` FROM audit.events
+		WHERE `+where+`
+		ORDER BY id
+		LIMIT $2`, args...); err != nil {
+		return nil, errors.Wrap(err, "list audit events")
+	}
+	return events, nil
+}
+
+// GetExportPosition returns the ID of the last event exported to repo, or 0 if nothing has been
+// exported to it yet.
+func GetExportPosition(ctx context.Context, q sqlx.QueryerContext, repo string) (int64, error) {
+	var id int64
+	if err := sqlx.GetContext(ctx, q, &id, `SELECT last_id FROM audit.exports WHERE repo = $1`, repo); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, nil
+		}
+		return 0, errors.Wrap(err, "get audit export position")
+	}
+	return id, nil
+}
+
+// ListExportGaps returns the IDs at or below the export position of repo whose events have not
+// been exported, oldest first.
+func ListExportGaps(ctx context.Context, q sqlx.QueryerContext, repo string) ([]int64, error) {
+	var ids []int64
+	if err := sqlx.SelectContext(ctx, q, &ids, `SELECT id FROM audit.export_gaps WHERE repo = $1 ORDER BY id`, repo); err != nil {
+		return nil, errors.Wrap(err, "list audit export gaps")
+	}
+	return ids, nil
+}
+
+// AddExportGaps records that the events with the given IDs have not been exported to repo,
+// although the export position has passed them.
+func AddExportGaps(ctx context.Context, tx *pachsql.Tx, repo string, ids []int64, now time.Time) error {
+	for _, id := range ids {
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO audit.export_gaps (repo, id, since) VALUES ($1, $2, $3)
+			ON CONFLICT (repo, id) DO NOTHING`, repo, id, now); err != nil {
+			return errors.Wrap(err, "add audit export gap")
+		}
+	}
+	return nil
+}
+
+// DeleteExportGaps forgets the gaps of repo with the given IDs, and every gap of repo recorded
+// before the given time.
+func DeleteExportGaps(ctx context.Context, tx *pachsql.Tx, repo string, ids []int64, before time.Time) error {
+	for _, id := range ids {
+		if _, err := tx.ExecContext(ctx, `DELETE FROM audit.export_gaps WHERE repo = $1 AND id = $2`, repo, id); err != nil {
+			return errors.Wrap(err, "delete audit export gap")
+		}
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM audit.export_gaps WHERE repo = $1 AND since < $2`, repo, before); err != nil {
+		return errors.Wrap(err, "expire audit export gaps")
+	}
+	return nil
+}
+
+// SetExportPosition records that every event up to and including id has been exported to repo.
+func SetExportPosition(ctx context.Context, tx *pachsql.Tx, repo string, id int64) error {
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO audit.exports (repo, last_id) VALUES ($1, $2)
+		ON CONFLICT (repo) DO UPDATE SET last_id = EXCLUDED.last_id`, repo, id); err != nil {
+		return errors.Wrap(err, "set audit export position")
+	}
+	return nil
+}
@@ -14,6 +14,7 @@ const (
 	roleBindingsCollectionName = "role_bindings"
 	membersCollectionName      = "members"
 	groupsCollectionName       = "groups"
+	rolesCollectionName        = "roles"
 
 	// InternalUser is the name of the user representing the auth system. By default, it is a cluster admin.
 	InternalUser = auth.InternalPrefix + "auth-server"
@@ -71,6 +72,19 @@ func GroupsCollection(db *pachsql.DB, listener col.PostgresListener) col.Postgre
 	)
 }
 
+var rolesIndexes = []*col.Index{}
+
+// RoleCollection returns a postgres collection representing custom roles, keyed by name.
+func RoleCollection(db *pachsql.DB, listener col.PostgresListener) col.PostgresCollection {
+	return col.NewPostgresCollection(
+		rolesCollectionName,
+		db,
+		listener,
+		&auth.Role{},
+		rolesIndexes,
+	)
+}
+
 // CollectionsV0 returns a list of all the PPS API collections for
 // postgres-initialization purposes. These collections are not usable for
 // querying.
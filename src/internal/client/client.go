@@ -28,6 +28,7 @@ import (
 	"google.golang.org/grpc/metadata"
 
 	"github.com/pachyderm/pachyderm/v2/src/admin"
+	"github.com/pachyderm/pachyderm/v2/src/audit"
 	"github.com/pachyderm/pachyderm/v2/src/auth"
 	"github.com/pachyderm/pachyderm/v2/src/debug"
 	"github.com/pachyderm/pachyderm/v2/src/enterprise"
@@ -41,11 +42,13 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/tracing"
 	"github.com/pachyderm/pachyderm/v2/src/license"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/pjs"
 	"github.com/pachyderm/pachyderm/v2/src/pps"
 	"github.com/pachyderm/pachyderm/v2/src/proxy"
 	"github.com/pachyderm/pachyderm/v2/src/transaction"
 	"github.com/pachyderm/pachyderm/v2/src/version"
 	"github.com/pachyderm/pachyderm/v2/src/version/versionpb"
+	"github.com/pachyderm/pachyderm/v2/src/webhook"
 )
 
 const (
@@ -101,6 +104,9 @@ type APIClient struct {
 	ProxyClient
 	Enterprise enterprise.APIClient // not embedded--method name conflicts with AuthAPIClient
 	License    license.APIClient
+	PJS        pjs.APIClient // not embedded--method name conflicts with PpsAPIClient
+	Audit      audit.APIClient
+	Webhook    webhook.APIClient
 
 	// addr is the parsed address used to connect to this server
 	addr *grpcutil.PachdAddress
@@ -846,6 +852,9 @@ func (c *APIClient) connect(rctx context.Context, timeout time.Duration, unaryIn
 	c.TransactionAPIClient = transaction.NewAPIClient(clientConn)
 	c.DebugClient = debug.NewDebugClient(clientConn)
 	c.ProxyClient = proxy.NewAPIClient(clientConn)
+	c.PJS = pjs.NewAPIClient(clientConn)
+	c.Audit = audit.NewAPIClient(clientConn)
+	c.Webhook = webhook.NewAPIClient(clientConn)
 	c.clientConn = clientConn
 	c.healthClient = grpc_health_v1.NewHealthClient(clientConn)
 	c.ctx = rctx
@@ -6,6 +6,22 @@ type putFileConfig struct {
 	datum             string
 	append            bool
 	importConcurrency uint32
+	contentType       string
+	metadata          map[string]string
+}
+
+// addFile returns an AddFile message for path.  The content type and metadata are only sent with
+// the first message for each file; later messages append to the file, which keeps them.
+func (pfc *putFileConfig) addFile(path string, first bool) *pfs.AddFile {
+	af := &pfs.AddFile{
+		Path:  path,
+		Datum: pfc.datum,
+	}
+	if first {
+		af.ContentType = pfc.contentType
+		af.Metadata = pfc.metadata
+	}
+	return af
 }
 
 // PutFileOption configures a PutFile call.
@@ -25,6 +41,22 @@ func WithDatumPutFile(datum string) PutFileOption {
 	}
 }
 
+// WithContentTypePutFile configures the PutFile call to set the content type of the files it
+// writes.  Setting the content type replaces the metadata of the files too.
+func WithContentTypePutFile(contentType string) PutFileOption {
+	return func(pf *putFileConfig) {
+		pf.contentType = contentType
+	}
+}
+
+// WithMetadataPutFile configures the PutFile call to set the user metadata of the files it
+// writes, replacing any metadata and content type that they already have.
+func WithMetadataPutFile(metadata map[string]string) PutFileOption {
+	return func(pf *putFileConfig) {
+		pf.metadata = metadata
+	}
+}
+
 // WithImportConcurrency configures the maximum number of tasks in flight created by PutFileURL.
 func WithImportConcurrency(importConcurrency uint32) PutFileOption {
 	return func(pf *putFileConfig) {
@@ -81,8 +113,24 @@ func WithDatumGetFile(datum string) GetFileOption {
 	}
 }
 
+// WithOffset skips offset bytes at the start of the file.
 func WithOffset(offset int64) GetFileOption {
 	return func(gf *pfs.GetFileRequest) {
 		gf.Offset = offset
 	}
 }
+
+// WithSizeBytes limits the get file request to at most size bytes after the offset.
+func WithSizeBytes(size int64) GetFileOption {
+	return func(gf *pfs.GetFileRequest) {
+		gf.SizeBytes = size
+	}
+}
+
+// WithRange reads only the size bytes starting at offset.
+func WithRange(offset, size int64) GetFileOption {
+	return func(gf *pfs.GetFileRequest) {
+		gf.Offset = offset
+		gf.SizeBytes = size
+	}
+}
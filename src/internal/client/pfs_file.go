@@ -190,22 +190,17 @@ func (mfc *modifyFileCore) PutFile(path string, r io.Reader, opts ...PutFileOpti
 		}
 		emptyFile := true
 		if _, err := grpcutil.ChunkReader(r, func(data []byte) error {
+			af := config.addFile(path, emptyFile)
+			af.Source = &pfs.AddFile_Raw{
+				Raw: wrapperspb.Bytes(data),
+			}
 			emptyFile = false
-			return mfc.sendPutFile(&pfs.AddFile{
-				Path:  path,
-				Datum: config.datum,
-				Source: &pfs.AddFile_Raw{
-					Raw: wrapperspb.Bytes(data),
-				},
-			})
+			return mfc.sendPutFile(af)
 		}); err != nil {
 			return err
 		}
 		if emptyFile {
-			return mfc.sendPutFile(&pfs.AddFile{
-				Path:  path,
-				Datum: config.datum,
-			})
+			return mfc.sendPutFile(config.addFile(path, true))
 		}
 		return nil
 	})
@@ -256,21 +251,18 @@ func (mfc *modifyFileCore) PutFileTAR(r io.Reader, opts ...PutFileOption) error
 				}
 			}
 			if hdr.Size == 0 {
-				if err := mfc.sendPutFile(&pfs.AddFile{
-					Path:  p,
-					Datum: config.datum,
-				}); err != nil {
+				if err := mfc.sendPutFile(config.addFile(p, true)); err != nil {
 					return err
 				}
 			} else {
+				first := true
 				if _, err := grpcutil.ChunkReader(tr, func(data []byte) error {
-					return mfc.sendPutFile(&pfs.AddFile{
-						Path:  p,
-						Datum: config.datum,
-						Source: &pfs.AddFile_Raw{
-							Raw: wrapperspb.Bytes(data),
-						},
-					})
+					af := config.addFile(p, first)
+					af.Source = &pfs.AddFile_Raw{
+						Raw: wrapperspb.Bytes(data),
+					}
+					first = false
+					return mfc.sendPutFile(af)
 				}); err != nil {
 					return err
 				}
@@ -294,15 +286,12 @@ func (mfc *modifyFileCore) PutFileURL(path, url string, recursive bool, opts ...
 				return err
 			}
 		}
-		pf := &pfs.AddFile{
-			Path:  path,
-			Datum: config.datum,
-			Source: &pfs.AddFile_Url{
-				Url: &pfs.AddFile_URLSource{
-					URL:         url,
-					Recursive:   recursive,
-					Concurrency: config.importConcurrency,
-				},
+		pf := config.addFile(path, true)
+		pf.Source = &pfs.AddFile_Url{
+			Url: &pfs.AddFile_URLSource{
+				URL:         url,
+				Recursive:   recursive,
+				Concurrency: config.importConcurrency,
 			},
 		}
 		return mfc.sendPutFile(pf)
@@ -614,6 +603,25 @@ func getFileTar(ctx context.Context, c pfs.APIClient, commit *pfs.Commit, path s
 	return grpcutil.NewStreamingBytesReader(client, cf), nil
 }
 
+// GetFileRange returns a reader for size bytes of a file starting at offset, or for the rest of
+// the file if size is 0.  Only the chunks of the file that overlap the range are read.
+func (c APIClient) GetFileRange(commit *pfs.Commit, path string, offset, size int64) (io.ReadCloser, error) {
+	return GetFileRange(c.Ctx(), c.PfsAPIClient, commit, path, offset, size)
+}
+
+func GetFileRange(ctx context.Context, c pfs.APIClient, commit *pfs.Commit, path string, offset, size int64) (_ io.ReadCloser, retErr error) {
+	defer func() {
+		retErr = grpcutil.ScrubGRPC(retErr)
+	}()
+	ctx, cf := context.WithCancel(ctx)
+	client, err := c.GetFile(ctx, &pfs.GetFileRequest{File: commit.NewFile(path), Offset: offset, SizeBytes: size})
+	if err != nil {
+		cf()
+		return nil, err
+	}
+	return grpcutil.NewStreamingBytesReader(client, cf), nil
+}
+
 // GetFileReader gets a reader for the specified path
 // TODO: This should probably be an io.ReadCloser so we can close the rpc if the full file isn't read.
 
@@ -668,15 +676,7 @@ type getFileReadSeeker struct {
 
 func (gfrs *getFileReadSeeker) Seek(offset int64, whence int) (int64, error) {
 	getFileReader := func(offset int64) (io.Reader, error) {
-		r, err := GetFileReader(gfrs.ctx, gfrs.c, gfrs.file.Commit, gfrs.file.Path)
-		if err != nil {
-			return nil, err
-		}
-		// TODO: Replace with file range request when implemented in PFS.
-		if _, err := io.CopyN(io.Discard, r, offset); err != nil {
-			return nil, err
-		}
-		return r, nil
+		return GetFileRange(gfrs.ctx, gfrs.c, gfrs.file.Commit, gfrs.file.Path, offset, 0)
 	}
 	switch whence {
 	case io.SeekStart:
@@ -6,6 +6,7 @@ import (
 	"context"
 
 	admin_v2 "github.com/pachyderm/pachyderm/v2/src/admin"
+	audit_v2 "github.com/pachyderm/pachyderm/v2/src/audit"
 	auth_v2 "github.com/pachyderm/pachyderm/v2/src/auth"
 	debug_v2 "github.com/pachyderm/pachyderm/v2/src/debug"
 	enterprise_v2 "github.com/pachyderm/pachyderm/v2/src/enterprise"
@@ -19,6 +20,7 @@ import (
 	taskapi "github.com/pachyderm/pachyderm/v2/src/task"
 	transaction_v2 "github.com/pachyderm/pachyderm/v2/src/transaction"
 	versionpb_v2 "github.com/pachyderm/pachyderm/v2/src/version/versionpb"
+	webhook_v2 "github.com/pachyderm/pachyderm/v2/src/webhook"
 
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -34,6 +36,12 @@ func (c *unsupportedAdminBuilderClient) InspectCluster(_ context.Context, _ *adm
 	return nil, unsupportedError("InspectCluster")
 }
 
+type unsupportedAuditBuilderClient struct{}
+
+func (c *unsupportedAuditBuilderClient) ListEvent(_ context.Context, _ *audit_v2.ListEventRequest, opts ...grpc.CallOption) (audit_v2.API_ListEventClient, error) {
+	return nil, unsupportedError("ListEvent")
+}
+
 type unsupportedAuthBuilderClient struct{}
 
 func (c *unsupportedAuthBuilderClient) Activate(_ context.Context, _ *auth_v2.ActivateRequest, opts ...grpc.CallOption) (*auth_v2.ActivateResponse, error) {
@@ -48,6 +56,10 @@ func (c *unsupportedAuthBuilderClient) Authorize(_ context.Context, _ *auth_v2.A
 	return nil, unsupportedError("Authorize")
 }
 
+func (c *unsupportedAuthBuilderClient) CreateRole(_ context.Context, _ *auth_v2.CreateRoleRequest, opts ...grpc.CallOption) (*auth_v2.CreateRoleResponse, error) {
+	return nil, unsupportedError("CreateRole")
+}
+
 func (c *unsupportedAuthBuilderClient) Deactivate(_ context.Context, _ *auth_v2.DeactivateRequest, opts ...grpc.CallOption) (*auth_v2.DeactivateResponse, error) {
 	return nil, unsupportedError("Deactivate")
 }
@@ -56,6 +68,10 @@ func (c *unsupportedAuthBuilderClient) DeleteExpiredAuthTokens(_ context.Context
 	return nil, unsupportedError("DeleteExpiredAuthTokens")
 }
 
+func (c *unsupportedAuthBuilderClient) DeleteRole(_ context.Context, _ *auth_v2.DeleteRoleRequest, opts ...grpc.CallOption) (*auth_v2.DeleteRoleResponse, error) {
+	return nil, unsupportedError("DeleteRole")
+}
+
 func (c *unsupportedAuthBuilderClient) ExtractAuthTokens(_ context.Context, _ *auth_v2.ExtractAuthTokensRequest, opts ...grpc.CallOption) (*auth_v2.ExtractAuthTokensResponse, error) {
 	return nil, unsupportedError("ExtractAuthTokens")
 }
@@ -100,6 +116,10 @@ func (c *unsupportedAuthBuilderClient) GetUsers(_ context.Context, _ *auth_v2.Ge
 	return nil, unsupportedError("GetUsers")
 }
 
+func (c *unsupportedAuthBuilderClient) ListRole(_ context.Context, _ *auth_v2.ListRoleRequest, opts ...grpc.CallOption) (*auth_v2.ListRoleResponse, error) {
+	return nil, unsupportedError("ListRole")
+}
+
 func (c *unsupportedAuthBuilderClient) ModifyMembers(_ context.Context, _ *auth_v2.ModifyMembersRequest, opts ...grpc.CallOption) (*auth_v2.ModifyMembersResponse, error) {
 	return nil, unsupportedError("ModifyMembers")
 }
@@ -306,6 +326,10 @@ func (c *unsupportedPfsBuilderClient) AddFileSet(_ context.Context, _ *pfs_v2.Ad
 	return nil, unsupportedError("AddFileSet")
 }
 
+func (c *unsupportedPfsBuilderClient) ApplyRetentionPolicy(_ context.Context, _ *pfs_v2.ApplyRetentionPolicyRequest, opts ...grpc.CallOption) (*pfs_v2.ApplyRetentionPolicyResponse, error) {
+	return nil, unsupportedError("ApplyRetentionPolicy")
+}
+
 func (c *unsupportedPfsBuilderClient) CheckStorage(_ context.Context, _ *pfs_v2.CheckStorageRequest, opts ...grpc.CallOption) (*pfs_v2.CheckStorageResponse, error) {
 	return nil, unsupportedError("CheckStorage")
 }
@@ -338,6 +362,10 @@ func (c *unsupportedPfsBuilderClient) CreateRepo(_ context.Context, _ *pfs_v2.Cr
 	return nil, unsupportedError("CreateRepo")
 }
 
+func (c *unsupportedPfsBuilderClient) CreateTag(_ context.Context, _ *pfs_v2.CreateTagRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	return nil, unsupportedError("CreateTag")
+}
+
 func (c *unsupportedPfsBuilderClient) DeleteAll(_ context.Context, _ *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	return nil, unsupportedError("DeleteAll")
 }
@@ -358,6 +386,10 @@ func (c *unsupportedPfsBuilderClient) DeleteRepos(_ context.Context, _ *pfs_v2.D
 	return nil, unsupportedError("DeleteRepos")
 }
 
+func (c *unsupportedPfsBuilderClient) DeleteTag(_ context.Context, _ *pfs_v2.DeleteTagRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	return nil, unsupportedError("DeleteTag")
+}
+
 func (c *unsupportedPfsBuilderClient) DiffFile(_ context.Context, _ *pfs_v2.DiffFileRequest, opts ...grpc.CallOption) (pfs_v2.API_DiffFileClient, error) {
 	return nil, unsupportedError("DiffFile")
 }
@@ -370,6 +402,10 @@ func (c *unsupportedPfsBuilderClient) DropCommitSet(_ context.Context, _ *pfs_v2
 	return nil, unsupportedError("DropCommitSet")
 }
 
+func (c *unsupportedPfsBuilderClient) EditMetadata(_ context.Context, _ *pfs_v2.EditMetadataRequest, opts ...grpc.CallOption) (*pfs_v2.EditMetadataResponse, error) {
+	return nil, unsupportedError("EditMetadata")
+}
+
 func (c *unsupportedPfsBuilderClient) Egress(_ context.Context, _ *pfs_v2.EgressRequest, opts ...grpc.CallOption) (*pfs_v2.EgressResponse, error) {
 	return nil, unsupportedError("Egress")
 }
@@ -430,6 +466,14 @@ func (c *unsupportedPfsBuilderClient) InspectRepo(_ context.Context, _ *pfs_v2.I
 	return nil, unsupportedError("InspectRepo")
 }
 
+func (c *unsupportedPfsBuilderClient) InspectStorage(_ context.Context, _ *pfs_v2.InspectStorageRequest, opts ...grpc.CallOption) (*pfs_v2.InspectStorageResponse, error) {
+	return nil, unsupportedError("InspectStorage")
+}
+
+func (c *unsupportedPfsBuilderClient) InspectTag(_ context.Context, _ *pfs_v2.InspectTagRequest, opts ...grpc.CallOption) (*pfs_v2.TagInfo, error) {
+	return nil, unsupportedError("InspectTag")
+}
+
 func (c *unsupportedPfsBuilderClient) ListBranch(_ context.Context, _ *pfs_v2.ListBranchRequest, opts ...grpc.CallOption) (pfs_v2.API_ListBranchClient, error) {
 	return nil, unsupportedError("ListBranch")
 }
@@ -454,6 +498,10 @@ func (c *unsupportedPfsBuilderClient) ListRepo(_ context.Context, _ *pfs_v2.List
 	return nil, unsupportedError("ListRepo")
 }
 
+func (c *unsupportedPfsBuilderClient) ListTag(_ context.Context, _ *pfs_v2.ListTagRequest, opts ...grpc.CallOption) (pfs_v2.API_ListTagClient, error) {
+	return nil, unsupportedError("ListTag")
+}
+
 func (c *unsupportedPfsBuilderClient) ListTask(_ context.Context, _ *taskapi.ListTaskRequest, opts ...grpc.CallOption) (pfs_v2.API_ListTaskClient, error) {
 	return nil, unsupportedError("ListTask")
 }
@@ -462,6 +510,10 @@ func (c *unsupportedPfsBuilderClient) ModifyFile(_ context.Context, opts ...grpc
 	return nil, unsupportedError("ModifyFile")
 }
 
+func (c *unsupportedPfsBuilderClient) PurgePath(_ context.Context, _ *pfs_v2.PurgePathRequest, opts ...grpc.CallOption) (*pfs_v2.PurgePathResponse, error) {
+	return nil, unsupportedError("PurgePath")
+}
+
 func (c *unsupportedPfsBuilderClient) PutCache(_ context.Context, _ *pfs_v2.PutCacheRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	return nil, unsupportedError("PutCache")
 }
@@ -470,6 +522,10 @@ func (c *unsupportedPfsBuilderClient) RenewFileSet(_ context.Context, _ *pfs_v2.
 	return nil, unsupportedError("RenewFileSet")
 }
 
+func (c *unsupportedPfsBuilderClient) SetRetentionPolicy(_ context.Context, _ *pfs_v2.SetRetentionPolicyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	return nil, unsupportedError("SetRetentionPolicy")
+}
+
 func (c *unsupportedPfsBuilderClient) ShardFileSet(_ context.Context, _ *pfs_v2.ShardFileSetRequest, opts ...grpc.CallOption) (*pfs_v2.ShardFileSetResponse, error) {
 	return nil, unsupportedError("ShardFileSet")
 }
@@ -735,3 +791,25 @@ type unsupportedVersionpbBuilderClient struct{}
 func (c *unsupportedVersionpbBuilderClient) GetVersion(_ context.Context, _ *emptypb.Empty, opts ...grpc.CallOption) (*versionpb_v2.Version, error) {
 	return nil, unsupportedError("GetVersion")
 }
+
+type unsupportedWebhookBuilderClient struct{}
+
+func (c *unsupportedWebhookBuilderClient) CreateWebhook(_ context.Context, _ *webhook_v2.CreateWebhookRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	return nil, unsupportedError("CreateWebhook")
+}
+
+func (c *unsupportedWebhookBuilderClient) DeleteWebhook(_ context.Context, _ *webhook_v2.DeleteWebhookRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	return nil, unsupportedError("DeleteWebhook")
+}
+
+func (c *unsupportedWebhookBuilderClient) InspectWebhook(_ context.Context, _ *webhook_v2.InspectWebhookRequest, opts ...grpc.CallOption) (*webhook_v2.WebhookInfo, error) {
+	return nil, unsupportedError("InspectWebhook")
+}
+
+func (c *unsupportedWebhookBuilderClient) ListDelivery(_ context.Context, _ *webhook_v2.ListDeliveryRequest, opts ...grpc.CallOption) (webhook_v2.API_ListDeliveryClient, error) {
+	return nil, unsupportedError("ListDelivery")
+}
+
+func (c *unsupportedWebhookBuilderClient) ListWebhook(_ context.Context, _ *webhook_v2.ListWebhookRequest, opts ...grpc.CallOption) (webhook_v2.API_ListWebhookClient, error) {
+	return nil, unsupportedError("ListWebhook")
+}
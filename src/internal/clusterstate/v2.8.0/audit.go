@@ -0,0 +1,63 @@
+package v2_8_0
+
+import (
+	"context"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/migrations"
+)
+
+// createAuditSchema creates the schema and tables backing the audit log.  The events table is
+// append-only: a trigger rejects every UPDATE, DELETE and TRUNCATE.  It also adds the repo type
+// of the repos the log is exported to.
+func createAuditSchema(ctx context.Context, env migrations.Env) error {
+	tx := env.Tx
+	if _, err := tx.ExecContext(ctx, `CREATE SCHEMA IF NOT EXISTS audit;`); err != nil {
+		return errors.Wrap(err, "creating audit schema")
+	}
+	if _, err := tx.ExecContext(ctx, `
+		CREATE TABLE IF NOT EXISTS audit.events (
+			id bigserial PRIMARY KEY,
+			time timestamptz NOT NULL,
+			principal text NOT NULL,
+			method text NOT NULL,
+			resource text NOT NULL,
+			peer text NOT NULL,
+			code text NOT NULL,
+			error text NOT NULL
+		);
+		CREATE INDEX IF NOT EXISTS events_time_idx ON audit.events (time);
+		CREATE INDEX IF NOT EXISTS events_principal_idx ON audit.events (principal, time);
+		CREATE INDEX IF NOT EXISTS events_resource_idx ON audit.events (resource text_pattern_ops);
+
+		CREATE OR REPLACE FUNCTION audit.reject_change() RETURNS trigger AS $$
+		BEGIN
+			RAISE EXCEPTION 'audit.events is append-only';
+		END;
+		$$ LANGUAGE plpgsql;
+		CREATE TRIGGER events_append_only BEFORE UPDATE OR DELETE ON audit.events
+			FOR EACH ROW EXECUTE PROCEDURE audit.reject_change();
+		CREATE TRIGGER events_no_truncate BEFORE TRUNCATE ON audit.events
+			FOR EACH STATEMENT EXECUTE PROCEDURE audit.reject_change();
+	`); err != nil {
+		return errors.Wrap(err, "creating audit.events table")
+	}
+	if _, err := tx.ExecContext(ctx, `
+		CREATE TABLE IF NOT EXISTS audit.exports (
+			repo text PRIMARY KEY,
+			last_id bigint NOT NULL
+		);
+		CREATE TABLE IF NOT EXISTS audit.export_gaps (
+			repo text NOT NULL,
+			id bigint NOT NULL,
+			since timestamptz NOT NULL,
+			PRIMARY KEY (repo, id)
+		);
+	`); err != nil {
+		return errors.Wrap(err, "creating audit.exports tables")
+	}
+	if _, err := tx.ExecContext(ctx, `ALTER TYPE pfs.repo_type ADD VALUE IF NOT EXISTS 'audit';`); err != nil {
+		return errors.Wrap(err, "adding audit repo type")
+	}
+	return nil
+}
@@ -0,0 +1,7 @@
+package v2_8_0
+
+func authCollections() []*postgresCollection {
+	return []*postgresCollection{
+		newPostgresCollection("roles"),
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/migrations"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/chunk"
 )
 
 func Migrate(state migrations.State) migrations.State {
@@ -17,5 +18,22 @@ func Migrate(state migrations.State) migrations.State {
 		Apply("create project defaults", func(ctx context.Context, env migrations.Env) error {
 			return setupPostgresCollections(ctx, env.Tx, ppsCollections()...)
 		}, migrations.Squash).
-		Apply("Rename migrated collections tables", renameCollectionsTables, migrations.Squash)
+		Apply("Rename migrated collections tables", renameCollectionsTables, migrations.Squash).
+		Apply("Create pjs schema", createPJSSchema, migrations.Squash).
+		Apply("Create pfs.sql_egresses table", createSQLEgressesTable, migrations.Squash).
+		Apply("Add metadata columns to projects, repos, branches and commits", addMetadataColumns, migrations.Squash).
+		Apply("Add retention_policy columns to repos and branches", addRetentionPolicyColumns, migrations.Squash).
+		Apply("Create pfs.tags table", createTagsTable, migrations.Squash).
+		Apply("storage chunk store v1", func(ctx context.Context, env migrations.Env) error {
+			return chunk.SetupPostgresStoreV1(ctx, env.Tx)
+		}, migrations.Squash).
+		Apply("Create audit schema", createAuditSchema, migrations.Squash).
+		Apply("create auth roles collection", func(ctx context.Context, env migrations.Env) error {
+			return setupPostgresCollections(ctx, env.Tx, authCollections()...)
+		}, migrations.Squash).
+		Apply("storage chunk store v2", func(ctx context.Context, env migrations.Env) error {
+			return chunk.SetupPostgresStoreV2(ctx, env.Tx)
+		}, migrations.Squash).
+		Apply("Create webhooks schema", createWebhooksSchema, migrations.Squash).
+		Apply("Create pfs.storage_stats table", createStorageStatsTable, migrations.Squash)
 }
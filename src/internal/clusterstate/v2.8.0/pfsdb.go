@@ -704,3 +704,77 @@ func renameCollectionsTables(ctx context.Context, env migrations.Env) error {
 	}
 	return nil
 }
+
+// createSQLEgressesTable creates the table recording the last commit from each
+// repo that was egressed to each SQL database, so that later egresses can
+// write only what changed.
+func createSQLEgressesTable(ctx context.Context, env migrations.Env) error {
+	if _, err := env.Tx.ExecContext(ctx, `
+		CREATE TABLE IF NOT EXISTS pfs.sql_egresses (
+			target text NOT NULL,
+			repo_id bigint REFERENCES pfs.repos(id) ON DELETE CASCADE NOT NULL,
+			commit_id bigint REFERENCES pfs.commits(int_id) ON DELETE CASCADE NOT NULL,
+			updated_at timestamptz DEFAULT CURRENT_TIMESTAMP NOT NULL,
+			PRIMARY KEY (target, repo_id)
+		);
+	`); err != nil {
+		return errors.Wrap(err, "creating sql_egresses table")
+	}
+	return nil
+}
+
+// addMetadataColumns adds a column of user-provided key/value metadata to the
+// projects, repos, branches and commits tables.
+func addMetadataColumns(ctx context.Context, env migrations.Env) error {
+	for _, table := range []string{"core.projects", "pfs.repos", "pfs.branches", "pfs.commits"} {
+		if _, err := env.Tx.ExecContext(ctx, fmt.Sprintf(`ALTER TABLE %s ADD COLUMN IF NOT EXISTS metadata jsonb NOT NULL DEFAULT '{}'::jsonb;`, table)); err != nil {
+			return errors.Wrapf(err, "adding metadata column to %s", table)
+		}
+	}
+	return nil
+}
+
+// addRetentionPolicyColumns adds a column holding the commit retention policy
+// of repos and branches.  A NULL policy keeps every commit.
+func addRetentionPolicyColumns(ctx context.Context, env migrations.Env) error {
+	for _, table := range []string{"pfs.repos", "pfs.branches"} {
+		if _, err := env.Tx.ExecContext(ctx, fmt.Sprintf(`ALTER TABLE %s ADD COLUMN IF NOT EXISTS retention_policy jsonb;`, table)); err != nil {
+			return errors.Wrapf(err, "adding retention_policy column to %s", table)
+		}
+	}
+	return nil
+}
+
+// createStorageStatsTable creates the table holding the latest storage
+// statistics computed by the pfs master.  It has at most one row.
+func createStorageStatsTable(ctx context.Context, env migrations.Env) error {
+	if _, err := env.Tx.ExecContext(ctx, `
+		CREATE TABLE IF NOT EXISTS pfs.storage_stats (
+			id int PRIMARY KEY DEFAULT 1 CHECK (id = 1),
+			computed_at timestamptz NOT NULL,
+			stats bytea NOT NULL
+		);
+	`); err != nil {
+		return errors.Wrap(err, "creating storage stats table")
+	}
+	return nil
+}
+
+// createTagsTable creates the table of immutable, named references to
+// commits.
+func createTagsTable(ctx context.Context, env migrations.Env) error {
+	if _, err := env.Tx.ExecContext(ctx, `
+		CREATE TABLE IF NOT EXISTS pfs.tags (
+			id bigserial PRIMARY KEY,
+			repo_id bigint REFERENCES pfs.repos(id) ON DELETE CASCADE NOT NULL,
+			name text NOT NULL,
+			commit_id bigint REFERENCES pfs.commits(int_id) ON DELETE CASCADE NOT NULL,
+			created_at timestamptz DEFAULT CURRENT_TIMESTAMP NOT NULL,
+			UNIQUE (repo_id, name)
+		);
+		CREATE INDEX IF NOT EXISTS tags_commit_id ON pfs.tags (commit_id);
+	`); err != nil {
+		return errors.Wrap(err, "creating tags table")
+	}
+	return nil
+}
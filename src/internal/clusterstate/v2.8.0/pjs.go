@@ -0,0 +1,41 @@
+package v2_8_0
+
+import (
+	"context"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/migrations"
+)
+
+// createPJSSchema creates the schema and tables backing the PJS (Pachyderm Job System) API.
+// Queues are not stored separately; a queue is the set of jobs sharing a spec hash.
+func createPJSSchema(ctx context.Context, env migrations.Env) error {
+	tx := env.Tx
+	if _, err := tx.ExecContext(ctx, `CREATE SCHEMA IF NOT EXISTS pjs;`); err != nil {
+		return errors.Wrap(err, "creating pjs schema")
+	}
+	if _, err := tx.ExecContext(ctx, `
+		CREATE TABLE IF NOT EXISTS pjs.jobs (
+			id bigserial PRIMARY KEY,
+			parent bigint REFERENCES pjs.jobs(id) ON DELETE CASCADE,
+			spec bytea NOT NULL,
+			spec_hash bytea NOT NULL,
+			input bytea NOT NULL,
+			input_hash bytea NOT NULL,
+			output bytea,
+			error smallint,
+			cache_read boolean NOT NULL DEFAULT FALSE,
+			cache_write boolean NOT NULL DEFAULT FALSE,
+			context_hash bytea UNIQUE,
+			queued_at timestamptz DEFAULT CURRENT_TIMESTAMP NOT NULL,
+			processing_at timestamptz,
+			done_at timestamptz
+		);
+		CREATE INDEX IF NOT EXISTS jobs_parent_idx ON pjs.jobs (parent);
+		CREATE INDEX IF NOT EXISTS jobs_queue_idx ON pjs.jobs (spec_hash, id) WHERE processing_at IS NULL AND done_at IS NULL;
+		CREATE INDEX IF NOT EXISTS jobs_cache_idx ON pjs.jobs (spec_hash, input_hash) WHERE cache_write AND output IS NOT NULL;
+	`); err != nil {
+		return errors.Wrap(err, "creating pjs.jobs table")
+	}
+	return nil
+}
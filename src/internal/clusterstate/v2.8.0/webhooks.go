@@ -0,0 +1,53 @@
+package v2_8_0
+
+import (
+	"context"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/migrations"
+)
+
+// createWebhooksSchema creates the schema and tables backing webhooks.  Events are written to the
+// deliveries table, once per matching webhook, in the same transaction as the change that caused
+// them; the rows are then delivered and kept as the delivery history.
+func createWebhooksSchema(ctx context.Context, env migrations.Env) error {
+	tx := env.Tx
+	if _, err := tx.ExecContext(ctx, `CREATE SCHEMA IF NOT EXISTS webhooks;`); err != nil {
+		return errors.Wrap(err, "creating webhooks schema")
+	}
+	if _, err := tx.ExecContext(ctx, `
+		CREATE TABLE IF NOT EXISTS webhooks.webhooks (
+			name text PRIMARY KEY,
+			url text NOT NULL,
+			secret text NOT NULL,
+			events text[] NOT NULL,
+			project text NOT NULL,
+			repo text NOT NULL,
+			branch text NOT NULL,
+			pipeline text NOT NULL,
+			created timestamptz NOT NULL
+		);
+	`); err != nil {
+		return errors.Wrap(err, "creating webhooks.webhooks table")
+	}
+	if _, err := tx.ExecContext(ctx, `
+		CREATE TABLE IF NOT EXISTS webhooks.deliveries (
+			id bigserial PRIMARY KEY,
+			webhook text NOT NULL REFERENCES webhooks.webhooks (name) ON DELETE CASCADE,
+			payload text NOT NULL,
+			state text NOT NULL,
+			attempts int NOT NULL DEFAULT 0,
+			last_status_code int NOT NULL DEFAULT 0,
+			last_error text NOT NULL DEFAULT '',
+			created timestamptz NOT NULL,
+			next_attempt timestamptz,
+			finished timestamptz
+		);
+		CREATE INDEX IF NOT EXISTS deliveries_pending_idx ON webhooks.deliveries (next_attempt) WHERE state = 'pending';
+		CREATE INDEX IF NOT EXISTS deliveries_webhook_idx ON webhooks.deliveries (webhook, id);
+		CREATE INDEX IF NOT EXISTS deliveries_finished_idx ON webhooks.deliveries (finished);
+	`); err != nil {
+		return errors.Wrap(err, "creating webhooks.deliveries table")
+	}
+	return nil
+}
@@ -204,6 +204,19 @@ func ParseBranch(project, arg string) (*pfs.Branch, error) {
 	return commit.Branch, nil
 }
 
+// ParseTag takes an argument of the form "repo@tag" and returns the
+// corresponding *pfs.Tag.
+func ParseTag(project, arg string) (*pfs.Tag, error) {
+	branch, err := ParseBranch(project, arg)
+	if err != nil {
+		return nil, err
+	}
+	if branch.Name == "" {
+		return nil, errors.Errorf("invalid tag \"%s\": expected repo@tag", arg)
+	}
+	return branch.Repo.NewTag(branch.Name), nil
+}
+
 // ParseJob takes an argument of the form "pipeline@job-id" and returns
 // the corresponding *pps.Job.
 func ParseJob(project, arg string) (*pps.Job, error) {
@@ -11,7 +11,9 @@ import (
 	"fmt"
 	"html/template"
 	"io"
+	"mime/multipart"
 	"net/http"
+	"net/textproto"
 	"path"
 	"strconv"
 	"strings"
@@ -21,6 +23,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/constants"
 	"github.com/pachyderm/pachyderm/v2/src/internal/client"
 	"github.com/pachyderm/pachyderm/v2/src/internal/conditionalrequest"
+	"github.com/pachyderm/pachyderm/v2/src/internal/httprange"
 	"github.com/pachyderm/pachyderm/v2/src/internal/log"
 	"github.com/pachyderm/pachyderm/v2/src/internal/middleware/auth/httpauth"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pctx"
@@ -290,55 +293,93 @@ func (r *Request) displayDirectoryListing(ctx context.Context, path string, info
 	}
 }
 
-// This handles a very limited amount of HTTP Range headers, only 'bytes=0-52'; no other formats, no
-// repeating ranges.  the format is inclusive; 0-499 returns 500 bytes.  We don't handle multiple
-// ranges because we can't do it efficiently; PFS can do offset + limit once, but not more than once
-// per request (and not transactionally for open commits if we split it across multiple requests).
-// If PFS handled multiple ranges, we could do that here too.
-func parseRange(rangeStr string, length int64) (offset, limit int64) {
-	if rangeStr == "" || !strings.HasPrefix(rangeStr, "bytes=") {
-		return 0, length
-	}
-	rangeStr = strings.TrimPrefix(rangeStr, "bytes=")
-	parts := strings.Split(rangeStr, "-")
-	if len(parts) != 2 {
-		return 0, length
-	}
-	start, err := strconv.ParseInt(parts[0], 10, 64)
-	if err != nil {
-		return 0, length
-	}
-	end, err := strconv.ParseInt(parts[1], 10, 64)
-	if err != nil {
-		return 0, length
-	}
-	if end > length {
-		end = length - 1
-	}
-	return start, end - start + 1
-}
-
+// sendFile sends the content of a file, or the parts of it named by the Range header.  Each range
+// is read with its own GetFile call, which only fetches the chunks that the range overlaps.
 func (r *Request) sendFile(ctx context.Context, info *pfs.FileInfo) {
 	ctx, c := pctx.WithCancel(ctx)
 	defer c()
 
-	offset, limit := parseRange(r.Request.Header.Get("range"), info.GetSizeBytes())
-	res, err := r.PachClient.PfsAPIClient.GetFile(ctx, &pfs.GetFileRequest{
-		File:   info.GetFile(),
-		Offset: offset,
-	})
+	w := r.ResponseWriter
+	size := info.GetSizeBytes()
+	ranges, err := httprange.Parse(r.Request.Header.Get("range"), size)
 	if err != nil {
-		r.displayGRPCError(ctx, "problem starting download", err)
+		w.Header().Set("content-range", httprange.UnsatisfiedContentRange(size))
+		r.displayErrorf(ctx, http.StatusRequestedRangeNotSatisfiable, "%v", err)
 		return
 	}
-	w := r.ResponseWriter
-	w.Header().Set("content-length", strconv.FormatInt(limit, 10))
-	if limit != info.GetSizeBytes() {
-		w.WriteHeader(http.StatusPartialContent)
-	} else {
+	switch len(ranges) {
+	case 0:
+		res, err := r.getRange(ctx, info.GetFile(), httprange.Range{Start: 0, Length: size})
+		if err != nil {
+			r.displayGRPCError(ctx, "problem starting download", err)
+			return
+		}
+		w.Header().Set("content-length", strconv.FormatInt(size, 10))
 		w.WriteHeader(http.StatusOK)
+		copyRange(ctx, w, res, size)
+	case 1:
+		rng := ranges[0]
+		res, err := r.getRange(ctx, info.GetFile(), rng)
+		if err != nil {
+			r.displayGRPCError(ctx, "problem starting download", err)
+			return
+		}
+		w.Header().Set("content-range", rng.ContentRange(size))
+		w.Header().Set("content-length", strconv.FormatInt(rng.Length, 10))
+		w.WriteHeader(http.StatusPartialContent)
+		copyRange(ctx, w, res, rng.Length)
+	default:
+		// Multiple ranges are sent as a multipart/byteranges body (RFC 7233 appendix A).  The
+		// parts are read separately, so the file must not change between them; that is true
+		// for finished commits, and for open commits the client can use If-Range to notice.
+		res, err := r.getRange(ctx, info.GetFile(), ranges[0])
+		if err != nil {
+			r.displayGRPCError(ctx, "problem starting download", err)
+			return
+		}
+		mw := multipart.NewWriter(w)
+		w.Header().Set("content-type", "multipart/byteranges; boundary="+mw.Boundary())
+		w.WriteHeader(http.StatusPartialContent)
+		for i, rng := range ranges {
+			if i > 0 {
+				res, err = r.getRange(ctx, info.GetFile(), rng)
+				if err != nil {
+					log.Info(ctx, "problem starting download of range", zap.Error(err))
+					fmt.Fprintf(w, "\n\nstream broke: %v", err)
+					return
+				}
+			}
+			pw, err := mw.CreatePart(textproto.MIMEHeader{
+				"Content-Type":  {"application/octet-stream"},
+				"Content-Range": {rng.ContentRange(size)},
+			})
+			if err != nil {
+				log.Info(ctx, "write error during download", zap.Error(err))
+				return
+			}
+			if !copyRange(ctx, pw, res, rng.Length) {
+				return
+			}
+		}
+		if err := mw.Close(); err != nil {
+			log.Info(ctx, "write error during download", zap.Error(err))
+		}
 	}
-	for {
+}
+
+// getRange starts reading a range of file.
+func (r *Request) getRange(ctx context.Context, file *pfs.File, rng httprange.Range) (pfs.API_GetFileClient, error) {
+	res, err := r.PachClient.PfsAPIClient.GetFile(ctx, &pfs.GetFileRequest{
+		File:      file,
+		Offset:    rng.Start,
+		SizeBytes: rng.Length,
+	})
+	return res, err //nolint:wrapcheck
+}
+
+// copyRange copies limit bytes from res to w, and returns true if they were all copied.
+func copyRange(ctx context.Context, w io.Writer, res pfs.API_GetFileClient, limit int64) bool {
+	for limit > 0 {
 		msg, err := res.Recv()
 		if err != nil {
 			// This rarely happens.  In a normal request, you'd look for io.EOF to exit,
@@ -350,25 +391,23 @@ func (r *Request) sendFile(ctx context.Context, info *pfs.FileInfo) {
 			// best we can do with HTTP.
 			//
 			// As for closing the actual stream so it doesn't leak when the server has
-			// more data than the client requested, we do that with `defer c()` above.
+			// more data than the client requested, the caller cancels ctx.
 			log.Info(ctx, "download stream broke unexpectedly", zap.Error(err))
 			fmt.Fprintf(w, "\n\nstream broke: %v", err)
-			return
+			return false
 		}
 		buf := msg.GetValue()
-		if len(buf) > int(limit) {
+		if int64(len(buf)) > limit {
 			buf = buf[0:limit]
 		}
 		n, err := w.Write(buf)
 		if err != nil {
 			log.Info(ctx, "write error during download", zap.Error(err))
-			return
+			return false
 		}
 		limit -= int64(n)
-		if limit <= 0 {
-			return
-		}
 	}
+	return true
 }
 
 func acceptsHTML(req *http.Request) bool {
@@ -489,6 +489,7 @@ func TestDownload(t *testing.T) {
 			wantHeader: http.Header{
 				"Accept-Ranges":  {"bytes"},
 				"Content-Length": {"10"},
+				"Content-Range":  {"bytes 0-9/26000"},
 				"Cache-Control":  {"private"},
 				"Etag":           {`"ee45bb2661662b371f3100176c518243dbbe282116a05bb8f025a9c23b97ea02"`},
 				"Last-Modified":  {finishedAt.Format(http.TimeFormat)},
@@ -508,12 +509,61 @@ func TestDownload(t *testing.T) {
 			wantHeader: http.Header{
 				"Accept-Ranges":  {"bytes"},
 				"Content-Length": {"11"},
+				"Content-Range":  {"bytes 25000-25010/26000"},
 				"Cache-Control":  {"private"},
 				"Etag":           {`"ee45bb2661662b371f3100176c518243dbbe282116a05bb8f025a9c23b97ea02"`},
 				"Last-Modified":  {finishedAt.Format(http.TimeFormat)},
 				"Vary":           {"authn-token"},
 			},
 		},
+		{
+			name:   "range download, finished, suffix",
+			method: http.MethodGet,
+			url:    fmt.Sprintf("https://example.com/pfs/default/test/%v/big.txt", finishedCommit.Id),
+			requestHeader: http.Header{
+				"Range": {"bytes=-3"},
+			},
+			wantContent: "ZZZ",
+			wantCode:    http.StatusPartialContent,
+			wantHeader: http.Header{
+				"Accept-Ranges":  {"bytes"},
+				"Content-Length": {"3"},
+				"Content-Range":  {"bytes 25997-25999/26000"},
+				"Cache-Control":  {"private"},
+				"Etag":           {`"ee45bb2661662b371f3100176c518243dbbe282116a05bb8f025a9c23b97ea02"`},
+				"Last-Modified":  {finishedAt.Format(http.TimeFormat)},
+				"Vary":           {"authn-token"},
+			},
+		},
+		{
+			name:   "range download, finished, multiple ranges",
+			method: http.MethodGet,
+			url:    fmt.Sprintf("https://example.com/pfs/default/test/%v/big.txt", finishedCommit.Id),
+			requestHeader: http.Header{
+				"Range": {"bytes=0-1, 1000-1001"},
+			},
+			wantContent: `/(?s)^--\w+\r\n.*Content-Range: bytes 0-1/26000\r\n.*\r\n\r\nAA\r\n--\w+\r\n.*Content-Range: bytes 1000-1001/26000\r\n.*\r\n\r\nBB\r\n--\w+--\r\n$/`,
+			wantCode:    http.StatusPartialContent,
+		},
+		{
+			name:   "range download, finished, unsatisfiable",
+			method: http.MethodGet,
+			url:    fmt.Sprintf("https://example.com/pfs/default/test/%v/big.txt", finishedCommit.Id),
+			requestHeader: http.Header{
+				"Range": {"bytes=26000-"},
+			},
+			wantContent: "no requested range overlaps the file",
+			wantCode:    http.StatusRequestedRangeNotSatisfiable,
+			wantHeader: http.Header{
+				"Accept-Ranges": {"bytes"},
+				"Content-Range": {"bytes */26000"},
+				"Content-Type":  {"text/plain"},
+				"Cache-Control": {"private"},
+				"Etag":          {`"ee45bb2661662b371f3100176c518243dbbe282116a05bb8f025a9c23b97ea02"`},
+				"Last-Modified": {finishedAt.Format(http.TimeFormat)},
+				"Vary":          {"authn-token"},
+			},
+		},
 		{
 			name:   "range download, finished, if match, doesn't match",
 			method: http.MethodGet,
@@ -0,0 +1,107 @@
+// Package httprange parses HTTP Range headers.
+//
+// See: https://www.rfc-editor.org/rfc/rfc7233#section-2.1
+package httprange
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+)
+
+// ErrUnsatisfiable is returned by Parse when none of the requested ranges overlap the resource.
+var ErrUnsatisfiable = errors.New("no requested range overlaps the file")
+
+// Range is a range of bytes in a resource.
+type Range struct {
+	Start  int64 // The offset of the first byte.
+	Length int64 // The number of bytes.
+}
+
+// ContentRange returns the value of the Content-Range header for this range of a resource of the
+// given size.
+func (r Range) ContentRange(size int64) string {
+	return fmt.Sprintf("bytes %d-%d/%d", r.Start, r.Start+r.Length-1, size)
+}
+
+// UnsatisfiedContentRange returns the value of the Content-Range header for a 416 response about
+// a resource of the given size.
+func UnsatisfiedContentRange(size int64) string {
+	return fmt.Sprintf("bytes */%d", size)
+}
+
+// Parse parses a Range header for a resource of the given size.  Each range is one of
+// "first-last" (inclusive; 0-499 is 500 bytes), "first-" (to the end of the resource), or "-n"
+// (the last n bytes), and ranges are separated by commas.  Ranges that extend past the end of the
+// resource are truncated, and ranges that start after it are dropped; if every range is dropped,
+// Parse returns ErrUnsatisfiable.
+//
+// If the header is empty, malformed, or not in bytes, Parse returns no ranges and the whole
+// resource should be sent, as the RFC requires.  So that a client can't make us send a resource
+// many times over, Parse also returns no ranges if they add up to more than the size of the
+// resource; net/http.ServeContent does the same.
+func Parse(header string, size int64) ([]Range, error) {
+	unit, spec, ok := strings.Cut(header, "=")
+	if !ok || !strings.EqualFold(strings.TrimSpace(unit), "bytes") {
+		return nil, nil
+	}
+	var ranges []Range
+	var total int64
+	var sawRange bool
+	for _, part := range strings.Split(spec, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue // The list syntax allows empty elements.
+		}
+		sawRange = true
+		first, last, ok := strings.Cut(part, "-")
+		if !ok {
+			return nil, nil
+		}
+		first, last = strings.TrimSpace(first), strings.TrimSpace(last)
+		var r Range
+		if first == "" {
+			// A suffix range: the last n bytes.
+			n, err := strconv.ParseInt(last, 10, 64)
+			if err != nil || n < 0 {
+				return nil, nil
+			}
+			if n == 0 || size == 0 {
+				continue
+			}
+			n = min(n, size)
+			r = Range{Start: size - n, Length: n}
+		} else {
+			start, err := strconv.ParseInt(first, 10, 64)
+			if err != nil || start < 0 {
+				return nil, nil
+			}
+			end := size - 1
+			if last != "" {
+				e, err := strconv.ParseInt(last, 10, 64)
+				if err != nil || e < start {
+					return nil, nil
+				}
+				end = min(e, end)
+			}
+			if start >= size {
+				continue
+			}
+			r = Range{Start: start, Length: end - start + 1}
+		}
+		ranges = append(ranges, r)
+		total += r.Length
+	}
+	if !sawRange {
+		return nil, nil
+	}
+	if len(ranges) == 0 {
+		return nil, ErrUnsatisfiable
+	}
+	if total > size {
+		return nil, nil
+	}
+	return ranges, nil
+}
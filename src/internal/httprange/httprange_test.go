@@ -0,0 +1,49 @@
+package httprange
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParse(t *testing.T) {
+	testData := []struct {
+		name    string
+		header  string
+		size    int64
+		want    []Range
+		wantErr error
+	}{
+		{name: "no header", header: "", size: 100},
+		{name: "single", header: "bytes=0-9", size: 100, want: []Range{{0, 10}}},
+		{name: "open ended", header: "bytes=90-", size: 100, want: []Range{{90, 10}}},
+		{name: "suffix", header: "bytes=-5", size: 100, want: []Range{{95, 5}}},
+		{name: "suffix longer than file", header: "bytes=-500", size: 100, want: []Range{{0, 100}}},
+		{name: "end past the end of the file", header: "bytes=50-500", size: 100, want: []Range{{50, 50}}},
+		{name: "multiple", header: "bytes=0-0, 10-19,-1", size: 100, want: []Range{{0, 1}, {10, 10}, {99, 1}}},
+		{name: "empty list elements", header: "bytes=,0-0,,", size: 100, want: []Range{{0, 1}}},
+		{name: "unit is case insensitive", header: "Bytes=0-0", size: 100, want: []Range{{0, 1}}},
+		{name: "unsatisfiable ranges are dropped", header: "bytes=0-0,200-300", size: 100, want: []Range{{0, 1}}},
+		{name: "unsatisfiable", header: "bytes=100-", size: 100, wantErr: ErrUnsatisfiable},
+		{name: "zero length suffix", header: "bytes=-0", size: 100, wantErr: ErrUnsatisfiable},
+		{name: "empty file", header: "bytes=0-", size: 0, wantErr: ErrUnsatisfiable},
+		{name: "other unit", header: "lines=0-9", size: 100},
+		{name: "backwards", header: "bytes=9-0", size: 100},
+		{name: "not a number", header: "bytes=a-9", size: 100},
+		{name: "no dash", header: "bytes=9", size: 100},
+		{name: "no ranges", header: "bytes=", size: 100},
+		{name: "more than the whole file", header: "bytes=0-99,0-99", size: 100},
+	}
+	for _, test := range testData {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := Parse(test.header, test.size)
+			if !errors.Is(err, test.wantErr) {
+				t.Fatalf("Parse(%q, %d) error:\n  got: %v\n want: %v", test.header, test.size, err, test.wantErr)
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("Parse(%q, %d) (-want +got):\n%s", test.header, test.size, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,309 @@
+// Package audit records mutating API calls in the audit log.
+package audit
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/internal/auditdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/log"
+	mauth "github.com/pachyderm/pachyderm/v2/src/internal/middleware/auth"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+)
+
+// auditedMethods is the set of RPCs that are recorded in the audit log: every PFS, PPS, Auth,
+// Transaction and Webhook RPC that changes the state of the cluster.
+var auditedMethods = map[string]bool{
+	//
+	// Auth API
+	//
+
+	"/auth_v2.API/Activate":                true,
+	"/auth_v2.API/Deactivate":              true,
+	"/auth_v2.API/SetConfiguration":        true,
+	"/auth_v2.API/ModifyRoleBinding":       true,
+	"/auth_v2.API/GetRobotToken":           true,
+	"/auth_v2.API/RevokeAuthToken":         true,
+	"/auth_v2.API/RevokeAuthTokensForUser": true,
+	"/auth_v2.API/SetGroupsForUser":        true,
+	"/auth_v2.API/ModifyMembers":           true,
+	"/auth_v2.API/RestoreAuthToken":        true,
+	"/auth_v2.API/DeleteExpiredAuthTokens": true,
+	"/auth_v2.API/RotateRootToken":         true,
+	"/auth_v2.API/CreateRole":              true,
+	"/auth_v2.API/DeleteRole":              true,
+
+	//
+	// PFS API
+	//
+
+	"/pfs_v2.API/ActivateAuth":         true,
+	"/pfs_v2.API/CreateRepo":           true,
+	"/pfs_v2.API/DeleteRepo":           true,
+	"/pfs_v2.API/DeleteRepos":          true,
+	"/pfs_v2.API/StartCommit":          true,
+	"/pfs_v2.API/FinishCommit":         true,
+	"/pfs_v2.API/ClearCommit":          true,
+	"/pfs_v2.API/SquashCommit":         true,
+	"/pfs_v2.API/DropCommit":           true,
+	"/pfs_v2.API/SquashCommitSet":      true,
+	"/pfs_v2.API/DropCommitSet":        true,
+	"/pfs_v2.API/CreateBranch":         true,
+	"/pfs_v2.API/DeleteBranch":         true,
+	"/pfs_v2.API/CreateTag":            true,
+	"/pfs_v2.API/DeleteTag":            true,
+	"/pfs_v2.API/ModifyFile":           true,
+	"/pfs_v2.API/DeleteAll":            true,
+	"/pfs_v2.API/CreateProject":        true,
+	"/pfs_v2.API/DeleteProject":        true,
+	"/pfs_v2.API/EditMetadata":         true,
+	"/pfs_v2.API/SetRetentionPolicy":   true,
+	"/pfs_v2.API/ApplyRetentionPolicy": true,
+	"/pfs_v2.API/PurgePath":            true,
+
+	//
+	// PPS API
+	//
+
+	"/pps_v2.API/ActivateAuth":       true,
+	"/pps_v2.API/DeleteJob":          true,
+	"/pps_v2.API/StopJob":            true,
+	"/pps_v2.API/UpdateJobState":     true,
+	"/pps_v2.API/RestartDatum":       true,
+	"/pps_v2.API/CreatePipeline":     true,
+	"/pps_v2.API/CreatePipelineV2":   true,
+	"/pps_v2.API/RerunPipeline":      true,
+	"/pps_v2.API/DeletePipeline":     true,
+	"/pps_v2.API/DeletePipelines":    true,
+	"/pps_v2.API/StartPipeline":      true,
+	"/pps_v2.API/StopPipeline":       true,
+	"/pps_v2.API/RunPipeline":        true,
+	"/pps_v2.API/RunCron":            true,
+	"/pps_v2.API/CreateSecret":       true,
+	"/pps_v2.API/DeleteSecret":       true,
+	"/pps_v2.API/DeleteAll":          true,
+	"/pps_v2.API/SetClusterDefaults": true,
+	"/pps_v2.API/SetProjectDefaults": true,
+
+	//
+	// Transaction API
+	//
+
+	"/transaction_v2.API/BatchTransaction":  true,
+	"/transaction_v2.API/StartTransaction":  true,
+	"/transaction_v2.API/DeleteTransaction": true,
+	"/transaction_v2.API/FinishTransaction": true,
+	"/transaction_v2.API/DeleteAll":         true,
+
+	//
+	// Webhook API
+	//
+
+	"/webhook_v2.API/CreateWebhook": true,
+	"/webhook_v2.API/DeleteWebhook": true,
+}
+
+// IsAudited returns true if calls to the given method are recorded in the audit log.
+func IsAudited(fullMethod string) bool {
+	return auditedMethods[fullMethod]
+}
+
+// Interceptor records every call to an audited method in the audit log, along with the
+// principal that made it, the resource it acted on and its result.  It must run before the auth
+// interceptor, so that calls which the auth interceptor denies are recorded too; the auth
+// interceptor reports the principal it resolves back to it.  A call whose event can't be recorded
+// fails, even if the method itself succeeded.
+type Interceptor struct {
+	db *pachsql.DB
+}
+
+// NewInterceptor returns an Interceptor that writes events to db.
+func NewInterceptor(db *pachsql.DB) *Interceptor {
+	return &Interceptor{db: db}
+}
+
+// InterceptUnary records audited unary RPCs.
+func (i *Interceptor) InterceptUnary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if !IsAudited(info.FullMethod) {
+		return handler(ctx, req)
+	}
+	var resource string
+	if msg, ok := req.(proto.Message); ok {
+		resource = Resource(msg)
+	}
+	start := time.Now()
+	ctx, principal := mauth.ReportWhoAmI(ctx)
+	res, err := handler(ctx, req)
+	if recErr := i.record(ctx, start, principal(), info.FullMethod, resource, err); recErr != nil {
+		return nil, recErr
+	}
+	return res, err
+}
+
+// InterceptStream records audited streaming RPCs.  The resource is taken from the first message
+// that the client sends.
+func (i *Interceptor) InterceptStream(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if !IsAudited(info.FullMethod) {
+		return handler(srv, stream)
+	}
+	start := time.Now()
+	ctx, principal := mauth.ReportWhoAmI(stream.Context())
+	s := &recordingStream{ServerStream: stream, ctx: ctx}
+	err := handler(srv, s)
+	if recErr := i.record(ctx, start, principal(), info.FullMethod, s.resource, err); recErr != nil {
+		return recErr
+	}
+	return err
+}
+
+func (i *Interceptor) record(ctx context.Context, start time.Time, principal, fullMethod, resource string, err error) error {
+	if principal == "" {
+		// Internal calls are made with their principal already in ctx.
+		principal = mauth.GetWhoAmI(ctx)
+	}
+	e := &auditdb.Event{
+		Time:      start,
+		Principal: principal,
+		Method:    fullMethod,
+		Resource:  resource,
+		Code:      status.Code(err).String(),
+	}
+	if p, ok := peer.FromContext(ctx); ok {
+		e.Peer = p.Addr.String()
+	}
+	if err != nil {
+		e.Error = err.Error()
+	}
+	// The event is recorded even if the client has gone away.
+	if _, err := auditdb.InsertEvent(context.WithoutCancel(ctx), i.db, e); err != nil {
+		log.Error(ctx, "failed to record audit event", zap.String("fullMethod", fullMethod), zap.Error(err))
+		return status.Errorf(codes.Unavailable, "could not record %s in the audit log: %v", fullMethod, err)
+	}
+	return nil
+}
+
+// recordingStream remembers the resource named by the first message received from the client.
+type recordingStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	received bool
+	resource string
+}
+
+func (s *recordingStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *recordingStream) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil && !s.received {
+		s.received = true
+		if msg, ok := m.(proto.Message); ok {
+			s.resource = Resource(msg)
+		}
+	}
+	return err //nolint:wrapcheck
+}
+
+// Resource returns the name of the resource that a request acts on, e.g. "default/images@master"
+// for a request naming a branch.  If the request names several resources, the most specific one
+// is returned, so that CreateBranch is recorded against the branch rather than its head commit.
+// Resources are named the way pachctl names them, so that the name of a resource is a prefix of
+// the names of the resources beneath it.  If the request names no resource, Resource returns "".
+func Resource(req proto.Message) string {
+	var best string
+	bestRank := len(resourceRanks)
+	req.ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if fd.Kind() != protoreflect.MessageKind || fd.IsList() || fd.IsMap() {
+			return true
+		}
+		name, rank := resourceName(v.Message().Interface())
+		if name != "" && rank < bestRank {
+			best, bestRank = name, rank
+		}
+		return true
+	})
+	return best
+}
+
+// resourceRanks orders the resource types by preference, most specific first.  Branches and tags
+// come before commits because requests that name both, like StartCommit and CreateTag, act on
+// the branch or tag.
+var resourceRanks = []string{"file", "branch", "tag", "commit", "job", "pipeline", "repo", "project", "auth"}
+
+func rank(kind string) int {
+	for i, k := range resourceRanks {
+		if k == kind {
+			return i
+		}
+	}
+	return len(resourceRanks)
+}
+
+// resourceName returns the name and rank of m if it is a resource.
+func resourceName(m proto.Message) (string, int) {
+	switch m := m.(type) {
+	case *pfs.File:
+		if m.GetCommit() == nil {
+			return "", len(resourceRanks)
+		}
+		return commitName(m.GetCommit()) + ":" + m.GetPath(), rank("file")
+	case *pfs.Commit:
+		return commitName(m), rank("commit")
+	case *pfs.Branch:
+		return repoName(m.GetRepo()) + "@" + m.GetName(), rank("branch")
+	case *pfs.Tag:
+		return repoName(m.GetRepo()) + "@" + m.GetName(), rank("tag")
+	case *pps.Job:
+		return pipelineName(m.GetPipeline()) + "@" + m.GetId(), rank("job")
+	case *pps.Pipeline:
+		return pipelineName(m), rank("pipeline")
+	case *pfs.Repo:
+		return repoName(m), rank("repo")
+	case *pfs.Project:
+		return projectName(m), rank("project")
+	case *auth.Resource:
+		return m.GetName(), rank("auth")
+	}
+	return "", len(resourceRanks)
+}
+
+// projectName names a project, defaulting to the default project as the API servers do.
+func projectName(p *pfs.Project) string {
+	if p.GetName() == "" {
+		return pfs.DefaultProjectName
+	}
+	return p.GetName()
+}
+
+// repoName names a repo, defaulting to a user repo in the default project as the API servers do.
+func repoName(r *pfs.Repo) string {
+	name := projectName(r.GetProject()) + "/" + r.GetName()
+	if t := r.GetType(); t != "" && t != pfs.UserRepoType {
+		name += "." + t
+	}
+	return name
+}
+
+func pipelineName(p *pps.Pipeline) string {
+	return projectName(p.GetProject()) + "/" + p.GetName()
+}
+
+// commitName names a commit by ID if it has one, and by branch otherwise.
+func commitName(c *pfs.Commit) string {
+	if c.GetId() == "" && c.GetBranch() != nil {
+		return repoName(c.GetBranch().GetRepo()) + "@" + c.GetBranch().GetName()
+	}
+	return repoName(c.AccessRepo()) + "@" + c.GetId()
+}
@@ -0,0 +1,85 @@
+package audit
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+)
+
+func TestResource(t *testing.T) {
+	repo := &pfs.Repo{Name: "images", Type: pfs.UserRepoType, Project: &pfs.Project{Name: "default"}}
+	branch := repo.NewBranch("master")
+	testData := []struct {
+		name string
+		req  proto.Message
+		want string
+	}{
+		{
+			name: "project",
+			req:  &pfs.CreateProjectRequest{Project: &pfs.Project{Name: "myproject"}},
+			want: "myproject",
+		},
+		{
+			name: "repo in the implicit default project",
+			req:  &pfs.CreateRepoRequest{Repo: &pfs.Repo{Name: "images"}},
+			want: "default/images",
+		},
+		{
+			name: "spec repo",
+			req:  &pfs.DeleteRepoRequest{Repo: &pfs.Repo{Name: "edges", Type: pfs.SpecRepoType, Project: &pfs.Project{Name: "p"}}},
+			want: "p/edges.spec",
+		},
+		{
+			name: "branch rather than its head",
+			req:  &pfs.CreateBranchRequest{Branch: branch, Head: repo.NewCommit("staging", "abc")},
+			want: "default/images@master",
+		},
+		{
+			name: "commit by branch",
+			req:  &pfs.FinishCommitRequest{Commit: repo.NewCommit("master", "")},
+			want: "default/images@master",
+		},
+		{
+			name: "commit by id",
+			req:  &pfs.SquashCommitRequest{Commit: repo.NewCommit("", "abc")},
+			want: "default/images@abc",
+		},
+		{
+			name: "file",
+			req:  &pfs.GetFileRequest{File: repo.NewCommit("master", "abc").NewFile("/a/b")},
+			want: "default/images@abc:/a/b",
+		},
+		{
+			name: "job",
+			req:  &pps.StopJobRequest{Job: &pps.Job{Pipeline: &pps.Pipeline{Name: "edges"}, Id: "123"}},
+			want: "default/edges@123",
+		},
+		{
+			name: "pipeline",
+			req:  &pps.DeletePipelineRequest{Pipeline: &pps.Pipeline{Name: "edges", Project: &pfs.Project{Name: "p"}}},
+			want: "p/edges",
+		},
+		{
+			name: "auth resource",
+			req:  &auth.ModifyRoleBindingRequest{Resource: &auth.Resource{Type: auth.ResourceType_REPO, Name: "default/images"}},
+			want: "default/images",
+		},
+		{
+			name: "nothing",
+			req:  &emptypb.Empty{},
+			want: "",
+		},
+	}
+	for _, test := range testData {
+		t.Run(test.name, func(t *testing.T) {
+			if got := Resource(test.req); got != test.want {
+				t.Errorf("Resource(%v): got %q, want %q", test.req, got, test.want)
+			}
+		})
+	}
+}
@@ -47,6 +47,7 @@ func authenticated(ctx context.Context, authApi authiface.APIServer, fullMethod
 }
 
 // clusterPermissions permits an RPC if the user is authorized with the given permissions on the cluster
+// and caches the user's name in the request's context
 func clusterPermissions(permissions ...auth.Permission) authHandler {
 	return func(ctx context.Context, authApi authiface.APIServer, fullMethod string) (string, error) {
 		resp, err := authApi.Authorize(ctx, &auth.AuthorizeRequest{
@@ -58,7 +59,7 @@ func clusterPermissions(permissions ...auth.Permission) authHandler {
 		}
 
 		if resp.Authorized {
-			return "", nil
+			return resp.Principal, nil
 		}
 
 		return "", &auth.ErrNotAuthorized{
@@ -85,6 +86,23 @@ func setWhoAmI(ctx context.Context, username string) context.Context {
 	return context.WithValue(ctx, whoAmIResultKey, username)
 }
 
+type whoAmIReportKey struct{}
+
+// ReportWhoAmI returns a context in which the interceptor reports the username
+// it resolves, even if it then denies the call, and a function returning that
+// username.  It is for interceptors that run before the auth interceptor, and
+// so never see the context it passes on.
+func ReportWhoAmI(ctx context.Context) (context.Context, func() string) {
+	username := new(string)
+	return context.WithValue(ctx, whoAmIReportKey{}, username), func() string { return *username }
+}
+
+func reportWhoAmI(ctx context.Context, username string) {
+	if p, ok := ctx.Value(whoAmIReportKey{}).(*string); ok {
+		*p = username
+	}
+}
+
 // AsInternalUser should never be used during user requests, only internal background jobs.
 // It gives a context a cached whoami username of form internal:<name>. It also overwrites
 // any existing metadata. As a result, this context may not be able to make additional gRPCs.
@@ -27,6 +27,22 @@ var authHandlers = map[string]authHandler{
 	// Allow InspectCluster to succeed before a user logs in
 	"/admin_v2.API/InspectCluster": unauthenticated,
 
+	//
+	// Audit API
+	//
+
+	"/audit_v2.API/ListEvent": authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_GET_AUDIT_LOG)),
+
+	//
+	// Webhook API
+	//
+
+	"/webhook_v2.API/CreateWebhook":  authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_CREATE_WEBHOOK)),
+	"/webhook_v2.API/InspectWebhook": authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_LIST_WEBHOOKS)),
+	"/webhook_v2.API/ListWebhook":    authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_LIST_WEBHOOKS)),
+	"/webhook_v2.API/DeleteWebhook":  authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_DELETE_WEBHOOK)),
+	"/webhook_v2.API/ListDelivery":   authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_LIST_WEBHOOKS)),
+
 	//
 	// Auth API
 	//
@@ -47,6 +63,7 @@ var authHandlers = map[string]authHandler{
 	"/auth_v2.API/GetGroups":             authenticated,
 	"/auth_v2.API/GetPermissions":        authenticated,
 	"/auth_v2.API/GetRolesForPermission": authenticated,
+	"/auth_v2.API/ListRole":              authenticated,
 
 	"/auth_v2.API/GetGroupsForPrincipal":      clusterPermissions(auth.Permission_CLUSTER_AUTH_GET_GROUPS),
 	"/auth_v2.API/GetPermissionsForPrincipal": clusterPermissions(auth.Permission_CLUSTER_AUTH_GET_PERMISSIONS_FOR_PRINCIPAL),
@@ -62,6 +79,8 @@ var authHandlers = map[string]authHandler{
 	"/auth_v2.API/DeleteExpiredAuthTokens":    clusterPermissions(auth.Permission_CLUSTER_AUTH_DELETE_EXPIRED_TOKENS),
 	"/auth_v2.API/RevokeAuthTokensForUser":    clusterPermissions(auth.Permission_CLUSTER_AUTH_REVOKE_USER_TOKENS),
 	"/auth_v2.API/RotateRootToken":            clusterPermissions(auth.Permission_CLUSTER_AUTH_ROTATE_ROOT_TOKEN),
+	"/auth_v2.API/CreateRole":                 clusterPermissions(auth.Permission_CLUSTER_AUTH_CREATE_ROLE),
+	"/auth_v2.API/DeleteRole":                 clusterPermissions(auth.Permission_CLUSTER_AUTH_DELETE_ROLE),
 
 	//
 	// Debug API
@@ -131,35 +150,43 @@ var authHandlers = map[string]authHandler{
 	//
 
 	// TODO: Add methods to handle repo permissions
-	"/pfs_v2.API/ActivateAuth":     clusterPermissions(auth.Permission_CLUSTER_AUTH_ACTIVATE),
-	"/pfs_v2.API/CreateRepo":       authDisabledOr(authenticated),
-	"/pfs_v2.API/InspectRepo":      authDisabledOr(authenticated),
-	"/pfs_v2.API/ListRepo":         authDisabledOr(authenticated),
-	"/pfs_v2.API/DeleteRepo":       authDisabledOr(authenticated),
-	"/pfs_v2.API/DeleteRepos":      authDisabledOr(authenticated),
-	"/pfs_v2.API/StartCommit":      authDisabledOr(authenticated),
-	"/pfs_v2.API/FinishCommit":     authDisabledOr(authenticated),
-	"/pfs_v2.API/InspectCommit":    authDisabledOr(authenticated),
-	"/pfs_v2.API/ListCommit":       authDisabledOr(authenticated),
-	"/pfs_v2.API/SubscribeCommit":  authDisabledOr(authenticated),
-	"/pfs_v2.API/ClearCommit":      authDisabledOr(authenticated),
-	"/pfs_v2.API/SquashCommit":     authDisabledOr(clusterPermissions(auth.Permission_REPO_DELETE_COMMIT)),
-	"/pfs_v2.API/DropCommit":       authDisabledOr(clusterPermissions(auth.Permission_REPO_DELETE_COMMIT)),
-	"/pfs_v2.API/InspectCommitSet": authDisabledOr(authenticated),
-	"/pfs_v2.API/ListCommitSet":    authDisabledOr(authenticated),
-	"/pfs_v2.API/SquashCommitSet":  authDisabledOr(clusterPermissions(auth.Permission_REPO_DELETE_COMMIT)),
-	"/pfs_v2.API/DropCommitSet":    authDisabledOr(clusterPermissions(auth.Permission_REPO_DELETE_COMMIT)),
-	"/pfs_v2.API/CreateBranch":     authDisabledOr(authenticated),
-	"/pfs_v2.API/InspectBranch":    authDisabledOr(authenticated),
-	"/pfs_v2.API/ListBranch":       authDisabledOr(authenticated),
-	"/pfs_v2.API/DeleteBranch":     authDisabledOr(authenticated),
-	"/pfs_v2.API/FindCommits":      authDisabledOr(authenticated),
-	"/pfs_v2.API/CreateProject":    authDisabledOr(clusterPermissions(auth.Permission_PROJECT_CREATE)),
-	"/pfs_v2.API/InspectProject":   authDisabledOr(authenticated),
-	"/pfs_v2.API/ListProject":      authDisabledOr(authenticated),
-	"/pfs_v2.API/DeleteProject":    authDisabledOr(authenticated),
-	"/pfs_v2.API/ModifyFile":       authDisabledOr(authenticated),
-	"/pfs_v2.API/GetFile":          authDisabledOr(authenticated),
+	"/pfs_v2.API/ActivateAuth":         clusterPermissions(auth.Permission_CLUSTER_AUTH_ACTIVATE),
+	"/pfs_v2.API/CreateRepo":           authDisabledOr(authenticated),
+	"/pfs_v2.API/InspectRepo":          authDisabledOr(authenticated),
+	"/pfs_v2.API/ListRepo":             authDisabledOr(authenticated),
+	"/pfs_v2.API/DeleteRepo":           authDisabledOr(authenticated),
+	"/pfs_v2.API/DeleteRepos":          authDisabledOr(authenticated),
+	"/pfs_v2.API/StartCommit":          authDisabledOr(authenticated),
+	"/pfs_v2.API/FinishCommit":         authDisabledOr(authenticated),
+	"/pfs_v2.API/InspectCommit":        authDisabledOr(authenticated),
+	"/pfs_v2.API/ListCommit":           authDisabledOr(authenticated),
+	"/pfs_v2.API/SubscribeCommit":      authDisabledOr(authenticated),
+	"/pfs_v2.API/ClearCommit":          authDisabledOr(authenticated),
+	"/pfs_v2.API/SquashCommit":         authDisabledOr(clusterPermissions(auth.Permission_REPO_DELETE_COMMIT)),
+	"/pfs_v2.API/DropCommit":           authDisabledOr(clusterPermissions(auth.Permission_REPO_DELETE_COMMIT)),
+	"/pfs_v2.API/InspectCommitSet":     authDisabledOr(authenticated),
+	"/pfs_v2.API/ListCommitSet":        authDisabledOr(authenticated),
+	"/pfs_v2.API/SquashCommitSet":      authDisabledOr(clusterPermissions(auth.Permission_REPO_DELETE_COMMIT)),
+	"/pfs_v2.API/DropCommitSet":        authDisabledOr(clusterPermissions(auth.Permission_REPO_DELETE_COMMIT)),
+	"/pfs_v2.API/CreateBranch":         authDisabledOr(authenticated),
+	"/pfs_v2.API/InspectBranch":        authDisabledOr(authenticated),
+	"/pfs_v2.API/ListBranch":           authDisabledOr(authenticated),
+	"/pfs_v2.API/DeleteBranch":         authDisabledOr(authenticated),
+	"/pfs_v2.API/CreateTag":            authDisabledOr(authenticated),
+	"/pfs_v2.API/InspectTag":           authDisabledOr(authenticated),
+	"/pfs_v2.API/ListTag":              authDisabledOr(authenticated),
+	"/pfs_v2.API/DeleteTag":            authDisabledOr(authenticated),
+	"/pfs_v2.API/FindCommits":          authDisabledOr(authenticated),
+	"/pfs_v2.API/CreateProject":        authDisabledOr(clusterPermissions(auth.Permission_PROJECT_CREATE)),
+	"/pfs_v2.API/InspectProject":       authDisabledOr(authenticated),
+	"/pfs_v2.API/ListProject":          authDisabledOr(authenticated),
+	"/pfs_v2.API/DeleteProject":        authDisabledOr(authenticated),
+	"/pfs_v2.API/EditMetadata":         authDisabledOr(authenticated),
+	"/pfs_v2.API/SetRetentionPolicy":   authDisabledOr(authenticated),
+	"/pfs_v2.API/ApplyRetentionPolicy": authDisabledOr(authenticated),
+	"/pfs_v2.API/PurgePath":            authDisabledOr(clusterPermissions(auth.Permission_REPO_DELETE_COMMIT)),
+	"/pfs_v2.API/ModifyFile":           authDisabledOr(authenticated),
+	"/pfs_v2.API/GetFile":              authDisabledOr(authenticated),
 	// TODO: GetFileTAR is unauthenticated for performance reasons. Normal authentication
 	// will be applied internally when a commit is used. When a file set id is used, we lean
 	// on the capability based authentication of file sets.
@@ -178,6 +205,7 @@ var authHandlers = map[string]authHandler{
 	"/pfs_v2.API/ComposeFileSet": authDisabledOr(authenticated),
 	"/pfs_v2.API/ShardFileSet":   authDisabledOr(authenticated),
 	"/pfs_v2.API/CheckStorage":   authDisabledOr(authenticated),
+	"/pfs_v2.API/InspectStorage": authDisabledOr(clusterPermissions(auth.Permission_REPO_READ)),
 	"/pfs_v2.API/PutCache":       authDisabledOr(authenticated),
 	"/pfs_v2.API/GetCache":       authDisabledOr(authenticated),
 	"/pfs_v2.API/ClearCache":     authDisabledOr(authenticated),
@@ -235,6 +263,20 @@ var authHandlers = map[string]authHandler{
 	"/pps_v2.API/GetProjectDefaults": authDisabledOr(authenticated),
 	"/pps_v2.API/SetProjectDefaults": authDisabledOr(authenticated),
 
+	//
+	// PJS API
+	//
+
+	"/pjs.API/CreateJob":    authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_PJS_WRITE_JOB)),
+	"/pjs.API/CancelJob":    authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_PJS_WRITE_JOB)),
+	"/pjs.API/DeleteJob":    authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_PJS_WRITE_JOB)),
+	"/pjs.API/ListJob":      authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_PJS_READ_JOB)),
+	"/pjs.API/WalkJob":      authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_PJS_READ_JOB)),
+	"/pjs.API/InspectJob":   authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_PJS_READ_JOB)),
+	"/pjs.API/ProcessQueue": authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_PJS_PROCESS_QUEUE)),
+	"/pjs.API/ListQueue":    authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_PJS_READ_JOB)),
+	"/pjs.API/InspectQueue": authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_PJS_READ_JOB)),
+
 	//
 	// TransactionAPI
 	//
@@ -320,6 +362,7 @@ func (i *Interceptor) InterceptUnary(ctx context.Context, req interface{}, info
 	}
 
 	username, err := a(ctx, i.getAuthServer(), info.FullMethod)
+	reportWhoAmI(ctx, username)
 	if err != nil {
 		log.Info(ctx, "denied unary call", zap.String("fullMethod", info.FullMethod), zap.String("username", nameOrUnauthenticated(username)), zap.String("remoteAddress", peerNameOrUnknown(ctx)))
 		return nil, err
@@ -342,6 +385,7 @@ func (i *Interceptor) InterceptStream(srv interface{}, stream grpc.ServerStream,
 	}
 
 	username, err := a(ctx, i.getAuthServer(), info.FullMethod)
+	reportWhoAmI(ctx, username)
 	if err != nil {
 		log.Info(ctx, "denied streaming call", zap.String("fullMethod", info.FullMethod), zap.String("username", nameOrUnauthenticated(username)), zap.String("remoteAddress", peerNameOrUnknown(ctx)))
 		return err
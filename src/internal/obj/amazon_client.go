@@ -172,7 +172,11 @@ func (c *amazonClient) Put(ctx context.Context, name string, r io.Reader) (retEr
 	return errors.EnsureStack(err)
 }
 
-func (c *amazonClient) Walk(ctx context.Context, name string, fn func(name string) error) (retErr error) {
+func (c *amazonClient) Walk(ctx context.Context, name string, fn func(name string) error) error {
+	return c.WalkInfo(ctx, name, func(info ObjectInfo) error { return fn(info.Name) })
+}
+
+func (c *amazonClient) WalkInfo(ctx context.Context, name string, fn func(info ObjectInfo) error) (retErr error) {
 	defer func() { retErr = c.transformError(retErr, name) }()
 	var fnErr error
 	var prefix = &name
@@ -185,7 +189,7 @@ func (c *amazonClient) Walk(ctx context.Context, name string, fn func(name strin
 			for _, object := range listObjectsOutput.Contents {
 				key := *object.Key
 				if strings.HasPrefix(key, name) {
-					if err := fn(key); err != nil {
+					if err := fn(ObjectInfo{Name: key, ETag: aws.StringValue(object.ETag), Size: aws.Int64Value(object.Size)}); err != nil {
 						fnErr = err
 						return false
 					}
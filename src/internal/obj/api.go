@@ -5,6 +5,14 @@ import (
 	"io"
 )
 
+// ObjectInfo describes an object in object storage.  ETag changes whenever
+// the object's content does, but its format is up to the backend.
+type ObjectInfo struct {
+	Name string
+	ETag string
+	Size int64
+}
+
 // Client is an interface to object storage.
 type Client interface {
 	// Put writes the data from r to an object at name
@@ -26,6 +34,10 @@ type Client interface {
 	// Walk calls `fn` with the names of objects which can be found under `prefix`.
 	Walk(ctx context.Context, prefix string, fn func(name string) error) error
 
+	// WalkInfo is like Walk, but calls `fn` with the name, ETag and size of
+	// each object.
+	WalkInfo(ctx context.Context, prefix string, fn func(info ObjectInfo) error) error
+
 	// Exists checks if a given object already exists
 	Exists(ctx context.Context, name string) (bool, error)
 
@@ -0,0 +1,131 @@
+package obj
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pacherr"
+)
+
+// dirClient is a read-only Client for a plain directory on the local host.
+// Its objects are the regular files under the directory, named by their
+// slash-separated paths relative to it.  Unlike fsClient, it doesn't lay out
+// or migrate the directory, and it never writes to it.
+type dirClient struct {
+	rootDir string
+}
+
+// NewDirClient returns a read-only Client for the directory rootDir, which
+// must exist.
+func NewDirClient(rootDir string) (Client, error) {
+	c := &dirClient{rootDir: filepath.Clean(rootDir)}
+	fi, err := os.Stat(c.rootDir)
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	if !fi.IsDir() {
+		return nil, errors.Errorf("%s is not a directory", c.rootDir)
+	}
+	return c, nil
+}
+
+func (c *dirClient) Put(ctx context.Context, name string, r io.Reader) error {
+	return errors.Errorf("cannot put %s: directory %s is read-only", name, c.rootDir)
+}
+
+func (c *dirClient) Get(ctx context.Context, name string, w io.Writer) (retErr error) {
+	p, err := c.pathFor(name)
+	if err != nil {
+		return err
+	}
+	f, err := os.Open(p)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return pacherr.NewNotExist(c.BucketURL().String(), name)
+		}
+		return errors.EnsureStack(err)
+	}
+	defer errors.Close(&retErr, f, "close %s", name)
+	_, err = io.Copy(w, f)
+	return errors.EnsureStack(err)
+}
+
+func (c *dirClient) Delete(ctx context.Context, name string) error {
+	return errors.Errorf("cannot delete %s: directory %s is read-only", name, c.rootDir)
+}
+
+func (c *dirClient) Exists(ctx context.Context, name string) (bool, error) {
+	p, err := c.pathFor(name)
+	if err != nil {
+		return false, err
+	}
+	fi, err := os.Stat(p)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, errors.EnsureStack(err)
+	}
+	return fi.Mode().IsRegular(), nil
+}
+
+func (c *dirClient) Walk(ctx context.Context, prefix string, cb func(string) error) error {
+	return c.WalkInfo(ctx, prefix, func(info ObjectInfo) error { return cb(info.Name) })
+}
+
+// WalkInfo implements Client.  As with fsClient, the ETag of a file is derived
+// from its modification time and size.
+func (c *dirClient) WalkInfo(ctx context.Context, prefix string, cb func(ObjectInfo) error) error {
+	return errors.EnsureStack(filepath.WalkDir(c.rootDir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return errors.EnsureStack(err)
+		}
+		rel, err := filepath.Rel(c.rootDir, p)
+		if err != nil {
+			return errors.EnsureStack(err)
+		}
+		name := filepath.ToSlash(rel)
+		if d.IsDir() {
+			// Only descend into directories that can hold names with the prefix.
+			if name != "." && !strings.HasPrefix(name+"/", prefix) && !strings.HasPrefix(prefix, name+"/") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !d.Type().IsRegular() || !strings.HasPrefix(name, prefix) {
+			return nil
+		}
+		fi, err := d.Info()
+		if err != nil {
+			return errors.EnsureStack(err)
+		}
+		return cb(ObjectInfo{
+			Name: name,
+			ETag: fmt.Sprintf("%x-%x", fi.ModTime().UnixNano(), fi.Size()),
+			Size: fi.Size(),
+		})
+	}))
+}
+
+func (c *dirClient) BucketURL() ObjectStoreURL {
+	return ObjectStoreURL{
+		Scheme: "local",
+		Bucket: strings.ReplaceAll(filepath.ToSlash(c.rootDir), "/", "."),
+	}
+}
+
+// pathFor returns the path of the file named name, which must stay inside the
+// directory.
+func (c *dirClient) pathFor(name string) (string, error) {
+	p := filepath.FromSlash(name)
+	if !filepath.IsLocal(p) {
+		return "", errors.Errorf("object name %q is outside of directory %s", name, c.rootDir)
+	}
+	return filepath.Join(c.rootDir, p), nil
+}
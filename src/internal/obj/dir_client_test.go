@@ -0,0 +1,62 @@
+package obj
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/pacherr"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pctx"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+)
+
+func TestDirClient(t *testing.T) {
+	ctx := pctx.TestContext(t)
+	dir := t.TempDir()
+	files := map[string]string{
+		"top":        "1",
+		"sub/a":      "22",
+		"sub/deep/b": "333",
+		"subway":     "4444",
+	}
+	for name, data := range files {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(p), 0o755))
+		require.NoError(t, os.WriteFile(p, []byte(data), 0o644))
+	}
+	c, err := NewDirClient(dir)
+	require.NoError(t, err)
+
+	walk := func(prefix string) []string {
+		var names []string
+		require.NoError(t, c.WalkInfo(ctx, prefix, func(info ObjectInfo) error {
+			require.Equal(t, int64(len(files[info.Name])), info.Size)
+			names = append(names, info.Name)
+			return nil
+		}))
+		sort.Strings(names)
+		return names
+	}
+	require.Equal(t, []string{"sub/a", "sub/deep/b", "subway", "top"}, walk(""))
+	require.Equal(t, []string{"sub/a", "sub/deep/b"}, walk("sub/"))
+	require.Equal(t, []string{"sub/a", "sub/deep/b", "subway"}, walk("sub"))
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, c.Get(ctx, "sub/deep/b", buf))
+	require.Equal(t, "333", buf.String())
+	exists, err := c.Exists(ctx, "sub")
+	require.NoError(t, err)
+	require.False(t, exists)
+	require.True(t, pacherr.IsNotExist(c.Get(ctx, "missing", buf)))
+	require.YesError(t, c.Get(ctx, "../outside", buf))
+
+	// The directory is never written to.
+	require.YesError(t, c.Put(ctx, "new", strings.NewReader("x")))
+	require.YesError(t, c.Delete(ctx, "top"))
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(entries))
+}
@@ -409,6 +409,60 @@ func NewClientFromURLAndSecret(ctx context.Context, url *ObjectStoreURL, reverse
 	}
 }
 
+// NewBucketClient constructs a client for reading the objects of a bucket
+// input, with the credentials in secret, which has the keys of the storage
+// secret.  Unlike NewClientFromURLAndSecret, it never falls back to pachd's
+// own credentials, and a local URL names a plain directory on pachd's host,
+// which is only ever read.
+func NewBucketClient(ctx context.Context, url *ObjectStoreURL, secret map[string][]byte) (c Client, err error) {
+	get := func(key string) (string, error) {
+		v := strings.TrimSpace(string(secret[key]))
+		if v == "" {
+			return "", errors.Errorf("%s not found in the bucket's secret", key)
+		}
+		return v, nil
+	}
+	switch url.Scheme {
+	case "local":
+		c, err = NewDirClient("/" + strings.ReplaceAll(url.Bucket, ".", "/"))
+	case "s3":
+		var region string
+		var creds AmazonCreds
+		if region, err = get(AmazonRegionEnvVar); err != nil {
+			return nil, err
+		}
+		if creds.ID, err = get(AmazonIDEnvVar); err != nil {
+			return nil, err
+		}
+		if creds.Secret, err = get(AmazonSecretEnvVar); err != nil {
+			return nil, err
+		}
+		creds.Token = strings.TrimSpace(string(secret[AmazonTokenEnvVar]))
+		endpoint := strings.TrimSpace(string(secret[CustomEndpointEnvVar]))
+		c, err = NewAmazonClient(ctx, region, url.Bucket, &creds, "", endpoint)
+	case "gcs", "gs":
+		if _, err := get(GoogleCredEnvVar); err != nil {
+			return nil, err
+		}
+		c, err = NewGoogleClient(url.Bucket, []option.ClientOption{option.WithCredentialsJSON(secret[GoogleCredEnvVar])})
+	case "as", "wasb":
+		var id, key string
+		if id, err = get(MicrosoftIDEnvVar); err != nil {
+			return nil, err
+		}
+		if key, err = get(MicrosoftSecretEnvVar); err != nil {
+			return nil, err
+		}
+		c, err = NewMicrosoftClient(url.Bucket, id, key)
+	default:
+		return nil, errors.Errorf("unrecognized object store: %s", url.Scheme)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return TracingObjClient(url.Scheme, c), nil
+}
+
 // ObjectStoreURL represents a parsed URL to an object in an object store.
 type ObjectStoreURL struct {
 	// The object store, e.g. s3, gcs, as...
@@ -510,11 +564,11 @@ func NewClientFromEnv(ctx context.Context, storageRoot string) (c Client, err er
 }
 
 // NewClient creates an obj.Client using the given backend and storage root (for
-// local backends).
+// local backends).  The options only apply to local backends.
 // TODO: Not sure if we want to keep the storage root configuration for
 // non-local deployments. If so, we will need to connect it to the object path
 // prefix for chunks.
-func NewClient(ctx context.Context, storageBackend string, storageRoot string) (Client, error) {
+func NewClient(ctx context.Context, storageBackend string, storageRoot string, opts ...LocalClientOption) (Client, error) {
 	var c Client
 	var err error
 	switch storageBackend {
@@ -538,7 +592,7 @@ func NewClient(ctx context.Context, storageBackend string, storageRoot string) (
 	case Microsoft:
 		c, err = NewMicrosoftClientFromSecret("")
 	case Local:
-		c, err = NewLocalClient(storageRoot)
+		c, err = NewLocalClient(storageRoot, opts...)
 	}
 	switch {
 	case err != nil:
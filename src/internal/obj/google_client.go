@@ -69,7 +69,11 @@ func (c *googleClient) Put(ctx context.Context, name string, r io.Reader) (retEr
 	return errors.EnsureStack(wc.Close())
 }
 
-func (c *googleClient) Walk(ctx context.Context, name string, fn func(name string) error) (retErr error) {
+func (c *googleClient) Walk(ctx context.Context, name string, fn func(name string) error) error {
+	return c.WalkInfo(ctx, name, func(info ObjectInfo) error { return fn(info.Name) })
+}
+
+func (c *googleClient) WalkInfo(ctx context.Context, name string, fn func(info ObjectInfo) error) (retErr error) {
 	defer func() { retErr = c.transformError(retErr, name) }()
 	objectIter := c.bucket.Objects(ctx, &storage.Query{Prefix: name})
 	for {
@@ -80,7 +84,7 @@ func (c *googleClient) Walk(ctx context.Context, name string, fn func(name strin
 			}
 			return errors.EnsureStack(err)
 		}
-		if err := fn(objectAttrs.Name); err != nil {
+		if err := fn(ObjectInfo{Name: objectAttrs.Name, ETag: objectAttrs.Etag, Size: objectAttrs.Size}); err != nil {
 			return err
 		}
 	}
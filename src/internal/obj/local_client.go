@@ -3,11 +3,15 @@ package obj
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
+	"fmt"
 	"io"
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/log"
@@ -17,9 +21,68 @@ import (
 	"go.uber.org/zap"
 )
 
-// NewLocalClient returns a Client that stores data on the local file system
-func NewLocalClient(rootDir string) (Client, error) {
-	c, err := newFSClient(rootDir)
+// stagingTTL is how long a staging file may go unmodified before the client assumes that the
+// write it belongs to was interrupted by a crash.  Other processes may share the root directory,
+// so recent staging files are left alone.
+const stagingTTL = time.Hour
+
+// FsyncPolicy controls how the local client flushes objects to stable storage.
+type FsyncPolicy int
+
+const (
+	// FsyncNone never flushes.  After a crash, recently written objects may be missing or
+	// truncated.  This is only suitable for tests.
+	FsyncNone FsyncPolicy = iota
+	// FsyncFile flushes the content of each object before it is renamed into place, so an object
+	// is never visible with partial content.
+	FsyncFile
+	// FsyncFull also flushes the directories that objects are renamed into and removed from, so a
+	// Put or Delete that has returned survives a crash.
+	FsyncFull
+)
+
+// ParseFsyncPolicy parses "none", "file" or "full".  The empty string is treated as "full".
+func ParseFsyncPolicy(s string) (FsyncPolicy, error) {
+	switch strings.ToLower(s) {
+	case "none":
+		return FsyncNone, nil
+	case "file":
+		return FsyncFile, nil
+	case "full", "":
+		return FsyncFull, nil
+	}
+	return FsyncNone, errors.Errorf("unknown fsync policy %q; expected none, file or full", s)
+}
+
+func (p FsyncPolicy) String() string {
+	switch p {
+	case FsyncNone:
+		return "none"
+	case FsyncFile:
+		return "file"
+	case FsyncFull:
+		return "full"
+	}
+	return "unknown"
+}
+
+// LocalClientOption configures a local client.
+type LocalClientOption func(*fsClient)
+
+// WithFsyncPolicy sets the fsync policy of a local client.  The default is FsyncNone.
+func WithFsyncPolicy(p FsyncPolicy) LocalClientOption {
+	return func(c *fsClient) {
+		c.fsync = p
+	}
+}
+
+// NewLocalClient returns a Client that stores data on the local file system.
+//
+// Objects are stored under rootDir/objects, in two levels of shard directories derived from a
+// hash of the object name, so no single directory grows too large.  Objects are written to
+// rootDir/staging and renamed into place, so readers never see a partially written object.
+func NewLocalClient(rootDir string, opts ...LocalClientOption) (Client, error) {
+	c, err := newFSClient(rootDir, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -31,15 +94,19 @@ func NewLocalClient(rootDir string) (Client, error) {
 
 type fsClient struct {
 	rootDir string
+	fsync   FsyncPolicy
 }
 
-func newFSClient(rootDir string) (Client, error) {
+func newFSClient(rootDir string, opts ...LocalClientOption) (Client, error) {
 	c := &fsClient{
 		rootDir: filepath.Clean(rootDir),
 	}
 	if c.rootDir == "" || c.rootDir == "/" || c.rootDir == "." {
 		panic("you probably didn't want to set the local client's root path to " + c.rootDir)
 	}
+	for _, opt := range opts {
+		opt(c)
+	}
 	if err := c.init(); err != nil {
 		return nil, err
 	}
@@ -59,10 +126,21 @@ func (c *fsClient) Put(ctx context.Context, name string, r io.Reader) (retErr er
 	if _, err := io.Copy(f, r); err != nil {
 		return errors.EnsureStack(err)
 	}
+	if c.fsync >= FsyncFile {
+		if err := f.Sync(); err != nil {
+			return errors.Wrap(err, "sync staging file")
+		}
+	}
 	if err := f.Close(); err != nil {
 		return errors.EnsureStack(err)
 	}
-	return errors.EnsureStack(os.Rename(staging, final))
+	if err := c.ensureShard(filepath.Dir(final)); err != nil {
+		return err
+	}
+	if err := os.Rename(staging, final); err != nil {
+		return errors.EnsureStack(err)
+	}
+	return c.syncDir(filepath.Dir(final))
 }
 
 func (c *fsClient) Get(ctx context.Context, name string, w io.Writer) (retErr error) {
@@ -79,11 +157,14 @@ func (c *fsClient) Get(ctx context.Context, name string, w io.Writer) (retErr er
 
 func (c *fsClient) Delete(ctx context.Context, name string) error {
 	log.Info(ctx, "delete", zap.String("key", name))
-	err := os.Remove(c.finalPathFor(name))
-	if os.IsNotExist(err) {
-		err = nil
+	final := c.finalPathFor(name)
+	if err := os.Remove(final); err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return errors.EnsureStack(err)
 	}
-	return err
+	return c.syncDir(filepath.Dir(final))
 }
 
 func (c *fsClient) Exists(ctx context.Context, name string) (bool, error) {
@@ -98,21 +179,64 @@ func (c *fsClient) Exists(ctx context.Context, name string) (bool, error) {
 }
 
 func (c *fsClient) Walk(ctx context.Context, prefix string, cb func(string) error) error {
-	dirEnts, err := os.ReadDir(filepath.Join(c.rootDir, "objects"))
+	return c.WalkInfo(ctx, prefix, func(info ObjectInfo) error { return cb(info.Name) })
+}
+
+// WalkInfo implements Client.  The ETag of a local object is derived from its
+// modification time and size, since objects are written by renaming a
+// complete file into place.
+func (c *fsClient) WalkInfo(ctx context.Context, prefix string, cb func(ObjectInfo) error) error {
+	// Object names are hashed to pick a shard, so every shard has to be read.
+	return c.walkShards(func(dir string) error {
+		dirEnts, err := os.ReadDir(dir)
+		if err != nil {
+			return errors.EnsureStack(err)
+		}
+		enc := base64.URLEncoding
+		for _, dirEnt := range dirEnts {
+			name, err := enc.DecodeString(dirEnt.Name())
+			if err != nil {
+				return errors.Wrapf(err, "parsing object name")
+			}
+			if !bytes.HasPrefix(name, []byte(prefix)) {
+				continue
+			}
+			fi, err := dirEnt.Info()
+			if err != nil {
+				return errors.EnsureStack(err)
+			}
+			if err := cb(ObjectInfo{
+				Name: string(name),
+				ETag: fmt.Sprintf("%x-%x", fi.ModTime().UnixNano(), fi.Size()),
+				Size: fi.Size(),
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// walkShards calls cb with each shard directory, in order.
+func (c *fsClient) walkShards(cb func(dir string) error) error {
+	objects := filepath.Join(c.rootDir, "objects")
+	outer, err := os.ReadDir(objects)
 	if err != nil {
 		return errors.EnsureStack(err)
 	}
-	enc := base64.URLEncoding
-	for _, dirEnt := range dirEnts {
-		// TODO: There is a better way to do this (encode the prefix rather than decode all and filter)
-		// but I'm not really sure how to do that with the filesystem API.
-		// We would need to seek to a certain dir entry.
-		name, err := enc.DecodeString(dirEnt.Name())
+	for _, o := range outer {
+		if !o.IsDir() {
+			continue
+		}
+		inner, err := os.ReadDir(filepath.Join(objects, o.Name()))
 		if err != nil {
-			return errors.Wrapf(err, "parsing object name")
+			return errors.EnsureStack(err)
 		}
-		if bytes.HasPrefix(name, []byte(prefix)) {
-			if err := cb(string(name)); err != nil {
+		for _, i := range inner {
+			if !i.IsDir() {
+				continue
+			}
+			if err := cb(filepath.Join(objects, o.Name(), i.Name())); err != nil {
 				return err
 			}
 		}
@@ -131,19 +255,131 @@ func (c *fsClient) stagingPathFor(name string) string {
 	return filepath.Join(c.rootDir, "staging", uuid.NewWithoutDashes())
 }
 
+// finalPathFor returns objects/<aa>/<bb>/<base64(name)>, where aabb are the first two bytes of the
+// SHA-256 of the name.
 func (c *fsClient) finalPathFor(name string) string {
+	sum := sha256.Sum256([]byte(name))
+	shard := hex.EncodeToString(sum[:2])
 	enc := base64.URLEncoding
-	return filepath.Join(c.rootDir, "objects", enc.EncodeToString([]byte(name)))
+	return filepath.Join(c.rootDir, "objects", shard[:2], shard[2:], enc.EncodeToString([]byte(name)))
+}
+
+// ensureShard creates a shard directory and its parent if they do not exist.
+func (c *fsClient) ensureShard(shard string) error {
+	for _, dir := range []string{filepath.Dir(shard), shard} {
+		if err := os.Mkdir(dir, 0o755); err != nil {
+			if os.IsExist(err) {
+				continue
+			}
+			return errors.EnsureStack(err)
+		}
+		if err := c.syncDir(filepath.Dir(dir)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// syncDir flushes the entries of dir if the fsync policy is FsyncFull.
+func (c *fsClient) syncDir(dir string) (retErr error) {
+	if c.fsync < FsyncFull {
+		return nil
+	}
+	f, err := os.Open(dir)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer c.closeFile(&retErr, f)
+	return errors.Wrapf(f.Sync(), "sync directory %v", dir)
 }
 
 func (c *fsClient) init() error {
-	if err := os.MkdirAll(filepath.Join(c.rootDir, "staging"), 0o755); err != nil {
+	for _, dir := range []string{"staging", "objects"} {
+		if err := os.MkdirAll(filepath.Join(c.rootDir, dir), 0o755); err != nil {
+			return errors.EnsureStack(err)
+		}
+	}
+	if err := c.syncDir(c.rootDir); err != nil {
+		return err
+	}
+	if err := c.cleanStaging(); err != nil {
+		return err
+	}
+	if err := c.migrateFlat(); err != nil {
+		return err
+	}
+	log.Info(pctx.TODO(), "successfully initialized fs-backed object store", zap.String("root", c.rootDir), zap.Stringer("fsync", c.fsync))
+	return nil
+}
+
+// cleanStaging removes staging files left behind by writes that were interrupted by a crash.
+func (c *fsClient) cleanStaging() error {
+	staging := filepath.Join(c.rootDir, "staging")
+	dirEnts, err := os.ReadDir(staging)
+	if err != nil {
 		return errors.EnsureStack(err)
 	}
-	if err := os.MkdirAll(filepath.Join(c.rootDir, "objects"), 0o755); err != nil {
+	for _, dirEnt := range dirEnts {
+		info, err := dirEnt.Info()
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return errors.EnsureStack(err)
+		}
+		if time.Since(info.ModTime()) < stagingTTL {
+			continue
+		}
+		var retErr error
+		c.removeFile(&retErr, filepath.Join(staging, dirEnt.Name()))
+		if retErr != nil {
+			return retErr
+		}
+	}
+	return nil
+}
+
+// migrateFlat moves objects written by earlier versions, which stored every object directly in
+// the objects directory, into their shards.  Renames are atomic, so an interrupted migration is
+// finished the next time the client starts.
+func (c *fsClient) migrateFlat() error {
+	objects := filepath.Join(c.rootDir, "objects")
+	dirEnts, err := os.ReadDir(objects)
+	if err != nil {
 		return errors.EnsureStack(err)
 	}
-	log.Info(pctx.TODO(), "successfully initialized fs-backed object store", zap.String("root", c.rootDir))
+	var migrated int
+	enc := base64.URLEncoding
+	for _, dirEnt := range dirEnts {
+		if dirEnt.IsDir() {
+			continue
+		}
+		name, err := enc.DecodeString(dirEnt.Name())
+		if err != nil {
+			return errors.Wrapf(err, "parsing object name")
+		}
+		final := c.finalPathFor(string(name))
+		if err := c.ensureShard(filepath.Dir(final)); err != nil {
+			return err
+		}
+		if err := os.Rename(filepath.Join(objects, dirEnt.Name()), final); err != nil {
+			if os.IsNotExist(err) {
+				// Another process migrated it first.
+				continue
+			}
+			return errors.EnsureStack(err)
+		}
+		if err := c.syncDir(filepath.Dir(final)); err != nil {
+			return err
+		}
+		migrated++
+	}
+	if migrated > 0 {
+		if err := c.syncDir(objects); err != nil {
+			return err
+		}
+		log.Info(pctx.TODO(), "migrated objects into shards", zap.String("root", c.rootDir), zap.Int("objects", migrated))
+	}
 	return nil
 }
 
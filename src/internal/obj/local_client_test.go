@@ -1,8 +1,14 @@
 package obj
 
 import (
+	"bytes"
+	"encoding/base64"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/pachyderm/pachyderm/v2/src/internal/pctx"
 	"github.com/pachyderm/pachyderm/v2/src/internal/require"
 )
 
@@ -11,6 +17,75 @@ func TestLocalClient(t *testing.T) {
 	TestSuite(t, newTestLocalClient)
 }
 
+func TestLocalClientFsyncFull(t *testing.T) {
+	t.Parallel()
+	TestSuite(t, func(t testing.TB) Client {
+		c, err := NewLocalClient(t.TempDir(), WithFsyncPolicy(FsyncFull))
+		require.NoError(t, err)
+		return c
+	})
+}
+
+func TestParseFsyncPolicy(t *testing.T) {
+	for _, p := range []FsyncPolicy{FsyncNone, FsyncFile, FsyncFull} {
+		got, err := ParseFsyncPolicy(p.String())
+		require.NoError(t, err)
+		require.Equal(t, p, got)
+	}
+	got, err := ParseFsyncPolicy("")
+	require.NoError(t, err)
+	require.Equal(t, FsyncFull, got)
+	_, err = ParseFsyncPolicy("sometimes")
+	require.YesError(t, err)
+}
+
+func TestLocalClientMigratesFlatLayout(t *testing.T) {
+	ctx := pctx.TestContext(t)
+	dir := t.TempDir()
+	// Lay out objects the way earlier versions did.
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "objects"), 0o755))
+	objects := map[string]string{"chunk/a": "aaa", "chunk/b": "bbb", "fileset/c": "ccc"}
+	for name, data := range objects {
+		p := filepath.Join(dir, "objects", base64.URLEncoding.EncodeToString([]byte(name)))
+		require.NoError(t, os.WriteFile(p, []byte(data), 0o644))
+	}
+	c, err := NewLocalClient(dir, WithFsyncPolicy(FsyncFull))
+	require.NoError(t, err)
+	for name, data := range objects {
+		buf := &bytes.Buffer{}
+		require.NoError(t, c.Get(ctx, name, buf))
+		require.Equal(t, data, buf.String())
+	}
+	var names []string
+	require.NoError(t, c.Walk(ctx, "chunk/", func(name string) error {
+		names = append(names, name)
+		return nil
+	}))
+	require.ElementsEqual(t, []string{"chunk/a", "chunk/b"}, names)
+	dirEnts, err := os.ReadDir(filepath.Join(dir, "objects"))
+	require.NoError(t, err)
+	for _, dirEnt := range dirEnts {
+		require.True(t, dirEnt.IsDir(), "%v should have been migrated into a shard", dirEnt.Name())
+	}
+}
+
+func TestLocalClientCleansStaging(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "staging"), 0o755))
+	stale := filepath.Join(dir, "staging", "stale")
+	fresh := filepath.Join(dir, "staging", "fresh")
+	require.NoError(t, os.WriteFile(stale, []byte("partial"), 0o644))
+	require.NoError(t, os.WriteFile(fresh, []byte("in progress"), 0o644))
+	old := time.Now().Add(-2 * stagingTTL)
+	require.NoError(t, os.Chtimes(stale, old, old))
+	_, err := NewLocalClient(dir)
+	require.NoError(t, err)
+	_, err = os.Stat(stale)
+	require.True(t, os.IsNotExist(err), "stale staging file should have been removed")
+	_, err = os.Stat(fresh)
+	require.NoError(t, err, "recent staging file should have been kept")
+}
+
 func newTestLocalClient(t testing.TB) Client {
 	dir := t.TempDir()
 	t.Log("testing local client in", dir)
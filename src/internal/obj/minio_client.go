@@ -55,8 +55,12 @@ func (c *minioClient) Put(ctx context.Context, name string, r io.Reader) (retErr
 	return errors.EnsureStack(err)
 }
 
+func (c *minioClient) Walk(ctx context.Context, name string, fn func(name string) error) error {
+	return c.WalkInfo(ctx, name, func(info ObjectInfo) error { return fn(info.Name) })
+}
+
 // TODO: this should respect the context
-func (c *minioClient) Walk(_ context.Context, name string, fn func(name string) error) (retErr error) {
+func (c *minioClient) WalkInfo(_ context.Context, name string, fn func(info ObjectInfo) error) (retErr error) {
 	defer func() { retErr = c.transformError(retErr, name) }()
 	recursive := true // Recursively walk by default.
 
@@ -66,7 +70,7 @@ func (c *minioClient) Walk(_ context.Context, name string, fn func(name string)
 		if objInfo.Err != nil {
 			return objInfo.Err
 		}
-		if err := fn(objInfo.Key); err != nil {
+		if err := fn(ObjectInfo{Name: objInfo.Key, ETag: objInfo.ETag, Size: objInfo.Size}); err != nil {
 			return err
 		}
 	}
@@ -65,6 +65,28 @@ func TestSuite(t *testing.T, newClient func(t testing.TB) Client) {
 		actualHash := pachhash.Sum(buf.Bytes())
 		require.Equal(t, expectedHash, actualHash)
 	})
+
+	t.Run("TestWalkInfo", func(t *testing.T) {
+		t.Parallel()
+		client := newClient(t)
+		prefix := randutil.UniqueString("test-walk-info-") + "/"
+		objects := map[string]string{prefix + "a": "foo", prefix + "b/c": "foo bar"}
+		for name, data := range objects {
+			require.NoError(t, client.Put(ctx, name, bytes.NewReader([]byte(data))))
+		}
+		infos := make(map[string]ObjectInfo)
+		require.NoError(t, client.WalkInfo(ctx, prefix, func(info ObjectInfo) error {
+			infos[info.Name] = info
+			return nil
+		}))
+		require.Equal(t, len(objects), len(infos))
+		for name, data := range objects {
+			info, ok := infos[name]
+			require.True(t, ok)
+			require.Equal(t, int64(len(data)), info.Size)
+			require.NotEqual(t, "", info.ETag)
+		}
+	})
 }
 
 func TestEmptyWrite(t *testing.T, client Client) {
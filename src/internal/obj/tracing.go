@@ -120,6 +120,17 @@ func (o *tracingObjClient) Walk(ctx context.Context, prefix string, fn func(name
 	return errors.EnsureStack(o.Client.Walk(ctx, prefix, fn))
 }
 
+// WalkInfo implements the corresponding method in the Client interface
+func (o *tracingObjClient) WalkInfo(ctx context.Context, prefix string, fn func(info ObjectInfo) error) (retErr error) {
+	objectOperationMetric.WithLabelValues(o.provider, "walk").Inc()
+	span, ctx := tracing.AddSpanToAnyExisting(ctx, "/"+o.provider+"/WalkInfo",
+		"prefix", prefix)
+	defer func() {
+		tracing.FinishAnySpan(span, "err", retErr)
+	}()
+	return errors.EnsureStack(o.Client.WalkInfo(ctx, prefix, fn))
+}
+
 // Exists implements the corresponding method in the Client interface
 func (o *tracingObjClient) Exists(ctx context.Context, name string) (retVal bool, retErr error) {
 	objectOperationMetric.WithLabelValues(o.provider, "exists").Inc()
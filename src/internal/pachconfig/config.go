@@ -144,12 +144,31 @@ type PachdSpecificConfiguration struct {
 	PachdPodName                 string `env:"PACHD_POD_NAME,required"`
 	EnableWorkerSecurityContexts bool   `env:"ENABLE_WORKER_SECURITY_CONTEXTS,default=true"`
 	TLSCertSecretName            string `env:"TLS_CERT_SECRET_NAME,default="`
+	// PPSInfraDriver is what runs pipeline workers: KubernetesInfraDriver runs
+	// them in pods, and LocalInfraDriver runs them as processes on pachd's host,
+	// each started from LocalWorkerBinary in its own directory under
+	// LocalWorkerRoot.
+	PPSInfraDriver    string `env:"PPS_INFRA_DRIVER,default=kubernetes"`
+	LocalWorkerBinary string `env:"LOCAL_WORKER_BINARY,default=worker"`
+	LocalWorkerRoot   string `env:"LOCAL_WORKER_ROOT,default=/tmp/pachyderm-workers"`
 
 	// Now that Pachyderm has HTTP endpoints, we need to be able to link users to the HTTP
 	// endpoint.  These two variables handle that; ProxyHost for the user-accessible location of
 	// the proxy, and ProxyTLS for whether or not to use https:// for generated URLs.
 	ProxyHost string `env:"PACHYDERM_PUBLIC_HOST,default="`
 	ProxyTLS  bool   `env:"PACHYDERM_PUBLIC_TLS,default=false"`
+	// If AuditRepo is set, the audit log is also exported, every AuditExportPeriod seconds, to
+	// the audit system repo of this repo.  It is named as "project/repo" or just "repo" in the
+	// default project.
+	AuditRepo         string `env:"AUDIT_REPO,default="`
+	AuditExportPeriod int64  `env:"AUDIT_EXPORT_PERIOD,default=60"`
+	// Webhook events are looked for every WebhookDeliveryPeriod seconds, and each attempt to
+	// deliver one may take WebhookTimeout seconds.  A delivery fails after WebhookMaxAttempts
+	// attempts, and finished deliveries are kept for WebhookHistory seconds.
+	WebhookDeliveryPeriod int64 `env:"WEBHOOK_DELIVERY_PERIOD,default=5"`
+	WebhookTimeout        int64 `env:"WEBHOOK_TIMEOUT,default=10"`
+	WebhookMaxAttempts    int64 `env:"WEBHOOK_MAX_ATTEMPTS,default=10"`
+	WebhookHistory        int64 `env:"WEBHOOK_HISTORY,default=604800"`
 	// Determined integration configuration
 	DeterminedUsername string `env:"DETERMINED_USERNAME,default="`
 	DeterminedPassword string `env:"DETERMINED_PASSWORD,default="`
@@ -202,6 +221,48 @@ type StorageConfiguration struct {
 	StorageFileSetsMaxOpen               int   `env:"STORAGE_FILESETS_MAX_OPEN,default=50"`
 	StorageDiskCacheSize                 int   `env:"STORAGE_DISK_CACHE_SIZE,default=100"`
 	StorageMemoryCacheSize               int   `env:"STORAGE_MEMORY_CACHE_SIZE,default=100"`
+	// StorageCompression is the algorithm used to compress new chunks: one
+	// of none, gzip, zstd or lz4.  Existing chunks record the algorithm they
+	// were compressed with, so it can be changed at any time, but chunks
+	// compressed differently don't deduplicate with each other.
+	StorageCompression string `env:"STORAGE_COMPRESSION,default=none"`
+	// StorageKeyDir is a directory of key encryption keys, which are used to
+	// wrap the data encryption keys of new chunks.  If it is empty, data
+	// encryption keys are stored unwrapped.
+	StorageKeyDir string `env:"STORAGE_KEY_DIR,default="`
+	// StorageKeySecretName is the Kubernetes secret mounted at StorageKeyDir,
+	// which is also mounted in pipeline sidecars.
+	StorageKeySecretName string `env:"STORAGE_KEY_SECRET_NAME,default="`
+	// StorageKeyRewrapPeriod is how often, in seconds, data encryption keys
+	// are re-wrapped with the primary key encryption key.
+	StorageKeyRewrapPeriod int64 `env:"STORAGE_KEY_REWRAP_PERIOD,default=3600"`
+	// StorageLocalFsync is how the local storage backend flushes objects to
+	// disk: one of none, file or full.  See obj.FsyncPolicy.
+	StorageLocalFsync string `env:"STORAGE_LOCAL_FSYNC,default=full"`
+	// StorageHotTierPath is a directory on fast local disk that holds copies
+	// of frequently read chunks.  If it is empty, there is no hot tier.  Only
+	// one pachd in the cluster may set it, as which chunks have copies is
+	// recorded for the whole cluster.
+	StorageHotTierPath string `env:"STORAGE_HOT_TIER_PATH,default="`
+	// StorageHotTierMaxBytes is the capacity of the hot tier, or 0 for no
+	// limit.
+	StorageHotTierMaxBytes int64 `env:"STORAGE_HOT_TIER_MAX_BYTES,default=0"`
+	// StorageHotTierRepos is a comma-separated list of repos, as
+	// project/repo, whose chunks are kept in the hot tier.
+	StorageHotTierRepos string `env:"STORAGE_HOT_TIER_REPOS,default="`
+	// StorageTieringPeriod is how often, in seconds, chunks are moved
+	// between tiers.
+	StorageTieringPeriod int64 `env:"STORAGE_TIERING_PERIOD,default=300"`
+	// StorageTierPromoteAccesses is the number of reads after which a chunk
+	// is copied to the hot tier.  Read counts are halved every period.
+	StorageTierPromoteAccesses int64 `env:"STORAGE_TIER_PROMOTE_ACCESSES,default=3"`
+	// StorageTierDemoteAfter is how long, in seconds, a chunk in the hot tier
+	// may go unread before its copy is dropped.
+	StorageTierDemoteAfter int64 `env:"STORAGE_TIER_DEMOTE_AFTER,default=86400"`
+	// StorageStatsPeriod is how often, in seconds, the storage statistics
+	// returned by InspectStorage are recomputed.  If it is 0, they are never
+	// computed.
+	StorageStatsPeriod int64 `env:"STORAGE_STATS_PERIOD,default=3600"`
 }
 
 // WorkerFullConfiguration contains the full worker configuration.
@@ -217,8 +278,19 @@ type WorkerSpecificConfiguration struct {
 	PPSWorkerIP string `env:"PPS_WORKER_IP,required"`
 	// The name of this pod
 	PodName string `env:"PPS_POD_NAME,required"`
+	// WorkerRoot is set for workers that pachd runs as local processes rather
+	// than in pods.  It replaces "/" as the directory that inputs and outputs
+	// are mounted under, and the worker listens only on PPSWorkerIP.
+	WorkerRoot string `env:"PPS_WORKER_ROOT,default="`
 }
 
+const (
+	// KubernetesInfraDriver runs pipeline workers in Kubernetes pods.
+	KubernetesInfraDriver = "kubernetes"
+	// LocalInfraDriver runs pipeline workers as processes on pachd's host.
+	LocalInfraDriver = "local"
+)
+
 // FeatureFlags contains the configuration for feature flags.  XXX: if you're
 // adding a new feature flag then you need to make sure it gets propagated to
 // the workers and their sidecars, this should be done in:
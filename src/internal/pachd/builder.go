@@ -11,8 +11,12 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/health"
 	"google.golang.org/grpc/health/grpc_health_v1"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	kubescheme "k8s.io/client-go/kubernetes/scheme"
 
 	"github.com/pachyderm/pachyderm/v2/src/admin"
+	"github.com/pachyderm/pachyderm/v2/src/audit"
 	"github.com/pachyderm/pachyderm/v2/src/auth"
 	debugclient "github.com/pachyderm/pachyderm/v2/src/debug"
 	"github.com/pachyderm/pachyderm/v2/src/identity"
@@ -23,6 +27,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/log"
 	"github.com/pachyderm/pachyderm/v2/src/internal/metrics"
+	auditmw "github.com/pachyderm/pachyderm/v2/src/internal/middleware/audit"
 	authmw "github.com/pachyderm/pachyderm/v2/src/internal/middleware/auth"
 	errorsmw "github.com/pachyderm/pachyderm/v2/src/internal/middleware/errors"
 	loggingmw "github.com/pachyderm/pachyderm/v2/src/internal/middleware/logging"
@@ -36,9 +41,11 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv"
 	licenseclient "github.com/pachyderm/pachyderm/v2/src/license"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/pjs"
 	"github.com/pachyderm/pachyderm/v2/src/pps"
 	"github.com/pachyderm/pachyderm/v2/src/proxy"
 	adminserver "github.com/pachyderm/pachyderm/v2/src/server/admin/server"
+	audit_server "github.com/pachyderm/pachyderm/v2/src/server/audit/server"
 	authserver "github.com/pachyderm/pachyderm/v2/src/server/auth/server"
 	debugserver "github.com/pachyderm/pachyderm/v2/src/server/debug/server"
 	eprsserver "github.com/pachyderm/pachyderm/v2/src/server/enterprise/server"
@@ -47,12 +54,15 @@ import (
 	licenseserver "github.com/pachyderm/pachyderm/v2/src/server/license/server"
 	pachw "github.com/pachyderm/pachyderm/v2/src/server/pachw/server"
 	pfs_server "github.com/pachyderm/pachyderm/v2/src/server/pfs/server"
+	pjs_server "github.com/pachyderm/pachyderm/v2/src/server/pjs/server"
 	pps_server "github.com/pachyderm/pachyderm/v2/src/server/pps/server"
 	proxyserver "github.com/pachyderm/pachyderm/v2/src/server/proxy/server"
 	transactionserver "github.com/pachyderm/pachyderm/v2/src/server/transaction/server"
+	webhook_server "github.com/pachyderm/pachyderm/v2/src/server/webhook/server"
 	"github.com/pachyderm/pachyderm/v2/src/transaction"
 	"github.com/pachyderm/pachyderm/v2/src/version"
 	"github.com/pachyderm/pachyderm/v2/src/version/versionpb"
+	"github.com/pachyderm/pachyderm/v2/src/webhook"
 )
 
 // An envBootstrapper is a type which needs to have some bootstrap code run
@@ -77,6 +87,7 @@ type builder struct {
 	enterpriseEnv      *eprsserver.Env
 	reporter           *metrics.Reporter
 	authInterceptor    *authmw.Interceptor
+	auditInterceptor   *auditmw.Interceptor
 	loggingInterceptor *loggingmw.LoggingInterceptor
 
 	txn    transactionserver.APIServer
@@ -118,11 +129,21 @@ func (b *builder) initJaeger(ctx context.Context) error {
 }
 
 func (b *builder) initKube(ctx context.Context) error {
-	b.env = serviceenv.InitWithKube(ctx, b.config)
+	if c := b.config.PachdSpecificConfiguration; c != nil && c.PPSInfraDriver == pachconfig.LocalInfraDriver {
+		// Without Kubernetes, the few things that pachd keeps there (such as
+		// pipeline secrets) are kept in memory instead.
+		env := serviceenv.InitServiceEnv(ctx, b.config)
+		env.SetKubeClient(kubefake.NewSimpleClientset())
+		env.SetDynamicKubeClient(dynamicfake.NewSimpleDynamicClient(kubescheme.Scheme))
+		b.env = env
+	} else {
+		b.env = serviceenv.InitWithKube(ctx, b.config)
+	}
 	if b.env.Config().EtcdPrefix == "" {
 		b.env.Config().EtcdPrefix = collection.DefaultPrefix
 	}
 	b.authInterceptor = authmw.NewInterceptor(b.env.AuthServer)
+	b.auditInterceptor = auditmw.NewInterceptor(b.env.GetDBClient())
 	b.loggingInterceptor = loggingmw.NewLoggingInterceptor(ctx)
 	if b.env.Config() != nil && b.env.Config().PachdSpecificConfiguration != nil {
 		b.daemon.criticalServersOnly = b.env.Config().RequireCriticalServersOnly
@@ -167,6 +188,7 @@ func (b *builder) initInternalServer(ctx context.Context) error {
 		grpc.ChainUnaryInterceptor(
 			errorsmw.UnaryServerInterceptor,
 			tracing.UnaryServerInterceptor(),
+			b.auditInterceptor.InterceptUnary,
 			b.authInterceptor.InterceptUnary,
 			b.loggingInterceptor.UnaryServerInterceptor,
 			validation.UnaryServerInterceptor,
@@ -174,6 +196,7 @@ func (b *builder) initInternalServer(ctx context.Context) error {
 		grpc.ChainStreamInterceptor(
 			errorsmw.StreamServerInterceptor,
 			tracing.StreamServerInterceptor(),
+			b.auditInterceptor.InterceptStream,
 			b.authInterceptor.InterceptStream,
 			b.loggingInterceptor.StreamServerInterceptor,
 			validation.StreamServerInterceptor,
@@ -191,6 +214,7 @@ func (b *builder) initExternalServer(ctx context.Context) error {
 			errorsmw.UnaryServerInterceptor,
 			version_middleware.UnaryServerInterceptor,
 			tracing.UnaryServerInterceptor(),
+			b.auditInterceptor.InterceptUnary,
 			b.authInterceptor.InterceptUnary,
 			b.loggingInterceptor.UnaryServerInterceptor,
 			validation.UnaryServerInterceptor,
@@ -199,6 +223,7 @@ func (b *builder) initExternalServer(ctx context.Context) error {
 			errorsmw.StreamServerInterceptor,
 			version_middleware.StreamServerInterceptor,
 			tracing.StreamServerInterceptor(),
+			b.auditInterceptor.InterceptStream,
 			b.authInterceptor.InterceptStream,
 			b.loggingInterceptor.StreamServerInterceptor,
 			validation.StreamServerInterceptor,
@@ -275,6 +300,28 @@ func (b *builder) registerPPSServer(ctx context.Context) error {
 	return nil
 }
 
+func (b *builder) registerPJSServer(ctx context.Context) error {
+	env, err := PJSEnv(b.env)
+	if err != nil {
+		return err
+	}
+	apiServer := pjs_server.NewAPIServer(*env)
+	b.forGRPCServer(func(s *grpc.Server) { pjs.RegisterAPIServer(s, apiServer) })
+	return nil
+}
+
+func (b *builder) registerAuditServer(ctx context.Context) error {
+	apiServer := audit_server.NewAPIServer(audit_server.Env{DB: b.env.GetDBClient()})
+	b.forGRPCServer(func(s *grpc.Server) { audit.RegisterAPIServer(s, apiServer) })
+	return nil
+}
+
+func (b *builder) registerWebhookServer(ctx context.Context) error {
+	apiServer := webhook_server.NewAPIServer(webhook_server.Env{DB: b.env.GetDBClient()})
+	b.forGRPCServer(func(s *grpc.Server) { webhook.RegisterAPIServer(s, apiServer) })
+	return nil
+}
+
 func (b *builder) registerTransactionServer(ctx context.Context) error {
 	var err error
 	b.txn, err = transactionserver.NewAPIServer(transactionserver.Env{
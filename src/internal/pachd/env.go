@@ -2,12 +2,16 @@ package pachd
 
 import (
 	"path"
+	"strings"
+	"time"
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/metrics"
 	"github.com/pachyderm/pachyderm/v2/src/internal/obj"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pctx"
 	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage"
 	txnenv "github.com/pachyderm/pachyderm/v2/src/internal/transactionenv"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
 	admin_server "github.com/pachyderm/pachyderm/v2/src/server/admin/server"
 	auth_server "github.com/pachyderm/pachyderm/v2/src/server/auth/server"
 	debug_server "github.com/pachyderm/pachyderm/v2/src/server/debug/server"
@@ -15,7 +19,9 @@ import (
 	license_server "github.com/pachyderm/pachyderm/v2/src/server/license/server"
 	pachw_server "github.com/pachyderm/pachyderm/v2/src/server/pachw/server"
 	pfs_server "github.com/pachyderm/pachyderm/v2/src/server/pfs/server"
+	pjs_server "github.com/pachyderm/pachyderm/v2/src/server/pjs/server"
 	pps_server "github.com/pachyderm/pachyderm/v2/src/server/pps/server"
+	webhook_server "github.com/pachyderm/pachyderm/v2/src/server/webhook/server"
 )
 
 func AdminEnv(senv serviceenv.ServiceEnv, paused bool) admin_server.Env {
@@ -90,9 +96,18 @@ func PachwEnv(env serviceenv.ServiceEnv) (*pachw_server.Env, error) {
 	}, nil
 }
 
+// newObjClient returns a client for the object storage configured for pachd.
+func newObjClient(env serviceenv.ServiceEnv) (obj.Client, error) {
+	fsync, err := obj.ParseFsyncPolicy(env.Config().StorageLocalFsync)
+	if err != nil {
+		return nil, err
+	}
+	return obj.NewClient(env.Context(), env.Config().StorageBackend, env.Config().StorageRoot, obj.WithFsyncPolicy(fsync))
+}
+
 func PFSEnv(env serviceenv.ServiceEnv, txnEnv *txnenv.TransactionEnv) (*pfs_server.Env, error) {
 	// Setup etcd, object storage, and database clients.
-	objClient, err := obj.NewClient(env.Context(), env.Config().StorageBackend, env.Config().StorageRoot)
+	objClient, err := newObjClient(env)
 	if err != nil {
 		return nil, err
 	}
@@ -100,6 +115,14 @@ func PFSEnv(env serviceenv.ServiceEnv, txnEnv *txnenv.TransactionEnv) (*pfs_serv
 	if env.AuthServer() == nil {
 		panic("auth server cannot be nil")
 	}
+	var auditRepo *pfs.Repo
+	if name := env.Config().AuditRepo; name != "" {
+		project, repo, ok := strings.Cut(name, "/")
+		if !ok {
+			project, repo = pfs.DefaultProjectName, name
+		}
+		auditRepo = &pfs.Repo{Project: &pfs.Project{Name: project}, Name: repo, Type: pfs.AuditRepoType}
+	}
 	return &pfs_server.Env{
 		ObjectClient: objClient,
 		DB:           env.GetDBClient(),
@@ -113,12 +136,21 @@ func PFSEnv(env serviceenv.ServiceEnv, txnEnv *txnenv.TransactionEnv) (*pfs_serv
 		GetPipelineInspector: func() pfs_server.PipelineInspector { return env.PpsServer() },
 
 		StorageConfig: env.Config().StorageConfiguration,
+
+		AuditRepo:         auditRepo,
+		AuditExportPeriod: time.Duration(env.Config().AuditExportPeriod) * time.Second,
+
+		Webhooks: webhook_server.DispatcherConfig{
+			Period:      time.Duration(env.Config().WebhookDeliveryPeriod) * time.Second,
+			Timeout:     time.Duration(env.Config().WebhookTimeout) * time.Second,
+			MaxAttempts: env.Config().WebhookMaxAttempts,
+			History:     time.Duration(env.Config().WebhookHistory) * time.Second,
+		},
 	}, nil
 }
 
 func PFSWorkerEnv(env serviceenv.ServiceEnv) (*pfs_server.WorkerEnv, error) {
-	ctx := env.Context()
-	objClient, err := obj.NewClient(ctx, env.Config().StorageBackend, env.Config().StorageRoot)
+	objClient, err := newObjClient(env)
 	if err != nil {
 		return nil, err
 	}
@@ -130,6 +162,21 @@ func PFSWorkerEnv(env serviceenv.ServiceEnv) (*pfs_server.WorkerEnv, error) {
 	}, nil
 }
 
+func PJSEnv(env serviceenv.ServiceEnv) (*pjs_server.Env, error) {
+	objClient, err := newObjClient(env)
+	if err != nil {
+		return nil, err
+	}
+	storageSrv, err := storage.New(storage.Env{DB: env.GetDBClient(), ObjectStore: objClient}, env.Config().StorageConfiguration)
+	if err != nil {
+		return nil, err
+	}
+	return &pjs_server.Env{
+		DB:      env.GetDBClient(),
+		Storage: storageSrv.Filesets,
+	}, nil
+}
+
 func PPSEnv(senv serviceenv.ServiceEnv, txnEnv *txnenv.TransactionEnv, reporter *metrics.Reporter) pps_server.Env {
 	etcdPrefix := path.Join(senv.Config().EtcdPrefix, senv.Config().PPSEtcdPrefix)
 	return pps_server.Env{
@@ -13,6 +13,7 @@ import (
 
 	"github.com/pachyderm/pachyderm/v2/src/auth"
 	"github.com/pachyderm/pachyderm/v2/src/enterprise"
+	audit_interceptor "github.com/pachyderm/pachyderm/v2/src/internal/middleware/audit"
 	auth_interceptor "github.com/pachyderm/pachyderm/v2/src/internal/middleware/auth"
 	"github.com/pachyderm/pachyderm/v2/src/internal/obj"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pachconfig"
@@ -105,6 +106,9 @@ func (fb *fullBuilder) buildAndRun(ctx context.Context) error {
 		fb.registerAuthServer,
 		fb.registerPFSServer,
 		fb.registerPPSServer,
+		fb.registerPJSServer,
+		fb.registerAuditServer,
+		fb.registerWebhookServer,
 		fb.registerTransactionServer,
 		fb.registerAdminServer,
 		fb.registerHealthServer,
@@ -137,6 +141,15 @@ func FullMode(ctx context.Context, config *pachconfig.PachdFullConfiguration) er
 	return newFullBuilder(config).buildAndRun(ctx)
 }
 
+// LocalMode runs a full-mode pachd without Kubernetes.
+//
+// Pipeline workers are run as processes on pachd's host rather than in pods;
+// see PachdSpecificConfiguration.PPSInfraDriver.
+func LocalMode(ctx context.Context, config *pachconfig.PachdFullConfiguration) error {
+	config.PPSInfraDriver = pachconfig.LocalInfraDriver
+	return FullMode(ctx, config)
+}
+
 type Env struct {
 	DB         *pachsql.DB
 	DirectDB   *pachsql.DB
@@ -150,9 +163,10 @@ type Full struct {
 	env    Env
 	config pachconfig.PachdFullConfiguration
 
-	selfGRPC        *grpc.ClientConn
-	authInterceptor *auth_interceptor.Interceptor
-	txnEnv          *transactionenv.TransactionEnv
+	selfGRPC         *grpc.ClientConn
+	authInterceptor  *auth_interceptor.Interceptor
+	auditInterceptor *audit_interceptor.Interceptor
+	txnEnv           *transactionenv.TransactionEnv
 
 	healthSrv grpc_health_v1.HealthServer
 	version   version.APIServer
@@ -179,6 +193,7 @@ func NewFull(env Env, config pachconfig.PachdFullConfiguration) *Full {
 	pd.authInterceptor = auth_interceptor.NewInterceptor(func() auth_iface.APIServer {
 		return pd.authSrv.(auth_iface.APIServer)
 	})
+	pd.auditInterceptor = audit_interceptor.NewInterceptor(env.DB)
 	pd.debugWorker = debug_server.NewWorker(debug_server.WorkerEnv{
 		PFS:         pfs.NewAPIClient(pd.selfGRPC),
 		TaskService: task.NewEtcdService(env.EtcdClient, "debug"),
@@ -277,7 +292,7 @@ func NewFull(env Env, config pachconfig.PachdFullConfiguration) *Full {
 	pd.addBackground("debugWorker", func(ctx context.Context) error {
 		return pd.debugWorker.Run(ctx)
 	})
-	pd.addBackground("grpc", newServeGRPC(pd.authInterceptor, env.Listener, func(gs grpc.ServiceRegistrar) {
+	pd.addBackground("grpc", newServeGRPC(pd.authInterceptor, pd.auditInterceptor, env.Listener, func(gs grpc.ServiceRegistrar) {
 		grpc_health_v1.RegisterHealthServer(gs, pd.healthSrv)
 		version.RegisterAPIServer(gs, pd.version)
 		auth.RegisterAPIServer(gs, pd.authSrv)
@@ -10,6 +10,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/log"
+	audit_interceptor "github.com/pachyderm/pachyderm/v2/src/internal/middleware/audit"
 	auth_interceptor "github.com/pachyderm/pachyderm/v2/src/internal/middleware/auth"
 	errorsmw "github.com/pachyderm/pachyderm/v2/src/internal/middleware/errors"
 	log_interceptor "github.com/pachyderm/pachyderm/v2/src/internal/middleware/logging"
@@ -199,7 +200,7 @@ func initAuthServer(out *auth.APIServer, env func() authserver.Env) setupStep {
 
 // newServeGRPC returns a background runner which servers gRPC on l.
 // reg is called to register functions with the server.
-func newServeGRPC(authInterceptor *auth_interceptor.Interceptor, l net.Listener, reg func(gs grpc.ServiceRegistrar)) func(ctx context.Context) error {
+func newServeGRPC(authInterceptor *auth_interceptor.Interceptor, auditInterceptor *audit_interceptor.Interceptor, l net.Listener, reg func(gs grpc.ServiceRegistrar)) func(ctx context.Context) error {
 	return func(ctx context.Context) error {
 		loggingInterceptor := log_interceptor.NewBaseContextInterceptor(ctx)
 		gs := grpc.NewServer(
@@ -207,6 +208,7 @@ func newServeGRPC(authInterceptor *auth_interceptor.Interceptor, l net.Listener,
 				errorsmw.UnaryServerInterceptor,
 				version_middleware.UnaryServerInterceptor,
 				tracing.UnaryServerInterceptor(),
+				auditInterceptor.InterceptUnary,
 				authInterceptor.InterceptUnary,
 				loggingInterceptor.UnaryServerInterceptor,
 				validation.UnaryServerInterceptor,
@@ -215,6 +217,7 @@ func newServeGRPC(authInterceptor *auth_interceptor.Interceptor, l net.Listener,
 				errorsmw.StreamServerInterceptor,
 				version_middleware.StreamServerInterceptor,
 				tracing.StreamServerInterceptor(),
+				auditInterceptor.InterceptStream,
 				authInterceptor.InterceptStream,
 				loggingInterceptor.StreamServerInterceptor,
 				validation.StreamServerInterceptor,
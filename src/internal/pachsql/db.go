@@ -5,18 +5,18 @@ import (
 	"net"
 	"strconv"
 	"strings"
-	"sync"
 
 	"github.com/go-sql-driver/mysql"
 	_ "github.com/jackc/pgx/v4/stdlib"
 	"github.com/jmoiron/sqlx"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
-	"go.uber.org/zap"
 )
 
 const (
-	ProtocolPostgres = "postgres"
-	ProtocolMySQL    = "mysql"
+	ProtocolPostgres  = "postgres"
+	ProtocolMySQL     = "mysql"
+	ProtocolSnowflake = "snowflake"
+	ProtocolSQLite    = "sqlite"
 )
 
 const (
@@ -29,8 +29,6 @@ const (
 	`
 )
 
-var fixMysqlLoggerOnce sync.Once
-
 // DB is an alias for sqlx.DB which is the standard database type used throughout the project
 type DB = sqlx.DB
 
@@ -53,38 +51,25 @@ type RowMap = map[string]interface{}
 // If password != "" then it will be used for authentication.
 // This function does not confirm that the database is reachable; callers may be interested in pachsql.DB.Ping()
 func OpenURL(u URL, password string) (*DB, error) {
-	var err error
-	var driver string
-	var dsn string
-	switch u.Protocol {
-	case ProtocolPostgres, "postgresql":
-		driver = "pgx"
-		dsn, err = postgresDSN(u, password)
-	case ProtocolMySQL:
-		driver = "mysql"
-		dsn, err = mySQLDSN(u, password)
-		fixMysqlLoggerOnce.Do(func() {
-			l := zap.NewStdLog(zap.L().Named("mysql"))
-			l.Println("enabled global mysql logger")
-			mysql.SetLogger(l) //nolint:errcheck
-		})
-	default:
-		return nil, errors.Errorf("database protocol %q not supported", u.Protocol)
+	d, err := DialectForProtocol(u.Protocol)
+	if err != nil {
+		return nil, err
 	}
+	dsn, err := d.DSN(u, password)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to generate DSN: %v", u)
 	}
-	res, err := sqlx.Open(driver, dsn)
+	res, err := sqlx.Open(d.Driver(), dsn)
 	return res, errors.EnsureStack(err)
 }
 
 // ListTables returns an array of SchemaTable structs that represent the tables.
 func ListTables(ctx context.Context, db *DB) ([]SchemaTable, error) {
-	var tables []SchemaTable
-	if err := sqlx.SelectContext(ctx, db, &tables, listTablesQuery); err != nil {
+	d, err := DialectForDriver(db.DriverName())
+	if err != nil {
 		return nil, errors.Wrap(err, "list tables")
 	}
-	return tables, nil
+	return d.ListTables(ctx, db)
 }
 
 func postgresDSN(u URL, password string) (string, error) {
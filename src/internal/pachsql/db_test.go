@@ -22,9 +22,19 @@ const (
 	tableName   = "tablename"
 )
 
+// mockDialect lets the sqlmock driver stand in for Postgres.
+type mockDialect struct{ postgresDialect }
+
+func (mockDialect) Driver() string { return mockDBName }
+
+func init() {
+	RegisterDialect(mockDialect{})
+}
+
 func TestListTables(t *testing.T) {
 	t.Run("ListTables() with a valid response", listTablesValidResponse)
 	t.Run("ListTables() with an unexpected response", listTablesUnexpectedResponse)
+	t.Run("ListTables() with an unknown driver", listTablesUnknownDriver)
 }
 
 func listTablesValidResponse(t *testing.T) {
@@ -59,3 +69,13 @@ func listTablesUnexpectedResponse(t *testing.T) {
 	require.YesError(t, err, "expected an error from ListTables()")
 	require.Matches(t, "^list tables:.*", err.Error(), "expected an error from ListTables()")
 }
+
+func listTablesUnknownDriver(t *testing.T) {
+	mockDB, _, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+	sqlxDB := sqlx.NewDb(mockDB, "oracle")
+	_, err = ListTables(context.Background(), sqlxDB)
+	require.YesError(t, err, "expected an error from ListTables()")
+	require.Matches(t, "^list tables:.*", err.Error(), "expected an error from ListTables()")
+}
@@ -0,0 +1,213 @@
+package pachsql
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/jmoiron/sqlx"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"go.uber.org/zap"
+)
+
+// A Dialect describes how to connect to and introspect a kind of SQL database.
+//
+// Dialects are registered with RegisterDialect under one or more URL protocols, and are
+// looked up by protocol when opening a URL and by database/sql driver name everywhere else.
+type Dialect interface {
+	// Driver is the name of the database/sql driver used to talk to the database.
+	Driver() string
+	// DSN returns the data source name passed to the driver to connect to u.
+	DSN(u URL, password string) (string, error)
+	// Placeholder returns a query placeholder, assuming i placeholders precede it.
+	Placeholder(i int) string
+	// DefaultSchema is the schema tables are assumed to be in when a table path
+	// does not name one.  If it is empty, the schema is inferred from the table name.
+	DefaultSchema() string
+	// ListTables returns the user tables in the database.
+	ListTables(ctx context.Context, db *DB) ([]SchemaTable, error)
+	// GetTableInfo looks up the columns of the table.
+	GetTableInfo(tx *Tx, schemaName, tableName string) (*TableInfo, error)
+}
+
+var dialects = struct {
+	mu         sync.RWMutex
+	byProtocol map[string]Dialect
+	byDriver   map[string]Dialect
+}{
+	byProtocol: make(map[string]Dialect),
+	byDriver:   make(map[string]Dialect),
+}
+
+func init() {
+	RegisterDialect(postgresDialect{}, ProtocolPostgres, "postgresql")
+	RegisterDialect(mysqlDialect{}, ProtocolMySQL)
+	RegisterDialect(snowflakeDialect{}, ProtocolSnowflake)
+	RegisterDialect(sqliteDialect{}, ProtocolSQLite)
+}
+
+// RegisterDialect makes d available to URLs with any of the given protocols.
+// It panics if a protocol or d's driver is already registered.
+func RegisterDialect(d Dialect, protocols ...string) {
+	dialects.mu.Lock()
+	defer dialects.mu.Unlock()
+	if _, ok := dialects.byDriver[d.Driver()]; ok {
+		panic(fmt.Sprintf("pachsql: dialect already registered for driver %q", d.Driver()))
+	}
+	for _, p := range protocols {
+		if _, ok := dialects.byProtocol[p]; ok {
+			panic(fmt.Sprintf("pachsql: dialect already registered for protocol %q", p))
+		}
+	}
+	dialects.byDriver[d.Driver()] = d
+	for _, p := range protocols {
+		dialects.byProtocol[p] = d
+	}
+}
+
+// Protocols returns the URL protocols which have a registered dialect, sorted.
+func Protocols() []string {
+	dialects.mu.RLock()
+	defer dialects.mu.RUnlock()
+	var ps []string
+	for p := range dialects.byProtocol {
+		ps = append(ps, p)
+	}
+	sort.Strings(ps)
+	return ps
+}
+
+// DialectForProtocol returns the dialect registered for the URL protocol.
+func DialectForProtocol(protocol string) (Dialect, error) {
+	dialects.mu.RLock()
+	defer dialects.mu.RUnlock()
+	d, ok := dialects.byProtocol[protocol]
+	if !ok {
+		return nil, errors.Errorf("database protocol %q not supported", protocol)
+	}
+	return d, nil
+}
+
+// DialectForDriver returns the dialect which uses the database/sql driver.
+func DialectForDriver(driver string) (Dialect, error) {
+	dialects.mu.RLock()
+	defer dialects.mu.RUnlock()
+	d, ok := dialects.byDriver[driver]
+	if !ok {
+		return nil, errors.Errorf("driver not supported: %s", driver)
+	}
+	return d, nil
+}
+
+type postgresDialect struct{}
+
+func (postgresDialect) Driver() string { return "pgx" }
+
+func (postgresDialect) DSN(u URL, password string) (string, error) { return postgresDSN(u, password) }
+
+func (postgresDialect) Placeholder(i int) string { return "$" + strconv.Itoa(i+1) }
+
+func (postgresDialect) DefaultSchema() string { return "public" }
+
+func (postgresDialect) ListTables(ctx context.Context, db *DB) ([]SchemaTable, error) {
+	return selectTables(ctx, db, listTablesQuery)
+}
+
+func (postgresDialect) GetTableInfo(tx *Tx, schemaName, tableName string) (*TableInfo, error) {
+	return informationSchemaTableInfo(tx, schemaName, tableName)
+}
+
+type mysqlDialect struct{}
+
+var fixMysqlLoggerOnce sync.Once
+
+func (mysqlDialect) Driver() string { return "mysql" }
+
+// DSN is called each time a MySQL connection pool is opened, so it is also
+// where the mysql driver's global logger is redirected to zap.
+func (mysqlDialect) DSN(u URL, password string) (string, error) {
+	fixMysqlLoggerOnce.Do(func() {
+		l := zap.NewStdLog(zap.L().Named("mysql"))
+		l.Println("enabled global mysql logger")
+		mysql.SetLogger(l) //nolint:errcheck
+	})
+	return mySQLDSN(u, password)
+}
+
+func (mysqlDialect) Placeholder(int) string { return "?" }
+
+func (mysqlDialect) DefaultSchema() string { return "" }
+
+func (mysqlDialect) ListTables(ctx context.Context, db *DB) ([]SchemaTable, error) {
+	return selectTables(ctx, db, `
+		SELECT table_schema AS schemaname, table_name AS tablename
+		FROM information_schema.tables
+		WHERE table_schema = DATABASE() AND table_type = 'BASE TABLE'
+		ORDER BY table_schema, table_name;
+	`)
+}
+
+func (mysqlDialect) GetTableInfo(tx *Tx, schemaName, tableName string) (*TableInfo, error) {
+	return informationSchemaTableInfo(tx, schemaName, tableName)
+}
+
+// snowflakeDialect targets Snowflake.  The Snowflake driver is not linked into
+// Pachyderm itself; binaries which need it must import a driver registered as "snowflake".
+type snowflakeDialect struct{}
+
+func (snowflakeDialect) Driver() string { return "snowflake" }
+
+// DSN returns a DSN of the form user:password@account/database/schema?params,
+// where the account is taken from the URL's host.
+func (snowflakeDialect) DSN(u URL, password string) (string, error) {
+	if u.Host == "" {
+		return "", errors.New("snowflake DSN must contain an account name as the host")
+	}
+	dsn := url.UserPassword(u.User, password).String() + "@" + u.Host
+	if u.Port != 0 {
+		dsn += ":" + strconv.Itoa(int(u.Port))
+	}
+	dsn += "/" + url.PathEscape(u.Database)
+	if u.Schema != "" {
+		dsn += "/" + url.PathEscape(u.Schema)
+	}
+	if len(u.Params) > 0 {
+		q := url.Values{}
+		for k, v := range u.Params {
+			q.Set(k, v)
+		}
+		dsn += "?" + q.Encode()
+	}
+	return dsn, nil
+}
+
+func (snowflakeDialect) Placeholder(int) string { return "?" }
+
+// DefaultSchema is empty, so a table path without a schema has its schema
+// inferred, and schema-qualified table paths are looked up as given.
+func (snowflakeDialect) DefaultSchema() string { return "" }
+
+func (snowflakeDialect) ListTables(ctx context.Context, db *DB) ([]SchemaTable, error) {
+	return selectTables(ctx, db, `
+		SELECT table_schema AS "schemaname", table_name AS "tablename"
+		FROM information_schema.tables
+		WHERE table_schema != 'INFORMATION_SCHEMA' AND table_type = 'BASE TABLE'
+		ORDER BY table_schema, table_name;
+	`)
+}
+
+func (snowflakeDialect) GetTableInfo(tx *Tx, schemaName, tableName string) (*TableInfo, error) {
+	return informationSchemaTableInfo(tx, schemaName, tableName)
+}
+
+func selectTables(ctx context.Context, db *DB, query string) ([]SchemaTable, error) {
+	var tables []SchemaTable
+	if err := sqlx.SelectContext(ctx, db, &tables, query); err != nil {
+		return nil, errors.Wrap(err, "list tables")
+	}
+	return tables, nil
+}
@@ -0,0 +1,100 @@
+package pachsql
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+)
+
+func TestDialectDSN(t *testing.T) {
+	testCases := []struct {
+		In  string
+		Out string
+	}{
+		{
+			In:  "sqlite:///var/data/my.db",
+			Out: "file:/var/data/my.db",
+		},
+		{
+			In:  "sqlite:///:memory:",
+			Out: "file::memory:",
+		},
+		{
+			In:  "sqlite:///tmp/my.db?_pragma=foreign_keys(1)",
+			Out: "file:/tmp/my.db?_pragma=foreign_keys%281%29",
+		},
+		{
+			In:  "snowflake://jbond@myaccount/martini/public?warehouse=shaken",
+			Out: "jbond:secret@myaccount/martini/public?warehouse=shaken",
+		},
+	}
+	for _, tc := range testCases {
+		u, err := ParseURL(tc.In)
+		require.NoError(t, err)
+		d, err := DialectForProtocol(u.Protocol)
+		require.NoError(t, err)
+		dsn, err := d.DSN(*u, "secret")
+		require.NoError(t, err)
+		require.Equal(t, tc.Out, dsn)
+	}
+}
+
+func TestDialectRegistry(t *testing.T) {
+	require.Equal(t, []string{"mysql", "postgres", "postgresql", "snowflake", "sqlite"}, Protocols())
+	_, err := DialectForProtocol("oracle")
+	require.YesError(t, err)
+	for driver, placeholder := range map[string]string{"pgx": "$3", "mysql": "?", "snowflake": "?", "sqlite": "?"} {
+		require.Equal(t, placeholder, Placeholder(driver, 2))
+	}
+	schema, table := SplitTableSchema("sqlite", "test_table")
+	require.Equal(t, "main", schema)
+	require.Equal(t, "test_table", table)
+	require.YesPanic(t, func() { RegisterDialect(sqliteDialect{}, "sqlite3") })
+	_, err = DialectForDriver("oracle")
+	require.YesError(t, err)
+}
+
+func TestSnowflakeTableInfo(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+	db := sqlx.NewDb(mockDB, "snowflake")
+	mock.ExpectBegin()
+	mock.ExpectQuery(`FROM information_schema.columns\s+WHERE upper\(table_name\) = upper\('TEST_TABLE'\) AND upper\(table_schema\) = upper\('PUBLIC'\)`).
+		WillReturnRows(sqlmock.NewRows([]string{"column_name", "data_type", "is_nullable", "numeric_precision", "numeric_scale"}).
+			AddRow("ID", "NUMBER", false, 38, 0).
+			AddRow("DATA", "VARIANT", true, nil, nil))
+	mock.ExpectRollback()
+	tx, err := db.Beginx()
+	require.NoError(t, err)
+	info, err := GetTableInfoTx(tx, "PUBLIC.TEST_TABLE")
+	require.NoError(t, err)
+	require.NoError(t, tx.Rollback())
+	require.Equal(t, &TableInfo{
+		Driver:  "snowflake",
+		Name:    "TEST_TABLE",
+		Schema:  "PUBLIC",
+		Columns: []ColumnInfo{{Name: "ID", DataType: "NUMBER"}, {Name: "DATA", DataType: "VARIANT", IsNullable: true}},
+	}, info)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSQLiteTableInfoQuotedName(t *testing.T) {
+	u, err := ParseURL("sqlite://" + filepath.Join(t.TempDir(), "test.db"))
+	require.NoError(t, err)
+	db, err := OpenURL(*u, "")
+	require.NoError(t, err)
+	defer db.Close()
+	_, err = db.Exec(`CREATE TABLE "it's" (id INTEGER NOT NULL, name VARCHAR(10))`)
+	require.NoError(t, err)
+	info, err := GetTableInfo(context.Background(), db, "it's")
+	require.NoError(t, err)
+	require.Equal(t, []ColumnInfo{{Name: "id", DataType: "INTEGER"}, {Name: "name", DataType: "VARCHAR", IsNullable: true}}, info.Columns)
+	_, err = GetTableInfo(context.Background(), db, "x') UNION SELECT 1, 2, 3 --")
+	require.YesError(t, err)
+}
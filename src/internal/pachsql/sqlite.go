@@ -0,0 +1,94 @@
+package pachsql
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	_ "modernc.org/sqlite"
+)
+
+// sqliteMemory is the database name which opens a private, in-memory SQLite database.
+const sqliteMemory = ":memory:"
+
+// sqliteDialect targets SQLite database files, using a pure Go driver so that
+// it works in CGO-less builds.
+//
+// URLs have no host, and their path is the path to the database file, e.g.
+// sqlite:///var/data/my.db, or sqlite:///:memory: for an in-memory database.
+type sqliteDialect struct{}
+
+func (sqliteDialect) Driver() string { return "sqlite" }
+
+func (sqliteDialect) DSN(u URL, _ string) (string, error) {
+	if u.Host != "" {
+		return "", errors.New("sqlite DSN should not contain a host")
+	}
+	path := u.Database
+	if u.Schema != "" {
+		// ParseURL splits the path into a database and a schema at the first
+		// slash, but for SQLite the whole path names the file.
+		path += "/" + u.Schema
+	}
+	if path == "" {
+		return "", errors.New("sqlite DSN must contain a database file path")
+	}
+	if path != sqliteMemory {
+		path = "/" + path
+	}
+	dsn := "file:" + path
+	if len(u.Params) > 0 {
+		q := url.Values{}
+		for k, v := range u.Params {
+			q.Set(k, v)
+		}
+		dsn += "?" + q.Encode()
+	}
+	return dsn, nil
+}
+
+func (sqliteDialect) Placeholder(int) string { return "?" }
+
+func (sqliteDialect) DefaultSchema() string { return "main" }
+
+func (sqliteDialect) ListTables(ctx context.Context, db *DB) ([]SchemaTable, error) {
+	return selectTables(ctx, db, `
+		SELECT 'main' AS schemaname, name AS tablename
+		FROM sqlite_master
+		WHERE type = 'table' AND name NOT LIKE 'sqlite_%'
+		ORDER BY name;
+	`)
+}
+
+// GetTableInfo uses PRAGMA table_info, since SQLite has no INFORMATION_SCHEMA.
+// Declared types are upper cased and stripped of any length or precision, to
+// match what INFORMATION_SCHEMA reports for other databases.
+func (sqliteDialect) GetTableInfo(tx *Tx, schemaName, tableName string) (*TableInfo, error) {
+	rows, err := tx.Query(`SELECT name, type, "notnull" FROM pragma_table_info(?, ?) ORDER BY cid`, tableName, schemaName)
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	defer rows.Close()
+	var cinfos []ColumnInfo
+	for rows.Next() {
+		var ci ColumnInfo
+		var notNull bool
+		if err := rows.Scan(&ci.Name, &ci.DataType, &notNull); err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		if i := strings.IndexByte(ci.DataType, '('); i >= 0 {
+			ci.DataType = strings.TrimSpace(ci.DataType[:i])
+		}
+		ci.DataType = strings.ToUpper(ci.DataType)
+		ci.IsNullable = !notNull
+		cinfos = append(cinfos, ci)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	if len(cinfos) == 0 {
+		return nil, errors.Errorf("table %s.%s does not exist", schemaName, tableName)
+	}
+	return &TableInfo{Driver: tx.DriverName(), Name: tableName, Schema: schemaName, Columns: cinfos}, nil
+}
@@ -23,7 +23,7 @@ type ColumnInfo struct {
 	IsNullable bool
 }
 
-// GetTableInfo looks up information about the table using INFORMATION_SCHEMA
+// GetTableInfo looks up information about the table
 func GetTableInfo(ctx context.Context, db *DB, tableName string) (*TableInfo, error) {
 	readonly := true
 	if db.DriverName() == "snowflake" {
@@ -41,9 +41,18 @@ func GetTableInfo(ctx context.Context, db *DB, tableName string) (*TableInfo, er
 	return ti, errors.EnsureStack(tx.Rollback())
 }
 
-// GetTableInfoTx looks up information about the table using INFORMATION_SCHEMA
+// GetTableInfoTx looks up information about the table using the dialect for the transaction's driver.
 func GetTableInfoTx(tx *Tx, tablePath string) (*TableInfo, error) {
+	d, err := DialectForDriver(tx.DriverName())
+	if err != nil {
+		return nil, err
+	}
 	schemaName, tableName := SplitTableSchema(tx.DriverName(), tablePath)
+	return d.GetTableInfo(tx, schemaName, tableName)
+}
+
+// informationSchemaTableInfo looks up information about the table using INFORMATION_SCHEMA
+func informationSchemaTableInfo(tx *Tx, schemaName, tableName string) (*TableInfo, error) {
 	if schemaName == "" {
 		// Check whether table is unique, and infer schema_name
 		if rows, err := tx.Query(fmt.Sprintf(`
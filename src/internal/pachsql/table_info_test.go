@@ -3,6 +3,7 @@ package pachsql_test
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 	"reflect"
 	"testing"
 
@@ -77,6 +78,34 @@ func TestGetTableInfo(suite *testing.T) {
 				},
 			},
 		},
+		{
+			Name:  "SQLite",
+			NewDB: newSQLiteDB,
+			Expected: &pachsql.TableInfo{
+				"sqlite",
+				"test_table",
+				"main",
+				[]pachsql.ColumnInfo{
+					{"c_id", "SMALLINT", false},
+					{"c_smallint", "SMALLINT", false},
+					{"c_int", "INT", false},
+					{"c_bigint", "BIGINT", false},
+					{"c_float", "FLOAT", false},
+					{"c_numeric_int", "NUMERIC", false},
+					{"c_numeric_float", "NUMERIC", false},
+					{"c_varchar", "VARCHAR", false},
+					{"c_time", "TIMESTAMP", false},
+					{"c_smallint_null", "SMALLINT", true},
+					{"c_int_null", "INT", true},
+					{"c_bigint_null", "BIGINT", true},
+					{"c_float_null", "FLOAT", true},
+					{"c_numeric_int_null", "NUMERIC", true},
+					{"c_numeric_float_null", "NUMERIC", true},
+					{"c_varchar_null", "VARCHAR", true},
+					{"c_time_null", "TIMESTAMP", true},
+				},
+			},
+		},
 	}
 	for _, tc := range tcs {
 		suite.Run(tc.Name, func(t *testing.T) {
@@ -98,3 +127,12 @@ func newEphemeralPostgresDB(ctx context.Context, t testing.TB) (*sqlx.DB, string
 	c := dockertestenv.NewTestDBConfig(t)
 	return testutil.OpenDB(t, c.Direct.DBOptions()...), c.Direct.DBName
 }
+
+func newSQLiteDB(_ context.Context, t testing.TB) (*sqlx.DB, string) {
+	u, err := pachsql.ParseURL("sqlite://" + filepath.Join(t.TempDir(), "test.db"))
+	require.NoError(t, err)
+	db, err := pachsql.OpenURL(*u, "")
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	return db, u.Database
+}
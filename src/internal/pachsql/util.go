@@ -4,7 +4,6 @@ import (
 	"database/sql"
 	"fmt"
 	"reflect"
-	"strconv"
 	"strings"
 	"time"
 
@@ -18,14 +17,11 @@ import (
 // This is more ergonimic for contructing a list of arguments since i = len(args)
 // but is perhaps unintuitive for those familiar with Postgres.
 func Placeholder(driverName string, i int) string {
-	switch driverName {
-	case "pgx":
-		return "$" + strconv.Itoa(i+1)
-	case "mysql":
-		return "?"
-	default:
-		panic(driverName)
+	d, err := DialectForDriver(driverName)
+	if err != nil {
+		panic(err)
 	}
+	return d.Placeholder(i)
 }
 
 // SplitTableSchema splits the tablePath on the first . and interprets the first part
@@ -35,14 +31,12 @@ func SplitTableSchema(driver string, tablePath string) (schemaName string, table
 		schemaName = parts[0]
 		tableName = parts[1]
 	} else {
-		tableName = tablePath
-		switch driver {
-		case "mysql":
-		case "pgx":
-			schemaName = "public"
-		default:
-			panic(fmt.Sprintf("driver not supported: %s", driver))
+		d, err := DialectForDriver(driver)
+		if err != nil {
+			panic(err)
 		}
+		tableName = tablePath
+		schemaName = d.DefaultSchema()
 	}
 	return schemaName, tableName
 }
@@ -26,6 +26,8 @@ const (
 		SELECT
 			branch.id,
 			branch.name,
+			branch.metadata,
+			branch.retention_policy,
 			branch.created_at,
 			branch.updated_at,
 			repo.id as "repo.id",
@@ -280,11 +282,12 @@ func UpsertBranch(ctx context.Context, tx *pachsql.Tx, branchInfo *pfs.BranchInf
 	// Instead, construct the commit_id based on existing project, repo, and commit_set_id fields.
 	if err := tx.QueryRowContext(ctx,
 		`
-		INSERT INTO pfs.branches(repo_id, name, head)
+		INSERT INTO pfs.branches(repo_id, name, head, metadata)
 		VALUES (
 			(SELECT repo.id FROM pfs.repos repo JOIN core.projects project ON repo.project_id = project.id WHERE project.name = $1 AND repo.name = $2 AND repo.type = $3),
 			$4,
-			(SELECT int_id FROM pfs.commits WHERE commit_id = $5)
+			(SELECT int_id FROM pfs.commits WHERE commit_id = $5),
+			$6
 		)
 		ON CONFLICT (repo_id, name) DO UPDATE SET head = EXCLUDED.head
 		RETURNING id
@@ -294,6 +297,7 @@ func UpsertBranch(ctx context.Context, tx *pachsql.Tx, branchInfo *pfs.BranchInf
 		branchInfo.Branch.Repo.Type,
 		branchInfo.Branch.Name,
 		CommitKey(branchInfo.Head),
+		Metadata(branchInfo.Metadata),
 	).Scan(&branchID); err != nil {
 		return 0, errors.Wrap(err, "could not create branch")
 	}
@@ -532,7 +536,7 @@ func fetchBranchInfoByBranch(ctx context.Context, tx *pachsql.Tx, branch *Branch
 	if branch == nil {
 		return nil, errors.Errorf("branch cannot be nil")
 	}
-	branchInfo := &pfs.BranchInfo{Branch: branch.Pb(), Head: branch.Head.Pb()}
+	branchInfo := &pfs.BranchInfo{Branch: branch.Pb(), Head: branch.Head.Pb(), Metadata: branch.Metadata.pb(), RetentionPolicy: branch.RetentionPolicy.Policy}
 	var err error
 	branchInfo.DirectProvenance, err = GetDirectBranchProvenance(ctx, tx, branch.ID)
 	if err != nil {
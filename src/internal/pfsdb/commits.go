@@ -41,12 +41,13 @@ const (
     	 compacting_time_s, 
     	 validating_time_s, 
     	 size, 
-    	 error) 
+    	 error,
+    	 metadata) 
 		VALUES 
 		($4, $5,
 		 (SELECT id from repo_row_id), 
 		 (SELECT id from pfs.branches WHERE name=$6 AND repo_id=(SELECT id from repo_row_id)), 
-		 $7, $8, $9, $10, $11, $12, $13, $14, $15)
+		 $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
 		RETURNING int_id;`
 	updateCommit = `
 		WITH repo_row_id AS (SELECT id from pfs.repos WHERE name=:repo.name AND type=:repo.type AND project_id=(SELECT id from core.projects WHERE name= :repo.project.name))
@@ -80,6 +81,7 @@ const (
     		commit.validating_time_s,
     		commit.error, 
     		commit.size, 
+    		commit.metadata,
     		commit.created_at,
     		commit.updated_at,
     		commit.repo_id AS "repo.id", 
@@ -224,12 +226,13 @@ func CreateCommit(ctx context.Context, tx *pachsql.Tx, commitInfo *pfs.CommitInf
 		ValidatingTime: pbutil.DurationPbToBigInt(commitInfo.Details.ValidatingTime),
 		Size:           commitInfo.Details.SizeBytes,
 		Error:          commitInfo.Error,
+		Metadata:       commitInfo.Metadata,
 	}
 	// It would be nice to use a named query here, but sadly there is no NamedQueryRowContext. Additionally,
 	// we run into errors when using named statements: (named statement already exists).
 	row := tx.QueryRowxContext(ctx, createCommit, insert.Repo.Name, insert.Repo.Type, insert.Repo.Project.Name,
 		insert.CommitID, insert.CommitSetID, insert.BranchName, insert.Description, insert.Origin, insert.StartTime, insert.FinishingTime,
-		insert.FinishedTime, insert.CompactingTime, insert.ValidatingTime, insert.Size, insert.Error)
+		insert.FinishedTime, insert.CompactingTime, insert.ValidatingTime, insert.Size, insert.Error, insert.Metadata)
 	if row.Err() != nil {
 		if IsDuplicateKeyErr(row.Err()) { // a duplicate key implies that an entry for the repo already exists.
 			return 0, &CommitAlreadyExistsError{CommitID: CommitKey(commitInfo.Commit)}
@@ -731,6 +734,7 @@ func parseCommitInfoFromRow(row *Commit) *pfs.CommitInfo {
 		Finished:    pbutil.TimeToTimestamppb(row.FinishedTime),
 		Description: row.Description,
 		Error:       row.Error,
+		Metadata:    row.Metadata.pb(),
 		Details: &pfs.CommitInfo_Details{
 			CompactingTime: pbutil.BigIntToDurationpb(row.CompactingTime),
 			ValidatingTime: pbutil.BigIntToDurationpb(row.ValidatingTime),
@@ -105,5 +105,6 @@ func IsNotFoundError(err error) bool {
 	return errors.As(err, &RepoNotFoundError{}) ||
 		errors.As(err, &ProjectNotFoundError{}) ||
 		errors.As(err, &CommitNotFoundError{}) ||
-		errors.As(err, &BranchNotFoundError{})
+		errors.As(err, &BranchNotFoundError{}) ||
+		errors.As(err, new(*TagNotFoundError))
 }
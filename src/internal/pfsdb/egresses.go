@@ -0,0 +1,64 @@
+package pfsdb
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+)
+
+// GetSQLEgressCommit returns the last commit from repo that was egressed to target,
+// or nil if nothing from repo has been egressed to target, or if that commit has
+// since been deleted.
+func GetSQLEgressCommit(ctx context.Context, tx *pachsql.Tx, target string, repo *pfs.Repo) (*pfs.Commit, error) {
+	repoID, err := GetRepoID(ctx, tx, repo.Project.GetName(), repo.Name, repo.Type)
+	if err != nil {
+		return nil, errors.Wrap(err, "get sql egress commit")
+	}
+	var commitID CommitID
+	if err := tx.GetContext(ctx, &commitID, `SELECT commit_id FROM pfs.sql_egresses WHERE target = $1 AND repo_id = $2`, target, repoID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "get sql egress commit")
+	}
+	commitInfo, err := GetCommit(ctx, tx, commitID)
+	if err != nil {
+		return nil, errors.Wrap(err, "get sql egress commit")
+	}
+	return commitInfo.Commit, nil
+}
+
+// UpsertSQLEgressCommit records commit as the last commit from its repo that was
+// egressed to target.
+func UpsertSQLEgressCommit(ctx context.Context, tx *pachsql.Tx, target string, commit *pfs.Commit) error {
+	commitID, err := GetCommitID(ctx, tx, commit)
+	if err != nil {
+		return errors.Wrap(err, "upsert sql egress commit")
+	}
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO pfs.sql_egresses (target, repo_id, commit_id)
+		SELECT $1, repo_id, int_id FROM pfs.commits WHERE int_id = $2
+		ON CONFLICT (target, repo_id) DO UPDATE SET
+			commit_id = EXCLUDED.commit_id,
+			updated_at = CURRENT_TIMESTAMP
+	`, target, commitID); err != nil {
+		return errors.Wrap(err, "upsert sql egress commit")
+	}
+	return nil
+}
+
+// DeleteSQLEgressCommit forgets the last commit from repo that was egressed to
+// target, so that the next egress from repo to target reloads every table.
+func DeleteSQLEgressCommit(ctx context.Context, tx *pachsql.Tx, target string, repo *pfs.Repo) error {
+	repoID, err := GetRepoID(ctx, tx, repo.Project.GetName(), repo.Name, repo.Type)
+	if err != nil {
+		return errors.Wrap(err, "delete sql egress commit")
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM pfs.sql_egresses WHERE target = $1 AND repo_id = $2`, target, repoID); err != nil {
+		return errors.Wrap(err, "delete sql egress commit")
+	}
+	return nil
+}
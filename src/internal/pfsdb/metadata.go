@@ -0,0 +1,46 @@
+package pfsdb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+)
+
+// UpdateProjectMetadata overwrites the metadata of the project with row id 'id'.
+func UpdateProjectMetadata(ctx context.Context, tx *pachsql.Tx, id ProjectID, metadata map[string]string) error {
+	return updateColumn(ctx, tx, "core.projects", "metadata", "id", id, Metadata(metadata), &ProjectNotFoundError{ID: id})
+}
+
+// UpdateRepoMetadata overwrites the metadata of the repo with row id 'id'.
+func UpdateRepoMetadata(ctx context.Context, tx *pachsql.Tx, id RepoID, metadata map[string]string) error {
+	return updateColumn(ctx, tx, "pfs.repos", "metadata", "id", id, Metadata(metadata), &RepoNotFoundError{ID: id})
+}
+
+// UpdateBranchMetadata overwrites the metadata of the branch with row id 'id'.
+func UpdateBranchMetadata(ctx context.Context, tx *pachsql.Tx, id BranchID, metadata map[string]string) error {
+	return updateColumn(ctx, tx, "pfs.branches", "metadata", "id", id, Metadata(metadata), &BranchNotFoundError{ID: id})
+}
+
+// UpdateCommitMetadata overwrites the metadata of the commit with row id 'id'.
+func UpdateCommitMetadata(ctx context.Context, tx *pachsql.Tx, id CommitID, metadata map[string]string) error {
+	return updateColumn(ctx, tx, "pfs.commits", "metadata", "int_id", id, Metadata(metadata), &CommitNotFoundError{RowID: id})
+}
+
+// updateColumn sets 'column' of the row of 'table' identified by 'id', and
+// returns 'notFound' if there is no such row.
+func updateColumn(ctx context.Context, tx *pachsql.Tx, table, column, idColumn string, id, value any, notFound error) error {
+	result, err := tx.ExecContext(ctx, fmt.Sprintf("UPDATE %s SET %s = $1 WHERE %s = $2;", table, column, idColumn), value, id)
+	if err != nil {
+		return errors.Wrapf(err, "update %s %s", table, column)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "could not get affected rows")
+	}
+	if rowsAffected == 0 {
+		return notFound
+	}
+	return nil
+}
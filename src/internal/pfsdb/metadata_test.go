@@ -0,0 +1,87 @@
+package pfsdb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pctx"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+)
+
+func TestRepoMetadata(t *testing.T) {
+	t.Parallel()
+	ctx := pctx.TestContext(t)
+	db := newTestDB(t, ctx)
+	repoInfo := testRepo(testRepoName, testRepoType)
+	repoInfo.Metadata = map[string]string{"team": "vision"}
+	withTx(t, ctx, db, func(ctx context.Context, tx *pachsql.Tx) {
+		id, err := pfsdb.UpsertRepo(ctx, tx, repoInfo)
+		require.NoError(t, err)
+		got, err := pfsdb.GetRepo(ctx, tx, id)
+		require.NoError(t, err)
+		require.Equal(t, repoInfo.Metadata, got.Metadata)
+		require.NoError(t, pfsdb.UpdateRepoMetadata(ctx, tx, id, map[string]string{"team": "nlp", "owner": "alice"}))
+		got, err = pfsdb.GetRepo(ctx, tx, id)
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{"team": "nlp", "owner": "alice"}, got.Metadata)
+		// Upserting the repo again must not clobber edited metadata.
+		_, err = pfsdb.UpsertRepo(ctx, tx, testRepo(testRepoName, testRepoType))
+		require.NoError(t, err)
+		got, err = pfsdb.GetRepo(ctx, tx, id)
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{"team": "nlp", "owner": "alice"}, got.Metadata)
+	})
+	withFailedTx(t, ctx, db, func(ctx context.Context, tx *pachsql.Tx) {
+		err := pfsdb.UpdateRepoMetadata(ctx, tx, pfsdb.RepoID(1<<30), map[string]string{})
+		require.YesError(t, err)
+		require.True(t, pfsdb.IsNotFoundError(err))
+	})
+}
+
+func TestCommitAndBranchMetadata(t *testing.T) {
+	withDB(t, func(ctx context.Context, t *testing.T, db *pachsql.DB) {
+		withTx(t, ctx, db, func(ctx context.Context, tx *pachsql.Tx) {
+			commitInfo := testCommit(ctx, t, tx, testRepoName)
+			commitInfo.Metadata = map[string]string{"reviewed": "false"}
+			commitID, err := pfsdb.CreateCommit(ctx, tx, commitInfo)
+			require.NoError(t, err)
+			got, err := pfsdb.GetCommit(ctx, tx, commitID)
+			require.NoError(t, err)
+			require.Equal(t, commitInfo.Metadata, got.Metadata)
+			require.NoError(t, pfsdb.UpdateCommitMetadata(ctx, tx, commitID, map[string]string{"reviewed": "true"}))
+			got, err = pfsdb.GetCommit(ctx, tx, commitID)
+			require.NoError(t, err)
+			require.Equal(t, map[string]string{"reviewed": "true"}, got.Metadata)
+
+			branchInfo := &pfs.BranchInfo{Branch: commitInfo.Commit.Branch, Head: commitInfo.Commit}
+			branchID, err := pfsdb.UpsertBranch(ctx, tx, branchInfo)
+			require.NoError(t, err)
+			require.NoError(t, pfsdb.UpdateBranchMetadata(ctx, tx, branchID, map[string]string{"stage": "prod"}))
+			gotBranch, err := pfsdb.GetBranchInfo(ctx, tx, branchID)
+			require.NoError(t, err)
+			require.Equal(t, map[string]string{"stage": "prod"}, gotBranch.Metadata)
+		})
+	})
+}
+
+func TestProjectMetadata(t *testing.T) {
+	t.Parallel()
+	ctx := pctx.TestContext(t)
+	db := newTestDB(t, ctx)
+	withTx(t, ctx, db, func(ctx context.Context, tx *pachsql.Tx) {
+		projectInfo := &pfs.ProjectInfo{Project: &pfs.Project{Name: "metadata"}, Metadata: map[string]string{"owner": "bob"}}
+		require.NoError(t, pfsdb.CreateProject(ctx, tx, projectInfo))
+		id, err := pfsdb.GetProjectID(ctx, tx, "metadata")
+		require.NoError(t, err)
+		got, err := pfsdb.GetProject(ctx, tx, id)
+		require.NoError(t, err)
+		require.Equal(t, projectInfo.Metadata, got.Metadata)
+		require.NoError(t, pfsdb.UpdateProjectMetadata(ctx, tx, id, nil))
+		got, err = pfsdb.GetProject(ctx, tx, id)
+		require.NoError(t, err)
+		require.Equal(t, 0, len(got.Metadata))
+	})
+}
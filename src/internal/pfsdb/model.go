@@ -2,11 +2,15 @@ package pfsdb
 
 import (
 	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
 	"strings"
 	"time"
 
+	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
 )
 
@@ -24,6 +28,90 @@ type CommitID uint64
 // BranchID is the row id for a branch entry in postgres.
 type BranchID uint64
 
+// Metadata is a set of user-provided key/value pairs, stored as a jsonb object.
+type Metadata map[string]string
+
+// Scan implements sql.Scanner
+func (md *Metadata) Scan(src interface{}) error {
+	var data []byte
+	switch x := src.(type) {
+	case nil:
+		*md = nil
+		return nil
+	case []byte:
+		data = x
+	case string:
+		data = []byte(x)
+	default:
+		return errors.Errorf("scanning pfsdb.Metadata: can't turn %T into pfsdb.Metadata", src)
+	}
+	result := make(Metadata)
+	if err := json.Unmarshal(data, &result); err != nil {
+		return errors.Wrap(err, "unmarshal metadata")
+	}
+	*md = result
+	return nil
+}
+
+// Value implements sql.Valuer
+func (md Metadata) Value() (driver.Value, error) {
+	if md == nil {
+		return "{}", nil
+	}
+	data, err := json.Marshal(map[string]string(md))
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal metadata")
+	}
+	return string(data), nil
+}
+
+// pb returns the metadata as a proto map, which is nil if there is no metadata.
+func (md Metadata) pb() map[string]string {
+	if len(md) == 0 {
+		return nil
+	}
+	return md
+}
+
+// RetentionPolicy is a pfs.RetentionPolicy stored as a nullable jsonb column.
+type RetentionPolicy struct {
+	Policy *pfs.RetentionPolicy
+}
+
+// Scan implements sql.Scanner
+func (rp *RetentionPolicy) Scan(src interface{}) error {
+	var data []byte
+	switch x := src.(type) {
+	case nil:
+		rp.Policy = nil
+		return nil
+	case []byte:
+		data = x
+	case string:
+		data = []byte(x)
+	default:
+		return errors.Errorf("scanning pfsdb.RetentionPolicy: can't turn %T into pfsdb.RetentionPolicy", src)
+	}
+	policy := &pfs.RetentionPolicy{}
+	if err := protojson.Unmarshal(data, policy); err != nil {
+		return errors.Wrap(err, "unmarshal retention policy")
+	}
+	rp.Policy = policy
+	return nil
+}
+
+// Value implements sql.Valuer
+func (rp RetentionPolicy) Value() (driver.Value, error) {
+	if rp.Policy == nil {
+		return nil, nil
+	}
+	data, err := protojson.Marshal(rp.Policy)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal retention policy")
+	}
+	return string(data), nil
+}
+
 type CreatedAtUpdatedAt struct {
 	CreatedAt time.Time `db:"created_at"`
 	UpdatedAt time.Time `db:"updated_at"`
@@ -33,6 +121,7 @@ type Project struct {
 	ID          ProjectID `db:"id"`
 	Name        string    `db:"name"`
 	Description string    `db:"description"`
+	Metadata    Metadata  `db:"metadata"`
 	CreatedAtUpdatedAt
 }
 
@@ -49,6 +138,7 @@ func (project *Project) PbInfo() *pfs.ProjectInfo {
 		},
 		Description: project.Description,
 		CreatedAt:   timestamppb.New(project.CreatedAt),
+		Metadata:    project.Metadata.pb(),
 	}
 }
 
@@ -58,11 +148,13 @@ func (project Project) GetCreatedAtUpdatedAt() CreatedAtUpdatedAt {
 
 // Repo is a row in the pfs.repos table.
 type Repo struct {
-	ID          RepoID  `db:"id"`
-	Project     Project `db:"project"`
-	Name        string  `db:"name"`
-	Type        string  `db:"type"`
-	Description string  `db:"description"`
+	ID              RepoID          `db:"id"`
+	Project         Project         `db:"project"`
+	Name            string          `db:"name"`
+	Type            string          `db:"type"`
+	Description     string          `db:"description"`
+	Metadata        Metadata        `db:"metadata"`
+	RetentionPolicy RetentionPolicy `db:"retention_policy"`
 	CreatedAtUpdatedAt
 	BranchesNames string `db:"branches"`
 }
@@ -85,10 +177,12 @@ func (repo *Repo) PbInfo() (*pfs.RepoInfo, error) {
 		return nil, err
 	}
 	return &pfs.RepoInfo{
-		Repo:        repo.Pb(),
-		Description: repo.Description,
-		Branches:    branches,
-		Created:     timestamppb.New(repo.CreatedAt),
+		Repo:            repo.Pb(),
+		Description:     repo.Description,
+		Branches:        branches,
+		Created:         timestamppb.New(repo.CreatedAt),
+		Metadata:        repo.Metadata.pb(),
+		RetentionPolicy: repo.RetentionPolicy.Policy,
 	}, nil
 }
 
@@ -121,6 +215,7 @@ type Commit struct {
 	ValidatingTime sql.NullInt64 `db:"validating_time_s"`
 	Error          string        `db:"error"`
 	Size           int64         `db:"size"`
+	Metadata       Metadata      `db:"metadata"`
 	// BranchName is used to derive the BranchID in commit related queries.
 	BranchName sql.NullString `db:"branch_name"`
 	BranchID   sql.NullInt64  `db:"branch_id"`
@@ -148,10 +243,12 @@ func (commit *Commit) Pb() *pfs.Commit {
 
 // Branch is a row in the pfs.branches table.
 type Branch struct {
-	ID   BranchID `db:"id"`
-	Head Commit   `db:"head"`
-	Repo Repo     `db:"repo"`
-	Name string   `db:"name"`
+	ID              BranchID        `db:"id"`
+	Head            Commit          `db:"head"`
+	Repo            Repo            `db:"repo"`
+	Name            string          `db:"name"`
+	Metadata        Metadata        `db:"metadata"`
+	RetentionPolicy RetentionPolicy `db:"retention_policy"`
 	CreatedAtUpdatedAt
 }
 
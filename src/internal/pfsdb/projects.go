@@ -116,7 +116,7 @@ func NewProjectIterator(ctx context.Context, extCtx sqlx.ExtContext, startPage,
 			values = append(values, filter.Name)
 		}
 	}
-	query := "SELECT id,name,description,created_at FROM core.projects project\n"
+	query := "SELECT id,name,description,metadata,created_at FROM core.projects project\n"
 	if len(conditions) > 0 {
 		query += fmt.Sprintf("WHERE %s\n", strings.Join(conditions, " AND "))
 	}
@@ -160,7 +160,7 @@ func ListProject(ctx context.Context, tx *pachsql.Tx) ([]ProjectWithID, error) {
 
 // CreateProject creates an entry in the core.projects table.
 func CreateProject(ctx context.Context, tx *pachsql.Tx, project *pfs.ProjectInfo) error {
-	_, err := tx.ExecContext(ctx, "INSERT INTO core.projects (name, description) VALUES ($1, $2);", project.Project.Name, project.Description)
+	_, err := tx.ExecContext(ctx, "INSERT INTO core.projects (name, description, metadata) VALUES ($1, $2, $3);", project.Project.Name, project.Description, Metadata(project.Metadata))
 	//todo: insert project.authInfo into auth table.
 	if err != nil && IsErrProjectAlreadyExists(err) {
 		return &ProjectAlreadyExistsError{Name: project.Project.Name}
@@ -191,16 +191,29 @@ func GetProject(ctx context.Context, tx *pachsql.Tx, id ProjectID) (*pfs.Project
 	return getProject(ctx, tx, "id", id)
 }
 
+// GetProjectID returns the row id of the project named 'projectName'.
+func GetProjectID(ctx context.Context, tx *pachsql.Tx, projectName string) (ProjectID, error) {
+	var id ProjectID
+	if err := tx.GetContext(ctx, &id, "SELECT id FROM core.projects WHERE name = $1", projectName); err != nil {
+		if err == sql.ErrNoRows {
+			return 0, &ProjectNotFoundError{Name: projectName}
+		}
+		return 0, errors.Wrapf(err, "could not get id for project %s", projectName)
+	}
+	return id, nil
+}
+
 // GetProjectByName retrieves an entry from the core.projects table by project name.
 func GetProjectByName(ctx context.Context, tx *pachsql.Tx, projectName string) (*pfs.ProjectInfo, error) {
 	return getProject(ctx, tx, "name", projectName)
 }
 
 func getProject(ctx context.Context, tx *pachsql.Tx, where string, whereVal interface{}) (*pfs.ProjectInfo, error) {
-	row := tx.QueryRowxContext(ctx, fmt.Sprintf("SELECT name, description, created_at FROM core.projects WHERE %s = $1", where), whereVal)
+	row := tx.QueryRowxContext(ctx, fmt.Sprintf("SELECT name, description, metadata, created_at FROM core.projects WHERE %s = $1", where), whereVal)
 	project := &pfs.ProjectInfo{Project: &pfs.Project{}}
 	var createdAt time.Time
-	err := row.Scan(&project.Project.Name, &project.Description, &createdAt)
+	var metadata Metadata
+	err := row.Scan(&project.Project.Name, &project.Description, &metadata, &createdAt)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			if name, ok := whereVal.(string); ok {
@@ -211,6 +224,7 @@ func getProject(ctx context.Context, tx *pachsql.Tx, where string, whereVal inte
 		return nil, errors.Wrap(err, "scanning project row")
 	}
 	project.CreatedAt = timestamppb.New(createdAt)
+	project.Metadata = metadata.pb()
 	return project, nil
 }
 
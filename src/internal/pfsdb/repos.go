@@ -26,6 +26,8 @@ const (
 			repo.name,
 			repo.type,
 			repo.description,
+			repo.metadata,
+			repo.retention_policy,
 			repo.project_id AS "project.id",
 			project.name AS "project.name",
 			array_agg(branch.name) AS "branches",
@@ -173,12 +175,12 @@ func UpsertRepo(ctx context.Context, tx *pachsql.Tx, repo *pfs.RepoInfo) (RepoID
 	var repoID RepoID
 	if err := tx.QueryRowContext(ctx,
 		`
-		INSERT INTO pfs.repos (name, type, project_id, description)
-		VALUES ($1, $2, (SELECT id from core.projects where name=$3), $4)
+		INSERT INTO pfs.repos (name, type, project_id, description, metadata)
+		VALUES ($1, $2, (SELECT id from core.projects where name=$3), $4, $5)
 		ON CONFLICT (name, type, project_id) DO UPDATE SET description= EXCLUDED.description
 		RETURNING id
 		`,
-		repo.Repo.Name, repo.Repo.Type, repo.Repo.Project.Name, repo.Description,
+		repo.Repo.Name, repo.Repo.Type, repo.Repo.Project.Name, repo.Description, Metadata(repo.Metadata),
 	).Scan(&repoID); err != nil {
 		return 0, errors.Wrap(err, "upsert repo")
 	}
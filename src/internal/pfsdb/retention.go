@@ -0,0 +1,20 @@
+package pfsdb
+
+import (
+	"context"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+)
+
+// SetRepoRetentionPolicy sets the retention policy of the repo with row id
+// 'id'.  A nil policy removes the repo's retention policy.
+func SetRepoRetentionPolicy(ctx context.Context, tx *pachsql.Tx, id RepoID, policy *pfs.RetentionPolicy) error {
+	return updateColumn(ctx, tx, "pfs.repos", "retention_policy", "id", id, RetentionPolicy{Policy: policy}, &RepoNotFoundError{ID: id})
+}
+
+// SetBranchRetentionPolicy sets the retention policy of the branch with row id
+// 'id'.  A nil policy removes the branch's retention policy.
+func SetBranchRetentionPolicy(ctx context.Context, tx *pachsql.Tx, id BranchID, policy *pfs.RetentionPolicy) error {
+	return updateColumn(ctx, tx, "pfs.branches", "retention_policy", "id", id, RetentionPolicy{Policy: policy}, &BranchNotFoundError{ID: id})
+}
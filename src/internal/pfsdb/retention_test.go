@@ -0,0 +1,54 @@
+package pfsdb_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+)
+
+func TestRetentionPolicy(t *testing.T) {
+	withDB(t, func(ctx context.Context, t *testing.T, db *pachsql.DB) {
+		withTx(t, ctx, db, func(ctx context.Context, tx *pachsql.Tx) {
+			commitInfo := testCommit(ctx, t, tx, testRepoName)
+			_, err := pfsdb.CreateCommit(ctx, tx, commitInfo)
+			require.NoError(t, err)
+			branchID, err := pfsdb.UpsertBranch(ctx, tx, &pfs.BranchInfo{Branch: commitInfo.Commit.Branch, Head: commitInfo.Commit})
+			require.NoError(t, err)
+			repoID, err := pfsdb.GetRepoID(ctx, tx, pfs.DefaultProjectName, testRepoName, testRepoType)
+			require.NoError(t, err)
+
+			repoInfo, err := pfsdb.GetRepo(ctx, tx, repoID)
+			require.NoError(t, err)
+			require.Nil(t, repoInfo.RetentionPolicy)
+
+			repoPolicy := &pfs.RetentionPolicy{KeepLast: 3}
+			require.NoError(t, pfsdb.SetRepoRetentionPolicy(ctx, tx, repoID, repoPolicy))
+			repoInfo, err = pfsdb.GetRepo(ctx, tx, repoID)
+			require.NoError(t, err)
+			require.Equal(t, "", cmp.Diff(repoPolicy, repoInfo.RetentionPolicy, protocmp.Transform()))
+
+			branchPolicy := &pfs.RetentionPolicy{
+				KeepNewerThan: durationpb.New(time.Hour),
+				KeepMetadata:  map[string]string{"release": "true"},
+			}
+			require.NoError(t, pfsdb.SetBranchRetentionPolicy(ctx, tx, branchID, branchPolicy))
+			branchInfo, err := pfsdb.GetBranchInfo(ctx, tx, branchID)
+			require.NoError(t, err)
+			require.Equal(t, "", cmp.Diff(branchPolicy, branchInfo.RetentionPolicy, protocmp.Transform()))
+
+			require.NoError(t, pfsdb.SetRepoRetentionPolicy(ctx, tx, repoID, nil))
+			repoInfo, err = pfsdb.GetRepo(ctx, tx, repoID)
+			require.NoError(t, err)
+			require.Nil(t, repoInfo.RetentionPolicy)
+		})
+	})
+}
@@ -0,0 +1,51 @@
+package pfsdb
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+)
+
+// PutStorageStats replaces the storage statistics returned by GetStorageStats.
+func PutStorageStats(ctx context.Context, tx *pachsql.Tx, stats *pfs.InspectStorageResponse) error {
+	data, err := proto.Marshal(stats)
+	if err != nil {
+		return errors.Wrap(err, "marshal storage stats")
+	}
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO pfs.storage_stats (computed_at, stats) VALUES ($1, $2)
+		ON CONFLICT (id) DO UPDATE SET computed_at = EXCLUDED.computed_at, stats = EXCLUDED.stats
+	`, stats.ComputedAt.AsTime(), data); err != nil {
+		return errors.Wrap(err, "put storage stats")
+	}
+	return nil
+}
+
+// GetStorageStats returns the storage statistics last stored by PutStorageStats, or nil if
+// there are none.
+func GetStorageStats(ctx context.Context, q sqlx.QueryerContext) (*pfs.InspectStorageResponse, error) {
+	var row struct {
+		ComputedAt time.Time `db:"computed_at"`
+		Stats      []byte    `db:"stats"`
+	}
+	if err := sqlx.GetContext(ctx, q, &row, `SELECT computed_at, stats FROM pfs.storage_stats`); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "get storage stats")
+	}
+	stats := &pfs.InspectStorageResponse{}
+	if err := proto.Unmarshal(row.Stats, stats); err != nil {
+		return nil, errors.Wrap(err, "unmarshal storage stats")
+	}
+	stats.ComputedAt = timestamppb.New(row.ComputedAt)
+	return stats, nil
+}
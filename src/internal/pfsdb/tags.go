@@ -0,0 +1,167 @@
+package pfsdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+)
+
+// TagNotFoundError is returned when a tag is not found in postgres.
+type TagNotFoundError struct {
+	Repo string
+	Name string
+}
+
+func (err *TagNotFoundError) Error() string {
+	return fmt.Sprintf("tag %s@%s not found", err.Repo, err.Name)
+}
+
+func (err *TagNotFoundError) GRPCStatus() *status.Status {
+	return status.New(codes.NotFound, err.Error())
+}
+
+func IsErrTagNotFound(err error) bool {
+	return errors.As(err, new(*TagNotFoundError))
+}
+
+// TagExistsError is returned when a tag with the same name already exists in
+// the repo.  Tags are immutable, so they can't be overwritten.
+type TagExistsError struct {
+	Repo string
+	Name string
+}
+
+func (err *TagExistsError) Error() string {
+	return fmt.Sprintf("tag %s@%s already exists", err.Repo, err.Name)
+}
+
+func (err *TagExistsError) GRPCStatus() *status.Status {
+	return status.New(codes.AlreadyExists, err.Error())
+}
+
+type tagRow struct {
+	Name      string    `db:"name"`
+	CommitID  CommitID  `db:"commit_id"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+func getRepoIDForTag(ctx context.Context, tx *pachsql.Tx, repo *pfs.Repo) (RepoID, error) {
+	repoType := repo.Type
+	if repoType == "" {
+		repoType = pfs.UserRepoType
+	}
+	return GetRepoID(ctx, tx, repo.GetProject().GetName(), repo.Name, repoType)
+}
+
+func (row *tagRow) pbInfo(ctx context.Context, tx *pachsql.Tx, repo *pfs.Repo) (*pfs.TagInfo, error) {
+	commitInfo, err := GetCommit(ctx, tx, row.CommitID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "get commit of tag %s@%s", repo, row.Name)
+	}
+	return &pfs.TagInfo{
+		Tag:     &pfs.Tag{Repo: commitInfo.Commit.Repo, Name: row.Name},
+		Commit:  commitInfo.Commit,
+		Created: timestamppb.New(row.CreatedAt),
+	}, nil
+}
+
+// CreateTag creates a tag named tag.Name pointing at commit, which must be in
+// tag.Repo.
+func CreateTag(ctx context.Context, tx *pachsql.Tx, tag *pfs.Tag, commit *pfs.Commit) error {
+	if commit.GetRepo().Key() != tag.GetRepo().Key() {
+		return errors.Errorf("commit %s is not in the repo of tag %s", commit, tag)
+	}
+	commitID, err := GetCommitID(ctx, tx, commit)
+	if err != nil {
+		return errors.Wrap(err, "create tag")
+	}
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO pfs.tags (repo_id, name, commit_id)
+		SELECT repo_id, $1, int_id FROM pfs.commits WHERE int_id = $2
+	`, tag.Name, commitID); err != nil {
+		if IsDuplicateKeyErr(err) {
+			return &TagExistsError{Repo: tag.Repo.String(), Name: tag.Name}
+		}
+		return errors.Wrap(err, "create tag")
+	}
+	return nil
+}
+
+// GetTag returns the tag named tag.Name in tag.Repo.
+func GetTag(ctx context.Context, tx *pachsql.Tx, tag *pfs.Tag) (*pfs.TagInfo, error) {
+	repoID, err := getRepoIDForTag(ctx, tx, tag.GetRepo())
+	if err != nil {
+		return nil, errors.Wrap(err, "get tag")
+	}
+	row := &tagRow{}
+	if err := tx.GetContext(ctx, row, `SELECT name, commit_id, created_at FROM pfs.tags WHERE repo_id = $1 AND name = $2`, repoID, tag.Name); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, &TagNotFoundError{Repo: tag.Repo.String(), Name: tag.Name}
+		}
+		return nil, errors.Wrap(err, "get tag")
+	}
+	return row.pbInfo(ctx, tx, tag.Repo)
+}
+
+// ListTags returns the tags in repo, ordered by name.
+func ListTags(ctx context.Context, tx *pachsql.Tx, repo *pfs.Repo) ([]*pfs.TagInfo, error) {
+	repoID, err := getRepoIDForTag(ctx, tx, repo)
+	if err != nil {
+		return nil, errors.Wrap(err, "list tags")
+	}
+	var rows []tagRow
+	if err := tx.SelectContext(ctx, &rows, `SELECT name, commit_id, created_at FROM pfs.tags WHERE repo_id = $1 ORDER BY name`, repoID); err != nil {
+		return nil, errors.Wrap(err, "list tags")
+	}
+	var tagInfos []*pfs.TagInfo
+	for _, row := range rows {
+		tagInfo, err := row.pbInfo(ctx, tx, repo)
+		if err != nil {
+			return nil, err
+		}
+		tagInfos = append(tagInfos, tagInfo)
+	}
+	return tagInfos, nil
+}
+
+// ListCommitTags returns the names of the tags pointing at commit.
+func ListCommitTags(ctx context.Context, tx *pachsql.Tx, commit *pfs.Commit) ([]string, error) {
+	commitID, err := GetCommitID(ctx, tx, commit)
+	if err != nil {
+		return nil, errors.Wrap(err, "list commit tags")
+	}
+	var names []string
+	if err := tx.SelectContext(ctx, &names, `SELECT name FROM pfs.tags WHERE commit_id = $1 ORDER BY name`, commitID); err != nil {
+		return nil, errors.Wrap(err, "list commit tags")
+	}
+	return names, nil
+}
+
+// DeleteTag deletes the tag named tag.Name in tag.Repo.
+func DeleteTag(ctx context.Context, tx *pachsql.Tx, tag *pfs.Tag) error {
+	repoID, err := getRepoIDForTag(ctx, tx, tag.GetRepo())
+	if err != nil {
+		return errors.Wrap(err, "delete tag")
+	}
+	result, err := tx.ExecContext(ctx, `DELETE FROM pfs.tags WHERE repo_id = $1 AND name = $2`, repoID, tag.Name)
+	if err != nil {
+		return errors.Wrap(err, "delete tag")
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "could not get affected rows")
+	}
+	if rowsAffected == 0 {
+		return &TagNotFoundError{Repo: tag.Repo.String(), Name: tag.Name}
+	}
+	return nil
+}
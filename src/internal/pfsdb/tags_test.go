@@ -0,0 +1,49 @@
+package pfsdb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+)
+
+func TestTags(t *testing.T) {
+	withDB(t, func(ctx context.Context, t *testing.T, db *pachsql.DB) {
+		withTx(t, ctx, db, func(ctx context.Context, tx *pachsql.Tx) {
+			commitInfo := testCommit(ctx, t, tx, testRepoName)
+			_, err := pfsdb.CreateCommit(ctx, tx, commitInfo)
+			require.NoError(t, err)
+			repo := commitInfo.Commit.Repo
+
+			require.NoError(t, pfsdb.CreateTag(ctx, tx, repo.NewTag("v2"), commitInfo.Commit))
+			require.NoError(t, pfsdb.CreateTag(ctx, tx, repo.NewTag("v1"), commitInfo.Commit))
+			err = pfsdb.CreateTag(ctx, tx, repo.NewTag("v1"), commitInfo.Commit)
+			require.YesError(t, err)
+			require.True(t, errors.As(err, new(*pfsdb.TagExistsError)))
+
+			tagInfo, err := pfsdb.GetTag(ctx, tx, repo.NewTag("v1"))
+			require.NoError(t, err)
+			require.Equal(t, "v1", tagInfo.Tag.Name)
+			require.Equal(t, commitInfo.Commit.Id, tagInfo.Commit.Id)
+
+			tagInfos, err := pfsdb.ListTags(ctx, tx, repo)
+			require.NoError(t, err)
+			require.Equal(t, 2, len(tagInfos))
+			require.Equal(t, "v1", tagInfos[0].Tag.Name)
+			require.Equal(t, "v2", tagInfos[1].Tag.Name)
+
+			names, err := pfsdb.ListCommitTags(ctx, tx, commitInfo.Commit)
+			require.NoError(t, err)
+			require.Equal(t, []string{"v1", "v2"}, names)
+
+			require.NoError(t, pfsdb.DeleteTag(ctx, tx, repo.NewTag("v1")))
+			_, err = pfsdb.GetTag(ctx, tx, repo.NewTag("v1"))
+			require.YesError(t, err)
+			require.True(t, pfsdb.IsErrTagNotFound(err))
+			require.True(t, pfsdb.IsErrTagNotFound(pfsdb.DeleteTag(ctx, tx, repo.NewTag("v1"))))
+		})
+	})
+}
@@ -0,0 +1,447 @@
+// Package pjsdb contains the database functions used by the PJS (Pachyderm Job System) API.
+//
+// Jobs are stored in the pjs.jobs table and form a tree via the parent column.
+// A Queue is not stored separately: it is the set of QUEUED jobs which share a spec hash.
+package pjsdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachhash"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+	"github.com/pachyderm/pachyderm/v2/src/pjs"
+)
+
+// JobID is the primary key of a job in the pjs.jobs table.
+type JobID int64
+
+// ErrQueueEmpty is returned by DequeueJob when there are no jobs queued.
+var ErrQueueEmpty = errors.New("queue is empty")
+
+// JobNotFoundError is returned when a job does not exist.
+type JobNotFoundError struct {
+	ID JobID
+}
+
+// Error satisfies the error interface.
+func (err *JobNotFoundError) Error() string {
+	if err.ID != 0 {
+		return fmt.Sprintf("job %d not found", err.ID)
+	}
+	return "job not found"
+}
+
+func (err *JobNotFoundError) Is(other error) bool {
+	_, ok := other.(*JobNotFoundError)
+	return ok
+}
+
+func (err *JobNotFoundError) GRPCStatus() *status.Status {
+	return status.New(codes.NotFound, err.Error())
+}
+
+// Job is a row in the pjs.jobs table.
+type Job struct {
+	ID           JobID         `db:"id"`
+	Parent       sql.NullInt64 `db:"parent"`
+	Spec         []byte        `db:"spec"`
+	SpecHash     []byte        `db:"spec_hash"`
+	Input        []byte        `db:"input"`
+	InputHash    []byte        `db:"input_hash"`
+	Output       []byte        `db:"output"`
+	Error        sql.NullInt16 `db:"error"`
+	CacheRead    bool          `db:"cache_read"`
+	CacheWrite   bool          `db:"cache_write"`
+	QueuedAt     time.Time     `db:"queued_at"`
+	ProcessingAt sql.NullTime  `db:"processing_at"`
+	DoneAt       sql.NullTime  `db:"done_at"`
+}
+
+var columns = []string{"id", "parent", "spec", "spec_hash", "input", "input_hash", "output", "error", "cache_read", "cache_write", "queued_at", "processing_at", "done_at"}
+
+var jobColumns = strings.Join(columns, ", ")
+
+// State returns the state of the job.
+func (j *Job) State() pjs.JobState {
+	switch {
+	case j.DoneAt.Valid:
+		return pjs.JobState_DONE
+	case j.ProcessingAt.Valid:
+		return pjs.JobState_PROCESSING
+	default:
+		return pjs.JobState_QUEUED
+	}
+}
+
+// ParentID returns the ID of the job's parent or 0 if the job is a root job.
+func (j *Job) ParentID() JobID {
+	if !j.Parent.Valid {
+		return 0
+	}
+	return JobID(j.Parent.Int64)
+}
+
+// InputElement unmarshals the input of the job.
+func (j *Job) InputElement() (*pjs.QueueElement, error) {
+	return unmarshalElement(j.Input)
+}
+
+// OutputElement unmarshals the output of the job.
+// It returns nil if the job does not have an output.
+func (j *Job) OutputElement() (*pjs.QueueElement, error) {
+	if j.Output == nil {
+		return nil, nil
+	}
+	return unmarshalElement(j.Output)
+}
+
+// ToJobInfo converts the row to a pjs.JobInfo.
+// Fileset handles in the returned elements are the handles stored in the database.
+func (j *Job) ToJobInfo() (*pjs.JobInfo, error) {
+	spec := &anypb.Any{}
+	if err := proto.Unmarshal(j.Spec, spec); err != nil {
+		return nil, errors.Wrap(err, "unmarshaling spec")
+	}
+	input, err := j.InputElement()
+	if err != nil {
+		return nil, err
+	}
+	info := &pjs.JobInfo{
+		Job:   &pjs.Job{Id: int64(j.ID)},
+		State: j.State(),
+		Spec:  spec,
+		Input: input,
+	}
+	if j.Parent.Valid {
+		info.ParentJob = &pjs.Job{Id: j.Parent.Int64}
+	}
+	if j.Error.Valid {
+		info.Result = &pjs.JobInfo_Error{Error: pjs.JobErrorCode(j.Error.Int16)}
+	} else if j.Output != nil {
+		output, err := j.OutputElement()
+		if err != nil {
+			return nil, err
+		}
+		info.Result = &pjs.JobInfo_Output{Output: output}
+	}
+	return info, nil
+}
+
+func unmarshalElement(data []byte) (*pjs.QueueElement, error) {
+	elem := &pjs.QueueElement{}
+	if err := proto.Unmarshal(data, elem); err != nil {
+		return nil, errors.Wrap(err, "unmarshaling queue element")
+	}
+	return elem, nil
+}
+
+func marshalDeterministic(msg proto.Message) ([]byte, error) {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	return data, errors.EnsureStack(err)
+}
+
+// HashSpec computes the hash of a job spec. Jobs with equal spec hashes are in the same queue.
+func HashSpec(spec *anypb.Any) ([]byte, error) {
+	data, err := marshalDeterministic(spec)
+	if err != nil {
+		return nil, err
+	}
+	sum := pachhash.Sum(data)
+	return sum[:], nil
+}
+
+// HashContext computes the hash under which a job context token is stored.
+func HashContext(token string) []byte {
+	sum := pachhash.Sum([]byte(token))
+	return sum[:]
+}
+
+// CreateJobRequest contains the information needed to create a job.
+// If Output is set, the job is created in the DONE state.
+type CreateJobRequest struct {
+	Parent     JobID
+	Spec       *anypb.Any
+	Input      *pjs.QueueElement
+	InputHash  []byte
+	Output     *pjs.QueueElement
+	CacheRead  bool
+	CacheWrite bool
+}
+
+// CreateJob inserts a new job and returns its ID.
+func CreateJob(ctx context.Context, tx *pachsql.Tx, req CreateJobRequest) (JobID, error) {
+	spec, err := marshalDeterministic(req.Spec)
+	if err != nil {
+		return 0, err
+	}
+	specHash, err := HashSpec(req.Spec)
+	if err != nil {
+		return 0, err
+	}
+	input, err := marshalDeterministic(req.Input)
+	if err != nil {
+		return 0, err
+	}
+	var output []byte
+	if req.Output != nil {
+		if output, err = marshalDeterministic(req.Output); err != nil {
+			return 0, err
+		}
+	}
+	var parent sql.NullInt64
+	if req.Parent != 0 {
+		parent = sql.NullInt64{Int64: int64(req.Parent), Valid: true}
+	}
+	var id JobID
+	if err := tx.QueryRowContext(ctx, `
+		INSERT INTO pjs.jobs (parent, spec, spec_hash, input, input_hash, output, cache_read, cache_write, done_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, CASE WHEN $6::bytea IS NULL THEN NULL ELSE CURRENT_TIMESTAMP END)
+		RETURNING id
+	`, parent, spec, specHash, input, req.InputHash, output, req.CacheRead, req.CacheWrite).Scan(&id); err != nil {
+		return 0, errors.Wrap(err, "inserting job")
+	}
+	return id, nil
+}
+
+// GetJob returns the job with the given ID.
+func GetJob(ctx context.Context, q sqlx.QueryerContext, id JobID) (*Job, error) {
+	job := &Job{}
+	if err := sqlx.GetContext(ctx, q, job, `SELECT `+jobColumns+` FROM pjs.jobs WHERE id = $1`, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, &JobNotFoundError{ID: id}
+		}
+		return nil, errors.Wrap(err, "getting job")
+	}
+	return job, nil
+}
+
+// GetJobByContext returns the job associated with a context token.
+// Only jobs which are currently processing have an associated context.
+func GetJobByContext(ctx context.Context, q sqlx.QueryerContext, token string) (*Job, error) {
+	job := &Job{}
+	if err := sqlx.GetContext(ctx, q, job, `SELECT `+jobColumns+` FROM pjs.jobs WHERE context_hash = $1`, HashContext(token)); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, status.Error(codes.PermissionDenied, "no job is associated with the provided context")
+		}
+		return nil, errors.Wrap(err, "getting job by context")
+	}
+	return job, nil
+}
+
+// ListJobChildren returns the children of a job, ordered by ID.
+// If parent is 0, then the root jobs are returned.
+func ListJobChildren(ctx context.Context, q sqlx.QueryerContext, parent JobID) ([]*Job, error) {
+	var jobs []*Job
+	query := `SELECT ` + jobColumns + ` FROM pjs.jobs WHERE parent = $1 ORDER BY id`
+	args := []any{parent}
+	if parent == 0 {
+		query = `SELECT ` + jobColumns + ` FROM pjs.jobs WHERE parent IS NULL ORDER BY id`
+		args = nil
+	}
+	if err := sqlx.SelectContext(ctx, q, &jobs, query, args...); err != nil {
+		return nil, errors.Wrap(err, "listing child jobs")
+	}
+	return jobs, nil
+}
+
+// subtreeCTE selects the ids of a job and all of its descendants into a CTE named subtree.
+// The path column orders the subtree in pre-order.
+const subtreeCTE = `
+	WITH RECURSIVE subtree(id, path) AS (
+		SELECT id, ARRAY[id] FROM pjs.jobs WHERE id = $1
+		UNION ALL
+		SELECT j.id, s.path || j.id FROM pjs.jobs j JOIN subtree s ON j.parent = s.id
+	)
+`
+
+// WalkJob returns a job and all of its descendants in pre-order.
+func WalkJob(ctx context.Context, q sqlx.QueryerContext, root JobID) ([]*Job, error) {
+	var jobs []*Job
+	if err := sqlx.SelectContext(ctx, q, &jobs, subtreeCTE+`
+		SELECT `+prefixColumns("j")+` FROM pjs.jobs j JOIN subtree s ON j.id = s.id ORDER BY s.path
+	`, root); err != nil {
+		return nil, errors.Wrap(err, "walking job")
+	}
+	if len(jobs) == 0 {
+		return nil, &JobNotFoundError{ID: root}
+	}
+	return jobs, nil
+}
+
+// IsDescendant returns true if id is ancestor or a descendant of ancestor.
+func IsDescendant(ctx context.Context, q sqlx.QueryerContext, ancestor, id JobID) (bool, error) {
+	var exists bool
+	if err := sqlx.GetContext(ctx, q, &exists, subtreeCTE+`
+		SELECT EXISTS (SELECT 1 FROM subtree WHERE id = $2)
+	`, ancestor, id); err != nil {
+		return false, errors.Wrap(err, "checking job ancestry")
+	}
+	return exists, nil
+}
+
+// CancelJob transitions a job and all of its QUEUED and PROCESSING descendants to DONE with the CANCELED error code.
+// It returns the number of jobs that were canceled.
+func CancelJob(ctx context.Context, tx *pachsql.Tx, id JobID) (int64, error) {
+	res, err := tx.ExecContext(ctx, subtreeCTE+`
+		UPDATE pjs.jobs SET done_at = CURRENT_TIMESTAMP, error = $2, context_hash = NULL
+		WHERE id IN (SELECT id FROM subtree) AND done_at IS NULL
+	`, id, int16(pjs.JobErrorCode_CANCELED))
+	if err != nil {
+		return 0, errors.Wrap(err, "canceling job")
+	}
+	n, err := res.RowsAffected()
+	return n, errors.EnsureStack(err)
+}
+
+// DeleteJob deletes a job and all of its descendants.
+// The deleted rows are returned so that the caller can release the filesets they reference.
+func DeleteJob(ctx context.Context, tx *pachsql.Tx, id JobID) ([]*Job, error) {
+	var jobs []*Job
+	if err := sqlx.SelectContext(ctx, tx, &jobs, subtreeCTE+`
+		DELETE FROM pjs.jobs WHERE id IN (SELECT id FROM subtree) RETURNING `+jobColumns, id); err != nil {
+		return nil, errors.Wrap(err, "deleting job")
+	}
+	if len(jobs) == 0 {
+		return nil, &JobNotFoundError{ID: id}
+	}
+	return jobs, nil
+}
+
+// DequeueJob claims the oldest QUEUED job in the queue identified by specHash and transitions it to PROCESSING.
+// The context token is associated with the job until it is DONE.
+// ErrQueueEmpty is returned if there are no QUEUED jobs.
+func DequeueJob(ctx context.Context, tx *pachsql.Tx, specHash []byte, token string) (*Job, error) {
+	job := &Job{}
+	if err := sqlx.GetContext(ctx, tx, job, `
+		UPDATE pjs.jobs SET processing_at = CURRENT_TIMESTAMP, context_hash = $2
+		WHERE id = (
+			SELECT id FROM pjs.jobs
+			WHERE spec_hash = $1 AND processing_at IS NULL AND done_at IS NULL
+			ORDER BY id
+			LIMIT 1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING `+jobColumns, specHash, HashContext(token)); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrQueueEmpty
+		}
+		return nil, errors.Wrap(err, "dequeuing job")
+	}
+	return job, nil
+}
+
+// CompleteJob transitions a PROCESSING job to DONE with the provided output.
+// It returns false if the job was no longer processing, e.g. because it was canceled.
+func CompleteJob(ctx context.Context, tx *pachsql.Tx, id JobID, output *pjs.QueueElement) (bool, error) {
+	data, err := marshalDeterministic(output)
+	if err != nil {
+		return false, err
+	}
+	res, err := tx.ExecContext(ctx, `
+		UPDATE pjs.jobs SET done_at = CURRENT_TIMESTAMP, output = $2, context_hash = NULL
+		WHERE id = $1 AND processing_at IS NOT NULL AND done_at IS NULL
+	`, id, data)
+	if err != nil {
+		return false, errors.Wrap(err, "completing job")
+	}
+	n, err := res.RowsAffected()
+	return n > 0, errors.EnsureStack(err)
+}
+
+// ErrorJob transitions a PROCESSING job to DONE with the provided error code.
+// It returns false if the job was no longer processing.
+func ErrorJob(ctx context.Context, tx *pachsql.Tx, id JobID, code pjs.JobErrorCode) (bool, error) {
+	res, err := tx.ExecContext(ctx, `
+		UPDATE pjs.jobs SET done_at = CURRENT_TIMESTAMP, error = $2, context_hash = NULL
+		WHERE id = $1 AND processing_at IS NOT NULL AND done_at IS NULL
+	`, id, int16(code))
+	if err != nil {
+		return false, errors.Wrap(err, "erroring job")
+	}
+	n, err := res.RowsAffected()
+	return n > 0, errors.EnsureStack(err)
+}
+
+// LookupCache returns the most recent successfully completed job with cache_write set which ran
+// the same spec against the same input.  It returns nil if there is no such job.
+func LookupCache(ctx context.Context, q sqlx.QueryerContext, specHash, inputHash []byte) (*Job, error) {
+	job := &Job{}
+	if err := sqlx.GetContext(ctx, q, job, `
+		SELECT `+jobColumns+` FROM pjs.jobs
+		WHERE spec_hash = $1 AND input_hash = $2 AND cache_write AND output IS NOT NULL AND error IS NULL
+		ORDER BY done_at DESC
+		LIMIT 1
+	`, specHash, inputHash); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "looking up cached job")
+	}
+	return job, nil
+}
+
+// Queue summarizes the jobs which share a spec.
+type Queue struct {
+	ID   []byte `db:"spec_hash"`
+	Spec []byte `db:"spec"`
+	// Size is the number of QUEUED jobs.
+	Size int64 `db:"size"`
+}
+
+// ToQueueInfo converts the queue to a pjs.QueueInfo.
+func (q *Queue) ToQueueInfo() (*pjs.QueueInfo, error) {
+	spec := &anypb.Any{}
+	if err := proto.Unmarshal(q.Spec, spec); err != nil {
+		return nil, errors.Wrap(err, "unmarshaling spec")
+	}
+	return &pjs.QueueInfo{
+		Queue: &pjs.Queue{Id: q.ID},
+		Spec:  spec,
+	}, nil
+}
+
+const queueQuery = `
+	SELECT spec_hash, (array_agg(spec))[1] AS spec,
+		count(*) FILTER (WHERE processing_at IS NULL AND done_at IS NULL) AS size
+	FROM pjs.jobs
+`
+
+// ListQueues lists every queue which has had a job created in it and not yet deleted.
+func ListQueues(ctx context.Context, q sqlx.QueryerContext) ([]*Queue, error) {
+	var queues []*Queue
+	if err := sqlx.SelectContext(ctx, q, &queues, queueQuery+` GROUP BY spec_hash ORDER BY min(id)`); err != nil {
+		return nil, errors.Wrap(err, "listing queues")
+	}
+	return queues, nil
+}
+
+// GetQueue returns the queue identified by id.
+func GetQueue(ctx context.Context, q sqlx.QueryerContext, id []byte) (*Queue, error) {
+	queue := &Queue{}
+	if err := sqlx.GetContext(ctx, q, queue, queueQuery+` WHERE spec_hash = $1 GROUP BY spec_hash`, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "queue %x not found", id)
+		}
+		return nil, errors.Wrap(err, "getting queue")
+	}
+	return queue, nil
+}
+
+func prefixColumns(table string) string {
+	prefixed := make([]string, len(columns))
+	for i, col := range columns {
+		prefixed[i] = table + "." + col
+	}
+	return strings.Join(prefixed, ", ")
+}
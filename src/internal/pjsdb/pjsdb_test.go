@@ -0,0 +1,155 @@
+//go:build unit_test
+
+package pjsdb_test
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/clusterstate"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dockertestenv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/migrations"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pctx"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pjsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/internal/testetcd"
+	"github.com/pachyderm/pachyderm/v2/src/pjs"
+)
+
+func newTestDB(t *testing.T) (context.Context, *pachsql.DB) {
+	ctx := pctx.TestContext(t)
+	db := dockertestenv.NewTestDB(t)
+	migrationEnv := migrations.Env{EtcdClient: testetcd.NewEnv(ctx, t).EtcdClient}
+	require.NoError(t, migrations.ApplyMigrations(ctx, db, migrationEnv, clusterstate.DesiredClusterState), "should be able to set up tables")
+	return ctx, db
+}
+
+func testSpec(t *testing.T, program string) *anypb.Any {
+	spec, err := anypb.New(wrapperspb.String(program))
+	require.NoError(t, err)
+	return spec
+}
+
+func createJob(ctx context.Context, t *testing.T, db *pachsql.DB, req pjsdb.CreateJobRequest) pjsdb.JobID {
+	var id pjsdb.JobID
+	require.NoError(t, dbutil.WithTx(ctx, db, func(ctx context.Context, tx *pachsql.Tx) error {
+		var err error
+		id, err = pjsdb.CreateJob(ctx, tx, req)
+		return err
+	}))
+	return id
+}
+
+func TestJobTree(t *testing.T) {
+	t.Parallel()
+	ctx, db := newTestDB(t)
+	spec := testSpec(t, "program")
+	root := createJob(ctx, t, db, pjsdb.CreateJobRequest{Spec: spec, Input: &pjs.QueueElement{Data: []byte("root")}})
+	child1 := createJob(ctx, t, db, pjsdb.CreateJobRequest{Parent: root, Spec: spec, Input: &pjs.QueueElement{}})
+	child2 := createJob(ctx, t, db, pjsdb.CreateJobRequest{Parent: root, Spec: spec, Input: &pjs.QueueElement{}})
+	grandchild := createJob(ctx, t, db, pjsdb.CreateJobRequest{Parent: child1, Spec: spec, Input: &pjs.QueueElement{}})
+
+	children, err := pjsdb.ListJobChildren(ctx, db, root)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(children))
+	require.Equal(t, child1, children[0].ID)
+	require.Equal(t, child2, children[1].ID)
+
+	walk, err := pjsdb.WalkJob(ctx, db, root)
+	require.NoError(t, err)
+	var ids []pjsdb.JobID
+	for _, j := range walk {
+		ids = append(ids, j.ID)
+	}
+	require.Equal(t, []pjsdb.JobID{root, child1, grandchild, child2}, ids)
+
+	ok, err := pjsdb.IsDescendant(ctx, db, root, grandchild)
+	require.NoError(t, err)
+	require.True(t, ok)
+	ok, err = pjsdb.IsDescendant(ctx, db, child2, grandchild)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	info, err := walk[0].ToJobInfo()
+	require.NoError(t, err)
+	require.Equal(t, pjs.JobState_QUEUED, info.State)
+	require.Equal(t, []byte("root"), info.Input.Data)
+	require.Nil(t, info.ParentJob)
+}
+
+func TestProcessJob(t *testing.T) {
+	t.Parallel()
+	ctx, db := newTestDB(t)
+	spec := testSpec(t, "program")
+	specHash, err := pjsdb.HashSpec(spec)
+	require.NoError(t, err)
+	id := createJob(ctx, t, db, pjsdb.CreateJobRequest{Spec: spec, Input: &pjs.QueueElement{}, InputHash: []byte("input"), CacheWrite: true})
+
+	queue, err := pjsdb.GetQueue(ctx, db, specHash)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), queue.Size)
+
+	require.NoError(t, dbutil.WithTx(ctx, db, func(ctx context.Context, tx *pachsql.Tx) error {
+		job, err := pjsdb.DequeueJob(ctx, tx, specHash, "token")
+		require.NoError(t, err)
+		require.Equal(t, id, job.ID)
+		require.Equal(t, pjs.JobState_PROCESSING, job.State())
+		_, err = pjsdb.DequeueJob(ctx, tx, specHash, "token2")
+		require.ErrorIs(t, err, pjsdb.ErrQueueEmpty)
+		return nil
+	}))
+	job, err := pjsdb.GetJobByContext(ctx, db, "token")
+	require.NoError(t, err)
+	require.Equal(t, id, job.ID)
+
+	require.NoError(t, dbutil.WithTx(ctx, db, func(ctx context.Context, tx *pachsql.Tx) error {
+		ok, err := pjsdb.CompleteJob(ctx, tx, id, &pjs.QueueElement{Data: []byte("output")})
+		require.NoError(t, err)
+		require.True(t, ok)
+		return nil
+	}))
+	_, err = pjsdb.GetJobByContext(ctx, db, "token")
+	require.YesError(t, err)
+
+	cached, err := pjsdb.LookupCache(ctx, db, specHash, []byte("input"))
+	require.NoError(t, err)
+	require.NotNil(t, cached)
+	output, err := cached.OutputElement()
+	require.NoError(t, err)
+	require.Equal(t, []byte("output"), output.Data)
+}
+
+func TestCancelAndDeleteJob(t *testing.T) {
+	t.Parallel()
+	ctx, db := newTestDB(t)
+	spec := testSpec(t, "program")
+	root := createJob(ctx, t, db, pjsdb.CreateJobRequest{Spec: spec, Input: &pjs.QueueElement{}})
+	child := createJob(ctx, t, db, pjsdb.CreateJobRequest{Parent: root, Spec: spec, Input: &pjs.QueueElement{}})
+
+	require.NoError(t, dbutil.WithTx(ctx, db, func(ctx context.Context, tx *pachsql.Tx) error {
+		n, err := pjsdb.CancelJob(ctx, tx, root)
+		require.NoError(t, err)
+		require.Equal(t, int64(2), n)
+		return nil
+	}))
+	job, err := pjsdb.GetJob(ctx, db, child)
+	require.NoError(t, err)
+	info, err := job.ToJobInfo()
+	require.NoError(t, err)
+	require.Equal(t, pjs.JobState_DONE, info.State)
+	require.Equal(t, pjs.JobErrorCode_CANCELED, info.GetError())
+
+	require.NoError(t, dbutil.WithTx(ctx, db, func(ctx context.Context, tx *pachsql.Tx) error {
+		deleted, err := pjsdb.DeleteJob(ctx, tx, root)
+		require.NoError(t, err)
+		require.Equal(t, 2, len(deleted))
+		return nil
+	}))
+	_, err = pjsdb.GetJob(ctx, db, child)
+	require.ErrorIs(t, err, &pjsdb.JobNotFoundError{})
+}
@@ -0,0 +1,164 @@
+package ppsutil
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/obj"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfsfile"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+)
+
+// bucketETagKey is the metadata key of a reference file that holds the ETag
+// of its object, so snapshots can be compared without reading every file.
+const bucketETagKey = "bucket-etag"
+
+// bucketSecretRoot is where workers mount the secrets of their bucket inputs.
+const bucketSecretRoot = "/pach-bucket-secrets"
+
+// BucketObject is the content of the reference file that stands in for an
+// object in a bucket input's repo.
+type BucketObject struct {
+	URL  string `json:"url"`
+	Key  string `json:"key"`
+	ETag string `json:"etag"`
+	Size int64  `json:"size"`
+}
+
+// ParseBucketObject parses the content of a reference file.
+func ParseBucketObject(data []byte) (*BucketObject, error) {
+	o := &BucketObject{}
+	if err := json.Unmarshal(data, o); err != nil {
+		return nil, errors.Wrapf(err, "parse bucket object reference")
+	}
+	return o, nil
+}
+
+// BucketSecretDir returns the directory where workers mount the secret of in.
+func BucketSecretDir(in *pps.BucketInput) string {
+	return path.Join(bucketSecretRoot, in.Secret)
+}
+
+// ReadBucketSecret reads the secret of in from BucketSecretDir.  A bucket
+// input without a secret has no credentials.
+func ReadBucketSecret(in *pps.BucketInput) (map[string][]byte, error) {
+	if in.Secret == "" {
+		return nil, nil
+	}
+	entries, err := os.ReadDir(BucketSecretDir(in))
+	if err != nil {
+		return nil, errors.Wrapf(err, "read secret %s of bucket %s", in.Secret, in.Url)
+	}
+	secret := make(map[string][]byte)
+	for _, e := range entries {
+		// Kubernetes keeps the secret's data in hidden directories, and links
+		// each key to it.
+		if strings.HasPrefix(e.Name(), "..") {
+			continue
+		}
+		data, err := os.ReadFile(path.Join(BucketSecretDir(in), e.Name()))
+		if err != nil {
+			return nil, errors.Wrapf(err, "read secret %s of bucket %s", in.Secret, in.Url)
+		}
+		secret[e.Name()] = data
+	}
+	return secret, nil
+}
+
+// BucketObjectPath returns the path of the reference file for the object at
+// key, under the prefix of a bucket input's URL.
+func BucketObjectPath(prefix, key string) string {
+	return pfsfile.CleanPath(strings.TrimPrefix(key, prefix))
+}
+
+// ListBucket lists the objects under the prefix of in's URL, keyed by the
+// path of their reference files, which is the object's key relative to the
+// prefix.
+func ListBucket(ctx context.Context, c obj.Client, in *pps.BucketInput) (map[string]*BucketObject, error) {
+	u, err := obj.ParseURL(in.Url)
+	if err != nil {
+		return nil, err
+	}
+	objects := make(map[string]*BucketObject)
+	if err := c.WalkInfo(ctx, u.Object, func(info obj.ObjectInfo) error {
+		p := strings.TrimPrefix(info.Name, u.Object)
+		// Keys ending in a slash are directory markers, not objects.
+		if p == "" || strings.HasSuffix(p, "/") {
+			return nil
+		}
+		objects[BucketObjectPath(u.Object, info.Name)] = &BucketObject{
+			URL:  in.Url,
+			Key:  info.Name,
+			ETag: info.ETag,
+			Size: info.Size,
+		}
+		return nil
+	}); err != nil {
+		return nil, errors.Wrapf(err, "list bucket %s", in.Url)
+	}
+	return objects, nil
+}
+
+// SnapshotBucket lists the objects under the prefix of in's URL and commits
+// the listing to in's repo, unless nothing changed since the last snapshot.
+// It reports whether it made a commit.
+func SnapshotBucket(ctx context.Context, pachClient *client.APIClient, c obj.Client, in *pps.BucketInput) (bool, error) {
+	objects, err := ListBucket(ctx, c, in)
+	if err != nil {
+		return false, err
+	}
+	commit := client.NewRepo(in.Project, in.Repo).NewCommit("master", "")
+	var deletes []string
+	if err := pachClient.GlobFile(commit, "**", func(fi *pfs.FileInfo) error {
+		if fi.FileType != pfs.FileType_FILE {
+			return nil
+		}
+		o, ok := objects[fi.File.Path]
+		switch {
+		case !ok:
+			deletes = append(deletes, fi.File.Path)
+		case o.ETag == fi.Metadata[bucketETagKey]:
+			delete(objects, fi.File.Path)
+		}
+		return nil
+	}); err != nil {
+		return false, errors.Wrapf(err, "glob snapshot of bucket %s", in.Url)
+	}
+	if len(deletes) == 0 && len(objects) == 0 {
+		return false, nil
+	}
+	puts := make([]string, 0, len(objects))
+	for p := range objects {
+		puts = append(puts, p)
+	}
+	sort.Strings(puts)
+	if err := pachClient.WithModifyFileClient(commit, func(m client.ModifyFile) error {
+		for _, p := range deletes {
+			if err := m.DeleteFile(p); err != nil {
+				return errors.Wrapf(err, "DeleteFile(%s)", p)
+			}
+		}
+		for _, p := range puts {
+			o := objects[p]
+			data, err := json.Marshal(o)
+			if err != nil {
+				return errors.EnsureStack(err)
+			}
+			if err := m.PutFile(p, bytes.NewReader(data), client.WithMetadataPutFile(map[string]string{bucketETagKey: o.ETag})); err != nil {
+				return errors.Wrapf(err, "PutFile(%s)", p)
+			}
+		}
+		return nil
+	}); err != nil {
+		return false, errors.Wrap(err, "WithModifyFileClient")
+	}
+	return true, nil
+}
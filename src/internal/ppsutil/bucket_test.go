@@ -0,0 +1,53 @@
+package ppsutil_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/obj"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pctx"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ppsutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+)
+
+func TestListBucket(t *testing.T) {
+	ctx := pctx.TestContext(t)
+	c, err := obj.NewLocalClient(t.TempDir())
+	require.NoError(t, err)
+	for name, data := range map[string]string{
+		"data/a":     "foo",
+		"data/b/c":   "foo bar",
+		"other/file": "baz",
+	} {
+		require.NoError(t, c.Put(ctx, name, bytes.NewReader([]byte(data))))
+	}
+	u := c.BucketURL()
+	u.Object = "data"
+	in := &pps.BucketInput{Url: u.String()}
+	// The URL must round trip to the same local bucket.
+	pu, err := obj.ParseURL(in.Url)
+	require.NoError(t, err)
+	c2, err := obj.NewClientFromURLAndSecret(ctx, pu)
+	require.NoError(t, err)
+
+	objects, err := ppsutil.ListBucket(ctx, c2, in)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(objects))
+	a, ok := objects["/a"]
+	require.True(t, ok)
+	require.Equal(t, "data/a", a.Key)
+	require.Equal(t, int64(3), a.Size)
+	require.Equal(t, in.Url, a.URL)
+	c3, ok := objects["/b/c"]
+	require.True(t, ok)
+	require.Equal(t, "data/b/c", c3.Key)
+	require.Equal(t, int64(7), c3.Size)
+
+	data, err := json.Marshal(a)
+	require.NoError(t, err)
+	parsed, err := ppsutil.ParseBucketObject(data)
+	require.NoError(t, err)
+	require.Equal(t, a, parsed)
+}
@@ -18,6 +18,7 @@ import (
 	"crypto/md5"
 	"fmt"
 	"io"
+	"os"
 	"strings"
 	"time"
 
@@ -41,6 +42,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/tracing"
 	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv"
 	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
+	"github.com/pachyderm/pachyderm/v2/src/internal/webhookdb"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
 	"github.com/pachyderm/pachyderm/v2/src/pps"
 	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
@@ -221,6 +223,11 @@ func SetPipelineState(ctx context.Context, db *pachsql.DB, pipelinesCollection c
 			}
 		}
 		resultMessage = fmt.Sprintf("SetPipelineState moved pipeline %s from %s to %s", pipeline, pipelineInfo.State, to)
+		if pipelineInfo.State != to {
+			if err := webhookdb.PipelineStateChanged(cbCtx, sqlTx, pipeline, pipelineInfo.State, to, reason); err != nil {
+				return err
+			}
+		}
 		// PipelineState_PIPELINE_CRASHING is a special case, because it can be using resources up.
 		if to == pps.PipelineState_PIPELINE_RUNNING || to == pps.PipelineState_PIPELINE_CRASHING {
 			pipelineInfo.Details.WorkersStartedAt = timestamppb.Now()
@@ -246,12 +253,16 @@ func JobInput(pipelineInfo *pps.PipelineInfo, outputCommit *pfs.Commit) *pps.Inp
 	commitsetID := outputCommit.Id
 	jobInput := proto.Clone(pipelineInfo.Details.Input).(*pps.Input)
 	pps.VisitInput(jobInput, func(input *pps.Input) error { //nolint:errcheck
-		if input.Pfs != nil {
+		// An input that names a tag always reads the tagged commit.
+		if input.Pfs != nil && input.Pfs.Commit == "" {
 			input.Pfs.Commit = commitsetID
 		}
 		if input.Cron != nil {
 			input.Cron.Commit = commitsetID
 		}
+		if input.Bucket != nil {
+			input.Bucket.Commit = commitsetID
+		}
 		return nil
 	})
 	return jobInput
@@ -295,8 +306,9 @@ func PipelineReqFromInfo(pipelineInfo *pps.PipelineInfo) *pps.CreatePipelineRequ
 	}
 }
 
-// UpdateJobState performs the operations involved with a job state transition.
-func UpdateJobState(pipelines col.PostgresReadWriteCollection, jobs col.ReadWriteCollection, jobInfo *pps.JobInfo, state pps.JobState, reason string) error {
+// UpdateJobState performs the operations involved with a job state transition.  If the job
+// fails, webhooks are notified in sqlTx.
+func UpdateJobState(ctx context.Context, sqlTx *pachsql.Tx, pipelines col.PostgresReadWriteCollection, jobs col.ReadWriteCollection, jobInfo *pps.JobInfo, state pps.JobState, reason string) error {
 	// Check if this is a new job
 	if jobInfo.State != pps.JobState_JOB_STATE_UNKNOWN {
 		if pps.IsTerminal(jobInfo.State) {
@@ -316,7 +328,13 @@ func UpdateJobState(pipelines col.PostgresReadWriteCollection, jobs col.ReadWrit
 	}
 	jobInfo.State = state
 	jobInfo.Reason = reason
-	return errors.Wrapf(jobs.Put(ppsdb.JobKey(jobInfo.Job), jobInfo), "put job %v", ppsdb.JobKey(jobInfo.Job))
+	if err := jobs.Put(ppsdb.JobKey(jobInfo.Job), jobInfo); err != nil {
+		return errors.Wrapf(err, "put job %v", ppsdb.JobKey(jobInfo.Job))
+	}
+	if state == pps.JobState_JOB_FAILURE {
+		return webhookdb.JobFailed(ctx, sqlTx, jobInfo)
+	}
+	return nil
 }
 
 func FinishJob(pachClient *client.APIClient, jobInfo *pps.JobInfo, state pps.JobState, reason string) (retErr error) {
@@ -366,16 +384,20 @@ func FinishJob(pachClient *client.APIClient, jobInfo *pps.JobInfo, state pps.Job
 
 func WriteJobInfo(pachClient *client.APIClient, jobInfo *pps.JobInfo) error {
 	_, err := pachClient.PpsAPIClient.UpdateJobState(pachClient.Ctx(), &pps.UpdateJobStateRequest{
-		Job:           jobInfo.Job,
-		State:         jobInfo.State,
-		Reason:        jobInfo.Reason,
-		Restart:       jobInfo.Restart,
-		DataProcessed: jobInfo.DataProcessed,
-		DataSkipped:   jobInfo.DataSkipped,
-		DataTotal:     jobInfo.DataTotal,
-		DataFailed:    jobInfo.DataFailed,
-		DataRecovered: jobInfo.DataRecovered,
-		Stats:         jobInfo.Stats,
+		Job:             jobInfo.Job,
+		State:           jobInfo.State,
+		Reason:          jobInfo.Reason,
+		Restart:         jobInfo.Restart,
+		DataProcessed:   jobInfo.DataProcessed,
+		DataSkipped:     jobInfo.DataSkipped,
+		DataTotal:       jobInfo.DataTotal,
+		DataFailed:      jobInfo.DataFailed,
+		DataRecovered:   jobInfo.DataRecovered,
+		DataQuarantined: jobInfo.DataQuarantined,
+		DataCacheHits:   jobInfo.DataCacheHits,
+		DataCacheMisses: jobInfo.DataCacheMisses,
+		WindowInputs:    jobInfo.WindowInputs,
+		Stats:           jobInfo.Stats,
 	})
 	return errors.EnsureStack(err)
 }
@@ -384,6 +406,23 @@ func MetaCommit(commit *pfs.Commit) *pfs.Commit {
 	return pfsutil.MetaCommit(commit)
 }
 
+// LocalHostEnv lists the variables of the host's environment that workers run
+// as local processes, and their user code, inherit.  Nothing else leaks in from
+// pachd's environment.
+var LocalHostEnv = []string{"PATH", "HOME", "USER", "LANG", "TZ", "TMPDIR"}
+
+// HostEnv returns the variables named in names that are set in the current
+// process's environment, in os.Environ's NAME=value form.
+func HostEnv(names []string) []string {
+	var env []string
+	for _, name := range names {
+		if value, ok := os.LookupEnv(name); ok {
+			env = append(env, name+"="+value)
+		}
+	}
+	return env
+}
+
 // ContainsS3Inputs returns 'true' if 'in' is or contains any PFS inputs with
 // 'S3' set to true. Any pipelines with s3 inputs lj
 func ContainsS3Inputs(in *pps.Input) bool {
@@ -591,6 +630,14 @@ func validateNames(names map[string]bool, input *pps.Input) error {
 			return errors.Errorf(`name "%s" was used more than once`, input.Cron.Name)
 		}
 		names[input.Cron.Name] = true
+	case input.Bucket != nil:
+		if err := validateName(input.Bucket.Name); err != nil {
+			return err
+		}
+		if names[input.Bucket.Name] {
+			return errors.Errorf(`name "%s" was used more than once`, input.Bucket.Name)
+		}
+		names[input.Bucket.Name] = true
 	case input.Union != nil:
 		for _, input := range input.Union {
 			namesCopy := make(map[string]bool)
@@ -0,0 +1,495 @@
+package sdata
+
+import (
+	"database/sql"
+	"encoding/json"
+	"io"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/parquet-go/parquet-go"
+	"github.com/parquet-go/parquet-go/format"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+)
+
+// parquetBatchSize is the number of rows a ParquetParser reads from the file at a time.
+const parquetBatchSize = 128
+
+// ParquetSchemaFromTableInfo returns a flat Parquet schema with one column for each column in info.
+func ParquetSchemaFromTableInfo(info *pachsql.TableInfo) (*parquet.Schema, error) {
+	return parquetSchema(info.Name, info.Columns)
+}
+
+// ParquetSchemaFromColumnTypes returns a flat Parquet schema with one column for each of cTypes.
+// It is intended for writing the results of a query.
+func ParquetSchemaFromColumnTypes(cTypes []*sql.ColumnType) (*parquet.Schema, error) {
+	cols := make([]pachsql.ColumnInfo, len(cTypes))
+	for i, cType := range cTypes {
+		nullable, ok := cType.Nullable()
+		if !ok {
+			nullable = true
+		}
+		cols[i] = pachsql.ColumnInfo{
+			Name:       cType.Name(),
+			DataType:   columnTypeName(cType),
+			IsNullable: nullable,
+		}
+	}
+	return parquetSchema("query", cols)
+}
+
+func parquetSchema(name string, cols []pachsql.ColumnInfo) (*parquet.Schema, error) {
+	group := make(parquet.Group, len(cols))
+	for _, col := range cols {
+		if _, exists := group[col.Name]; exists {
+			return nil, errors.Errorf("duplicate column %q", col.Name)
+		}
+		node, err := makeParquetNode(col.DataType)
+		if err != nil {
+			return nil, errors.Wrapf(err, "column %q", col.Name)
+		}
+		if col.IsNullable {
+			node = parquet.Optional(node)
+		}
+		group[col.Name] = node
+	}
+	return parquet.NewSchema(name, group), nil
+}
+
+// makeParquetNode maps a SQL data type to a Parquet column type.  The set of
+// types is the same as the set supported by makeTupleElement.
+func makeParquetNode(dbType string) (parquet.Node, error) {
+	switch dbType {
+	case "BOOL", "BOOLEAN":
+		return parquet.Leaf(parquet.BooleanType), nil
+	case "SMALLINT", "INT2":
+		return parquet.Int(16), nil
+	case "INTEGER", "INT", "INT4":
+		return parquet.Int(32), nil
+	case "BIGINT", "INT8":
+		return parquet.Int(64), nil
+	case "UNSIGNED SMALLINT", "UNSIGNED INT2":
+		return parquet.Uint(16), nil
+	case "UNSIGNED INTEGER", "UNSIGNED INT", "UNSIGNED INT4":
+		return parquet.Uint(32), nil
+	case "UNSIGNED BIGINT", "UNSIGNED INT8":
+		return parquet.Uint(64), nil
+	case "FLOAT4", "REAL":
+		return parquet.Leaf(parquet.FloatType), nil
+	case "FLOAT", "FLOAT8", "DOUBLE PRECISION":
+		return parquet.Leaf(parquet.DoubleType), nil
+	// The precision and scale of these types is not known here, so they are
+	// stored as strings to avoid losing precision.
+	case "NUMERIC", "DECIMAL", "NUMBER", "FIXED":
+		return parquet.String(), nil
+	case "VARCHAR", "TEXT", "CHARACTER VARYING":
+		return parquet.String(), nil
+	case "DATE":
+		return parquet.Date(), nil
+	case "TIME":
+		return parquet.Time(parquet.Microsecond), nil
+	case "TIMESTAMP", "TIMESTAMP_LTZ", "TIMESTAMP_NTZ", "TIMESTAMP_TZ", "TIMESTAMPTZ", "TIMESTAMP WITH TIME ZONE", "TIMESTAMP WITHOUT TIME ZONE":
+		return parquet.Timestamp(parquet.Microsecond), nil
+	case "VARIANT":
+		return parquet.JSON(), nil
+	default:
+		return nil, errors.Errorf("unrecognized type: %v", dbType)
+	}
+}
+
+// ParquetWriter writes Tuples as rows of a Parquet file.
+//
+// Parquet files end with a footer describing their contents, so Flush
+// finishes the file and no more tuples can be written afterwards.
+type ParquetWriter struct {
+	pw      *parquet.Writer
+	columns []parquet.LeafColumn
+	row     parquet.Row
+}
+
+// NewParquetWriter returns a ParquetWriter writing to w.  The fields of each
+// Tuple are written to the columns of schema named by fieldNames.
+func NewParquetWriter(w io.Writer, schema *parquet.Schema, fieldNames []string) (*ParquetWriter, error) {
+	columns, err := lookupParquetColumns(schema, fieldNames)
+	if err != nil {
+		return nil, err
+	}
+	return &ParquetWriter{
+		pw:      parquet.NewWriter(w, schema),
+		columns: columns,
+		row:     make(parquet.Row, len(schema.Columns())),
+	}, nil
+}
+
+func (m *ParquetWriter) WriteTuple(row Tuple) error {
+	if len(row) != len(m.columns) {
+		return ErrTupleFields{Writer: m, Tuple: row}
+	}
+	for i := range m.row {
+		m.row[i] = parquet.NullValue().Level(0, 0, i)
+	}
+	for i, col := range m.columns {
+		v, err := toParquetValue(col, row[i])
+		if err != nil {
+			return errors.Wrapf(err, "column %q", col.Path[0])
+		}
+		m.row[col.ColumnIndex] = v
+	}
+	_, err := m.pw.WriteRows([]parquet.Row{m.row})
+	return errors.EnsureStack(err)
+}
+
+func (m *ParquetWriter) Flush() error {
+	return errors.EnsureStack(m.pw.Close())
+}
+
+func toParquetValue(col parquet.LeafColumn, x interface{}) (parquet.Value, error) {
+	x, err := derefTupleElement(x)
+	if err != nil {
+		return parquet.Value{}, err
+	}
+	if x == nil {
+		if col.MaxDefinitionLevel == 0 {
+			return parquet.Value{}, errors.Errorf("null value for required column")
+		}
+		return parquet.NullValue().Level(0, 0, col.ColumnIndex), nil
+	}
+	v, err := makeParquetValue(col.Node.Type(), x)
+	if err != nil {
+		return parquet.Value{}, err
+	}
+	return v.Level(0, col.MaxDefinitionLevel, col.ColumnIndex), nil
+}
+
+func makeParquetValue(typ parquet.Type, x interface{}) (parquet.Value, error) {
+	if lt := typ.LogicalType(); lt != nil {
+		switch {
+		case lt.Date != nil:
+			var t time.Time
+			if err := asTime(&t, x); err != nil {
+				return parquet.Value{}, err
+			}
+			return parquet.Int32Value(unixDays(t)), nil
+		case lt.Time != nil:
+			var t time.Time
+			if err := asTime(&t, x); err != nil {
+				return parquet.Value{}, err
+			}
+			sinceMidnight := t.Sub(time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location()))
+			return makeTimeValue(typ, sinceMidnight/timeUnitDuration(lt.Time.Unit)), nil
+		case lt.Timestamp != nil:
+			var t time.Time
+			if err := asTime(&t, x); err != nil {
+				return parquet.Value{}, err
+			}
+			return parquet.Int64Value(unixTimestamp(t, timeUnitDuration(lt.Timestamp.Unit))), nil
+		case lt.Integer != nil && !lt.Integer.IsSigned && typ.Kind() == parquet.Int32:
+			var i int64
+			if err := asInt64(&i, x); err != nil {
+				return parquet.Value{}, err
+			}
+			if i < 0 || i > math.MaxUint32 {
+				return parquet.Value{}, errors.Errorf("%d is out of range for an unsigned 32-bit integer", i)
+			}
+			return parquet.Int32Value(int32(uint32(i))), nil
+		case lt.Json != nil:
+			if _, ok := x.(string); !ok {
+				js, err := json.Marshal(x)
+				if err != nil {
+					return parquet.Value{}, errors.EnsureStack(err)
+				}
+				x = string(js)
+			}
+		}
+	}
+	switch typ.Kind() {
+	case parquet.Boolean:
+		var b bool
+		if err := asBool(&b, x); err != nil {
+			return parquet.Value{}, err
+		}
+		return parquet.BooleanValue(b), nil
+	case parquet.Int32:
+		var i int32
+		if err := asInt32(&i, x); err != nil {
+			return parquet.Value{}, err
+		}
+		return parquet.Int32Value(i), nil
+	case parquet.Int64:
+		if u, ok := x.(uint64); ok {
+			return parquet.Int64Value(int64(u)), nil
+		}
+		var i int64
+		if err := asInt64(&i, x); err != nil {
+			return parquet.Value{}, err
+		}
+		return parquet.Int64Value(i), nil
+	case parquet.Float:
+		var f float64
+		if err := asFloat64(&f, x); err != nil {
+			return parquet.Value{}, err
+		}
+		return parquet.FloatValue(float32(f)), nil
+	case parquet.Double:
+		var f float64
+		if err := asFloat64(&f, x); err != nil {
+			return parquet.Value{}, err
+		}
+		return parquet.DoubleValue(f), nil
+	case parquet.ByteArray:
+		var s string
+		if err := asString(&s, x); err != nil {
+			return parquet.Value{}, err
+		}
+		return parquet.ByteArrayValue([]byte(s)), nil
+	default:
+		return parquet.Value{}, errors.Errorf("unsupported parquet type %v", typ)
+	}
+}
+
+func makeTimeValue(typ parquet.Type, n time.Duration) parquet.Value {
+	if typ.Kind() == parquet.Int32 {
+		return parquet.Int32Value(int32(n))
+	}
+	return parquet.Int64Value(int64(n))
+}
+
+// derefTupleElement returns the value pointed to by a Tuple element, or nil if the
+// element is an invalid sql.Null* value.
+func derefTupleElement(x interface{}) (interface{}, error) {
+	switch x := x.(type) {
+	case nil:
+		return nil, nil
+	case *bool:
+		return *x, nil
+	case *int16:
+		return int64(*x), nil
+	case *int32:
+		return int64(*x), nil
+	case *int64:
+		return *x, nil
+	case *uint64:
+		return *x, nil
+	case *float32:
+		return float64(*x), nil
+	case *float64:
+		return *x, nil
+	case *string:
+		return *x, nil
+	case *sql.RawBytes:
+		return string(*x), nil
+	case *time.Time:
+		return *x, nil
+	case *interface{}:
+		return *x, nil
+	case *sql.NullBool:
+		if !x.Valid {
+			return nil, nil
+		}
+		return x.Bool, nil
+	case *sql.NullByte:
+		if !x.Valid {
+			return nil, nil
+		}
+		return int64(x.Byte), nil
+	case *sql.NullInt16:
+		if !x.Valid {
+			return nil, nil
+		}
+		return int64(x.Int16), nil
+	case *sql.NullInt32:
+		if !x.Valid {
+			return nil, nil
+		}
+		return int64(x.Int32), nil
+	case *sql.NullInt64:
+		if !x.Valid {
+			return nil, nil
+		}
+		return x.Int64, nil
+	case *sql.NullFloat64:
+		if !x.Valid {
+			return nil, nil
+		}
+		return x.Float64, nil
+	case *sql.NullString:
+		if !x.Valid {
+			return nil, nil
+		}
+		return x.String, nil
+	case *sql.NullTime:
+		if !x.Valid {
+			return nil, nil
+		}
+		return x.Time, nil
+	default:
+		return nil, errors.Errorf("unrecognized value (%v: %T)", x, x)
+	}
+}
+
+// A ParquetParser reads rows from a Parquet file into tuples.
+type ParquetParser struct {
+	r       *parquet.Reader
+	columns []parquet.LeafColumn
+
+	rows []parquet.Row
+	n, i int
+}
+
+// NewParquetParser returns a ParquetParser reading the Parquet file in r,
+// which is size bytes long.  The columns named by fieldNames are read into
+// the fields of each Tuple.
+func NewParquetParser(r io.ReaderAt, size int64, fieldNames []string) (*ParquetParser, error) {
+	f, err := parquet.OpenFile(r, size)
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	columns, err := lookupParquetColumns(f.Schema(), fieldNames)
+	if err != nil {
+		return nil, err
+	}
+	return &ParquetParser{
+		r:       parquet.NewReader(f),
+		columns: columns,
+		rows:    make([]parquet.Row, parquetBatchSize),
+	}, nil
+}
+
+// Next reads one row of the Parquet file into the Tuple.
+func (p *ParquetParser) Next(row Tuple) error {
+	if len(row) != len(p.columns) {
+		return ErrTupleFields{Tuple: row}
+	}
+	if p.i >= p.n {
+		if err := p.readRows(); err != nil {
+			return err
+		}
+	}
+	values := p.rows[p.i]
+	p.i++
+	for i, col := range p.columns {
+		x, err := fromParquetValue(col.Node.Type(), values[col.ColumnIndex])
+		if err != nil {
+			return errors.Wrapf(err, "column %q", col.Path[0])
+		}
+		if err := convert(row[i], x); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *ParquetParser) readRows() error {
+	n, err := p.r.ReadRows(p.rows)
+	p.n, p.i = n, 0
+	if n > 0 {
+		// ReadRows may return io.EOF along with the last rows of the file.
+		return nil
+	}
+	if err == nil {
+		err = io.EOF
+	}
+	return errors.EnsureStack(err)
+}
+
+// fromParquetValue converts a Parquet value into a value accepted by convert,
+// or nil if the value is null.
+func fromParquetValue(typ parquet.Type, v parquet.Value) (interface{}, error) {
+	if v.IsNull() {
+		return nil, nil
+	}
+	if lt := typ.LogicalType(); lt != nil {
+		switch {
+		case lt.Date != nil:
+			return time.Unix(int64(v.Int32())*24*60*60, 0).UTC(), nil
+		case lt.Time != nil:
+			n := v.Int64()
+			if v.Kind() == parquet.Int32 {
+				n = int64(v.Int32())
+			}
+			return time.Time{}.Add(time.Duration(n) * timeUnitDuration(lt.Time.Unit)), nil
+		case lt.Timestamp != nil:
+			return fromUnixTimestamp(v.Int64(), timeUnitDuration(lt.Timestamp.Unit)), nil
+		case lt.Integer != nil && !lt.Integer.IsSigned && v.Kind() == parquet.Int32:
+			return int64(v.Uint32()), nil
+		case lt.Integer != nil && !lt.Integer.IsSigned && v.Kind() == parquet.Int64:
+			return strconv.FormatUint(v.Uint64(), 10), nil
+		}
+	}
+	switch v.Kind() {
+	case parquet.Boolean:
+		return v.Boolean(), nil
+	case parquet.Int32:
+		return int64(v.Int32()), nil
+	case parquet.Int64:
+		return v.Int64(), nil
+	case parquet.Float:
+		return float64(v.Float()), nil
+	case parquet.Double:
+		return v.Double(), nil
+	case parquet.ByteArray, parquet.FixedLenByteArray:
+		return string(v.ByteArray()), nil
+	default:
+		return nil, errors.Errorf("unsupported parquet type %v", typ)
+	}
+}
+
+func lookupParquetColumns(schema *parquet.Schema, fieldNames []string) ([]parquet.LeafColumn, error) {
+	columns := make([]parquet.LeafColumn, len(fieldNames))
+	for i, name := range fieldNames {
+		col, ok := schema.Lookup(name)
+		if !ok {
+			return nil, errors.Errorf("parquet schema has no column %q", name)
+		}
+		if col.MaxRepetitionLevel > 0 {
+			return nil, errors.Errorf("repeated parquet column %q is not supported", name)
+		}
+		columns[i] = col
+	}
+	return columns, nil
+}
+
+func timeUnitDuration(u format.TimeUnit) time.Duration {
+	switch {
+	case u.Millis != nil:
+		return time.Millisecond
+	case u.Micros != nil:
+		return time.Microsecond
+	default:
+		return time.Nanosecond
+	}
+}
+
+const secondsPerDay = 24 * 60 * 60
+
+// unixDays returns the number of days between the Unix epoch and t, rounded
+// down so that times before the epoch fall on the right day.
+func unixDays(t time.Time) int32 {
+	s := t.Unix()
+	days := s / secondsPerDay
+	if s%secondsPerDay < 0 {
+		days--
+	}
+	return int32(days)
+}
+
+// unixTimestamp returns t as a count of unit since the Unix epoch.  Unlike
+// t.UnixNano, it does not overflow for times outside of the years 1678 to 2262
+// when unit is coarser than a nanosecond.
+func unixTimestamp(t time.Time, unit time.Duration) int64 {
+	return t.Unix()*int64(time.Second/unit) + int64(t.Nanosecond())/int64(unit)
+}
+
+// fromUnixTimestamp is the inverse of unixTimestamp.
+func fromUnixTimestamp(n int64, unit time.Duration) time.Time {
+	perSecond := int64(time.Second / unit)
+	sec, rem := n/perSecond, n%perSecond
+	if rem < 0 {
+		sec--
+		rem += perSecond
+	}
+	return time.Unix(sec, rem*int64(unit)).UTC()
+}
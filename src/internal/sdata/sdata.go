@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"io"
 	"reflect"
+	"strings"
 	"time"
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
@@ -77,7 +78,7 @@ func MaterializeSQL(tw TupleWriter, rows *sql.Rows) (*MaterializationResult, err
 func NewTupleFromColumnTypes(cTypes []*sql.ColumnType) (Tuple, error) {
 	row := make(Tuple, len(cTypes))
 	for i, cType := range cTypes {
-		dbType := cType.DatabaseTypeName()
+		dbType := columnTypeName(cType)
 		nullable, ok := cType.Nullable()
 		if !ok {
 			nullable = true
@@ -91,6 +92,17 @@ func NewTupleFromColumnTypes(cTypes []*sql.ColumnType) (Tuple, error) {
 	return row, nil
 }
 
+// columnTypeName returns the database type of the column, without any length or precision.
+// Most drivers already report bare type names, but the SQLite driver reports the type as
+// declared, e.g. NUMERIC(20,0).
+func columnTypeName(cType *sql.ColumnType) string {
+	name := cType.DatabaseTypeName()
+	if i := strings.IndexByte(name, '('); i >= 0 {
+		name = strings.TrimSpace(name[:i])
+	}
+	return strings.ToUpper(name)
+}
+
 // Copy copies a tuple from r to w. Row is used to indicate the correct shape of read data.
 func Copy(w TupleWriter, r TupleReader, row Tuple) (n int, _ error) {
 	for {
@@ -5,15 +5,18 @@ import (
 	"database/sql"
 	"fmt"
 	"io"
+	"math"
 	"math/rand"
 	"reflect"
 	"testing"
+	"time"
 
 	fuzz "github.com/google/gofuzz"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pctx"
 	"github.com/pachyderm/pachyderm/v2/src/internal/require"
 	"github.com/pachyderm/pachyderm/v2/src/internal/sdata/testutil"
+	"github.com/parquet-go/parquet-go"
 )
 
 // TestFormatParse is a round trip from a Tuple through formatting and parsing
@@ -90,6 +93,122 @@ func TestFormatParse(t *testing.T) {
 	}
 }
 
+// TestParquetFormatParse is TestFormatParse for the Parquet writer and parser, which
+// need a schema and random access to the written file.
+func TestParquetFormatParse(t *testing.T) {
+	newTuple := func() Tuple {
+		a := int64(0)
+		b := float64(0)
+		c := ""
+		d := sql.NullInt64{}
+		e := false
+		f := sql.NullString{}
+		g := time.Time{}
+		return Tuple{&a, &b, &c, &d, &e, &f, &g}
+	}
+	info := &pachsql.TableInfo{
+		Name: "test_table",
+		Columns: []pachsql.ColumnInfo{
+			{Name: "a", DataType: "BIGINT"},
+			{Name: "b", DataType: "DOUBLE PRECISION"},
+			{Name: "c", DataType: "TEXT"},
+			{Name: "d", DataType: "BIGINT", IsNullable: true},
+			{Name: "e", DataType: "BOOLEAN"},
+			{Name: "f", DataType: "VARCHAR", IsNullable: true},
+			{Name: "g", DataType: "TIMESTAMP"},
+		},
+	}
+	fieldNames := []string{"a", "b", "c", "d", "e", "f", "g"}
+	schema, err := ParquetSchemaFromTableInfo(info)
+	require.NoError(t, err)
+
+	const N = 10
+	buf := &bytes.Buffer{}
+	fz := fuzz.New()
+	fz.RandSource(rand.NewSource(0))
+	testutil.AddFuzzFuncs(fz)
+
+	var expected []Tuple
+	w, err := NewParquetWriter(buf, schema, fieldNames)
+	require.NoError(t, err)
+	for i := 0; i < N; i++ {
+		x := newTuple()
+		for i := range x {
+			fz.Fuzz(x[i])
+		}
+		// Parquet timestamps are stored with microsecond precision.
+		*x[6].(*time.Time) = x[6].(*time.Time).Truncate(time.Microsecond).UTC()
+		require.NoError(t, w.WriteTuple(x))
+		expected = append(expected, x)
+	}
+	require.NoError(t, w.Flush())
+
+	var actual []Tuple
+	r, err := NewParquetParser(bytes.NewReader(buf.Bytes()), int64(buf.Len()), fieldNames)
+	require.NoError(t, err)
+	for i := 0; i < N; i++ {
+		y := newTuple()
+		require.NoError(t, r.Next(y))
+		actual = append(actual, y)
+	}
+	require.ErrorIs(t, r.Next(newTuple()), io.EOF)
+	require.Len(t, actual, len(expected))
+	for i := range actual {
+		require.Equal(t, expected[i], actual[i])
+	}
+}
+
+// TestParquetUint32 checks that unsigned 32-bit integers above the range of an
+// int32 survive a round trip.
+func TestParquetUint32(t *testing.T) {
+	info := &pachsql.TableInfo{
+		Name:    "test_table",
+		Columns: []pachsql.ColumnInfo{{Name: "a", DataType: "UNSIGNED INTEGER"}},
+	}
+	fieldNames := []string{"a"}
+	schema, err := ParquetSchemaFromTableInfo(info)
+	require.NoError(t, err)
+	values := []int64{0, math.MaxInt32, math.MaxInt32 + 1, math.MaxUint32}
+	buf := &bytes.Buffer{}
+	w, err := NewParquetWriter(buf, schema, fieldNames)
+	require.NoError(t, err)
+	for _, x := range values {
+		x := x
+		require.NoError(t, w.WriteTuple(Tuple{&x}))
+	}
+	require.NoError(t, w.Flush())
+
+	r, err := NewParquetParser(bytes.NewReader(buf.Bytes()), int64(buf.Len()), fieldNames)
+	require.NoError(t, err)
+	for _, x := range values {
+		var y int64
+		require.NoError(t, r.Next(Tuple{&y}))
+		require.Equal(t, x, y)
+	}
+	var y int64
+	require.ErrorIs(t, r.Next(Tuple{&y}), io.EOF)
+}
+
+// TestParquetTimeRange checks that dates and timestamps outside of the range
+// of UnixNano, and dates before the epoch, survive a round trip.
+func TestParquetTimeRange(t *testing.T) {
+	for _, node := range []parquet.Node{parquet.Date(), parquet.Timestamp(parquet.Microsecond)} {
+		for _, x := range []time.Time{
+			time.Date(1969, 12, 31, 0, 0, 0, 0, time.UTC),
+			time.Date(1900, 3, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(1500, 1, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(3000, 6, 15, 0, 0, 0, 0, time.UTC),
+		} {
+			v, err := makeParquetValue(node.Type(), x)
+			require.NoError(t, err)
+			y, err := fromParquetValue(node.Type(), v)
+			require.NoError(t, err)
+			require.Equal(t, x, y)
+		}
+	}
+	require.Equal(t, int32(-1), unixDays(time.Date(1969, 12, 31, 12, 0, 0, 0, time.UTC)))
+}
+
 // TestMaterializeSQL checks that rows can be materialized from all the supported databases,
 // with all the supported writers.
 // It does not check that the writers themselves output in the correct format.
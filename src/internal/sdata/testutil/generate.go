@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"path/filepath"
 	"reflect"
 	"strings"
 	"testing"
@@ -16,7 +17,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
 )
 
-var SupportedDBSpecs = []DBSpec{postgreSQLSpec{}, mySQLSpec{}}
+var SupportedDBSpecs = []DBSpec{postgreSQLSpec{}, mySQLSpec{}, sqliteSpec{}}
 
 type setIDer interface {
 	SetID(int16)
@@ -64,6 +65,30 @@ func (s mySQLSpec) TestRow() setIDer {
 	return &pachsql.TestRow{}
 }
 
+type sqliteSpec struct{}
+
+func (s sqliteSpec) String() string { return "SQLite" }
+
+func (s sqliteSpec) Create(ctx context.Context, t *testing.T) (*sqlx.DB, string, string) {
+	const tableName = "test_table"
+	u, err := pachsql.ParseURL("sqlite://" + filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("parse sqlite url: %v", err)
+	}
+	db, err := pachsql.OpenURL(*u, "")
+	if err != nil {
+		t.Fatalf("open sqlite db: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db, u.Database, tableName
+}
+
+func (s sqliteSpec) Schema() string { return "main" }
+
+func (s sqliteSpec) TestRow() setIDer {
+	return &pachsql.TestRow{}
+}
+
 func AddFuzzFuncs(fz *fuzz.Fuzzer) {
 	fz.Funcs(
 		func(ti *time.Time, co fuzz.Continue) {
@@ -23,6 +23,7 @@ type EntryFunc = func(interface{}, *DataRef) error
 // Callbacks will be executed with respect to the order the entries are added (for the ChunkFunc
 // interface, entries are ordered within as well as across calls).
 type Batcher struct {
+	storage   *Storage
 	client    Client
 	entries   []*entry
 	buf       []byte
@@ -43,6 +44,7 @@ type entry struct {
 func (s *Storage) NewBatcher(ctx context.Context, name string, threshold int, opts ...BatcherOption) *Batcher {
 	client := NewClient(s.store, s.db, s.tracker, NewRenewer(ctx, s.tracker, name, defaultChunkTTL), s.pool)
 	b := &Batcher{
+		storage:   s,
 		client:    client,
 		threshold: threshold,
 		taskChain: taskchain.New(ctx, semaphore.NewWeighted(taskParallelism)),
@@ -73,7 +75,7 @@ func (b *Batcher) Add(meta interface{}, data []byte, pointsTo []*DataRef) error
 func (b *Batcher) createBatch(entries []*entry, buf []byte) error {
 	return b.taskChain.CreateTask(func(ctx context.Context) (func() error, error) {
 		pointsTo := getPointsTo(entries)
-		dataRef, err := upload(ctx, b.client, buf, pointsTo, false)
+		dataRef, err := upload(ctx, b.storage, b.client, buf, pointsTo, false)
 		if err != nil {
 			return nil, err
 		}
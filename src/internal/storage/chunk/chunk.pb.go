@@ -25,6 +25,8 @@ type CompressionAlgo int32
 const (
 	CompressionAlgo_NONE            CompressionAlgo = 0
 	CompressionAlgo_GZIP_BEST_SPEED CompressionAlgo = 1
+	CompressionAlgo_ZSTD            CompressionAlgo = 2
+	CompressionAlgo_LZ4             CompressionAlgo = 3
 )
 
 // Enum value maps for CompressionAlgo.
@@ -32,10 +34,14 @@ var (
 	CompressionAlgo_name = map[int32]string{
 		0: "NONE",
 		1: "GZIP_BEST_SPEED",
+		2: "ZSTD",
+		3: "LZ4",
 	}
 	CompressionAlgo_value = map[string]int32{
 		"NONE":            0,
 		"GZIP_BEST_SPEED": 1,
+		"ZSTD":            2,
+		"LZ4":             3,
 	}
 )
 
@@ -192,12 +198,15 @@ type Ref struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id              []byte          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	SizeBytes       int64           `protobuf:"varint,2,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
-	Edge            bool            `protobuf:"varint,3,opt,name=edge,proto3" json:"edge,omitempty"`
+	Id        []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SizeBytes int64  `protobuf:"varint,2,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	Edge      bool   `protobuf:"varint,3,opt,name=edge,proto3" json:"edge,omitempty"`
+	// dek is the data encryption key of the chunk.  If kek_id is set, dek is
+	// wrapped by the key encryption key with that ID.
 	Dek             []byte          `protobuf:"bytes,4,opt,name=dek,proto3" json:"dek,omitempty"`
 	EncryptionAlgo  EncryptionAlgo  `protobuf:"varint,5,opt,name=encryption_algo,json=encryptionAlgo,proto3,enum=chunk.EncryptionAlgo" json:"encryption_algo,omitempty"`
 	CompressionAlgo CompressionAlgo `protobuf:"varint,6,opt,name=compression_algo,json=compressionAlgo,proto3,enum=chunk.CompressionAlgo" json:"compression_algo,omitempty"`
+	KekId           string          `protobuf:"bytes,7,opt,name=kek_id,json=kekId,proto3" json:"kek_id,omitempty"`
 }
 
 func (x *Ref) Reset() {
@@ -274,6 +283,13 @@ func (x *Ref) GetCompressionAlgo() CompressionAlgo {
 	return CompressionAlgo_NONE
 }
 
+func (x *Ref) GetKekId() string {
+	if x != nil {
+		return x.KekId
+	}
+	return ""
+}
+
 var File_internal_storage_chunk_chunk_proto protoreflect.FileDescriptor
 
 var file_internal_storage_chunk_chunk_proto_rawDesc = []byte{
@@ -287,7 +303,7 @@ var file_internal_storage_chunk_chunk_proto_rawDesc = []byte{
 	0x65, 0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
 	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
 	0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0xdd, 0x01, 0x0a, 0x03, 0x52,
+	0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0xf4, 0x01, 0x0a, 0x03, 0x52,
 	0x65, 0x66, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02,
 	0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73,
 	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65,
@@ -301,19 +317,21 @@ var file_internal_storage_chunk_chunk_proto_rawDesc = []byte{
 	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x6c, 0x67, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28,
 	0x0e, 0x32, 0x16, 0x2e, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65,
 	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x67, 0x6f, 0x52, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x72,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x67, 0x6f, 0x2a, 0x30, 0x0a, 0x0f, 0x43, 0x6f,
-	0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x67, 0x6f, 0x12, 0x08, 0x0a,
-	0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x47, 0x5a, 0x49, 0x50, 0x5f,
-	0x42, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x50, 0x45, 0x45, 0x44, 0x10, 0x01, 0x2a, 0x3b, 0x0a, 0x0e,
-	0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x67, 0x6f, 0x12, 0x1b,
-	0x0a, 0x17, 0x45, 0x4e, 0x43, 0x52, 0x59, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x4c, 0x47,
-	0x4f, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x43,
-	0x48, 0x41, 0x43, 0x48, 0x41, 0x32, 0x30, 0x10, 0x01, 0x42, 0x3e, 0x5a, 0x3c, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x61, 0x63, 0x68, 0x79, 0x64, 0x65, 0x72,
-	0x6d, 0x2f, 0x70, 0x61, 0x63, 0x68, 0x79, 0x64, 0x65, 0x72, 0x6d, 0x2f, 0x76, 0x32, 0x2f, 0x73,
-	0x72, 0x63, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x73, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x2f, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x67, 0x6f, 0x12, 0x15, 0x0a, 0x06, 0x6b, 0x65,
+	0x6b, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6b, 0x65, 0x6b, 0x49,
+	0x64, 0x2a, 0x43, 0x0a, 0x0f, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x41, 0x6c, 0x67, 0x6f, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x13,
+	0x0a, 0x0f, 0x47, 0x5a, 0x49, 0x50, 0x5f, 0x42, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x50, 0x45, 0x45,
+	0x44, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x5a, 0x53, 0x54, 0x44, 0x10, 0x02, 0x12, 0x07, 0x0a,
+	0x03, 0x4c, 0x5a, 0x34, 0x10, 0x03, 0x2a, 0x3b, 0x0a, 0x0e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x67, 0x6f, 0x12, 0x1b, 0x0a, 0x17, 0x45, 0x4e, 0x43, 0x52,
+	0x59, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x4c, 0x47, 0x4f, 0x5f, 0x55, 0x4e, 0x4b, 0x4e,
+	0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x48, 0x41, 0x43, 0x48, 0x41, 0x32,
+	0x30, 0x10, 0x01, 0x42, 0x3e, 0x5a, 0x3c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x70, 0x61, 0x63, 0x68, 0x79, 0x64, 0x65, 0x72, 0x6d, 0x2f, 0x70, 0x61, 0x63, 0x68,
+	0x79, 0x64, 0x65, 0x72, 0x6d, 0x2f, 0x76, 0x32, 0x2f, 0x73, 0x72, 0x63, 0x2f, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x63, 0x68,
+	0x75, 0x6e, 0x6b, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
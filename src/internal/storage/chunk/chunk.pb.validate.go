@@ -201,6 +201,8 @@ func (m *Ref) validate(all bool) error {
 
 	// no validation rules for CompressionAlgo
 
+	// no validation rules for KekId
+
 	if len(errors) > 0 {
 		return RefMultiError(errors)
 	}
@@ -34,5 +34,6 @@ func (x *Ref) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	protoextensions.AddBytes(enc, "dek", x.Dek)
 	enc.AddString("encryption_algo", x.EncryptionAlgo.String())
 	enc.AddString("compression_algo", x.CompressionAlgo.String())
+	enc.AddString("kek_id", x.KekId)
 	return nil
 }
@@ -33,6 +33,33 @@ func TestCheck(t *testing.T) {
 	require.YesError(t, err)
 }
 
+// TestUploadCompression checks that chunks are uploaded with the configured
+// compression, and read back intact.
+func TestUploadCompression(t *testing.T) {
+	ctx := pctx.TestContext(t)
+	db := dockertestenv.NewTestDB(t)
+	tr := track.NewTestTracker(t, db)
+	_, s := NewTestStorage(t, db, tr, WithCompression(CompressionAlgo_ZSTD))
+
+	data := bytes.Repeat([]byte("compressible "), 1e6)
+	var dataRefs []*DataRef
+	u := s.NewUploader(ctx, "test-writer", false, func(_ interface{}, refs []*DataRef) error {
+		dataRefs = append(dataRefs, refs...)
+		return nil
+	})
+	require.NoError(t, u.Upload(nil, bytes.NewReader(data)))
+	require.NoError(t, u.Close())
+	var stored int64
+	for _, dataRef := range dataRefs {
+		require.Equal(t, CompressionAlgo_ZSTD, dataRef.Ref.CompressionAlgo)
+		stored += dataRef.Ref.SizeBytes
+	}
+	require.True(t, stored < int64(len(data)), "compressed size %d should be less than %d", stored, len(data))
+	buf := &bytes.Buffer{}
+	require.NoError(t, s.NewReader(ctx, dataRefs).Get(buf))
+	require.True(t, bytes.Equal(data, buf.Bytes()))
+}
+
 func deleteOne(t testing.TB, s kv.Store) {
 	ctx := pctx.TestContext(t)
 	it := s.NewKeyIterator(kv.Span{})
@@ -0,0 +1,181 @@
+package chunk
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pacherr"
+)
+
+// ConsistencyReport is the result of cross-checking the chunk objects in object storage against
+// the chunk entries in the database and the tracker.  Each problem is counted, and the object
+// keys of up to a limited number of examples are kept.
+type ConsistencyReport struct {
+	// MissingObjects are live, uploaded entries whose object is not in object storage.
+	MissingObjects    int64
+	MissingObjectKeys []string
+	// UntrackedEntries are live entries with no tracker object.  Tracker GC will never
+	// tombstone them, so their objects are never deleted.
+	UntrackedEntries   int64
+	UntrackedEntryKeys []string
+	// OrphanedObjects are objects with no entry.  Chunk GC only deletes the objects of
+	// entries, so they are never deleted.
+	OrphanedObjects    int64
+	OrphanedObjectKeys []string
+	// GarbageObjects and GarbageBytes count the objects of tombstoned entries, which are
+	// waiting to be deleted by chunk GC.
+	GarbageObjects int64
+	GarbageBytes   int64
+}
+
+// Consistent returns true if no problems were found.  Garbage is not a problem.
+func (r *ConsistencyReport) Consistent() bool {
+	return r.MissingObjects == 0 && r.UntrackedEntries == 0 && r.OrphanedObjects == 0
+}
+
+// sizedEntry is an Entry along with the size of its object.
+type sizedEntry struct {
+	Entry
+	Size int64 `db:"size"`
+}
+
+// CheckConsistency cross-checks object storage, the chunk entries and the tracker, and reports
+// anything that garbage collection will not clean up.  Garbage collection and uploads may run
+// while it does, so every problem is confirmed before it is reported: entries and objects that
+// appear or disappear during the check are not reported.  At most limit example keys of each
+// problem are kept, unless limit is zero.
+func (s *Storage) CheckConsistency(ctx context.Context, limit int) (*ConsistencyReport, error) {
+	report := &ConsistencyReport{}
+	keep := func(keys *[]string, key []byte) {
+		if limit == 0 || len(*keys) < limit {
+			*keys = append(*keys, string(key))
+		}
+	}
+	entries := make(map[string]sizedEntry)
+	if err := func() (retErr error) {
+		rows, err := s.db.QueryxContext(ctx, `SELECT chunk_id, gen, uploaded, tombstone, size FROM storage.chunk_objects`)
+		if err != nil {
+			return errors.EnsureStack(err)
+		}
+		defer errors.Close(&retErr, rows, "close rows")
+		for rows.Next() {
+			var ent sizedEntry
+			if err := rows.StructScan(&ent); err != nil {
+				return errors.EnsureStack(err)
+			}
+			entries[string(chunkKey(ent.ChunkID, ent.Gen))] = ent
+		}
+		return errors.EnsureStack(rows.Err())
+	}(); err != nil {
+		return nil, errors.Wrap(err, "list chunk entries")
+	}
+	listed := make(map[string]bool)
+	var orphans []Entry
+	if err := s.ListStore(ctx, func(id ID, gen uint64) error {
+		key := string(chunkKey(id, gen))
+		listed[key] = true
+		if _, ok := entries[key]; !ok {
+			orphans = append(orphans, Entry{ChunkID: id, Gen: gen})
+		}
+		return nil
+	}); err != nil {
+		return nil, errors.Wrap(err, "list chunk objects")
+	}
+	// Entries are created before their objects are uploaded, and objects are deleted before
+	// their entries, so an object without an entry is only an orphan if it is still there and
+	// its entry was not created after the entries were listed.
+	for _, o := range orphans {
+		key := chunkKey(o.ChunkID, o.Gen)
+		if _, err := s.getEntry(ctx, o.ChunkID, o.Gen); err == nil {
+			continue
+		} else if !pacherr.IsNotExist(err) {
+			return nil, err
+		}
+		if exists, err := s.store.Exists(ctx, key); err != nil {
+			return nil, errors.EnsureStack(err)
+		} else if !exists {
+			continue
+		}
+		report.OrphanedObjects++
+		keep(&report.OrphanedObjectKeys, key)
+	}
+	for k, ent := range entries {
+		key := []byte(k)
+		if ent.Tombstone {
+			if listed[k] {
+				report.GarbageObjects++
+				report.GarbageBytes += ent.Size
+			}
+			continue
+		}
+		if !ent.Uploaded {
+			continue
+		}
+		if !listed[k] {
+			missing, err := s.confirmLive(ctx, ent.Entry, func() (bool, error) {
+				exists, err := s.store.Exists(ctx, key)
+				return !exists, errors.EnsureStack(err)
+			})
+			if err != nil {
+				return nil, err
+			}
+			if missing {
+				report.MissingObjects++
+				keep(&report.MissingObjectKeys, key)
+			}
+		}
+		untracked, err := s.confirmLive(ctx, ent.Entry, func() (bool, error) {
+			if _, err := s.tracker.GetExpiresAt(ctx, ent.ChunkID.TrackerID()); err != nil {
+				if pacherr.IsNotExist(err) {
+					return true, nil
+				}
+				return false, errors.EnsureStack(err)
+			}
+			return false, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		if untracked {
+			report.UntrackedEntries++
+			keep(&report.UntrackedEntryKeys, key)
+		}
+	}
+	sort.Strings(report.OrphanedObjectKeys)
+	sort.Strings(report.MissingObjectKeys)
+	sort.Strings(report.UntrackedEntryKeys)
+	return report, nil
+}
+
+// confirmLive returns true if check returns true and the entry is still live afterwards, so that
+// entries which are tombstoned by the tracker GC or deleted by chunk GC during the check are not
+// reported.
+func (s *Storage) confirmLive(ctx context.Context, ent Entry, check func() (bool, error)) (bool, error) {
+	if ok, err := check(); err != nil || !ok {
+		return false, err
+	}
+	cur, err := s.getEntry(ctx, ent.ChunkID, ent.Gen)
+	if err != nil {
+		if pacherr.IsNotExist(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return !cur.Tombstone, nil
+}
+
+func (s *Storage) getEntry(ctx context.Context, chunkID ID, gen uint64) (*Entry, error) {
+	ent := &Entry{}
+	if err := s.db.GetContext(ctx, ent, `
+		SELECT chunk_id, gen, uploaded, tombstone FROM storage.chunk_objects
+		WHERE chunk_id = $1 AND gen = $2
+	`, chunkID, gen); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, pacherr.NewNotExist("chunk_objects", string(chunkKey(chunkID, gen)))
+		}
+		return nil, errors.EnsureStack(err)
+	}
+	return ent, nil
+}
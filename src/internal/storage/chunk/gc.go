@@ -4,8 +4,10 @@ import (
 	"context"
 	"time"
 
+	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/log"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
 	"go.uber.org/zap"
 )
 
@@ -82,9 +84,18 @@ func (gc *GarbageCollector) deleteObject(ctx context.Context, chunkID ID, gen ui
 }
 
 func (gc *GarbageCollector) deleteEntry(ctx context.Context, chunkID ID, gen uint64) error {
-	_, err := gc.s.db.ExecContext(ctx, `
-	DELETE FROM storage.chunk_objects
-	WHERE chunk_id = $1 AND gen = $2 AND tombstone = TRUE
-	`, chunkID, gen)
-	return errors.EnsureStack(err)
+	return dbutil.WithTx(ctx, gc.s.db, func(ctx context.Context, tx *pachsql.Tx) error {
+		if _, err := tx.ExecContext(ctx, `
+		DELETE FROM storage.chunk_objects
+		WHERE chunk_id = $1 AND gen = $2 AND tombstone = TRUE
+		`, chunkID, gen); err != nil {
+			return errors.EnsureStack(err)
+		}
+		// The wrapped DEK is no longer needed once the last object of the chunk is gone.
+		_, err := tx.ExecContext(ctx, `
+		DELETE FROM storage.chunk_deks
+		WHERE chunk_id = $1 AND NOT EXISTS (SELECT 1 FROM storage.chunk_objects WHERE chunk_id = $1)
+		`, chunkID)
+		return errors.EnsureStack(err)
+	})
 }
@@ -79,3 +79,61 @@ func writeRandom(t testing.TB, s *Storage) {
 	require.NoError(t, u.Upload(nil, io.LimitReader(rng, size)))
 	require.NoError(t, u.Close())
 }
+
+func TestCheckConsistency(t *testing.T) {
+	ctx := pctx.TestContext(t)
+	db := dockertestenv.NewTestDB(t)
+	tracker := track.NewTestTracker(t, db)
+	oc, s := NewTestStorage(t, db, tracker)
+
+	writeRandom(t, s)
+	report, err := s.CheckConsistency(ctx, 0)
+	require.NoError(t, err)
+	require.True(t, report.Consistent())
+	require.Equal(t, int64(0), report.GarbageObjects)
+
+	// Lose the object of one chunk, and leave an object behind that has no entry.
+	var keys [][]byte
+	require.NoError(t, stream.ForEach(ctx, oc.NewKeyIterator(kv.Span{}), func(key []byte) error {
+		keys = append(keys, append([]byte{}, key...))
+		return nil
+	}))
+	require.True(t, len(keys) > 1)
+	require.NoError(t, oc.Delete(ctx, keys[0]))
+	orphan := chunkKey(Hash([]byte("orphan")), 1)
+	require.NoError(t, oc.Put(ctx, orphan, []byte("orphan")))
+	report, err = s.CheckConsistency(ctx, 1)
+	require.NoError(t, err)
+	require.False(t, report.Consistent())
+	require.Equal(t, int64(1), report.MissingObjects)
+	require.Equal(t, []string{string(keys[0])}, report.MissingObjectKeys)
+	require.Equal(t, int64(1), report.OrphanedObjects)
+	require.Equal(t, []string{string(orphan)}, report.OrphanedObjectKeys)
+
+	// Once the chunks are deleted by the tracker GC, their objects are garbage until the chunk
+	// GC removes them.
+	_, err = db.ExecContext(ctx, `UPDATE storage.tracker_objects SET expires_at = CURRENT_TIMESTAMP - interval '1 hour'`)
+	require.NoError(t, err)
+	tgc := track.NewGarbageCollector(tracker, time.Minute, track.DeleterMux(func(tid string) track.Deleter {
+		switch {
+		case strings.HasPrefix(tid, TrackerPrefix):
+			return s.NewDeleter()
+		case strings.HasPrefix(tid, renew.TmpTrackerPrefix):
+			return renew.NewTmpDeleter()
+		default:
+			return nil
+		}
+	}))
+	require.NoError(t, tgc.RunUntilEmpty(ctx))
+	report, err = s.CheckConsistency(ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, int64(len(keys)-1), report.GarbageObjects)
+	require.True(t, report.GarbageBytes > 0)
+	require.Equal(t, int64(0), report.MissingObjects)
+
+	require.NoError(t, NewGC(s, time.Minute).RunOnce(ctx))
+	report, err = s.CheckConsistency(ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), report.GarbageObjects)
+	require.Equal(t, int64(1), report.OrphanedObjects)
+}
@@ -0,0 +1,347 @@
+package chunk
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/crypto/chacha20poly1305"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/log"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pacherr"
+	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
+)
+
+// KeyProvider wraps the data encryption keys (DEKs) of chunks with key
+// encryption keys (KEKs), in the style of a KMS.  The ID of the KEK used to
+// wrap a DEK is recorded on the chunk's Ref.
+//
+// Unwrap must return a pacherr.NotExist error for KEKs it doesn't know about,
+// so that retired KEKs can be handled by falling back to the re-wrapped DEK.
+type KeyProvider interface {
+	// PrimaryKeyID returns the ID of the KEK that new DEKs are wrapped with.
+	PrimaryKeyID(ctx context.Context) (string, error)
+	Wrap(ctx context.Context, kekID string, dek []byte) ([]byte, error)
+	Unwrap(ctx context.Context, kekID string, wrapped []byte) ([]byte, error)
+}
+
+const (
+	keyFileExt      = ".key"
+	primaryKeyFile  = "primary"
+	rewrapBatchSize = 100
+)
+
+// FileKeyProvider is a KeyProvider backed by a directory of KEKs, which is
+// mostly useful for testing, or for mounting KEKs from a Kubernetes secret.
+// Each KEK is 32 hex encoded bytes in a file named <id>.key, and the file
+// named "primary" contains the ID of the primary KEK.  KEKs are cached once
+// read.
+type FileKeyProvider struct {
+	dir  string
+	mu   sync.Mutex
+	keks map[string][]byte
+}
+
+// NewFileKeyProvider returns a FileKeyProvider for the KEKs in dir.
+func NewFileKeyProvider(dir string) *FileKeyProvider {
+	return &FileKeyProvider{
+		dir:  dir,
+		keks: make(map[string][]byte),
+	}
+}
+
+// PrimaryKeyID implements KeyProvider.
+func (p *FileKeyProvider) PrimaryKeyID(ctx context.Context) (string, error) {
+	data, err := os.ReadFile(filepath.Join(p.dir, primaryKeyFile))
+	if err != nil {
+		return "", errors.Wrap(err, "read primary key id")
+	}
+	id := strings.TrimSpace(string(data))
+	if id == "" {
+		return "", errors.Errorf("no primary key in %s", p.dir)
+	}
+	return id, nil
+}
+
+// Wrap implements KeyProvider.
+func (p *FileKeyProvider) Wrap(ctx context.Context, kekID string, dek []byte) ([]byte, error) {
+	kek, err := p.kek(kekID)
+	if err != nil {
+		return nil, err
+	}
+	return wrapKey(kek, kekID, dek)
+}
+
+// Unwrap implements KeyProvider.
+func (p *FileKeyProvider) Unwrap(ctx context.Context, kekID string, wrapped []byte) ([]byte, error) {
+	kek, err := p.kek(kekID)
+	if err != nil {
+		return nil, err
+	}
+	return unwrapKey(kek, kekID, wrapped)
+}
+
+// Rotate generates a new KEK and makes it the primary KEK.  It returns the ID
+// of the new KEK.
+func (p *FileKeyProvider) Rotate() (string, error) {
+	kek := make([]byte, chacha20poly1305.KeySize)
+	if _, err := rand.Read(kek); err != nil {
+		return "", errors.EnsureStack(err)
+	}
+	id := uuid.NewWithoutDashes()
+	if err := os.MkdirAll(p.dir, 0o700); err != nil {
+		return "", errors.EnsureStack(err)
+	}
+	if err := writeFileAtomic(filepath.Join(p.dir, id+keyFileExt), []byte(hex.EncodeToString(kek))); err != nil {
+		return "", err
+	}
+	if err := writeFileAtomic(filepath.Join(p.dir, primaryKeyFile), []byte(id)); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// Retire deletes the KEK with ID 'id'.  The primary KEK cannot be retired.
+func (p *FileKeyProvider) Retire(ctx context.Context, id string) error {
+	primary, err := p.PrimaryKeyID(ctx)
+	if err != nil {
+		return err
+	}
+	if id == primary {
+		return errors.Errorf("cannot retire primary key %s", id)
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.keks, id)
+	return errors.EnsureStack(os.Remove(filepath.Join(p.dir, id+keyFileExt)))
+}
+
+func (p *FileKeyProvider) kek(id string) ([]byte, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if kek, ok := p.keks[id]; ok {
+		return kek, nil
+	}
+	if id == "" || strings.ContainsAny(id, `/\.`) {
+		return nil, errors.Errorf("invalid key id %q", id)
+	}
+	data, err := os.ReadFile(filepath.Join(p.dir, id+keyFileExt))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, pacherr.NewNotExist("chunk-keys", id)
+		}
+		return nil, errors.EnsureStack(err)
+	}
+	kek, err := hex.DecodeString(strings.TrimSpace(string(data)))
+	if err != nil {
+		return nil, errors.Wrapf(err, "decode key %s", id)
+	}
+	if len(kek) != chacha20poly1305.KeySize {
+		return nil, errors.Errorf("key %s is %d bytes, must be %d", id, len(kek), chacha20poly1305.KeySize)
+	}
+	p.keks[id] = kek
+	return kek, nil
+}
+
+// wrapKey encrypts dek with kek using XChaCha20-Poly1305, and prepends the
+// nonce.  The nonce is derived from kek and dek rather than random, so that
+// wrapping is deterministic, and the Refs of identical chunks stay identical.
+// This reveals nothing that the content addressed chunk IDs don't already.
+func wrapKey(kek []byte, kekID string, dek []byte) ([]byte, error) {
+	aead, err := chacha20poly1305.NewX(kek)
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	var x []byte
+	x = append(x, kek...)
+	x = append(x, dek...)
+	nonce := Hash(x)[:aead.NonceSize()]
+	return aead.Seal(nonce, nonce, dek, []byte(kekID)), nil
+}
+
+func unwrapKey(kek []byte, kekID string, wrapped []byte) ([]byte, error) {
+	aead, err := chacha20poly1305.NewX(kek)
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	if len(wrapped) < aead.NonceSize() {
+		return nil, errors.Errorf("wrapped key is too short")
+	}
+	nonce, ctext := wrapped[:aead.NonceSize()], wrapped[aead.NonceSize():]
+	dek, err := aead.Open(nil, nonce, ctext, []byte(kekID))
+	if err != nil {
+		return nil, errors.Wrapf(err, "unwrap key with %s", kekID)
+	}
+	return dek, nil
+}
+
+func writeFileAtomic(name string, data []byte) error {
+	tmp := name + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return errors.EnsureStack(err)
+	}
+	return errors.EnsureStack(os.Rename(tmp, name))
+}
+
+// wrapDEK wraps the DEK of ref with the primary KEK.  If record is set, the
+// wrapped DEK is also recorded, so that it can be re-wrapped when the primary
+// KEK changes; refs to chunks which weren't uploaded have nothing to record.
+func (s *Storage) wrapDEK(ctx context.Context, ref *Ref, record bool) error {
+	kekID, err := s.keys.PrimaryKeyID(ctx)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	wrapped, err := s.keys.Wrap(ctx, kekID, ref.Dek)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	// The chunk may have been uploaded before, in which case the recorded DEK
+	// is already correct, and may have been re-wrapped.
+	if record {
+		if _, err := s.db.ExecContext(ctx, `
+		INSERT INTO storage.chunk_deks (chunk_id, kek_id, wrapped_dek)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (chunk_id) DO NOTHING
+		`, ref.Id, kekID, wrapped); err != nil {
+			return errors.EnsureStack(err)
+		}
+	}
+	ref.Dek, ref.KekId = wrapped, kekID
+	return nil
+}
+
+// unwrapDEK returns the plaintext DEK of ref.  Refs are immutable, so if the
+// KEK recorded on ref has been retired, the re-wrapped DEK is used instead.
+func (s *Storage) unwrapDEK(ctx context.Context, ref *Ref) ([]byte, error) {
+	if ref.KekId == "" {
+		return ref.Dek, nil
+	}
+	if s.keys == nil {
+		return nil, errors.Errorf("chunk %v has a wrapped key, but no key provider is configured", ref.Id)
+	}
+	dek, err := s.keys.Unwrap(ctx, ref.KekId, ref.Dek)
+	if !pacherr.IsNotExist(err) {
+		return dek, errors.EnsureStack(err)
+	}
+	var row dekRow
+	if err := s.db.GetContext(ctx, &row, `
+	SELECT chunk_id, kek_id, wrapped_dek FROM storage.chunk_deks WHERE chunk_id = $1
+	`, ref.Id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.Errorf("key %s of chunk %v is retired, and the chunk has no re-wrapped key", ref.KekId, ref.Id)
+		}
+		return nil, errors.EnsureStack(err)
+	}
+	dek, err = s.keys.Unwrap(ctx, row.KekID, row.WrappedDEK)
+	return dek, errors.EnsureStack(err)
+}
+
+type dekRow struct {
+	ChunkID    ID     `db:"chunk_id"`
+	KekID      string `db:"kek_id"`
+	WrappedDEK []byte `db:"wrapped_dek"`
+}
+
+// Rewrap re-wraps up to 'limit' recorded DEKs which aren't wrapped by the
+// primary KEK, without touching the chunk data.  It returns the number of DEKs
+// re-wrapped; zero means that KEKs other than the primary can be retired.
+func (s *Storage) Rewrap(ctx context.Context, limit int) (int, error) {
+	if s.keys == nil {
+		return 0, nil
+	}
+	primary, err := s.keys.PrimaryKeyID(ctx)
+	if err != nil {
+		return 0, errors.EnsureStack(err)
+	}
+	var rows []dekRow
+	if err := s.db.SelectContext(ctx, &rows, `
+	SELECT chunk_id, kek_id, wrapped_dek FROM storage.chunk_deks
+	WHERE kek_id <> $1
+	ORDER BY chunk_id
+	LIMIT $2
+	`, primary, limit); err != nil {
+		return 0, errors.EnsureStack(err)
+	}
+	var n int
+	for _, row := range rows {
+		dek, err := s.keys.Unwrap(ctx, row.KekID, row.WrappedDEK)
+		if err != nil {
+			return n, errors.Wrapf(err, "unwrap key of chunk %v", row.ChunkID)
+		}
+		wrapped, err := s.keys.Wrap(ctx, primary, dek)
+		if err != nil {
+			return n, errors.Wrapf(err, "wrap key of chunk %v", row.ChunkID)
+		}
+		// Compare and swap, in case of a concurrent re-wrap.
+		if _, err := s.db.ExecContext(ctx, `
+		UPDATE storage.chunk_deks SET kek_id = $1, wrapped_dek = $2
+		WHERE chunk_id = $3 AND kek_id = $4 AND wrapped_dek = $5
+		`, primary, wrapped, row.ChunkID, row.KekID, row.WrappedDEK); err != nil {
+			return n, errors.EnsureStack(err)
+		}
+		n++
+	}
+	return n, nil
+}
+
+// Rewrapper re-wraps DEKs with the primary KEK in the background, so that KEKs
+// can be rotated without rewriting chunk data.
+type Rewrapper struct {
+	s      *Storage
+	period time.Duration
+}
+
+// NewRewrapper returns a new Rewrapper operating on s.
+func NewRewrapper(s *Storage, d time.Duration) *Rewrapper {
+	return &Rewrapper{s: s, period: d}
+}
+
+// RunForever calls RunOnce until the context is cancelled, logging any errors.
+func (r *Rewrapper) RunForever(ctx context.Context) error {
+	ticker := time.NewTicker(r.period)
+	defer ticker.Stop()
+	for {
+		if err := r.RunOnce(ctx); err != nil {
+			select {
+			case <-ctx.Done():
+				return err
+			default:
+			}
+			log.Error(ctx, "error re-wrapping chunk keys", zap.Error(err))
+		}
+		select {
+		case <-ctx.Done():
+			return errors.EnsureStack(context.Cause(ctx))
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce re-wraps every DEK which isn't wrapped by the primary KEK.
+func (r *Rewrapper) RunOnce(ctx context.Context) (retErr error) {
+	ctx, end := log.SpanContext(ctx, "RunOnce")
+	defer end(log.Errorp(&retErr))
+	var total int
+	for {
+		n, err := r.s.Rewrap(ctx, rewrapBatchSize)
+		total += n
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			break
+		}
+	}
+	if total > 0 {
+		log.Info(ctx, "re-wrapped chunk keys", zap.Int("count", total))
+	}
+	return nil
+}
@@ -0,0 +1,131 @@
+package chunk
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/dockertestenv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pacherr"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pctx"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/track"
+)
+
+func TestFileKeyProvider(t *testing.T) {
+	ctx := pctx.TestContext(t)
+	p := NewFileKeyProvider(t.TempDir())
+	_, err := p.PrimaryKeyID(ctx)
+	require.YesError(t, err)
+
+	first, err := p.Rotate()
+	require.NoError(t, err)
+	primary, err := p.PrimaryKeyID(ctx)
+	require.NoError(t, err)
+	require.Equal(t, first, primary)
+
+	dek := bytes.Repeat([]byte{1}, 32)
+	wrapped, err := p.Wrap(ctx, first, dek)
+	require.NoError(t, err)
+	require.False(t, bytes.Contains(wrapped, dek))
+	again, err := p.Wrap(ctx, first, dek)
+	require.NoError(t, err)
+	require.Equal(t, wrapped, again, "wrapping should be deterministic")
+	got, err := p.Unwrap(ctx, first, wrapped)
+	require.NoError(t, err)
+	require.Equal(t, dek, got)
+
+	second, err := p.Rotate()
+	require.NoError(t, err)
+	primary, err = p.PrimaryKeyID(ctx)
+	require.NoError(t, err)
+	require.Equal(t, second, primary)
+	_, err = p.Unwrap(ctx, second, wrapped)
+	require.YesError(t, err)
+
+	require.YesError(t, p.Retire(ctx, second))
+	require.NoError(t, p.Retire(ctx, first))
+	_, err = p.Unwrap(ctx, first, wrapped)
+	require.True(t, pacherr.IsNotExist(err))
+}
+
+func TestRewrap(t *testing.T) {
+	ctx := pctx.TestContext(t)
+	db := dockertestenv.NewTestDB(t)
+	tr := track.NewTestTracker(t, db)
+	keys := NewFileKeyProvider(t.TempDir())
+	first, err := keys.Rotate()
+	require.NoError(t, err)
+	_, s := NewTestStorage(t, db, tr, WithKeyProvider(keys))
+
+	data := make([]byte, 1e7)
+	rand.New(rand.NewSource(0)).Read(data)
+	var dataRefs []*DataRef
+	u := s.NewUploader(ctx, "test-writer", false, func(_ interface{}, refs []*DataRef) error {
+		dataRefs = append(dataRefs, refs...)
+		return nil
+	})
+	require.NoError(t, u.Upload(nil, bytes.NewReader(data)))
+	require.NoError(t, u.Close())
+	for _, dataRef := range dataRefs {
+		require.Equal(t, first, dataRef.Ref.KekId)
+	}
+	readAll := func() []byte {
+		buf := &bytes.Buffer{}
+		require.NoError(t, s.NewReader(ctx, dataRefs).Get(buf))
+		return buf.Bytes()
+	}
+	require.True(t, bytes.Equal(data, readAll()))
+
+	// Rotate, re-wrap, and retire the old key.  The chunks must still be
+	// readable through the original refs.
+	_, err = keys.Rotate()
+	require.NoError(t, err)
+	n, err := s.Rewrap(ctx, 100)
+	require.NoError(t, err)
+	require.True(t, n > 0)
+	n, err = s.Rewrap(ctx, 100)
+	require.NoError(t, err)
+	require.Equal(t, 0, n)
+	require.NoError(t, keys.Retire(ctx, first))
+	s.memCache.Purge()
+	require.True(t, bytes.Equal(data, readAll()))
+}
+
+// TestChunkIDsWithKeys checks that enabling key encryption keys, with the
+// default compression, doesn't change the IDs of new chunks, so that they
+// deduplicate with the chunks uploaded before.
+func TestChunkIDsWithKeys(t *testing.T) {
+	ctx := pctx.TestContext(t)
+	db := dockertestenv.NewTestDB(t)
+	tr := track.NewTestTracker(t, db)
+	data := make([]byte, 1e7)
+	rand.New(rand.NewSource(0)).Read(data)
+	upload := func(s *Storage, noUpload bool) []*DataRef {
+		var dataRefs []*DataRef
+		u := s.NewUploader(ctx, "test-writer", noUpload, func(_ interface{}, refs []*DataRef) error {
+			dataRefs = append(dataRefs, refs...)
+			return nil
+		})
+		require.NoError(t, u.Upload(nil, bytes.NewReader(data)))
+		require.NoError(t, u.Close())
+		return dataRefs
+	}
+
+	_, before := NewTestStorage(t, db, tr)
+	beforeRefs := upload(before, false)
+
+	keys := NewFileKeyProvider(t.TempDir())
+	kekID, err := keys.Rotate()
+	require.NoError(t, err)
+	_, after := NewTestStorage(t, db, tr, WithKeyProvider(keys))
+	for _, noUpload := range []bool{false, true} {
+		afterRefs := upload(after, noUpload)
+		require.Equal(t, len(beforeRefs), len(afterRefs))
+		for i := range beforeRefs {
+			require.Equal(t, beforeRefs[i].Ref.Id, afterRefs[i].Ref.Id)
+			require.Equal(t, CompressionAlgo_NONE, afterRefs[i].Ref.CompressionAlgo)
+			require.Equal(t, kekID, afterRefs[i].Ref.KekId)
+		}
+	}
+}
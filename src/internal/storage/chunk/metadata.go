@@ -87,6 +87,37 @@ func SetupPostgresStoreV0(ctx context.Context, tx *pachsql.Tx) error {
 	return errors.EnsureStack(err)
 }
 
+// SetupPostgresStoreV1 adds the table of wrapped data encryption keys, which
+// are re-wrapped when key encryption keys are rotated.
+func SetupPostgresStoreV1(ctx context.Context, tx *pachsql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+	CREATE TABLE storage.chunk_deks (
+		chunk_id BYTEA NOT NULL,
+		kek_id VARCHAR(128) NOT NULL,
+		wrapped_dek BYTEA NOT NULL,
+
+		PRIMARY KEY(chunk_id)
+	);
+
+	CREATE INDEX chunk_deks_kek_id ON storage.chunk_deks (kek_id);
+	`)
+	return errors.EnsureStack(err)
+}
+
+// SetupPostgresStoreV2 adds the columns that track which tier each chunk object
+// is stored in, and how often and how recently it has been read.
+func SetupPostgresStoreV2(ctx context.Context, tx *pachsql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+	ALTER TABLE storage.chunk_objects
+		ADD COLUMN tier VARCHAR(16) NOT NULL DEFAULT 'cold',
+		ADD COLUMN accessed_at TIMESTAMP,
+		ADD COLUMN access_count INT8 NOT NULL DEFAULT 0;
+
+	CREATE INDEX chunk_objects_tier ON storage.chunk_objects (tier);
+	`)
+	return errors.EnsureStack(err)
+}
+
 // KeyStore is a store for named secret keys
 type KeyStore interface {
 	Create(ctx context.Context, name string, data []byte) error
@@ -1,7 +1,10 @@
 package chunk
 
 import (
+	"strings"
+
 	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pachhash"
 )
 
@@ -33,6 +36,28 @@ func WithCompression(algo CompressionAlgo) StorageOption {
 	}
 }
 
+// WithKeyProvider sets the provider of the key encryption keys used to wrap
+// the data encryption keys of new chunks.
+func WithKeyProvider(keys KeyProvider) StorageOption {
+	return func(s *Storage) {
+		s.keys = keys
+	}
+}
+
+// ParseCompressionAlgo returns the compression algorithm named 'name', which
+// is case-insensitive.  "gzip" is accepted as shorthand for GZIP_BEST_SPEED.
+func ParseCompressionAlgo(name string) (CompressionAlgo, error) {
+	name = strings.ToUpper(name)
+	if name == "GZIP" {
+		return CompressionAlgo_GZIP_BEST_SPEED, nil
+	}
+	algo, ok := CompressionAlgo_value[name]
+	if !ok {
+		return 0, errors.Errorf("unrecognized compression algorithm %q", name)
+	}
+	return CompressionAlgo(algo), nil
+}
+
 type BatcherOption func(b *Batcher)
 
 func WithChunkCallback(cb ChunkFunc) BatcherOption {
@@ -57,6 +82,14 @@ func WithOffsetBytes(offsetBytes int64) ReaderOption {
 	}
 }
 
+// WithLimitBytes limits a reader to at most limitBytes bytes after its offset.  Data past the
+// limit is not fetched.  A limit of 0 reads to the end of the data.
+func WithLimitBytes(limitBytes int64) ReaderOption {
+	return func(r *Reader) {
+		r.limitBytes = limitBytes
+	}
+}
+
 func WithPrefetchLimit(limit int) ReaderOption {
 	return func(r *Reader) {
 		r.prefetchLimit = limit
@@ -16,6 +16,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/taskchain"
 
 	"golang.org/x/sync/semaphore"
+	"google.golang.org/protobuf/proto"
 )
 
 // Reader reads data from chunk storage.
@@ -25,6 +26,7 @@ type Reader struct {
 	client        Client
 	dataRefs      []*DataRef
 	offsetBytes   int64
+	limitBytes    int64
 	prefetchLimit int
 }
 
@@ -45,9 +47,28 @@ func newReader(ctx context.Context, s *Storage, client Client, dataRefs []*DataR
 		r.offsetBytes -= r.dataRefs[0].SizeBytes
 		r.dataRefs = r.dataRefs[1:]
 	}
+	if r.limitBytes > 0 {
+		r.dataRefs = limitDataRefs(r.dataRefs, r.offsetBytes, r.limitBytes)
+	}
 	return r
 }
 
+// limitDataRefs returns the data references covering the limit bytes after offset in the first
+// data reference.  The last data reference is shortened to end at the limit.
+func limitDataRefs(dataRefs []*DataRef, offset, limit int64) []*DataRef {
+	for i, dataRef := range dataRefs {
+		size := dataRef.SizeBytes - offset
+		if size >= limit {
+			last := proto.Clone(dataRef).(*DataRef)
+			last.SizeBytes = offset + limit
+			return append(dataRefs[:i:i], last)
+		}
+		limit -= size
+		offset = 0
+	}
+	return dataRefs
+}
+
 // Get writes the concatenation of the data referenced by the data references.
 func (r *Reader) Get(w io.Writer) (retErr error) {
 	if len(r.dataRefs) == 0 {
@@ -92,6 +113,7 @@ func (r *Reader) Get(w io.Writer) (retErr error) {
 // DataReader is an abstraction that lazily reads data referenced by a data reference.
 type DataReader struct {
 	ctx      context.Context
+	storage  *Storage
 	client   Client
 	memCache *memoryCache
 	pool     *kv.Pool
@@ -104,6 +126,7 @@ type DataReader struct {
 func newDataReader(ctx context.Context, s *Storage, client Client, dataRef *DataRef, offset int64) *DataReader {
 	return &DataReader{
 		ctx:      ctx,
+		storage:  s,
 		client:   client,
 		memCache: s.memCache,
 		pool:     s.pool,
@@ -141,7 +164,11 @@ func (dr *DataReader) fetchData() error {
 			return err
 		}
 		return dr.deduper.Do(dr.ctx, ref.Key(), func() error {
-			data, err := Get(dr.ctx, dr.client, ref)
+			dek, err := dr.storage.unwrapDEK(dr.ctx, ref)
+			if err != nil {
+				return err
+			}
+			data, err := Get(dr.ctx, dr.client, ref, dek)
 			if err != nil {
 				return err
 			}
@@ -0,0 +1,32 @@
+package chunk
+
+import (
+	"testing"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+)
+
+func TestLimitDataRefs(t *testing.T) {
+	dataRefs := []*DataRef{
+		{OffsetBytes: 0, SizeBytes: 10},
+		{OffsetBytes: 5, SizeBytes: 10},
+		{OffsetBytes: 0, SizeBytes: 10},
+	}
+	sizes := func(drs []*DataRef) []int64 {
+		var result []int64
+		for _, dr := range drs {
+			result = append(result, dr.SizeBytes)
+		}
+		return result
+	}
+	// Within the first data reference.
+	require.Equal(t, []int64{7}, sizes(limitDataRefs(dataRefs, 2, 5)))
+	// Ending exactly at the end of a data reference.
+	require.Equal(t, []int64{10, 10}, sizes(limitDataRefs(dataRefs, 2, 18)))
+	// Spanning several data references.
+	require.Equal(t, []int64{10, 10, 1}, sizes(limitDataRefs(dataRefs, 0, 21)))
+	// Past the end.
+	require.Equal(t, []int64{10, 10, 10}, sizes(limitDataRefs(dataRefs, 0, 100)))
+	// The input is not modified.
+	require.Equal(t, []int64{10, 10, 10}, sizes(dataRefs))
+}
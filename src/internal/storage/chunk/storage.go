@@ -7,6 +7,7 @@ import (
 	"time"
 
 	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/miscutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pacherr"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pachhash"
@@ -38,6 +39,13 @@ type Storage struct {
 	prefetchLimit int
 
 	createOpts CreateOptions
+	keys       KeyProvider
+
+	// cold is the store in object storage, and hot is the hot tier, if there is one.  If there
+	// is a hot tier, store reads through it.
+	cold     kv.Store
+	hot      kv.Store
+	accesses *accessLog
 }
 
 // NewStorage creates a new Storage.
@@ -50,13 +58,20 @@ func NewStorage(store kv.Store, db *pachsql.DB, tracker track.Tracker, opts ...S
 		deduper:       &miscutil.WorkDeduper[pachhash.Output]{},
 		pool:          kv.NewPool(DefaultMaxChunkSize),
 		prefetchLimit: DefaultPrefetchLimit,
+		// Chunks are uncompressed by default, so that new chunks deduplicate
+		// with the chunks written before compression was configurable.
 		createOpts: CreateOptions{
-			Compression: CompressionAlgo_GZIP_BEST_SPEED,
+			Compression: CompressionAlgo_NONE,
 		},
 	}
 	for _, opt := range opts {
 		opt(s)
 	}
+	s.cold = s.store
+	if s.hot != nil {
+		s.accesses = newAccessLog(db)
+		s.store = &tieredStore{Store: s.cold, hot: s.hot, accesses: s.accesses}
+	}
 	return s
 }
 
@@ -89,6 +104,30 @@ func (s *Storage) ListStore(ctx context.Context, cb func(id ID, gen uint64) erro
 	})
 }
 
+// IterateSizes calls cb with the ID of every chunk in object storage and its size, as stored.
+func (s *Storage) IterateSizes(ctx context.Context, cb func(id ID, sizeBytes int64) error) error {
+	rows, err := s.db.QueryxContext(ctx, `
+		SELECT chunk_id, max(size)
+		FROM storage.chunk_objects
+		WHERE uploaded = TRUE AND tombstone = FALSE
+		GROUP BY chunk_id`)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var id ID
+		var size int64
+		if err := rows.Scan(&id, &size); err != nil {
+			return errors.EnsureStack(err)
+		}
+		if err := cb(id, size); err != nil {
+			return err
+		}
+	}
+	return errors.EnsureStack(rows.Err())
+}
+
 // NewDeleter creates a deleter for use with a tracker.GC
 func (s *Storage) NewDeleter() track.Deleter {
 	return &deleter{}
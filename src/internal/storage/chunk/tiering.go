@@ -0,0 +1,407 @@
+package chunk
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/log"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/kv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/stream"
+)
+
+// Tier is a place where chunk objects are stored.
+//
+// Object storage is the cold tier, and always holds the object of every chunk.  The hot tier is
+// a store on fast local disk that holds copies of the objects of the chunks that are read most,
+// so losing it loses no data.  The tier of each chunk object is recorded in the tier column of
+// storage.chunk_objects, and is changed by the Tierer.
+//
+// The tier column is shared by the whole cluster, so there must be a single hot tier: only one
+// process, on one node, may be configured with one.  Other processes read from object storage.
+// If that process moves to another node, the Tierer finds that the recorded copies are missing
+// and copies the chunks that should still be hot again.
+type Tier string
+
+const (
+	TierCold Tier = "cold"
+	TierHot  Tier = "hot"
+)
+
+// accessFlushPeriod is how often the reads recorded by a Storage are written to the database.
+const accessFlushPeriod = 30 * time.Second
+
+// WithHotTier stores copies of frequently read chunks in hot, which should be on fast local
+// disk.  Reads are served from the hot tier if it has a copy of the chunk, and are recorded so
+// that a Tierer can decide which chunks to copy there.
+func WithHotTier(hot kv.Store) StorageOption {
+	return func(s *Storage) {
+		s.hot = hot
+	}
+}
+
+// tieredStore is the store of a Storage with a hot tier.  Writes, deletes and listings go to
+// object storage, and reads are served from the hot tier when possible.
+type tieredStore struct {
+	kv.Store
+	hot      kv.Store
+	accesses *accessLog
+}
+
+func (ts *tieredStore) Get(ctx context.Context, key []byte, buf []byte) (int, error) {
+	ts.accesses.record(ctx, key)
+	if n, err := ts.hot.Get(ctx, key, buf); err == nil {
+		// The hot copy is not written durably, so make sure it is intact.
+		id, _, err := parseKey(key)
+		if err == nil {
+			err = verifyData(id, buf[:n])
+		}
+		if err == nil {
+			return n, nil
+		}
+		log.Error(ctx, "dropping bad hot copy of chunk", zap.ByteString("key", key), zap.Error(err))
+		if err := ts.hot.Delete(ctx, key); err != nil {
+			log.Error(ctx, "could not delete bad hot copy of chunk", zap.ByteString("key", key), zap.Error(err))
+		}
+	}
+	n, err := ts.Store.Get(ctx, key, buf)
+	return n, errors.EnsureStack(err)
+}
+
+func (ts *tieredStore) Delete(ctx context.Context, key []byte) error {
+	if err := ts.hot.Delete(ctx, key); err != nil {
+		return errors.EnsureStack(err)
+	}
+	return errors.EnsureStack(ts.Store.Delete(ctx, key))
+}
+
+// accessLog counts the chunk objects read by a process, and periodically adds the counts to
+// storage.chunk_objects.
+type accessLog struct {
+	db *pachsql.DB
+
+	mu        sync.Mutex
+	counts    map[string]int64
+	lastFlush time.Time
+	flushing  bool
+}
+
+func newAccessLog(db *pachsql.DB) *accessLog {
+	return &accessLog{
+		db:        db,
+		counts:    make(map[string]int64),
+		lastFlush: time.Now(),
+	}
+}
+
+// record counts a read of the object at key, and starts a flush in the background if one is due.
+func (l *accessLog) record(ctx context.Context, key []byte) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.counts[string(key)]++
+	if l.flushing || time.Since(l.lastFlush) < accessFlushPeriod {
+		return
+	}
+	l.flushing = true
+	ctx = context.WithoutCancel(ctx)
+	go func() {
+		if err := l.flush(ctx); err != nil {
+			log.Error(ctx, "error recording chunk reads", zap.Error(err))
+		}
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		l.flushing = false
+	}()
+}
+
+// flush writes the counted reads to the database.  Counts that cannot be written are dropped.
+func (l *accessLog) flush(ctx context.Context) error {
+	l.mu.Lock()
+	counts := l.counts
+	l.counts = make(map[string]int64)
+	l.lastFlush = time.Now()
+	l.mu.Unlock()
+	if len(counts) == 0 {
+		return nil
+	}
+	return dbutil.WithTx(ctx, l.db, func(ctx context.Context, tx *pachsql.Tx) error {
+		for key, n := range counts {
+			id, gen, err := parseKey([]byte(key))
+			if err != nil {
+				return err
+			}
+			if _, err := tx.ExecContext(ctx, `
+				UPDATE storage.chunk_objects
+				SET access_count = access_count + $3, accessed_at = CURRENT_TIMESTAMP
+				WHERE chunk_id = $1 AND gen = $2
+			`, id, gen, n); err != nil {
+				return errors.Wrap(err, "record chunk reads")
+			}
+		}
+		return nil
+	})
+}
+
+// TieringPolicy decides which chunks have copies in the hot tier.
+type TieringPolicy struct {
+	// PromoteAccesses is the number of reads after which a chunk is copied to the hot tier.
+	// Read counts are halved after every run of the Tierer, so this is a measure of how
+	// frequently a chunk is read.  Zero disables promotion by read frequency.
+	PromoteAccesses int64
+	// DemoteAfter is how long a chunk in the hot tier may go unread before its copy is dropped.
+	// Zero disables demotion by age.
+	DemoteAfter time.Duration
+	// MaxHotBytes is the capacity of the hot tier.  When it is exceeded, the copies of the
+	// least recently read chunks are dropped first, and pinned chunks last.  Zero means the
+	// hot tier is unbounded.
+	MaxHotBytes int64
+	// Pinned returns the tracker IDs of chunks that are kept in the hot tier however they are
+	// read, for example the chunks of certain repos.  It may be nil.
+	Pinned func(ctx context.Context) (map[string]struct{}, error)
+}
+
+// tierEntry is a live chunk object, along with its tier and how it has been read.
+type tierEntry struct {
+	Entry
+	Size        int64      `db:"size"`
+	Tier        Tier       `db:"tier"`
+	CreatedAt   time.Time  `db:"created_at"`
+	AccessedAt  *time.Time `db:"accessed_at"`
+	AccessCount int64      `db:"access_count"`
+	pinned      bool
+	lost        bool // Recorded as hot, but the hot tier has no copy.
+}
+
+// lastAccess returns when the chunk object was last read, or created if it has never been read.
+func (e *tierEntry) lastAccess() time.Time {
+	if e.AccessedAt != nil {
+		return *e.AccessedAt
+	}
+	return e.CreatedAt
+}
+
+// plan returns the chunk objects that should be copied to the hot tier and the chunk objects
+// whose hot copies should be dropped, as of now.
+func (p *TieringPolicy) plan(ents []*tierEntry, now time.Time) (promote, demote []*tierEntry) {
+	var want []*tierEntry
+	for _, e := range ents {
+		switch {
+		case e.pinned:
+		case p.PromoteAccesses > 0 && e.AccessCount >= p.PromoteAccesses:
+		case e.Tier == TierHot && (p.DemoteAfter <= 0 || now.Sub(e.lastAccess()) < p.DemoteAfter):
+		default:
+			continue
+		}
+		want = append(want, e)
+	}
+	// Fill the hot tier with pinned chunks first, then the most recently read.
+	sort.SliceStable(want, func(i, j int) bool {
+		a, b := want[i], want[j]
+		if a.pinned != b.pinned {
+			return a.pinned
+		}
+		if !a.lastAccess().Equal(b.lastAccess()) {
+			return a.lastAccess().After(b.lastAccess())
+		}
+		return a.AccessCount > b.AccessCount
+	})
+	keep := make(map[*tierEntry]bool)
+	var size int64
+	for _, e := range want {
+		if p.MaxHotBytes > 0 && size+e.Size > p.MaxHotBytes {
+			continue
+		}
+		size += e.Size
+		keep[e] = true
+	}
+	for _, e := range ents {
+		switch {
+		case keep[e] && (e.Tier != TierHot || e.lost):
+			promote = append(promote, e)
+		case !keep[e] && e.Tier == TierHot:
+			demote = append(demote, e)
+		}
+	}
+	return promote, demote
+}
+
+// Tierer moves chunks between tiers in the background, according to a TieringPolicy.
+type Tierer struct {
+	s      *Storage
+	period time.Duration
+	policy TieringPolicy
+}
+
+// NewTierer returns a new Tierer operating on s, which must have a hot tier.
+func NewTierer(s *Storage, d time.Duration, policy TieringPolicy) *Tierer {
+	return &Tierer{s: s, period: d, policy: policy}
+}
+
+// RunForever calls RunOnce until the context is cancelled, logging any errors.
+func (t *Tierer) RunForever(ctx context.Context) error {
+	ticker := time.NewTicker(t.period)
+	defer ticker.Stop()
+	for {
+		if err := t.RunOnce(ctx); err != nil {
+			select {
+			case <-ctx.Done():
+				return err
+			default:
+			}
+			log.Error(ctx, "error moving chunks between tiers", zap.Error(err))
+		}
+		select {
+		case <-ctx.Done():
+			return errors.EnsureStack(context.Cause(ctx))
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce applies the policy to every live chunk object, then halves the read counts.
+func (t *Tierer) RunOnce(ctx context.Context) (retErr error) {
+	ctx, end := log.SpanContext(ctx, "RunOnce")
+	defer end(log.Errorp(&retErr))
+	if t.s.hot == nil {
+		return errors.New("chunk storage has no hot tier")
+	}
+	if err := t.s.accesses.flush(ctx); err != nil {
+		return err
+	}
+	var pinned map[string]struct{}
+	if t.policy.Pinned != nil {
+		var err error
+		if pinned, err = t.policy.Pinned(ctx); err != nil {
+			return errors.Wrap(err, "list pinned chunks")
+		}
+	}
+	var ents []*tierEntry
+	if err := t.s.db.SelectContext(ctx, &ents, `
+		SELECT chunk_id, gen, uploaded, tombstone, size, tier, created_at, accessed_at, access_count
+		FROM storage.chunk_objects
+		WHERE uploaded = TRUE AND tombstone = FALSE
+	`); err != nil {
+		return errors.Wrap(err, "list chunk objects")
+	}
+	present, err := t.listHot(ctx)
+	if err != nil {
+		return err
+	}
+	hot := make(map[string]bool)
+	var lost int
+	for _, e := range ents {
+		_, e.pinned = pinned[e.ChunkID.TrackerID()]
+		if e.Tier != TierHot {
+			continue
+		}
+		key := string(chunkKey(e.ChunkID, e.Gen))
+		if !present[key] {
+			// The copy is gone, e.g. because the hot tier is now on another node.
+			e.lost = true
+			lost++
+			continue
+		}
+		hot[key] = true
+	}
+	if lost > 0 {
+		log.Info(ctx, "hot tier is missing the copies of some chunks", zap.Int("chunks", lost))
+	}
+	if err := t.dropStrays(ctx, present, hot); err != nil {
+		return err
+	}
+	promote, demote := t.policy.plan(ents, time.Now())
+	var promotedBytes, demotedBytes int64
+	for _, e := range demote {
+		if err := t.demote(ctx, e); err != nil {
+			return err
+		}
+		demotedBytes += e.Size
+	}
+	for _, e := range promote {
+		if err := t.promote(ctx, e); err != nil {
+			return err
+		}
+		promotedBytes += e.Size
+	}
+	if len(promote) > 0 || len(demote) > 0 {
+		log.Info(ctx, "moved chunks between tiers",
+			zap.Int("promoted", len(promote)), zap.Int64("promotedBytes", promotedBytes),
+			zap.Int("demoted", len(demote)), zap.Int64("demotedBytes", demotedBytes))
+	}
+	_, err = t.s.db.ExecContext(ctx, `
+		UPDATE storage.chunk_objects SET access_count = access_count / 2 WHERE access_count > 0
+	`)
+	return errors.Wrap(err, "decay chunk read counts")
+}
+
+// promote copies a chunk object to the hot tier.  The copy is dropped again if the chunk was
+// deleted in the meantime.
+func (t *Tierer) promote(ctx context.Context, e *tierEntry) error {
+	key := chunkKey(e.ChunkID, e.Gen)
+	if err := t.s.pool.GetF(ctx, t.s.cold, key, func(data []byte) error {
+		return errors.EnsureStack(t.s.hot.Put(ctx, key, data))
+	}); err != nil {
+		return errors.Wrapf(err, "copy chunk %v to hot tier", e.ChunkID)
+	}
+	n, err := t.setTier(ctx, e, TierHot)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return errors.EnsureStack(t.s.hot.Delete(ctx, key))
+	}
+	return nil
+}
+
+// demote drops the hot copy of a chunk object.
+func (t *Tierer) demote(ctx context.Context, e *tierEntry) error {
+	if _, err := t.setTier(ctx, e, TierCold); err != nil {
+		return err
+	}
+	return errors.EnsureStack(t.s.hot.Delete(ctx, chunkKey(e.ChunkID, e.Gen)))
+}
+
+// setTier records the tier of a live chunk object, and returns the number of objects updated.
+func (t *Tierer) setTier(ctx context.Context, e *tierEntry, tier Tier) (int64, error) {
+	res, err := t.s.db.ExecContext(ctx, `
+		UPDATE storage.chunk_objects SET tier = $3
+		WHERE chunk_id = $1 AND gen = $2 AND tombstone = FALSE
+	`, e.ChunkID, e.Gen, tier)
+	if err != nil {
+		return 0, errors.Wrapf(err, "set tier of chunk %v", e.ChunkID)
+	}
+	n, err := res.RowsAffected()
+	return n, errors.EnsureStack(err)
+}
+
+// listHot returns the keys of the copies in the hot tier.
+func (t *Tierer) listHot(ctx context.Context) (map[string]bool, error) {
+	present := make(map[string]bool)
+	if err := stream.ForEach(ctx, t.s.hot.NewKeyIterator(kv.Span{}), func(key []byte) error {
+		present[string(key)] = true
+		return nil
+	}); err != nil {
+		return nil, errors.Wrap(err, "list hot tier")
+	}
+	return present, nil
+}
+
+// dropStrays deletes the copies in the hot tier of chunk objects that are not recorded as being
+// there, which are left behind if a demotion is interrupted.
+func (t *Tierer) dropStrays(ctx context.Context, present, hot map[string]bool) error {
+	for key := range present {
+		if hot[key] {
+			continue
+		}
+		if err := t.s.hot.Delete(ctx, []byte(key)); err != nil {
+			return errors.EnsureStack(err)
+		}
+	}
+	return nil
+}
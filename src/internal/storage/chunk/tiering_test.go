@@ -0,0 +1,121 @@
+package chunk
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/dockertestenv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pctx"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/kv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/track"
+	"github.com/pachyderm/pachyderm/v2/src/internal/stream"
+)
+
+func TestTieringPlan(t *testing.T) {
+	now := time.Now()
+	ago := func(d time.Duration) *time.Time {
+		t := now.Add(-d)
+		return &t
+	}
+	ent := func(id string, tier Tier, size int64, accessedAt *time.Time, accesses int64) *tierEntry {
+		return &tierEntry{
+			Entry:       Entry{ChunkID: ID(id)},
+			Size:        size,
+			Tier:        tier,
+			CreatedAt:   now.Add(-48 * time.Hour),
+			AccessedAt:  accessedAt,
+			AccessCount: accesses,
+		}
+	}
+	frequent := ent("frequent", TierCold, 10, ago(time.Minute), 5)
+	rare := ent("rare", TierCold, 10, ago(time.Minute), 1)
+	recent := ent("recent", TierHot, 10, ago(time.Hour), 0)
+	stale := ent("stale", TierHot, 10, ago(2*time.Hour), 0)
+	never := ent("never", TierHot, 10, nil, 0)
+	pinned := ent("pinned", TierCold, 10, nil, 0)
+	pinned.pinned = true
+	ents := []*tierEntry{frequent, rare, recent, stale, never, pinned}
+	names := func(es ...*tierEntry) []string {
+		var names []string
+		for _, e := range es {
+			names = append(names, string(e.ChunkID))
+		}
+		return names
+	}
+
+	p := &TieringPolicy{PromoteAccesses: 3, DemoteAfter: 90 * time.Minute}
+	promote, demote := p.plan(ents, now)
+	require.ElementsEqual(t, names(frequent, pinned), names(promote...))
+	require.ElementsEqual(t, names(stale, never), names(demote...))
+
+	// With room for three chunks, the pinned chunk comes first, then the most recently read.
+	p.MaxHotBytes = 30
+	promote, demote = p.plan(ents, now)
+	require.ElementsEqual(t, names(frequent, pinned), names(promote...))
+	require.ElementsEqual(t, names(stale, never), names(demote...))
+	p.MaxHotBytes = 20
+	promote, demote = p.plan(ents, now)
+	require.ElementsEqual(t, names(frequent, pinned), names(promote...))
+	require.ElementsEqual(t, names(recent, stale, never), names(demote...))
+
+	// Without promotion or demotion, only pinned chunks move.
+	p = &TieringPolicy{}
+	promote, demote = p.plan(ents, now)
+	require.ElementsEqual(t, names(pinned), names(promote...))
+	require.Equal(t, 0, len(demote))
+}
+
+func TestTierer(t *testing.T) {
+	ctx := pctx.TestContext(t)
+	db := dockertestenv.NewTestDB(t)
+	tracker := track.NewTestTracker(t, db)
+	hot := kv.NewFSStore(t.TempDir(), 512, DefaultMaxChunkSize)
+	_, s := NewTestStorage(t, db, tracker, WithHotTier(hot))
+	writeRandom(t, s)
+	countHot := func() int {
+		var n int
+		require.NoError(t, stream.ForEach(ctx, hot.NewKeyIterator(kv.Span{}), func([]byte) error {
+			n++
+			return nil
+		}))
+		return n
+	}
+	var ids []ID
+	var gens []uint64
+	require.NoError(t, s.ListStore(ctx, func(id ID, gen uint64) error {
+		ids = append(ids, id)
+		gens = append(gens, gen)
+		return nil
+	}))
+	require.True(t, len(ids) > 1)
+	key := chunkKey(ids[0], gens[0])
+
+	// Read one chunk often enough to be promoted.
+	for i := 0; i < 3; i++ {
+		require.NoError(t, s.pool.GetF(ctx, s.store, key, func([]byte) error { return nil }))
+	}
+	tierer := NewTierer(s, time.Minute, TieringPolicy{PromoteAccesses: 3, DemoteAfter: time.Hour})
+	require.NoError(t, tierer.RunOnce(ctx))
+	require.Equal(t, 1, countHot())
+	var tier Tier
+	require.NoError(t, db.GetContext(ctx, &tier, `SELECT tier FROM storage.chunk_objects WHERE chunk_id = $1`, ids[0]))
+	require.Equal(t, TierHot, tier)
+	// Reads are served from the hot tier.
+	require.NoError(t, s.pool.GetF(ctx, s.store, key, func(data []byte) error {
+		return verifyData(ids[0], data)
+	}))
+
+	// If the hot tier loses the copy, e.g. because it moved to another node, it is copied again.
+	require.NoError(t, hot.Delete(ctx, key))
+	require.NoError(t, tierer.RunOnce(ctx))
+	require.Equal(t, 1, countHot())
+
+	// Once the chunk goes unread for long enough, its hot copy is dropped.
+	_, err := db.ExecContext(ctx, `UPDATE storage.chunk_objects SET accessed_at = CURRENT_TIMESTAMP - interval '2 hours'`)
+	require.NoError(t, err)
+	require.NoError(t, tierer.RunOnce(ctx))
+	require.Equal(t, 0, countHot())
+	require.NoError(t, db.GetContext(ctx, &tier, `SELECT tier FROM storage.chunk_objects WHERE chunk_id = $1`, ids[0]))
+	require.Equal(t, TierCold, tier)
+}
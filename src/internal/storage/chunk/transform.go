@@ -6,9 +6,12 @@ import (
 	"context"
 	"crypto/cipher"
 	"io"
+	"sync"
 
+	"github.com/klauspost/compress/zstd"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pachhash"
+	"github.com/pierrec/lz4/v4"
 	"golang.org/x/crypto/chacha20"
 	"google.golang.org/protobuf/proto"
 )
@@ -43,26 +46,30 @@ func Create(ctx context.Context, opts CreateOptions, ptext []byte, createFunc fu
 	}, nil
 }
 
-// Get calls client.Get to retrieve a chunk, then verifies, decrypts, and decompresses the data.
+// Get calls client.Get to retrieve a chunk, then verifies, decrypts using dek, and decompresses the data.
+// dek is the unwrapped data encryption key of the chunk.
 // the uncompressed plaintext, is returned.
-func Get(ctx context.Context, client Client, ref *Ref) ([]byte, error) {
+func Get(ctx context.Context, client Client, ref *Ref, dek []byte) ([]byte, error) {
 	if ref.EncryptionAlgo != EncryptionAlgo_CHACHA20 {
 		return nil, errors.Errorf("unknown encryption algorithm %d", ref.EncryptionAlgo)
 	}
 	var rawData []byte
-	err := client.Get(ctx, ref.Id, func(ctext []byte) error {
+	err := client.Get(ctx, ref.Id, func(ctext []byte) (retErr error) {
 		rawData = nil
 		if err := verifyData(ref.Id, ctext); err != nil {
 			return err
 		}
 		var r io.Reader = bytes.NewReader(ctext)
 		var err error
-		if r, err = decrypt(ref.Dek, r); err != nil {
+		if r, err = decrypt(dek, r); err != nil {
 			return err
 		}
 		if r, err = decompress(ref.CompressionAlgo, r); err != nil {
 			return err
 		}
+		if rc, ok := r.(io.Closer); ok {
+			defer errors.Close(&retErr, rc, "close decompressor")
+		}
 		rawData, err = io.ReadAll(r)
 		if err != nil {
 			return errors.EnsureStack(err)
@@ -81,33 +88,69 @@ func compress(algo CompressionAlgo, dst, src []byte) (CompressionAlgo, int, erro
 	case CompressionAlgo_NONE:
 		copy(dst, src)
 		return CompressionAlgo_NONE, len(src), nil
-	case CompressionAlgo_GZIP_BEST_SPEED:
+	case CompressionAlgo_GZIP_BEST_SPEED, CompressionAlgo_ZSTD, CompressionAlgo_LZ4:
 		lw := newLimitWriter(dst)
-		err := func() (retErr error) {
-			gw, err := gzip.NewWriterLevel(lw, gzip.BestSpeed)
+		err := func() error {
+			cw, err := newCompressWriter(algo, lw)
 			if err != nil {
-				return errors.EnsureStack(err)
+				return err
 			}
-			defer func() {
-				if err := gw.Close(); retErr == nil {
-					retErr = err
-				}
-			}()
-			_, err = gw.Write(src)
-			if err != nil {
-				return errors.EnsureStack(err)
+			// Not all compressors can be closed twice, so close explicitly
+			// rather than deferring.
+			if _, err := cw.Write(src); err != nil {
+				return errors.Join(errors.EnsureStack(err), cw.Close())
 			}
-			return errors.EnsureStack(gw.Close())
+			return errors.EnsureStack(cw.Close())
 		}()
 		if errors.Is(err, io.ErrShortWrite) {
 			return compress(CompressionAlgo_NONE, dst, src)
 		}
-		return CompressionAlgo_GZIP_BEST_SPEED, lw.pos, err
+		return algo, lw.pos, err
 	default:
 		return 0, 0, errors.Errorf("unrecognized compression: %v", algo)
 	}
 }
 
+func newCompressWriter(algo CompressionAlgo, w io.Writer) (io.WriteCloser, error) {
+	switch algo {
+	case CompressionAlgo_GZIP_BEST_SPEED:
+		gw, err := gzip.NewWriterLevel(w, gzip.BestSpeed)
+		return gw, errors.EnsureStack(err)
+	case CompressionAlgo_ZSTD:
+		zw, ok := zstdEncoders.Get().(*zstd.Encoder)
+		if !ok {
+			// Chunks are compressed in a single call, so concurrency within
+			// the encoder doesn't help.
+			var err error
+			if zw, err = zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.SpeedFastest), zstd.WithEncoderConcurrency(1)); err != nil {
+				return nil, errors.EnsureStack(err)
+			}
+		}
+		zw.Reset(w)
+		return pooledZstdEncoder{zw}, nil
+	case CompressionAlgo_LZ4:
+		return lz4.NewWriter(w), nil
+	default:
+		return nil, errors.Errorf("unrecognized compression: %v", algo)
+	}
+}
+
+// zstdEncoders holds zstd encoders for reuse, since creating one allocates
+// several large buffers.
+var zstdEncoders sync.Pool
+
+// pooledZstdEncoder returns its encoder to zstdEncoders when closed.
+type pooledZstdEncoder struct {
+	*zstd.Encoder
+}
+
+func (e pooledZstdEncoder) Close() error {
+	err := e.Encoder.Close()
+	e.Encoder.Reset(nil)
+	zstdEncoders.Put(e.Encoder)
+	return errors.EnsureStack(err)
+}
+
 func decompress(algo CompressionAlgo, r io.Reader) (io.Reader, error) {
 	switch algo {
 	case CompressionAlgo_NONE:
@@ -118,6 +161,14 @@ func decompress(algo CompressionAlgo, r io.Reader) (io.Reader, error) {
 			return nil, errors.EnsureStack(err)
 		}
 		return gr, nil
+	case CompressionAlgo_ZSTD:
+		zr, err := zstd.NewReader(r, zstd.WithDecoderConcurrency(1))
+		if err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		return zr.IOReadCloser(), nil
+	case CompressionAlgo_LZ4:
+		return lz4.NewReader(r), nil
 	default:
 		return nil, errors.Errorf("unrecognized compression: %v", algo)
 	}
@@ -0,0 +1,170 @@
+package chunk
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"math"
+	"math/rand"
+	"testing"
+
+	units "github.com/docker/go-units"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+)
+
+var compressionAlgos = []CompressionAlgo{
+	CompressionAlgo_NONE,
+	CompressionAlgo_GZIP_BEST_SPEED,
+	CompressionAlgo_ZSTD,
+	CompressionAlgo_LZ4,
+}
+
+// rawImageData returns 'n' bytes resembling uncompressed 8-bit RGB pixels:
+// smooth gradients with some sensor noise.
+func rawImageData(random *rand.Rand, n int) []byte {
+	const width = 1024
+	data := make([]byte, n)
+	for i := 0; i < n; i += 3 {
+		x, y := (i/3)%width, (i/3)/width
+		for c := 0; c < 3 && i+c < n; c++ {
+			v := 128 + 100*math.Sin(float64(x*(c+1))/200) + 20*math.Cos(float64(y)/150)
+			data[i+c] = byte(int(v) + random.Intn(2))
+		}
+	}
+	return data
+}
+
+// encodedImageData returns 'n' bytes resembling already compressed images,
+// e.g. JPEGs, which are effectively incompressible.
+func encodedImageData(random *rand.Rand, n int) []byte {
+	data := make([]byte, n)
+	random.Read(data)
+	return data
+}
+
+// parquetData returns 'n' bytes resembling the column chunks of a parquet
+// file: sorted int64 IDs, timestamps, dictionary-encoded categories and
+// float measurements, stored column by column.
+func parquetData(random *rand.Rand, n int) []byte {
+	const rowSize = 8 + 8 + 4 + 8
+	rows := n / rowSize
+	buf := bytes.NewBuffer(make([]byte, 0, n))
+	var b [8]byte
+	id, ts := int64(random.Intn(1<<20)), int64(1700000000000)
+	for i := 0; i < rows; i++ {
+		id += int64(1 + random.Intn(3))
+		binary.LittleEndian.PutUint64(b[:], uint64(id))
+		buf.Write(b[:])
+	}
+	for i := 0; i < rows; i++ {
+		ts += int64(random.Intn(1000))
+		binary.LittleEndian.PutUint64(b[:], uint64(ts))
+		buf.Write(b[:])
+	}
+	for i := 0; i < rows; i++ {
+		binary.LittleEndian.PutUint32(b[:4], uint32(random.Intn(16)))
+		buf.Write(b[:4])
+	}
+	for i := 0; i < rows; i++ {
+		binary.LittleEndian.PutUint64(b[:], math.Float64bits(math.Round(random.NormFloat64()*1000)/100))
+		buf.Write(b[:])
+	}
+	buf.Write(make([]byte, n-buf.Len()))
+	return buf.Bytes()
+}
+
+var compressionData = []struct {
+	name string
+	gen  func(*rand.Rand, int) []byte
+}{
+	{"raw-image", rawImageData},
+	{"encoded-image", encodedImageData},
+	{"parquet", parquetData},
+}
+
+func TestCompress(t *testing.T) {
+	random := rand.New(rand.NewSource(0))
+	for _, data := range compressionData {
+		src := data.gen(random, units.MB)
+		for _, algo := range compressionAlgos {
+			t.Run(data.name+"/"+algo.String(), func(t *testing.T) {
+				dst := make([]byte, len(src))
+				used, n, err := compress(algo, dst, src)
+				require.NoError(t, err)
+				if used != algo {
+					// Compression may only be skipped if it doesn't help.
+					require.Equal(t, CompressionAlgo_NONE, used)
+					require.Equal(t, len(src), n)
+				}
+				r, err := decompress(used, bytes.NewReader(dst[:n]))
+				require.NoError(t, err)
+				got, err := io.ReadAll(r)
+				require.NoError(t, err)
+				require.True(t, bytes.Equal(src, got))
+			})
+		}
+	}
+}
+
+// BenchmarkCompress compares the throughput and compression ratio of the chunk
+// compression algorithms on typical data.
+func BenchmarkCompress(b *testing.B) {
+	random := rand.New(rand.NewSource(0))
+	for _, data := range compressionData {
+		src := data.gen(random, DefaultMaxChunkSize)
+		for _, algo := range compressionAlgos {
+			b.Run(data.name+"/"+algo.String(), func(b *testing.B) {
+				dst := make([]byte, len(src))
+				b.SetBytes(int64(len(src)))
+				b.ResetTimer()
+				var n int
+				for i := 0; i < b.N; i++ {
+					var err error
+					_, n, err = compress(algo, dst, src)
+					require.NoError(b, err)
+				}
+				b.ReportMetric(float64(len(src))/float64(n), "ratio")
+			})
+		}
+	}
+}
+
+// BenchmarkDecompress compares the decompression throughput of the chunk
+// compression algorithms on typical data.
+func BenchmarkDecompress(b *testing.B) {
+	random := rand.New(rand.NewSource(0))
+	for _, data := range compressionData {
+		src := data.gen(random, DefaultMaxChunkSize)
+		for _, algo := range compressionAlgos {
+			b.Run(data.name+"/"+algo.String(), func(b *testing.B) {
+				dst := make([]byte, len(src))
+				used, n, err := compress(algo, dst, src)
+				require.NoError(b, err)
+				b.SetBytes(int64(len(src)))
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					r, err := decompress(used, bytes.NewReader(dst[:n]))
+					require.NoError(b, err)
+					_, err = io.Copy(io.Discard, r)
+					require.NoError(b, err)
+				}
+			})
+		}
+	}
+}
+
+func TestParseCompressionAlgo(t *testing.T) {
+	for name, want := range map[string]CompressionAlgo{
+		"none": CompressionAlgo_NONE,
+		"gzip": CompressionAlgo_GZIP_BEST_SPEED,
+		"zstd": CompressionAlgo_ZSTD,
+		"LZ4":  CompressionAlgo_LZ4,
+	} {
+		got, err := ParseCompressionAlgo(name)
+		require.NoError(t, err)
+		require.Equal(t, want, got)
+	}
+	_, err := ParseCompressionAlgo("brotli")
+	require.YesError(t, err)
+}
@@ -50,7 +50,7 @@ func (u *Uploader) Upload(meta interface{}, r io.Reader) error {
 	var dataRefs []*DataRef
 	if err := ComputeChunks(r, func(chunkBytes []byte) error {
 		return u.taskChain.CreateTask(func(ctx context.Context) (func() error, error) {
-			dataRef, err := upload(ctx, u.client, chunkBytes, nil, u.noUpload)
+			dataRef, err := upload(ctx, u.storage, u.client, chunkBytes, nil, u.noUpload)
 			if err != nil {
 				return nil, err
 			}
@@ -97,7 +97,7 @@ func (u *Uploader) Copy(meta interface{}, dataRefs []*DataRef) error {
 			var err error
 			dataRefs, err = u.align(u.ctx, dataRefs, func(chunk []byte) error {
 				return u.taskChain.CreateTask(func(ctx context.Context) (func() error, error) {
-					dataRef, err := upload(ctx, u.client, chunk, nil, u.noUpload)
+					dataRef, err := upload(ctx, u.storage, u.client, chunk, nil, u.noUpload)
 					if err != nil {
 						return nil, err
 					}
@@ -178,7 +178,7 @@ func (u *Uploader) CopyByReference(meta interface{}, dataRefs []*DataRef) error
 	})
 }
 
-func upload(ctx context.Context, client Client, chunkBytes []byte, pointsTo []ID, noUpload bool) (*DataRef, error) {
+func upload(ctx context.Context, s *Storage, client Client, chunkBytes []byte, pointsTo []ID, noUpload bool) (*DataRef, error) {
 	md := Metadata{
 		Size:     len(chunkBytes),
 		PointsTo: pointsTo,
@@ -192,10 +192,17 @@ func upload(ctx context.Context, client Client, chunkBytes []byte, pointsTo []ID
 			return Hash(data), nil
 		}
 	}
-	ref, err := Create(ctx, CreateOptions{}, chunkBytes, createFunc)
+	// Only the compression is configurable; chunks are not keyed by the
+	// storage secret, so that the same bytes always produce the same chunk.
+	ref, err := Create(ctx, CreateOptions{Compression: s.createOpts.Compression}, chunkBytes, createFunc)
 	if err != nil {
 		return nil, err
 	}
+	if s.keys != nil {
+		if err := s.wrapDEK(ctx, ref, !noUpload); err != nil {
+			return nil, err
+		}
+	}
 	contentHash := Hash(chunkBytes)
 	return &DataRef{
 		Hash:      contentHash,
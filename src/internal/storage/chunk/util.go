@@ -19,6 +19,8 @@ func NewTestStorage(t testing.TB, db *pachsql.DB, tr track.Tracker, opts ...Stor
 	store := kv.NewFSStore(p, 512, DefaultMaxChunkSize)
 	db.MustExec(`CREATE SCHEMA IF NOT EXISTS storage`)
 	require.NoError(t, dbutil.WithTx(context.Background(), db, SetupPostgresStoreV0))
+	require.NoError(t, dbutil.WithTx(context.Background(), db, SetupPostgresStoreV1))
+	require.NoError(t, dbutil.WithTx(context.Background(), db, SetupPostgresStoreV2))
 	return store, NewStorage(store, db, tr, opts...)
 }
 
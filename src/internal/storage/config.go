@@ -17,11 +17,24 @@ const (
 )
 
 // MakeChunkOptions returns the chunk storage options for the config.
-func makeChunkOptions(conf *pachconfig.StorageConfiguration) (opts []chunk.StorageOption) {
+func makeChunkOptions(conf *pachconfig.StorageConfiguration) (opts []chunk.StorageOption, _ error) {
 	if conf.StorageMemoryCacheSize > 0 {
 		opts = append(opts, chunk.WithMemoryCacheSize(conf.StorageMemoryCacheSize))
 	}
-	return opts
+	if conf.StorageCompression != "" {
+		algo, err := chunk.ParseCompressionAlgo(conf.StorageCompression)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, chunk.WithCompression(algo))
+	}
+	if conf.StorageKeyDir != "" {
+		opts = append(opts, chunk.WithKeyProvider(chunk.NewFileKeyProvider(conf.StorageKeyDir)))
+	}
+	if conf.StorageHotTierPath != "" {
+		opts = append(opts, chunk.WithHotTier(kv.NewFSStore(conf.StorageHotTierPath, maxKeySize, chunk.DefaultMaxChunkSize)))
+	}
+	return opts, nil
 }
 
 func makeFilesetOptions(conf *pachconfig.StorageConfiguration) (opts []fileset.StorageOption) {
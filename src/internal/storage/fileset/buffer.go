@@ -5,6 +5,8 @@ import (
 	"io"
 	"sort"
 	"strings"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset/index"
 )
 
 type Buffer struct {
@@ -20,10 +22,11 @@ type file struct {
 }
 
 // contents are either raw bytes to be appended or an existing file to be copied
-// Exactly one will be non-nil
+// Exactly one of buf and copy will be non-nil
 type fileContent struct {
-	buf  *bytes.Buffer
-	copy File
+	buf      *bytes.Buffer
+	metadata *index.FileMetadata // Only set for raw bytes; copies carry their own.
+	copy     File
 }
 
 func NewBuffer() *Buffer {
@@ -49,14 +52,18 @@ func (b *Buffer) add(path, datum string) *file {
 	return datumFiles[datum]
 }
 
-func (b *Buffer) Add(path, datum string) io.Writer {
+// Add returns a writer that appends to a file.  If md is not nil, it replaces the metadata of the
+// file.
+func (b *Buffer) Add(path, datum string, md *index.FileMetadata) io.Writer {
 	f := b.add(path, datum)
-	if len(f.contents) > 0 && f.contents[len(f.contents)-1].copy == nil {
-		return f.contents[len(f.contents)-1].buf
+	if len(f.contents) == 0 || f.contents[len(f.contents)-1].copy != nil {
+		f.contents = append(f.contents, fileContent{buf: &bytes.Buffer{}})
+	}
+	content := &f.contents[len(f.contents)-1]
+	if md != nil {
+		content.metadata = md
 	}
-	buf := &bytes.Buffer{}
-	f.contents = append(f.contents, fileContent{buf: buf})
-	return buf
+	return content.buf
 }
 
 func (b *Buffer) Delete(path, datum string) {
@@ -92,14 +99,14 @@ func (b *Buffer) Copy(file File, datum string) {
 	f.contents = append(f.contents, fileContent{copy: file})
 }
 
-func (b *Buffer) WalkAdditive(onAdd func(path, datum string, r io.Reader) error, onCopy func(file File, datum string) error) error {
+func (b *Buffer) WalkAdditive(onAdd func(path, datum string, md *index.FileMetadata, r io.Reader) error, onCopy func(file File, datum string) error) error {
 	for _, file := range sortFiles(b.additive) {
 		for _, content := range file.contents {
 			if content.copy != nil {
 				if err := onCopy(content.copy, file.datum); err != nil {
 					return err
 				}
-			} else if err := onAdd(file.path, file.datum, bytes.NewReader(content.buf.Bytes())); err != nil {
+			} else if err := onAdd(file.path, file.datum, content.metadata, bytes.NewReader(content.buf.Bytes())); err != nil {
 				return err
 			}
 		}
@@ -8,10 +8,13 @@ import (
 	"math/rand"
 	"sort"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
 	units "github.com/docker/go-units"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/dockertestenv"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
@@ -354,3 +357,121 @@ func testStableHash(ctx context.Context, t *testing.T, data, expected []byte, ms
 	}
 	require.True(t, bytes.Equal(stableHash, getHash()), msg)
 }
+
+func TestMetadata(t *testing.T) {
+	ctx := pctx.TestContext(t)
+	s := newTestStorage(ctx, t)
+	put := func(data string, md *index.FileMetadata) ID {
+		uw, err := s.NewUnorderedWriter(ctx)
+		require.NoError(t, err)
+		require.NoError(t, uw.PutWithMetadata(ctx, "/test", "", true, md, strings.NewReader(data)))
+		id, err := uw.Close(ctx)
+		require.NoError(t, err)
+		return *id
+	}
+	check := func(ids []ID, wantData string, want *index.FileMetadata) {
+		fs, err := s.Open(ctx, ids)
+		require.NoError(t, err)
+		var n int
+		require.NoError(t, fs.Iterate(ctx, func(f File) error {
+			n++
+			buf := &bytes.Buffer{}
+			require.NoError(t, f.Content(ctx, buf))
+			require.Equal(t, wantData, buf.String())
+			require.Equal(t, "", cmp.Diff(want, f.Index().File.Metadata, protocmp.Transform()))
+			return nil
+		}))
+		require.Equal(t, 1, n)
+	}
+	md1 := &index.FileMetadata{ContentType: "text/plain", Attributes: map[string]string{"a": "1"}}
+	md2 := &index.FileMetadata{ContentType: "text/csv"}
+	id1 := put("a", md1)
+	check([]ID{id1}, "a", md1)
+	// Appending without metadata keeps the metadata of the file.
+	id2 := put("b", nil)
+	check([]ID{id1, id2}, "ab", md1)
+	// Appending with metadata replaces it.
+	id3 := put("c", md2)
+	check([]ID{id1, id2, id3}, "abc", md2)
+	// Copies keep the metadata of the file that they copy.
+	fs, err := s.Open(ctx, []ID{id1, id2, id3})
+	require.NoError(t, err)
+	w := s.NewWriter(ctx)
+	require.NoError(t, CopyFiles(ctx, w, fs))
+	copyID, err := w.Close()
+	require.NoError(t, err)
+	check([]ID{*copyID}, "abc", md2)
+}
+
+func TestPurge(t *testing.T) {
+	ctx := pctx.TestContext(t)
+	storage := newTestStorage(ctx, t)
+	files := []*testFile{
+		{path: "/a", datum: "x", data: []byte("a")},
+		{path: "/b/c", datum: "x", data: []byte("c")},
+		{path: "/b/d", datum: "y", data: []byte("d")},
+		{path: "/e", datum: "y", data: randutil.Bytes(rand.New(rand.NewSource(0)), DefaultBatchThreshold)},
+	}
+	id := writeFileSet(ctx, t, storage, files)
+	purgeB := func(idx *index.Index) bool { return strings.HasPrefix(idx.Path, "/b/") }
+
+	purgedID, purged, err := storage.Purge(ctx, id, purgeB, nil, time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(purged))
+	purgedIdxs := purged
+	purgedChunks := make(map[string]bool)
+	for _, chunkID := range PurgedChunks(purged) {
+		purgedChunks[string(chunkID)] = true
+	}
+	fs, err := storage.Open(ctx, []ID{*purgedID})
+	require.NoError(t, err)
+	expected := []*testFile{files[0], files[3]}
+	require.NoError(t, fs.Iterate(ctx, func(f File) error {
+		require.Equal(t, expected[0].path, f.Index().Path)
+		require.Equal(t, expected[0].datum, f.Index().File.Datum)
+		checkFile(ctx, t, f, expected[0])
+		require.False(t, sharesChunk(f.Index(), purgedChunks))
+		expected = expected[1:]
+		return nil
+	}))
+	require.Equal(t, 0, len(expected))
+
+	// Purging again finds nothing to purge.
+	rewrittenID, purged, err := storage.Purge(ctx, *purgedID, purgeB, PurgedChunks(purged), time.Hour)
+	require.NoError(t, err)
+	require.Nil(t, rewrittenID)
+	require.Equal(t, 0, len(purged))
+
+	// A file set with none of the purged files is still rewritten if its files
+	// share the chunks purged from another file set.
+	rewrittenID, purged, err = storage.Purge(ctx, id, func(*index.Index) bool { return false }, PurgedChunks(purgedIdxs), time.Hour)
+	require.NoError(t, err)
+	require.NotNil(t, rewrittenID)
+	require.Equal(t, 0, len(purged))
+	fs, err = storage.Open(ctx, []ID{*rewrittenID})
+	require.NoError(t, err)
+	n := 0
+	require.NoError(t, fs.Iterate(ctx, func(f File) error {
+		checkFile(ctx, t, f, files[n])
+		require.False(t, sharesChunk(f.Index(), purgedChunks))
+		n++
+		return nil
+	}))
+	require.Equal(t, len(files), n)
+
+	// Deletes of purged files are left out too.
+	w := storage.NewWriter(ctx)
+	require.NoError(t, w.Add("/a", "x", bytes.NewReader([]byte("a"))))
+	require.NoError(t, w.Delete("/b/c", "x"))
+	deletesID, err := w.Close()
+	require.NoError(t, err)
+	rewrittenID, purged, err = storage.Purge(ctx, *deletesID, purgeB, nil, time.Hour)
+	require.NoError(t, err)
+	require.NotNil(t, rewrittenID)
+	require.Equal(t, 0, len(purged))
+	fs, err = storage.Open(ctx, []ID{*rewrittenID})
+	require.NoError(t, err)
+	require.NoError(t, fs.IterateDeletes(ctx, func(f File) error {
+		return errors.Errorf("unexpected delete of %s", f.Index().Path)
+	}))
+}
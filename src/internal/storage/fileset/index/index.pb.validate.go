@@ -385,6 +385,35 @@ func (m *File) validate(all bool) error {
 
 	}
 
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FileValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FileValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FileValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return FileMultiError(errors)
 	}
@@ -461,3 +490,106 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = FileValidationError{}
+
+// Validate checks the field values on FileMetadata with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FileMetadata) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FileMetadata with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FileMetadataMultiError, or
+// nil if none found.
+func (m *FileMetadata) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FileMetadata) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ContentType
+
+	// no validation rules for Attributes
+
+	if len(errors) > 0 {
+		return FileMetadataMultiError(errors)
+	}
+
+	return nil
+}
+
+// FileMetadataMultiError is an error wrapping multiple validation errors
+// returned by FileMetadata.ValidateAll() if the designated constraints aren't met.
+type FileMetadataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FileMetadataMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FileMetadataMultiError) AllErrors() []error { return m }
+
+// FileMetadataValidationError is the validation error returned by
+// FileMetadata.Validate if the designated constraints aren't met.
+type FileMetadataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FileMetadataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FileMetadataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FileMetadataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FileMetadataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FileMetadataValidationError) ErrorName() string { return "FileMetadataValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FileMetadataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFileMetadata.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FileMetadataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FileMetadataValidationError{}
@@ -5,6 +5,7 @@
 package index
 
 import (
+	fmt "fmt"
 	zapcore "go.uber.org/zap/zapcore"
 )
 
@@ -58,5 +59,24 @@ func (x *File) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 		return nil
 	}
 	enc.AddArray("data_refs", zapcore.ArrayMarshalerFunc(data_refsArrMarshaller))
+	if obj, ok := interface{}(x.Metadata).(zapcore.ObjectMarshaler); ok {
+		enc.AddObject("metadata", obj)
+	} else {
+		enc.AddReflected("metadata", x.Metadata)
+	}
+	return nil
+}
+
+func (x *FileMetadata) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddString("content_type", x.ContentType)
+	enc.AddObject("attributes", zapcore.ObjectMarshalerFunc(func(enc zapcore.ObjectEncoder) error {
+		for k, v := range x.Attributes {
+			enc.AddString(fmt.Sprintf("%v", k), v)
+		}
+		return nil
+	}))
 	return nil
 }
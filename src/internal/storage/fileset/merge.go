@@ -58,12 +58,17 @@ func (mr *MergeReader) Iterate(ctx context.Context, cb func(File) error, opts ..
 			return cb(newFileReader(mr.chunks, fss[0].file.Index()))
 		}
 		var dataRefs []*chunk.DataRef
+		var md *index.FileMetadata
 		for _, fs := range fss {
 			idx := fs.file.Index()
 			dataRefs = append(dataRefs, idx.File.DataRefs...)
+			if idx.File.Metadata != nil {
+				md = idx.File.Metadata
+			}
 		}
 		mergeIdx := fss[0].file.Index()
 		mergeIdx.File.DataRefs = dataRefs
+		mergeIdx.File.Metadata = md
 		return cb(newMergeFileReader(mr.chunks, mergeIdx))
 
 	})
@@ -0,0 +1,142 @@
+package fileset
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/miscutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/chunk"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset/index"
+)
+
+// Purge writes a copy of the file set with the given ID that leaves out the
+// files that match purge, along with their deletes, and returns the ID of the
+// copy and the indexes of the files that were left out.  If nothing needs to
+// change, Purge returns a nil ID and writes nothing.
+//
+// Unlike a delete, a purge leaves the copy with no reference to the purged
+// chunks: the chunks of the files that were left out, and any of 'chunks',
+// which are those of files purged from other file sets.  A copied file whose
+// data shares one of them is rewritten from its content rather than copied by
+// reference.  Purge does not renew id.
+func (s *Storage) Purge(ctx context.Context, id ID, purge func(idx *index.Index) bool, chunks []chunk.ID, ttl time.Duration) (*ID, []*index.Index, error) {
+	fs, err := s.Open(ctx, []ID{id})
+	if err != nil {
+		return nil, nil, err
+	}
+	var purged []*index.Index
+	purgedChunks := make(map[string]bool)
+	for _, chunkID := range chunks {
+		purgedChunks[string(chunkID)] = true
+	}
+	if err := fs.Iterate(ctx, func(f File) error {
+		idx := f.Index()
+		if !purge(idx) {
+			return nil
+		}
+		purged = append(purged, idx)
+		for _, chunkID := range index.PointsTo(idx) {
+			purgedChunks[string(chunkID)] = true
+		}
+		return nil
+	}); err != nil {
+		return nil, nil, errors.EnsureStack(err)
+	}
+	changed := len(purged) > 0
+	if !changed {
+		if changed, err = s.needsPurge(ctx, fs, purge, purgedChunks); err != nil {
+			return nil, nil, err
+		}
+	}
+	if !changed {
+		return nil, nil, nil
+	}
+	w := s.newWriter(ctx, WithTTL(ttl))
+	if err := fs.IterateDeletes(ctx, func(f File) error {
+		idx := f.Index()
+		if purge(idx) {
+			return nil
+		}
+		return w.Delete(idx.Path, idx.File.Datum)
+	}); err != nil {
+		return nil, nil, errors.EnsureStack(err)
+	}
+	if err := fs.Iterate(ctx, func(f File) error {
+		idx := f.Index()
+		if purge(idx) {
+			return nil
+		}
+		if !sharesChunk(idx, purgedChunks) {
+			return w.Copy(f, idx.File.Datum)
+		}
+		return miscutil.WithPipe(func(pw io.Writer) error {
+			return f.Content(ctx, pw)
+		}, func(r io.Reader) error {
+			return w.add(idx.Path, idx.File.Datum, idx.File.Metadata, r)
+		})
+	}); err != nil {
+		return nil, nil, errors.EnsureStack(err)
+	}
+	purgedID, err := w.Close()
+	if err != nil {
+		return nil, nil, err
+	}
+	return purgedID, purged, nil
+}
+
+// needsPurge reports whether a file set with no files to purge still has to
+// be rewritten, because it has a delete to purge or a file that shares one of
+// the purged chunks.
+func (s *Storage) needsPurge(ctx context.Context, fs FileSet, purge func(idx *index.Index) bool, chunks map[string]bool) (bool, error) {
+	var found bool
+	if err := fs.IterateDeletes(ctx, func(f File) error {
+		if purge(f.Index()) {
+			found = true
+			return errutil.ErrBreak
+		}
+		return nil
+	}); err != nil && !errors.Is(err, errutil.ErrBreak) {
+		return false, errors.EnsureStack(err)
+	}
+	if found || len(chunks) == 0 {
+		return found, nil
+	}
+	if err := fs.Iterate(ctx, func(f File) error {
+		if sharesChunk(f.Index(), chunks) {
+			found = true
+			return errutil.ErrBreak
+		}
+		return nil
+	}); err != nil && !errors.Is(err, errutil.ErrBreak) {
+		return false, errors.EnsureStack(err)
+	}
+	return found, nil
+}
+
+func sharesChunk(idx *index.Index, chunks map[string]bool) bool {
+	for _, chunkID := range index.PointsTo(idx) {
+		if chunks[string(chunkID)] {
+			return true
+		}
+	}
+	return false
+}
+
+// PurgedChunks returns the IDs of the chunks that the given purged files
+// referenced, without duplicates.
+func PurgedChunks(purged []*index.Index) []chunk.ID {
+	seen := make(map[string]bool)
+	var ids []chunk.ID
+	for _, idx := range purged {
+		for _, chunkID := range index.PointsTo(idx) {
+			if !seen[string(chunkID)] {
+				seen[string(chunkID)] = true
+				ids = append(ids, chunkID)
+			}
+		}
+	}
+	return ids
+}
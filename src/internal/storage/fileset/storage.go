@@ -8,10 +8,12 @@ import (
 
 	units "github.com/docker/go-units"
 	"golang.org/x/sync/semaphore"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachhash"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/chunk"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset/index"
@@ -314,6 +316,25 @@ func (s *Storage) SizeUpperBound(ctx context.Context, id ID) (int64, error) {
 	return total, nil
 }
 
+// Fingerprint returns a hash of the primitive file sets that make up the file sets at ids.
+// Clones of a file set have the same fingerprint, so it can be used to recognize equivalent
+// file sets across handles.
+func (s *Storage) Fingerprint(ctx context.Context, ids []ID) ([]byte, error) {
+	prims, err := s.flattenPrimitives(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	h := pachhash.New()
+	for _, prim := range prims {
+		data, err := proto.MarshalOptions{Deterministic: true}.Marshal(prim)
+		if err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		h.Write(data)
+	}
+	return h.Sum(nil), nil
+}
+
 // Size returns the size of the data in the file set in bytes.
 func (s *Storage) Size(ctx context.Context, id ID) (int64, error) {
 	fs, err := s.Open(ctx, []ID{id})
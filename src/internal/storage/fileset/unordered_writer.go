@@ -50,6 +50,12 @@ func newUnorderedWriter(ctx context.Context, storage *Storage, memThreshold, fil
 }
 
 func (uw *UnorderedWriter) Put(ctx context.Context, p, datum string, appendFile bool, r io.Reader) (retErr error) {
+	return uw.PutWithMetadata(ctx, p, datum, appendFile, nil, r)
+}
+
+// PutWithMetadata is like Put, but also sets the user metadata of the file.  If md is nil, the
+// file keeps any metadata it already has.
+func (uw *UnorderedWriter) PutWithMetadata(ctx context.Context, p, datum string, appendFile bool, md *index.FileMetadata, r io.Reader) (retErr error) {
 	if err := uw.validate(p); err != nil {
 		return err
 	}
@@ -59,7 +65,7 @@ func (uw *UnorderedWriter) Put(ctx context.Context, p, datum string, appendFile
 	if !appendFile {
 		uw.buffer.Delete(p, datum)
 	}
-	w := uw.buffer.Add(p, datum)
+	w := uw.buffer.Add(p, datum, md)
 	for {
 		n, err := io.CopyN(w, r, uw.memAvailable)
 		uw.memAvailable -= n
@@ -73,7 +79,7 @@ func (uw *UnorderedWriter) Put(ctx context.Context, p, datum string, appendFile
 			if err := uw.serialize(ctx); err != nil {
 				return err
 			}
-			w = uw.buffer.Add(p, datum)
+			w = uw.buffer.Add(p, datum, nil)
 		}
 	}
 	if int64(uw.buffer.Count()) >= uw.fileThreshold {
@@ -97,8 +103,8 @@ func (uw *UnorderedWriter) serialize(ctx context.Context) error {
 	}
 	return log.LogStep(ctx, "UnorderedWriter.serialize", func(_ context.Context) error {
 		return uw.withWriter(func(w *Writer) error {
-			if err := uw.buffer.WalkAdditive(func(path, datum string, r io.Reader) error {
-				return w.Add(path, datum, r)
+			if err := uw.buffer.WalkAdditive(func(path, datum string, md *index.FileMetadata, r io.Reader) error {
+				return w.add(path, datum, md, r)
 			}, func(f File, datum string) error {
 				return w.Copy(f, datum)
 			}); err != nil {
@@ -66,10 +66,15 @@ func newWriter(ctx context.Context, storage *Storage, opts ...WriterOption) *Wri
 }
 
 func (w *Writer) Add(path, datum string, r io.Reader) error {
+	return w.add(path, datum, nil, r)
+}
+
+func (w *Writer) add(path, datum string, md *index.FileMetadata, r io.Reader) error {
 	idx := &index.Index{
 		Path: path,
 		File: &index.File{
-			Datum: datum,
+			Datum:    datum,
+			Metadata: md,
 		},
 	}
 	if err := w.checkIndex(w.idx, idx); err != nil {
@@ -135,7 +140,8 @@ func (w *Writer) Copy(file File, datum string) error {
 		copyIdx := &index.Index{
 			Path: idx.Path,
 			File: &index.File{
-				Datum: datum,
+				Datum:    datum,
+				Metadata: idx.File.Metadata,
 			},
 		}
 		if _, ok := file.(*FileReader); ok {
@@ -144,17 +150,17 @@ func (w *Writer) Copy(file File, datum string) error {
 		return w.uploader.Copy(copyIdx, idx.File.DataRefs)
 	}
 	if len(idx.File.DataRefs) == 0 {
-		return w.Add(idx.Path, datum, &bytes.Buffer{})
+		return w.add(idx.Path, datum, idx.File.Metadata, &bytes.Buffer{})
 	}
 	if len(idx.File.DataRefs) == 1 {
 		r := w.storage.chunks.NewDataReader(w.ctx, idx.File.DataRefs[0])
-		return w.Add(idx.Path, datum, r)
+		return w.add(idx.Path, datum, idx.File.Metadata, r)
 	}
 	return miscutil.WithPipe(func(w2 io.Writer) error {
 		r := w.storage.chunks.NewReader(w.ctx, idx.File.DataRefs)
 		return r.Get(w2)
 	}, func(r io.Reader) error {
-		return w.Add(idx.Path, datum, r)
+		return w.add(idx.Path, datum, idx.File.Metadata, r)
 	})
 }
 
@@ -111,7 +111,8 @@ type fsIterator struct {
 func (it *fsIterator) Next(ctx context.Context, dst *[]byte) error {
 	if it.keys == nil {
 		dirEnts, err := os.ReadDir(filepath.Join(it.s.dir, "objects"))
-		if err != nil {
+		// The store is initialized lazily, so a missing directory is an empty store.
+		if err != nil && !os.IsNotExist(err) {
 			return errors.EnsureStack(err)
 		}
 		var keys [][]byte
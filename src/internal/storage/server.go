@@ -58,7 +58,10 @@ func New(env Env, config pachconfig.StorageConfiguration) (*Server, error) {
 	}
 	store = wrapStore(&config, store)
 	store = kv.NewPrefixed(store, []byte(chunkPrefix))
-	chunkStorageOpts := makeChunkOptions(&config)
+	chunkStorageOpts, err := makeChunkOptions(&config)
+	if err != nil {
+		return nil, err
+	}
 	chunkStorageOpts = append(chunkStorageOpts, chunk.WithSecret(secret))
 	chunkStorage := chunk.NewStorage(store, env.DB, tracker, chunkStorageOpts...)
 
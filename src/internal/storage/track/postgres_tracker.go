@@ -177,6 +177,29 @@ func (t *postgresTracker) GetUpstream(ctx context.Context, id string) ([]string,
 	return ups, nil
 }
 
+func (t *postgresTracker) IterateReachable(ctx context.Context, id, prefix string, cb func(id string) error) error {
+	var reachable []string
+	if err := t.db.SelectContext(ctx, &reachable,
+		`WITH RECURSIVE reachable(int_id) AS (
+			SELECT int_id FROM storage.tracker_objects WHERE str_id = $1
+			UNION
+			SELECT refs.to_id FROM storage.tracker_refs AS refs
+			JOIN reachable ON refs.from_id = reachable.int_id
+		)
+		SELECT str_id
+		FROM storage.tracker_objects
+		WHERE int_id IN (SELECT int_id FROM reachable) AND str_id LIKE $2 || '%'
+	`, id, prefix); err != nil {
+		return errors.EnsureStack(err)
+	}
+	for _, id := range reachable {
+		if err := cb(id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (t *postgresTracker) GetExpiresAt(ctx context.Context, id string) (time.Time, error) {
 	var expiresAt time.Time
 	if err := t.db.GetContext(ctx, &expiresAt,
@@ -50,6 +50,10 @@ type Tracker interface {
 	// GetUpstream gets all objects immediately upstream of (pointing to) the object with id
 	GetUpstream(ctx context.Context, id string) ([]string, error)
 
+	// IterateReachable calls cb with every object with an id starting with prefix that can be reached from
+	// the object with id by following references, including the object itself.
+	IterateReachable(ctx context.Context, id, prefix string, cb func(id string) error) error
+
 	// DeleteTx deletes the object, or returns ErrDanglingRef if deleting it would create dangling refs.
 	// If the id doesn't exist, no error is returned
 	DeleteTx(tx *pachsql.Tx, id string) error
@@ -128,6 +132,24 @@ func TestTracker(t *testing.T, newTracker func(testing.TB) Tracker) {
 				require.ElementsEqual(t, []string{"3"}, ups)
 			},
 		},
+		{
+			"IterateReachable",
+			func(t *testing.T, tracker Tracker) {
+				require.NoError(t, Create(ctx, tracker, "chunk/1", []string{}, 0))
+				require.NoError(t, Create(ctx, tracker, "chunk/2", []string{}, 0))
+				require.NoError(t, Create(ctx, tracker, "chunk/3", []string{}, 0))
+				require.NoError(t, Create(ctx, tracker, "fileset/1", []string{"chunk/1", "chunk/2"}, 0))
+				require.NoError(t, Create(ctx, tracker, "fileset/2", []string{"fileset/1", "chunk/2"}, 0))
+
+				var reached []string
+				err := tracker.IterateReachable(ctx, "fileset/2", "chunk/", func(id string) error {
+					reached = append(reached, id)
+					return nil
+				})
+				require.NoError(t, err)
+				require.ElementsEqual(t, []string{"chunk/1", "chunk/2"}, reached)
+			},
+		},
 		{
 			"DeleteSingleObject",
 			func(t *testing.T, tracker Tracker) {
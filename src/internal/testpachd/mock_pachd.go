@@ -74,6 +74,9 @@ type getPermissionsFunc func(context.Context, *auth.GetPermissionsRequest) (*aut
 type getPermissionsForPrincipalFunc func(context.Context, *auth.GetPermissionsForPrincipalRequest) (*auth.GetPermissionsResponse, error)
 type whoAmIFunc func(context.Context, *auth.WhoAmIRequest) (*auth.WhoAmIResponse, error)
 type getRolesForPermissionFunc func(context.Context, *auth.GetRolesForPermissionRequest) (*auth.GetRolesForPermissionResponse, error)
+type createRoleFunc func(context.Context, *auth.CreateRoleRequest) (*auth.CreateRoleResponse, error)
+type listRoleFunc func(context.Context, *auth.ListRoleRequest) (*auth.ListRoleResponse, error)
+type deleteRoleFunc func(context.Context, *auth.DeleteRoleRequest) (*auth.DeleteRoleResponse, error)
 type getOIDCLoginFunc func(context.Context, *auth.GetOIDCLoginRequest) (*auth.GetOIDCLoginResponse, error)
 type getRobotTokenFunc func(context.Context, *auth.GetRobotTokenRequest) (*auth.GetRobotTokenResponse, error)
 type revokeAuthTokenFunc func(context.Context, *auth.RevokeAuthTokenRequest) (*auth.RevokeAuthTokenResponse, error)
@@ -122,6 +125,9 @@ type mockGetPermissionsForPrincipal struct {
 }
 type mockWhoAmI struct{ handler whoAmIFunc }
 type mockGetRolesForPermission struct{ handler getRolesForPermissionFunc }
+type mockCreateRole struct{ handler createRoleFunc }
+type mockListRole struct{ handler listRoleFunc }
+type mockDeleteRole struct{ handler deleteRoleFunc }
 type mockGetOIDCLogin struct{ handler getOIDCLoginFunc }
 type mockGetRobotToken struct{ handler getRobotTokenFunc }
 type mockRevokeAuthToken struct{ handler revokeAuthTokenFunc }
@@ -201,6 +207,9 @@ func (mock *mockAuthenticate) Use(cb authenticateFunc)
 func (mock *mockAuthorize) Use(cb authorizeFunc)                                   { mock.handler = cb }
 func (mock *mockWhoAmI) Use(cb whoAmIFunc)                                         { mock.handler = cb }
 func (mock *mockGetRolesForPermission) Use(cb getRolesForPermissionFunc)           { mock.handler = cb }
+func (mock *mockCreateRole) Use(cb createRoleFunc)                                 { mock.handler = cb }
+func (mock *mockListRole) Use(cb listRoleFunc)                                     { mock.handler = cb }
+func (mock *mockDeleteRole) Use(cb deleteRoleFunc)                                 { mock.handler = cb }
 func (mock *mockGetOIDCLogin) Use(cb getOIDCLoginFunc)                             { mock.handler = cb }
 func (mock *mockGetRobotToken) Use(cb getRobotTokenFunc)                           { mock.handler = cb }
 func (mock *mockRevokeAuthToken) Use(cb revokeAuthTokenFunc)                       { mock.handler = cb }
@@ -291,6 +300,9 @@ type mockAuthServer struct {
 	GetPermissionsForPrincipal                 mockGetPermissionsForPrincipal
 	WhoAmI                                     mockWhoAmI
 	GetRolesForPermission                      mockGetRolesForPermission
+	CreateRole                                 mockCreateRole
+	ListRole                                   mockListRole
+	DeleteRole                                 mockDeleteRole
 	GetOIDCLogin                               mockGetOIDCLogin
 	GetRobotToken                              mockGetRobotToken
 	RevokeAuthToken                            mockRevokeAuthToken
@@ -396,6 +408,24 @@ func (api *authServerAPI) GetRolesForPermission(ctx context.Context, req *auth.G
 	}
 	return nil, errors.Errorf("unhandled pachd mock auth.GetRolesForPermission")
 }
+func (api *authServerAPI) CreateRole(ctx context.Context, req *auth.CreateRoleRequest) (*auth.CreateRoleResponse, error) {
+	if api.mock.CreateRole.handler != nil {
+		return api.mock.CreateRole.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock auth.CreateRole")
+}
+func (api *authServerAPI) ListRole(ctx context.Context, req *auth.ListRoleRequest) (*auth.ListRoleResponse, error) {
+	if api.mock.ListRole.handler != nil {
+		return api.mock.ListRole.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock auth.ListRole")
+}
+func (api *authServerAPI) DeleteRole(ctx context.Context, req *auth.DeleteRoleRequest) (*auth.DeleteRoleResponse, error) {
+	if api.mock.DeleteRole.handler != nil {
+		return api.mock.DeleteRole.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock auth.DeleteRole")
+}
 func (api *authServerAPI) GetOIDCLogin(ctx context.Context, req *auth.GetOIDCLoginRequest) (*auth.GetOIDCLoginResponse, error) {
 	if api.mock.GetOIDCLogin.handler != nil {
 		return api.mock.GetOIDCLogin.handler(ctx, req)
@@ -982,10 +1012,18 @@ type createBranchFunc func(context.Context, *pfs.CreateBranchRequest) (*emptypb.
 type inspectBranchFunc func(context.Context, *pfs.InspectBranchRequest) (*pfs.BranchInfo, error)
 type listBranchFunc func(*pfs.ListBranchRequest, pfs.API_ListBranchServer) error
 type deleteBranchFunc func(context.Context, *pfs.DeleteBranchRequest) (*emptypb.Empty, error)
+type createTagFunc func(context.Context, *pfs.CreateTagRequest) (*emptypb.Empty, error)
+type inspectTagFunc func(context.Context, *pfs.InspectTagRequest) (*pfs.TagInfo, error)
+type listTagFunc func(*pfs.ListTagRequest, pfs.API_ListTagServer) error
+type deleteTagFunc func(context.Context, *pfs.DeleteTagRequest) (*emptypb.Empty, error)
 type createProjectFunc func(context.Context, *pfs.CreateProjectRequest) (*emptypb.Empty, error)
 type inspectProjectFunc func(context.Context, *pfs.InspectProjectRequest) (*pfs.ProjectInfo, error)
 type listProjectFunc func(*pfs.ListProjectRequest, pfs.API_ListProjectServer) error
 type deleteProjectFunc func(context.Context, *pfs.DeleteProjectRequest) (*emptypb.Empty, error)
+type editMetadataFunc func(context.Context, *pfs.EditMetadataRequest) (*pfs.EditMetadataResponse, error)
+type setRetentionPolicyFunc func(context.Context, *pfs.SetRetentionPolicyRequest) (*emptypb.Empty, error)
+type applyRetentionPolicyFunc func(context.Context, *pfs.ApplyRetentionPolicyRequest) (*pfs.ApplyRetentionPolicyResponse, error)
+type purgePathFunc func(context.Context, *pfs.PurgePathRequest) (*pfs.PurgePathResponse, error)
 type modifyFileFunc func(pfs.API_ModifyFileServer) error
 type getFileTARFunc func(*pfs.GetFileRequest, pfs.API_GetFileTARServer) error
 type getFileFunc func(*pfs.GetFileRequest, pfs.API_GetFileServer) error
@@ -1003,6 +1041,7 @@ type renewFileSetFunc func(context.Context, *pfs.RenewFileSetRequest) (*emptypb.
 type composeFileSetFunc func(context.Context, *pfs.ComposeFileSetRequest) (*pfs.CreateFileSetResponse, error)
 type shardFileSetFunc func(context.Context, *pfs.ShardFileSetRequest) (*pfs.ShardFileSetResponse, error)
 type checkStorageFunc func(context.Context, *pfs.CheckStorageRequest) (*pfs.CheckStorageResponse, error)
+type inspectStorageFunc func(context.Context, *pfs.InspectStorageRequest) (*pfs.InspectStorageResponse, error)
 type putCacheFunc func(context.Context, *pfs.PutCacheRequest) (*emptypb.Empty, error)
 type getCacheFunc func(context.Context, *pfs.GetCacheRequest) (*pfs.GetCacheResponse, error)
 type clearCacheFunc func(context.Context, *pfs.ClearCacheRequest) (*emptypb.Empty, error)
@@ -1032,10 +1071,18 @@ type mockCreateBranch struct{ handler createBranchFunc }
 type mockInspectBranch struct{ handler inspectBranchFunc }
 type mockListBranch struct{ handler listBranchFunc }
 type mockDeleteBranch struct{ handler deleteBranchFunc }
+type mockCreateTag struct{ handler createTagFunc }
+type mockInspectTag struct{ handler inspectTagFunc }
+type mockListTag struct{ handler listTagFunc }
+type mockDeleteTag struct{ handler deleteTagFunc }
 type mockCreateProject struct{ handler createProjectFunc }
 type mockInspectProject struct{ handler inspectProjectFunc }
 type mockListProject struct{ handler listProjectFunc }
 type mockDeleteProject struct{ handler deleteProjectFunc }
+type mockEditMetadata struct{ handler editMetadataFunc }
+type mockSetRetentionPolicy struct{ handler setRetentionPolicyFunc }
+type mockApplyRetentionPolicy struct{ handler applyRetentionPolicyFunc }
+type mockPurgePath struct{ handler purgePathFunc }
 type mockModifyFile struct{ handler modifyFileFunc }
 type mockGetFile struct{ handler getFileFunc }
 type mockGetFileTAR struct{ handler getFileTARFunc }
@@ -1053,61 +1100,71 @@ type mockRenewFileSet struct{ handler renewFileSetFunc }
 type mockComposeFileSet struct{ handler composeFileSetFunc }
 type mockShardFileSet struct{ handler shardFileSetFunc }
 type mockCheckStorage struct{ handler checkStorageFunc }
+type mockInspectStorage struct{ handler inspectStorageFunc }
 type mockPutCache struct{ handler putCacheFunc }
 type mockGetCache struct{ handler getCacheFunc }
 type mockClearCache struct{ handler clearCacheFunc }
 type mockListTaskPFS struct{ handler listTaskPFSFunc }
 type mockEgress struct{ handler egressFunc }
 
-func (mock *mockActivateAuthPFS) Use(cb activateAuthPFSFunc)   { mock.handler = cb }
-func (mock *mockCreateRepo) Use(cb createRepoFunc)             { mock.handler = cb }
-func (mock *mockInspectRepo) Use(cb inspectRepoFunc)           { mock.handler = cb }
-func (mock *mockListRepo) Use(cb listRepoFunc)                 { mock.handler = cb }
-func (mock *mockDeleteRepo) Use(cb deleteRepoFunc)             { mock.handler = cb }
-func (mock *mockDeleteRepos) Use(cb deleteReposFunc)           { mock.handler = cb }
-func (mock *mockStartCommit) Use(cb startCommitFunc)           { mock.handler = cb }
-func (mock *mockFinishCommit) Use(cb finishCommitFunc)         { mock.handler = cb }
-func (mock *mockInspectCommit) Use(cb inspectCommitFunc)       { mock.handler = cb }
-func (mock *mockListCommit) Use(cb listCommitFunc)             { mock.handler = cb }
-func (mock *mockSubscribeCommit) Use(cb subscribeCommitFunc)   { mock.handler = cb }
-func (mock *mockClearCommit) Use(cb clearCommitFunc)           { mock.handler = cb }
-func (mock *mockSquashCommitSet) Use(cb squashCommitSetFunc)   { mock.handler = cb }
-func (mock *mockDropCommitSet) Use(cb dropCommitSetFunc)       { mock.handler = cb }
-func (mock *mockSquashCommit) Use(cb squashCommitFunc)         { mock.handler = cb }
-func (mock *mockDropCommit) Use(cb dropCommitFunc)             { mock.handler = cb }
-func (mock *mockInspectCommitSet) Use(cb inspectCommitSetFunc) { mock.handler = cb }
-func (mock *mockListCommitSet) Use(cb listCommitSetFunc)       { mock.handler = cb }
-func (mock *mockFindCommits) Use(cb FindCommitsFunc)           { mock.handler = cb }
-func (mock *mockCreateBranch) Use(cb createBranchFunc)         { mock.handler = cb }
-func (mock *mockInspectBranch) Use(cb inspectBranchFunc)       { mock.handler = cb }
-func (mock *mockListBranch) Use(cb listBranchFunc)             { mock.handler = cb }
-func (mock *mockDeleteBranch) Use(cb deleteBranchFunc)         { mock.handler = cb }
-func (mock *mockCreateProject) Use(cb createProjectFunc)       { mock.handler = cb }
-func (mock *mockInspectProject) Use(cb inspectProjectFunc)     { mock.handler = cb }
-func (mock *mockListProject) Use(cb listProjectFunc)           { mock.handler = cb }
-func (mock *mockDeleteProject) Use(cb deleteProjectFunc)       { mock.handler = cb }
-func (mock *mockModifyFile) Use(cb modifyFileFunc)             { mock.handler = cb }
-func (mock *mockGetFile) Use(cb getFileFunc)                   { mock.handler = cb }
-func (mock *mockGetFileTAR) Use(cb getFileTARFunc)             { mock.handler = cb }
-func (mock *mockInspectFile) Use(cb inspectFileFunc)           { mock.handler = cb }
-func (mock *mockListFile) Use(cb listFileFunc)                 { mock.handler = cb }
-func (mock *mockWalkFile) Use(cb walkFileFunc)                 { mock.handler = cb }
-func (mock *mockGlobFile) Use(cb globFileFunc)                 { mock.handler = cb }
-func (mock *mockDiffFile) Use(cb diffFileFunc)                 { mock.handler = cb }
-func (mock *mockDeleteAllPFS) Use(cb deleteAllPFSFunc)         { mock.handler = cb }
-func (mock *mockFsck) Use(cb fsckFunc)                         { mock.handler = cb }
-func (mock *mockCreateFileSet) Use(cb createFileSetFunc)       { mock.handler = cb }
-func (mock *mockAddFileSet) Use(cb addFileSetFunc)             { mock.handler = cb }
-func (mock *mockGetFileSet) Use(cb getFileSetFunc)             { mock.handler = cb }
-func (mock *mockRenewFileSet) Use(cb renewFileSetFunc)         { mock.handler = cb }
-func (mock *mockComposeFileSet) Use(cb composeFileSetFunc)     { mock.handler = cb }
-func (mock *mockShardFileSet) Use(cb shardFileSetFunc)         { mock.handler = cb }
-func (mock *mockCheckStorage) Use(cb checkStorageFunc)         { mock.handler = cb }
-func (mock *mockPutCache) Use(cb putCacheFunc)                 { mock.handler = cb }
-func (mock *mockGetCache) Use(cb getCacheFunc)                 { mock.handler = cb }
-func (mock *mockClearCache) Use(cb clearCacheFunc)             { mock.handler = cb }
-func (mock *mockListTaskPFS) Use(cb listTaskPFSFunc)           { mock.handler = cb }
-func (mock *mockEgress) Use(cb egressFunc)                     { mock.handler = cb }
+func (mock *mockActivateAuthPFS) Use(cb activateAuthPFSFunc)           { mock.handler = cb }
+func (mock *mockCreateRepo) Use(cb createRepoFunc)                     { mock.handler = cb }
+func (mock *mockInspectRepo) Use(cb inspectRepoFunc)                   { mock.handler = cb }
+func (mock *mockListRepo) Use(cb listRepoFunc)                         { mock.handler = cb }
+func (mock *mockDeleteRepo) Use(cb deleteRepoFunc)                     { mock.handler = cb }
+func (mock *mockDeleteRepos) Use(cb deleteReposFunc)                   { mock.handler = cb }
+func (mock *mockStartCommit) Use(cb startCommitFunc)                   { mock.handler = cb }
+func (mock *mockFinishCommit) Use(cb finishCommitFunc)                 { mock.handler = cb }
+func (mock *mockInspectCommit) Use(cb inspectCommitFunc)               { mock.handler = cb }
+func (mock *mockListCommit) Use(cb listCommitFunc)                     { mock.handler = cb }
+func (mock *mockSubscribeCommit) Use(cb subscribeCommitFunc)           { mock.handler = cb }
+func (mock *mockClearCommit) Use(cb clearCommitFunc)                   { mock.handler = cb }
+func (mock *mockSquashCommitSet) Use(cb squashCommitSetFunc)           { mock.handler = cb }
+func (mock *mockDropCommitSet) Use(cb dropCommitSetFunc)               { mock.handler = cb }
+func (mock *mockSquashCommit) Use(cb squashCommitFunc)                 { mock.handler = cb }
+func (mock *mockDropCommit) Use(cb dropCommitFunc)                     { mock.handler = cb }
+func (mock *mockInspectCommitSet) Use(cb inspectCommitSetFunc)         { mock.handler = cb }
+func (mock *mockListCommitSet) Use(cb listCommitSetFunc)               { mock.handler = cb }
+func (mock *mockFindCommits) Use(cb FindCommitsFunc)                   { mock.handler = cb }
+func (mock *mockCreateBranch) Use(cb createBranchFunc)                 { mock.handler = cb }
+func (mock *mockInspectBranch) Use(cb inspectBranchFunc)               { mock.handler = cb }
+func (mock *mockListBranch) Use(cb listBranchFunc)                     { mock.handler = cb }
+func (mock *mockDeleteBranch) Use(cb deleteBranchFunc)                 { mock.handler = cb }
+func (mock *mockCreateTag) Use(cb createTagFunc)                       { mock.handler = cb }
+func (mock *mockInspectTag) Use(cb inspectTagFunc)                     { mock.handler = cb }
+func (mock *mockListTag) Use(cb listTagFunc)                           { mock.handler = cb }
+func (mock *mockDeleteTag) Use(cb deleteTagFunc)                       { mock.handler = cb }
+func (mock *mockCreateProject) Use(cb createProjectFunc)               { mock.handler = cb }
+func (mock *mockInspectProject) Use(cb inspectProjectFunc)             { mock.handler = cb }
+func (mock *mockListProject) Use(cb listProjectFunc)                   { mock.handler = cb }
+func (mock *mockDeleteProject) Use(cb deleteProjectFunc)               { mock.handler = cb }
+func (mock *mockEditMetadata) Use(cb editMetadataFunc)                 { mock.handler = cb }
+func (mock *mockSetRetentionPolicy) Use(cb setRetentionPolicyFunc)     { mock.handler = cb }
+func (mock *mockApplyRetentionPolicy) Use(cb applyRetentionPolicyFunc) { mock.handler = cb }
+func (mock *mockPurgePath) Use(cb purgePathFunc)                       { mock.handler = cb }
+func (mock *mockModifyFile) Use(cb modifyFileFunc)                     { mock.handler = cb }
+func (mock *mockGetFile) Use(cb getFileFunc)                           { mock.handler = cb }
+func (mock *mockGetFileTAR) Use(cb getFileTARFunc)                     { mock.handler = cb }
+func (mock *mockInspectFile) Use(cb inspectFileFunc)                   { mock.handler = cb }
+func (mock *mockListFile) Use(cb listFileFunc)                         { mock.handler = cb }
+func (mock *mockWalkFile) Use(cb walkFileFunc)                         { mock.handler = cb }
+func (mock *mockGlobFile) Use(cb globFileFunc)                         { mock.handler = cb }
+func (mock *mockDiffFile) Use(cb diffFileFunc)                         { mock.handler = cb }
+func (mock *mockDeleteAllPFS) Use(cb deleteAllPFSFunc)                 { mock.handler = cb }
+func (mock *mockFsck) Use(cb fsckFunc)                                 { mock.handler = cb }
+func (mock *mockCreateFileSet) Use(cb createFileSetFunc)               { mock.handler = cb }
+func (mock *mockAddFileSet) Use(cb addFileSetFunc)                     { mock.handler = cb }
+func (mock *mockGetFileSet) Use(cb getFileSetFunc)                     { mock.handler = cb }
+func (mock *mockRenewFileSet) Use(cb renewFileSetFunc)                 { mock.handler = cb }
+func (mock *mockComposeFileSet) Use(cb composeFileSetFunc)             { mock.handler = cb }
+func (mock *mockShardFileSet) Use(cb shardFileSetFunc)                 { mock.handler = cb }
+func (mock *mockCheckStorage) Use(cb checkStorageFunc)                 { mock.handler = cb }
+func (mock *mockInspectStorage) Use(cb inspectStorageFunc)             { mock.handler = cb }
+func (mock *mockPutCache) Use(cb putCacheFunc)                         { mock.handler = cb }
+func (mock *mockGetCache) Use(cb getCacheFunc)                         { mock.handler = cb }
+func (mock *mockClearCache) Use(cb clearCacheFunc)                     { mock.handler = cb }
+func (mock *mockListTaskPFS) Use(cb listTaskPFSFunc)                   { mock.handler = cb }
+func (mock *mockEgress) Use(cb egressFunc)                             { mock.handler = cb }
 
 type pfsServerAPI struct {
 	pfs.UnsafeAPIServer
@@ -1115,56 +1172,65 @@ type pfsServerAPI struct {
 }
 
 type mockPFSServer struct {
-	api              pfsServerAPI
-	ActivateAuth     mockActivateAuthPFS
-	CreateRepo       mockCreateRepo
-	InspectRepo      mockInspectRepo
-	ListRepo         mockListRepo
-	DeleteRepo       mockDeleteRepo
-	DeleteRepos      mockDeleteRepos
-	StartCommit      mockStartCommit
-	FinishCommit     mockFinishCommit
-	InspectCommit    mockInspectCommit
-	ListCommit       mockListCommit
-	SubscribeCommit  mockSubscribeCommit
-	ClearCommit      mockClearCommit
-	SquashCommitSet  mockSquashCommitSet
-	DropCommitSet    mockDropCommitSet
-	SquashCommit     mockSquashCommit
-	DropCommit       mockDropCommit
-	InspectCommitSet mockInspectCommitSet
-	ListCommitSet    mockListCommitSet
-	FindCommits      mockFindCommits
-	CreateBranch     mockCreateBranch
-	InspectBranch    mockInspectBranch
-	ListBranch       mockListBranch
-	DeleteBranch     mockDeleteBranch
-	CreateProject    mockCreateProject
-	InspectProject   mockInspectProject
-	ListProject      mockListProject
-	DeleteProject    mockDeleteProject
-	ModifyFile       mockModifyFile
-	GetFile          mockGetFile
-	GetFileTAR       mockGetFileTAR
-	InspectFile      mockInspectFile
-	ListFile         mockListFile
-	WalkFile         mockWalkFile
-	GlobFile         mockGlobFile
-	DiffFile         mockDiffFile
-	DeleteAll        mockDeleteAllPFS
-	Fsck             mockFsck
-	CreateFileSet    mockCreateFileSet
-	AddFileSet       mockAddFileSet
-	GetFileSet       mockGetFileSet
-	RenewFileSet     mockRenewFileSet
-	ComposeFileSet   mockComposeFileSet
-	ShardFileSet     mockShardFileSet
-	CheckStorage     mockCheckStorage
-	PutCache         mockPutCache
-	GetCache         mockGetCache
-	ClearCache       mockClearCache
-	ListTask         mockListTaskPFS
-	Egress           mockEgress
+	api                  pfsServerAPI
+	ActivateAuth         mockActivateAuthPFS
+	CreateRepo           mockCreateRepo
+	InspectRepo          mockInspectRepo
+	ListRepo             mockListRepo
+	DeleteRepo           mockDeleteRepo
+	DeleteRepos          mockDeleteRepos
+	StartCommit          mockStartCommit
+	FinishCommit         mockFinishCommit
+	InspectCommit        mockInspectCommit
+	ListCommit           mockListCommit
+	SubscribeCommit      mockSubscribeCommit
+	ClearCommit          mockClearCommit
+	SquashCommitSet      mockSquashCommitSet
+	DropCommitSet        mockDropCommitSet
+	SquashCommit         mockSquashCommit
+	DropCommit           mockDropCommit
+	InspectCommitSet     mockInspectCommitSet
+	ListCommitSet        mockListCommitSet
+	FindCommits          mockFindCommits
+	CreateBranch         mockCreateBranch
+	InspectBranch        mockInspectBranch
+	ListBranch           mockListBranch
+	DeleteBranch         mockDeleteBranch
+	CreateTag            mockCreateTag
+	InspectTag           mockInspectTag
+	ListTag              mockListTag
+	DeleteTag            mockDeleteTag
+	CreateProject        mockCreateProject
+	InspectProject       mockInspectProject
+	ListProject          mockListProject
+	DeleteProject        mockDeleteProject
+	EditMetadata         mockEditMetadata
+	SetRetentionPolicy   mockSetRetentionPolicy
+	ApplyRetentionPolicy mockApplyRetentionPolicy
+	PurgePath            mockPurgePath
+	ModifyFile           mockModifyFile
+	GetFile              mockGetFile
+	GetFileTAR           mockGetFileTAR
+	InspectFile          mockInspectFile
+	ListFile             mockListFile
+	WalkFile             mockWalkFile
+	GlobFile             mockGlobFile
+	DiffFile             mockDiffFile
+	DeleteAll            mockDeleteAllPFS
+	Fsck                 mockFsck
+	CreateFileSet        mockCreateFileSet
+	AddFileSet           mockAddFileSet
+	GetFileSet           mockGetFileSet
+	RenewFileSet         mockRenewFileSet
+	ComposeFileSet       mockComposeFileSet
+	ShardFileSet         mockShardFileSet
+	CheckStorage         mockCheckStorage
+	InspectStorage       mockInspectStorage
+	PutCache             mockPutCache
+	GetCache             mockGetCache
+	ClearCache           mockClearCache
+	ListTask             mockListTaskPFS
+	Egress               mockEgress
 }
 
 func (api *pfsServerAPI) ActivateAuth(ctx context.Context, req *pfs.ActivateAuthRequest) (*pfs.ActivateAuthResponse, error) {
@@ -1307,6 +1373,30 @@ func (api *pfsServerAPI) DeleteBranch(ctx context.Context, req *pfs.DeleteBranch
 	}
 	return nil, errors.Errorf("unhandled pachd mock pfs.DeleteBranch")
 }
+func (api *pfsServerAPI) CreateTag(ctx context.Context, req *pfs.CreateTagRequest) (*emptypb.Empty, error) {
+	if api.mock.CreateTag.handler != nil {
+		return api.mock.CreateTag.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.CreateTag")
+}
+func (api *pfsServerAPI) InspectTag(ctx context.Context, req *pfs.InspectTagRequest) (*pfs.TagInfo, error) {
+	if api.mock.InspectTag.handler != nil {
+		return api.mock.InspectTag.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.InspectTag")
+}
+func (api *pfsServerAPI) ListTag(req *pfs.ListTagRequest, srv pfs.API_ListTagServer) error {
+	if api.mock.ListTag.handler != nil {
+		return api.mock.ListTag.handler(req, srv)
+	}
+	return errors.Errorf("unhandled pachd mock pfs.ListTag")
+}
+func (api *pfsServerAPI) DeleteTag(ctx context.Context, req *pfs.DeleteTagRequest) (*emptypb.Empty, error) {
+	if api.mock.DeleteTag.handler != nil {
+		return api.mock.DeleteTag.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.DeleteTag")
+}
 func (api *pfsServerAPI) CreateProject(ctx context.Context, req *pfs.CreateProjectRequest) (*emptypb.Empty, error) {
 	if api.mock.CreateProject.handler != nil {
 		return api.mock.CreateProject.handler(ctx, req)
@@ -1331,6 +1421,30 @@ func (api *pfsServerAPI) DeleteProject(ctx context.Context, req *pfs.DeleteProje
 	}
 	return nil, errors.Errorf("unhandled pachd mock pfs.DeleteProject")
 }
+func (api *pfsServerAPI) EditMetadata(ctx context.Context, req *pfs.EditMetadataRequest) (*pfs.EditMetadataResponse, error) {
+	if api.mock.EditMetadata.handler != nil {
+		return api.mock.EditMetadata.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.EditMetadata")
+}
+func (api *pfsServerAPI) SetRetentionPolicy(ctx context.Context, req *pfs.SetRetentionPolicyRequest) (*emptypb.Empty, error) {
+	if api.mock.SetRetentionPolicy.handler != nil {
+		return api.mock.SetRetentionPolicy.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.SetRetentionPolicy")
+}
+func (api *pfsServerAPI) ApplyRetentionPolicy(ctx context.Context, req *pfs.ApplyRetentionPolicyRequest) (*pfs.ApplyRetentionPolicyResponse, error) {
+	if api.mock.ApplyRetentionPolicy.handler != nil {
+		return api.mock.ApplyRetentionPolicy.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.ApplyRetentionPolicy")
+}
+func (api *pfsServerAPI) PurgePath(ctx context.Context, req *pfs.PurgePathRequest) (*pfs.PurgePathResponse, error) {
+	if api.mock.PurgePath.handler != nil {
+		return api.mock.PurgePath.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.PurgePath")
+}
 func (api *pfsServerAPI) ModifyFile(serv pfs.API_ModifyFileServer) error {
 	if api.mock.ModifyFile.handler != nil {
 		return api.mock.ModifyFile.handler(serv)
@@ -1433,6 +1547,12 @@ func (api *pfsServerAPI) CheckStorage(ctx context.Context, req *pfs.CheckStorage
 	}
 	return nil, errors.Errorf("unhandled pachd mock CheckStorage")
 }
+func (api *pfsServerAPI) InspectStorage(ctx context.Context, req *pfs.InspectStorageRequest) (*pfs.InspectStorageResponse, error) {
+	if api.mock.InspectStorage.handler != nil {
+		return api.mock.InspectStorage.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock InspectStorage")
+}
 func (api *pfsServerAPI) PutCache(ctx context.Context, req *pfs.PutCacheRequest) (*emptypb.Empty, error) {
 	if api.mock.PutCache.handler != nil {
 		return api.mock.PutCache.handler(ctx, req)
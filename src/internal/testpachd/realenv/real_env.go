@@ -158,6 +158,7 @@ func newRealEnv(ctx context.Context, t testing.TB, mockPPSTransactionServer bool
 			require.NoError(t, cmdutil.PopulateDefaults(config))
 			config.StorageBackend = obj.Local
 			config.StorageRoot = path.Join(realEnv.Directory, "localStorage")
+			config.StorageLocalFsync = "none"
 		},
 		DefaultConfigOptions,
 		pachconfig.WithEtcdHostPort(etcdClientURL.Hostname(), etcdClientURL.Port()),
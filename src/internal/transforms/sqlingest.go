@@ -2,6 +2,7 @@ package transforms
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"io"
 	"os"
@@ -31,7 +32,7 @@ type SQLIngestParams struct {
 // read from files in the input.
 // The resulting rows are written to files in params.OutputDir.
 // The format of the output file is controlled by params.Format.
-// Valid options are "json" and "csv"
+// Valid options are "json", "csv", and "parquet"
 //
 // It makes outgoing connections using pachsql.OpenURL
 // It accesses the filesystem only within params.InputDir, and params.OutputDir
@@ -66,12 +67,15 @@ func SQLIngest(ctx context.Context, params SQLIngestParams) error {
 			return errors.EnsureStack(err)
 		}
 		log.Info(ctx, "Query complete, begin reading rows")
-		colNames, err := rows.Columns()
+		cTypes, err := rows.ColumnTypes()
 		if err != nil {
 			return errors.EnsureStack(err)
 		}
-		log.Info(ctx, "Got columns", zap.Strings("columns", colNames))
-		tw := writerFactory(w, colNames)
+		log.Info(ctx, "Got columns", zap.Strings("columns", columnNames(cTypes)))
+		tw, err := writerFactory(w, cTypes)
+		if err != nil {
+			return err
+		}
 		res, err := sdata.MaterializeSQL(tw, rows)
 		if err != nil {
 			return err
@@ -85,28 +89,44 @@ func SQLIngest(ctx context.Context, params SQLIngestParams) error {
 	return nil
 }
 
-type writerFactory = func(w io.Writer, fieldNames []string) sdata.TupleWriter
+type writerFactory = func(w io.Writer, cTypes []*sql.ColumnType) (sdata.TupleWriter, error)
 
 func makeWriterFactory(formatName string, hasHeader bool) (writerFactory, error) {
 	switch formatName {
 	case "json", "jsonlines":
-		return func(w io.Writer, fieldNames []string) sdata.TupleWriter {
-			return sdata.NewJSONWriter(w, fieldNames)
+		return func(w io.Writer, cTypes []*sql.ColumnType) (sdata.TupleWriter, error) {
+			return sdata.NewJSONWriter(w, columnNames(cTypes)), nil
 		}, nil
 	case "csv":
 		if hasHeader {
-			return func(w io.Writer, fieldNames []string) sdata.TupleWriter {
-				return sdata.NewCSVWriter(w, fieldNames)
+			return func(w io.Writer, cTypes []*sql.ColumnType) (sdata.TupleWriter, error) {
+				return sdata.NewCSVWriter(w, columnNames(cTypes)), nil
 			}, nil
 		}
-		return func(w io.Writer, fieldNames []string) sdata.TupleWriter {
-			return sdata.NewCSVWriter(w, nil)
+		return func(w io.Writer, cTypes []*sql.ColumnType) (sdata.TupleWriter, error) {
+			return sdata.NewCSVWriter(w, nil), nil
+		}, nil
+	case "parquet":
+		return func(w io.Writer, cTypes []*sql.ColumnType) (sdata.TupleWriter, error) {
+			schema, err := sdata.ParquetSchemaFromColumnTypes(cTypes)
+			if err != nil {
+				return nil, err
+			}
+			return sdata.NewParquetWriter(w, schema, columnNames(cTypes))
 		}, nil
 	default:
 		return nil, errors.Errorf("unrecognized format %v", formatName)
 	}
 }
 
+func columnNames(cTypes []*sql.ColumnType) []string {
+	names := make([]string, len(cTypes))
+	for i, cType := range cTypes {
+		names[i] = cType.Name()
+	}
+	return names
+}
+
 type SQLQueryGenerationParams struct {
 	InputDir, OutputDir string
 
@@ -186,12 +206,15 @@ func RunSQLRaw(ctx context.Context, params SQLRunParams) error {
 		return errors.EnsureStack(err)
 	}
 	log.Info(ctx, "Query complete, begin reading rows")
-	columns, err := rows.Columns()
+	cTypes, err := rows.ColumnTypes()
 	if err != nil {
 		return errors.EnsureStack(err)
 	}
-	log.Info(ctx, "Got columns", zap.Strings("columns", columns))
-	tw := writerFactory(w, columns)
+	log.Info(ctx, "Got columns", zap.Strings("columns", columnNames(cTypes)))
+	tw, err := writerFactory(w, cTypes)
+	if err != nil {
+		return err
+	}
 	res, err := sdata.MaterializeSQL(tw, rows)
 	if err != nil {
 		return err
@@ -9,6 +9,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/parquet-go/parquet-go"
+
 	"github.com/pachyderm/pachyderm/v2/src/internal/dockertestenv"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pctx"
@@ -91,6 +93,48 @@ func TestCSVSQLIngest(t *testing.T) {
 	require.Equal(t, N, lineCount)
 }
 
+func TestParquetSQLIngest(t *testing.T) {
+	ctx := pctx.TestContext(t)
+	inputDir, outputDir := t.TempDir(), t.TempDir()
+	u := dockertestenv.NewMySQLURL(ctx, t)
+	const N = 100
+	loadDB(t, u, N)
+
+	// write queries
+	const Shards = 2
+	for i := 0; i < Shards; i++ {
+		name := fmt.Sprintf("%04d", i)
+		// query would normally be different per shard
+		query := "select * from test_data"
+		err := os.WriteFile(filepath.Join(inputDir, name), []byte(query), 0755)
+		require.NoError(t, err)
+	}
+
+	err := SQLIngest(ctx, SQLIngestParams{
+		InputDir:  inputDir,
+		OutputDir: outputDir,
+
+		URL:      u,
+		Password: dockertestenv.MySQLPassword,
+		Format:   "parquet",
+	})
+	require.NoError(t, err)
+
+	// check the file exists and has a row for each row in the table
+	dirEnts, err := os.ReadDir(outputDir)
+	require.NoError(t, err)
+	require.Len(t, dirEnts, Shards)
+	require.Equal(t, outputName, dirEnts[0].Name())
+	f, err := os.Open(filepath.Join(outputDir, outputName))
+	require.NoError(t, err)
+	defer f.Close()
+	fi, err := f.Stat()
+	require.NoError(t, err)
+	pf, err := parquet.OpenFile(f, fi.Size())
+	require.NoError(t, err)
+	require.Equal(t, int64(N), pf.NumRows())
+}
+
 func TestCSVHeaderSQLIngest(t *testing.T) {
 	ctx := pctx.TestContext(t)
 	inputDir, outputDir := t.TempDir(), t.TempDir()
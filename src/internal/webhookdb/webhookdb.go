@@ -0,0 +1,361 @@
+// Package webhookdb contains the database functions backing webhooks.
+//
+// Webhooks are stored in the webhooks.webhooks table.  Events are enqueued in the
+// webhooks.deliveries table, one row per matching webhook, by the transaction that causes them,
+// so an event is recorded if and only if its change is committed.  The rows are delivered by the
+// PFS master and kept, once finished, as the delivery history.
+package webhookdb
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pacherr"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+	"github.com/pachyderm/pachyderm/v2/src/webhook"
+)
+
+// Delivery states, as stored in the state column.
+const (
+	statePending   = "pending"
+	stateSucceeded = "succeeded"
+	stateFailed    = "failed"
+)
+
+// Webhook is a row in the webhooks.webhooks table.
+type Webhook struct {
+	Name     string         `db:"name"`
+	URL      string         `db:"url"`
+	Secret   string         `db:"secret"`
+	Events   pq.StringArray `db:"events"`
+	Project  string         `db:"project"`
+	Repo     string         `db:"repo"`
+	Branch   string         `db:"branch"`
+	Pipeline string         `db:"pipeline"`
+	Created  time.Time      `db:"created"`
+}
+
+const webhookColumns = "name, url, secret, events, project, repo, branch, pipeline, created"
+
+// FromRequest converts a CreateWebhookRequest to a row.
+func FromRequest(req *webhook.CreateWebhookRequest, created time.Time) *Webhook {
+	w := &Webhook{
+		Name:     req.Name,
+		URL:      req.Url,
+		Secret:   req.Secret,
+		Events:   pq.StringArray{},
+		Project:  req.Project,
+		Repo:     req.Repo,
+		Branch:   req.Branch,
+		Pipeline: req.Pipeline,
+		Created:  created,
+	}
+	for _, e := range req.Events {
+		w.Events = append(w.Events, e.String())
+	}
+	return w
+}
+
+// ToProto converts the row to a webhook.WebhookInfo, without its secret.
+func (w *Webhook) ToProto() *webhook.WebhookInfo {
+	info := &webhook.WebhookInfo{
+		Name:     w.Name,
+		Url:      w.URL,
+		Project:  w.Project,
+		Repo:     w.Repo,
+		Branch:   w.Branch,
+		Pipeline: w.Pipeline,
+		Signed:   w.Secret != "",
+		Created:  timestamppb.New(w.Created),
+	}
+	for _, e := range w.Events {
+		info.Events = append(info.Events, webhook.EventType(webhook.EventType_value[e]))
+	}
+	return info
+}
+
+// PutWebhook creates a webhook.  If update is true, a webhook with the same name is replaced,
+// keeping its creation time; otherwise it is an error for one to exist.
+func PutWebhook(ctx context.Context, tx *pachsql.Tx, w *Webhook, update bool) error {
+	query := `
+		INSERT INTO webhooks.webhooks (` + webhookColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`
+	if update {
+		query += `
+		ON CONFLICT (name) DO UPDATE SET
+			url = EXCLUDED.url, secret = EXCLUDED.secret, events = EXCLUDED.events,
+			project = EXCLUDED.project, repo = EXCLUDED.repo, branch = EXCLUDED.branch,
+			pipeline = EXCLUDED.pipeline`
+	} else {
+		query += ` ON CONFLICT (name) DO NOTHING`
+	}
+	res, err := tx.ExecContext(ctx, query, w.Name, w.URL, w.Secret, w.Events, w.Project, w.Repo, w.Branch, w.Pipeline, w.Created)
+	if err != nil {
+		return errors.Wrap(err, "put webhook")
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "put webhook")
+	}
+	if n == 0 {
+		return pacherr.NewExists("webhooks", w.Name)
+	}
+	return nil
+}
+
+// GetWebhook returns the webhook with the given name.
+func GetWebhook(ctx context.Context, q sqlx.QueryerContext, name string) (*Webhook, error) {
+	w := &Webhook{}
+	if err := sqlx.GetContext(ctx, q, w, `SELECT `+webhookColumns+` FROM webhooks.webhooks WHERE name = $1`, name); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, pacherr.NewNotExist("webhooks", name)
+		}
+		return nil, errors.Wrap(err, "get webhook")
+	}
+	return w, nil
+}
+
+// ListWebhooks calls cb with each webhook, in order of name.
+func ListWebhooks(ctx context.Context, q sqlx.QueryerContext, cb func(*Webhook) error) error {
+	var webhooks []*Webhook
+	if err := sqlx.SelectContext(ctx, q, &webhooks, `SELECT `+webhookColumns+` FROM webhooks.webhooks ORDER BY name`); err != nil {
+		return errors.Wrap(err, "list webhooks")
+	}
+	for _, w := range webhooks {
+		if err := cb(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeleteWebhook deletes a webhook along with its deliveries.
+func DeleteWebhook(ctx context.Context, tx *pachsql.Tx, name string) error {
+	res, err := tx.ExecContext(ctx, `DELETE FROM webhooks.webhooks WHERE name = $1`, name)
+	if err != nil {
+		return errors.Wrap(err, "delete webhook")
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "delete webhook")
+	}
+	if n == 0 {
+		return pacherr.NewNotExist("webhooks", name)
+	}
+	return nil
+}
+
+// CommitFinished enqueues a COMMIT_FINISHED event for every matching webhook.
+func CommitFinished(ctx context.Context, tx *pachsql.Tx, commitInfo *pfs.CommitInfo) error {
+	commit := commitInfo.Commit
+	return enqueue(ctx, tx, &webhook.Event{
+		Type:        webhook.EventType_COMMIT_FINISHED,
+		Time:        timestamppb.Now(),
+		Commit:      commit,
+		CommitError: commitInfo.Error,
+	}, commit.Repo.Project.GetName(), commit.Repo.Name, commit.GetBranch().GetName(), "")
+}
+
+// JobFailed enqueues a JOB_FAILED event for every matching webhook.
+func JobFailed(ctx context.Context, tx *pachsql.Tx, jobInfo *pps.JobInfo) error {
+	job := jobInfo.Job
+	return enqueue(ctx, tx, &webhook.Event{
+		Type:     webhook.EventType_JOB_FAILED,
+		Time:     timestamppb.Now(),
+		Job:      job,
+		JobState: jobInfo.State,
+		Reason:   jobInfo.Reason,
+	}, job.Pipeline.Project.GetName(), "", "", job.Pipeline.Name)
+}
+
+// PipelineStateChanged enqueues a PIPELINE_STATE_CHANGED event for every matching webhook.
+func PipelineStateChanged(ctx context.Context, tx *pachsql.Tx, pipeline *pps.Pipeline, from, to pps.PipelineState, reason string) error {
+	return enqueue(ctx, tx, &webhook.Event{
+		Type:                  webhook.EventType_PIPELINE_STATE_CHANGED,
+		Time:                  timestamppb.Now(),
+		Pipeline:              pipeline,
+		PipelineState:         to,
+		PreviousPipelineState: from,
+		Reason:                reason,
+	}, pipeline.Project.GetName(), "", "", pipeline.Name)
+}
+
+// enqueue inserts a pending delivery of e for every webhook subscribed to its type whose
+// filters match.  The repo and branch filters only apply to events with a repo, and the pipeline
+// filter only to events with a pipeline.
+func enqueue(ctx context.Context, tx *pachsql.Tx, e *webhook.Event, project, repo, branch, pipeline string) error {
+	payload, err := protojson.Marshal(e)
+	if err != nil {
+		return errors.Wrap(err, "marshal webhook event")
+	}
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO webhooks.deliveries (webhook, payload, state, created, next_attempt)
+		SELECT name, $1, $2, now(), now() FROM webhooks.webhooks
+		WHERE $3 = ANY(events)
+			AND (project = '' OR project = $4)
+			AND (repo = '' OR $5 = '' OR repo = $5)
+			AND (branch = '' OR $5 = '' OR branch = $6)
+			AND (pipeline = '' OR $7 = '' OR pipeline = $7)`,
+		string(payload), statePending, e.Type.String(), project, repo, branch, pipeline); err != nil {
+		return errors.Wrapf(err, "enqueue %v webhook event", e.Type)
+	}
+	return nil
+}
+
+// Delivery is a row in the webhooks.deliveries table.
+type Delivery struct {
+	ID             int64        `db:"id"`
+	Webhook        string       `db:"webhook"`
+	Payload        string       `db:"payload"`
+	State          string       `db:"state"`
+	Attempts       int64        `db:"attempts"`
+	LastStatusCode int64        `db:"last_status_code"`
+	LastError      string       `db:"last_error"`
+	Created        time.Time    `db:"created"`
+	NextAttempt    sql.NullTime `db:"next_attempt"`
+	Finished       sql.NullTime `db:"finished"`
+}
+
+const deliveryColumns = "id, webhook, payload, state, attempts, last_status_code, last_error, created, next_attempt, finished"
+
+var deliveryStates = map[string]webhook.DeliveryState{
+	statePending:   webhook.DeliveryState_DELIVERY_PENDING,
+	stateSucceeded: webhook.DeliveryState_DELIVERY_SUCCEEDED,
+	stateFailed:    webhook.DeliveryState_DELIVERY_FAILED,
+}
+
+// ToProto converts the row to a webhook.Delivery.
+func (d *Delivery) ToProto() (*webhook.Delivery, error) {
+	e := &webhook.Event{}
+	if err := protojson.Unmarshal([]byte(d.Payload), e); err != nil {
+		return nil, errors.Wrapf(err, "unmarshal payload of delivery %d", d.ID)
+	}
+	pb := &webhook.Delivery{
+		Id:             d.ID,
+		Webhook:        d.Webhook,
+		Event:          e,
+		State:          deliveryStates[d.State],
+		Attempts:       d.Attempts,
+		LastStatusCode: d.LastStatusCode,
+		LastError:      d.LastError,
+		Created:        timestamppb.New(d.Created),
+	}
+	if d.NextAttempt.Valid {
+		pb.NextAttempt = timestamppb.New(d.NextAttempt.Time)
+	}
+	if d.Finished.Valid {
+		pb.Finished = timestamppb.New(d.Finished.Time)
+	}
+	return pb, nil
+}
+
+// ListDeliveries calls cb with each delivery to the named webhook, or to every webhook if it is
+// empty, newest first.  At most limit deliveries are returned, unless limit is zero.
+func ListDeliveries(ctx context.Context, q sqlx.QueryerContext, name string, limit int64, cb func(*Delivery) error) error {
+	var where []string
+	var args []any
+	arg := func(v any) string {
+		args = append(args, v)
+		return "$" + strconv.Itoa(len(args))
+	}
+	if name != "" {
+		where = append(where, "webhook = "+arg(name))
+	}
+	query := `SELECT ` + deliveryColumns + ` FROM webhooks.deliveries`
+	if len(where) > 0 {
+		query += ` WHERE ` + strings.Join(where, " AND ")
+	}
+	query += ` ORDER BY id DESC`
+	if limit > 0 {
+		query += ` LIMIT ` + arg(limit)
+	}
+	rows, err := q.QueryxContext(ctx, query, args...)
+	if err != nil {
+		return errors.Wrap(err, "list webhook deliveries")
+	}
+	defer rows.Close()
+	for rows.Next() {
+		d := &Delivery{}
+		if err := rows.StructScan(d); err != nil {
+			return errors.Wrap(err, "scan webhook delivery")
+		}
+		if err := cb(d); err != nil {
+			return err
+		}
+	}
+	return errors.Wrap(rows.Err(), "iterate webhook deliveries")
+}
+
+// PendingDelivery is a delivery that is due, along with the webhook it is for.
+type PendingDelivery struct {
+	Delivery
+	URL    string `db:"url"`
+	Secret string `db:"secret"`
+}
+
+// ListDue returns up to limit pending deliveries whose next attempt is due, oldest first.
+func ListDue(ctx context.Context, q sqlx.QueryerContext, now time.Time, limit int) ([]*PendingDelivery, error) {
+	var ds []*PendingDelivery
+	if err := sqlx.SelectContext(ctx, q, &ds, `
+		SELECT d.id, d.webhook, d.payload, d.state, d.attempts, d.last_status_code, d.last_error,
+			d.created, d.next_attempt, d.finished, w.url, w.secret
+		FROM webhooks.deliveries d JOIN webhooks.webhooks w ON d.webhook = w.name
+		WHERE d.state = $1 AND d.next_attempt <= $2
+		ORDER BY d.next_attempt, d.id
+		LIMIT $3`, statePending, now, limit); err != nil {
+		return nil, errors.Wrap(err, "list due webhook deliveries")
+	}
+	return ds, nil
+}
+
+// Attempt is the result of one attempt to deliver an event.
+type Attempt struct {
+	StatusCode int64
+	Err        error
+	// Retry is when to try again, if the attempt failed.  If it is zero, the delivery fails for
+	// good.
+	Retry time.Time
+}
+
+// RecordAttempt records an attempt to deliver the delivery with the given ID.
+func RecordAttempt(ctx context.Context, q sqlx.ExecerContext, id int64, a Attempt, now time.Time) error {
+	state, next, finished, msg := stateSucceeded, sql.NullTime{}, sql.NullTime{Time: now, Valid: true}, ""
+	if a.Err != nil {
+		msg = a.Err.Error()
+		if a.Retry.IsZero() {
+			state = stateFailed
+		} else {
+			state, next, finished = statePending, sql.NullTime{Time: a.Retry, Valid: true}, sql.NullTime{}
+		}
+	}
+	if _, err := q.ExecContext(ctx, `
+		UPDATE webhooks.deliveries
+		SET state = $2, attempts = attempts + 1, last_status_code = $3, last_error = $4,
+			next_attempt = $5, finished = $6
+		WHERE id = $1`, id, state, a.StatusCode, msg, next, finished); err != nil {
+		return errors.Wrapf(err, "record attempt of webhook delivery %d", id)
+	}
+	return nil
+}
+
+// PruneDeliveries deletes the deliveries that finished before the given time, and returns how
+// many were deleted.
+func PruneDeliveries(ctx context.Context, q sqlx.ExecerContext, before time.Time) (int64, error) {
+	res, err := q.ExecContext(ctx, `DELETE FROM webhooks.deliveries WHERE finished < $1`, before)
+	if err != nil {
+		return 0, errors.Wrap(err, "prune webhook deliveries")
+	}
+	n, err := res.RowsAffected()
+	return n, errors.Wrap(err, "prune webhook deliveries")
+}
@@ -0,0 +1,102 @@
+package webhookdb_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/clusterstate"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dockertestenv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/migrations"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pacherr"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pctx"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/internal/testetcd"
+	"github.com/pachyderm/pachyderm/v2/src/internal/webhookdb"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+	"github.com/pachyderm/pachyderm/v2/src/webhook"
+)
+
+func TestWebhookDeliveries(t *testing.T) {
+	ctx := pctx.TestContext(t)
+	db := dockertestenv.NewTestDB(t)
+	migrationEnv := migrations.Env{EtcdClient: testetcd.NewEnv(ctx, t).EtcdClient}
+	require.NoError(t, migrations.ApplyMigrations(ctx, db, migrationEnv, clusterstate.DesiredClusterState))
+	withTx := func(f func(ctx context.Context, tx *pachsql.Tx) error) error {
+		return dbutil.WithTx(ctx, db, f)
+	}
+	now := time.Now()
+	for _, req := range []*webhook.CreateWebhookRequest{
+		{Name: "all", Url: "http://all", Events: []webhook.EventType{webhook.EventType_COMMIT_FINISHED, webhook.EventType_JOB_FAILED}},
+		{Name: "master", Url: "http://master", Secret: "s", Events: []webhook.EventType{webhook.EventType_COMMIT_FINISHED}, Repo: "images", Branch: "master"},
+		{Name: "edges", Url: "http://edges", Events: []webhook.EventType{webhook.EventType_JOB_FAILED, webhook.EventType_COMMIT_FINISHED}, Pipeline: "edges"},
+	} {
+		require.NoError(t, withTx(func(ctx context.Context, tx *pachsql.Tx) error {
+			return webhookdb.PutWebhook(ctx, tx, webhookdb.FromRequest(req, now), false)
+		}))
+	}
+	err := withTx(func(ctx context.Context, tx *pachsql.Tx) error {
+		return webhookdb.PutWebhook(ctx, tx, &webhookdb.Webhook{Name: "all", Events: []string{}}, false)
+	})
+	require.True(t, pacherr.IsExists(err))
+	w, err := webhookdb.GetWebhook(ctx, db, "master")
+	require.NoError(t, err)
+	require.True(t, w.ToProto().Signed)
+	require.Equal(t, []webhook.EventType{webhook.EventType_COMMIT_FINISHED}, w.ToProto().Events)
+
+	images := &pfs.Repo{Project: &pfs.Project{Name: "default"}, Name: "images", Type: pfs.UserRepoType}
+	require.NoError(t, withTx(func(ctx context.Context, tx *pachsql.Tx) error {
+		if err := webhookdb.CommitFinished(ctx, tx, &pfs.CommitInfo{Commit: images.NewCommit("master", "a")}); err != nil {
+			return err
+		}
+		if err := webhookdb.CommitFinished(ctx, tx, &pfs.CommitInfo{Commit: images.NewCommit("dev", "b")}); err != nil {
+			return err
+		}
+		return webhookdb.JobFailed(ctx, tx, &pps.JobInfo{
+			Job:   &pps.Job{Pipeline: &pps.Pipeline{Project: &pfs.Project{Name: "default"}, Name: "edges"}, Id: "c"},
+			State: pps.JobState_JOB_FAILURE,
+		})
+	}))
+	count := func(name string) int {
+		var n int
+		require.NoError(t, webhookdb.ListDeliveries(ctx, db, name, 0, func(*webhookdb.Delivery) error {
+			n++
+			return nil
+		}))
+		return n
+	}
+	// "all" gets every event, "master" only the commit to master, and "edges" the job failure
+	// and both commits, since its pipeline filter does not apply to commits.
+	require.Equal(t, 3, count("all"))
+	require.Equal(t, 1, count("master"))
+	require.Equal(t, 3, count("edges"))
+
+	due, err := webhookdb.ListDue(ctx, db, time.Now().Add(time.Second), 100)
+	require.NoError(t, err)
+	require.Equal(t, 7, len(due))
+	for i, d := range due {
+		a := webhookdb.Attempt{}
+		if i%2 == 1 {
+			a = webhookdb.Attempt{StatusCode: 500, Err: pacherr.NewNotExist("x", "y"), Retry: time.Now().Add(time.Hour)}
+		}
+		require.NoError(t, webhookdb.RecordAttempt(ctx, db, d.ID, a, time.Now()))
+	}
+	due, err = webhookdb.ListDue(ctx, db, time.Now().Add(time.Second), 100)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(due))
+	n, err := webhookdb.PruneDeliveries(ctx, db, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	require.Equal(t, int64(4), n)
+
+	require.NoError(t, withTx(func(ctx context.Context, tx *pachsql.Tx) error {
+		return webhookdb.DeleteWebhook(ctx, tx, "edges")
+	}))
+	require.Equal(t, 0, count("edges"))
+	err = withTx(func(ctx context.Context, tx *pachsql.Tx) error {
+		return webhookdb.DeleteWebhook(ctx, tx, "edges")
+	})
+	require.True(t, pacherr.IsNotExist(err))
+}
@@ -23,6 +23,9 @@ const (
 	UserRepoType = "user"
 	MetaRepoType = "meta"
 	SpecRepoType = "spec"
+	// AuditRepoType is the type of the system repo that pachd exports the
+	// audit log to.  Only pachd may write to it.
+	AuditRepoType = "audit"
 
 	DefaultProjectName = "default"
 
@@ -130,6 +133,21 @@ func (b *Branch) Key() string {
 	return b.GetRepo().Key() + "@" + b.Name
 }
 
+func (r *Repo) NewTag(name string) *Tag {
+	return &Tag{
+		Repo: proto.Clone(r).(*Repo),
+		Name: name,
+	}
+}
+
+func (t *Tag) String() string {
+	return t.Repo.String() + "@" + t.Name
+}
+
+func (t *Tag) Key() string {
+	return t.GetRepo().Key() + "@" + t.Name
+}
+
 // ValidateName returns an error if the project is nil or its name is an invalid
 // project name.  DefaultProjectName is always valid; otherwise the ancestry
 // package is used to validate the name.
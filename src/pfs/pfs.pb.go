@@ -243,6 +243,57 @@ func (Delimiter) EnumDescriptor() ([]byte, []int) {
 	return file_pfs_pfs_proto_rawDescGZIP(), []int{3}
 }
 
+type SQLDatabaseEgress_Mode int32
+
+const (
+	// REPLACE deletes the contents of each table written to and reloads it
+	// from the commit.
+	SQLDatabaseEgress_REPLACE SQLDatabaseEgress_Mode = 0
+	// UPSERT applies only the rows which changed since the last commit from
+	// the same repo was egressed to the same database.  The first egress to
+	// a database behaves like REPLACE.
+	SQLDatabaseEgress_UPSERT SQLDatabaseEgress_Mode = 1
+)
+
+// Enum value maps for SQLDatabaseEgress_Mode.
+var (
+	SQLDatabaseEgress_Mode_name = map[int32]string{
+		0: "REPLACE",
+		1: "UPSERT",
+	}
+	SQLDatabaseEgress_Mode_value = map[string]int32{
+		"REPLACE": 0,
+		"UPSERT":  1,
+	}
+)
+
+func (x SQLDatabaseEgress_Mode) Enum() *SQLDatabaseEgress_Mode {
+	p := new(SQLDatabaseEgress_Mode)
+	*p = x
+	return p
+}
+
+func (x SQLDatabaseEgress_Mode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SQLDatabaseEgress_Mode) Descriptor() protoreflect.EnumDescriptor {
+	return file_pfs_pfs_proto_enumTypes[4].Descriptor()
+}
+
+func (SQLDatabaseEgress_Mode) Type() protoreflect.EnumType {
+	return &file_pfs_pfs_proto_enumTypes[4]
+}
+
+func (x SQLDatabaseEgress_Mode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SQLDatabaseEgress_Mode.Descriptor instead.
+func (SQLDatabaseEgress_Mode) EnumDescriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{98, 0}
+}
+
 type SQLDatabaseEgress_FileFormat_Type int32
 
 const (
@@ -279,11 +330,11 @@ func (x SQLDatabaseEgress_FileFormat_Type) String() string {
 }
 
 func (SQLDatabaseEgress_FileFormat_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_pfs_pfs_proto_enumTypes[4].Descriptor()
+	return file_pfs_pfs_proto_enumTypes[5].Descriptor()
 }
 
 func (SQLDatabaseEgress_FileFormat_Type) Type() protoreflect.EnumType {
-	return &file_pfs_pfs_proto_enumTypes[4]
+	return &file_pfs_pfs_proto_enumTypes[5]
 }
 
 func (x SQLDatabaseEgress_FileFormat_Type) Number() protoreflect.EnumNumber {
@@ -292,7 +343,7 @@ func (x SQLDatabaseEgress_FileFormat_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SQLDatabaseEgress_FileFormat_Type.Descriptor instead.
 func (SQLDatabaseEgress_FileFormat_Type) EnumDescriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{76, 0, 0}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{98, 0, 0}
 }
 
 type Repo struct {
@@ -413,6 +464,63 @@ func (x *Branch) GetName() string {
 	return ""
 }
 
+// Tag is an immutable, human-readable name for a commit in a repo.  Unlike a
+// branch, a tag always refers to the same commit.
+type Tag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Repo *Repo  `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *Tag) Reset() {
+	*x = Tag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfs_pfs_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Tag) autogeneratedString() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tag) ProtoMessage() {}
+
+func (x *Tag) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tag.ProtoReflect.Descriptor instead.
+func (*Tag) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Tag) GetRepo() *Repo {
+	if x != nil {
+		return x.Repo
+	}
+	return nil
+}
+
+func (x *Tag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
 type File struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -426,7 +534,7 @@ type File struct {
 func (x *File) Reset() {
 	*x = File{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[2]
+		mi := &file_pfs_pfs_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -439,7 +547,7 @@ func (x *File) String() string {
 func (*File) ProtoMessage() {}
 
 func (x *File) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[2]
+	mi := &file_pfs_pfs_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -452,7 +560,7 @@ func (x *File) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use File.ProtoReflect.Descriptor instead.
 func (*File) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{2}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *File) GetCommit() *Commit {
@@ -492,12 +600,17 @@ type RepoInfo struct {
 	// Pachyderm Auth API (in src/client/auth/auth.proto)
 	AuthInfo *AuthInfo         `protobuf:"bytes,6,opt,name=auth_info,json=authInfo,proto3" json:"auth_info,omitempty"`
 	Details  *RepoInfo_Details `protobuf:"bytes,7,opt,name=details,proto3" json:"details,omitempty"`
+	// metadata is user-provided key/value pairs describing this repo.
+	Metadata map[string]string `protobuf:"bytes,8,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// retention_policy is applied to every branch in this repo that does not
+	// have its own policy.
+	RetentionPolicy *RetentionPolicy `protobuf:"bytes,9,opt,name=retention_policy,json=retentionPolicy,proto3" json:"retention_policy,omitempty"`
 }
 
 func (x *RepoInfo) Reset() {
 	*x = RepoInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[3]
+		mi := &file_pfs_pfs_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -510,7 +623,7 @@ func (x *RepoInfo) String() string {
 func (*RepoInfo) ProtoMessage() {}
 
 func (x *RepoInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[3]
+	mi := &file_pfs_pfs_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -523,7 +636,7 @@ func (x *RepoInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RepoInfo.ProtoReflect.Descriptor instead.
 func (*RepoInfo) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{3}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *RepoInfo) GetRepo() *Repo {
@@ -575,6 +688,20 @@ func (x *RepoInfo) GetDetails() *RepoInfo_Details {
 	return nil
 }
 
+func (x *RepoInfo) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *RepoInfo) GetRetentionPolicy() *RetentionPolicy {
+	if x != nil {
+		return x.RetentionPolicy
+	}
+	return nil
+}
+
 // AuthInfo includes the caller's access scope for a resource, and is returned
 // by services like ListRepo, InspectRepo, and ListProject, but is not persisted in the database.
 // It's used by the Pachyderm dashboard to render repo access appropriately.
@@ -596,7 +723,7 @@ type AuthInfo struct {
 func (x *AuthInfo) Reset() {
 	*x = AuthInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[4]
+		mi := &file_pfs_pfs_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -609,7 +736,7 @@ func (x *AuthInfo) String() string {
 func (*AuthInfo) ProtoMessage() {}
 
 func (x *AuthInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[4]
+	mi := &file_pfs_pfs_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -622,7 +749,7 @@ func (x *AuthInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthInfo.ProtoReflect.Descriptor instead.
 func (*AuthInfo) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{4}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *AuthInfo) GetPermissions() []auth.Permission {
@@ -650,12 +777,16 @@ type BranchInfo struct {
 	Subvenance       []*Branch `protobuf:"bytes,4,rep,name=subvenance,proto3" json:"subvenance,omitempty"`
 	DirectProvenance []*Branch `protobuf:"bytes,5,rep,name=direct_provenance,json=directProvenance,proto3" json:"direct_provenance,omitempty"`
 	Trigger          *Trigger  `protobuf:"bytes,6,opt,name=trigger,proto3" json:"trigger,omitempty"`
+	// metadata is user-provided key/value pairs describing this branch.
+	Metadata map[string]string `protobuf:"bytes,7,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// retention_policy overrides the retention policy of the branch's repo.
+	RetentionPolicy *RetentionPolicy `protobuf:"bytes,8,opt,name=retention_policy,json=retentionPolicy,proto3" json:"retention_policy,omitempty"`
 }
 
 func (x *BranchInfo) Reset() {
 	*x = BranchInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[5]
+		mi := &file_pfs_pfs_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -668,7 +799,7 @@ func (x *BranchInfo) String() string {
 func (*BranchInfo) ProtoMessage() {}
 
 func (x *BranchInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[5]
+	mi := &file_pfs_pfs_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -681,7 +812,7 @@ func (x *BranchInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BranchInfo.ProtoReflect.Descriptor instead.
 func (*BranchInfo) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{5}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *BranchInfo) GetBranch() *Branch {
@@ -726,6 +857,90 @@ func (x *BranchInfo) GetTrigger() *Trigger {
 	return nil
 }
 
+func (x *BranchInfo) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *BranchInfo) GetRetentionPolicy() *RetentionPolicy {
+	if x != nil {
+		return x.RetentionPolicy
+	}
+	return nil
+}
+
+// RetentionPolicy describes which commits on a branch are kept.  A commit that
+// does not satisfy any of the set conditions expires, and the PFS master
+// squashes it in the background.  The head of a branch never expires.  A
+// policy that sets neither keep_last nor keep_newer_than keeps every commit.
+type RetentionPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// keep_last keeps the most recent keep_last commits on the branch.
+	KeepLast int64 `protobuf:"varint,1,opt,name=keep_last,json=keepLast,proto3" json:"keep_last,omitempty"`
+	// keep_newer_than keeps commits started within the given duration.
+	KeepNewerThan *durationpb.Duration `protobuf:"bytes,2,opt,name=keep_newer_than,json=keepNewerThan,proto3" json:"keep_newer_than,omitempty"`
+	// keep_metadata keeps commits whose metadata contains all of these pairs.
+	KeepMetadata map[string]string `protobuf:"bytes,3,rep,name=keep_metadata,json=keepMetadata,proto3" json:"keep_metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *RetentionPolicy) Reset() {
+	*x = RetentionPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfs_pfs_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetentionPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetentionPolicy) ProtoMessage() {}
+
+func (x *RetentionPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetentionPolicy.ProtoReflect.Descriptor instead.
+func (*RetentionPolicy) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RetentionPolicy) GetKeepLast() int64 {
+	if x != nil {
+		return x.KeepLast
+	}
+	return 0
+}
+
+func (x *RetentionPolicy) GetKeepNewerThan() *durationpb.Duration {
+	if x != nil {
+		return x.KeepNewerThan
+	}
+	return nil
+}
+
+func (x *RetentionPolicy) GetKeepMetadata() map[string]string {
+	if x != nil {
+		return x.KeepMetadata
+	}
+	return nil
+}
+
 // Trigger defines the conditions under which a head is moved, and to which
 // branch it is moved.
 type Trigger struct {
@@ -756,7 +971,7 @@ type Trigger struct {
 func (x *Trigger) Reset() {
 	*x = Trigger{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[6]
+		mi := &file_pfs_pfs_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -769,7 +984,7 @@ func (x *Trigger) String() string {
 func (*Trigger) ProtoMessage() {}
 
 func (x *Trigger) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[6]
+	mi := &file_pfs_pfs_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -782,7 +997,7 @@ func (x *Trigger) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Trigger.ProtoReflect.Descriptor instead.
 func (*Trigger) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{6}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *Trigger) GetBranch() string {
@@ -838,7 +1053,7 @@ type CommitOrigin struct {
 func (x *CommitOrigin) Reset() {
 	*x = CommitOrigin{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[7]
+		mi := &file_pfs_pfs_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -851,7 +1066,7 @@ func (x *CommitOrigin) String() string {
 func (*CommitOrigin) ProtoMessage() {}
 
 func (x *CommitOrigin) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[7]
+	mi := &file_pfs_pfs_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -864,7 +1079,7 @@ func (x *CommitOrigin) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CommitOrigin.ProtoReflect.Descriptor instead.
 func (*CommitOrigin) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{7}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *CommitOrigin) GetKind() OriginKind {
@@ -891,7 +1106,7 @@ type Commit struct {
 func (x *Commit) Reset() {
 	*x = Commit{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[8]
+		mi := &file_pfs_pfs_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -904,7 +1119,7 @@ func (x *Commit) autogeneratedString() string {
 func (*Commit) ProtoMessage() {}
 
 func (x *Commit) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[8]
+	mi := &file_pfs_pfs_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -917,7 +1132,7 @@ func (x *Commit) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Commit.ProtoReflect.Descriptor instead.
 func (*Commit) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{8}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *Commit) GetRepo() *Repo {
@@ -960,12 +1175,14 @@ type CommitInfo struct {
 	Error               string                 `protobuf:"bytes,10,opt,name=error,proto3" json:"error,omitempty"`
 	SizeBytesUpperBound int64                  `protobuf:"varint,11,opt,name=size_bytes_upper_bound,json=sizeBytesUpperBound,proto3" json:"size_bytes_upper_bound,omitempty"`
 	Details             *CommitInfo_Details    `protobuf:"bytes,12,opt,name=details,proto3" json:"details,omitempty"`
+	// metadata is user-provided key/value pairs describing this commit.
+	Metadata map[string]string `protobuf:"bytes,14,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (x *CommitInfo) Reset() {
 	*x = CommitInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[9]
+		mi := &file_pfs_pfs_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -978,7 +1195,7 @@ func (x *CommitInfo) String() string {
 func (*CommitInfo) ProtoMessage() {}
 
 func (x *CommitInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[9]
+	mi := &file_pfs_pfs_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -991,7 +1208,7 @@ func (x *CommitInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CommitInfo.ProtoReflect.Descriptor instead.
 func (*CommitInfo) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{9}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *CommitInfo) GetCommit() *Commit {
@@ -1078,6 +1295,13 @@ func (x *CommitInfo) GetDetails() *CommitInfo_Details {
 	return nil
 }
 
+func (x *CommitInfo) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
 type CommitSet struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1089,7 +1313,7 @@ type CommitSet struct {
 func (x *CommitSet) Reset() {
 	*x = CommitSet{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[10]
+		mi := &file_pfs_pfs_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1102,7 +1326,7 @@ func (x *CommitSet) String() string {
 func (*CommitSet) ProtoMessage() {}
 
 func (x *CommitSet) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[10]
+	mi := &file_pfs_pfs_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1115,7 +1339,7 @@ func (x *CommitSet) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CommitSet.ProtoReflect.Descriptor instead.
 func (*CommitSet) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{10}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *CommitSet) GetId() string {
@@ -1137,7 +1361,7 @@ type CommitSetInfo struct {
 func (x *CommitSetInfo) Reset() {
 	*x = CommitSetInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[11]
+		mi := &file_pfs_pfs_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1150,7 +1374,7 @@ func (x *CommitSetInfo) String() string {
 func (*CommitSetInfo) ProtoMessage() {}
 
 func (x *CommitSetInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[11]
+	mi := &file_pfs_pfs_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1163,7 +1387,7 @@ func (x *CommitSetInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CommitSetInfo.ProtoReflect.Descriptor instead.
 func (*CommitSetInfo) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{11}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *CommitSetInfo) GetCommitSet() *CommitSet {
@@ -1190,12 +1414,16 @@ type FileInfo struct {
 	Committed *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=committed,proto3" json:"committed,omitempty"`
 	SizeBytes int64                  `protobuf:"varint,4,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
 	Hash      []byte                 `protobuf:"bytes,5,opt,name=hash,proto3" json:"hash,omitempty"`
+	// The MIME type of the file's content, if one was set when it was added.
+	ContentType string `protobuf:"bytes,6,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// User metadata set when the file was added.
+	Metadata map[string]string `protobuf:"bytes,7,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (x *FileInfo) Reset() {
 	*x = FileInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[12]
+		mi := &file_pfs_pfs_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1208,7 +1436,7 @@ func (x *FileInfo) String() string {
 func (*FileInfo) ProtoMessage() {}
 
 func (x *FileInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[12]
+	mi := &file_pfs_pfs_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1221,7 +1449,7 @@ func (x *FileInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FileInfo.ProtoReflect.Descriptor instead.
 func (*FileInfo) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{12}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *FileInfo) GetFile() *File {
@@ -1259,6 +1487,20 @@ func (x *FileInfo) GetHash() []byte {
 	return nil
 }
 
+func (x *FileInfo) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *FileInfo) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
 type Project struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1270,7 +1512,7 @@ type Project struct {
 func (x *Project) Reset() {
 	*x = Project{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[13]
+		mi := &file_pfs_pfs_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1283,7 +1525,7 @@ func (x *Project) autogeneratedString() string {
 func (*Project) ProtoMessage() {}
 
 func (x *Project) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[13]
+	mi := &file_pfs_pfs_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1296,7 +1538,7 @@ func (x *Project) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Project.ProtoReflect.Descriptor instead.
 func (*Project) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{13}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *Project) GetName() string {
@@ -1315,12 +1557,14 @@ type ProjectInfo struct {
 	Description string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
 	AuthInfo    *AuthInfo              `protobuf:"bytes,3,opt,name=auth_info,json=authInfo,proto3" json:"auth_info,omitempty"`
 	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// metadata is user-provided key/value pairs describing this project.
+	Metadata map[string]string `protobuf:"bytes,5,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (x *ProjectInfo) Reset() {
 	*x = ProjectInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[14]
+		mi := &file_pfs_pfs_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1333,7 +1577,7 @@ func (x *ProjectInfo) String() string {
 func (*ProjectInfo) ProtoMessage() {}
 
 func (x *ProjectInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[14]
+	mi := &file_pfs_pfs_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1346,7 +1590,7 @@ func (x *ProjectInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProjectInfo.ProtoReflect.Descriptor instead.
 func (*ProjectInfo) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{14}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ProjectInfo) GetProject() *Project {
@@ -1377,6 +1621,13 @@ func (x *ProjectInfo) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *ProjectInfo) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
 type CreateRepoRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1390,7 +1641,7 @@ type CreateRepoRequest struct {
 func (x *CreateRepoRequest) Reset() {
 	*x = CreateRepoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[15]
+		mi := &file_pfs_pfs_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1403,7 +1654,7 @@ func (x *CreateRepoRequest) String() string {
 func (*CreateRepoRequest) ProtoMessage() {}
 
 func (x *CreateRepoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[15]
+	mi := &file_pfs_pfs_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1416,7 +1667,7 @@ func (x *CreateRepoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateRepoRequest.ProtoReflect.Descriptor instead.
 func (*CreateRepoRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{15}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *CreateRepoRequest) GetRepo() *Repo {
@@ -1451,7 +1702,7 @@ type InspectRepoRequest struct {
 func (x *InspectRepoRequest) Reset() {
 	*x = InspectRepoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[16]
+		mi := &file_pfs_pfs_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1464,7 +1715,7 @@ func (x *InspectRepoRequest) String() string {
 func (*InspectRepoRequest) ProtoMessage() {}
 
 func (x *InspectRepoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[16]
+	mi := &file_pfs_pfs_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1477,7 +1728,7 @@ func (x *InspectRepoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InspectRepoRequest.ProtoReflect.Descriptor instead.
 func (*InspectRepoRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{16}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *InspectRepoRequest) GetRepo() *Repo {
@@ -1497,12 +1748,15 @@ type ListRepoRequest struct {
 	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
 	// projects filters out repos that do not belong in the list, while no projects means list all repos.
 	Projects []*Project `protobuf:"bytes,2,rep,name=projects,proto3" json:"projects,omitempty"`
+	// metadata filters out repos whose metadata does not contain every one of
+	// these key/value pairs.
+	Metadata map[string]string `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (x *ListRepoRequest) Reset() {
 	*x = ListRepoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[17]
+		mi := &file_pfs_pfs_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1515,7 +1769,7 @@ func (x *ListRepoRequest) String() string {
 func (*ListRepoRequest) ProtoMessage() {}
 
 func (x *ListRepoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[17]
+	mi := &file_pfs_pfs_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1528,7 +1782,7 @@ func (x *ListRepoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListRepoRequest.ProtoReflect.Descriptor instead.
 func (*ListRepoRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{17}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ListRepoRequest) GetType() string {
@@ -1545,6 +1799,13 @@ func (x *ListRepoRequest) GetProjects() []*Project {
 	return nil
 }
 
+func (x *ListRepoRequest) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
 type DeleteRepoRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1557,7 +1818,7 @@ type DeleteRepoRequest struct {
 func (x *DeleteRepoRequest) Reset() {
 	*x = DeleteRepoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[18]
+		mi := &file_pfs_pfs_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1570,7 +1831,7 @@ func (x *DeleteRepoRequest) String() string {
 func (*DeleteRepoRequest) ProtoMessage() {}
 
 func (x *DeleteRepoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[18]
+	mi := &file_pfs_pfs_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1583,7 +1844,7 @@ func (x *DeleteRepoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteRepoRequest.ProtoReflect.Descriptor instead.
 func (*DeleteRepoRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{18}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *DeleteRepoRequest) GetRepo() *Repo {
@@ -1618,7 +1879,7 @@ type DeleteReposRequest struct {
 func (x *DeleteReposRequest) Reset() {
 	*x = DeleteReposRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[19]
+		mi := &file_pfs_pfs_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1631,7 +1892,7 @@ func (x *DeleteReposRequest) String() string {
 func (*DeleteReposRequest) ProtoMessage() {}
 
 func (x *DeleteReposRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[19]
+	mi := &file_pfs_pfs_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1644,7 +1905,7 @@ func (x *DeleteReposRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteReposRequest.ProtoReflect.Descriptor instead.
 func (*DeleteReposRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{19}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *DeleteReposRequest) GetProjects() []*Project {
@@ -1681,7 +1942,7 @@ type DeleteRepoResponse struct {
 func (x *DeleteRepoResponse) Reset() {
 	*x = DeleteRepoResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[20]
+		mi := &file_pfs_pfs_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1694,7 +1955,7 @@ func (x *DeleteRepoResponse) String() string {
 func (*DeleteRepoResponse) ProtoMessage() {}
 
 func (x *DeleteRepoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[20]
+	mi := &file_pfs_pfs_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1707,7 +1968,7 @@ func (x *DeleteRepoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteRepoResponse.ProtoReflect.Descriptor instead.
 func (*DeleteRepoResponse) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{20}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *DeleteRepoResponse) GetDeleted() bool {
@@ -1728,7 +1989,7 @@ type DeleteReposResponse struct {
 func (x *DeleteReposResponse) Reset() {
 	*x = DeleteReposResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[21]
+		mi := &file_pfs_pfs_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1741,7 +2002,7 @@ func (x *DeleteReposResponse) String() string {
 func (*DeleteReposResponse) ProtoMessage() {}
 
 func (x *DeleteReposResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[21]
+	mi := &file_pfs_pfs_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1754,7 +2015,7 @@ func (x *DeleteReposResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteReposResponse.ProtoReflect.Descriptor instead.
 func (*DeleteReposResponse) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{21}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *DeleteReposResponse) GetRepos() []*Repo {
@@ -1780,7 +2041,7 @@ type StartCommitRequest struct {
 func (x *StartCommitRequest) Reset() {
 	*x = StartCommitRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[22]
+		mi := &file_pfs_pfs_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1793,7 +2054,7 @@ func (x *StartCommitRequest) String() string {
 func (*StartCommitRequest) ProtoMessage() {}
 
 func (x *StartCommitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[22]
+	mi := &file_pfs_pfs_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1806,7 +2067,7 @@ func (x *StartCommitRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StartCommitRequest.ProtoReflect.Descriptor instead.
 func (*StartCommitRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{22}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *StartCommitRequest) GetParent() *Commit {
@@ -1846,7 +2107,7 @@ type FinishCommitRequest struct {
 func (x *FinishCommitRequest) Reset() {
 	*x = FinishCommitRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[23]
+		mi := &file_pfs_pfs_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1859,7 +2120,7 @@ func (x *FinishCommitRequest) String() string {
 func (*FinishCommitRequest) ProtoMessage() {}
 
 func (x *FinishCommitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[23]
+	mi := &file_pfs_pfs_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1872,7 +2133,7 @@ func (x *FinishCommitRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FinishCommitRequest.ProtoReflect.Descriptor instead.
 func (*FinishCommitRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{23}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *FinishCommitRequest) GetCommit() *Commit {
@@ -1916,7 +2177,7 @@ type InspectCommitRequest struct {
 func (x *InspectCommitRequest) Reset() {
 	*x = InspectCommitRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[24]
+		mi := &file_pfs_pfs_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1929,7 +2190,7 @@ func (x *InspectCommitRequest) String() string {
 func (*InspectCommitRequest) ProtoMessage() {}
 
 func (x *InspectCommitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[24]
+	mi := &file_pfs_pfs_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1942,7 +2203,7 @@ func (x *InspectCommitRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InspectCommitRequest.ProtoReflect.Descriptor instead.
 func (*InspectCommitRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{24}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *InspectCommitRequest) GetCommit() *Commit {
@@ -1972,12 +2233,14 @@ type ListCommitRequest struct {
 	All         bool                   `protobuf:"varint,6,opt,name=all,proto3" json:"all,omitempty"`                                                        // Return commits of all kinds (without this, aliases are excluded)
 	OriginKind  OriginKind             `protobuf:"varint,7,opt,name=origin_kind,json=originKind,proto3,enum=pfs_v2.OriginKind" json:"origin_kind,omitempty"` // Return only commits of this kind (mutually exclusive with all)
 	StartedTime *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=started_time,json=startedTime,proto3" json:"started_time,omitempty"`                      // Return commits started before this time
+	// Return only commits whose metadata contains every one of these key/value pairs
+	Metadata map[string]string `protobuf:"bytes,9,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (x *ListCommitRequest) Reset() {
 	*x = ListCommitRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[25]
+		mi := &file_pfs_pfs_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1990,7 +2253,7 @@ func (x *ListCommitRequest) String() string {
 func (*ListCommitRequest) ProtoMessage() {}
 
 func (x *ListCommitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[25]
+	mi := &file_pfs_pfs_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2003,7 +2266,7 @@ func (x *ListCommitRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCommitRequest.ProtoReflect.Descriptor instead.
 func (*ListCommitRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{25}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *ListCommitRequest) GetRepo() *Repo {
@@ -2062,6 +2325,13 @@ func (x *ListCommitRequest) GetStartedTime() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *ListCommitRequest) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
 type InspectCommitSetRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2074,7 +2344,7 @@ type InspectCommitSetRequest struct {
 func (x *InspectCommitSetRequest) Reset() {
 	*x = InspectCommitSetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[26]
+		mi := &file_pfs_pfs_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2087,7 +2357,7 @@ func (x *InspectCommitSetRequest) String() string {
 func (*InspectCommitSetRequest) ProtoMessage() {}
 
 func (x *InspectCommitSetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[26]
+	mi := &file_pfs_pfs_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2100,7 +2370,7 @@ func (x *InspectCommitSetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InspectCommitSetRequest.ProtoReflect.Descriptor instead.
 func (*InspectCommitSetRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{26}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *InspectCommitSetRequest) GetCommitSet() *CommitSet {
@@ -2128,7 +2398,7 @@ type ListCommitSetRequest struct {
 func (x *ListCommitSetRequest) Reset() {
 	*x = ListCommitSetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[27]
+		mi := &file_pfs_pfs_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2141,7 +2411,7 @@ func (x *ListCommitSetRequest) String() string {
 func (*ListCommitSetRequest) ProtoMessage() {}
 
 func (x *ListCommitSetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[27]
+	mi := &file_pfs_pfs_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2154,7 +2424,7 @@ func (x *ListCommitSetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCommitSetRequest.ProtoReflect.Descriptor instead.
 func (*ListCommitSetRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{27}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *ListCommitSetRequest) GetProject() *Project {
@@ -2175,7 +2445,7 @@ type SquashCommitSetRequest struct {
 func (x *SquashCommitSetRequest) Reset() {
 	*x = SquashCommitSetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[28]
+		mi := &file_pfs_pfs_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2188,7 +2458,7 @@ func (x *SquashCommitSetRequest) String() string {
 func (*SquashCommitSetRequest) ProtoMessage() {}
 
 func (x *SquashCommitSetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[28]
+	mi := &file_pfs_pfs_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2201,7 +2471,7 @@ func (x *SquashCommitSetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SquashCommitSetRequest.ProtoReflect.Descriptor instead.
 func (*SquashCommitSetRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{28}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *SquashCommitSetRequest) GetCommitSet() *CommitSet {
@@ -2222,7 +2492,7 @@ type DropCommitSetRequest struct {
 func (x *DropCommitSetRequest) Reset() {
 	*x = DropCommitSetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[29]
+		mi := &file_pfs_pfs_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2235,7 +2505,7 @@ func (x *DropCommitSetRequest) String() string {
 func (*DropCommitSetRequest) ProtoMessage() {}
 
 func (x *DropCommitSetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[29]
+	mi := &file_pfs_pfs_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2248,7 +2518,7 @@ func (x *DropCommitSetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DropCommitSetRequest.ProtoReflect.Descriptor instead.
 func (*DropCommitSetRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{29}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *DropCommitSetRequest) GetCommitSet() *CommitSet {
@@ -2276,7 +2546,7 @@ type SubscribeCommitRequest struct {
 func (x *SubscribeCommitRequest) Reset() {
 	*x = SubscribeCommitRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[30]
+		mi := &file_pfs_pfs_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2289,7 +2559,7 @@ func (x *SubscribeCommitRequest) String() string {
 func (*SubscribeCommitRequest) ProtoMessage() {}
 
 func (x *SubscribeCommitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[30]
+	mi := &file_pfs_pfs_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2302,7 +2572,7 @@ func (x *SubscribeCommitRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubscribeCommitRequest.ProtoReflect.Descriptor instead.
 func (*SubscribeCommitRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{30}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *SubscribeCommitRequest) GetRepo() *Repo {
@@ -2358,7 +2628,7 @@ type ClearCommitRequest struct {
 func (x *ClearCommitRequest) Reset() {
 	*x = ClearCommitRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[31]
+		mi := &file_pfs_pfs_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2371,7 +2641,7 @@ func (x *ClearCommitRequest) String() string {
 func (*ClearCommitRequest) ProtoMessage() {}
 
 func (x *ClearCommitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[31]
+	mi := &file_pfs_pfs_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2384,7 +2654,7 @@ func (x *ClearCommitRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClearCommitRequest.ProtoReflect.Descriptor instead.
 func (*ClearCommitRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{31}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *ClearCommitRequest) GetCommit() *Commit {
@@ -2408,7 +2678,7 @@ type SquashCommitRequest struct {
 func (x *SquashCommitRequest) Reset() {
 	*x = SquashCommitRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[32]
+		mi := &file_pfs_pfs_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2421,7 +2691,7 @@ func (x *SquashCommitRequest) String() string {
 func (*SquashCommitRequest) ProtoMessage() {}
 
 func (x *SquashCommitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[32]
+	mi := &file_pfs_pfs_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2434,7 +2704,7 @@ func (x *SquashCommitRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SquashCommitRequest.ProtoReflect.Descriptor instead.
 func (*SquashCommitRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{32}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *SquashCommitRequest) GetCommit() *Commit {
@@ -2460,7 +2730,7 @@ type SquashCommitResponse struct {
 func (x *SquashCommitResponse) Reset() {
 	*x = SquashCommitResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[33]
+		mi := &file_pfs_pfs_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2473,7 +2743,7 @@ func (x *SquashCommitResponse) String() string {
 func (*SquashCommitResponse) ProtoMessage() {}
 
 func (x *SquashCommitResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[33]
+	mi := &file_pfs_pfs_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2486,7 +2756,7 @@ func (x *SquashCommitResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SquashCommitResponse.ProtoReflect.Descriptor instead.
 func (*SquashCommitResponse) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{33}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{35}
 }
 
 type DropCommitRequest struct {
@@ -2503,7 +2773,7 @@ type DropCommitRequest struct {
 func (x *DropCommitRequest) Reset() {
 	*x = DropCommitRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[34]
+		mi := &file_pfs_pfs_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2516,7 +2786,7 @@ func (x *DropCommitRequest) String() string {
 func (*DropCommitRequest) ProtoMessage() {}
 
 func (x *DropCommitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[34]
+	mi := &file_pfs_pfs_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2529,7 +2799,7 @@ func (x *DropCommitRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DropCommitRequest.ProtoReflect.Descriptor instead.
 func (*DropCommitRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{34}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *DropCommitRequest) GetCommit() *Commit {
@@ -2555,7 +2825,7 @@ type DropCommitResponse struct {
 func (x *DropCommitResponse) Reset() {
 	*x = DropCommitResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[35]
+		mi := &file_pfs_pfs_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2568,7 +2838,7 @@ func (x *DropCommitResponse) String() string {
 func (*DropCommitResponse) ProtoMessage() {}
 
 func (x *DropCommitResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[35]
+	mi := &file_pfs_pfs_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2581,7 +2851,7 @@ func (x *DropCommitResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DropCommitResponse.ProtoReflect.Descriptor instead.
 func (*DropCommitResponse) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{35}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{37}
 }
 
 type CreateBranchRequest struct {
@@ -2599,7 +2869,7 @@ type CreateBranchRequest struct {
 func (x *CreateBranchRequest) Reset() {
 	*x = CreateBranchRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[36]
+		mi := &file_pfs_pfs_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2612,7 +2882,7 @@ func (x *CreateBranchRequest) String() string {
 func (*CreateBranchRequest) ProtoMessage() {}
 
 func (x *CreateBranchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[36]
+	mi := &file_pfs_pfs_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2625,7 +2895,7 @@ func (x *CreateBranchRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateBranchRequest.ProtoReflect.Descriptor instead.
 func (*CreateBranchRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{36}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *CreateBranchRequest) GetHead() *Commit {
@@ -2676,7 +2946,7 @@ type FindCommitsRequest struct {
 func (x *FindCommitsRequest) Reset() {
 	*x = FindCommitsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[37]
+		mi := &file_pfs_pfs_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2689,7 +2959,7 @@ func (x *FindCommitsRequest) String() string {
 func (*FindCommitsRequest) ProtoMessage() {}
 
 func (x *FindCommitsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[37]
+	mi := &file_pfs_pfs_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2702,7 +2972,7 @@ func (x *FindCommitsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FindCommitsRequest.ProtoReflect.Descriptor instead.
 func (*FindCommitsRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{37}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *FindCommitsRequest) GetStart() *Commit {
@@ -2742,7 +3012,7 @@ type FindCommitsResponse struct {
 func (x *FindCommitsResponse) Reset() {
 	*x = FindCommitsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[38]
+		mi := &file_pfs_pfs_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2755,7 +3025,7 @@ func (x *FindCommitsResponse) String() string {
 func (*FindCommitsResponse) ProtoMessage() {}
 
 func (x *FindCommitsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[38]
+	mi := &file_pfs_pfs_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2768,7 +3038,7 @@ func (x *FindCommitsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FindCommitsResponse.ProtoReflect.Descriptor instead.
 func (*FindCommitsResponse) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{38}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{40}
 }
 
 func (m *FindCommitsResponse) GetResult() isFindCommitsResponse_Result {
@@ -2826,7 +3096,7 @@ type InspectBranchRequest struct {
 func (x *InspectBranchRequest) Reset() {
 	*x = InspectBranchRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[39]
+		mi := &file_pfs_pfs_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2839,7 +3109,7 @@ func (x *InspectBranchRequest) String() string {
 func (*InspectBranchRequest) ProtoMessage() {}
 
 func (x *InspectBranchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[39]
+	mi := &file_pfs_pfs_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2852,7 +3122,7 @@ func (x *InspectBranchRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InspectBranchRequest.ProtoReflect.Descriptor instead.
 func (*InspectBranchRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{39}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *InspectBranchRequest) GetBranch() *Branch {
@@ -2874,7 +3144,7 @@ type ListBranchRequest struct {
 func (x *ListBranchRequest) Reset() {
 	*x = ListBranchRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[40]
+		mi := &file_pfs_pfs_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2887,7 +3157,7 @@ func (x *ListBranchRequest) String() string {
 func (*ListBranchRequest) ProtoMessage() {}
 
 func (x *ListBranchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[40]
+	mi := &file_pfs_pfs_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2900,7 +3170,7 @@ func (x *ListBranchRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListBranchRequest.ProtoReflect.Descriptor instead.
 func (*ListBranchRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{40}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *ListBranchRequest) GetRepo() *Repo {
@@ -2929,7 +3199,7 @@ type DeleteBranchRequest struct {
 func (x *DeleteBranchRequest) Reset() {
 	*x = DeleteBranchRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[41]
+		mi := &file_pfs_pfs_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2942,7 +3212,7 @@ func (x *DeleteBranchRequest) String() string {
 func (*DeleteBranchRequest) ProtoMessage() {}
 
 func (x *DeleteBranchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[41]
+	mi := &file_pfs_pfs_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2955,7 +3225,7 @@ func (x *DeleteBranchRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteBranchRequest.ProtoReflect.Descriptor instead.
 func (*DeleteBranchRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{41}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *DeleteBranchRequest) GetBranch() *Branch {
@@ -2972,33 +3242,33 @@ func (x *DeleteBranchRequest) GetForce() bool {
 	return false
 }
 
-type CreateProjectRequest struct {
+type TagInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Project     *Project `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
-	Description string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Update      bool     `protobuf:"varint,3,opt,name=update,proto3" json:"update,omitempty"`
+	Tag     *Tag                   `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Commit  *Commit                `protobuf:"bytes,2,opt,name=commit,proto3" json:"commit,omitempty"`
+	Created *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created,proto3" json:"created,omitempty"`
 }
 
-func (x *CreateProjectRequest) Reset() {
-	*x = CreateProjectRequest{}
+func (x *TagInfo) Reset() {
+	*x = TagInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[42]
+		mi := &file_pfs_pfs_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateProjectRequest) String() string {
+func (x *TagInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateProjectRequest) ProtoMessage() {}
+func (*TagInfo) ProtoMessage() {}
 
-func (x *CreateProjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[42]
+func (x *TagInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3009,57 +3279,60 @@ func (x *CreateProjectRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateProjectRequest.ProtoReflect.Descriptor instead.
-func (*CreateProjectRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use TagInfo.ProtoReflect.Descriptor instead.
+func (*TagInfo) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *CreateProjectRequest) GetProject() *Project {
+func (x *TagInfo) GetTag() *Tag {
 	if x != nil {
-		return x.Project
+		return x.Tag
 	}
 	return nil
 }
 
-func (x *CreateProjectRequest) GetDescription() string {
+func (x *TagInfo) GetCommit() *Commit {
 	if x != nil {
-		return x.Description
+		return x.Commit
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateProjectRequest) GetUpdate() bool {
+func (x *TagInfo) GetCreated() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Update
+		return x.Created
 	}
-	return false
+	return nil
 }
 
-type InspectProjectRequest struct {
+type CreateTagRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Project *Project `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	Tag *Tag `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	// commit is the commit to tag; it may be given as a branch, in which case
+	// the branch's current head is tagged.
+	Commit *Commit `protobuf:"bytes,2,opt,name=commit,proto3" json:"commit,omitempty"`
 }
 
-func (x *InspectProjectRequest) Reset() {
-	*x = InspectProjectRequest{}
+func (x *CreateTagRequest) Reset() {
+	*x = CreateTagRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[43]
+		mi := &file_pfs_pfs_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *InspectProjectRequest) String() string {
+func (x *CreateTagRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InspectProjectRequest) ProtoMessage() {}
+func (*CreateTagRequest) ProtoMessage() {}
 
-func (x *InspectProjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[43]
+func (x *CreateTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3070,41 +3343,50 @@ func (x *InspectProjectRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InspectProjectRequest.ProtoReflect.Descriptor instead.
-func (*InspectProjectRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use CreateTagRequest.ProtoReflect.Descriptor instead.
+func (*CreateTagRequest) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *InspectProjectRequest) GetProject() *Project {
+func (x *CreateTagRequest) GetTag() *Tag {
 	if x != nil {
-		return x.Project
+		return x.Tag
 	}
 	return nil
 }
 
-type ListProjectRequest struct {
+func (x *CreateTagRequest) GetCommit() *Commit {
+	if x != nil {
+		return x.Commit
+	}
+	return nil
+}
+
+type InspectTagRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Tag *Tag `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
 }
 
-func (x *ListProjectRequest) Reset() {
-	*x = ListProjectRequest{}
+func (x *InspectTagRequest) Reset() {
+	*x = InspectTagRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[44]
+		mi := &file_pfs_pfs_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListProjectRequest) String() string {
+func (x *InspectTagRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProjectRequest) ProtoMessage() {}
+func (*InspectTagRequest) ProtoMessage() {}
 
-func (x *ListProjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[44]
+func (x *InspectTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3115,37 +3397,43 @@ func (x *ListProjectRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProjectRequest.ProtoReflect.Descriptor instead.
-func (*ListProjectRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use InspectTagRequest.ProtoReflect.Descriptor instead.
+func (*InspectTagRequest) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{46}
 }
 
-type DeleteProjectRequest struct {
+func (x *InspectTagRequest) GetTag() *Tag {
+	if x != nil {
+		return x.Tag
+	}
+	return nil
+}
+
+type ListTagRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Project *Project `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
-	Force   bool     `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	Repo *Repo `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
 }
 
-func (x *DeleteProjectRequest) Reset() {
-	*x = DeleteProjectRequest{}
+func (x *ListTagRequest) Reset() {
+	*x = ListTagRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[45]
+		mi := &file_pfs_pfs_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteProjectRequest) String() string {
+func (x *ListTagRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteProjectRequest) ProtoMessage() {}
+func (*ListTagRequest) ProtoMessage() {}
 
-func (x *DeleteProjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[45]
+func (x *ListTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3156,56 +3444,43 @@ func (x *DeleteProjectRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteProjectRequest.ProtoReflect.Descriptor instead.
-func (*DeleteProjectRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use ListTagRequest.ProtoReflect.Descriptor instead.
+func (*ListTagRequest) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *DeleteProjectRequest) GetProject() *Project {
+func (x *ListTagRequest) GetRepo() *Repo {
 	if x != nil {
-		return x.Project
+		return x.Repo
 	}
 	return nil
 }
 
-func (x *DeleteProjectRequest) GetForce() bool {
-	if x != nil {
-		return x.Force
-	}
-	return false
-}
-
-type AddFile struct {
+type DeleteTagRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Path  string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
-	Datum string `protobuf:"bytes,2,opt,name=datum,proto3" json:"datum,omitempty"`
-	// Types that are assignable to Source:
-	//
-	//	*AddFile_Raw
-	//	*AddFile_Url
-	Source isAddFile_Source `protobuf_oneof:"source"`
+	Tag *Tag `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
 }
 
-func (x *AddFile) Reset() {
-	*x = AddFile{}
+func (x *DeleteTagRequest) Reset() {
+	*x = DeleteTagRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[46]
+		mi := &file_pfs_pfs_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddFile) String() string {
+func (x *DeleteTagRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddFile) ProtoMessage() {}
+func (*DeleteTagRequest) ProtoMessage() {}
 
-func (x *AddFile) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[46]
+func (x *DeleteTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3216,88 +3491,106 @@ func (x *AddFile) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddFile.ProtoReflect.Descriptor instead.
-func (*AddFile) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use DeleteTagRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTagRequest) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *AddFile) GetPath() string {
+func (x *DeleteTagRequest) GetTag() *Tag {
 	if x != nil {
-		return x.Path
+		return x.Tag
 	}
-	return ""
+	return nil
 }
 
-func (x *AddFile) GetDatum() string {
-	if x != nil {
-		return x.Datum
-	}
-	return ""
+type CreateProjectRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Project     *Project `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	Description string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Update      bool     `protobuf:"varint,3,opt,name=update,proto3" json:"update,omitempty"`
 }
 
-func (m *AddFile) GetSource() isAddFile_Source {
-	if m != nil {
-		return m.Source
+func (x *CreateProjectRequest) Reset() {
+	*x = CreateProjectRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfs_pfs_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *AddFile) GetRaw() *wrapperspb.BytesValue {
-	if x, ok := x.GetSource().(*AddFile_Raw); ok {
-		return x.Raw
-	}
-	return nil
+func (x *CreateProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *AddFile) GetUrl() *AddFile_URLSource {
-	if x, ok := x.GetSource().(*AddFile_Url); ok {
-		return x.Url
+func (*CreateProjectRequest) ProtoMessage() {}
+
+func (x *CreateProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-type isAddFile_Source interface {
-	isAddFile_Source()
+// Deprecated: Use CreateProjectRequest.ProtoReflect.Descriptor instead.
+func (*CreateProjectRequest) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{49}
 }
 
-type AddFile_Raw struct {
-	Raw *wrapperspb.BytesValue `protobuf:"bytes,3,opt,name=raw,proto3,oneof"`
+func (x *CreateProjectRequest) GetProject() *Project {
+	if x != nil {
+		return x.Project
+	}
+	return nil
 }
 
-type AddFile_Url struct {
-	Url *AddFile_URLSource `protobuf:"bytes,4,opt,name=url,proto3,oneof"`
+func (x *CreateProjectRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
 }
 
-func (*AddFile_Raw) isAddFile_Source() {}
-
-func (*AddFile_Url) isAddFile_Source() {}
+func (x *CreateProjectRequest) GetUpdate() bool {
+	if x != nil {
+		return x.Update
+	}
+	return false
+}
 
-type DeleteFile struct {
+type InspectProjectRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Path  string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
-	Datum string `protobuf:"bytes,2,opt,name=datum,proto3" json:"datum,omitempty"`
+	Project *Project `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
 }
 
-func (x *DeleteFile) Reset() {
-	*x = DeleteFile{}
+func (x *InspectProjectRequest) Reset() {
+	*x = InspectProjectRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[47]
+		mi := &file_pfs_pfs_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteFile) String() string {
+func (x *InspectProjectRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteFile) ProtoMessage() {}
+func (*InspectProjectRequest) ProtoMessage() {}
 
-func (x *DeleteFile) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[47]
+func (x *InspectProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3308,53 +3601,41 @@ func (x *DeleteFile) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteFile.ProtoReflect.Descriptor instead.
-func (*DeleteFile) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{47}
-}
-
-func (x *DeleteFile) GetPath() string {
-	if x != nil {
-		return x.Path
-	}
-	return ""
+// Deprecated: Use InspectProjectRequest.ProtoReflect.Descriptor instead.
+func (*InspectProjectRequest) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *DeleteFile) GetDatum() string {
+func (x *InspectProjectRequest) GetProject() *Project {
 	if x != nil {
-		return x.Datum
+		return x.Project
 	}
-	return ""
+	return nil
 }
 
-type CopyFile struct {
+type ListProjectRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Dst    string `protobuf:"bytes,1,opt,name=dst,proto3" json:"dst,omitempty"`
-	Datum  string `protobuf:"bytes,2,opt,name=datum,proto3" json:"datum,omitempty"`
-	Src    *File  `protobuf:"bytes,3,opt,name=src,proto3" json:"src,omitempty"`
-	Append bool   `protobuf:"varint,4,opt,name=append,proto3" json:"append,omitempty"`
 }
 
-func (x *CopyFile) Reset() {
-	*x = CopyFile{}
+func (x *ListProjectRequest) Reset() {
+	*x = ListProjectRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[48]
+		mi := &file_pfs_pfs_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CopyFile) String() string {
+func (x *ListProjectRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CopyFile) ProtoMessage() {}
+func (*ListProjectRequest) ProtoMessage() {}
 
-func (x *CopyFile) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[48]
+func (x *ListProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3365,70 +3646,93 @@ func (x *CopyFile) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CopyFile.ProtoReflect.Descriptor instead.
-func (*CopyFile) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use ListProjectRequest.ProtoReflect.Descriptor instead.
+func (*ListProjectRequest) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *CopyFile) GetDst() string {
-	if x != nil {
-		return x.Dst
+type DeleteProjectRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Project *Project `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	Force   bool     `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+}
+
+func (x *DeleteProjectRequest) Reset() {
+	*x = DeleteProjectRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfs_pfs_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *CopyFile) GetDatum() string {
-	if x != nil {
-		return x.Datum
+func (x *DeleteProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProjectRequest) ProtoMessage() {}
+
+func (x *DeleteProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *CopyFile) GetSrc() *File {
+// Deprecated: Use DeleteProjectRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProjectRequest) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *DeleteProjectRequest) GetProject() *Project {
 	if x != nil {
-		return x.Src
+		return x.Project
 	}
 	return nil
 }
 
-func (x *CopyFile) GetAppend() bool {
+func (x *DeleteProjectRequest) GetForce() bool {
 	if x != nil {
-		return x.Append
+		return x.Force
 	}
 	return false
 }
 
-type ModifyFileRequest struct {
+// EditMetadataRequest edits the user metadata of projects, repos, branches and
+// commits.  The edits are applied in order, in a single transaction.
+type EditMetadataRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Body:
-	//
-	//	*ModifyFileRequest_SetCommit
-	//	*ModifyFileRequest_AddFile
-	//	*ModifyFileRequest_DeleteFile
-	//	*ModifyFileRequest_CopyFile
-	Body isModifyFileRequest_Body `protobuf_oneof:"body"`
+	Edits []*EditMetadataRequest_Edit `protobuf:"bytes,1,rep,name=edits,proto3" json:"edits,omitempty"`
 }
 
-func (x *ModifyFileRequest) Reset() {
-	*x = ModifyFileRequest{}
+func (x *EditMetadataRequest) Reset() {
+	*x = EditMetadataRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[49]
+		mi := &file_pfs_pfs_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ModifyFileRequest) String() string {
+func (x *EditMetadataRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ModifyFileRequest) ProtoMessage() {}
+func (*EditMetadataRequest) ProtoMessage() {}
 
-func (x *ModifyFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[49]
+func (x *EditMetadataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3439,102 +3743,178 @@ func (x *ModifyFileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ModifyFileRequest.ProtoReflect.Descriptor instead.
-func (*ModifyFileRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use EditMetadataRequest.ProtoReflect.Descriptor instead.
+func (*EditMetadataRequest) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{53}
 }
 
-func (m *ModifyFileRequest) GetBody() isModifyFileRequest_Body {
-	if m != nil {
-		return m.Body
+func (x *EditMetadataRequest) GetEdits() []*EditMetadataRequest_Edit {
+	if x != nil {
+		return x.Edits
 	}
 	return nil
 }
 
-func (x *ModifyFileRequest) GetSetCommit() *Commit {
-	if x, ok := x.GetBody().(*ModifyFileRequest_SetCommit); ok {
-		return x.SetCommit
-	}
-	return nil
+type EditMetadataResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *ModifyFileRequest) GetAddFile() *AddFile {
-	if x, ok := x.GetBody().(*ModifyFileRequest_AddFile); ok {
-		return x.AddFile
+func (x *EditMetadataResponse) Reset() {
+	*x = EditMetadataResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfs_pfs_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *ModifyFileRequest) GetDeleteFile() *DeleteFile {
-	if x, ok := x.GetBody().(*ModifyFileRequest_DeleteFile); ok {
-		return x.DeleteFile
+func (x *EditMetadataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditMetadataResponse) ProtoMessage() {}
+
+func (x *EditMetadataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *ModifyFileRequest) GetCopyFile() *CopyFile {
-	if x, ok := x.GetBody().(*ModifyFileRequest_CopyFile); ok {
-		return x.CopyFile
+// Deprecated: Use EditMetadataResponse.ProtoReflect.Descriptor instead.
+func (*EditMetadataResponse) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{54}
+}
+
+// SetRetentionPolicyRequest sets the retention policy of a repo or a branch.
+// An unset policy removes the existing one.
+type SetRetentionPolicyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Target:
+	//	*SetRetentionPolicyRequest_Repo
+	//	*SetRetentionPolicyRequest_Branch
+	Target isSetRetentionPolicyRequest_Target `protobuf_oneof:"target"`
+	Policy *RetentionPolicy                   `protobuf:"bytes,3,opt,name=policy,proto3" json:"policy,omitempty"`
+}
+
+func (x *SetRetentionPolicyRequest) Reset() {
+	*x = SetRetentionPolicyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfs_pfs_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-type isModifyFileRequest_Body interface {
-	isModifyFileRequest_Body()
+func (x *SetRetentionPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type ModifyFileRequest_SetCommit struct {
-	SetCommit *Commit `protobuf:"bytes,1,opt,name=set_commit,json=setCommit,proto3,oneof"`
+func (*SetRetentionPolicyRequest) ProtoMessage() {}
+
+func (x *SetRetentionPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type ModifyFileRequest_AddFile struct {
-	AddFile *AddFile `protobuf:"bytes,2,opt,name=add_file,json=addFile,proto3,oneof"`
+// Deprecated: Use SetRetentionPolicyRequest.ProtoReflect.Descriptor instead.
+func (*SetRetentionPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{55}
 }
 
-type ModifyFileRequest_DeleteFile struct {
-	DeleteFile *DeleteFile `protobuf:"bytes,3,opt,name=delete_file,json=deleteFile,proto3,oneof"`
+func (m *SetRetentionPolicyRequest) GetTarget() isSetRetentionPolicyRequest_Target {
+	if m != nil {
+		return m.Target
+	}
+	return nil
 }
 
-type ModifyFileRequest_CopyFile struct {
-	CopyFile *CopyFile `protobuf:"bytes,4,opt,name=copy_file,json=copyFile,proto3,oneof"`
+func (x *SetRetentionPolicyRequest) GetRepo() *Repo {
+	if x, ok := x.GetTarget().(*SetRetentionPolicyRequest_Repo); ok {
+		return x.Repo
+	}
+	return nil
 }
 
-func (*ModifyFileRequest_SetCommit) isModifyFileRequest_Body() {}
+func (x *SetRetentionPolicyRequest) GetBranch() *Branch {
+	if x, ok := x.GetTarget().(*SetRetentionPolicyRequest_Branch); ok {
+		return x.Branch
+	}
+	return nil
+}
 
-func (*ModifyFileRequest_AddFile) isModifyFileRequest_Body() {}
+func (x *SetRetentionPolicyRequest) GetPolicy() *RetentionPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
 
-func (*ModifyFileRequest_DeleteFile) isModifyFileRequest_Body() {}
+type isSetRetentionPolicyRequest_Target interface {
+	isSetRetentionPolicyRequest_Target()
+}
 
-func (*ModifyFileRequest_CopyFile) isModifyFileRequest_Body() {}
+type SetRetentionPolicyRequest_Repo struct {
+	Repo *Repo `protobuf:"bytes,1,opt,name=repo,proto3,oneof"`
+}
 
-type GetFileRequest struct {
+type SetRetentionPolicyRequest_Branch struct {
+	Branch *Branch `protobuf:"bytes,2,opt,name=branch,proto3,oneof"`
+}
+
+func (*SetRetentionPolicyRequest_Repo) isSetRetentionPolicyRequest_Target() {}
+
+func (*SetRetentionPolicyRequest_Branch) isSetRetentionPolicyRequest_Target() {}
+
+// ApplyRetentionPolicyRequest squashes the expired commits of a repo, or of a
+// single branch.
+type ApplyRetentionPolicyRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	File      *File      `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
-	URL       string     `protobuf:"bytes,2,opt,name=URL,proto3" json:"URL,omitempty"`
-	Offset    int64      `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
-	PathRange *PathRange `protobuf:"bytes,4,opt,name=path_range,json=pathRange,proto3" json:"path_range,omitempty"`
+	// Types that are assignable to Target:
+	//	*ApplyRetentionPolicyRequest_Repo
+	//	*ApplyRetentionPolicyRequest_Branch
+	Target isApplyRetentionPolicyRequest_Target `protobuf_oneof:"target"`
+	// dry_run reports which commits would be squashed without squashing them.
+	DryRun bool `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 }
 
-func (x *GetFileRequest) Reset() {
-	*x = GetFileRequest{}
+func (x *ApplyRetentionPolicyRequest) Reset() {
+	*x = ApplyRetentionPolicyRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[50]
+		mi := &file_pfs_pfs_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetFileRequest) String() string {
+func (x *ApplyRetentionPolicyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFileRequest) ProtoMessage() {}
+func (*ApplyRetentionPolicyRequest) ProtoMessage() {}
 
-func (x *GetFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[50]
+func (x *ApplyRetentionPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3545,64 +3925,81 @@ func (x *GetFileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFileRequest.ProtoReflect.Descriptor instead.
-func (*GetFileRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use ApplyRetentionPolicyRequest.ProtoReflect.Descriptor instead.
+func (*ApplyRetentionPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *GetFileRequest) GetFile() *File {
-	if x != nil {
-		return x.File
+func (m *ApplyRetentionPolicyRequest) GetTarget() isApplyRetentionPolicyRequest_Target {
+	if m != nil {
+		return m.Target
 	}
 	return nil
 }
 
-func (x *GetFileRequest) GetURL() string {
-	if x != nil {
-		return x.URL
+func (x *ApplyRetentionPolicyRequest) GetRepo() *Repo {
+	if x, ok := x.GetTarget().(*ApplyRetentionPolicyRequest_Repo); ok {
+		return x.Repo
 	}
-	return ""
+	return nil
 }
 
-func (x *GetFileRequest) GetOffset() int64 {
-	if x != nil {
-		return x.Offset
+func (x *ApplyRetentionPolicyRequest) GetBranch() *Branch {
+	if x, ok := x.GetTarget().(*ApplyRetentionPolicyRequest_Branch); ok {
+		return x.Branch
 	}
-	return 0
+	return nil
 }
 
-func (x *GetFileRequest) GetPathRange() *PathRange {
+func (x *ApplyRetentionPolicyRequest) GetDryRun() bool {
 	if x != nil {
-		return x.PathRange
+		return x.DryRun
 	}
-	return nil
+	return false
 }
 
-type InspectFileRequest struct {
+type isApplyRetentionPolicyRequest_Target interface {
+	isApplyRetentionPolicyRequest_Target()
+}
+
+type ApplyRetentionPolicyRequest_Repo struct {
+	Repo *Repo `protobuf:"bytes,1,opt,name=repo,proto3,oneof"`
+}
+
+type ApplyRetentionPolicyRequest_Branch struct {
+	Branch *Branch `protobuf:"bytes,2,opt,name=branch,proto3,oneof"`
+}
+
+func (*ApplyRetentionPolicyRequest_Repo) isApplyRetentionPolicyRequest_Target() {}
+
+func (*ApplyRetentionPolicyRequest_Branch) isApplyRetentionPolicyRequest_Target() {}
+
+type ApplyRetentionPolicyResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	File *File `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	// expired holds the commits that were (or, for a dry run, would be) squashed.
+	Expired []*Commit `protobuf:"bytes,1,rep,name=expired,proto3" json:"expired,omitempty"`
 }
 
-func (x *InspectFileRequest) Reset() {
-	*x = InspectFileRequest{}
+func (x *ApplyRetentionPolicyResponse) Reset() {
+	*x = ApplyRetentionPolicyResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[51]
+		mi := &file_pfs_pfs_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *InspectFileRequest) String() string {
+func (x *ApplyRetentionPolicyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InspectFileRequest) ProtoMessage() {}
+func (*ApplyRetentionPolicyResponse) ProtoMessage() {}
 
-func (x *InspectFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[51]
+func (x *ApplyRetentionPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3613,55 +4010,46 @@ func (x *InspectFileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InspectFileRequest.ProtoReflect.Descriptor instead.
-func (*InspectFileRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use ApplyRetentionPolicyResponse.ProtoReflect.Descriptor instead.
+func (*ApplyRetentionPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *InspectFileRequest) GetFile() *File {
+func (x *ApplyRetentionPolicyResponse) GetExpired() []*Commit {
 	if x != nil {
-		return x.File
+		return x.Expired
 	}
 	return nil
 }
 
-type ListFileRequest struct {
+type PurgePathRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// File is the parent directory of the files we want to list. This sets the
-	// repo, the commit/branch, and path prefix of files we're interested in
-	// If the "path" field is omitted, a list of files at the top level of the repo
-	// is returned
-	File *File `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
-	// Marker for pagination. If set, the files that come after the marker in
-	// lexicographical order will be returned. If reverse is also set, the files
-	// that come before the marker in lexicographical order will be returned.
-	PaginationMarker *File `protobuf:"bytes,3,opt,name=paginationMarker,proto3" json:"paginationMarker,omitempty"`
-	// Number of files to return
-	Number int64 `protobuf:"varint,4,opt,name=number,proto3" json:"number,omitempty"`
-	// If true, return files in reverse order
-	Reverse bool `protobuf:"varint,5,opt,name=reverse,proto3" json:"reverse,omitempty"`
+	Repo *Repo `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	// path is the file to purge.  If it names a directory, every file under it
+	// is purged.
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
 }
 
-func (x *ListFileRequest) Reset() {
-	*x = ListFileRequest{}
+func (x *PurgePathRequest) Reset() {
+	*x = PurgePathRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[52]
+		mi := &file_pfs_pfs_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListFileRequest) String() string {
+func (x *PurgePathRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListFileRequest) ProtoMessage() {}
+func (*PurgePathRequest) ProtoMessage() {}
 
-func (x *ListFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[52]
+func (x *PurgePathRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3672,72 +4060,54 @@ func (x *ListFileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListFileRequest.ProtoReflect.Descriptor instead.
-func (*ListFileRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{52}
-}
-
-func (x *ListFileRequest) GetFile() *File {
-	if x != nil {
-		return x.File
-	}
-	return nil
+// Deprecated: Use PurgePathRequest.ProtoReflect.Descriptor instead.
+func (*PurgePathRequest) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *ListFileRequest) GetPaginationMarker() *File {
+func (x *PurgePathRequest) GetRepo() *Repo {
 	if x != nil {
-		return x.PaginationMarker
+		return x.Repo
 	}
 	return nil
 }
 
-func (x *ListFileRequest) GetNumber() int64 {
-	if x != nil {
-		return x.Number
-	}
-	return 0
-}
-
-func (x *ListFileRequest) GetReverse() bool {
+func (x *PurgePathRequest) GetPath() string {
 	if x != nil {
-		return x.Reverse
+		return x.Path
 	}
-	return false
+	return ""
 }
 
-type WalkFileRequest struct {
+// PurgedDatums are the datums of a pipeline whose output was purged because
+// they read a purged file.  The pipeline's next job reprocesses them.
+type PurgedDatums struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	File *File `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
-	// Marker for pagination. If set, the files that come after the marker in
-	// lexicographical order will be returned. If reverse is also set, the files
-	// that come before the marker in lexicographical order will be returned.
-	PaginationMarker *File `protobuf:"bytes,2,opt,name=paginationMarker,proto3" json:"paginationMarker,omitempty"`
-	// Number of files to return
-	Number int64 `protobuf:"varint,3,opt,name=number,proto3" json:"number,omitempty"`
-	// If true, return files in reverse order
-	Reverse bool `protobuf:"varint,4,opt,name=reverse,proto3" json:"reverse,omitempty"`
+	// repo is the pipeline's output repo.
+	Repo   *Repo    `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	Datums []string `protobuf:"bytes,2,rep,name=datums,proto3" json:"datums,omitempty"`
 }
 
-func (x *WalkFileRequest) Reset() {
-	*x = WalkFileRequest{}
+func (x *PurgedDatums) Reset() {
+	*x = PurgedDatums{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[53]
+		mi := &file_pfs_pfs_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WalkFileRequest) String() string {
+func (x *PurgedDatums) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WalkFileRequest) ProtoMessage() {}
+func (*PurgedDatums) ProtoMessage() {}
 
-func (x *WalkFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[53]
+func (x *PurgedDatums) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3748,66 +4118,56 @@ func (x *WalkFileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WalkFileRequest.ProtoReflect.Descriptor instead.
-func (*WalkFileRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{53}
+// Deprecated: Use PurgedDatums.ProtoReflect.Descriptor instead.
+func (*PurgedDatums) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *WalkFileRequest) GetFile() *File {
+func (x *PurgedDatums) GetRepo() *Repo {
 	if x != nil {
-		return x.File
+		return x.Repo
 	}
 	return nil
 }
 
-func (x *WalkFileRequest) GetPaginationMarker() *File {
+func (x *PurgedDatums) GetDatums() []string {
 	if x != nil {
-		return x.PaginationMarker
+		return x.Datums
 	}
 	return nil
 }
 
-func (x *WalkFileRequest) GetNumber() int64 {
-	if x != nil {
-		return x.Number
-	}
-	return 0
-}
-
-func (x *WalkFileRequest) GetReverse() bool {
-	if x != nil {
-		return x.Reverse
-	}
-	return false
-}
-
-type GlobFileRequest struct {
+type PurgePathResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Commit    *Commit    `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"`
-	Pattern   string     `protobuf:"bytes,2,opt,name=pattern,proto3" json:"pattern,omitempty"`
-	PathRange *PathRange `protobuf:"bytes,3,opt,name=path_range,json=pathRange,proto3" json:"path_range,omitempty"`
+	// commits holds the commits whose file sets were rewritten without the
+	// purged files.
+	Commits []*Commit       `protobuf:"bytes,1,rep,name=commits,proto3" json:"commits,omitempty"`
+	Datums  []*PurgedDatums `protobuf:"bytes,2,rep,name=datums,proto3" json:"datums,omitempty"`
+	// unreferenced_chunks holds the IDs of the chunks that held purged data and
+	// that no commit references anymore.  Garbage collection deletes them.
+	UnreferencedChunks []string `protobuf:"bytes,3,rep,name=unreferenced_chunks,json=unreferencedChunks,proto3" json:"unreferenced_chunks,omitempty"`
 }
 
-func (x *GlobFileRequest) Reset() {
-	*x = GlobFileRequest{}
+func (x *PurgePathResponse) Reset() {
+	*x = PurgePathResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[54]
+		mi := &file_pfs_pfs_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GlobFileRequest) String() string {
+func (x *PurgePathResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GlobFileRequest) ProtoMessage() {}
+func (*PurgePathResponse) ProtoMessage() {}
 
-func (x *GlobFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[54]
+func (x *PurgePathResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3818,61 +4178,69 @@ func (x *GlobFileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GlobFileRequest.ProtoReflect.Descriptor instead.
-func (*GlobFileRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{54}
+// Deprecated: Use PurgePathResponse.ProtoReflect.Descriptor instead.
+func (*PurgePathResponse) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *GlobFileRequest) GetCommit() *Commit {
+func (x *PurgePathResponse) GetCommits() []*Commit {
 	if x != nil {
-		return x.Commit
+		return x.Commits
 	}
 	return nil
 }
 
-func (x *GlobFileRequest) GetPattern() string {
+func (x *PurgePathResponse) GetDatums() []*PurgedDatums {
 	if x != nil {
-		return x.Pattern
+		return x.Datums
 	}
-	return ""
+	return nil
 }
 
-func (x *GlobFileRequest) GetPathRange() *PathRange {
+func (x *PurgePathResponse) GetUnreferencedChunks() []string {
 	if x != nil {
-		return x.PathRange
+		return x.UnreferencedChunks
 	}
 	return nil
 }
 
-type DiffFileRequest struct {
+type AddFile struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NewFile *File `protobuf:"bytes,1,opt,name=new_file,json=newFile,proto3" json:"new_file,omitempty"`
-	// OldFile may be left nil in which case the same path in the parent of
-	// NewFile's commit will be used.
-	OldFile *File `protobuf:"bytes,2,opt,name=old_file,json=oldFile,proto3" json:"old_file,omitempty"`
-	Shallow bool  `protobuf:"varint,3,opt,name=shallow,proto3" json:"shallow,omitempty"`
+	Path  string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Datum string `protobuf:"bytes,2,opt,name=datum,proto3" json:"datum,omitempty"`
+	// Types that are assignable to Source:
+	//
+	//	*AddFile_Raw
+	//	*AddFile_Url
+	Source isAddFile_Source `protobuf_oneof:"source"`
+	// The content type and metadata of a file are set together: if either is
+	// set, both replace the content type and metadata that the file already has.
+	// Otherwise they are left unchanged, so appending to a file keeps them.
+	// They can't be set on a recursive URL source.
+	ContentType string            `protobuf:"bytes,5,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Metadata    map[string]string `protobuf:"bytes,6,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *DiffFileRequest) Reset() {
-	*x = DiffFileRequest{}
+func (x *AddFile) Reset() {
+	*x = AddFile{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[55]
+		mi := &file_pfs_pfs_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DiffFileRequest) String() string {
+func (x *AddFile) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DiffFileRequest) ProtoMessage() {}
+func (*AddFile) ProtoMessage() {}
 
-func (x *DiffFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[55]
+func (x *AddFile) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3883,58 +4251,102 @@ func (x *DiffFileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DiffFileRequest.ProtoReflect.Descriptor instead.
-func (*DiffFileRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{55}
+// Deprecated: Use AddFile.ProtoReflect.Descriptor instead.
+func (*AddFile) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *DiffFileRequest) GetNewFile() *File {
+func (x *AddFile) GetPath() string {
 	if x != nil {
-		return x.NewFile
+		return x.Path
 	}
-	return nil
+	return ""
 }
 
-func (x *DiffFileRequest) GetOldFile() *File {
+func (x *AddFile) GetDatum() string {
 	if x != nil {
-		return x.OldFile
+		return x.Datum
+	}
+	return ""
+}
+
+func (m *AddFile) GetSource() isAddFile_Source {
+	if m != nil {
+		return m.Source
 	}
 	return nil
 }
 
-func (x *DiffFileRequest) GetShallow() bool {
+func (x *AddFile) GetRaw() *wrapperspb.BytesValue {
+	if x, ok := x.GetSource().(*AddFile_Raw); ok {
+		return x.Raw
+	}
+	return nil
+}
+
+func (x *AddFile) GetUrl() *AddFile_URLSource {
+	if x, ok := x.GetSource().(*AddFile_Url); ok {
+		return x.Url
+	}
+	return nil
+}
+
+func (x *AddFile) GetContentType() string {
 	if x != nil {
-		return x.Shallow
+		return x.ContentType
 	}
-	return false
+	return ""
 }
 
-type DiffFileResponse struct {
+func (x *AddFile) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type isAddFile_Source interface {
+	isAddFile_Source()
+}
+
+type AddFile_Raw struct {
+	Raw *wrapperspb.BytesValue `protobuf:"bytes,3,opt,name=raw,proto3,oneof"`
+}
+
+type AddFile_Url struct {
+	Url *AddFile_URLSource `protobuf:"bytes,4,opt,name=url,proto3,oneof"`
+}
+
+func (*AddFile_Raw) isAddFile_Source() {}
+
+func (*AddFile_Url) isAddFile_Source() {}
+
+type DeleteFile struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NewFile *FileInfo `protobuf:"bytes,1,opt,name=new_file,json=newFile,proto3" json:"new_file,omitempty"`
-	OldFile *FileInfo `protobuf:"bytes,2,opt,name=old_file,json=oldFile,proto3" json:"old_file,omitempty"`
+	Path  string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Datum string `protobuf:"bytes,2,opt,name=datum,proto3" json:"datum,omitempty"`
 }
 
-func (x *DiffFileResponse) Reset() {
-	*x = DiffFileResponse{}
+func (x *DeleteFile) Reset() {
+	*x = DeleteFile{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[56]
+		mi := &file_pfs_pfs_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DiffFileResponse) String() string {
+func (x *DeleteFile) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DiffFileResponse) ProtoMessage() {}
+func (*DeleteFile) ProtoMessage() {}
 
-func (x *DiffFileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[56]
+func (x *DeleteFile) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3945,55 +4357,53 @@ func (x *DiffFileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DiffFileResponse.ProtoReflect.Descriptor instead.
-func (*DiffFileResponse) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{56}
+// Deprecated: Use DeleteFile.ProtoReflect.Descriptor instead.
+func (*DeleteFile) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *DiffFileResponse) GetNewFile() *FileInfo {
+func (x *DeleteFile) GetPath() string {
 	if x != nil {
-		return x.NewFile
+		return x.Path
 	}
-	return nil
+	return ""
 }
 
-func (x *DiffFileResponse) GetOldFile() *FileInfo {
+func (x *DeleteFile) GetDatum() string {
 	if x != nil {
-		return x.OldFile
+		return x.Datum
 	}
-	return nil
+	return ""
 }
 
-type FsckRequest struct {
+type CopyFile struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Fix bool `protobuf:"varint,1,opt,name=fix,proto3" json:"fix,omitempty"`
-	// Types that are assignable to ZombieCheck:
-	//
-	//	*FsckRequest_ZombieTarget
-	//	*FsckRequest_ZombieAll
-	ZombieCheck isFsckRequest_ZombieCheck `protobuf_oneof:"zombie_check"`
+	Dst    string `protobuf:"bytes,1,opt,name=dst,proto3" json:"dst,omitempty"`
+	Datum  string `protobuf:"bytes,2,opt,name=datum,proto3" json:"datum,omitempty"`
+	Src    *File  `protobuf:"bytes,3,opt,name=src,proto3" json:"src,omitempty"`
+	Append bool   `protobuf:"varint,4,opt,name=append,proto3" json:"append,omitempty"`
 }
 
-func (x *FsckRequest) Reset() {
-	*x = FsckRequest{}
+func (x *CopyFile) Reset() {
+	*x = CopyFile{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[57]
+		mi := &file_pfs_pfs_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FsckRequest) String() string {
+func (x *CopyFile) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FsckRequest) ProtoMessage() {}
+func (*CopyFile) ProtoMessage() {}
 
-func (x *FsckRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[57]
+func (x *CopyFile) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4004,82 +4414,70 @@ func (x *FsckRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FsckRequest.ProtoReflect.Descriptor instead.
-func (*FsckRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{57}
+// Deprecated: Use CopyFile.ProtoReflect.Descriptor instead.
+func (*CopyFile) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *FsckRequest) GetFix() bool {
+func (x *CopyFile) GetDst() string {
 	if x != nil {
-		return x.Fix
+		return x.Dst
 	}
-	return false
+	return ""
 }
 
-func (m *FsckRequest) GetZombieCheck() isFsckRequest_ZombieCheck {
-	if m != nil {
-		return m.ZombieCheck
+func (x *CopyFile) GetDatum() string {
+	if x != nil {
+		return x.Datum
 	}
-	return nil
+	return ""
 }
 
-func (x *FsckRequest) GetZombieTarget() *Commit {
-	if x, ok := x.GetZombieCheck().(*FsckRequest_ZombieTarget); ok {
-		return x.ZombieTarget
+func (x *CopyFile) GetSrc() *File {
+	if x != nil {
+		return x.Src
 	}
 	return nil
 }
 
-func (x *FsckRequest) GetZombieAll() bool {
-	if x, ok := x.GetZombieCheck().(*FsckRequest_ZombieAll); ok {
-		return x.ZombieAll
+func (x *CopyFile) GetAppend() bool {
+	if x != nil {
+		return x.Append
 	}
 	return false
 }
 
-type isFsckRequest_ZombieCheck interface {
-	isFsckRequest_ZombieCheck()
-}
-
-type FsckRequest_ZombieTarget struct {
-	ZombieTarget *Commit `protobuf:"bytes,2,opt,name=zombie_target,json=zombieTarget,proto3,oneof"`
-}
-
-type FsckRequest_ZombieAll struct {
-	// run zombie data detection against all pipelines
-	ZombieAll bool `protobuf:"varint,3,opt,name=zombie_all,json=zombieAll,proto3,oneof"`
-}
-
-func (*FsckRequest_ZombieTarget) isFsckRequest_ZombieCheck() {}
-
-func (*FsckRequest_ZombieAll) isFsckRequest_ZombieCheck() {}
-
-type FsckResponse struct {
+type ModifyFileRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Fix   string `protobuf:"bytes,1,opt,name=fix,proto3" json:"fix,omitempty"`
-	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// Types that are assignable to Body:
+	//
+	//	*ModifyFileRequest_SetCommit
+	//	*ModifyFileRequest_AddFile
+	//	*ModifyFileRequest_DeleteFile
+	//	*ModifyFileRequest_CopyFile
+	Body isModifyFileRequest_Body `protobuf_oneof:"body"`
 }
 
-func (x *FsckResponse) Reset() {
-	*x = FsckResponse{}
+func (x *ModifyFileRequest) Reset() {
+	*x = ModifyFileRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[58]
+		mi := &file_pfs_pfs_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FsckResponse) String() string {
+func (x *ModifyFileRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FsckResponse) ProtoMessage() {}
+func (*ModifyFileRequest) ProtoMessage() {}
 
-func (x *FsckResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[58]
+func (x *ModifyFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4090,97 +4488,106 @@ func (x *FsckResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FsckResponse.ProtoReflect.Descriptor instead.
-func (*FsckResponse) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{58}
+// Deprecated: Use ModifyFileRequest.ProtoReflect.Descriptor instead.
+func (*ModifyFileRequest) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *FsckResponse) GetFix() string {
-	if x != nil {
-		return x.Fix
+func (m *ModifyFileRequest) GetBody() isModifyFileRequest_Body {
+	if m != nil {
+		return m.Body
 	}
-	return ""
+	return nil
 }
 
-func (x *FsckResponse) GetError() string {
-	if x != nil {
-		return x.Error
+func (x *ModifyFileRequest) GetSetCommit() *Commit {
+	if x, ok := x.GetBody().(*ModifyFileRequest_SetCommit); ok {
+		return x.SetCommit
 	}
-	return ""
+	return nil
 }
 
-type CreateFileSetResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *ModifyFileRequest) GetAddFile() *AddFile {
+	if x, ok := x.GetBody().(*ModifyFileRequest_AddFile); ok {
+		return x.AddFile
+	}
+	return nil
+}
 
-	FileSetId string `protobuf:"bytes,1,opt,name=file_set_id,json=fileSetId,proto3" json:"file_set_id,omitempty"`
+func (x *ModifyFileRequest) GetDeleteFile() *DeleteFile {
+	if x, ok := x.GetBody().(*ModifyFileRequest_DeleteFile); ok {
+		return x.DeleteFile
+	}
+	return nil
 }
 
-func (x *CreateFileSetResponse) Reset() {
-	*x = CreateFileSetResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[59]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ModifyFileRequest) GetCopyFile() *CopyFile {
+	if x, ok := x.GetBody().(*ModifyFileRequest_CopyFile); ok {
+		return x.CopyFile
 	}
+	return nil
 }
 
-func (x *CreateFileSetResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+type isModifyFileRequest_Body interface {
+	isModifyFileRequest_Body()
 }
 
-func (*CreateFileSetResponse) ProtoMessage() {}
+type ModifyFileRequest_SetCommit struct {
+	SetCommit *Commit `protobuf:"bytes,1,opt,name=set_commit,json=setCommit,proto3,oneof"`
+}
 
-func (x *CreateFileSetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[59]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+type ModifyFileRequest_AddFile struct {
+	AddFile *AddFile `protobuf:"bytes,2,opt,name=add_file,json=addFile,proto3,oneof"`
 }
 
-// Deprecated: Use CreateFileSetResponse.ProtoReflect.Descriptor instead.
-func (*CreateFileSetResponse) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{59}
+type ModifyFileRequest_DeleteFile struct {
+	DeleteFile *DeleteFile `protobuf:"bytes,3,opt,name=delete_file,json=deleteFile,proto3,oneof"`
 }
 
-func (x *CreateFileSetResponse) GetFileSetId() string {
-	if x != nil {
-		return x.FileSetId
-	}
-	return ""
+type ModifyFileRequest_CopyFile struct {
+	CopyFile *CopyFile `protobuf:"bytes,4,opt,name=copy_file,json=copyFile,proto3,oneof"`
 }
 
-type GetFileSetRequest struct {
+func (*ModifyFileRequest_SetCommit) isModifyFileRequest_Body() {}
+
+func (*ModifyFileRequest_AddFile) isModifyFileRequest_Body() {}
+
+func (*ModifyFileRequest_DeleteFile) isModifyFileRequest_Body() {}
+
+func (*ModifyFileRequest_CopyFile) isModifyFileRequest_Body() {}
+
+type GetFileRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Commit *Commit `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"`
+	File *File  `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	URL  string `protobuf:"bytes,2,opt,name=URL,proto3" json:"URL,omitempty"`
+	// The number of bytes to skip at the start of the file.  Only supported by GetFile.
+	Offset    int64      `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	PathRange *PathRange `protobuf:"bytes,4,opt,name=path_range,json=pathRange,proto3" json:"path_range,omitempty"`
+	// The maximum number of bytes to return after offset; 0 returns the rest of the file.  Only
+	// the chunks covering the requested range are read.  Only supported by GetFile.
+	SizeBytes int64 `protobuf:"varint,5,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
 }
 
-func (x *GetFileSetRequest) Reset() {
-	*x = GetFileSetRequest{}
+func (x *GetFileRequest) Reset() {
+	*x = GetFileRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[60]
+		mi := &file_pfs_pfs_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetFileSetRequest) String() string {
+func (x *GetFileRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFileSetRequest) ProtoMessage() {}
+func (*GetFileRequest) ProtoMessage() {}
 
-func (x *GetFileSetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[60]
+func (x *GetFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4191,44 +4598,71 @@ func (x *GetFileSetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFileSetRequest.ProtoReflect.Descriptor instead.
-func (*GetFileSetRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{60}
+// Deprecated: Use GetFileRequest.ProtoReflect.Descriptor instead.
+func (*GetFileRequest) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *GetFileSetRequest) GetCommit() *Commit {
+func (x *GetFileRequest) GetFile() *File {
 	if x != nil {
-		return x.Commit
+		return x.File
 	}
 	return nil
 }
 
-type AddFileSetRequest struct {
+func (x *GetFileRequest) GetURL() string {
+	if x != nil {
+		return x.URL
+	}
+	return ""
+}
+
+func (x *GetFileRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetFileRequest) GetPathRange() *PathRange {
+	if x != nil {
+		return x.PathRange
+	}
+	return nil
+}
+
+func (x *GetFileRequest) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+type InspectFileRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Commit    *Commit `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"`
-	FileSetId string  `protobuf:"bytes,2,opt,name=file_set_id,json=fileSetId,proto3" json:"file_set_id,omitempty"`
+	File *File `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
 }
 
-func (x *AddFileSetRequest) Reset() {
-	*x = AddFileSetRequest{}
+func (x *InspectFileRequest) Reset() {
+	*x = InspectFileRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[61]
+		mi := &file_pfs_pfs_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddFileSetRequest) String() string {
+func (x *InspectFileRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddFileSetRequest) ProtoMessage() {}
+func (*InspectFileRequest) ProtoMessage() {}
 
-func (x *AddFileSetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[61]
+func (x *InspectFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4239,51 +4673,55 @@ func (x *AddFileSetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddFileSetRequest.ProtoReflect.Descriptor instead.
-func (*AddFileSetRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{61}
+// Deprecated: Use InspectFileRequest.ProtoReflect.Descriptor instead.
+func (*InspectFileRequest) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *AddFileSetRequest) GetCommit() *Commit {
+func (x *InspectFileRequest) GetFile() *File {
 	if x != nil {
-		return x.Commit
+		return x.File
 	}
 	return nil
 }
 
-func (x *AddFileSetRequest) GetFileSetId() string {
-	if x != nil {
-		return x.FileSetId
-	}
-	return ""
-}
-
-type RenewFileSetRequest struct {
+type ListFileRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FileSetId  string `protobuf:"bytes,1,opt,name=file_set_id,json=fileSetId,proto3" json:"file_set_id,omitempty"`
-	TtlSeconds int64  `protobuf:"varint,2,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+	// File is the parent directory of the files we want to list. This sets the
+	// repo, the commit/branch, and path prefix of files we're interested in
+	// If the "path" field is omitted, a list of files at the top level of the repo
+	// is returned
+	File *File `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	// Marker for pagination. If set, the files that come after the marker in
+	// lexicographical order will be returned. If reverse is also set, the files
+	// that come before the marker in lexicographical order will be returned.
+	PaginationMarker *File `protobuf:"bytes,3,opt,name=paginationMarker,proto3" json:"paginationMarker,omitempty"`
+	// Number of files to return
+	Number int64 `protobuf:"varint,4,opt,name=number,proto3" json:"number,omitempty"`
+	// If true, return files in reverse order
+	Reverse bool `protobuf:"varint,5,opt,name=reverse,proto3" json:"reverse,omitempty"`
 }
 
-func (x *RenewFileSetRequest) Reset() {
-	*x = RenewFileSetRequest{}
+func (x *ListFileRequest) Reset() {
+	*x = ListFileRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[62]
+		mi := &file_pfs_pfs_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RenewFileSetRequest) String() string {
+func (x *ListFileRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RenewFileSetRequest) ProtoMessage() {}
+func (*ListFileRequest) ProtoMessage() {}
 
-func (x *RenewFileSetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[62]
+func (x *ListFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4294,52 +4732,72 @@ func (x *RenewFileSetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RenewFileSetRequest.ProtoReflect.Descriptor instead.
-func (*RenewFileSetRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{62}
+// Deprecated: Use ListFileRequest.ProtoReflect.Descriptor instead.
+func (*ListFileRequest) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *RenewFileSetRequest) GetFileSetId() string {
+func (x *ListFileRequest) GetFile() *File {
 	if x != nil {
-		return x.FileSetId
+		return x.File
 	}
-	return ""
+	return nil
 }
 
-func (x *RenewFileSetRequest) GetTtlSeconds() int64 {
+func (x *ListFileRequest) GetPaginationMarker() *File {
 	if x != nil {
-		return x.TtlSeconds
+		return x.PaginationMarker
+	}
+	return nil
+}
+
+func (x *ListFileRequest) GetNumber() int64 {
+	if x != nil {
+		return x.Number
 	}
 	return 0
 }
 
-type ComposeFileSetRequest struct {
+func (x *ListFileRequest) GetReverse() bool {
+	if x != nil {
+		return x.Reverse
+	}
+	return false
+}
+
+type WalkFileRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FileSetIds []string `protobuf:"bytes,1,rep,name=file_set_ids,json=fileSetIds,proto3" json:"file_set_ids,omitempty"`
-	TtlSeconds int64    `protobuf:"varint,2,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
-	Compact    bool     `protobuf:"varint,3,opt,name=compact,proto3" json:"compact,omitempty"`
+	File *File `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	// Marker for pagination. If set, the files that come after the marker in
+	// lexicographical order will be returned. If reverse is also set, the files
+	// that come before the marker in lexicographical order will be returned.
+	PaginationMarker *File `protobuf:"bytes,2,opt,name=paginationMarker,proto3" json:"paginationMarker,omitempty"`
+	// Number of files to return
+	Number int64 `protobuf:"varint,3,opt,name=number,proto3" json:"number,omitempty"`
+	// If true, return files in reverse order
+	Reverse bool `protobuf:"varint,4,opt,name=reverse,proto3" json:"reverse,omitempty"`
 }
 
-func (x *ComposeFileSetRequest) Reset() {
-	*x = ComposeFileSetRequest{}
+func (x *WalkFileRequest) Reset() {
+	*x = WalkFileRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[63]
+		mi := &file_pfs_pfs_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ComposeFileSetRequest) String() string {
+func (x *WalkFileRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ComposeFileSetRequest) ProtoMessage() {}
+func (*WalkFileRequest) ProtoMessage() {}
 
-func (x *ComposeFileSetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[63]
+func (x *WalkFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4350,57 +4808,66 @@ func (x *ComposeFileSetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ComposeFileSetRequest.ProtoReflect.Descriptor instead.
-func (*ComposeFileSetRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{63}
+// Deprecated: Use WalkFileRequest.ProtoReflect.Descriptor instead.
+func (*WalkFileRequest) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *ComposeFileSetRequest) GetFileSetIds() []string {
+func (x *WalkFileRequest) GetFile() *File {
 	if x != nil {
-		return x.FileSetIds
+		return x.File
 	}
 	return nil
 }
 
-func (x *ComposeFileSetRequest) GetTtlSeconds() int64 {
+func (x *WalkFileRequest) GetPaginationMarker() *File {
 	if x != nil {
-		return x.TtlSeconds
+		return x.PaginationMarker
+	}
+	return nil
+}
+
+func (x *WalkFileRequest) GetNumber() int64 {
+	if x != nil {
+		return x.Number
 	}
 	return 0
 }
 
-func (x *ComposeFileSetRequest) GetCompact() bool {
+func (x *WalkFileRequest) GetReverse() bool {
 	if x != nil {
-		return x.Compact
+		return x.Reverse
 	}
 	return false
 }
 
-type ShardFileSetRequest struct {
+type GlobFileRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FileSetId string `protobuf:"bytes,1,opt,name=file_set_id,json=fileSetId,proto3" json:"file_set_id,omitempty"`
+	Commit    *Commit    `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"`
+	Pattern   string     `protobuf:"bytes,2,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	PathRange *PathRange `protobuf:"bytes,3,opt,name=path_range,json=pathRange,proto3" json:"path_range,omitempty"`
 }
 
-func (x *ShardFileSetRequest) Reset() {
-	*x = ShardFileSetRequest{}
+func (x *GlobFileRequest) Reset() {
+	*x = GlobFileRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[64]
+		mi := &file_pfs_pfs_proto_msgTypes[69]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ShardFileSetRequest) String() string {
+func (x *GlobFileRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ShardFileSetRequest) ProtoMessage() {}
+func (*GlobFileRequest) ProtoMessage() {}
 
-func (x *ShardFileSetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[64]
+func (x *GlobFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[69]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4411,44 +4878,61 @@ func (x *ShardFileSetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ShardFileSetRequest.ProtoReflect.Descriptor instead.
-func (*ShardFileSetRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{64}
+// Deprecated: Use GlobFileRequest.ProtoReflect.Descriptor instead.
+func (*GlobFileRequest) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *ShardFileSetRequest) GetFileSetId() string {
+func (x *GlobFileRequest) GetCommit() *Commit {
 	if x != nil {
-		return x.FileSetId
+		return x.Commit
+	}
+	return nil
+}
+
+func (x *GlobFileRequest) GetPattern() string {
+	if x != nil {
+		return x.Pattern
 	}
 	return ""
 }
 
-type PathRange struct {
+func (x *GlobFileRequest) GetPathRange() *PathRange {
+	if x != nil {
+		return x.PathRange
+	}
+	return nil
+}
+
+type DiffFileRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Lower string `protobuf:"bytes,1,opt,name=lower,proto3" json:"lower,omitempty"`
-	Upper string `protobuf:"bytes,2,opt,name=upper,proto3" json:"upper,omitempty"`
+	NewFile *File `protobuf:"bytes,1,opt,name=new_file,json=newFile,proto3" json:"new_file,omitempty"`
+	// OldFile may be left nil in which case the same path in the parent of
+	// NewFile's commit will be used.
+	OldFile *File `protobuf:"bytes,2,opt,name=old_file,json=oldFile,proto3" json:"old_file,omitempty"`
+	Shallow bool  `protobuf:"varint,3,opt,name=shallow,proto3" json:"shallow,omitempty"`
 }
 
-func (x *PathRange) Reset() {
-	*x = PathRange{}
+func (x *DiffFileRequest) Reset() {
+	*x = DiffFileRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[65]
+		mi := &file_pfs_pfs_proto_msgTypes[70]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PathRange) String() string {
+func (x *DiffFileRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PathRange) ProtoMessage() {}
+func (*DiffFileRequest) ProtoMessage() {}
 
-func (x *PathRange) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[65]
+func (x *DiffFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4459,50 +4943,58 @@ func (x *PathRange) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PathRange.ProtoReflect.Descriptor instead.
-func (*PathRange) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{65}
+// Deprecated: Use DiffFileRequest.ProtoReflect.Descriptor instead.
+func (*DiffFileRequest) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *PathRange) GetLower() string {
+func (x *DiffFileRequest) GetNewFile() *File {
 	if x != nil {
-		return x.Lower
+		return x.NewFile
 	}
-	return ""
+	return nil
 }
 
-func (x *PathRange) GetUpper() string {
+func (x *DiffFileRequest) GetOldFile() *File {
 	if x != nil {
-		return x.Upper
+		return x.OldFile
 	}
-	return ""
+	return nil
 }
 
-type ShardFileSetResponse struct {
+func (x *DiffFileRequest) GetShallow() bool {
+	if x != nil {
+		return x.Shallow
+	}
+	return false
+}
+
+type DiffFileResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Shards []*PathRange `protobuf:"bytes,1,rep,name=shards,proto3" json:"shards,omitempty"`
+	NewFile *FileInfo `protobuf:"bytes,1,opt,name=new_file,json=newFile,proto3" json:"new_file,omitempty"`
+	OldFile *FileInfo `protobuf:"bytes,2,opt,name=old_file,json=oldFile,proto3" json:"old_file,omitempty"`
 }
 
-func (x *ShardFileSetResponse) Reset() {
-	*x = ShardFileSetResponse{}
+func (x *DiffFileResponse) Reset() {
+	*x = DiffFileResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[66]
+		mi := &file_pfs_pfs_proto_msgTypes[71]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ShardFileSetResponse) String() string {
+func (x *DiffFileResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ShardFileSetResponse) ProtoMessage() {}
+func (*DiffFileResponse) ProtoMessage() {}
 
-func (x *ShardFileSetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[66]
+func (x *DiffFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[71]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4513,45 +5005,55 @@ func (x *ShardFileSetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ShardFileSetResponse.ProtoReflect.Descriptor instead.
-func (*ShardFileSetResponse) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{66}
+// Deprecated: Use DiffFileResponse.ProtoReflect.Descriptor instead.
+func (*DiffFileResponse) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *ShardFileSetResponse) GetShards() []*PathRange {
+func (x *DiffFileResponse) GetNewFile() *FileInfo {
 	if x != nil {
-		return x.Shards
+		return x.NewFile
 	}
 	return nil
 }
 
-type CheckStorageRequest struct {
+func (x *DiffFileResponse) GetOldFile() *FileInfo {
+	if x != nil {
+		return x.OldFile
+	}
+	return nil
+}
+
+type FsckRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ReadChunkData bool   `protobuf:"varint,1,opt,name=read_chunk_data,json=readChunkData,proto3" json:"read_chunk_data,omitempty"`
-	ChunkBegin    []byte `protobuf:"bytes,2,opt,name=chunk_begin,json=chunkBegin,proto3" json:"chunk_begin,omitempty"`
-	ChunkEnd      []byte `protobuf:"bytes,3,opt,name=chunk_end,json=chunkEnd,proto3" json:"chunk_end,omitempty"`
+	Fix bool `protobuf:"varint,1,opt,name=fix,proto3" json:"fix,omitempty"`
+	// Types that are assignable to ZombieCheck:
+	//
+	//	*FsckRequest_ZombieTarget
+	//	*FsckRequest_ZombieAll
+	ZombieCheck isFsckRequest_ZombieCheck `protobuf_oneof:"zombie_check"`
 }
 
-func (x *CheckStorageRequest) Reset() {
-	*x = CheckStorageRequest{}
+func (x *FsckRequest) Reset() {
+	*x = FsckRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[67]
+		mi := &file_pfs_pfs_proto_msgTypes[72]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CheckStorageRequest) String() string {
+func (x *FsckRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckStorageRequest) ProtoMessage() {}
+func (*FsckRequest) ProtoMessage() {}
 
-func (x *CheckStorageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[67]
+func (x *FsckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[72]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4562,57 +5064,82 @@ func (x *CheckStorageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckStorageRequest.ProtoReflect.Descriptor instead.
-func (*CheckStorageRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{67}
+// Deprecated: Use FsckRequest.ProtoReflect.Descriptor instead.
+func (*FsckRequest) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *CheckStorageRequest) GetReadChunkData() bool {
+func (x *FsckRequest) GetFix() bool {
 	if x != nil {
-		return x.ReadChunkData
+		return x.Fix
 	}
 	return false
 }
 
-func (x *CheckStorageRequest) GetChunkBegin() []byte {
-	if x != nil {
-		return x.ChunkBegin
+func (m *FsckRequest) GetZombieCheck() isFsckRequest_ZombieCheck {
+	if m != nil {
+		return m.ZombieCheck
 	}
 	return nil
 }
 
-func (x *CheckStorageRequest) GetChunkEnd() []byte {
-	if x != nil {
-		return x.ChunkEnd
+func (x *FsckRequest) GetZombieTarget() *Commit {
+	if x, ok := x.GetZombieCheck().(*FsckRequest_ZombieTarget); ok {
+		return x.ZombieTarget
 	}
 	return nil
 }
 
-type CheckStorageResponse struct {
+func (x *FsckRequest) GetZombieAll() bool {
+	if x, ok := x.GetZombieCheck().(*FsckRequest_ZombieAll); ok {
+		return x.ZombieAll
+	}
+	return false
+}
+
+type isFsckRequest_ZombieCheck interface {
+	isFsckRequest_ZombieCheck()
+}
+
+type FsckRequest_ZombieTarget struct {
+	ZombieTarget *Commit `protobuf:"bytes,2,opt,name=zombie_target,json=zombieTarget,proto3,oneof"`
+}
+
+type FsckRequest_ZombieAll struct {
+	// run zombie data detection against all pipelines
+	ZombieAll bool `protobuf:"varint,3,opt,name=zombie_all,json=zombieAll,proto3,oneof"`
+}
+
+func (*FsckRequest_ZombieTarget) isFsckRequest_ZombieCheck() {}
+
+func (*FsckRequest_ZombieAll) isFsckRequest_ZombieCheck() {}
+
+type FsckResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ChunkObjectCount int64 `protobuf:"varint,1,opt,name=chunk_object_count,json=chunkObjectCount,proto3" json:"chunk_object_count,omitempty"`
+	Fix   string `protobuf:"bytes,1,opt,name=fix,proto3" json:"fix,omitempty"`
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *CheckStorageResponse) Reset() {
-	*x = CheckStorageResponse{}
+func (x *FsckResponse) Reset() {
+	*x = FsckResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[68]
+		mi := &file_pfs_pfs_proto_msgTypes[73]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CheckStorageResponse) String() string {
+func (x *FsckResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckStorageResponse) ProtoMessage() {}
+func (*FsckResponse) ProtoMessage() {}
 
-func (x *CheckStorageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[68]
+func (x *FsckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[73]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4623,46 +5150,50 @@ func (x *CheckStorageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckStorageResponse.ProtoReflect.Descriptor instead.
-func (*CheckStorageResponse) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{68}
+// Deprecated: Use FsckResponse.ProtoReflect.Descriptor instead.
+func (*FsckResponse) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *CheckStorageResponse) GetChunkObjectCount() int64 {
+func (x *FsckResponse) GetFix() string {
 	if x != nil {
-		return x.ChunkObjectCount
+		return x.Fix
 	}
-	return 0
+	return ""
 }
 
-type PutCacheRequest struct {
+func (x *FsckResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type CreateFileSetResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Key        string     `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value      *anypb.Any `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
-	FileSetIds []string   `protobuf:"bytes,3,rep,name=file_set_ids,json=fileSetIds,proto3" json:"file_set_ids,omitempty"`
-	Tag        string     `protobuf:"bytes,4,opt,name=tag,proto3" json:"tag,omitempty"`
+	FileSetId string `protobuf:"bytes,1,opt,name=file_set_id,json=fileSetId,proto3" json:"file_set_id,omitempty"`
 }
 
-func (x *PutCacheRequest) Reset() {
-	*x = PutCacheRequest{}
+func (x *CreateFileSetResponse) Reset() {
+	*x = CreateFileSetResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[69]
+		mi := &file_pfs_pfs_proto_msgTypes[74]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PutCacheRequest) String() string {
+func (x *CreateFileSetResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PutCacheRequest) ProtoMessage() {}
+func (*CreateFileSetResponse) ProtoMessage() {}
 
-func (x *PutCacheRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[69]
+func (x *CreateFileSetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[74]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4673,64 +5204,43 @@ func (x *PutCacheRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PutCacheRequest.ProtoReflect.Descriptor instead.
-func (*PutCacheRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{69}
-}
-
-func (x *PutCacheRequest) GetKey() string {
-	if x != nil {
-		return x.Key
-	}
-	return ""
-}
-
-func (x *PutCacheRequest) GetValue() *anypb.Any {
-	if x != nil {
-		return x.Value
-	}
-	return nil
-}
-
-func (x *PutCacheRequest) GetFileSetIds() []string {
-	if x != nil {
-		return x.FileSetIds
-	}
-	return nil
+// Deprecated: Use CreateFileSetResponse.ProtoReflect.Descriptor instead.
+func (*CreateFileSetResponse) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *PutCacheRequest) GetTag() string {
+func (x *CreateFileSetResponse) GetFileSetId() string {
 	if x != nil {
-		return x.Tag
+		return x.FileSetId
 	}
 	return ""
 }
 
-type GetCacheRequest struct {
+type GetFileSetRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Commit *Commit `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"`
 }
 
-func (x *GetCacheRequest) Reset() {
-	*x = GetCacheRequest{}
+func (x *GetFileSetRequest) Reset() {
+	*x = GetFileSetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[70]
+		mi := &file_pfs_pfs_proto_msgTypes[75]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetCacheRequest) String() string {
+func (x *GetFileSetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCacheRequest) ProtoMessage() {}
+func (*GetFileSetRequest) ProtoMessage() {}
 
-func (x *GetCacheRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[70]
+func (x *GetFileSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[75]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4741,43 +5251,44 @@ func (x *GetCacheRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCacheRequest.ProtoReflect.Descriptor instead.
-func (*GetCacheRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{70}
+// Deprecated: Use GetFileSetRequest.ProtoReflect.Descriptor instead.
+func (*GetFileSetRequest) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *GetCacheRequest) GetKey() string {
+func (x *GetFileSetRequest) GetCommit() *Commit {
 	if x != nil {
-		return x.Key
+		return x.Commit
 	}
-	return ""
+	return nil
 }
 
-type GetCacheResponse struct {
+type AddFileSetRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Value *anypb.Any `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Commit    *Commit `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"`
+	FileSetId string  `protobuf:"bytes,2,opt,name=file_set_id,json=fileSetId,proto3" json:"file_set_id,omitempty"`
 }
 
-func (x *GetCacheResponse) Reset() {
-	*x = GetCacheResponse{}
+func (x *AddFileSetRequest) Reset() {
+	*x = AddFileSetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[71]
+		mi := &file_pfs_pfs_proto_msgTypes[76]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetCacheResponse) String() string {
+func (x *AddFileSetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCacheResponse) ProtoMessage() {}
+func (*AddFileSetRequest) ProtoMessage() {}
 
-func (x *GetCacheResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[71]
+func (x *AddFileSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[76]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4788,43 +5299,51 @@ func (x *GetCacheResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCacheResponse.ProtoReflect.Descriptor instead.
-func (*GetCacheResponse) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{71}
+// Deprecated: Use AddFileSetRequest.ProtoReflect.Descriptor instead.
+func (*AddFileSetRequest) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *GetCacheResponse) GetValue() *anypb.Any {
+func (x *AddFileSetRequest) GetCommit() *Commit {
 	if x != nil {
-		return x.Value
+		return x.Commit
 	}
 	return nil
 }
 
-type ClearCacheRequest struct {
+func (x *AddFileSetRequest) GetFileSetId() string {
+	if x != nil {
+		return x.FileSetId
+	}
+	return ""
+}
+
+type RenewFileSetRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TagPrefix string `protobuf:"bytes,1,opt,name=tag_prefix,json=tagPrefix,proto3" json:"tag_prefix,omitempty"`
+	FileSetId  string `protobuf:"bytes,1,opt,name=file_set_id,json=fileSetId,proto3" json:"file_set_id,omitempty"`
+	TtlSeconds int64  `protobuf:"varint,2,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
 }
 
-func (x *ClearCacheRequest) Reset() {
-	*x = ClearCacheRequest{}
+func (x *RenewFileSetRequest) Reset() {
+	*x = RenewFileSetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[72]
+		mi := &file_pfs_pfs_proto_msgTypes[77]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ClearCacheRequest) String() string {
+func (x *RenewFileSetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClearCacheRequest) ProtoMessage() {}
+func (*RenewFileSetRequest) ProtoMessage() {}
 
-func (x *ClearCacheRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[72]
+func (x *RenewFileSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[77]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4835,41 +5354,52 @@ func (x *ClearCacheRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClearCacheRequest.ProtoReflect.Descriptor instead.
-func (*ClearCacheRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{72}
+// Deprecated: Use RenewFileSetRequest.ProtoReflect.Descriptor instead.
+func (*RenewFileSetRequest) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *ClearCacheRequest) GetTagPrefix() string {
+func (x *RenewFileSetRequest) GetFileSetId() string {
 	if x != nil {
-		return x.TagPrefix
+		return x.FileSetId
 	}
 	return ""
 }
 
-type ActivateAuthRequest struct {
+func (x *RenewFileSetRequest) GetTtlSeconds() int64 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+type ComposeFileSetRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	FileSetIds []string `protobuf:"bytes,1,rep,name=file_set_ids,json=fileSetIds,proto3" json:"file_set_ids,omitempty"`
+	TtlSeconds int64    `protobuf:"varint,2,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+	Compact    bool     `protobuf:"varint,3,opt,name=compact,proto3" json:"compact,omitempty"`
 }
 
-func (x *ActivateAuthRequest) Reset() {
-	*x = ActivateAuthRequest{}
+func (x *ComposeFileSetRequest) Reset() {
+	*x = ComposeFileSetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[73]
+		mi := &file_pfs_pfs_proto_msgTypes[78]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ActivateAuthRequest) String() string {
+func (x *ComposeFileSetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ActivateAuthRequest) ProtoMessage() {}
+func (*ComposeFileSetRequest) ProtoMessage() {}
 
-func (x *ActivateAuthRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[73]
+func (x *ComposeFileSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[78]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4880,34 +5410,57 @@ func (x *ActivateAuthRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ActivateAuthRequest.ProtoReflect.Descriptor instead.
-func (*ActivateAuthRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{73}
+// Deprecated: Use ComposeFileSetRequest.ProtoReflect.Descriptor instead.
+func (*ComposeFileSetRequest) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{78}
 }
 
-type ActivateAuthResponse struct {
+func (x *ComposeFileSetRequest) GetFileSetIds() []string {
+	if x != nil {
+		return x.FileSetIds
+	}
+	return nil
+}
+
+func (x *ComposeFileSetRequest) GetTtlSeconds() int64 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+func (x *ComposeFileSetRequest) GetCompact() bool {
+	if x != nil {
+		return x.Compact
+	}
+	return false
+}
+
+type ShardFileSetRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	FileSetId string `protobuf:"bytes,1,opt,name=file_set_id,json=fileSetId,proto3" json:"file_set_id,omitempty"`
 }
 
-func (x *ActivateAuthResponse) Reset() {
-	*x = ActivateAuthResponse{}
+func (x *ShardFileSetRequest) Reset() {
+	*x = ShardFileSetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[74]
+		mi := &file_pfs_pfs_proto_msgTypes[79]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ActivateAuthResponse) String() string {
+func (x *ShardFileSetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ActivateAuthResponse) ProtoMessage() {}
+func (*ShardFileSetRequest) ProtoMessage() {}
 
-func (x *ActivateAuthResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[74]
+func (x *ShardFileSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[79]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4918,36 +5471,44 @@ func (x *ActivateAuthResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ActivateAuthResponse.ProtoReflect.Descriptor instead.
-func (*ActivateAuthResponse) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{74}
+// Deprecated: Use ShardFileSetRequest.ProtoReflect.Descriptor instead.
+func (*ShardFileSetRequest) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{79}
 }
 
-type ObjectStorageEgress struct {
+func (x *ShardFileSetRequest) GetFileSetId() string {
+	if x != nil {
+		return x.FileSetId
+	}
+	return ""
+}
+
+type PathRange struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Lower string `protobuf:"bytes,1,opt,name=lower,proto3" json:"lower,omitempty"`
+	Upper string `protobuf:"bytes,2,opt,name=upper,proto3" json:"upper,omitempty"`
 }
 
-func (x *ObjectStorageEgress) Reset() {
-	*x = ObjectStorageEgress{}
+func (x *PathRange) Reset() {
+	*x = PathRange{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[75]
+		mi := &file_pfs_pfs_proto_msgTypes[80]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ObjectStorageEgress) String() string {
+func (x *PathRange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ObjectStorageEgress) ProtoMessage() {}
+func (*PathRange) ProtoMessage() {}
 
-func (x *ObjectStorageEgress) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[75]
+func (x *PathRange) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[80]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4958,45 +5519,50 @@ func (x *ObjectStorageEgress) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ObjectStorageEgress.ProtoReflect.Descriptor instead.
-func (*ObjectStorageEgress) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{75}
+// Deprecated: Use PathRange.ProtoReflect.Descriptor instead.
+func (*PathRange) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *ObjectStorageEgress) GetUrl() string {
+func (x *PathRange) GetLower() string {
 	if x != nil {
-		return x.Url
+		return x.Lower
 	}
 	return ""
 }
 
-type SQLDatabaseEgress struct {
+func (x *PathRange) GetUpper() string {
+	if x != nil {
+		return x.Upper
+	}
+	return ""
+}
+
+type ShardFileSetResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Url        string                        `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
-	FileFormat *SQLDatabaseEgress_FileFormat `protobuf:"bytes,2,opt,name=file_format,json=fileFormat,proto3" json:"file_format,omitempty"`
-	Secret     *SQLDatabaseEgress_Secret     `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
+	Shards []*PathRange `protobuf:"bytes,1,rep,name=shards,proto3" json:"shards,omitempty"`
 }
 
-func (x *SQLDatabaseEgress) Reset() {
-	*x = SQLDatabaseEgress{}
+func (x *ShardFileSetResponse) Reset() {
+	*x = ShardFileSetResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[76]
+		mi := &file_pfs_pfs_proto_msgTypes[81]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SQLDatabaseEgress) String() string {
+func (x *ShardFileSetResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SQLDatabaseEgress) ProtoMessage() {}
+func (*ShardFileSetResponse) ProtoMessage() {}
 
-func (x *SQLDatabaseEgress) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[76]
+func (x *ShardFileSetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[81]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5007,62 +5573,52 @@ func (x *SQLDatabaseEgress) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SQLDatabaseEgress.ProtoReflect.Descriptor instead.
-func (*SQLDatabaseEgress) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{76}
-}
-
-func (x *SQLDatabaseEgress) GetUrl() string {
-	if x != nil {
-		return x.Url
-	}
-	return ""
-}
-
-func (x *SQLDatabaseEgress) GetFileFormat() *SQLDatabaseEgress_FileFormat {
-	if x != nil {
-		return x.FileFormat
-	}
-	return nil
+// Deprecated: Use ShardFileSetResponse.ProtoReflect.Descriptor instead.
+func (*ShardFileSetResponse) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *SQLDatabaseEgress) GetSecret() *SQLDatabaseEgress_Secret {
+func (x *ShardFileSetResponse) GetShards() []*PathRange {
 	if x != nil {
-		return x.Secret
+		return x.Shards
 	}
 	return nil
 }
 
-type EgressRequest struct {
+type CheckStorageRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Commit *Commit `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"`
-	// Types that are assignable to Target:
-	//
-	//	*EgressRequest_ObjectStorage
-	//	*EgressRequest_SqlDatabase
-	Target isEgressRequest_Target `protobuf_oneof:"target"`
+	ReadChunkData bool   `protobuf:"varint,1,opt,name=read_chunk_data,json=readChunkData,proto3" json:"read_chunk_data,omitempty"`
+	ChunkBegin    []byte `protobuf:"bytes,2,opt,name=chunk_begin,json=chunkBegin,proto3" json:"chunk_begin,omitempty"`
+	ChunkEnd      []byte `protobuf:"bytes,3,opt,name=chunk_end,json=chunkEnd,proto3" json:"chunk_end,omitempty"`
+	// If true, also cross-check the chunk objects in object storage against the
+	// chunk entries and the tracker, and report what garbage collection will
+	// not clean up.  The chunk range is ignored by this check.
+	CheckConsistency bool `protobuf:"varint,4,opt,name=check_consistency,json=checkConsistency,proto3" json:"check_consistency,omitempty"`
+	// The maximum number of example object keys to return for each problem
+	// found by the consistency check.  If zero, all of them are returned.
+	ConsistencyLimit int64 `protobuf:"varint,5,opt,name=consistency_limit,json=consistencyLimit,proto3" json:"consistency_limit,omitempty"`
 }
 
-func (x *EgressRequest) Reset() {
-	*x = EgressRequest{}
+func (x *CheckStorageRequest) Reset() {
+	*x = CheckStorageRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[77]
+		mi := &file_pfs_pfs_proto_msgTypes[82]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EgressRequest) String() string {
+func (x *CheckStorageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EgressRequest) ProtoMessage() {}
+func (*CheckStorageRequest) ProtoMessage() {}
 
-func (x *EgressRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[77]
+func (x *CheckStorageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[82]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5073,84 +5629,84 @@ func (x *EgressRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EgressRequest.ProtoReflect.Descriptor instead.
-func (*EgressRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{77}
+// Deprecated: Use CheckStorageRequest.ProtoReflect.Descriptor instead.
+func (*CheckStorageRequest) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *EgressRequest) GetCommit() *Commit {
+func (x *CheckStorageRequest) GetReadChunkData() bool {
 	if x != nil {
-		return x.Commit
+		return x.ReadChunkData
 	}
-	return nil
+	return false
 }
 
-func (m *EgressRequest) GetTarget() isEgressRequest_Target {
-	if m != nil {
-		return m.Target
+func (x *CheckStorageRequest) GetChunkBegin() []byte {
+	if x != nil {
+		return x.ChunkBegin
 	}
 	return nil
 }
 
-func (x *EgressRequest) GetObjectStorage() *ObjectStorageEgress {
-	if x, ok := x.GetTarget().(*EgressRequest_ObjectStorage); ok {
-		return x.ObjectStorage
+func (x *CheckStorageRequest) GetChunkEnd() []byte {
+	if x != nil {
+		return x.ChunkEnd
 	}
 	return nil
 }
 
-func (x *EgressRequest) GetSqlDatabase() *SQLDatabaseEgress {
-	if x, ok := x.GetTarget().(*EgressRequest_SqlDatabase); ok {
-		return x.SqlDatabase
+func (x *CheckStorageRequest) GetCheckConsistency() bool {
+	if x != nil {
+		return x.CheckConsistency
 	}
-	return nil
-}
-
-type isEgressRequest_Target interface {
-	isEgressRequest_Target()
-}
-
-type EgressRequest_ObjectStorage struct {
-	ObjectStorage *ObjectStorageEgress `protobuf:"bytes,2,opt,name=object_storage,json=objectStorage,proto3,oneof"`
+	return false
 }
 
-type EgressRequest_SqlDatabase struct {
-	SqlDatabase *SQLDatabaseEgress `protobuf:"bytes,3,opt,name=sql_database,json=sqlDatabase,proto3,oneof"`
+func (x *CheckStorageRequest) GetConsistencyLimit() int64 {
+	if x != nil {
+		return x.ConsistencyLimit
+	}
+	return 0
 }
 
-func (*EgressRequest_ObjectStorage) isEgressRequest_Target() {}
-
-func (*EgressRequest_SqlDatabase) isEgressRequest_Target() {}
-
-type EgressResponse struct {
+// StorageConsistency is the result of the storage consistency check.
+type StorageConsistency struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Result:
-	//
-	//	*EgressResponse_ObjectStorage
-	//	*EgressResponse_SqlDatabase
-	Result isEgressResponse_Result `protobuf_oneof:"result"`
-}
-
-func (x *EgressResponse) Reset() {
-	*x = EgressResponse{}
+	// Live chunk entries whose object is missing from object storage.
+	MissingObjectCount int64    `protobuf:"varint,1,opt,name=missing_object_count,json=missingObjectCount,proto3" json:"missing_object_count,omitempty"`
+	MissingObjects     []string `protobuf:"bytes,2,rep,name=missing_objects,json=missingObjects,proto3" json:"missing_objects,omitempty"`
+	// Live chunk entries with no tracker object, which will never be garbage
+	// collected.
+	UntrackedEntryCount int64    `protobuf:"varint,3,opt,name=untracked_entry_count,json=untrackedEntryCount,proto3" json:"untracked_entry_count,omitempty"`
+	UntrackedEntries    []string `protobuf:"bytes,4,rep,name=untracked_entries,json=untrackedEntries,proto3" json:"untracked_entries,omitempty"`
+	// Objects with no chunk entry, which will never be garbage collected.
+	OrphanedObjectCount int64    `protobuf:"varint,5,opt,name=orphaned_object_count,json=orphanedObjectCount,proto3" json:"orphaned_object_count,omitempty"`
+	OrphanedObjects     []string `protobuf:"bytes,6,rep,name=orphaned_objects,json=orphanedObjects,proto3" json:"orphaned_objects,omitempty"`
+	// Objects of deleted chunks that are waiting to be garbage collected.
+	GarbageObjectCount int64 `protobuf:"varint,7,opt,name=garbage_object_count,json=garbageObjectCount,proto3" json:"garbage_object_count,omitempty"`
+	GarbageBytes       int64 `protobuf:"varint,8,opt,name=garbage_bytes,json=garbageBytes,proto3" json:"garbage_bytes,omitempty"`
+}
+
+func (x *StorageConsistency) Reset() {
+	*x = StorageConsistency{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[78]
+		mi := &file_pfs_pfs_proto_msgTypes[83]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EgressResponse) String() string {
+func (x *StorageConsistency) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EgressResponse) ProtoMessage() {}
+func (*StorageConsistency) ProtoMessage() {}
 
-func (x *EgressResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[78]
+func (x *StorageConsistency) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[83]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5161,74 +5717,94 @@ func (x *EgressResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EgressResponse.ProtoReflect.Descriptor instead.
-func (*EgressResponse) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{78}
+// Deprecated: Use StorageConsistency.ProtoReflect.Descriptor instead.
+func (*StorageConsistency) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{83}
 }
 
-func (m *EgressResponse) GetResult() isEgressResponse_Result {
-	if m != nil {
-		return m.Result
+func (x *StorageConsistency) GetMissingObjectCount() int64 {
+	if x != nil {
+		return x.MissingObjectCount
 	}
-	return nil
+	return 0
 }
 
-func (x *EgressResponse) GetObjectStorage() *EgressResponse_ObjectStorageResult {
-	if x, ok := x.GetResult().(*EgressResponse_ObjectStorage); ok {
-		return x.ObjectStorage
+func (x *StorageConsistency) GetMissingObjects() []string {
+	if x != nil {
+		return x.MissingObjects
 	}
 	return nil
 }
 
-func (x *EgressResponse) GetSqlDatabase() *EgressResponse_SQLDatabaseResult {
-	if x, ok := x.GetResult().(*EgressResponse_SqlDatabase); ok {
-		return x.SqlDatabase
+func (x *StorageConsistency) GetUntrackedEntryCount() int64 {
+	if x != nil {
+		return x.UntrackedEntryCount
 	}
-	return nil
+	return 0
 }
 
-type isEgressResponse_Result interface {
-	isEgressResponse_Result()
+func (x *StorageConsistency) GetUntrackedEntries() []string {
+	if x != nil {
+		return x.UntrackedEntries
+	}
+	return nil
 }
 
-type EgressResponse_ObjectStorage struct {
-	ObjectStorage *EgressResponse_ObjectStorageResult `protobuf:"bytes,1,opt,name=object_storage,json=objectStorage,proto3,oneof"`
+func (x *StorageConsistency) GetOrphanedObjectCount() int64 {
+	if x != nil {
+		return x.OrphanedObjectCount
+	}
+	return 0
 }
 
-type EgressResponse_SqlDatabase struct {
-	SqlDatabase *EgressResponse_SQLDatabaseResult `protobuf:"bytes,2,opt,name=sql_database,json=sqlDatabase,proto3,oneof"`
+func (x *StorageConsistency) GetOrphanedObjects() []string {
+	if x != nil {
+		return x.OrphanedObjects
+	}
+	return nil
 }
 
-func (*EgressResponse_ObjectStorage) isEgressResponse_Result() {}
+func (x *StorageConsistency) GetGarbageObjectCount() int64 {
+	if x != nil {
+		return x.GarbageObjectCount
+	}
+	return 0
+}
 
-func (*EgressResponse_SqlDatabase) isEgressResponse_Result() {}
+func (x *StorageConsistency) GetGarbageBytes() int64 {
+	if x != nil {
+		return x.GarbageBytes
+	}
+	return 0
+}
 
-// Details are only provided when explicitly requested
-type RepoInfo_Details struct {
+type CheckStorageResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SizeBytes int64 `protobuf:"varint,1,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	ChunkObjectCount int64 `protobuf:"varint,1,opt,name=chunk_object_count,json=chunkObjectCount,proto3" json:"chunk_object_count,omitempty"`
+	// Set if check_consistency was requested.
+	Consistency *StorageConsistency `protobuf:"bytes,2,opt,name=consistency,proto3" json:"consistency,omitempty"`
 }
 
-func (x *RepoInfo_Details) Reset() {
-	*x = RepoInfo_Details{}
+func (x *CheckStorageResponse) Reset() {
+	*x = CheckStorageResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[79]
+		mi := &file_pfs_pfs_proto_msgTypes[84]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RepoInfo_Details) String() string {
+func (x *CheckStorageResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RepoInfo_Details) ProtoMessage() {}
+func (*CheckStorageResponse) ProtoMessage() {}
 
-func (x *RepoInfo_Details) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[79]
+func (x *CheckStorageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[84]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5239,46 +5815,52 @@ func (x *RepoInfo_Details) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RepoInfo_Details.ProtoReflect.Descriptor instead.
-func (*RepoInfo_Details) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{3, 0}
+// Deprecated: Use CheckStorageResponse.ProtoReflect.Descriptor instead.
+func (*CheckStorageResponse) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *RepoInfo_Details) GetSizeBytes() int64 {
+func (x *CheckStorageResponse) GetChunkObjectCount() int64 {
 	if x != nil {
-		return x.SizeBytes
+		return x.ChunkObjectCount
 	}
 	return 0
 }
 
-// Details are only provided when explicitly requested
-type CommitInfo_Details struct {
+func (x *CheckStorageResponse) GetConsistency() *StorageConsistency {
+	if x != nil {
+		return x.Consistency
+	}
+	return nil
+}
+
+type InspectStorageRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SizeBytes      int64                `protobuf:"varint,1,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
-	CompactingTime *durationpb.Duration `protobuf:"bytes,2,opt,name=compacting_time,json=compactingTime,proto3" json:"compacting_time,omitempty"`
-	ValidatingTime *durationpb.Duration `protobuf:"bytes,3,opt,name=validating_time,json=validatingTime,proto3" json:"validating_time,omitempty"`
+	// The maximum number of repos and pairs of repos to return.  If zero, all
+	// of them are returned.
+	Limit int64 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (x *CommitInfo_Details) Reset() {
-	*x = CommitInfo_Details{}
+func (x *InspectStorageRequest) Reset() {
+	*x = InspectStorageRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[80]
+		mi := &file_pfs_pfs_proto_msgTypes[85]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CommitInfo_Details) String() string {
+func (x *InspectStorageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CommitInfo_Details) ProtoMessage() {}
+func (*InspectStorageRequest) ProtoMessage() {}
 
-func (x *CommitInfo_Details) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[80]
+func (x *InspectStorageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[85]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5289,59 +5871,55 @@ func (x *CommitInfo_Details) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CommitInfo_Details.ProtoReflect.Descriptor instead.
-func (*CommitInfo_Details) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{9, 0}
+// Deprecated: Use InspectStorageRequest.ProtoReflect.Descriptor instead.
+func (*InspectStorageRequest) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *CommitInfo_Details) GetSizeBytes() int64 {
+func (x *InspectStorageRequest) GetLimit() int64 {
 	if x != nil {
-		return x.SizeBytes
+		return x.Limit
 	}
 	return 0
 }
 
-func (x *CommitInfo_Details) GetCompactingTime() *durationpb.Duration {
-	if x != nil {
-		return x.CompactingTime
-	}
-	return nil
-}
-
-func (x *CommitInfo_Details) GetValidatingTime() *durationpb.Duration {
-	if x != nil {
-		return x.ValidatingTime
-	}
-	return nil
-}
-
-type AddFile_URLSource struct {
+// StorageStats describes the storage used by some data.  Sizes are measured
+// in the bytes of the chunks the data is stored in, after compression and
+// encryption.
+type StorageStats struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	URL         string `protobuf:"bytes,1,opt,name=URL,proto3" json:"URL,omitempty"`
-	Recursive   bool   `protobuf:"varint,2,opt,name=recursive,proto3" json:"recursive,omitempty"`
-	Concurrency uint32 `protobuf:"varint,3,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
+	// The bytes the data would use if no chunks were shared: the sum, over each
+	// finished commit, of the size of the chunks that the commit references.
+	LogicalBytes int64 `protobuf:"varint,1,opt,name=logical_bytes,json=logicalBytes,proto3" json:"logical_bytes,omitempty"`
+	// The bytes of the distinct chunks that the data references.
+	PhysicalBytes int64 `protobuf:"varint,2,opt,name=physical_bytes,json=physicalBytes,proto3" json:"physical_bytes,omitempty"`
+	// The bytes of the chunks that are not referenced by any other repo or
+	// project.
+	UniqueBytes int64 `protobuf:"varint,3,opt,name=unique_bytes,json=uniqueBytes,proto3" json:"unique_bytes,omitempty"`
+	// The number of distinct chunks that the data references.
+	ChunkCount int64 `protobuf:"varint,4,opt,name=chunk_count,json=chunkCount,proto3" json:"chunk_count,omitempty"`
 }
 
-func (x *AddFile_URLSource) Reset() {
-	*x = AddFile_URLSource{}
+func (x *StorageStats) Reset() {
+	*x = StorageStats{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[81]
+		mi := &file_pfs_pfs_proto_msgTypes[86]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddFile_URLSource) String() string {
+func (x *StorageStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddFile_URLSource) ProtoMessage() {}
+func (*StorageStats) ProtoMessage() {}
 
-func (x *AddFile_URLSource) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[81]
+func (x *StorageStats) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[86]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5352,58 +5930,65 @@ func (x *AddFile_URLSource) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddFile_URLSource.ProtoReflect.Descriptor instead.
-func (*AddFile_URLSource) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{46, 0}
+// Deprecated: Use StorageStats.ProtoReflect.Descriptor instead.
+func (*StorageStats) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{86}
 }
 
-func (x *AddFile_URLSource) GetURL() string {
+func (x *StorageStats) GetLogicalBytes() int64 {
 	if x != nil {
-		return x.URL
+		return x.LogicalBytes
 	}
-	return ""
+	return 0
 }
 
-func (x *AddFile_URLSource) GetRecursive() bool {
+func (x *StorageStats) GetPhysicalBytes() int64 {
 	if x != nil {
-		return x.Recursive
+		return x.PhysicalBytes
 	}
-	return false
+	return 0
 }
 
-func (x *AddFile_URLSource) GetConcurrency() uint32 {
+func (x *StorageStats) GetUniqueBytes() int64 {
 	if x != nil {
-		return x.Concurrency
+		return x.UniqueBytes
 	}
 	return 0
 }
 
-type SQLDatabaseEgress_FileFormat struct {
+func (x *StorageStats) GetChunkCount() int64 {
+	if x != nil {
+		return x.ChunkCount
+	}
+	return 0
+}
+
+type RepoStorageStats struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type    SQLDatabaseEgress_FileFormat_Type `protobuf:"varint,1,opt,name=type,proto3,enum=pfs_v2.SQLDatabaseEgress_FileFormat_Type" json:"type,omitempty"`
-	Columns []string                          `protobuf:"bytes,2,rep,name=columns,proto3" json:"columns,omitempty"`
+	Repo  *Repo         `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	Stats *StorageStats `protobuf:"bytes,2,opt,name=stats,proto3" json:"stats,omitempty"`
 }
 
-func (x *SQLDatabaseEgress_FileFormat) Reset() {
-	*x = SQLDatabaseEgress_FileFormat{}
+func (x *RepoStorageStats) Reset() {
+	*x = RepoStorageStats{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[82]
+		mi := &file_pfs_pfs_proto_msgTypes[87]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SQLDatabaseEgress_FileFormat) String() string {
+func (x *RepoStorageStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SQLDatabaseEgress_FileFormat) ProtoMessage() {}
+func (*RepoStorageStats) ProtoMessage() {}
 
-func (x *SQLDatabaseEgress_FileFormat) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[82]
+func (x *RepoStorageStats) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[87]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5414,51 +5999,51 @@ func (x *SQLDatabaseEgress_FileFormat) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SQLDatabaseEgress_FileFormat.ProtoReflect.Descriptor instead.
-func (*SQLDatabaseEgress_FileFormat) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{76, 0}
+// Deprecated: Use RepoStorageStats.ProtoReflect.Descriptor instead.
+func (*RepoStorageStats) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{87}
 }
 
-func (x *SQLDatabaseEgress_FileFormat) GetType() SQLDatabaseEgress_FileFormat_Type {
+func (x *RepoStorageStats) GetRepo() *Repo {
 	if x != nil {
-		return x.Type
+		return x.Repo
 	}
-	return SQLDatabaseEgress_FileFormat_UNKNOWN
+	return nil
 }
 
-func (x *SQLDatabaseEgress_FileFormat) GetColumns() []string {
+func (x *RepoStorageStats) GetStats() *StorageStats {
 	if x != nil {
-		return x.Columns
+		return x.Stats
 	}
 	return nil
 }
 
-type SQLDatabaseEgress_Secret struct {
+type ProjectStorageStats struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Key  string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Project *Project      `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	Stats   *StorageStats `protobuf:"bytes,2,opt,name=stats,proto3" json:"stats,omitempty"`
 }
 
-func (x *SQLDatabaseEgress_Secret) Reset() {
-	*x = SQLDatabaseEgress_Secret{}
+func (x *ProjectStorageStats) Reset() {
+	*x = ProjectStorageStats{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[83]
+		mi := &file_pfs_pfs_proto_msgTypes[88]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SQLDatabaseEgress_Secret) String() string {
+func (x *ProjectStorageStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SQLDatabaseEgress_Secret) ProtoMessage() {}
+func (*ProjectStorageStats) ProtoMessage() {}
 
-func (x *SQLDatabaseEgress_Secret) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[83]
+func (x *ProjectStorageStats) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[88]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5469,50 +6054,55 @@ func (x *SQLDatabaseEgress_Secret) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SQLDatabaseEgress_Secret.ProtoReflect.Descriptor instead.
-func (*SQLDatabaseEgress_Secret) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{76, 1}
+// Deprecated: Use ProjectStorageStats.ProtoReflect.Descriptor instead.
+func (*ProjectStorageStats) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{88}
 }
 
-func (x *SQLDatabaseEgress_Secret) GetName() string {
+func (x *ProjectStorageStats) GetProject() *Project {
 	if x != nil {
-		return x.Name
+		return x.Project
 	}
-	return ""
+	return nil
 }
 
-func (x *SQLDatabaseEgress_Secret) GetKey() string {
+func (x *ProjectStorageStats) GetStats() *StorageStats {
 	if x != nil {
-		return x.Key
+		return x.Stats
 	}
-	return ""
+	return nil
 }
 
-type EgressResponse_ObjectStorageResult struct {
+// SharedStorageStats describes the chunks that two repos have in common.
+type SharedStorageStats struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BytesWritten int64 `protobuf:"varint,1,opt,name=bytes_written,json=bytesWritten,proto3" json:"bytes_written,omitempty"`
+	RepoA       *Repo `protobuf:"bytes,1,opt,name=repo_a,json=repoA,proto3" json:"repo_a,omitempty"`
+	RepoB       *Repo `protobuf:"bytes,2,opt,name=repo_b,json=repoB,proto3" json:"repo_b,omitempty"`
+	SharedBytes int64 `protobuf:"varint,3,opt,name=shared_bytes,json=sharedBytes,proto3" json:"shared_bytes,omitempty"`
+	// shared_bytes as a fraction of the physical bytes of both repos together.
+	SharedRatio float64 `protobuf:"fixed64,4,opt,name=shared_ratio,json=sharedRatio,proto3" json:"shared_ratio,omitempty"`
 }
 
-func (x *EgressResponse_ObjectStorageResult) Reset() {
-	*x = EgressResponse_ObjectStorageResult{}
+func (x *SharedStorageStats) Reset() {
+	*x = SharedStorageStats{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[84]
+		mi := &file_pfs_pfs_proto_msgTypes[89]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EgressResponse_ObjectStorageResult) String() string {
+func (x *SharedStorageStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EgressResponse_ObjectStorageResult) ProtoMessage() {}
+func (*SharedStorageStats) ProtoMessage() {}
 
-func (x *EgressResponse_ObjectStorageResult) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[84]
+func (x *SharedStorageStats) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[89]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5523,43 +6113,73 @@ func (x *EgressResponse_ObjectStorageResult) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EgressResponse_ObjectStorageResult.ProtoReflect.Descriptor instead.
-func (*EgressResponse_ObjectStorageResult) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{78, 0}
+// Deprecated: Use SharedStorageStats.ProtoReflect.Descriptor instead.
+func (*SharedStorageStats) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{89}
 }
 
-func (x *EgressResponse_ObjectStorageResult) GetBytesWritten() int64 {
+func (x *SharedStorageStats) GetRepoA() *Repo {
 	if x != nil {
-		return x.BytesWritten
+		return x.RepoA
+	}
+	return nil
+}
+
+func (x *SharedStorageStats) GetRepoB() *Repo {
+	if x != nil {
+		return x.RepoB
+	}
+	return nil
+}
+
+func (x *SharedStorageStats) GetSharedBytes() int64 {
+	if x != nil {
+		return x.SharedBytes
 	}
 	return 0
 }
 
-type EgressResponse_SQLDatabaseResult struct {
+func (x *SharedStorageStats) GetSharedRatio() float64 {
+	if x != nil {
+		return x.SharedRatio
+	}
+	return 0
+}
+
+type InspectStorageResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RowsWritten map[string]int64 `protobuf:"bytes,1,rep,name=rows_written,json=rowsWritten,proto3" json:"rows_written,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// The storage used by all repos.
+	Total    *StorageStats          `protobuf:"bytes,1,opt,name=total,proto3" json:"total,omitempty"`
+	Projects []*ProjectStorageStats `protobuf:"bytes,2,rep,name=projects,proto3" json:"projects,omitempty"`
+	// The repos, most unique bytes first.
+	Repos []*RepoStorageStats `protobuf:"bytes,3,rep,name=repos,proto3" json:"repos,omitempty"`
+	// The pairs of repos that share chunks, most shared bytes first.
+	Shared []*SharedStorageStats `protobuf:"bytes,4,rep,name=shared,proto3" json:"shared,omitempty"`
+	// When the statistics were computed.  pachd computes them periodically in
+	// the background, so they may not reflect the latest commits.
+	ComputedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=computed_at,json=computedAt,proto3" json:"computed_at,omitempty"`
 }
 
-func (x *EgressResponse_SQLDatabaseResult) Reset() {
-	*x = EgressResponse_SQLDatabaseResult{}
+func (x *InspectStorageResponse) Reset() {
+	*x = InspectStorageResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[85]
+		mi := &file_pfs_pfs_proto_msgTypes[90]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EgressResponse_SQLDatabaseResult) String() string {
+func (x *InspectStorageResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EgressResponse_SQLDatabaseResult) ProtoMessage() {}
+func (*InspectStorageResponse) ProtoMessage() {}
 
-func (x *EgressResponse_SQLDatabaseResult) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[85]
+func (x *InspectStorageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[90]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5570,1224 +6190,3209 @@ func (x *EgressResponse_SQLDatabaseResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EgressResponse_SQLDatabaseResult.ProtoReflect.Descriptor instead.
-func (*EgressResponse_SQLDatabaseResult) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{78, 1}
+// Deprecated: Use InspectStorageResponse.ProtoReflect.Descriptor instead.
+func (*InspectStorageResponse) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{90}
 }
 
-func (x *EgressResponse_SQLDatabaseResult) GetRowsWritten() map[string]int64 {
+func (x *InspectStorageResponse) GetTotal() *StorageStats {
 	if x != nil {
-		return x.RowsWritten
+		return x.Total
 	}
 	return nil
 }
 
-var File_pfs_pfs_proto protoreflect.FileDescriptor
-
-var file_pfs_pfs_proto_rawDesc = []byte{
-	0x0a, 0x0d, 0x70, 0x66, 0x73, 0x2f, 0x70, 0x66, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
-	0x06, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x1a, 0x0f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x1a, 0x0f, 0x74, 0x61, 0x73, 0x6b, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x1a, 0x1e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0x59, 0x0a, 0x04, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x6f,
-	0x6a, 0x65, 0x63, 0x74, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x3e, 0x0a,
-	0x06, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x20, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52,
-	0x65, 0x70, 0x6f, 0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x58, 0x0a,
-	0x04, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43,
-	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x12, 0x0a,
-	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74,
-	0x68, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x22, 0xf2, 0x02, 0x0a, 0x08, 0x52, 0x65, 0x70, 0x6f,
-	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x20, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x70, 0x6f,
-	0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x33, 0x0a, 0x16,
-	0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x75, 0x70, 0x70, 0x65, 0x72,
-	0x5f, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x73, 0x69,
-	0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x55, 0x70, 0x70, 0x65, 0x72, 0x42, 0x6f, 0x75, 0x6e,
-	0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x08, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x18,
-	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x42,
-	0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x08, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x12,
-	0x2d, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x41, 0x75, 0x74, 0x68,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x32,
-	0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x18, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x49, 0x6e, 0x66,
-	0x6f, 0x2e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69,
-	0x6c, 0x73, 0x1a, 0x28, 0x0a, 0x07, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x1d, 0x0a,
-	0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x57, 0x0a, 0x08,
-	0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x35, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x13, 0x2e,
-	0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12,
-	0x14, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05,
-	0x72, 0x6f, 0x6c, 0x65, 0x73, 0x22, 0xa0, 0x02, 0x0a, 0x0a, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68,
-	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x26, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x42, 0x72,
-	0x61, 0x6e, 0x63, 0x68, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x22, 0x0a, 0x04,
-	0x68, 0x65, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73,
-	0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x04, 0x68, 0x65, 0x61, 0x64,
-	0x12, 0x2e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x42, 0x72,
-	0x61, 0x6e, 0x63, 0x68, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65,
-	0x12, 0x2e, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x04,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x42, 0x72,
-	0x61, 0x6e, 0x63, 0x68, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65,
-	0x12, 0x3b, 0x0a, 0x11, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x65,
-	0x6e, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66,
-	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x10, 0x64, 0x69, 0x72,
-	0x65, 0x63, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x29, 0x0a,
-	0x07, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
-	0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52,
-	0x07, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x22, 0xa6, 0x01, 0x0a, 0x07, 0x54, 0x72, 0x69,
-	0x67, 0x67, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x10, 0x0a, 0x03,
-	0x61, 0x6c, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x61, 0x6c, 0x6c, 0x12, 0x26,
-	0x0a, 0x0f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x73, 0x70, 0x65,
-	0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d,
-	0x69, 0x74, 0x53, 0x70, 0x65, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f,
-	0x6d, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x6f, 0x6d,
-	0x6d, 0x69, 0x74, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x72, 0x6f, 0x6e, 0x5f, 0x73, 0x70, 0x65,
-	0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x72, 0x6f, 0x6e, 0x53, 0x70, 0x65,
-	0x63, 0x22, 0x36, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4f, 0x72, 0x69, 0x67, 0x69,
-	0x6e, 0x12, 0x26, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x12, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x4b,
-	0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x22, 0x62, 0x0a, 0x06, 0x43, 0x6f, 0x6d,
-	0x6d, 0x69, 0x74, 0x12, 0x20, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x52,
-	0x04, 0x72, 0x65, 0x70, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x26, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x42,
-	0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x22, 0x8d, 0x06,
-	0x0a, 0x0a, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x26, 0x0a, 0x06,
-	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70,
-	0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x06, 0x63, 0x6f,
-	0x6d, 0x6d, 0x69, 0x74, 0x12, 0x2c, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f,
-	0x6d, 0x6d, 0x69, 0x74, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67,
-	0x69, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x0d, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x63,
-	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66,
-	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x0c, 0x70, 0x61, 0x72,
-	0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x33, 0x0a, 0x0d, 0x63, 0x68, 0x69,
-	0x6c, 0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
-	0x52, 0x0c, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x34,
-	0x0a, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x73, 0x74, 0x61,
-	0x72, 0x74, 0x65, 0x64, 0x12, 0x38, 0x0a, 0x09, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x69, 0x6e,
-	0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x09, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x12, 0x36,
-	0x0a, 0x08, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x66, 0x69,
-	0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x3b, 0x0a, 0x11, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
-	0x5f, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x0d, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
-	0x74, 0x52, 0x10, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61,
-	0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x33, 0x0a, 0x16, 0x73, 0x69, 0x7a,
-	0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x75, 0x70, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x6f,
-	0x75, 0x6e, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x73, 0x69, 0x7a, 0x65, 0x42,
-	0x79, 0x74, 0x65, 0x73, 0x55, 0x70, 0x70, 0x65, 0x72, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x34,
-	0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49,
-	0x6e, 0x66, 0x6f, 0x2e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x07, 0x64, 0x65, 0x74,
-	0x61, 0x69, 0x6c, 0x73, 0x1a, 0xb0, 0x01, 0x0a, 0x07, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
-	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12,
-	0x42, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x54,
-	0x69, 0x6d, 0x65, 0x12, 0x42, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6e,
-	0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x4a, 0x04, 0x08, 0x09, 0x10, 0x0a, 0x22, 0x1b, 0x0a,
-	0x09, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x65, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x6f, 0x0a, 0x0d, 0x43, 0x6f,
-	0x6d, 0x6d, 0x69, 0x74, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x30, 0x0a, 0x0a, 0x63,
-	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x11, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53,
-	0x65, 0x74, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x65, 0x74, 0x12, 0x2c, 0x0a,
-	0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12,
-	0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x22, 0xc8, 0x01, 0x0a, 0x08,
-	0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x20, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e,
-	0x46, 0x69, 0x6c, 0x65, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x2d, 0x0a, 0x09, 0x66, 0x69,
-	0x6c, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e,
-	0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52,
-	0x08, 0x66, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x6f, 0x6d,
-	0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
-	0x74, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65,
-	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74,
-	0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x22, 0x1d, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
-	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xc4, 0x01, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
-	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x29, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e,
-	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
-	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x41,
-	0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66,
-	0x6f, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x6f, 0x0a, 0x11,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x20, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x04, 0x72,
-	0x65, 0x70, 0x6f, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x22, 0x36, 0x0a,
-	0x12, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x52,
-	0x04, 0x72, 0x65, 0x70, 0x6f, 0x22, 0x52, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70,
-	0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2b, 0x0a, 0x08,
-	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f,
-	0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52,
-	0x08, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x22, 0x4b, 0x0a, 0x11, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20,
-	0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70,
-	0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x04, 0x72, 0x65, 0x70, 0x6f,
-	0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x22, 0x69, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x52, 0x65, 0x70, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x08,
-	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f,
-	0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52,
-	0x08, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72,
-	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x12,
-	0x10, 0x0a, 0x03, 0x61, 0x6c, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x61, 0x6c,
-	0x6c, 0x22, 0x2e, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x64, 0x22, 0x39, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x05, 0x72, 0x65, 0x70, 0x6f,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32,
-	0x2e, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x05, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x22, 0x86, 0x01, 0x0a,
-	0x12, 0x53, 0x74, 0x61, 0x72, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d,
-	0x6d, 0x69, 0x74, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x64,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a,
-	0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
-	0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x06, 0x62,
-	0x72, 0x61, 0x6e, 0x63, 0x68, 0x22, 0x8b, 0x01, 0x0a, 0x13, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68,
-	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a,
-	0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
-	0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x06, 0x63,
-	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x14, 0x0a,
-	0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f,
-	0x72, 0x63, 0x65, 0x22, 0x67, 0x0a, 0x14, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x43, 0x6f,
-	0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x06, 0x63,
-	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66,
-	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x06, 0x63, 0x6f, 0x6d,
-	0x6d, 0x69, 0x74, 0x12, 0x27, 0x0a, 0x04, 0x77, 0x61, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x13, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
-	0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x04, 0x77, 0x61, 0x69, 0x74, 0x22, 0xb1, 0x02, 0x0a,
-	0x11, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x20, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x04,
-	0x72, 0x65, 0x70, 0x6f, 0x12, 0x22, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
-	0x69, 0x74, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x1e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f,
-	0x6d, 0x6d, 0x69, 0x74, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62,
-	0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
-	0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6c,
-	0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x61, 0x6c, 0x6c, 0x12, 0x33, 0x0a, 0x0b,
-	0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x12, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4f, 0x72, 0x69, 0x67, 0x69,
-	0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x0a, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x4b, 0x69, 0x6e,
-	0x64, 0x12, 0x3d, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d,
-	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65,
-	0x22, 0x5f, 0x0a, 0x17, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
-	0x74, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x0a, 0x63,
-	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x11, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53,
-	0x65, 0x74, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x65, 0x74, 0x12, 0x12, 0x0a,
-	0x04, 0x77, 0x61, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x77, 0x61, 0x69,
-	0x74, 0x22, 0x41, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53,
-	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x07, 0x70, 0x72, 0x6f,
-	0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x66, 0x73,
-	0x5f, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x07, 0x70, 0x72, 0x6f,
-	0x6a, 0x65, 0x63, 0x74, 0x22, 0x4a, 0x0a, 0x16, 0x53, 0x71, 0x75, 0x61, 0x73, 0x68, 0x43, 0x6f,
-	0x6d, 0x6d, 0x69, 0x74, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30,
-	0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
-	0x69, 0x74, 0x53, 0x65, 0x74, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x65, 0x74,
-	0x22, 0x52, 0x0a, 0x14, 0x44, 0x72, 0x6f, 0x70, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x65,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d,
-	0x69, 0x74, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70,
-	0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x65, 0x74, 0x42,
-	0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
-	0x74, 0x53, 0x65, 0x74, 0x22, 0xe8, 0x01, 0x0a, 0x16, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x62, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x20, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
-	0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x04, 0x72, 0x65, 0x70,
-	0x6f, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x22, 0x0a, 0x04, 0x66, 0x72, 0x6f,
-	0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32,
-	0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x29, 0x0a,
-	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70,
-	0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6c, 0x6c, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x61, 0x6c, 0x6c, 0x12, 0x33, 0x0a, 0x0b, 0x6f, 0x72,
-	0x69, 0x67, 0x69, 0x6e, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x12, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x4b,
-	0x69, 0x6e, 0x64, 0x52, 0x0a, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x22,
-	0x3c, 0x0a, 0x12, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43,
-	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x22, 0x5b, 0x0a,
-	0x13, 0x53, 0x71, 0x75, 0x61, 0x73, 0x68, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f,
-	0x6d, 0x6d, 0x69, 0x74, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x1c, 0x0a, 0x09,
-	0x72, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x09, 0x72, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x22, 0x16, 0x0a, 0x14, 0x53, 0x71,
-	0x75, 0x61, 0x73, 0x68, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x59, 0x0a, 0x11, 0x44, 0x72, 0x6f, 0x70, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32,
-	0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12,
-	0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x09, 0x72, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x22, 0x14, 0x0a,
-	0x12, 0x44, 0x72, 0x6f, 0x70, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0xe2, 0x01, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x72,
-	0x61, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x04, 0x68,
-	0x65, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f,
-	0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x04, 0x68, 0x65, 0x61, 0x64, 0x12,
-	0x26, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52,
-	0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x2e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x76, 0x65,
-	0x6e, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66,
-	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x0a, 0x70, 0x72, 0x6f,
-	0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x74, 0x72, 0x69, 0x67, 0x67,
-	0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76,
-	0x32, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x07, 0x74, 0x72, 0x69, 0x67, 0x67,
-	0x65, 0x72, 0x12, 0x24, 0x0a, 0x0e, 0x6e, 0x65, 0x77, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
-	0x5f, 0x73, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6e, 0x65, 0x77, 0x43,
-	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x65, 0x74, 0x22, 0x77, 0x0a, 0x12, 0x46, 0x69, 0x6e, 0x64,
-	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e,
-	0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
-	0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x42, 0x08, 0xfa,
-	0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x1b,
-	0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x6c,
-	0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69,
-	0x74, 0x22, 0xc3, 0x01, 0x0a, 0x13, 0x46, 0x69, 0x6e, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x0c, 0x66, 0x6f, 0x75,
-	0x6e, 0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48,
-	0x00, 0x52, 0x0b, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x42,
-	0x0a, 0x14, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x64, 0x5f,
-	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70,
-	0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x00, 0x52, 0x12,
-	0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d,
-	0x69, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x5f, 0x73, 0x65,
-	0x61, 0x72, 0x63, 0x68, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x63, 0x6f,
-	0x6d, 0x6d, 0x69, 0x74, 0x73, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x64, 0x42, 0x08, 0x0a,
-	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x3e, 0x0a, 0x14, 0x49, 0x6e, 0x73, 0x70, 0x65,
-	0x63, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x26, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52,
-	0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x22, 0x4f, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x42,
-	0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x04,
-	0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x66, 0x73,
-	0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x12, 0x18,
-	0x0a, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x22, 0x5d, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x30, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x42,
-	0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63,
-	0x68, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x22, 0x7b, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x29, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0f, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
-	0x74, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x22, 0x4c, 0x0a, 0x15, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x50,
-	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a,
-	0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
-	0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x42,
-	0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65,
-	0x63, 0x74, 0x22, 0x14, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x57, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x29, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65,
-	0x63, 0x74, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x66,
-	0x6f, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63,
-	0x65, 0x22, 0xfc, 0x01, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x0a,
-	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74,
-	0x68, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x12, 0x2f, 0x0a, 0x03, 0x72, 0x61, 0x77, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x48, 0x00, 0x52, 0x03, 0x72, 0x61, 0x77, 0x12, 0x2d, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x41,
-	0x64, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x2e, 0x55, 0x52, 0x4c, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x48, 0x00, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x1a, 0x5d, 0x0a, 0x09, 0x55, 0x52, 0x4c, 0x53, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x55, 0x52, 0x4c, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x55, 0x52, 0x4c, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x75, 0x72, 0x73,
-	0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x72, 0x65, 0x63, 0x75, 0x72,
-	0x73, 0x69, 0x76, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65,
-	0x6e, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x63, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x08, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x22, 0x36, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61,
-	0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x22, 0x74, 0x0a, 0x08, 0x43, 0x6f, 0x70, 0x79,
-	0x46, 0x69, 0x6c, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x64, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x12, 0x28, 0x0a, 0x03,
-	0x73, 0x72, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x66, 0x73, 0x5f,
-	0x76, 0x32, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10,
-	0x01, 0x52, 0x03, 0x73, 0x72, 0x63, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x22, 0x8a,
-	0x02, 0x0a, 0x11, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d,
-	0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76,
-	0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02,
-	0x10, 0x01, 0x48, 0x00, 0x52, 0x09, 0x73, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12,
-	0x36, 0x0a, 0x08, 0x61, 0x64, 0x64, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x41, 0x64, 0x64, 0x46, 0x69,
-	0x6c, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x48, 0x00, 0x52, 0x07,
-	0x61, 0x64, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x3f, 0x0a, 0x0b, 0x64, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70,
-	0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65,
-	0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x48, 0x00, 0x52, 0x0a, 0x64, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x39, 0x0a, 0x09, 0x63, 0x6f, 0x70, 0x79,
-	0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x66,
-	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x70, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x42, 0x08, 0xfa,
-	0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x48, 0x00, 0x52, 0x08, 0x63, 0x6f, 0x70, 0x79, 0x46,
-	0x69, 0x6c, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x8e, 0x01, 0x0a, 0x0e,
-	0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20,
-	0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70,
-	0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65,
-	0x12, 0x10, 0x0a, 0x03, 0x55, 0x52, 0x4c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x55,
-	0x52, 0x4c, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x30, 0x0a, 0x0a, 0x70, 0x61,
-	0x74, 0x68, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
-	0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x52, 0x61, 0x6e, 0x67,
-	0x65, 0x52, 0x09, 0x70, 0x61, 0x74, 0x68, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x36, 0x0a, 0x12,
-	0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x20, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x04,
-	0x66, 0x69, 0x6c, 0x65, 0x22, 0xa5, 0x01, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6c,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e,
-	0x46, 0x69, 0x6c, 0x65, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x38, 0x0a, 0x10, 0x70, 0x61,
-	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x46, 0x69,
-	0x6c, 0x65, 0x52, 0x10, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61,
-	0x72, 0x6b, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07,
-	0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72,
-	0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x22, 0x9f, 0x01, 0x0a,
-	0x0f, 0x57, 0x61, 0x6c, 0x6b, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x20, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
-	0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x04, 0x66, 0x69,
-	0x6c, 0x65, 0x12, 0x38, 0x0a, 0x10, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70,
-	0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x10, 0x70, 0x61, 0x67, 0x69,
-	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06,
-	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x22, 0x85,
-	0x01, 0x0a, 0x0f, 0x47, 0x6c, 0x6f, 0x62, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x26, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
-	0x69, 0x74, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61,
-	0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x74,
-	0x74, 0x65, 0x72, 0x6e, 0x12, 0x30, 0x0a, 0x0a, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x72, 0x61, 0x6e,
-	0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76,
-	0x32, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x09, 0x70, 0x61, 0x74,
-	0x68, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x7d, 0x0a, 0x0f, 0x44, 0x69, 0x66, 0x66, 0x46, 0x69,
-	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x08, 0x6e, 0x65, 0x77,
-	0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x66,
-	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x07, 0x6e, 0x65, 0x77, 0x46, 0x69,
-	0x6c, 0x65, 0x12, 0x27, 0x0a, 0x08, 0x6f, 0x6c, 0x64, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x46, 0x69,
-	0x6c, 0x65, 0x52, 0x07, 0x6f, 0x6c, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73,
-	0x68, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x68,
-	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x22, 0x6c, 0x0a, 0x10, 0x44, 0x69, 0x66, 0x66, 0x46, 0x69, 0x6c,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x08, 0x6e, 0x65, 0x77,
-	0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x66,
-	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x6e,
-	0x65, 0x77, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x2b, 0x0a, 0x08, 0x6f, 0x6c, 0x64, 0x5f, 0x66, 0x69,
-	0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76,
-	0x32, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x6f, 0x6c, 0x64, 0x46,
-	0x69, 0x6c, 0x65, 0x22, 0x87, 0x01, 0x0a, 0x0b, 0x46, 0x73, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x03, 0x66, 0x69, 0x78, 0x12, 0x35, 0x0a, 0x0d, 0x7a, 0x6f, 0x6d, 0x62, 0x69, 0x65, 0x5f,
-	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70,
-	0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x00, 0x52, 0x0c,
-	0x7a, 0x6f, 0x6d, 0x62, 0x69, 0x65, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x1f, 0x0a, 0x0a,
-	0x7a, 0x6f, 0x6d, 0x62, 0x69, 0x65, 0x5f, 0x61, 0x6c, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
-	0x48, 0x00, 0x52, 0x09, 0x7a, 0x6f, 0x6d, 0x62, 0x69, 0x65, 0x41, 0x6c, 0x6c, 0x42, 0x0e, 0x0a,
-	0x0c, 0x7a, 0x6f, 0x6d, 0x62, 0x69, 0x65, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x22, 0x36, 0x0a,
-	0x0c, 0x46, 0x73, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a,
-	0x03, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x66, 0x69, 0x78, 0x12,
-	0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x37, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46,
-	0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e,
-	0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x49, 0x64, 0x22, 0x3b,
-	0x0a, 0x11, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d,
-	0x6d, 0x69, 0x74, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x22, 0x5b, 0x0a, 0x11, 0x41,
-	0x64, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x26, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
-	0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x1e, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x65,
-	0x5f, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66,
-	0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x49, 0x64, 0x22, 0x56, 0x0a, 0x13, 0x52, 0x65, 0x6e, 0x65,
-	0x77, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1e, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x49, 0x64, 0x12,
-	0x1f, 0x0a, 0x0b, 0x74, 0x74, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x74, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
-	0x22, 0x74, 0x0a, 0x15, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x53,
-	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0c, 0x66, 0x69, 0x6c,
-	0x65, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x0a, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x49, 0x64, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74,
-	0x74, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0a, 0x74, 0x74, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07,
-	0x63, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63,
-	0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x22, 0x35, 0x0a, 0x13, 0x53, 0x68, 0x61, 0x72, 0x64, 0x46,
-	0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a,
-	0x0b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x49, 0x64, 0x22, 0x37, 0x0a,
-	0x09, 0x50, 0x61, 0x74, 0x68, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f,
-	0x77, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f, 0x77, 0x65, 0x72,
-	0x12, 0x14, 0x0a, 0x05, 0x75, 0x70, 0x70, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x75, 0x70, 0x70, 0x65, 0x72, 0x22, 0x41, 0x0a, 0x14, 0x53, 0x68, 0x61, 0x72, 0x64, 0x46,
-	0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29,
-	0x0a, 0x06, 0x73, 0x68, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11,
-	0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x52, 0x61, 0x6e, 0x67,
-	0x65, 0x52, 0x06, 0x73, 0x68, 0x61, 0x72, 0x64, 0x73, 0x22, 0x7b, 0x0a, 0x13, 0x43, 0x68, 0x65,
-	0x63, 0x6b, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x26, 0x0a, 0x0f, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x64,
-	0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x72, 0x65, 0x61, 0x64, 0x43,
-	0x68, 0x75, 0x6e, 0x6b, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x68, 0x75, 0x6e,
-	0x6b, 0x5f, 0x62, 0x65, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x63,
-	0x68, 0x75, 0x6e, 0x6b, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x75,
-	0x6e, 0x6b, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x68,
-	0x75, 0x6e, 0x6b, 0x45, 0x6e, 0x64, 0x22, 0x44, 0x0a, 0x14, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53,
-	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c,
-	0x0a, 0x12, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x63, 0x68, 0x75, 0x6e,
-	0x6b, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x83, 0x01, 0x0a,
-	0x0f, 0x50, 0x75, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x2a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x20,
-	0x0a, 0x0c, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x49, 0x64, 0x73,
-	0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74,
-	0x61, 0x67, 0x22, 0x23, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x3e, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x61,
-	0x63, 0x68, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x32, 0x0a, 0x11, 0x43, 0x6c, 0x65, 0x61, 0x72,
-	0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
-	0x74, 0x61, 0x67, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x74, 0x61, 0x67, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x22, 0x15, 0x0a, 0x13, 0x41,
-	0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x22, 0x16, 0x0a, 0x14, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x41, 0x75,
-	0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x0a, 0x13, 0x4f, 0x62,
-	0x6a, 0x65, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x45, 0x67, 0x72, 0x65, 0x73,
-	0x73, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x75, 0x72, 0x6c, 0x22, 0xf3, 0x02, 0x0a, 0x11, 0x53, 0x51, 0x4c, 0x44, 0x61, 0x74, 0x61, 0x62,
-	0x61, 0x73, 0x65, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x45, 0x0a, 0x0b, 0x66,
-	0x69, 0x6c, 0x65, 0x5f, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x24, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x53, 0x51, 0x4c, 0x44, 0x61, 0x74,
-	0x61, 0x62, 0x61, 0x73, 0x65, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x2e, 0x46, 0x69, 0x6c, 0x65,
-	0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x6d,
-	0x61, 0x74, 0x12, 0x38, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x53, 0x51, 0x4c, 0x44,
-	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x2e, 0x53, 0x65,
-	0x63, 0x72, 0x65, 0x74, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x1a, 0x9a, 0x01, 0x0a,
-	0x0a, 0x46, 0x69, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x3d, 0x0a, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x70, 0x66, 0x73, 0x5f,
-	0x76, 0x32, 0x2e, 0x53, 0x51, 0x4c, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x45, 0x67,
-	0x72, 0x65, 0x73, 0x73, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x2e,
-	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f,
-	0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6c,
-	0x75, 0x6d, 0x6e, 0x73, 0x22, 0x33, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07,
-	0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x43, 0x53, 0x56,
-	0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07,
-	0x50, 0x41, 0x52, 0x51, 0x55, 0x45, 0x54, 0x10, 0x03, 0x1a, 0x2e, 0x0a, 0x06, 0x53, 0x65, 0x63,
-	0x72, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0xc7, 0x01, 0x0a, 0x0d, 0x45, 0x67,
-	0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x06, 0x63,
-	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66,
-	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x06, 0x63, 0x6f, 0x6d,
-	0x6d, 0x69, 0x74, 0x12, 0x44, 0x0a, 0x0e, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x73, 0x74,
-	0x6f, 0x72, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x66,
-	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0d, 0x6f, 0x62, 0x6a, 0x65,
-	0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x3e, 0x0a, 0x0c, 0x73, 0x71, 0x6c,
-	0x5f, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x19, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x53, 0x51, 0x4c, 0x44, 0x61, 0x74, 0x61,
-	0x62, 0x61, 0x73, 0x65, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x71,
-	0x6c, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x74, 0x61, 0x72,
-	0x67, 0x65, 0x74, 0x22, 0xae, 0x03, 0x0a, 0x0e, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0e, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74,
-	0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a,
-	0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x74, 0x6f,
-	0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x0d, 0x6f, 0x62,
-	0x6a, 0x65, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x4d, 0x0a, 0x0c, 0x73,
-	0x71, 0x6c, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x28, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x45, 0x67, 0x72, 0x65, 0x73,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x51, 0x4c, 0x44, 0x61, 0x74,
-	0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x73,
-	0x71, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x1a, 0x3a, 0x0a, 0x13, 0x4f, 0x62,
-	0x6a, 0x65, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x74,
-	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x62, 0x79, 0x74, 0x65, 0x73, 0x57,
-	0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x1a, 0xb1, 0x01, 0x0a, 0x11, 0x53, 0x51, 0x4c, 0x44, 0x61,
-	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x5c, 0x0a, 0x0c,
-	0x72, 0x6f, 0x77, 0x73, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x39, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x45, 0x67, 0x72, 0x65,
-	0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x51, 0x4c, 0x44, 0x61,
-	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x52, 0x6f, 0x77,
-	0x73, 0x57, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x72,
-	0x6f, 0x77, 0x73, 0x57, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x1a, 0x3e, 0x0a, 0x10, 0x52, 0x6f,
-	0x77, 0x73, 0x57, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x2a, 0x49, 0x0a, 0x0a, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x4b, 0x69,
-	0x6e, 0x64, 0x12, 0x17, 0x0a, 0x13, 0x4f, 0x52, 0x49, 0x47, 0x49, 0x4e, 0x5f, 0x4b, 0x49, 0x4e,
-	0x44, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x55,
-	0x53, 0x45, 0x52, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x41, 0x55, 0x54, 0x4f, 0x10, 0x02, 0x12,
-	0x08, 0x0a, 0x04, 0x46, 0x53, 0x43, 0x4b, 0x10, 0x03, 0x22, 0x04, 0x08, 0x04, 0x10, 0x04, 0x2a,
-	0x2b, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0c, 0x0a, 0x08, 0x52,
-	0x45, 0x53, 0x45, 0x52, 0x56, 0x45, 0x44, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x49, 0x4c,
-	0x45, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x44, 0x49, 0x52, 0x10, 0x02, 0x2a, 0x5c, 0x0a, 0x0b,
-	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x43,
-	0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e,
-	0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x54, 0x41, 0x52, 0x54, 0x45, 0x44,
-	0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x52, 0x45, 0x41, 0x44, 0x59, 0x10, 0x02, 0x12, 0x0d, 0x0a,
-	0x09, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08,
-	0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x45, 0x44, 0x10, 0x04, 0x2a, 0x3b, 0x0a, 0x09, 0x44, 0x65,
-	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10,
-	0x00, 0x12, 0x08, 0x0a, 0x04, 0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x4c,
-	0x49, 0x4e, 0x45, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x53, 0x51, 0x4c, 0x10, 0x03, 0x12, 0x07,
-	0x0a, 0x03, 0x43, 0x53, 0x56, 0x10, 0x04, 0x32, 0xbc, 0x1a, 0x0a, 0x03, 0x41, 0x50, 0x49, 0x12,
-	0x41, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x19, 0x2e,
-	0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70,
-	0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0b, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x52, 0x65, 0x70,
-	0x6f, 0x12, 0x1a, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x49, 0x6e, 0x73, 0x70, 0x65,
-	0x63, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e,
-	0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x49, 0x6e, 0x66, 0x6f, 0x22,
-	0x00, 0x12, 0x39, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x17, 0x2e,
-	0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e,
-	0x52, 0x65, 0x70, 0x6f, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x30, 0x01, 0x12, 0x45, 0x0a, 0x0a,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x19, 0x2e, 0x70, 0x66, 0x73,
-	0x5f, 0x76, 0x32, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x70,
-	0x6f, 0x73, 0x12, 0x1a, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
-	0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65,
-	0x70, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3b, 0x0a,
-	0x0b, 0x53, 0x74, 0x61, 0x72, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x1a, 0x2e, 0x70,
-	0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76,
-	0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0c, 0x46, 0x69,
-	0x6e, 0x69, 0x73, 0x68, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x1b, 0x2e, 0x70, 0x66, 0x73,
-	0x5f, 0x76, 0x32, 0x2e, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
-	0x00, 0x12, 0x43, 0x0a, 0x0b, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
-	0x12, 0x1a, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x43,
-	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0d, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63,
-	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x1c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32,
-	0x2e, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43,
-	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0a, 0x4c,
-	0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x19, 0x2e, 0x70, 0x66, 0x73, 0x5f,
-	0x76, 0x32, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f,
-	0x6d, 0x6d, 0x69, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x30, 0x01, 0x12, 0x49, 0x0a, 0x0f,
-	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12,
-	0x1e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x62, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x12, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49,
-	0x6e, 0x66, 0x6f, 0x22, 0x00, 0x30, 0x01, 0x12, 0x4b, 0x0a, 0x0c, 0x53, 0x71, 0x75, 0x61, 0x73,
-	0x68, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x1b, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32,
-	0x2e, 0x53, 0x71, 0x75, 0x61, 0x73, 0x68, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x53, 0x71,
-	0x75, 0x61, 0x73, 0x68, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0a, 0x44, 0x72, 0x6f, 0x70, 0x43, 0x6f, 0x6d, 0x6d,
-	0x69, 0x74, 0x12, 0x19, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x44, 0x72, 0x6f, 0x70,
-	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
-	0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x44, 0x72, 0x6f, 0x70, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x10, 0x49,
-	0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x65, 0x74, 0x12,
-	0x1f, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74,
-	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x12, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
-	0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x30, 0x01, 0x12, 0x48, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74,
-	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x65, 0x74, 0x12, 0x1c, 0x2e, 0x70, 0x66, 0x73, 0x5f,
-	0x76, 0x32, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x65, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32,
-	0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00,
-	0x30, 0x01, 0x12, 0x4b, 0x0a, 0x0f, 0x53, 0x71, 0x75, 0x61, 0x73, 0x68, 0x43, 0x6f, 0x6d, 0x6d,
-	0x69, 0x74, 0x53, 0x65, 0x74, 0x12, 0x1e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x53,
-	0x71, 0x75, 0x61, 0x73, 0x68, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x65, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12,
-	0x47, 0x0a, 0x0d, 0x44, 0x72, 0x6f, 0x70, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x65, 0x74,
-	0x12, 0x1c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x44, 0x72, 0x6f, 0x70, 0x43, 0x6f,
-	0x6d, 0x6d, 0x69, 0x74, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0b, 0x46, 0x69, 0x6e, 0x64,
-	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x1a, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32,
-	0x2e, 0x46, 0x69, 0x6e, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x46, 0x69, 0x6e,
-	0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x30, 0x01, 0x12, 0x45, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x72,
-	0x61, 0x6e, 0x63, 0x68, 0x12, 0x1b, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0d, 0x49,
-	0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x1c, 0x2e, 0x70,
-	0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x42, 0x72, 0x61,
-	0x6e, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x70, 0x66, 0x73,
-	0x5f, 0x76, 0x32, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00,
-	0x12, 0x3f, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x19,
-	0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x72, 0x61, 0x6e,
-	0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x70, 0x66, 0x73, 0x5f,
-	0x76, 0x32, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x30,
-	0x01, 0x12, 0x45, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x72, 0x61, 0x6e, 0x63,
-	0x68, 0x12, 0x1b, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0a, 0x4d, 0x6f, 0x64, 0x69,
-	0x66, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x19, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e,
-	0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x28, 0x01, 0x12, 0x42, 0x0a,
-	0x07, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x16, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76,
-	0x32, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x00, 0x30,
-	0x01, 0x12, 0x45, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x54, 0x41, 0x52, 0x12,
-	0x16, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x3d, 0x0a, 0x0b, 0x49, 0x6e, 0x73, 0x70,
-	0x65, 0x63, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1a, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32,
-	0x2e, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x46, 0x69, 0x6c,
-	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x46,
-	0x69, 0x6c, 0x65, 0x12, 0x17, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70,
-	0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00,
-	0x30, 0x01, 0x12, 0x39, 0x0a, 0x08, 0x57, 0x61, 0x6c, 0x6b, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x17,
-	0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x57, 0x61, 0x6c, 0x6b, 0x46, 0x69, 0x6c, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32,
-	0x2e, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x30, 0x01, 0x12, 0x39, 0x0a,
-	0x08, 0x47, 0x6c, 0x6f, 0x62, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x17, 0x2e, 0x70, 0x66, 0x73, 0x5f,
-	0x76, 0x32, 0x2e, 0x47, 0x6c, 0x6f, 0x62, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x46, 0x69, 0x6c, 0x65,
-	0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x30, 0x01, 0x12, 0x41, 0x0a, 0x08, 0x44, 0x69, 0x66, 0x66,
-	0x46, 0x69, 0x6c, 0x65, 0x12, 0x17, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x44, 0x69,
-	0x66, 0x66, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e,
-	0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x46, 0x69, 0x6c, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x4b, 0x0a, 0x0c, 0x41,
-	0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x12, 0x1b, 0x2e, 0x70, 0x66,
-	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74,
-	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76,
-	0x32, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x41, 0x6c, 0x6c, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x04, 0x46, 0x73, 0x63, 0x6b, 0x12,
-	0x13, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x46, 0x73, 0x63, 0x6b, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x46, 0x73,
-	0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x4d,
-	0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x12,
-	0x19, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x46,
-	0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x66, 0x73,
-	0x5f, 0x76, 0x32, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x65,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x12, 0x48, 0x0a,
-	0x0a, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x12, 0x19, 0x2e, 0x70, 0x66,
-	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x0a, 0x41, 0x64, 0x64, 0x46, 0x69,
-	0x6c, 0x65, 0x53, 0x65, 0x74, 0x12, 0x19, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x41,
-	0x64, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0c, 0x52, 0x65,
-	0x6e, 0x65, 0x77, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x12, 0x1b, 0x2e, 0x70, 0x66, 0x73,
-	0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
-	0x00, 0x12, 0x50, 0x0a, 0x0e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x65, 0x46, 0x69, 0x6c, 0x65,
-	0x53, 0x65, 0x74, 0x12, 0x1d, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d,
-	0x70, 0x6f, 0x73, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0c, 0x53, 0x68, 0x61, 0x72, 0x64, 0x46, 0x69, 0x6c, 0x65,
-	0x53, 0x65, 0x74, 0x12, 0x1b, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x53, 0x68, 0x61,
-	0x72, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x64, 0x46,
-	0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x4b, 0x0a, 0x0c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
-	0x12, 0x1b, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53,
-	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
-	0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a,
-	0x08, 0x50, 0x75, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x17, 0x2e, 0x70, 0x66, 0x73, 0x5f,
-	0x76, 0x32, 0x2e, 0x50, 0x75, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x08,
-	0x47, 0x65, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x17, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76,
-	0x32, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x18, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61,
-	0x63, 0x68, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x41, 0x0a,
-	0x0a, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x19, 0x2e, 0x70, 0x66,
-	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
-	0x12, 0x3b, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x18, 0x2e, 0x74,
-	0x61, 0x73, 0x6b, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x61, 0x70, 0x69,
-	0x2e, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x30, 0x01, 0x12, 0x39, 0x0a,
-	0x06, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x15, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32,
-	0x2e, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
-	0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1c, 0x2e, 0x70, 0x66, 0x73, 0x5f,
-	0x76, 0x32, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
-	0x00, 0x12, 0x46, 0x0a, 0x0e, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x50, 0x72, 0x6f, 0x6a,
-	0x65, 0x63, 0x74, 0x12, 0x1d, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x49, 0x6e, 0x73,
-	0x70, 0x65, 0x63, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x6f, 0x6a,
-	0x65, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0b, 0x4c, 0x69, 0x73,
-	0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1a, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76,
-	0x32, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x72,
-	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x30, 0x01, 0x12, 0x47, 0x0a,
-	0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1c,
-	0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72,
-	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x42, 0x2b, 0x5a, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x61, 0x63, 0x68, 0x79, 0x64, 0x65, 0x72, 0x6d, 0x2f, 0x70,
-	0x61, 0x63, 0x68, 0x79, 0x64, 0x65, 0x72, 0x6d, 0x2f, 0x76, 0x32, 0x2f, 0x73, 0x72, 0x63, 0x2f,
-	0x70, 0x66, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *InspectStorageResponse) GetProjects() []*ProjectStorageStats {
+	if x != nil {
+		return x.Projects
+	}
+	return nil
 }
 
-var (
-	file_pfs_pfs_proto_rawDescOnce sync.Once
-	file_pfs_pfs_proto_rawDescData = file_pfs_pfs_proto_rawDesc
-)
+func (x *InspectStorageResponse) GetRepos() []*RepoStorageStats {
+	if x != nil {
+		return x.Repos
+	}
+	return nil
+}
 
-func file_pfs_pfs_proto_rawDescGZIP() []byte {
-	file_pfs_pfs_proto_rawDescOnce.Do(func() {
-		file_pfs_pfs_proto_rawDescData = protoimpl.X.CompressGZIP(file_pfs_pfs_proto_rawDescData)
-	})
-	return file_pfs_pfs_proto_rawDescData
+func (x *InspectStorageResponse) GetShared() []*SharedStorageStats {
+	if x != nil {
+		return x.Shared
+	}
+	return nil
 }
 
-var file_pfs_pfs_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
-var file_pfs_pfs_proto_msgTypes = make([]protoimpl.MessageInfo, 87)
-var file_pfs_pfs_proto_goTypes = []interface{}{
-	(OriginKind)(0),                            // 0: pfs_v2.OriginKind
-	(FileType)(0),                              // 1: pfs_v2.FileType
-	(CommitState)(0),                           // 2: pfs_v2.CommitState
-	(Delimiter)(0),                             // 3: pfs_v2.Delimiter
-	(SQLDatabaseEgress_FileFormat_Type)(0),     // 4: pfs_v2.SQLDatabaseEgress.FileFormat.Type
-	(*Repo)(nil),                               // 5: pfs_v2.Repo
-	(*Branch)(nil),                             // 6: pfs_v2.Branch
-	(*File)(nil),                               // 7: pfs_v2.File
-	(*RepoInfo)(nil),                           // 8: pfs_v2.RepoInfo
-	(*AuthInfo)(nil),                           // 9: pfs_v2.AuthInfo
-	(*BranchInfo)(nil),                         // 10: pfs_v2.BranchInfo
-	(*Trigger)(nil),                            // 11: pfs_v2.Trigger
-	(*CommitOrigin)(nil),                       // 12: pfs_v2.CommitOrigin
-	(*Commit)(nil),                             // 13: pfs_v2.Commit
-	(*CommitInfo)(nil),                         // 14: pfs_v2.CommitInfo
-	(*CommitSet)(nil),                          // 15: pfs_v2.CommitSet
-	(*CommitSetInfo)(nil),                      // 16: pfs_v2.CommitSetInfo
-	(*FileInfo)(nil),                           // 17: pfs_v2.FileInfo
-	(*Project)(nil),                            // 18: pfs_v2.Project
-	(*ProjectInfo)(nil),                        // 19: pfs_v2.ProjectInfo
-	(*CreateRepoRequest)(nil),                  // 20: pfs_v2.CreateRepoRequest
-	(*InspectRepoRequest)(nil),                 // 21: pfs_v2.InspectRepoRequest
-	(*ListRepoRequest)(nil),                    // 22: pfs_v2.ListRepoRequest
-	(*DeleteRepoRequest)(nil),                  // 23: pfs_v2.DeleteRepoRequest
-	(*DeleteReposRequest)(nil),                 // 24: pfs_v2.DeleteReposRequest
-	(*DeleteRepoResponse)(nil),                 // 25: pfs_v2.DeleteRepoResponse
-	(*DeleteReposResponse)(nil),                // 26: pfs_v2.DeleteReposResponse
-	(*StartCommitRequest)(nil),                 // 27: pfs_v2.StartCommitRequest
-	(*FinishCommitRequest)(nil),                // 28: pfs_v2.FinishCommitRequest
-	(*InspectCommitRequest)(nil),               // 29: pfs_v2.InspectCommitRequest
-	(*ListCommitRequest)(nil),                  // 30: pfs_v2.ListCommitRequest
-	(*InspectCommitSetRequest)(nil),            // 31: pfs_v2.InspectCommitSetRequest
-	(*ListCommitSetRequest)(nil),               // 32: pfs_v2.ListCommitSetRequest
-	(*SquashCommitSetRequest)(nil),             // 33: pfs_v2.SquashCommitSetRequest
-	(*DropCommitSetRequest)(nil),               // 34: pfs_v2.DropCommitSetRequest
-	(*SubscribeCommitRequest)(nil),             // 35: pfs_v2.SubscribeCommitRequest
-	(*ClearCommitRequest)(nil),                 // 36: pfs_v2.ClearCommitRequest
-	(*SquashCommitRequest)(nil),                // 37: pfs_v2.SquashCommitRequest
-	(*SquashCommitResponse)(nil),               // 38: pfs_v2.SquashCommitResponse
-	(*DropCommitRequest)(nil),                  // 39: pfs_v2.DropCommitRequest
-	(*DropCommitResponse)(nil),                 // 40: pfs_v2.DropCommitResponse
-	(*CreateBranchRequest)(nil),                // 41: pfs_v2.CreateBranchRequest
-	(*FindCommitsRequest)(nil),                 // 42: pfs_v2.FindCommitsRequest
-	(*FindCommitsResponse)(nil),                // 43: pfs_v2.FindCommitsResponse
-	(*InspectBranchRequest)(nil),               // 44: pfs_v2.InspectBranchRequest
-	(*ListBranchRequest)(nil),                  // 45: pfs_v2.ListBranchRequest
-	(*DeleteBranchRequest)(nil),                // 46: pfs_v2.DeleteBranchRequest
-	(*CreateProjectRequest)(nil),               // 47: pfs_v2.CreateProjectRequest
-	(*InspectProjectRequest)(nil),              // 48: pfs_v2.InspectProjectRequest
-	(*ListProjectRequest)(nil),                 // 49: pfs_v2.ListProjectRequest
-	(*DeleteProjectRequest)(nil),               // 50: pfs_v2.DeleteProjectRequest
-	(*AddFile)(nil),                            // 51: pfs_v2.AddFile
-	(*DeleteFile)(nil),                         // 52: pfs_v2.DeleteFile
-	(*CopyFile)(nil),                           // 53: pfs_v2.CopyFile
-	(*ModifyFileRequest)(nil),                  // 54: pfs_v2.ModifyFileRequest
-	(*GetFileRequest)(nil),                     // 55: pfs_v2.GetFileRequest
-	(*InspectFileRequest)(nil),                 // 56: pfs_v2.InspectFileRequest
-	(*ListFileRequest)(nil),                    // 57: pfs_v2.ListFileRequest
-	(*WalkFileRequest)(nil),                    // 58: pfs_v2.WalkFileRequest
-	(*GlobFileRequest)(nil),                    // 59: pfs_v2.GlobFileRequest
-	(*DiffFileRequest)(nil),                    // 60: pfs_v2.DiffFileRequest
-	(*DiffFileResponse)(nil),                   // 61: pfs_v2.DiffFileResponse
-	(*FsckRequest)(nil),                        // 62: pfs_v2.FsckRequest
-	(*FsckResponse)(nil),                       // 63: pfs_v2.FsckResponse
-	(*CreateFileSetResponse)(nil),              // 64: pfs_v2.CreateFileSetResponse
-	(*GetFileSetRequest)(nil),                  // 65: pfs_v2.GetFileSetRequest
-	(*AddFileSetRequest)(nil),                  // 66: pfs_v2.AddFileSetRequest
-	(*RenewFileSetRequest)(nil),                // 67: pfs_v2.RenewFileSetRequest
-	(*ComposeFileSetRequest)(nil),              // 68: pfs_v2.ComposeFileSetRequest
-	(*ShardFileSetRequest)(nil),                // 69: pfs_v2.ShardFileSetRequest
-	(*PathRange)(nil),                          // 70: pfs_v2.PathRange
-	(*ShardFileSetResponse)(nil),               // 71: pfs_v2.ShardFileSetResponse
-	(*CheckStorageRequest)(nil),                // 72: pfs_v2.CheckStorageRequest
-	(*CheckStorageResponse)(nil),               // 73: pfs_v2.CheckStorageResponse
-	(*PutCacheRequest)(nil),                    // 74: pfs_v2.PutCacheRequest
-	(*GetCacheRequest)(nil),                    // 75: pfs_v2.GetCacheRequest
-	(*GetCacheResponse)(nil),                   // 76: pfs_v2.GetCacheResponse
-	(*ClearCacheRequest)(nil),                  // 77: pfs_v2.ClearCacheRequest
-	(*ActivateAuthRequest)(nil),                // 78: pfs_v2.ActivateAuthRequest
-	(*ActivateAuthResponse)(nil),               // 79: pfs_v2.ActivateAuthResponse
-	(*ObjectStorageEgress)(nil),                // 80: pfs_v2.ObjectStorageEgress
-	(*SQLDatabaseEgress)(nil),                  // 81: pfs_v2.SQLDatabaseEgress
-	(*EgressRequest)(nil),                      // 82: pfs_v2.EgressRequest
-	(*EgressResponse)(nil),                     // 83: pfs_v2.EgressResponse
-	(*RepoInfo_Details)(nil),                   // 84: pfs_v2.RepoInfo.Details
-	(*CommitInfo_Details)(nil),                 // 85: pfs_v2.CommitInfo.Details
-	(*AddFile_URLSource)(nil),                  // 86: pfs_v2.AddFile.URLSource
-	(*SQLDatabaseEgress_FileFormat)(nil),       // 87: pfs_v2.SQLDatabaseEgress.FileFormat
-	(*SQLDatabaseEgress_Secret)(nil),           // 88: pfs_v2.SQLDatabaseEgress.Secret
-	(*EgressResponse_ObjectStorageResult)(nil), // 89: pfs_v2.EgressResponse.ObjectStorageResult
-	(*EgressResponse_SQLDatabaseResult)(nil),   // 90: pfs_v2.EgressResponse.SQLDatabaseResult
-	nil,                                        // 91: pfs_v2.EgressResponse.SQLDatabaseResult.RowsWrittenEntry
-	(*timestamppb.Timestamp)(nil),              // 92: google.protobuf.Timestamp
-	(auth.Permission)(0),                       // 93: auth_v2.Permission
-	(*wrapperspb.BytesValue)(nil),              // 94: google.protobuf.BytesValue
-	(*anypb.Any)(nil),                          // 95: google.protobuf.Any
-	(*durationpb.Duration)(nil),                // 96: google.protobuf.Duration
-	(*emptypb.Empty)(nil),                      // 97: google.protobuf.Empty
-	(*task.ListTaskRequest)(nil),               // 98: taskapi.ListTaskRequest
-	(*task.TaskInfo)(nil),                      // 99: taskapi.TaskInfo
+func (x *InspectStorageResponse) GetComputedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ComputedAt
+	}
+	return nil
 }
-var file_pfs_pfs_proto_depIdxs = []int32{
-	18,  // 0: pfs_v2.Repo.project:type_name -> pfs_v2.Project
-	5,   // 1: pfs_v2.Branch.repo:type_name -> pfs_v2.Repo
-	13,  // 2: pfs_v2.File.commit:type_name -> pfs_v2.Commit
-	5,   // 3: pfs_v2.RepoInfo.repo:type_name -> pfs_v2.Repo
-	92,  // 4: pfs_v2.RepoInfo.created:type_name -> google.protobuf.Timestamp
-	6,   // 5: pfs_v2.RepoInfo.branches:type_name -> pfs_v2.Branch
-	9,   // 6: pfs_v2.RepoInfo.auth_info:type_name -> pfs_v2.AuthInfo
-	84,  // 7: pfs_v2.RepoInfo.details:type_name -> pfs_v2.RepoInfo.Details
-	93,  // 8: pfs_v2.AuthInfo.permissions:type_name -> auth_v2.Permission
-	6,   // 9: pfs_v2.BranchInfo.branch:type_name -> pfs_v2.Branch
-	13,  // 10: pfs_v2.BranchInfo.head:type_name -> pfs_v2.Commit
-	6,   // 11: pfs_v2.BranchInfo.provenance:type_name -> pfs_v2.Branch
-	6,   // 12: pfs_v2.BranchInfo.subvenance:type_name -> pfs_v2.Branch
-	6,   // 13: pfs_v2.BranchInfo.direct_provenance:type_name -> pfs_v2.Branch
-	11,  // 14: pfs_v2.BranchInfo.trigger:type_name -> pfs_v2.Trigger
-	0,   // 15: pfs_v2.CommitOrigin.kind:type_name -> pfs_v2.OriginKind
-	5,   // 16: pfs_v2.Commit.repo:type_name -> pfs_v2.Repo
-	6,   // 17: pfs_v2.Commit.branch:type_name -> pfs_v2.Branch
-	13,  // 18: pfs_v2.CommitInfo.commit:type_name -> pfs_v2.Commit
-	12,  // 19: pfs_v2.CommitInfo.origin:type_name -> pfs_v2.CommitOrigin
-	13,  // 20: pfs_v2.CommitInfo.parent_commit:type_name -> pfs_v2.Commit
-	13,  // 21: pfs_v2.CommitInfo.child_commits:type_name -> pfs_v2.Commit
-	92,  // 22: pfs_v2.CommitInfo.started:type_name -> google.protobuf.Timestamp
-	92,  // 23: pfs_v2.CommitInfo.finishing:type_name -> google.protobuf.Timestamp
-	92,  // 24: pfs_v2.CommitInfo.finished:type_name -> google.protobuf.Timestamp
-	13,  // 25: pfs_v2.CommitInfo.direct_provenance:type_name -> pfs_v2.Commit
-	85,  // 26: pfs_v2.CommitInfo.details:type_name -> pfs_v2.CommitInfo.Details
-	15,  // 27: pfs_v2.CommitSetInfo.commit_set:type_name -> pfs_v2.CommitSet
-	14,  // 28: pfs_v2.CommitSetInfo.commits:type_name -> pfs_v2.CommitInfo
-	7,   // 29: pfs_v2.FileInfo.file:type_name -> pfs_v2.File
-	1,   // 30: pfs_v2.FileInfo.file_type:type_name -> pfs_v2.FileType
-	92,  // 31: pfs_v2.FileInfo.committed:type_name -> google.protobuf.Timestamp
-	18,  // 32: pfs_v2.ProjectInfo.project:type_name -> pfs_v2.Project
-	9,   // 33: pfs_v2.ProjectInfo.auth_info:type_name -> pfs_v2.AuthInfo
-	92,  // 34: pfs_v2.ProjectInfo.created_at:type_name -> google.protobuf.Timestamp
-	5,   // 35: pfs_v2.CreateRepoRequest.repo:type_name -> pfs_v2.Repo
-	5,   // 36: pfs_v2.InspectRepoRequest.repo:type_name -> pfs_v2.Repo
-	18,  // 37: pfs_v2.ListRepoRequest.projects:type_name -> pfs_v2.Project
-	5,   // 38: pfs_v2.DeleteRepoRequest.repo:type_name -> pfs_v2.Repo
-	18,  // 39: pfs_v2.DeleteReposRequest.projects:type_name -> pfs_v2.Project
-	5,   // 40: pfs_v2.DeleteReposResponse.repos:type_name -> pfs_v2.Repo
-	13,  // 41: pfs_v2.StartCommitRequest.parent:type_name -> pfs_v2.Commit
-	6,   // 42: pfs_v2.StartCommitRequest.branch:type_name -> pfs_v2.Branch
-	13,  // 43: pfs_v2.FinishCommitRequest.commit:type_name -> pfs_v2.Commit
-	13,  // 44: pfs_v2.InspectCommitRequest.commit:type_name -> pfs_v2.Commit
-	2,   // 45: pfs_v2.InspectCommitRequest.wait:type_name -> pfs_v2.CommitState
-	5,   // 46: pfs_v2.ListCommitRequest.repo:type_name -> pfs_v2.Repo
-	13,  // 47: pfs_v2.ListCommitRequest.from:type_name -> pfs_v2.Commit
-	13,  // 48: pfs_v2.ListCommitRequest.to:type_name -> pfs_v2.Commit
-	0,   // 49: pfs_v2.ListCommitRequest.origin_kind:type_name -> pfs_v2.OriginKind
-	92,  // 50: pfs_v2.ListCommitRequest.started_time:type_name -> google.protobuf.Timestamp
-	15,  // 51: pfs_v2.InspectCommitSetRequest.commit_set:type_name -> pfs_v2.CommitSet
-	18,  // 52: pfs_v2.ListCommitSetRequest.project:type_name -> pfs_v2.Project
-	15,  // 53: pfs_v2.SquashCommitSetRequest.commit_set:type_name -> pfs_v2.CommitSet
-	15,  // 54: pfs_v2.DropCommitSetRequest.commit_set:type_name -> pfs_v2.CommitSet
-	5,   // 55: pfs_v2.SubscribeCommitRequest.repo:type_name -> pfs_v2.Repo
-	13,  // 56: pfs_v2.SubscribeCommitRequest.from:type_name -> pfs_v2.Commit
-	2,   // 57: pfs_v2.SubscribeCommitRequest.state:type_name -> pfs_v2.CommitState
-	0,   // 58: pfs_v2.SubscribeCommitRequest.origin_kind:type_name -> pfs_v2.OriginKind
-	13,  // 59: pfs_v2.ClearCommitRequest.commit:type_name -> pfs_v2.Commit
-	13,  // 60: pfs_v2.SquashCommitRequest.commit:type_name -> pfs_v2.Commit
-	13,  // 61: pfs_v2.DropCommitRequest.commit:type_name -> pfs_v2.Commit
-	13,  // 62: pfs_v2.CreateBranchRequest.head:type_name -> pfs_v2.Commit
-	6,   // 63: pfs_v2.CreateBranchRequest.branch:type_name -> pfs_v2.Branch
-	6,   // 64: pfs_v2.CreateBranchRequest.provenance:type_name -> pfs_v2.Branch
-	11,  // 65: pfs_v2.CreateBranchRequest.trigger:type_name -> pfs_v2.Trigger
-	13,  // 66: pfs_v2.FindCommitsRequest.start:type_name -> pfs_v2.Commit
-	13,  // 67: pfs_v2.FindCommitsResponse.found_commit:type_name -> pfs_v2.Commit
-	13,  // 68: pfs_v2.FindCommitsResponse.last_searched_commit:type_name -> pfs_v2.Commit
-	6,   // 69: pfs_v2.InspectBranchRequest.branch:type_name -> pfs_v2.Branch
-	5,   // 70: pfs_v2.ListBranchRequest.repo:type_name -> pfs_v2.Repo
-	6,   // 71: pfs_v2.DeleteBranchRequest.branch:type_name -> pfs_v2.Branch
-	18,  // 72: pfs_v2.CreateProjectRequest.project:type_name -> pfs_v2.Project
-	18,  // 73: pfs_v2.InspectProjectRequest.project:type_name -> pfs_v2.Project
-	18,  // 74: pfs_v2.DeleteProjectRequest.project:type_name -> pfs_v2.Project
-	94,  // 75: pfs_v2.AddFile.raw:type_name -> google.protobuf.BytesValue
-	86,  // 76: pfs_v2.AddFile.url:type_name -> pfs_v2.AddFile.URLSource
-	7,   // 77: pfs_v2.CopyFile.src:type_name -> pfs_v2.File
-	13,  // 78: pfs_v2.ModifyFileRequest.set_commit:type_name -> pfs_v2.Commit
-	51,  // 79: pfs_v2.ModifyFileRequest.add_file:type_name -> pfs_v2.AddFile
-	52,  // 80: pfs_v2.ModifyFileRequest.delete_file:type_name -> pfs_v2.DeleteFile
-	53,  // 81: pfs_v2.ModifyFileRequest.copy_file:type_name -> pfs_v2.CopyFile
-	7,   // 82: pfs_v2.GetFileRequest.file:type_name -> pfs_v2.File
-	70,  // 83: pfs_v2.GetFileRequest.path_range:type_name -> pfs_v2.PathRange
-	7,   // 84: pfs_v2.InspectFileRequest.file:type_name -> pfs_v2.File
-	7,   // 85: pfs_v2.ListFileRequest.file:type_name -> pfs_v2.File
-	7,   // 86: pfs_v2.ListFileRequest.paginationMarker:type_name -> pfs_v2.File
-	7,   // 87: pfs_v2.WalkFileRequest.file:type_name -> pfs_v2.File
-	7,   // 88: pfs_v2.WalkFileRequest.paginationMarker:type_name -> pfs_v2.File
-	13,  // 89: pfs_v2.GlobFileRequest.commit:type_name -> pfs_v2.Commit
-	70,  // 90: pfs_v2.GlobFileRequest.path_range:type_name -> pfs_v2.PathRange
-	7,   // 91: pfs_v2.DiffFileRequest.new_file:type_name -> pfs_v2.File
-	7,   // 92: pfs_v2.DiffFileRequest.old_file:type_name -> pfs_v2.File
-	17,  // 93: pfs_v2.DiffFileResponse.new_file:type_name -> pfs_v2.FileInfo
-	17,  // 94: pfs_v2.DiffFileResponse.old_file:type_name -> pfs_v2.FileInfo
-	13,  // 95: pfs_v2.FsckRequest.zombie_target:type_name -> pfs_v2.Commit
-	13,  // 96: pfs_v2.GetFileSetRequest.commit:type_name -> pfs_v2.Commit
-	13,  // 97: pfs_v2.AddFileSetRequest.commit:type_name -> pfs_v2.Commit
-	70,  // 98: pfs_v2.ShardFileSetResponse.shards:type_name -> pfs_v2.PathRange
-	95,  // 99: pfs_v2.PutCacheRequest.value:type_name -> google.protobuf.Any
-	95,  // 100: pfs_v2.GetCacheResponse.value:type_name -> google.protobuf.Any
-	87,  // 101: pfs_v2.SQLDatabaseEgress.file_format:type_name -> pfs_v2.SQLDatabaseEgress.FileFormat
-	88,  // 102: pfs_v2.SQLDatabaseEgress.secret:type_name -> pfs_v2.SQLDatabaseEgress.Secret
-	13,  // 103: pfs_v2.EgressRequest.commit:type_name -> pfs_v2.Commit
-	80,  // 104: pfs_v2.EgressRequest.object_storage:type_name -> pfs_v2.ObjectStorageEgress
-	81,  // 105: pfs_v2.EgressRequest.sql_database:type_name -> pfs_v2.SQLDatabaseEgress
-	89,  // 106: pfs_v2.EgressResponse.object_storage:type_name -> pfs_v2.EgressResponse.ObjectStorageResult
-	90,  // 107: pfs_v2.EgressResponse.sql_database:type_name -> pfs_v2.EgressResponse.SQLDatabaseResult
-	96,  // 108: pfs_v2.CommitInfo.Details.compacting_time:type_name -> google.protobuf.Duration
-	96,  // 109: pfs_v2.CommitInfo.Details.validating_time:type_name -> google.protobuf.Duration
-	4,   // 110: pfs_v2.SQLDatabaseEgress.FileFormat.type:type_name -> pfs_v2.SQLDatabaseEgress.FileFormat.Type
-	91,  // 111: pfs_v2.EgressResponse.SQLDatabaseResult.rows_written:type_name -> pfs_v2.EgressResponse.SQLDatabaseResult.RowsWrittenEntry
-	20,  // 112: pfs_v2.API.CreateRepo:input_type -> pfs_v2.CreateRepoRequest
-	21,  // 113: pfs_v2.API.InspectRepo:input_type -> pfs_v2.InspectRepoRequest
-	22,  // 114: pfs_v2.API.ListRepo:input_type -> pfs_v2.ListRepoRequest
-	23,  // 115: pfs_v2.API.DeleteRepo:input_type -> pfs_v2.DeleteRepoRequest
-	24,  // 116: pfs_v2.API.DeleteRepos:input_type -> pfs_v2.DeleteReposRequest
-	27,  // 117: pfs_v2.API.StartCommit:input_type -> pfs_v2.StartCommitRequest
-	28,  // 118: pfs_v2.API.FinishCommit:input_type -> pfs_v2.FinishCommitRequest
-	36,  // 119: pfs_v2.API.ClearCommit:input_type -> pfs_v2.ClearCommitRequest
-	29,  // 120: pfs_v2.API.InspectCommit:input_type -> pfs_v2.InspectCommitRequest
-	30,  // 121: pfs_v2.API.ListCommit:input_type -> pfs_v2.ListCommitRequest
-	35,  // 122: pfs_v2.API.SubscribeCommit:input_type -> pfs_v2.SubscribeCommitRequest
-	37,  // 123: pfs_v2.API.SquashCommit:input_type -> pfs_v2.SquashCommitRequest
-	39,  // 124: pfs_v2.API.DropCommit:input_type -> pfs_v2.DropCommitRequest
-	31,  // 125: pfs_v2.API.InspectCommitSet:input_type -> pfs_v2.InspectCommitSetRequest
-	32,  // 126: pfs_v2.API.ListCommitSet:input_type -> pfs_v2.ListCommitSetRequest
-	33,  // 127: pfs_v2.API.SquashCommitSet:input_type -> pfs_v2.SquashCommitSetRequest
-	34,  // 128: pfs_v2.API.DropCommitSet:input_type -> pfs_v2.DropCommitSetRequest
-	42,  // 129: pfs_v2.API.FindCommits:input_type -> pfs_v2.FindCommitsRequest
-	41,  // 130: pfs_v2.API.CreateBranch:input_type -> pfs_v2.CreateBranchRequest
-	44,  // 131: pfs_v2.API.InspectBranch:input_type -> pfs_v2.InspectBranchRequest
-	45,  // 132: pfs_v2.API.ListBranch:input_type -> pfs_v2.ListBranchRequest
-	46,  // 133: pfs_v2.API.DeleteBranch:input_type -> pfs_v2.DeleteBranchRequest
-	54,  // 134: pfs_v2.API.ModifyFile:input_type -> pfs_v2.ModifyFileRequest
-	55,  // 135: pfs_v2.API.GetFile:input_type -> pfs_v2.GetFileRequest
-	55,  // 136: pfs_v2.API.GetFileTAR:input_type -> pfs_v2.GetFileRequest
-	56,  // 137: pfs_v2.API.InspectFile:input_type -> pfs_v2.InspectFileRequest
-	57,  // 138: pfs_v2.API.ListFile:input_type -> pfs_v2.ListFileRequest
-	58,  // 139: pfs_v2.API.WalkFile:input_type -> pfs_v2.WalkFileRequest
-	59,  // 140: pfs_v2.API.GlobFile:input_type -> pfs_v2.GlobFileRequest
-	60,  // 141: pfs_v2.API.DiffFile:input_type -> pfs_v2.DiffFileRequest
-	78,  // 142: pfs_v2.API.ActivateAuth:input_type -> pfs_v2.ActivateAuthRequest
-	97,  // 143: pfs_v2.API.DeleteAll:input_type -> google.protobuf.Empty
-	62,  // 144: pfs_v2.API.Fsck:input_type -> pfs_v2.FsckRequest
-	54,  // 145: pfs_v2.API.CreateFileSet:input_type -> pfs_v2.ModifyFileRequest
-	65,  // 146: pfs_v2.API.GetFileSet:input_type -> pfs_v2.GetFileSetRequest
-	66,  // 147: pfs_v2.API.AddFileSet:input_type -> pfs_v2.AddFileSetRequest
-	67,  // 148: pfs_v2.API.RenewFileSet:input_type -> pfs_v2.RenewFileSetRequest
-	68,  // 149: pfs_v2.API.ComposeFileSet:input_type -> pfs_v2.ComposeFileSetRequest
-	69,  // 150: pfs_v2.API.ShardFileSet:input_type -> pfs_v2.ShardFileSetRequest
-	72,  // 151: pfs_v2.API.CheckStorage:input_type -> pfs_v2.CheckStorageRequest
-	74,  // 152: pfs_v2.API.PutCache:input_type -> pfs_v2.PutCacheRequest
-	75,  // 153: pfs_v2.API.GetCache:input_type -> pfs_v2.GetCacheRequest
-	77,  // 154: pfs_v2.API.ClearCache:input_type -> pfs_v2.ClearCacheRequest
-	98,  // 155: pfs_v2.API.ListTask:input_type -> taskapi.ListTaskRequest
-	82,  // 156: pfs_v2.API.Egress:input_type -> pfs_v2.EgressRequest
-	47,  // 157: pfs_v2.API.CreateProject:input_type -> pfs_v2.CreateProjectRequest
-	48,  // 158: pfs_v2.API.InspectProject:input_type -> pfs_v2.InspectProjectRequest
-	49,  // 159: pfs_v2.API.ListProject:input_type -> pfs_v2.ListProjectRequest
-	50,  // 160: pfs_v2.API.DeleteProject:input_type -> pfs_v2.DeleteProjectRequest
-	97,  // 161: pfs_v2.API.CreateRepo:output_type -> google.protobuf.Empty
-	8,   // 162: pfs_v2.API.InspectRepo:output_type -> pfs_v2.RepoInfo
-	8,   // 163: pfs_v2.API.ListRepo:output_type -> pfs_v2.RepoInfo
-	25,  // 164: pfs_v2.API.DeleteRepo:output_type -> pfs_v2.DeleteRepoResponse
-	26,  // 165: pfs_v2.API.DeleteRepos:output_type -> pfs_v2.DeleteReposResponse
-	13,  // 166: pfs_v2.API.StartCommit:output_type -> pfs_v2.Commit
-	97,  // 167: pfs_v2.API.FinishCommit:output_type -> google.protobuf.Empty
-	97,  // 168: pfs_v2.API.ClearCommit:output_type -> google.protobuf.Empty
-	14,  // 169: pfs_v2.API.InspectCommit:output_type -> pfs_v2.CommitInfo
-	14,  // 170: pfs_v2.API.ListCommit:output_type -> pfs_v2.CommitInfo
-	14,  // 171: pfs_v2.API.SubscribeCommit:output_type -> pfs_v2.CommitInfo
-	38,  // 172: pfs_v2.API.SquashCommit:output_type -> pfs_v2.SquashCommitResponse
-	40,  // 173: pfs_v2.API.DropCommit:output_type -> pfs_v2.DropCommitResponse
-	14,  // 174: pfs_v2.API.InspectCommitSet:output_type -> pfs_v2.CommitInfo
-	16,  // 175: pfs_v2.API.ListCommitSet:output_type -> pfs_v2.CommitSetInfo
-	97,  // 176: pfs_v2.API.SquashCommitSet:output_type -> google.protobuf.Empty
-	97,  // 177: pfs_v2.API.DropCommitSet:output_type -> google.protobuf.Empty
-	43,  // 178: pfs_v2.API.FindCommits:output_type -> pfs_v2.FindCommitsResponse
-	97,  // 179: pfs_v2.API.CreateBranch:output_type -> google.protobuf.Empty
-	10,  // 180: pfs_v2.API.InspectBranch:output_type -> pfs_v2.BranchInfo
-	10,  // 181: pfs_v2.API.ListBranch:output_type -> pfs_v2.BranchInfo
-	97,  // 182: pfs_v2.API.DeleteBranch:output_type -> google.protobuf.Empty
-	97,  // 183: pfs_v2.API.ModifyFile:output_type -> google.protobuf.Empty
-	94,  // 184: pfs_v2.API.GetFile:output_type -> google.protobuf.BytesValue
-	94,  // 185: pfs_v2.API.GetFileTAR:output_type -> google.protobuf.BytesValue
-	17,  // 186: pfs_v2.API.InspectFile:output_type -> pfs_v2.FileInfo
-	17,  // 187: pfs_v2.API.ListFile:output_type -> pfs_v2.FileInfo
-	17,  // 188: pfs_v2.API.WalkFile:output_type -> pfs_v2.FileInfo
-	17,  // 189: pfs_v2.API.GlobFile:output_type -> pfs_v2.FileInfo
-	61,  // 190: pfs_v2.API.DiffFile:output_type -> pfs_v2.DiffFileResponse
-	79,  // 191: pfs_v2.API.ActivateAuth:output_type -> pfs_v2.ActivateAuthResponse
-	97,  // 192: pfs_v2.API.DeleteAll:output_type -> google.protobuf.Empty
-	63,  // 193: pfs_v2.API.Fsck:output_type -> pfs_v2.FsckResponse
-	64,  // 194: pfs_v2.API.CreateFileSet:output_type -> pfs_v2.CreateFileSetResponse
-	64,  // 195: pfs_v2.API.GetFileSet:output_type -> pfs_v2.CreateFileSetResponse
-	97,  // 196: pfs_v2.API.AddFileSet:output_type -> google.protobuf.Empty
-	97,  // 197: pfs_v2.API.RenewFileSet:output_type -> google.protobuf.Empty
-	64,  // 198: pfs_v2.API.ComposeFileSet:output_type -> pfs_v2.CreateFileSetResponse
-	71,  // 199: pfs_v2.API.ShardFileSet:output_type -> pfs_v2.ShardFileSetResponse
-	73,  // 200: pfs_v2.API.CheckStorage:output_type -> pfs_v2.CheckStorageResponse
-	97,  // 201: pfs_v2.API.PutCache:output_type -> google.protobuf.Empty
-	76,  // 202: pfs_v2.API.GetCache:output_type -> pfs_v2.GetCacheResponse
-	97,  // 203: pfs_v2.API.ClearCache:output_type -> google.protobuf.Empty
-	99,  // 204: pfs_v2.API.ListTask:output_type -> taskapi.TaskInfo
-	83,  // 205: pfs_v2.API.Egress:output_type -> pfs_v2.EgressResponse
-	97,  // 206: pfs_v2.API.CreateProject:output_type -> google.protobuf.Empty
-	19,  // 207: pfs_v2.API.InspectProject:output_type -> pfs_v2.ProjectInfo
-	19,  // 208: pfs_v2.API.ListProject:output_type -> pfs_v2.ProjectInfo
-	97,  // 209: pfs_v2.API.DeleteProject:output_type -> google.protobuf.Empty
-	161, // [161:210] is the sub-list for method output_type
-	112, // [112:161] is the sub-list for method input_type
-	112, // [112:112] is the sub-list for extension type_name
-	112, // [112:112] is the sub-list for extension extendee
-	0,   // [0:112] is the sub-list for field type_name
+
+type PutCacheRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key        string     `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value      *anypb.Any `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	FileSetIds []string   `protobuf:"bytes,3,rep,name=file_set_ids,json=fileSetIds,proto3" json:"file_set_ids,omitempty"`
+	Tag        string     `protobuf:"bytes,4,opt,name=tag,proto3" json:"tag,omitempty"`
 }
 
-func init() { file_pfs_pfs_proto_init() }
-func file_pfs_pfs_proto_init() {
-	if File_pfs_pfs_proto != nil {
-		return
+func (x *PutCacheRequest) Reset() {
+	*x = PutCacheRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfs_pfs_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PutCacheRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutCacheRequest) ProtoMessage() {}
+
+func (x *PutCacheRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutCacheRequest.ProtoReflect.Descriptor instead.
+func (*PutCacheRequest) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *PutCacheRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *PutCacheRequest) GetValue() *anypb.Any {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *PutCacheRequest) GetFileSetIds() []string {
+	if x != nil {
+		return x.FileSetIds
+	}
+	return nil
+}
+
+func (x *PutCacheRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+type GetCacheRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *GetCacheRequest) Reset() {
+	*x = GetCacheRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfs_pfs_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCacheRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCacheRequest) ProtoMessage() {}
+
+func (x *GetCacheRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCacheRequest.ProtoReflect.Descriptor instead.
+func (*GetCacheRequest) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *GetCacheRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type GetCacheResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value *anypb.Any `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *GetCacheResponse) Reset() {
+	*x = GetCacheResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfs_pfs_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCacheResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCacheResponse) ProtoMessage() {}
+
+func (x *GetCacheResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCacheResponse.ProtoReflect.Descriptor instead.
+func (*GetCacheResponse) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *GetCacheResponse) GetValue() *anypb.Any {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type ClearCacheRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TagPrefix string `protobuf:"bytes,1,opt,name=tag_prefix,json=tagPrefix,proto3" json:"tag_prefix,omitempty"`
+}
+
+func (x *ClearCacheRequest) Reset() {
+	*x = ClearCacheRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfs_pfs_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClearCacheRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearCacheRequest) ProtoMessage() {}
+
+func (x *ClearCacheRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearCacheRequest.ProtoReflect.Descriptor instead.
+func (*ClearCacheRequest) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *ClearCacheRequest) GetTagPrefix() string {
+	if x != nil {
+		return x.TagPrefix
+	}
+	return ""
+}
+
+type ActivateAuthRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ActivateAuthRequest) Reset() {
+	*x = ActivateAuthRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfs_pfs_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ActivateAuthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivateAuthRequest) ProtoMessage() {}
+
+func (x *ActivateAuthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivateAuthRequest.ProtoReflect.Descriptor instead.
+func (*ActivateAuthRequest) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{95}
+}
+
+type ActivateAuthResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ActivateAuthResponse) Reset() {
+	*x = ActivateAuthResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfs_pfs_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ActivateAuthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivateAuthResponse) ProtoMessage() {}
+
+func (x *ActivateAuthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivateAuthResponse.ProtoReflect.Descriptor instead.
+func (*ActivateAuthResponse) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{96}
+}
+
+type ObjectStorageEgress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+}
+
+func (x *ObjectStorageEgress) Reset() {
+	*x = ObjectStorageEgress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfs_pfs_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ObjectStorageEgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ObjectStorageEgress) ProtoMessage() {}
+
+func (x *ObjectStorageEgress) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ObjectStorageEgress.ProtoReflect.Descriptor instead.
+func (*ObjectStorageEgress) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *ObjectStorageEgress) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type SQLDatabaseEgress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url        string                        `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	FileFormat *SQLDatabaseEgress_FileFormat `protobuf:"bytes,2,opt,name=file_format,json=fileFormat,proto3" json:"file_format,omitempty"`
+	Secret     *SQLDatabaseEgress_Secret     `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
+	Mode       SQLDatabaseEgress_Mode        `protobuf:"varint,4,opt,name=mode,proto3,enum=pfs_v2.SQLDatabaseEgress_Mode" json:"mode,omitempty"`
+	// primary_keys maps table names to the columns which uniquely identify their
+	// rows.  Required for every table written to in UPSERT mode.
+	PrimaryKeys map[string]*SQLDatabaseEgress_PrimaryKey `protobuf:"bytes,5,rep,name=primary_keys,json=primaryKeys,proto3" json:"primary_keys,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *SQLDatabaseEgress) Reset() {
+	*x = SQLDatabaseEgress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfs_pfs_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SQLDatabaseEgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SQLDatabaseEgress) ProtoMessage() {}
+
+func (x *SQLDatabaseEgress) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SQLDatabaseEgress.ProtoReflect.Descriptor instead.
+func (*SQLDatabaseEgress) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *SQLDatabaseEgress) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *SQLDatabaseEgress) GetFileFormat() *SQLDatabaseEgress_FileFormat {
+	if x != nil {
+		return x.FileFormat
+	}
+	return nil
+}
+
+func (x *SQLDatabaseEgress) GetSecret() *SQLDatabaseEgress_Secret {
+	if x != nil {
+		return x.Secret
+	}
+	return nil
+}
+
+func (x *SQLDatabaseEgress) GetMode() SQLDatabaseEgress_Mode {
+	if x != nil {
+		return x.Mode
+	}
+	return SQLDatabaseEgress_REPLACE
+}
+
+func (x *SQLDatabaseEgress) GetPrimaryKeys() map[string]*SQLDatabaseEgress_PrimaryKey {
+	if x != nil {
+		return x.PrimaryKeys
+	}
+	return nil
+}
+
+type EgressRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Commit *Commit `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"`
+	// Types that are assignable to Target:
+	//
+	//	*EgressRequest_ObjectStorage
+	//	*EgressRequest_SqlDatabase
+	Target isEgressRequest_Target `protobuf_oneof:"target"`
+}
+
+func (x *EgressRequest) Reset() {
+	*x = EgressRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfs_pfs_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EgressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EgressRequest) ProtoMessage() {}
+
+func (x *EgressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EgressRequest.ProtoReflect.Descriptor instead.
+func (*EgressRequest) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *EgressRequest) GetCommit() *Commit {
+	if x != nil {
+		return x.Commit
+	}
+	return nil
+}
+
+func (m *EgressRequest) GetTarget() isEgressRequest_Target {
+	if m != nil {
+		return m.Target
+	}
+	return nil
+}
+
+func (x *EgressRequest) GetObjectStorage() *ObjectStorageEgress {
+	if x, ok := x.GetTarget().(*EgressRequest_ObjectStorage); ok {
+		return x.ObjectStorage
+	}
+	return nil
+}
+
+func (x *EgressRequest) GetSqlDatabase() *SQLDatabaseEgress {
+	if x, ok := x.GetTarget().(*EgressRequest_SqlDatabase); ok {
+		return x.SqlDatabase
+	}
+	return nil
+}
+
+type isEgressRequest_Target interface {
+	isEgressRequest_Target()
+}
+
+type EgressRequest_ObjectStorage struct {
+	ObjectStorage *ObjectStorageEgress `protobuf:"bytes,2,opt,name=object_storage,json=objectStorage,proto3,oneof"`
+}
+
+type EgressRequest_SqlDatabase struct {
+	SqlDatabase *SQLDatabaseEgress `protobuf:"bytes,3,opt,name=sql_database,json=sqlDatabase,proto3,oneof"`
+}
+
+func (*EgressRequest_ObjectStorage) isEgressRequest_Target() {}
+
+func (*EgressRequest_SqlDatabase) isEgressRequest_Target() {}
+
+type EgressResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Result:
+	//
+	//	*EgressResponse_ObjectStorage
+	//	*EgressResponse_SqlDatabase
+	Result isEgressResponse_Result `protobuf_oneof:"result"`
+}
+
+func (x *EgressResponse) Reset() {
+	*x = EgressResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfs_pfs_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EgressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EgressResponse) ProtoMessage() {}
+
+func (x *EgressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EgressResponse.ProtoReflect.Descriptor instead.
+func (*EgressResponse) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{100}
+}
+
+func (m *EgressResponse) GetResult() isEgressResponse_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *EgressResponse) GetObjectStorage() *EgressResponse_ObjectStorageResult {
+	if x, ok := x.GetResult().(*EgressResponse_ObjectStorage); ok {
+		return x.ObjectStorage
+	}
+	return nil
+}
+
+func (x *EgressResponse) GetSqlDatabase() *EgressResponse_SQLDatabaseResult {
+	if x, ok := x.GetResult().(*EgressResponse_SqlDatabase); ok {
+		return x.SqlDatabase
+	}
+	return nil
+}
+
+type isEgressResponse_Result interface {
+	isEgressResponse_Result()
+}
+
+type EgressResponse_ObjectStorage struct {
+	ObjectStorage *EgressResponse_ObjectStorageResult `protobuf:"bytes,1,opt,name=object_storage,json=objectStorage,proto3,oneof"`
+}
+
+type EgressResponse_SqlDatabase struct {
+	SqlDatabase *EgressResponse_SQLDatabaseResult `protobuf:"bytes,2,opt,name=sql_database,json=sqlDatabase,proto3,oneof"`
+}
+
+func (*EgressResponse_ObjectStorage) isEgressResponse_Result() {}
+
+func (*EgressResponse_SqlDatabase) isEgressResponse_Result() {}
+
+// Details are only provided when explicitly requested
+type RepoInfo_Details struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SizeBytes int64 `protobuf:"varint,1,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+}
+
+func (x *RepoInfo_Details) Reset() {
+	*x = RepoInfo_Details{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfs_pfs_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepoInfo_Details) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepoInfo_Details) ProtoMessage() {}
+
+func (x *RepoInfo_Details) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepoInfo_Details.ProtoReflect.Descriptor instead.
+func (*RepoInfo_Details) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{4, 0}
+}
+
+func (x *RepoInfo_Details) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+// Details are only provided when explicitly requested
+type CommitInfo_Details struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SizeBytes      int64                `protobuf:"varint,1,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	CompactingTime *durationpb.Duration `protobuf:"bytes,2,opt,name=compacting_time,json=compactingTime,proto3" json:"compacting_time,omitempty"`
+	ValidatingTime *durationpb.Duration `protobuf:"bytes,3,opt,name=validating_time,json=validatingTime,proto3" json:"validating_time,omitempty"`
+}
+
+func (x *CommitInfo_Details) Reset() {
+	*x = CommitInfo_Details{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfs_pfs_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommitInfo_Details) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitInfo_Details) ProtoMessage() {}
+
+func (x *CommitInfo_Details) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitInfo_Details.ProtoReflect.Descriptor instead.
+func (*CommitInfo_Details) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{11, 0}
+}
+
+func (x *CommitInfo_Details) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *CommitInfo_Details) GetCompactingTime() *durationpb.Duration {
+	if x != nil {
+		return x.CompactingTime
+	}
+	return nil
+}
+
+func (x *CommitInfo_Details) GetValidatingTime() *durationpb.Duration {
+	if x != nil {
+		return x.ValidatingTime
+	}
+	return nil
+}
+
+type EditMetadataRequest_Edit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Target:
+	//	*EditMetadataRequest_Edit_Project
+	//	*EditMetadataRequest_Edit_Repo
+	//	*EditMetadataRequest_Edit_Branch
+	//	*EditMetadataRequest_Edit_Commit
+	Target isEditMetadataRequest_Edit_Target `protobuf_oneof:"target"`
+	// Types that are assignable to Op:
+	//	*EditMetadataRequest_Edit_Replace_
+	//	*EditMetadataRequest_Edit_AddKey_
+	//	*EditMetadataRequest_Edit_EditKey_
+	//	*EditMetadataRequest_Edit_DeleteKey_
+	Op isEditMetadataRequest_Edit_Op `protobuf_oneof:"op"`
+}
+
+func (x *EditMetadataRequest_Edit) Reset() {
+	*x = EditMetadataRequest_Edit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfs_pfs_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EditMetadataRequest_Edit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditMetadataRequest_Edit) ProtoMessage() {}
+
+func (x *EditMetadataRequest_Edit) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditMetadataRequest_Edit.ProtoReflect.Descriptor instead.
+func (*EditMetadataRequest_Edit) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{53, 0}
+}
+
+func (m *EditMetadataRequest_Edit) GetTarget() isEditMetadataRequest_Edit_Target {
+	if m != nil {
+		return m.Target
+	}
+	return nil
+}
+
+func (x *EditMetadataRequest_Edit) GetProject() *Project {
+	if x, ok := x.GetTarget().(*EditMetadataRequest_Edit_Project); ok {
+		return x.Project
+	}
+	return nil
+}
+
+func (x *EditMetadataRequest_Edit) GetRepo() *Repo {
+	if x, ok := x.GetTarget().(*EditMetadataRequest_Edit_Repo); ok {
+		return x.Repo
+	}
+	return nil
+}
+
+func (x *EditMetadataRequest_Edit) GetBranch() *Branch {
+	if x, ok := x.GetTarget().(*EditMetadataRequest_Edit_Branch); ok {
+		return x.Branch
+	}
+	return nil
+}
+
+func (x *EditMetadataRequest_Edit) GetCommit() *Commit {
+	if x, ok := x.GetTarget().(*EditMetadataRequest_Edit_Commit); ok {
+		return x.Commit
+	}
+	return nil
+}
+
+func (m *EditMetadataRequest_Edit) GetOp() isEditMetadataRequest_Edit_Op {
+	if m != nil {
+		return m.Op
+	}
+	return nil
+}
+
+func (x *EditMetadataRequest_Edit) GetReplace() *EditMetadataRequest_Edit_Replace {
+	if x, ok := x.GetOp().(*EditMetadataRequest_Edit_Replace_); ok {
+		return x.Replace
+	}
+	return nil
+}
+
+func (x *EditMetadataRequest_Edit) GetAddKey() *EditMetadataRequest_Edit_AddKey {
+	if x, ok := x.GetOp().(*EditMetadataRequest_Edit_AddKey_); ok {
+		return x.AddKey
+	}
+	return nil
+}
+
+func (x *EditMetadataRequest_Edit) GetEditKey() *EditMetadataRequest_Edit_EditKey {
+	if x, ok := x.GetOp().(*EditMetadataRequest_Edit_EditKey_); ok {
+		return x.EditKey
+	}
+	return nil
+}
+
+func (x *EditMetadataRequest_Edit) GetDeleteKey() *EditMetadataRequest_Edit_DeleteKey {
+	if x, ok := x.GetOp().(*EditMetadataRequest_Edit_DeleteKey_); ok {
+		return x.DeleteKey
+	}
+	return nil
+}
+
+type isEditMetadataRequest_Edit_Target interface {
+	isEditMetadataRequest_Edit_Target()
+}
+
+type EditMetadataRequest_Edit_Project struct {
+	Project *Project `protobuf:"bytes,1,opt,name=project,proto3,oneof"`
+}
+
+type EditMetadataRequest_Edit_Repo struct {
+	Repo *Repo `protobuf:"bytes,2,opt,name=repo,proto3,oneof"`
+}
+
+type EditMetadataRequest_Edit_Branch struct {
+	Branch *Branch `protobuf:"bytes,3,opt,name=branch,proto3,oneof"`
+}
+
+type EditMetadataRequest_Edit_Commit struct {
+	Commit *Commit `protobuf:"bytes,4,opt,name=commit,proto3,oneof"`
+}
+
+func (*EditMetadataRequest_Edit_Project) isEditMetadataRequest_Edit_Target() {}
+
+func (*EditMetadataRequest_Edit_Repo) isEditMetadataRequest_Edit_Target() {}
+
+func (*EditMetadataRequest_Edit_Branch) isEditMetadataRequest_Edit_Target() {}
+
+func (*EditMetadataRequest_Edit_Commit) isEditMetadataRequest_Edit_Target() {}
+
+type isEditMetadataRequest_Edit_Op interface {
+	isEditMetadataRequest_Edit_Op()
+}
+
+type EditMetadataRequest_Edit_Replace_ struct {
+	Replace *EditMetadataRequest_Edit_Replace `protobuf:"bytes,10,opt,name=replace,proto3,oneof"`
+}
+
+type EditMetadataRequest_Edit_AddKey_ struct {
+	AddKey *EditMetadataRequest_Edit_AddKey `protobuf:"bytes,11,opt,name=add_key,json=addKey,proto3,oneof"`
+}
+
+type EditMetadataRequest_Edit_EditKey_ struct {
+	EditKey *EditMetadataRequest_Edit_EditKey `protobuf:"bytes,12,opt,name=edit_key,json=editKey,proto3,oneof"`
+}
+
+type EditMetadataRequest_Edit_DeleteKey_ struct {
+	DeleteKey *EditMetadataRequest_Edit_DeleteKey `protobuf:"bytes,13,opt,name=delete_key,json=deleteKey,proto3,oneof"`
+}
+
+func (*EditMetadataRequest_Edit_Replace_) isEditMetadataRequest_Edit_Op() {}
+
+func (*EditMetadataRequest_Edit_AddKey_) isEditMetadataRequest_Edit_Op() {}
+
+func (*EditMetadataRequest_Edit_EditKey_) isEditMetadataRequest_Edit_Op() {}
+
+func (*EditMetadataRequest_Edit_DeleteKey_) isEditMetadataRequest_Edit_Op() {}
+
+// Replace replaces all of the target's metadata.
+type EditMetadataRequest_Edit_Replace struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Replacement map[string]string `protobuf:"bytes,1,rep,name=replacement,proto3" json:"replacement,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *EditMetadataRequest_Edit_Replace) Reset() {
+	*x = EditMetadataRequest_Edit_Replace{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfs_pfs_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EditMetadataRequest_Edit_Replace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditMetadataRequest_Edit_Replace) ProtoMessage() {}
+
+func (x *EditMetadataRequest_Edit_Replace) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditMetadataRequest_Edit_Replace.ProtoReflect.Descriptor instead.
+func (*EditMetadataRequest_Edit_Replace) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{53, 0, 0}
+}
+
+func (x *EditMetadataRequest_Edit_Replace) GetReplacement() map[string]string {
+	if x != nil {
+		return x.Replacement
+	}
+	return nil
+}
+
+// AddKey adds a key that must not already exist.
+type EditMetadataRequest_Edit_AddKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *EditMetadataRequest_Edit_AddKey) Reset() {
+	*x = EditMetadataRequest_Edit_AddKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfs_pfs_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EditMetadataRequest_Edit_AddKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditMetadataRequest_Edit_AddKey) ProtoMessage() {}
+
+func (x *EditMetadataRequest_Edit_AddKey) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditMetadataRequest_Edit_AddKey.ProtoReflect.Descriptor instead.
+func (*EditMetadataRequest_Edit_AddKey) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{53, 0, 1}
+}
+
+func (x *EditMetadataRequest_Edit_AddKey) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *EditMetadataRequest_Edit_AddKey) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// EditKey sets a key, adding it if it doesn't already exist.
+type EditMetadataRequest_Edit_EditKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *EditMetadataRequest_Edit_EditKey) Reset() {
+	*x = EditMetadataRequest_Edit_EditKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfs_pfs_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EditMetadataRequest_Edit_EditKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditMetadataRequest_Edit_EditKey) ProtoMessage() {}
+
+func (x *EditMetadataRequest_Edit_EditKey) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditMetadataRequest_Edit_EditKey.ProtoReflect.Descriptor instead.
+func (*EditMetadataRequest_Edit_EditKey) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{53, 0, 2}
+}
+
+func (x *EditMetadataRequest_Edit_EditKey) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *EditMetadataRequest_Edit_EditKey) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// DeleteKey removes a key, if it exists.
+type EditMetadataRequest_Edit_DeleteKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *EditMetadataRequest_Edit_DeleteKey) Reset() {
+	*x = EditMetadataRequest_Edit_DeleteKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfs_pfs_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EditMetadataRequest_Edit_DeleteKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditMetadataRequest_Edit_DeleteKey) ProtoMessage() {}
+
+func (x *EditMetadataRequest_Edit_DeleteKey) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditMetadataRequest_Edit_DeleteKey.ProtoReflect.Descriptor instead.
+func (*EditMetadataRequest_Edit_DeleteKey) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{53, 0, 3}
+}
+
+func (x *EditMetadataRequest_Edit_DeleteKey) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type AddFile_URLSource struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	URL         string `protobuf:"bytes,1,opt,name=URL,proto3" json:"URL,omitempty"`
+	Recursive   bool   `protobuf:"varint,2,opt,name=recursive,proto3" json:"recursive,omitempty"`
+	Concurrency uint32 `protobuf:"varint,3,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
+}
+
+func (x *AddFile_URLSource) Reset() {
+	*x = AddFile_URLSource{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfs_pfs_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddFile_URLSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddFile_URLSource) ProtoMessage() {}
+
+func (x *AddFile_URLSource) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddFile_URLSource.ProtoReflect.Descriptor instead.
+func (*AddFile_URLSource) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{61, 0}
+}
+
+func (x *AddFile_URLSource) GetURL() string {
+	if x != nil {
+		return x.URL
+	}
+	return ""
+}
+
+func (x *AddFile_URLSource) GetRecursive() bool {
+	if x != nil {
+		return x.Recursive
+	}
+	return false
+}
+
+func (x *AddFile_URLSource) GetConcurrency() uint32 {
+	if x != nil {
+		return x.Concurrency
+	}
+	return 0
+}
+
+type SQLDatabaseEgress_FileFormat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type    SQLDatabaseEgress_FileFormat_Type `protobuf:"varint,1,opt,name=type,proto3,enum=pfs_v2.SQLDatabaseEgress_FileFormat_Type" json:"type,omitempty"`
+	Columns []string                          `protobuf:"bytes,2,rep,name=columns,proto3" json:"columns,omitempty"`
+}
+
+func (x *SQLDatabaseEgress_FileFormat) Reset() {
+	*x = SQLDatabaseEgress_FileFormat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfs_pfs_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SQLDatabaseEgress_FileFormat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SQLDatabaseEgress_FileFormat) ProtoMessage() {}
+
+func (x *SQLDatabaseEgress_FileFormat) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SQLDatabaseEgress_FileFormat.ProtoReflect.Descriptor instead.
+func (*SQLDatabaseEgress_FileFormat) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{98, 0}
+}
+
+func (x *SQLDatabaseEgress_FileFormat) GetType() SQLDatabaseEgress_FileFormat_Type {
+	if x != nil {
+		return x.Type
+	}
+	return SQLDatabaseEgress_FileFormat_UNKNOWN
+}
+
+func (x *SQLDatabaseEgress_FileFormat) GetColumns() []string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+type SQLDatabaseEgress_Secret struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Key  string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *SQLDatabaseEgress_Secret) Reset() {
+	*x = SQLDatabaseEgress_Secret{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfs_pfs_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SQLDatabaseEgress_Secret) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SQLDatabaseEgress_Secret) ProtoMessage() {}
+
+func (x *SQLDatabaseEgress_Secret) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SQLDatabaseEgress_Secret.ProtoReflect.Descriptor instead.
+func (*SQLDatabaseEgress_Secret) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{98, 1}
+}
+
+func (x *SQLDatabaseEgress_Secret) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SQLDatabaseEgress_Secret) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type SQLDatabaseEgress_PrimaryKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Columns []string `protobuf:"bytes,1,rep,name=columns,proto3" json:"columns,omitempty"`
+}
+
+func (x *SQLDatabaseEgress_PrimaryKey) Reset() {
+	*x = SQLDatabaseEgress_PrimaryKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfs_pfs_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SQLDatabaseEgress_PrimaryKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SQLDatabaseEgress_PrimaryKey) ProtoMessage() {}
+
+func (x *SQLDatabaseEgress_PrimaryKey) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SQLDatabaseEgress_PrimaryKey.ProtoReflect.Descriptor instead.
+func (*SQLDatabaseEgress_PrimaryKey) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{98, 2}
+}
+
+func (x *SQLDatabaseEgress_PrimaryKey) GetColumns() []string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+type EgressResponse_ObjectStorageResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BytesWritten int64 `protobuf:"varint,1,opt,name=bytes_written,json=bytesWritten,proto3" json:"bytes_written,omitempty"`
+}
+
+func (x *EgressResponse_ObjectStorageResult) Reset() {
+	*x = EgressResponse_ObjectStorageResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfs_pfs_proto_msgTypes[123]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EgressResponse_ObjectStorageResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EgressResponse_ObjectStorageResult) ProtoMessage() {}
+
+func (x *EgressResponse_ObjectStorageResult) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[123]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EgressResponse_ObjectStorageResult.ProtoReflect.Descriptor instead.
+func (*EgressResponse_ObjectStorageResult) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{100, 0}
+}
+
+func (x *EgressResponse_ObjectStorageResult) GetBytesWritten() int64 {
+	if x != nil {
+		return x.BytesWritten
+	}
+	return 0
+}
+
+type EgressResponse_SQLDatabaseResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RowsWritten  map[string]int64 `protobuf:"bytes,1,rep,name=rows_written,json=rowsWritten,proto3" json:"rows_written,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	RowsInserted map[string]int64 `protobuf:"bytes,2,rep,name=rows_inserted,json=rowsInserted,proto3" json:"rows_inserted,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	RowsUpdated  map[string]int64 `protobuf:"bytes,3,rep,name=rows_updated,json=rowsUpdated,proto3" json:"rows_updated,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	RowsDeleted  map[string]int64 `protobuf:"bytes,4,rep,name=rows_deleted,json=rowsDeleted,proto3" json:"rows_deleted,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// base_commit is the previously egressed commit that changes were computed
+	// against.  It is unset if the tables were reloaded from scratch.
+	BaseCommit *Commit `protobuf:"bytes,5,opt,name=base_commit,json=baseCommit,proto3" json:"base_commit,omitempty"`
+}
+
+func (x *EgressResponse_SQLDatabaseResult) Reset() {
+	*x = EgressResponse_SQLDatabaseResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfs_pfs_proto_msgTypes[124]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EgressResponse_SQLDatabaseResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EgressResponse_SQLDatabaseResult) ProtoMessage() {}
+
+func (x *EgressResponse_SQLDatabaseResult) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[124]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EgressResponse_SQLDatabaseResult.ProtoReflect.Descriptor instead.
+func (*EgressResponse_SQLDatabaseResult) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{100, 1}
+}
+
+func (x *EgressResponse_SQLDatabaseResult) GetRowsWritten() map[string]int64 {
+	if x != nil {
+		return x.RowsWritten
+	}
+	return nil
+}
+
+func (x *EgressResponse_SQLDatabaseResult) GetRowsInserted() map[string]int64 {
+	if x != nil {
+		return x.RowsInserted
+	}
+	return nil
+}
+
+func (x *EgressResponse_SQLDatabaseResult) GetRowsUpdated() map[string]int64 {
+	if x != nil {
+		return x.RowsUpdated
+	}
+	return nil
+}
+
+func (x *EgressResponse_SQLDatabaseResult) GetRowsDeleted() map[string]int64 {
+	if x != nil {
+		return x.RowsDeleted
+	}
+	return nil
+}
+
+func (x *EgressResponse_SQLDatabaseResult) GetBaseCommit() *Commit {
+	if x != nil {
+		return x.BaseCommit
+	}
+	return nil
+}
+
+var File_pfs_pfs_proto protoreflect.FileDescriptor
+
+var file_pfs_pfs_proto_rawDesc = []byte{
+	0x0a, 0x0d, 0x70, 0x66, 0x73, 0x2f, 0x70, 0x66, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x06, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x0f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x0f, 0x74, 0x61, 0x73, 0x6b, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0x59, 0x0a, 0x04, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x3e, 0x0a,
+	0x06, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x20, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52,
+	0x65, 0x70, 0x6f, 0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3b, 0x0a,
+	0x03, 0x54, 0x61, 0x67, 0x12, 0x20, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x70, 0x6f,
+	0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x58, 0x0a, 0x04, 0x46, 0x69,
+	0x6c, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x14,
+	0x0a, 0x05, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64,
+	0x61, 0x74, 0x75, 0x6d, 0x22, 0xaf, 0x04, 0x0a, 0x08, 0x52, 0x65, 0x70, 0x6f, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x20, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x04, 0x72,
+	0x65, 0x70, 0x6f, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x33, 0x0a, 0x16, 0x73, 0x69, 0x7a,
+	0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x75, 0x70, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x6f,
+	0x75, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x73, 0x69, 0x7a, 0x65, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x55, 0x70, 0x70, 0x65, 0x72, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x20,
+	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x2a, 0x0a, 0x08, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x42, 0x72, 0x61, 0x6e,
+	0x63, 0x68, 0x52, 0x08, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x09,
+	0x61, 0x75, 0x74, 0x68, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x32, 0x0a, 0x07, 0x64,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70,
+	0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12,
+	0x3a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x08, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x49,
+	0x6e, 0x66, 0x6f, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x42, 0x0a, 0x10, 0x72,
+	0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52,
+	0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0f,
+	0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x1a,
+	0x28, 0x0a, 0x07, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69,
+	0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x57, 0x0a, 0x08, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x35, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76,
+	0x32, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x70, 0x65,
+	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x6c,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x22,
+	0xdf, 0x03, 0x0a, 0x0a, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x26,
+	0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x06,
+	0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x22, 0x0a, 0x04, 0x68, 0x65, 0x61, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x52, 0x04, 0x68, 0x65, 0x61, 0x64, 0x12, 0x2e, 0x0a, 0x0a, 0x70, 0x72,
+	0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x0a,
+	0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x2e, 0x0a, 0x0a, 0x73, 0x75,
+	0x62, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x0a,
+	0x73, 0x75, 0x62, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x3b, 0x0a, 0x11, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x42,
+	0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x10, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x50, 0x72, 0x6f,
+	0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x74, 0x72, 0x69, 0x67, 0x67,
+	0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76,
+	0x32, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x07, 0x74, 0x72, 0x69, 0x67, 0x67,
+	0x65, 0x72, 0x12, 0x3c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x07,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x42, 0x72,
+	0x61, 0x6e, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x42, 0x0a, 0x10, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x66, 0x73,
+	0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x52, 0x0f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0x82, 0x02, 0x0a, 0x0f, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x6b, 0x65, 0x65, 0x70, 0x5f, 0x6c, 0x61,
+	0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6b, 0x65, 0x65, 0x70, 0x4c, 0x61,
+	0x73, 0x74, 0x12, 0x41, 0x0a, 0x0f, 0x6b, 0x65, 0x65, 0x70, 0x5f, 0x6e, 0x65, 0x77, 0x65, 0x72,
+	0x5f, 0x74, 0x68, 0x61, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x6b, 0x65, 0x65, 0x70, 0x4e, 0x65, 0x77, 0x65,
+	0x72, 0x54, 0x68, 0x61, 0x6e, 0x12, 0x4e, 0x0a, 0x0d, 0x6b, 0x65, 0x65, 0x70, 0x5f, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x70,
+	0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x4b, 0x65, 0x65, 0x70, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x6b, 0x65, 0x65, 0x70, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x3f, 0x0a, 0x11, 0x4b, 0x65, 0x65, 0x70, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xa6, 0x01, 0x0a, 0x07, 0x54, 0x72, 0x69, 0x67, 0x67,
+	0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6c,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x61, 0x6c, 0x6c, 0x12, 0x26, 0x0a, 0x0f,
+	0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x53, 0x70, 0x65, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x72, 0x6f, 0x6e, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x72, 0x6f, 0x6e, 0x53, 0x70, 0x65, 0x63, 0x22,
+	0x36, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12,
+	0x26, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e,
+	0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x4b, 0x69, 0x6e,
+	0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x22, 0x62, 0x0a, 0x06, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x12, 0x20, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x04, 0x72,
+	0x65, 0x70, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x26, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x42, 0x72, 0x61,
+	0x6e, 0x63, 0x68, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x22, 0x88, 0x07, 0x0a, 0x0a,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x26, 0x0a, 0x06, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73,
+	0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x12, 0x2c, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e,
+	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x0d, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f,
+	0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x0c, 0x70, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x33, 0x0a, 0x0d, 0x63, 0x68, 0x69, 0x6c, 0x64,
+	0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x0c,
+	0x63, 0x68, 0x69, 0x6c, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x34, 0x0a, 0x07,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x65, 0x64, 0x12, 0x38, 0x0a, 0x09, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x12, 0x36, 0x0a, 0x08,
+	0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x66, 0x69, 0x6e, 0x69,
+	0x73, 0x68, 0x65, 0x64, 0x12, 0x3b, 0x0a, 0x11, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x70,
+	0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52,
+	0x10, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x33, 0x0a, 0x16, 0x73, 0x69, 0x7a, 0x65, 0x5f,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x75, 0x70, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x6f, 0x75, 0x6e,
+	0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x55, 0x70, 0x70, 0x65, 0x72, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x34, 0x0a, 0x07,
+	0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x6e, 0x66,
+	0x6f, 0x2e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x73, 0x12, 0x3c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0e,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x1a, 0xb0, 0x01, 0x0a, 0x07, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x42, 0x0a, 0x0f, 0x63,
+	0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x42, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x54,
+	0x69, 0x6d, 0x65, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x4a, 0x04, 0x08, 0x09, 0x10, 0x0a, 0x22, 0x1b, 0x0a, 0x09, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x53, 0x65, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x6f, 0x0a, 0x0d, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x65, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x30, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x73,
+	0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76,
+	0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x65, 0x74, 0x52, 0x09, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x53, 0x65, 0x74, 0x12, 0x2c, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32,
+	0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x73, 0x22, 0xe4, 0x02, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x20, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x04, 0x66,
+	0x69, 0x6c, 0x65, 0x12, 0x2d, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e,
+	0x46, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x68,
+	0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12,
+	0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x3a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x07,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x46, 0x69,
+	0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x3b,
+	0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x1d, 0x0a, 0x07, 0x50,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xc0, 0x02, 0x0a, 0x0b, 0x50,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x29, 0x0a, 0x07, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x66,
+	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x07, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x5f,
+	0x69, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x66, 0x73,
+	0x5f, 0x76, 0x32, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x61, 0x75,
+	0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x3d, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x6f, 0x0a,
+	0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x20, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x04,
+	0x72, 0x65, 0x70, 0x6f, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x22, 0x36,
+	0x0a, 0x12, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x70, 0x6f,
+	0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x22, 0xd2, 0x01, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2b,
+	0x0a, 0x08, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x41, 0x0a, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e,
+	0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x3b,
+	0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x4b, 0x0a, 0x11, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x20, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x04, 0x72, 0x65,
+	0x70, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x22, 0x69, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b,
+	0x0a, 0x08, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x66,
+	0x6f, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6c, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03,
+	0x61, 0x6c, 0x6c, 0x22, 0x2e, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x70,
+	0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x22, 0x39, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x70,
+	0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x05, 0x72, 0x65,
+	0x70, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x66, 0x73, 0x5f,
+	0x76, 0x32, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x05, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x22, 0x86,
+	0x01, 0x0a, 0x12, 0x53, 0x74, 0x61, 0x72, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x20, 0x0a,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x26, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52,
+	0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x22, 0x8b, 0x01, 0x0a, 0x13, 0x46, 0x69, 0x6e, 0x69,
+	0x73, 0x68, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x26, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52,
+	0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
+	0x66, 0x6f, 0x72, 0x63, 0x65, 0x22, 0x67, 0x0a, 0x14, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a,
+	0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x06, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x27, 0x0a, 0x04, 0x77, 0x61, 0x69, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x04, 0x77, 0x61, 0x69, 0x74, 0x22, 0xb3,
+	0x03, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x70, 0x6f,
+	0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x12, 0x22, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x1e, 0x0a, 0x02, 0x74, 0x6f,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x61, 0x6c, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x61, 0x6c, 0x6c, 0x12, 0x33,
+	0x0a, 0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x0a, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x4b,
+	0x69, 0x6e, 0x64, 0x12, 0x3d, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x43, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x09,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0x5f, 0x0a, 0x17, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x30, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x53, 0x65, 0x74, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x65,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x61, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x04, 0x77, 0x61, 0x69, 0x74, 0x22, 0x41, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a,
+	0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52,
+	0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x4a, 0x0a, 0x16, 0x53, 0x71, 0x75, 0x61,
+	0x73, 0x68, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x30, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x73, 0x65, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x65, 0x74, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x53, 0x65, 0x74, 0x22, 0x52, 0x0a, 0x14, 0x44, 0x72, 0x6f, 0x70, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x0a,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x53, 0x65, 0x74, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x09, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x65, 0x74, 0x22, 0xe8, 0x01, 0x0a, 0x16, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x52,
+	0x04, 0x72, 0x65, 0x70, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x22, 0x0a,
+	0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66,
+	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x04, 0x66, 0x72, 0x6f,
+	0x6d, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x13, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x61, 0x6c, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x61, 0x6c, 0x6c, 0x12, 0x33,
+	0x0a, 0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x0a, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x4b,
+	0x69, 0x6e, 0x64, 0x22, 0x3c, 0x0a, 0x12, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x06, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f,
+	0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x22, 0x5b, 0x0a, 0x13, 0x53, 0x71, 0x75, 0x61, 0x73, 0x68, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76,
+	0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x72, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x22, 0x16,
+	0x0a, 0x14, 0x53, 0x71, 0x75, 0x61, 0x73, 0x68, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x59, 0x0a, 0x11, 0x44, 0x72, 0x6f, 0x70, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x06, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66,
+	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x06, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x72, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76,
+	0x65, 0x22, 0x14, 0x0a, 0x12, 0x44, 0x72, 0x6f, 0x70, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xe2, 0x01, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x22, 0x0a, 0x04, 0x68, 0x65, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x04, 0x68,
+	0x65, 0x61, 0x64, 0x12, 0x26, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x42, 0x72, 0x61,
+	0x6e, 0x63, 0x68, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x2e, 0x0a, 0x0a, 0x70,
+	0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52,
+	0x0a, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x74,
+	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70,
+	0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x07, 0x74,
+	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x0e, 0x6e, 0x65, 0x77, 0x5f, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c,
+	0x6e, 0x65, 0x77, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x65, 0x74, 0x22, 0x77, 0x0a, 0x12,
+	0x46, 0x69, 0x6e, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x2e, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xc3, 0x01, 0x0a, 0x13, 0x46, 0x69, 0x6e, 0x64, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a,
+	0x0c, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x12, 0x42, 0x0a, 0x14, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x48, 0x00, 0x52, 0x12, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x64,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x73, 0x5f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65,
+	0x64, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x3e, 0x0a, 0x14, 0x49,
+	0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x42, 0x72, 0x61,
+	0x6e, 0x63, 0x68, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x22, 0x4f, 0x0a, 0x11, 0x4c,
+	0x69, 0x73, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x20, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x04, 0x72, 0x65,
+	0x70, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x22, 0x5d, 0x0a, 0x13,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x42, 0x72, 0x61,
+	0x6e, 0x63, 0x68, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x06, 0x62,
+	0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x22, 0x86, 0x01, 0x0a, 0x07,
+	0x54, 0x61, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1d, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x54, 0x61,
+	0x67, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x26, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x34,
+	0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x22, 0x6d, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x54,
+	0x61, 0x67, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x03, 0x74, 0x61,
+	0x67, 0x12, 0x30, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x06, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x22, 0x3c, 0x0a, 0x11, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x54, 0x61,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x54,
+	0x61, 0x67, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x03, 0x74, 0x61,
+	0x67, 0x22, 0x3c, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x42,
+	0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x22,
+	0x3b, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0b, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x54, 0x61, 0x67, 0x42, 0x08, 0xfa,
+	0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x03, 0x74, 0x61, 0x67, 0x22, 0x7b, 0x0a, 0x14,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12,
+	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x22, 0x4c, 0x0a, 0x15, 0x49, 0x6e, 0x73,
+	0x70, 0x65, 0x63, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x33, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x07,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x14, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x57, 0x0a,
+	0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e,
+	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x22, 0xd6, 0x06, 0x0a, 0x13, 0x45, 0x64, 0x69, 0x74, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36,
+	0x0a, 0x05, 0x65, 0x64, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x52,
+	0x05, 0x65, 0x64, 0x69, 0x74, 0x73, 0x1a, 0x86, 0x06, 0x0a, 0x04, 0x45, 0x64, 0x69, 0x74, 0x12,
+	0x2b, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x48, 0x00, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x22, 0x0a, 0x04,
+	0x72, 0x65, 0x70, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x66, 0x73,
+	0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x48, 0x00, 0x52, 0x04, 0x72, 0x65, 0x70, 0x6f,
+	0x12, 0x28, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68,
+	0x48, 0x00, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x28, 0x0a, 0x06, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73,
+	0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x00, 0x52, 0x06, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x12, 0x44, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x45,
+	0x64, 0x69, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x48,
+	0x01, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x42, 0x0a, 0x07, 0x61, 0x64,
+	0x64, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x70, 0x66,
+	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x2e, 0x41, 0x64,
+	0x64, 0x4b, 0x65, 0x79, 0x48, 0x01, 0x52, 0x06, 0x61, 0x64, 0x64, 0x4b, 0x65, 0x79, 0x12, 0x45,
+	0x0a, 0x08, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x28, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x64,
+	0x69, 0x74, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x4b, 0x65, 0x79, 0x48, 0x01, 0x52, 0x07, 0x65, 0x64,
+	0x69, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x4b, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x70, 0x66, 0x73, 0x5f,
+	0x76, 0x32, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x4b, 0x65, 0x79, 0x48, 0x01, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4b,
+	0x65, 0x79, 0x1a, 0xa6, 0x01, 0x0a, 0x07, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x5b,
+	0x0a, 0x0b, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x45, 0x64, 0x69,
+	0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x2e, 0x45, 0x64, 0x69, 0x74, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2e, 0x52, 0x65,
+	0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b,
+	0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x3e, 0x0a, 0x10, 0x52,
+	0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x30, 0x0a, 0x06, 0x41,
+	0x64, 0x64, 0x4b, 0x65, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x31, 0x0a,
+	0x07, 0x45, 0x64, 0x69, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x1a, 0x1d, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x42,
+	0x08, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x42, 0x04, 0x0a, 0x02, 0x6f, 0x70, 0x22,
+	0x16, 0x0a, 0x14, 0x45, 0x64, 0x69, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa4, 0x01, 0x0a, 0x19, 0x53, 0x65, 0x74, 0x52,
+	0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x70,
+	0x6f, 0x48, 0x00, 0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x12, 0x28, 0x0a, 0x06, 0x62, 0x72, 0x61,
+	0x6e, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f,
+	0x76, 0x32, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x48, 0x00, 0x52, 0x06, 0x62, 0x72, 0x61,
+	0x6e, 0x63, 0x68, 0x12, 0x2f, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x74,
+	0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x06, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x42, 0x08, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x22, 0x8e,
+	0x01, 0x0a, 0x1b, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f,
+	0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22,
+	0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70,
+	0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x48, 0x00, 0x52, 0x04, 0x72, 0x65,
+	0x70, 0x6f, 0x12, 0x28, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x42, 0x72, 0x61, 0x6e,
+	0x63, 0x68, 0x48, 0x00, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x17, 0x0a, 0x07,
+	0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64,
+	0x72, 0x79, 0x52, 0x75, 0x6e, 0x42, 0x08, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x22,
+	0x48, 0x0a, 0x1c, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f,
+	0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x28, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x52, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x22, 0x48, 0x0a, 0x10, 0x50, 0x75, 0x72,
+	0x67, 0x65, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a,
+	0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x66,
+	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x22, 0x48, 0x0a, 0x0c, 0x50, 0x75, 0x72, 0x67, 0x65, 0x64, 0x44, 0x61, 0x74,
+	0x75, 0x6d, 0x73, 0x12, 0x20, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x52,
+	0x04, 0x72, 0x65, 0x70, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x73, 0x22, 0x9c, 0x01,
+	0x0a, 0x11, 0x50, 0x75, 0x72, 0x67, 0x65, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x2c, 0x0a,
+	0x06, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x64, 0x44, 0x61, 0x74,
+	0x75, 0x6d, 0x73, 0x52, 0x06, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x75,
+	0x6e, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x75, 0x6e,
+	0x6b, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x75, 0x6e, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x64, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x22, 0x97, 0x03, 0x0a,
+	0x07, 0x41, 0x64, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05,
+	0x64, 0x61, 0x74, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x61, 0x74,
+	0x75, 0x6d, 0x12, 0x2f, 0x0a, 0x03, 0x72, 0x61, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x48, 0x00, 0x52, 0x03,
+	0x72, 0x61, 0x77, 0x12, 0x2d, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x41, 0x64, 0x64, 0x46, 0x69, 0x6c,
+	0x65, 0x2e, 0x55, 0x52, 0x4c, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x00, 0x52, 0x03, 0x75,
+	0x72, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x39, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32,
+	0x2e, 0x41, 0x64, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x1a, 0x5d, 0x0a, 0x09, 0x55, 0x52, 0x4c, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x55, 0x52, 0x4c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x55, 0x52, 0x4c, 0x12,
+	0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x72, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x1a,
+	0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x08, 0x0a, 0x06,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x36, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x46, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x75,
+	0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x22, 0x74,
+	0x0a, 0x08, 0x43, 0x6f, 0x70, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x73,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x64, 0x61, 0x74, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x61, 0x74,
+	0x75, 0x6d, 0x12, 0x28, 0x0a, 0x03, 0x73, 0x72, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x42, 0x08, 0xfa,
+	0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x03, 0x73, 0x72, 0x63, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x70,
+	0x70, 0x65, 0x6e, 0x64, 0x22, 0x8a, 0x02, 0x0a, 0x11, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x46,
+	0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x65,
+	0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x42, 0x08,
+	0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x48, 0x00, 0x52, 0x09, 0x73, 0x65, 0x74, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x36, 0x0a, 0x08, 0x61, 0x64, 0x64, 0x5f, 0x66, 0x69, 0x6c,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32,
+	0x2e, 0x41, 0x64, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02,
+	0x10, 0x01, 0x48, 0x00, 0x52, 0x07, 0x61, 0x64, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x3f, 0x0a,
+	0x0b, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01,
+	0x48, 0x00, 0x52, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x39,
+	0x0a, 0x09, 0x63, 0x6f, 0x70, 0x79, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x70, 0x79, 0x46,
+	0x69, 0x6c, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x48, 0x00, 0x52,
+	0x08, 0x63, 0x6f, 0x70, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x62, 0x6f, 0x64,
+	0x79, 0x22, 0xbf, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x46, 0x69, 0x6c, 0x65,
+	0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x55, 0x52, 0x4c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x55, 0x52, 0x4c, 0x12, 0x1f, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x28,
+	0x00, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x30, 0x0a, 0x0a, 0x70, 0x61, 0x74,
+	0x68, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x52, 0x61, 0x6e, 0x67, 0x65,
+	0x52, 0x09, 0x70, 0x61, 0x74, 0x68, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x26, 0x0a, 0x0a, 0x73,
+	0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x42,
+	0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x28, 0x00, 0x52, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x22, 0x36, 0x0a, 0x12, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x46, 0x69,
+	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x04, 0x66, 0x69, 0x6c,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32,
+	0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x22, 0xa5, 0x01, 0x0a, 0x0f,
+	0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x20, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x04, 0x66, 0x69, 0x6c,
+	0x65, 0x12, 0x38, 0x0a, 0x10, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x66,
+	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x10, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x4a, 0x04, 0x08,
+	0x02, 0x10, 0x03, 0x22, 0x9f, 0x01, 0x0a, 0x0f, 0x57, 0x61, 0x6c, 0x6b, 0x46, 0x69, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x46,
+	0x69, 0x6c, 0x65, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x38, 0x0a, 0x10, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x46, 0x69, 0x6c,
+	0x65, 0x52, 0x10, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x72,
+	0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65,
+	0x76, 0x65, 0x72, 0x73, 0x65, 0x22, 0x85, 0x01, 0x0a, 0x0f, 0x47, 0x6c, 0x6f, 0x62, 0x46, 0x69,
+	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x06, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f,
+	0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x30, 0x0a, 0x0a, 0x70,
+	0x61, 0x74, 0x68, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x09, 0x70, 0x61, 0x74, 0x68, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x7d, 0x0a,
+	0x0f, 0x44, 0x69, 0x66, 0x66, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x27, 0x0a, 0x08, 0x6e, 0x65, 0x77, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x46, 0x69, 0x6c, 0x65,
+	0x52, 0x07, 0x6e, 0x65, 0x77, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x27, 0x0a, 0x08, 0x6f, 0x6c, 0x64,
+	0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x66,
+	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x07, 0x6f, 0x6c, 0x64, 0x46, 0x69,
+	0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x68, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x68, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x22, 0x6c, 0x0a, 0x10,
+	0x44, 0x69, 0x66, 0x66, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2b, 0x0a, 0x08, 0x6e, 0x65, 0x77, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x46, 0x69, 0x6c, 0x65,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x6e, 0x65, 0x77, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x2b, 0x0a,
+	0x08, 0x6f, 0x6c, 0x64, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x07, 0x6f, 0x6c, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x22, 0x87, 0x01, 0x0a, 0x0b, 0x46,
+	0x73, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x69,
+	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x66, 0x69, 0x78, 0x12, 0x35, 0x0a, 0x0d,
+	0x7a, 0x6f, 0x6d, 0x62, 0x69, 0x65, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x7a, 0x6f, 0x6d, 0x62, 0x69, 0x65, 0x54, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x12, 0x1f, 0x0a, 0x0a, 0x7a, 0x6f, 0x6d, 0x62, 0x69, 0x65, 0x5f, 0x61, 0x6c,
+	0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x09, 0x7a, 0x6f, 0x6d, 0x62, 0x69,
+	0x65, 0x41, 0x6c, 0x6c, 0x42, 0x0e, 0x0a, 0x0c, 0x7a, 0x6f, 0x6d, 0x62, 0x69, 0x65, 0x5f, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x22, 0x36, 0x0a, 0x0c, 0x46, 0x73, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x66, 0x69, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x37, 0x0a, 0x15,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x65,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x6c, 0x65,
+	0x53, 0x65, 0x74, 0x49, 0x64, 0x22, 0x3b, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65,
+	0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x06, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73,
+	0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x22, 0x5b, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32,
+	0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12,
+	0x1e, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x49, 0x64, 0x22,
+	0x56, 0x0a, 0x13, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73,
+	0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x6c,
+	0x65, 0x53, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x74, 0x6c, 0x5f, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x74, 0x6c,
+	0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x74, 0x0a, 0x15, 0x43, 0x6f, 0x6d, 0x70, 0x6f,
+	0x73, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x20, 0x0a, 0x0c, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x49,
+	0x64, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x74, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x74, 0x6c, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x22, 0x35, 0x0a,
+	0x13, 0x53, 0x68, 0x61, 0x72, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x53,
+	0x65, 0x74, 0x49, 0x64, 0x22, 0x37, 0x0a, 0x09, 0x50, 0x61, 0x74, 0x68, 0x52, 0x61, 0x6e, 0x67,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x70, 0x70, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x70, 0x70, 0x65, 0x72, 0x22, 0x41, 0x0a,
+	0x14, 0x53, 0x68, 0x61, 0x72, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x72, 0x64, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50,
+	0x61, 0x74, 0x68, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x06, 0x73, 0x68, 0x61, 0x72, 0x64, 0x73,
+	0x22, 0xd5, 0x01, 0x0a, 0x13, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x72, 0x65, 0x61, 0x64,
+	0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0d, 0x72, 0x65, 0x61, 0x64, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x62, 0x65, 0x67, 0x69, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x42, 0x65, 0x67, 0x69,
+	0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x45, 0x6e, 0x64, 0x12, 0x2b,
+	0x0a, 0x11, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x2b, 0x0a, 0x11, 0x63,
+	0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x63, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x86, 0x03, 0x0a, 0x12, 0x53, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x12,
+	0x30, 0x0a, 0x14, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x6f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6e, 0x67, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x75, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x75, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x6b, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2b,
+	0x0a, 0x11, 0x75, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x65, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x75, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x6b, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x6f,
+	0x72, 0x70, 0x68, 0x61, 0x6e, 0x65, 0x64, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x6f, 0x72, 0x70, 0x68,
+	0x61, 0x6e, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x29, 0x0a, 0x10, 0x6f, 0x72, 0x70, 0x68, 0x61, 0x6e, 0x65, 0x64, 0x5f, 0x6f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x6f, 0x72, 0x70, 0x68, 0x61,
+	0x6e, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x67, 0x61,
+	0x72, 0x62, 0x61, 0x67, 0x65, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67,
+	0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d,
+	0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x22, 0x82, 0x01, 0x0a, 0x14, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x63, 0x68,
+	0x75, 0x6e, 0x6b, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3c, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x73,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6f,
+	0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x69,
+	0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x22, 0x2d, 0x0a, 0x15, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63,
+	0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x9e, 0x01, 0x0a, 0x0c, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x61,
+	0x6c, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6c,
+	0x6f, 0x67, 0x69, 0x63, 0x61, 0x6c, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x70,
+	0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0d, 0x70, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x68, 0x75, 0x6e,
+	0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x60, 0x0a, 0x10, 0x52, 0x65, 0x70, 0x6f, 0x53, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x04, 0x72, 0x65,
+	0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76,
+	0x32, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x12, 0x2a, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x66,
+	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x22, 0x6c, 0x0a, 0x13, 0x50, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12,
+	0x29, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x2a, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x66, 0x73, 0x5f,
+	0x76, 0x32, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x22, 0xa4, 0x01, 0x0a, 0x12, 0x53, 0x68, 0x61, 0x72, 0x65,
+	0x64, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x23, 0x0a,
+	0x06, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x05, 0x72, 0x65, 0x70,
+	0x6f, 0x41, 0x12, 0x23, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x62, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x70, 0x6f,
+	0x52, 0x05, 0x72, 0x65, 0x70, 0x6f, 0x42, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x68, 0x61, 0x72, 0x65,
+	0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x68,
+	0x61, 0x72, 0x65, 0x64, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0b, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x22, 0x9e, 0x02,
+	0x0a, 0x16, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32,
+	0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x12, 0x37, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e,
+	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x2e, 0x0a,
+	0x05, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70,
+	0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x12, 0x32, 0x0a,
+	0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x53, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65,
+	0x64, 0x12, 0x3b, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x83,
+	0x01, 0x0a, 0x0f, 0x50, 0x75, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x2a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x20, 0x0a, 0x0c, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x49,
+	0x64, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x74, 0x61, 0x67, 0x22, 0x23, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x3e, 0x0a, 0x10, 0x47, 0x65, 0x74,
+	0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41,
+	0x6e, 0x79, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x32, 0x0a, 0x11, 0x43, 0x6c, 0x65,
+	0x61, 0x72, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x74, 0x61, 0x67, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x67, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x22, 0x15, 0x0a,
+	0x13, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x16, 0x0a, 0x14, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x0a, 0x13,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x45, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0xa5, 0x05, 0x0a, 0x11, 0x53, 0x51, 0x4c, 0x44, 0x61, 0x74,
+	0x61, 0x62, 0x61, 0x73, 0x65, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x75,
+	0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x45, 0x0a,
+	0x0b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x53, 0x51, 0x4c, 0x44,
+	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x2e, 0x46, 0x69,
+	0x6c, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x65, 0x46, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x12, 0x38, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x53, 0x51,
+	0x4c, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x2e,
+	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x32,
+	0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x70,
+	0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x53, 0x51, 0x4c, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
+	0x65, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f,
+	0x64, 0x65, 0x12, 0x4d, 0x0a, 0x0c, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x5f, 0x6b, 0x65,
+	0x79, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76,
+	0x32, 0x2e, 0x53, 0x51, 0x4c, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x45, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x2e, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79,
+	0x73, 0x1a, 0x9a, 0x01, 0x0a, 0x0a, 0x46, 0x69, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x12, 0x3d, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29,
+	0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x53, 0x51, 0x4c, 0x44, 0x61, 0x74, 0x61, 0x62,
+	0x61, 0x73, 0x65, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x46, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x22, 0x33, 0x0a, 0x04, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x07,
+	0x0a, 0x03, 0x43, 0x53, 0x56, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x4a, 0x53, 0x4f, 0x4e, 0x10,
+	0x02, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x41, 0x52, 0x51, 0x55, 0x45, 0x54, 0x10, 0x03, 0x1a, 0x2e,
+	0x0a, 0x06, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x1a, 0x26,
+	0x0a, 0x0a, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x1a, 0x64, 0x0a, 0x10, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72,
+	0x79, 0x4b, 0x65, 0x79, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3a, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x66,
+	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x53, 0x51, 0x4c, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
+	0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x2e, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65,
+	0x79, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x1f, 0x0a, 0x04,
+	0x4d, 0x6f, 0x64, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45, 0x50, 0x4c, 0x41, 0x43, 0x45, 0x10,
+	0x00, 0x12, 0x0a, 0x0a, 0x06, 0x55, 0x50, 0x53, 0x45, 0x52, 0x54, 0x10, 0x01, 0x22, 0xc7, 0x01,
+	0x0a, 0x0d, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x26, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52,
+	0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x44, 0x0a, 0x0e, 0x6f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0d,
+	0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x3e, 0x0a,
+	0x0c, 0x73, 0x71, 0x6c, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x53, 0x51, 0x4c,
+	0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x48, 0x00,
+	0x52, 0x0b, 0x73, 0x71, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x42, 0x08, 0x0a,
+	0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x22, 0xbd, 0x07, 0x0a, 0x0e, 0x45, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0e, 0x6f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x45, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x48, 0x00,
+	0x52, 0x0d, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12,
+	0x4d, 0x0a, 0x0c, 0x73, 0x71, 0x6c, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x45,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x51,
+	0x4c, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x48,
+	0x00, 0x52, 0x0b, 0x73, 0x71, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x1a, 0x3a,
+	0x0a, 0x13, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x77,
+	0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x57, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x1a, 0xc0, 0x05, 0x0a, 0x11, 0x53,
+	0x51, 0x4c, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x12, 0x5c, 0x0a, 0x0c, 0x72, 0x6f, 0x77, 0x73, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e,
+	0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53,
+	0x51, 0x4c, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x2e, 0x52, 0x6f, 0x77, 0x73, 0x57, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x0b, 0x72, 0x6f, 0x77, 0x73, 0x57, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x12, 0x5f,
+	0x0a, 0x0d, 0x72, 0x6f, 0x77, 0x73, 0x5f, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x65, 0x64, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x45,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x51,
+	0x4c, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e,
+	0x52, 0x6f, 0x77, 0x73, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x0c, 0x72, 0x6f, 0x77, 0x73, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x65, 0x64, 0x12,
+	0x5c, 0x0a, 0x0c, 0x72, 0x6f, 0x77, 0x73, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x45,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x51,
+	0x4c, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e,
+	0x52, 0x6f, 0x77, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x0b, 0x72, 0x6f, 0x77, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x5c, 0x0a,
+	0x0c, 0x72, 0x6f, 0x77, 0x73, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x45, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x51, 0x4c, 0x44,
+	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x52, 0x6f,
+	0x77, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b,
+	0x72, 0x6f, 0x77, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x2f, 0x0a, 0x0b, 0x62,
+	0x61, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x52, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x1a, 0x3e, 0x0a, 0x10,
+	0x52, 0x6f, 0x77, 0x73, 0x57, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3f, 0x0a, 0x11,
+	0x52, 0x6f, 0x77, 0x73, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3e, 0x0a,
+	0x10, 0x52, 0x6f, 0x77, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3e, 0x0a,
+	0x10, 0x52, 0x6f, 0x77, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x08, 0x0a,
+	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2a, 0x49, 0x0a, 0x0a, 0x4f, 0x72, 0x69, 0x67, 0x69,
+	0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x17, 0x0a, 0x13, 0x4f, 0x52, 0x49, 0x47, 0x49, 0x4e, 0x5f,
+	0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x08,
+	0x0a, 0x04, 0x55, 0x53, 0x45, 0x52, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x41, 0x55, 0x54, 0x4f,
+	0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x53, 0x43, 0x4b, 0x10, 0x03, 0x22, 0x04, 0x08, 0x04,
+	0x10, 0x04, 0x2a, 0x2b, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0c,
+	0x0a, 0x08, 0x52, 0x45, 0x53, 0x45, 0x52, 0x56, 0x45, 0x44, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04,
+	0x46, 0x49, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x44, 0x49, 0x52, 0x10, 0x02, 0x2a,
+	0x5c, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x18,
+	0x0a, 0x14, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55,
+	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x54, 0x41, 0x52,
+	0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x52, 0x45, 0x41, 0x44, 0x59, 0x10, 0x02,
+	0x12, 0x0d, 0x0a, 0x09, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x12,
+	0x0c, 0x0a, 0x08, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x45, 0x44, 0x10, 0x04, 0x2a, 0x3b, 0x0a,
+	0x09, 0x44, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f,
+	0x4e, 0x45, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x08,
+	0x0a, 0x04, 0x4c, 0x49, 0x4e, 0x45, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x53, 0x51, 0x4c, 0x10,
+	0x03, 0x12, 0x07, 0x0a, 0x03, 0x43, 0x53, 0x56, 0x10, 0x04, 0x32, 0xce, 0x1f, 0x0a, 0x03, 0x41,
+	0x50, 0x49, 0x12, 0x41, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f,
+	0x12, 0x19, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0b, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74,
+	0x52, 0x65, 0x70, 0x6f, 0x12, 0x1a, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x49, 0x6e,
+	0x73, 0x70, 0x65, 0x63, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x10, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x49, 0x6e,
+	0x66, 0x6f, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f,
+	0x12, 0x17, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x66, 0x73, 0x5f,
+	0x76, 0x32, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x30, 0x01, 0x12,
+	0x45, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x19, 0x2e,
+	0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x70,
+	0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76,
+	0x32, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x52, 0x65, 0x70, 0x6f, 0x73, 0x12, 0x1a, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x3b, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x72, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12,
+	0x1a, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x66,
+	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x22, 0x00, 0x12, 0x45, 0x0a,
+	0x0c, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x1b, 0x2e,
+	0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0b, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x12, 0x1a, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6c, 0x65,
+	0x61, 0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0d, 0x49, 0x6e, 0x73,
+	0x70, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x1c, 0x2e, 0x70, 0x66, 0x73,
+	0x5f, 0x76, 0x32, 0x2e, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76,
+	0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x12, 0x3f,
+	0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x19, 0x2e, 0x70,
+	0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32,
+	0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x30, 0x01, 0x12,
+	0x49, 0x0a, 0x0f, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x12, 0x1e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x12, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x30, 0x01, 0x12, 0x4b, 0x0a, 0x0c, 0x53, 0x71,
+	0x75, 0x61, 0x73, 0x68, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x1b, 0x2e, 0x70, 0x66, 0x73,
+	0x5f, 0x76, 0x32, 0x2e, 0x53, 0x71, 0x75, 0x61, 0x73, 0x68, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32,
+	0x2e, 0x53, 0x71, 0x75, 0x61, 0x73, 0x68, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0a, 0x44, 0x72, 0x6f, 0x70, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x19, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x44,
+	0x72, 0x6f, 0x70, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1a, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x44, 0x72, 0x6f, 0x70, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b,
+	0x0a, 0x10, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53,
+	0x65, 0x74, 0x12, 0x1f, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x49, 0x6e, 0x73, 0x70,
+	0x65, 0x63, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x30, 0x01, 0x12, 0x48, 0x0a, 0x0d, 0x4c,
+	0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x65, 0x74, 0x12, 0x1c, 0x2e, 0x70,
+	0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x66, 0x73,
+	0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x66,
+	0x6f, 0x22, 0x00, 0x30, 0x01, 0x12, 0x4b, 0x0a, 0x0f, 0x53, 0x71, 0x75, 0x61, 0x73, 0x68, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x65, 0x74, 0x12, 0x1e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76,
+	0x32, 0x2e, 0x53, 0x71, 0x75, 0x61, 0x73, 0x68, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x00, 0x12, 0x47, 0x0a, 0x0d, 0x44, 0x72, 0x6f, 0x70, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x53, 0x65, 0x74, 0x12, 0x1c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x44, 0x72, 0x6f,
+	0x70, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0b, 0x46,
+	0x69, 0x6e, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x1a, 0x2e, 0x70, 0x66, 0x73,
+	0x5f, 0x76, 0x32, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e,
+	0x46, 0x69, 0x6e, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x45, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x1b, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x43,
+	0x0a, 0x0d, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12,
+	0x1c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74,
+	0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e,
+	0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x49, 0x6e, 0x66,
+	0x6f, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x63,
+	0x68, 0x12, 0x19, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42,
+	0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x70,
+	0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f,
+	0x22, 0x00, 0x30, 0x01, 0x12, 0x45, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x72,
+	0x61, 0x6e, 0x63, 0x68, 0x12, 0x1b, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x09, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x67, 0x12, 0x18, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76,
+	0x32, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0a,
+	0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x54, 0x61, 0x67, 0x12, 0x19, 0x2e, 0x70, 0x66, 0x73,
+	0x5f, 0x76, 0x32, 0x2e, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x54, 0x61, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x54,
+	0x61, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x07, 0x4c, 0x69, 0x73, 0x74,
+	0x54, 0x61, 0x67, 0x12, 0x16, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x70, 0x66,
+	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x54, 0x61, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x30, 0x01,
+	0x12, 0x3f, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x67, 0x12, 0x18, 0x2e,
+	0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x00, 0x12, 0x43, 0x0a, 0x0a, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x12,
+	0x19, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x46,
+	0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x00, 0x28, 0x01, 0x12, 0x42, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c,
+	0x65, 0x12, 0x16, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x69,
+	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x45, 0x0a, 0x0a, 0x47, 0x65,
+	0x74, 0x46, 0x69, 0x6c, 0x65, 0x54, 0x41, 0x52, 0x12, 0x16, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76,
+	0x32, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x00, 0x30,
+	0x01, 0x12, 0x3d, 0x0a, 0x0b, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x46, 0x69, 0x6c, 0x65,
+	0x12, 0x1a, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63,
+	0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70,
+	0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00,
+	0x12, 0x39, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x17, 0x2e, 0x70,
+	0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x46,
+	0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x30, 0x01, 0x12, 0x39, 0x0a, 0x08, 0x57,
+	0x61, 0x6c, 0x6b, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x17, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32,
+	0x2e, 0x57, 0x61, 0x6c, 0x6b, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x10, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x22, 0x00, 0x30, 0x01, 0x12, 0x39, 0x0a, 0x08, 0x47, 0x6c, 0x6f, 0x62, 0x46, 0x69,
+	0x6c, 0x65, 0x12, 0x17, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x47, 0x6c, 0x6f, 0x62,
+	0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x66,
+	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x30,
+	0x01, 0x12, 0x41, 0x0a, 0x08, 0x44, 0x69, 0x66, 0x66, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x17, 0x2e,
+	0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x46, 0x69, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e,
+	0x44, 0x69, 0x66, 0x66, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x30, 0x01, 0x12, 0x4b, 0x0a, 0x0c, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x41, 0x75, 0x74, 0x68, 0x12, 0x1b, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x41, 0x63,
+	0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x3d, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x6c, 0x6c, 0x12, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
+	0x12, 0x35, 0x0a, 0x04, 0x46, 0x73, 0x63, 0x6b, 0x12, 0x13, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76,
+	0x32, 0x2e, 0x46, 0x73, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e,
+	0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x46, 0x73, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x4d, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x12, 0x19, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76,
+	0x32, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x12, 0x48, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c,
+	0x65, 0x53, 0x65, 0x74, 0x12, 0x19, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x47, 0x65,
+	0x74, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1d, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46,
+	0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x41, 0x0a, 0x0a, 0x41, 0x64, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x12, 0x19,
+	0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x41, 0x64, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x53,
+	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0c, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x46, 0x69, 0x6c, 0x65,
+	0x53, 0x65, 0x74, 0x12, 0x1b, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x6e,
+	0x65, 0x77, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0e, 0x43, 0x6f,
+	0x6d, 0x70, 0x6f, 0x73, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x12, 0x1d, 0x2e, 0x70,
+	0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x65, 0x46, 0x69, 0x6c,
+	0x65, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x66,
+	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x53,
+	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0c,
+	0x53, 0x68, 0x61, 0x72, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x12, 0x1b, 0x2e, 0x70,
+	0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x53,
+	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x66, 0x73, 0x5f,
+	0x76, 0x32, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0c, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x2e, 0x70, 0x66, 0x73, 0x5f,
+	0x76, 0x32, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0e, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63,
+	0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76,
+	0x32, 0x2e, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32,
+	0x2e, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x08, 0x50, 0x75, 0x74,
+	0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x17, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50,
+	0x75, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x43,
+	0x61, 0x63, 0x68, 0x65, 0x12, 0x17, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x47, 0x65,
+	0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e,
+	0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x0a, 0x43, 0x6c, 0x65,
+	0x61, 0x72, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x19, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32,
+	0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x08,
+	0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x18, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x61,
+	0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x11, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x61, 0x73,
+	0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x30, 0x01, 0x12, 0x39, 0x0a, 0x06, 0x45, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x15, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x45, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x66, 0x73,
+	0x5f, 0x76, 0x32, 0x2e, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x46, 0x0a,
+	0x0e, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12,
+	0x1d, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74,
+	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13,
+	0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x12, 0x1a, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x13, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x30, 0x01, 0x12, 0x47, 0x0a, 0x0d, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1c, 0x2e, 0x70, 0x66, 0x73,
+	0x5f, 0x76, 0x32, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0c, 0x45, 0x64, 0x69, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x1b, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x45, 0x64, 0x69, 0x74,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x51, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x21, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x53,
+	0x65, 0x74, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x00, 0x12, 0x63, 0x0a, 0x14, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x74, 0x65, 0x6e,
+	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x23, 0x2e, 0x70, 0x66, 0x73,
+	0x5f, 0x76, 0x32, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69,
+	0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x24, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x52, 0x65,
+	0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x09, 0x50, 0x75, 0x72, 0x67, 0x65,
+	0x50, 0x61, 0x74, 0x68, 0x12, 0x18, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x75,
+	0x72, 0x67, 0x65, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19,
+	0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x50, 0x61, 0x74,
+	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x2b, 0x5a, 0x29, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x61, 0x63, 0x68, 0x79, 0x64,
+	0x65, 0x72, 0x6d, 0x2f, 0x70, 0x61, 0x63, 0x68, 0x79, 0x64, 0x65, 0x72, 0x6d, 0x2f, 0x76, 0x32,
+	0x2f, 0x73, 0x72, 0x63, 0x2f, 0x70, 0x66, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_pfs_pfs_proto_rawDescOnce sync.Once
+	file_pfs_pfs_proto_rawDescData = file_pfs_pfs_proto_rawDesc
+)
+
+func file_pfs_pfs_proto_rawDescGZIP() []byte {
+	file_pfs_pfs_proto_rawDescOnce.Do(func() {
+		file_pfs_pfs_proto_rawDescData = protoimpl.X.CompressGZIP(file_pfs_pfs_proto_rawDescData)
+	})
+	return file_pfs_pfs_proto_rawDescData
+}
+
+var file_pfs_pfs_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_pfs_pfs_proto_msgTypes = make([]protoimpl.MessageInfo, 129)
+var file_pfs_pfs_proto_goTypes = []interface{}{
+	(OriginKind)(0),                            // 0: pfs_v2.OriginKind
+	(FileType)(0),                              // 1: pfs_v2.FileType
+	(CommitState)(0),                           // 2: pfs_v2.CommitState
+	(Delimiter)(0),                             // 3: pfs_v2.Delimiter
+	(SQLDatabaseEgress_Mode)(0),                // 4: pfs_v2.SQLDatabaseEgress.Mode
+	(SQLDatabaseEgress_FileFormat_Type)(0),     // 5: pfs_v2.SQLDatabaseEgress.FileFormat.Type
+	(*Repo)(nil),                               // 6: pfs_v2.Repo
+	(*Branch)(nil),                             // 7: pfs_v2.Branch
+	(*Tag)(nil),                                // 8: pfs_v2.Tag
+	(*File)(nil),                               // 9: pfs_v2.File
+	(*RepoInfo)(nil),                           // 10: pfs_v2.RepoInfo
+	(*AuthInfo)(nil),                           // 11: pfs_v2.AuthInfo
+	(*BranchInfo)(nil),                         // 12: pfs_v2.BranchInfo
+	(*RetentionPolicy)(nil),                    // 13: pfs_v2.RetentionPolicy
+	(*Trigger)(nil),                            // 14: pfs_v2.Trigger
+	(*CommitOrigin)(nil),                       // 15: pfs_v2.CommitOrigin
+	(*Commit)(nil),                             // 16: pfs_v2.Commit
+	(*CommitInfo)(nil),                         // 17: pfs_v2.CommitInfo
+	(*CommitSet)(nil),                          // 18: pfs_v2.CommitSet
+	(*CommitSetInfo)(nil),                      // 19: pfs_v2.CommitSetInfo
+	(*FileInfo)(nil),                           // 20: pfs_v2.FileInfo
+	(*Project)(nil),                            // 21: pfs_v2.Project
+	(*ProjectInfo)(nil),                        // 22: pfs_v2.ProjectInfo
+	(*CreateRepoRequest)(nil),                  // 23: pfs_v2.CreateRepoRequest
+	(*InspectRepoRequest)(nil),                 // 24: pfs_v2.InspectRepoRequest
+	(*ListRepoRequest)(nil),                    // 25: pfs_v2.ListRepoRequest
+	(*DeleteRepoRequest)(nil),                  // 26: pfs_v2.DeleteRepoRequest
+	(*DeleteReposRequest)(nil),                 // 27: pfs_v2.DeleteReposRequest
+	(*DeleteRepoResponse)(nil),                 // 28: pfs_v2.DeleteRepoResponse
+	(*DeleteReposResponse)(nil),                // 29: pfs_v2.DeleteReposResponse
+	(*StartCommitRequest)(nil),                 // 30: pfs_v2.StartCommitRequest
+	(*FinishCommitRequest)(nil),                // 31: pfs_v2.FinishCommitRequest
+	(*InspectCommitRequest)(nil),               // 32: pfs_v2.InspectCommitRequest
+	(*ListCommitRequest)(nil),                  // 33: pfs_v2.ListCommitRequest
+	(*InspectCommitSetRequest)(nil),            // 34: pfs_v2.InspectCommitSetRequest
+	(*ListCommitSetRequest)(nil),               // 35: pfs_v2.ListCommitSetRequest
+	(*SquashCommitSetRequest)(nil),             // 36: pfs_v2.SquashCommitSetRequest
+	(*DropCommitSetRequest)(nil),               // 37: pfs_v2.DropCommitSetRequest
+	(*SubscribeCommitRequest)(nil),             // 38: pfs_v2.SubscribeCommitRequest
+	(*ClearCommitRequest)(nil),                 // 39: pfs_v2.ClearCommitRequest
+	(*SquashCommitRequest)(nil),                // 40: pfs_v2.SquashCommitRequest
+	(*SquashCommitResponse)(nil),               // 41: pfs_v2.SquashCommitResponse
+	(*DropCommitRequest)(nil),                  // 42: pfs_v2.DropCommitRequest
+	(*DropCommitResponse)(nil),                 // 43: pfs_v2.DropCommitResponse
+	(*CreateBranchRequest)(nil),                // 44: pfs_v2.CreateBranchRequest
+	(*FindCommitsRequest)(nil),                 // 45: pfs_v2.FindCommitsRequest
+	(*FindCommitsResponse)(nil),                // 46: pfs_v2.FindCommitsResponse
+	(*InspectBranchRequest)(nil),               // 47: pfs_v2.InspectBranchRequest
+	(*ListBranchRequest)(nil),                  // 48: pfs_v2.ListBranchRequest
+	(*DeleteBranchRequest)(nil),                // 49: pfs_v2.DeleteBranchRequest
+	(*TagInfo)(nil),                            // 50: pfs_v2.TagInfo
+	(*CreateTagRequest)(nil),                   // 51: pfs_v2.CreateTagRequest
+	(*InspectTagRequest)(nil),                  // 52: pfs_v2.InspectTagRequest
+	(*ListTagRequest)(nil),                     // 53: pfs_v2.ListTagRequest
+	(*DeleteTagRequest)(nil),                   // 54: pfs_v2.DeleteTagRequest
+	(*CreateProjectRequest)(nil),               // 55: pfs_v2.CreateProjectRequest
+	(*InspectProjectRequest)(nil),              // 56: pfs_v2.InspectProjectRequest
+	(*ListProjectRequest)(nil),                 // 57: pfs_v2.ListProjectRequest
+	(*DeleteProjectRequest)(nil),               // 58: pfs_v2.DeleteProjectRequest
+	(*EditMetadataRequest)(nil),                // 59: pfs_v2.EditMetadataRequest
+	(*EditMetadataResponse)(nil),               // 60: pfs_v2.EditMetadataResponse
+	(*SetRetentionPolicyRequest)(nil),          // 61: pfs_v2.SetRetentionPolicyRequest
+	(*ApplyRetentionPolicyRequest)(nil),        // 62: pfs_v2.ApplyRetentionPolicyRequest
+	(*ApplyRetentionPolicyResponse)(nil),       // 63: pfs_v2.ApplyRetentionPolicyResponse
+	(*PurgePathRequest)(nil),                   // 64: pfs_v2.PurgePathRequest
+	(*PurgedDatums)(nil),                       // 65: pfs_v2.PurgedDatums
+	(*PurgePathResponse)(nil),                  // 66: pfs_v2.PurgePathResponse
+	(*AddFile)(nil),                            // 67: pfs_v2.AddFile
+	(*DeleteFile)(nil),                         // 68: pfs_v2.DeleteFile
+	(*CopyFile)(nil),                           // 69: pfs_v2.CopyFile
+	(*ModifyFileRequest)(nil),                  // 70: pfs_v2.ModifyFileRequest
+	(*GetFileRequest)(nil),                     // 71: pfs_v2.GetFileRequest
+	(*InspectFileRequest)(nil),                 // 72: pfs_v2.InspectFileRequest
+	(*ListFileRequest)(nil),                    // 73: pfs_v2.ListFileRequest
+	(*WalkFileRequest)(nil),                    // 74: pfs_v2.WalkFileRequest
+	(*GlobFileRequest)(nil),                    // 75: pfs_v2.GlobFileRequest
+	(*DiffFileRequest)(nil),                    // 76: pfs_v2.DiffFileRequest
+	(*DiffFileResponse)(nil),                   // 77: pfs_v2.DiffFileResponse
+	(*FsckRequest)(nil),                        // 78: pfs_v2.FsckRequest
+	(*FsckResponse)(nil),                       // 79: pfs_v2.FsckResponse
+	(*CreateFileSetResponse)(nil),              // 80: pfs_v2.CreateFileSetResponse
+	(*GetFileSetRequest)(nil),                  // 81: pfs_v2.GetFileSetRequest
+	(*AddFileSetRequest)(nil),                  // 82: pfs_v2.AddFileSetRequest
+	(*RenewFileSetRequest)(nil),                // 83: pfs_v2.RenewFileSetRequest
+	(*ComposeFileSetRequest)(nil),              // 84: pfs_v2.ComposeFileSetRequest
+	(*ShardFileSetRequest)(nil),                // 85: pfs_v2.ShardFileSetRequest
+	(*PathRange)(nil),                          // 86: pfs_v2.PathRange
+	(*ShardFileSetResponse)(nil),               // 87: pfs_v2.ShardFileSetResponse
+	(*CheckStorageRequest)(nil),                // 88: pfs_v2.CheckStorageRequest
+	(*StorageConsistency)(nil),                 // 89: pfs_v2.StorageConsistency
+	(*CheckStorageResponse)(nil),               // 90: pfs_v2.CheckStorageResponse
+	(*InspectStorageRequest)(nil),              // 91: pfs_v2.InspectStorageRequest
+	(*StorageStats)(nil),                       // 92: pfs_v2.StorageStats
+	(*RepoStorageStats)(nil),                   // 93: pfs_v2.RepoStorageStats
+	(*ProjectStorageStats)(nil),                // 94: pfs_v2.ProjectStorageStats
+	(*SharedStorageStats)(nil),                 // 95: pfs_v2.SharedStorageStats
+	(*InspectStorageResponse)(nil),             // 96: pfs_v2.InspectStorageResponse
+	(*PutCacheRequest)(nil),                    // 97: pfs_v2.PutCacheRequest
+	(*GetCacheRequest)(nil),                    // 98: pfs_v2.GetCacheRequest
+	(*GetCacheResponse)(nil),                   // 99: pfs_v2.GetCacheResponse
+	(*ClearCacheRequest)(nil),                  // 100: pfs_v2.ClearCacheRequest
+	(*ActivateAuthRequest)(nil),                // 101: pfs_v2.ActivateAuthRequest
+	(*ActivateAuthResponse)(nil),               // 102: pfs_v2.ActivateAuthResponse
+	(*ObjectStorageEgress)(nil),                // 103: pfs_v2.ObjectStorageEgress
+	(*SQLDatabaseEgress)(nil),                  // 104: pfs_v2.SQLDatabaseEgress
+	(*EgressRequest)(nil),                      // 105: pfs_v2.EgressRequest
+	(*EgressResponse)(nil),                     // 106: pfs_v2.EgressResponse
+	(*RepoInfo_Details)(nil),                   // 107: pfs_v2.RepoInfo.Details
+	nil,                                        // 108: pfs_v2.RepoInfo.MetadataEntry
+	nil,                                        // 109: pfs_v2.BranchInfo.MetadataEntry
+	nil,                                        // 110: pfs_v2.RetentionPolicy.KeepMetadataEntry
+	(*CommitInfo_Details)(nil),                 // 111: pfs_v2.CommitInfo.Details
+	nil,                                        // 112: pfs_v2.CommitInfo.MetadataEntry
+	nil,                                        // 113: pfs_v2.FileInfo.MetadataEntry
+	nil,                                        // 114: pfs_v2.ProjectInfo.MetadataEntry
+	nil,                                        // 115: pfs_v2.ListRepoRequest.MetadataEntry
+	nil,                                        // 116: pfs_v2.ListCommitRequest.MetadataEntry
+	(*EditMetadataRequest_Edit)(nil),           // 117: pfs_v2.EditMetadataRequest.Edit
+	(*EditMetadataRequest_Edit_Replace)(nil),   // 118: pfs_v2.EditMetadataRequest.Edit.Replace
+	(*EditMetadataRequest_Edit_AddKey)(nil),    // 119: pfs_v2.EditMetadataRequest.Edit.AddKey
+	(*EditMetadataRequest_Edit_EditKey)(nil),   // 120: pfs_v2.EditMetadataRequest.Edit.EditKey
+	(*EditMetadataRequest_Edit_DeleteKey)(nil), // 121: pfs_v2.EditMetadataRequest.Edit.DeleteKey
+	nil,                                  // 122: pfs_v2.EditMetadataRequest.Edit.Replace.ReplacementEntry
+	(*AddFile_URLSource)(nil),            // 123: pfs_v2.AddFile.URLSource
+	nil,                                  // 124: pfs_v2.AddFile.MetadataEntry
+	(*SQLDatabaseEgress_FileFormat)(nil), // 125: pfs_v2.SQLDatabaseEgress.FileFormat
+	(*SQLDatabaseEgress_Secret)(nil),     // 126: pfs_v2.SQLDatabaseEgress.Secret
+	(*SQLDatabaseEgress_PrimaryKey)(nil), // 127: pfs_v2.SQLDatabaseEgress.PrimaryKey
+	nil,                                  // 128: pfs_v2.SQLDatabaseEgress.PrimaryKeysEntry
+	(*EgressResponse_ObjectStorageResult)(nil), // 129: pfs_v2.EgressResponse.ObjectStorageResult
+	(*EgressResponse_SQLDatabaseResult)(nil),   // 130: pfs_v2.EgressResponse.SQLDatabaseResult
+	nil,                                        // 131: pfs_v2.EgressResponse.SQLDatabaseResult.RowsWrittenEntry
+	nil,                                        // 132: pfs_v2.EgressResponse.SQLDatabaseResult.RowsInsertedEntry
+	nil,                                        // 133: pfs_v2.EgressResponse.SQLDatabaseResult.RowsUpdatedEntry
+	nil,                                        // 134: pfs_v2.EgressResponse.SQLDatabaseResult.RowsDeletedEntry
+	(*timestamppb.Timestamp)(nil),              // 135: google.protobuf.Timestamp
+	(auth.Permission)(0),                       // 136: auth_v2.Permission
+	(*durationpb.Duration)(nil),                // 137: google.protobuf.Duration
+	(*wrapperspb.BytesValue)(nil),              // 138: google.protobuf.BytesValue
+	(*anypb.Any)(nil),                          // 139: google.protobuf.Any
+	(*emptypb.Empty)(nil),                      // 140: google.protobuf.Empty
+	(*task.ListTaskRequest)(nil),               // 141: taskapi.ListTaskRequest
+	(*task.TaskInfo)(nil),                      // 142: taskapi.TaskInfo
+}
+var file_pfs_pfs_proto_depIdxs = []int32{
+	21,  // 0: pfs_v2.Repo.project:type_name -> pfs_v2.Project
+	6,   // 1: pfs_v2.Branch.repo:type_name -> pfs_v2.Repo
+	6,   // 2: pfs_v2.Tag.repo:type_name -> pfs_v2.Repo
+	16,  // 3: pfs_v2.File.commit:type_name -> pfs_v2.Commit
+	6,   // 4: pfs_v2.RepoInfo.repo:type_name -> pfs_v2.Repo
+	135, // 5: pfs_v2.RepoInfo.created:type_name -> google.protobuf.Timestamp
+	7,   // 6: pfs_v2.RepoInfo.branches:type_name -> pfs_v2.Branch
+	11,  // 7: pfs_v2.RepoInfo.auth_info:type_name -> pfs_v2.AuthInfo
+	107, // 8: pfs_v2.RepoInfo.details:type_name -> pfs_v2.RepoInfo.Details
+	108, // 9: pfs_v2.RepoInfo.metadata:type_name -> pfs_v2.RepoInfo.MetadataEntry
+	13,  // 10: pfs_v2.RepoInfo.retention_policy:type_name -> pfs_v2.RetentionPolicy
+	136, // 11: pfs_v2.AuthInfo.permissions:type_name -> auth_v2.Permission
+	7,   // 12: pfs_v2.BranchInfo.branch:type_name -> pfs_v2.Branch
+	16,  // 13: pfs_v2.BranchInfo.head:type_name -> pfs_v2.Commit
+	7,   // 14: pfs_v2.BranchInfo.provenance:type_name -> pfs_v2.Branch
+	7,   // 15: pfs_v2.BranchInfo.subvenance:type_name -> pfs_v2.Branch
+	7,   // 16: pfs_v2.BranchInfo.direct_provenance:type_name -> pfs_v2.Branch
+	14,  // 17: pfs_v2.BranchInfo.trigger:type_name -> pfs_v2.Trigger
+	109, // 18: pfs_v2.BranchInfo.metadata:type_name -> pfs_v2.BranchInfo.MetadataEntry
+	13,  // 19: pfs_v2.BranchInfo.retention_policy:type_name -> pfs_v2.RetentionPolicy
+	137, // 20: pfs_v2.RetentionPolicy.keep_newer_than:type_name -> google.protobuf.Duration
+	110, // 21: pfs_v2.RetentionPolicy.keep_metadata:type_name -> pfs_v2.RetentionPolicy.KeepMetadataEntry
+	0,   // 22: pfs_v2.CommitOrigin.kind:type_name -> pfs_v2.OriginKind
+	6,   // 23: pfs_v2.Commit.repo:type_name -> pfs_v2.Repo
+	7,   // 24: pfs_v2.Commit.branch:type_name -> pfs_v2.Branch
+	16,  // 25: pfs_v2.CommitInfo.commit:type_name -> pfs_v2.Commit
+	15,  // 26: pfs_v2.CommitInfo.origin:type_name -> pfs_v2.CommitOrigin
+	16,  // 27: pfs_v2.CommitInfo.parent_commit:type_name -> pfs_v2.Commit
+	16,  // 28: pfs_v2.CommitInfo.child_commits:type_name -> pfs_v2.Commit
+	135, // 29: pfs_v2.CommitInfo.started:type_name -> google.protobuf.Timestamp
+	135, // 30: pfs_v2.CommitInfo.finishing:type_name -> google.protobuf.Timestamp
+	135, // 31: pfs_v2.CommitInfo.finished:type_name -> google.protobuf.Timestamp
+	16,  // 32: pfs_v2.CommitInfo.direct_provenance:type_name -> pfs_v2.Commit
+	111, // 33: pfs_v2.CommitInfo.details:type_name -> pfs_v2.CommitInfo.Details
+	112, // 34: pfs_v2.CommitInfo.metadata:type_name -> pfs_v2.CommitInfo.MetadataEntry
+	18,  // 35: pfs_v2.CommitSetInfo.commit_set:type_name -> pfs_v2.CommitSet
+	17,  // 36: pfs_v2.CommitSetInfo.commits:type_name -> pfs_v2.CommitInfo
+	9,   // 37: pfs_v2.FileInfo.file:type_name -> pfs_v2.File
+	1,   // 38: pfs_v2.FileInfo.file_type:type_name -> pfs_v2.FileType
+	135, // 39: pfs_v2.FileInfo.committed:type_name -> google.protobuf.Timestamp
+	113, // 40: pfs_v2.FileInfo.metadata:type_name -> pfs_v2.FileInfo.MetadataEntry
+	21,  // 41: pfs_v2.ProjectInfo.project:type_name -> pfs_v2.Project
+	11,  // 42: pfs_v2.ProjectInfo.auth_info:type_name -> pfs_v2.AuthInfo
+	135, // 43: pfs_v2.ProjectInfo.created_at:type_name -> google.protobuf.Timestamp
+	114, // 44: pfs_v2.ProjectInfo.metadata:type_name -> pfs_v2.ProjectInfo.MetadataEntry
+	6,   // 45: pfs_v2.CreateRepoRequest.repo:type_name -> pfs_v2.Repo
+	6,   // 46: pfs_v2.InspectRepoRequest.repo:type_name -> pfs_v2.Repo
+	21,  // 47: pfs_v2.ListRepoRequest.projects:type_name -> pfs_v2.Project
+	115, // 48: pfs_v2.ListRepoRequest.metadata:type_name -> pfs_v2.ListRepoRequest.MetadataEntry
+	6,   // 49: pfs_v2.DeleteRepoRequest.repo:type_name -> pfs_v2.Repo
+	21,  // 50: pfs_v2.DeleteReposRequest.projects:type_name -> pfs_v2.Project
+	6,   // 51: pfs_v2.DeleteReposResponse.repos:type_name -> pfs_v2.Repo
+	16,  // 52: pfs_v2.StartCommitRequest.parent:type_name -> pfs_v2.Commit
+	7,   // 53: pfs_v2.StartCommitRequest.branch:type_name -> pfs_v2.Branch
+	16,  // 54: pfs_v2.FinishCommitRequest.commit:type_name -> pfs_v2.Commit
+	16,  // 55: pfs_v2.InspectCommitRequest.commit:type_name -> pfs_v2.Commit
+	2,   // 56: pfs_v2.InspectCommitRequest.wait:type_name -> pfs_v2.CommitState
+	6,   // 57: pfs_v2.ListCommitRequest.repo:type_name -> pfs_v2.Repo
+	16,  // 58: pfs_v2.ListCommitRequest.from:type_name -> pfs_v2.Commit
+	16,  // 59: pfs_v2.ListCommitRequest.to:type_name -> pfs_v2.Commit
+	0,   // 60: pfs_v2.ListCommitRequest.origin_kind:type_name -> pfs_v2.OriginKind
+	135, // 61: pfs_v2.ListCommitRequest.started_time:type_name -> google.protobuf.Timestamp
+	116, // 62: pfs_v2.ListCommitRequest.metadata:type_name -> pfs_v2.ListCommitRequest.MetadataEntry
+	18,  // 63: pfs_v2.InspectCommitSetRequest.commit_set:type_name -> pfs_v2.CommitSet
+	21,  // 64: pfs_v2.ListCommitSetRequest.project:type_name -> pfs_v2.Project
+	18,  // 65: pfs_v2.SquashCommitSetRequest.commit_set:type_name -> pfs_v2.CommitSet
+	18,  // 66: pfs_v2.DropCommitSetRequest.commit_set:type_name -> pfs_v2.CommitSet
+	6,   // 67: pfs_v2.SubscribeCommitRequest.repo:type_name -> pfs_v2.Repo
+	16,  // 68: pfs_v2.SubscribeCommitRequest.from:type_name -> pfs_v2.Commit
+	2,   // 69: pfs_v2.SubscribeCommitRequest.state:type_name -> pfs_v2.CommitState
+	0,   // 70: pfs_v2.SubscribeCommitRequest.origin_kind:type_name -> pfs_v2.OriginKind
+	16,  // 71: pfs_v2.ClearCommitRequest.commit:type_name -> pfs_v2.Commit
+	16,  // 72: pfs_v2.SquashCommitRequest.commit:type_name -> pfs_v2.Commit
+	16,  // 73: pfs_v2.DropCommitRequest.commit:type_name -> pfs_v2.Commit
+	16,  // 74: pfs_v2.CreateBranchRequest.head:type_name -> pfs_v2.Commit
+	7,   // 75: pfs_v2.CreateBranchRequest.branch:type_name -> pfs_v2.Branch
+	7,   // 76: pfs_v2.CreateBranchRequest.provenance:type_name -> pfs_v2.Branch
+	14,  // 77: pfs_v2.CreateBranchRequest.trigger:type_name -> pfs_v2.Trigger
+	16,  // 78: pfs_v2.FindCommitsRequest.start:type_name -> pfs_v2.Commit
+	16,  // 79: pfs_v2.FindCommitsResponse.found_commit:type_name -> pfs_v2.Commit
+	16,  // 80: pfs_v2.FindCommitsResponse.last_searched_commit:type_name -> pfs_v2.Commit
+	7,   // 81: pfs_v2.InspectBranchRequest.branch:type_name -> pfs_v2.Branch
+	6,   // 82: pfs_v2.ListBranchRequest.repo:type_name -> pfs_v2.Repo
+	7,   // 83: pfs_v2.DeleteBranchRequest.branch:type_name -> pfs_v2.Branch
+	8,   // 84: pfs_v2.TagInfo.tag:type_name -> pfs_v2.Tag
+	16,  // 85: pfs_v2.TagInfo.commit:type_name -> pfs_v2.Commit
+	135, // 86: pfs_v2.TagInfo.created:type_name -> google.protobuf.Timestamp
+	8,   // 87: pfs_v2.CreateTagRequest.tag:type_name -> pfs_v2.Tag
+	16,  // 88: pfs_v2.CreateTagRequest.commit:type_name -> pfs_v2.Commit
+	8,   // 89: pfs_v2.InspectTagRequest.tag:type_name -> pfs_v2.Tag
+	6,   // 90: pfs_v2.ListTagRequest.repo:type_name -> pfs_v2.Repo
+	8,   // 91: pfs_v2.DeleteTagRequest.tag:type_name -> pfs_v2.Tag
+	21,  // 92: pfs_v2.CreateProjectRequest.project:type_name -> pfs_v2.Project
+	21,  // 93: pfs_v2.InspectProjectRequest.project:type_name -> pfs_v2.Project
+	21,  // 94: pfs_v2.DeleteProjectRequest.project:type_name -> pfs_v2.Project
+	117, // 95: pfs_v2.EditMetadataRequest.edits:type_name -> pfs_v2.EditMetadataRequest.Edit
+	6,   // 96: pfs_v2.SetRetentionPolicyRequest.repo:type_name -> pfs_v2.Repo
+	7,   // 97: pfs_v2.SetRetentionPolicyRequest.branch:type_name -> pfs_v2.Branch
+	13,  // 98: pfs_v2.SetRetentionPolicyRequest.policy:type_name -> pfs_v2.RetentionPolicy
+	6,   // 99: pfs_v2.ApplyRetentionPolicyRequest.repo:type_name -> pfs_v2.Repo
+	7,   // 100: pfs_v2.ApplyRetentionPolicyRequest.branch:type_name -> pfs_v2.Branch
+	16,  // 101: pfs_v2.ApplyRetentionPolicyResponse.expired:type_name -> pfs_v2.Commit
+	6,   // 102: pfs_v2.PurgePathRequest.repo:type_name -> pfs_v2.Repo
+	6,   // 103: pfs_v2.PurgedDatums.repo:type_name -> pfs_v2.Repo
+	16,  // 104: pfs_v2.PurgePathResponse.commits:type_name -> pfs_v2.Commit
+	65,  // 105: pfs_v2.PurgePathResponse.datums:type_name -> pfs_v2.PurgedDatums
+	138, // 106: pfs_v2.AddFile.raw:type_name -> google.protobuf.BytesValue
+	123, // 107: pfs_v2.AddFile.url:type_name -> pfs_v2.AddFile.URLSource
+	124, // 108: pfs_v2.AddFile.metadata:type_name -> pfs_v2.AddFile.MetadataEntry
+	9,   // 109: pfs_v2.CopyFile.src:type_name -> pfs_v2.File
+	16,  // 110: pfs_v2.ModifyFileRequest.set_commit:type_name -> pfs_v2.Commit
+	67,  // 111: pfs_v2.ModifyFileRequest.add_file:type_name -> pfs_v2.AddFile
+	68,  // 112: pfs_v2.ModifyFileRequest.delete_file:type_name -> pfs_v2.DeleteFile
+	69,  // 113: pfs_v2.ModifyFileRequest.copy_file:type_name -> pfs_v2.CopyFile
+	9,   // 114: pfs_v2.GetFileRequest.file:type_name -> pfs_v2.File
+	86,  // 115: pfs_v2.GetFileRequest.path_range:type_name -> pfs_v2.PathRange
+	9,   // 116: pfs_v2.InspectFileRequest.file:type_name -> pfs_v2.File
+	9,   // 117: pfs_v2.ListFileRequest.file:type_name -> pfs_v2.File
+	9,   // 118: pfs_v2.ListFileRequest.paginationMarker:type_name -> pfs_v2.File
+	9,   // 119: pfs_v2.WalkFileRequest.file:type_name -> pfs_v2.File
+	9,   // 120: pfs_v2.WalkFileRequest.paginationMarker:type_name -> pfs_v2.File
+	16,  // 121: pfs_v2.GlobFileRequest.commit:type_name -> pfs_v2.Commit
+	86,  // 122: pfs_v2.GlobFileRequest.path_range:type_name -> pfs_v2.PathRange
+	9,   // 123: pfs_v2.DiffFileRequest.new_file:type_name -> pfs_v2.File
+	9,   // 124: pfs_v2.DiffFileRequest.old_file:type_name -> pfs_v2.File
+	20,  // 125: pfs_v2.DiffFileResponse.new_file:type_name -> pfs_v2.FileInfo
+	20,  // 126: pfs_v2.DiffFileResponse.old_file:type_name -> pfs_v2.FileInfo
+	16,  // 127: pfs_v2.FsckRequest.zombie_target:type_name -> pfs_v2.Commit
+	16,  // 128: pfs_v2.GetFileSetRequest.commit:type_name -> pfs_v2.Commit
+	16,  // 129: pfs_v2.AddFileSetRequest.commit:type_name -> pfs_v2.Commit
+	86,  // 130: pfs_v2.ShardFileSetResponse.shards:type_name -> pfs_v2.PathRange
+	89,  // 131: pfs_v2.CheckStorageResponse.consistency:type_name -> pfs_v2.StorageConsistency
+	6,   // 132: pfs_v2.RepoStorageStats.repo:type_name -> pfs_v2.Repo
+	92,  // 133: pfs_v2.RepoStorageStats.stats:type_name -> pfs_v2.StorageStats
+	21,  // 134: pfs_v2.ProjectStorageStats.project:type_name -> pfs_v2.Project
+	92,  // 135: pfs_v2.ProjectStorageStats.stats:type_name -> pfs_v2.StorageStats
+	6,   // 136: pfs_v2.SharedStorageStats.repo_a:type_name -> pfs_v2.Repo
+	6,   // 137: pfs_v2.SharedStorageStats.repo_b:type_name -> pfs_v2.Repo
+	92,  // 138: pfs_v2.InspectStorageResponse.total:type_name -> pfs_v2.StorageStats
+	94,  // 139: pfs_v2.InspectStorageResponse.projects:type_name -> pfs_v2.ProjectStorageStats
+	93,  // 140: pfs_v2.InspectStorageResponse.repos:type_name -> pfs_v2.RepoStorageStats
+	95,  // 141: pfs_v2.InspectStorageResponse.shared:type_name -> pfs_v2.SharedStorageStats
+	135, // 142: pfs_v2.InspectStorageResponse.computed_at:type_name -> google.protobuf.Timestamp
+	139, // 143: pfs_v2.PutCacheRequest.value:type_name -> google.protobuf.Any
+	139, // 144: pfs_v2.GetCacheResponse.value:type_name -> google.protobuf.Any
+	125, // 145: pfs_v2.SQLDatabaseEgress.file_format:type_name -> pfs_v2.SQLDatabaseEgress.FileFormat
+	126, // 146: pfs_v2.SQLDatabaseEgress.secret:type_name -> pfs_v2.SQLDatabaseEgress.Secret
+	4,   // 147: pfs_v2.SQLDatabaseEgress.mode:type_name -> pfs_v2.SQLDatabaseEgress.Mode
+	128, // 148: pfs_v2.SQLDatabaseEgress.primary_keys:type_name -> pfs_v2.SQLDatabaseEgress.PrimaryKeysEntry
+	16,  // 149: pfs_v2.EgressRequest.commit:type_name -> pfs_v2.Commit
+	103, // 150: pfs_v2.EgressRequest.object_storage:type_name -> pfs_v2.ObjectStorageEgress
+	104, // 151: pfs_v2.EgressRequest.sql_database:type_name -> pfs_v2.SQLDatabaseEgress
+	129, // 152: pfs_v2.EgressResponse.object_storage:type_name -> pfs_v2.EgressResponse.ObjectStorageResult
+	130, // 153: pfs_v2.EgressResponse.sql_database:type_name -> pfs_v2.EgressResponse.SQLDatabaseResult
+	137, // 154: pfs_v2.CommitInfo.Details.compacting_time:type_name -> google.protobuf.Duration
+	137, // 155: pfs_v2.CommitInfo.Details.validating_time:type_name -> google.protobuf.Duration
+	21,  // 156: pfs_v2.EditMetadataRequest.Edit.project:type_name -> pfs_v2.Project
+	6,   // 157: pfs_v2.EditMetadataRequest.Edit.repo:type_name -> pfs_v2.Repo
+	7,   // 158: pfs_v2.EditMetadataRequest.Edit.branch:type_name -> pfs_v2.Branch
+	16,  // 159: pfs_v2.EditMetadataRequest.Edit.commit:type_name -> pfs_v2.Commit
+	118, // 160: pfs_v2.EditMetadataRequest.Edit.replace:type_name -> pfs_v2.EditMetadataRequest.Edit.Replace
+	119, // 161: pfs_v2.EditMetadataRequest.Edit.add_key:type_name -> pfs_v2.EditMetadataRequest.Edit.AddKey
+	120, // 162: pfs_v2.EditMetadataRequest.Edit.edit_key:type_name -> pfs_v2.EditMetadataRequest.Edit.EditKey
+	121, // 163: pfs_v2.EditMetadataRequest.Edit.delete_key:type_name -> pfs_v2.EditMetadataRequest.Edit.DeleteKey
+	122, // 164: pfs_v2.EditMetadataRequest.Edit.Replace.replacement:type_name -> pfs_v2.EditMetadataRequest.Edit.Replace.ReplacementEntry
+	5,   // 165: pfs_v2.SQLDatabaseEgress.FileFormat.type:type_name -> pfs_v2.SQLDatabaseEgress.FileFormat.Type
+	127, // 166: pfs_v2.SQLDatabaseEgress.PrimaryKeysEntry.value:type_name -> pfs_v2.SQLDatabaseEgress.PrimaryKey
+	131, // 167: pfs_v2.EgressResponse.SQLDatabaseResult.rows_written:type_name -> pfs_v2.EgressResponse.SQLDatabaseResult.RowsWrittenEntry
+	132, // 168: pfs_v2.EgressResponse.SQLDatabaseResult.rows_inserted:type_name -> pfs_v2.EgressResponse.SQLDatabaseResult.RowsInsertedEntry
+	133, // 169: pfs_v2.EgressResponse.SQLDatabaseResult.rows_updated:type_name -> pfs_v2.EgressResponse.SQLDatabaseResult.RowsUpdatedEntry
+	134, // 170: pfs_v2.EgressResponse.SQLDatabaseResult.rows_deleted:type_name -> pfs_v2.EgressResponse.SQLDatabaseResult.RowsDeletedEntry
+	16,  // 171: pfs_v2.EgressResponse.SQLDatabaseResult.base_commit:type_name -> pfs_v2.Commit
+	23,  // 172: pfs_v2.API.CreateRepo:input_type -> pfs_v2.CreateRepoRequest
+	24,  // 173: pfs_v2.API.InspectRepo:input_type -> pfs_v2.InspectRepoRequest
+	25,  // 174: pfs_v2.API.ListRepo:input_type -> pfs_v2.ListRepoRequest
+	26,  // 175: pfs_v2.API.DeleteRepo:input_type -> pfs_v2.DeleteRepoRequest
+	27,  // 176: pfs_v2.API.DeleteRepos:input_type -> pfs_v2.DeleteReposRequest
+	30,  // 177: pfs_v2.API.StartCommit:input_type -> pfs_v2.StartCommitRequest
+	31,  // 178: pfs_v2.API.FinishCommit:input_type -> pfs_v2.FinishCommitRequest
+	39,  // 179: pfs_v2.API.ClearCommit:input_type -> pfs_v2.ClearCommitRequest
+	32,  // 180: pfs_v2.API.InspectCommit:input_type -> pfs_v2.InspectCommitRequest
+	33,  // 181: pfs_v2.API.ListCommit:input_type -> pfs_v2.ListCommitRequest
+	38,  // 182: pfs_v2.API.SubscribeCommit:input_type -> pfs_v2.SubscribeCommitRequest
+	40,  // 183: pfs_v2.API.SquashCommit:input_type -> pfs_v2.SquashCommitRequest
+	42,  // 184: pfs_v2.API.DropCommit:input_type -> pfs_v2.DropCommitRequest
+	34,  // 185: pfs_v2.API.InspectCommitSet:input_type -> pfs_v2.InspectCommitSetRequest
+	35,  // 186: pfs_v2.API.ListCommitSet:input_type -> pfs_v2.ListCommitSetRequest
+	36,  // 187: pfs_v2.API.SquashCommitSet:input_type -> pfs_v2.SquashCommitSetRequest
+	37,  // 188: pfs_v2.API.DropCommitSet:input_type -> pfs_v2.DropCommitSetRequest
+	45,  // 189: pfs_v2.API.FindCommits:input_type -> pfs_v2.FindCommitsRequest
+	44,  // 190: pfs_v2.API.CreateBranch:input_type -> pfs_v2.CreateBranchRequest
+	47,  // 191: pfs_v2.API.InspectBranch:input_type -> pfs_v2.InspectBranchRequest
+	48,  // 192: pfs_v2.API.ListBranch:input_type -> pfs_v2.ListBranchRequest
+	49,  // 193: pfs_v2.API.DeleteBranch:input_type -> pfs_v2.DeleteBranchRequest
+	51,  // 194: pfs_v2.API.CreateTag:input_type -> pfs_v2.CreateTagRequest
+	52,  // 195: pfs_v2.API.InspectTag:input_type -> pfs_v2.InspectTagRequest
+	53,  // 196: pfs_v2.API.ListTag:input_type -> pfs_v2.ListTagRequest
+	54,  // 197: pfs_v2.API.DeleteTag:input_type -> pfs_v2.DeleteTagRequest
+	70,  // 198: pfs_v2.API.ModifyFile:input_type -> pfs_v2.ModifyFileRequest
+	71,  // 199: pfs_v2.API.GetFile:input_type -> pfs_v2.GetFileRequest
+	71,  // 200: pfs_v2.API.GetFileTAR:input_type -> pfs_v2.GetFileRequest
+	72,  // 201: pfs_v2.API.InspectFile:input_type -> pfs_v2.InspectFileRequest
+	73,  // 202: pfs_v2.API.ListFile:input_type -> pfs_v2.ListFileRequest
+	74,  // 203: pfs_v2.API.WalkFile:input_type -> pfs_v2.WalkFileRequest
+	75,  // 204: pfs_v2.API.GlobFile:input_type -> pfs_v2.GlobFileRequest
+	76,  // 205: pfs_v2.API.DiffFile:input_type -> pfs_v2.DiffFileRequest
+	101, // 206: pfs_v2.API.ActivateAuth:input_type -> pfs_v2.ActivateAuthRequest
+	140, // 207: pfs_v2.API.DeleteAll:input_type -> google.protobuf.Empty
+	78,  // 208: pfs_v2.API.Fsck:input_type -> pfs_v2.FsckRequest
+	70,  // 209: pfs_v2.API.CreateFileSet:input_type -> pfs_v2.ModifyFileRequest
+	81,  // 210: pfs_v2.API.GetFileSet:input_type -> pfs_v2.GetFileSetRequest
+	82,  // 211: pfs_v2.API.AddFileSet:input_type -> pfs_v2.AddFileSetRequest
+	83,  // 212: pfs_v2.API.RenewFileSet:input_type -> pfs_v2.RenewFileSetRequest
+	84,  // 213: pfs_v2.API.ComposeFileSet:input_type -> pfs_v2.ComposeFileSetRequest
+	85,  // 214: pfs_v2.API.ShardFileSet:input_type -> pfs_v2.ShardFileSetRequest
+	88,  // 215: pfs_v2.API.CheckStorage:input_type -> pfs_v2.CheckStorageRequest
+	91,  // 216: pfs_v2.API.InspectStorage:input_type -> pfs_v2.InspectStorageRequest
+	97,  // 217: pfs_v2.API.PutCache:input_type -> pfs_v2.PutCacheRequest
+	98,  // 218: pfs_v2.API.GetCache:input_type -> pfs_v2.GetCacheRequest
+	100, // 219: pfs_v2.API.ClearCache:input_type -> pfs_v2.ClearCacheRequest
+	141, // 220: pfs_v2.API.ListTask:input_type -> taskapi.ListTaskRequest
+	105, // 221: pfs_v2.API.Egress:input_type -> pfs_v2.EgressRequest
+	55,  // 222: pfs_v2.API.CreateProject:input_type -> pfs_v2.CreateProjectRequest
+	56,  // 223: pfs_v2.API.InspectProject:input_type -> pfs_v2.InspectProjectRequest
+	57,  // 224: pfs_v2.API.ListProject:input_type -> pfs_v2.ListProjectRequest
+	58,  // 225: pfs_v2.API.DeleteProject:input_type -> pfs_v2.DeleteProjectRequest
+	59,  // 226: pfs_v2.API.EditMetadata:input_type -> pfs_v2.EditMetadataRequest
+	61,  // 227: pfs_v2.API.SetRetentionPolicy:input_type -> pfs_v2.SetRetentionPolicyRequest
+	62,  // 228: pfs_v2.API.ApplyRetentionPolicy:input_type -> pfs_v2.ApplyRetentionPolicyRequest
+	64,  // 229: pfs_v2.API.PurgePath:input_type -> pfs_v2.PurgePathRequest
+	140, // 230: pfs_v2.API.CreateRepo:output_type -> google.protobuf.Empty
+	10,  // 231: pfs_v2.API.InspectRepo:output_type -> pfs_v2.RepoInfo
+	10,  // 232: pfs_v2.API.ListRepo:output_type -> pfs_v2.RepoInfo
+	28,  // 233: pfs_v2.API.DeleteRepo:output_type -> pfs_v2.DeleteRepoResponse
+	29,  // 234: pfs_v2.API.DeleteRepos:output_type -> pfs_v2.DeleteReposResponse
+	16,  // 235: pfs_v2.API.StartCommit:output_type -> pfs_v2.Commit
+	140, // 236: pfs_v2.API.FinishCommit:output_type -> google.protobuf.Empty
+	140, // 237: pfs_v2.API.ClearCommit:output_type -> google.protobuf.Empty
+	17,  // 238: pfs_v2.API.InspectCommit:output_type -> pfs_v2.CommitInfo
+	17,  // 239: pfs_v2.API.ListCommit:output_type -> pfs_v2.CommitInfo
+	17,  // 240: pfs_v2.API.SubscribeCommit:output_type -> pfs_v2.CommitInfo
+	41,  // 241: pfs_v2.API.SquashCommit:output_type -> pfs_v2.SquashCommitResponse
+	43,  // 242: pfs_v2.API.DropCommit:output_type -> pfs_v2.DropCommitResponse
+	17,  // 243: pfs_v2.API.InspectCommitSet:output_type -> pfs_v2.CommitInfo
+	19,  // 244: pfs_v2.API.ListCommitSet:output_type -> pfs_v2.CommitSetInfo
+	140, // 245: pfs_v2.API.SquashCommitSet:output_type -> google.protobuf.Empty
+	140, // 246: pfs_v2.API.DropCommitSet:output_type -> google.protobuf.Empty
+	46,  // 247: pfs_v2.API.FindCommits:output_type -> pfs_v2.FindCommitsResponse
+	140, // 248: pfs_v2.API.CreateBranch:output_type -> google.protobuf.Empty
+	12,  // 249: pfs_v2.API.InspectBranch:output_type -> pfs_v2.BranchInfo
+	12,  // 250: pfs_v2.API.ListBranch:output_type -> pfs_v2.BranchInfo
+	140, // 251: pfs_v2.API.DeleteBranch:output_type -> google.protobuf.Empty
+	140, // 252: pfs_v2.API.CreateTag:output_type -> google.protobuf.Empty
+	50,  // 253: pfs_v2.API.InspectTag:output_type -> pfs_v2.TagInfo
+	50,  // 254: pfs_v2.API.ListTag:output_type -> pfs_v2.TagInfo
+	140, // 255: pfs_v2.API.DeleteTag:output_type -> google.protobuf.Empty
+	140, // 256: pfs_v2.API.ModifyFile:output_type -> google.protobuf.Empty
+	138, // 257: pfs_v2.API.GetFile:output_type -> google.protobuf.BytesValue
+	138, // 258: pfs_v2.API.GetFileTAR:output_type -> google.protobuf.BytesValue
+	20,  // 259: pfs_v2.API.InspectFile:output_type -> pfs_v2.FileInfo
+	20,  // 260: pfs_v2.API.ListFile:output_type -> pfs_v2.FileInfo
+	20,  // 261: pfs_v2.API.WalkFile:output_type -> pfs_v2.FileInfo
+	20,  // 262: pfs_v2.API.GlobFile:output_type -> pfs_v2.FileInfo
+	77,  // 263: pfs_v2.API.DiffFile:output_type -> pfs_v2.DiffFileResponse
+	102, // 264: pfs_v2.API.ActivateAuth:output_type -> pfs_v2.ActivateAuthResponse
+	140, // 265: pfs_v2.API.DeleteAll:output_type -> google.protobuf.Empty
+	79,  // 266: pfs_v2.API.Fsck:output_type -> pfs_v2.FsckResponse
+	80,  // 267: pfs_v2.API.CreateFileSet:output_type -> pfs_v2.CreateFileSetResponse
+	80,  // 268: pfs_v2.API.GetFileSet:output_type -> pfs_v2.CreateFileSetResponse
+	140, // 269: pfs_v2.API.AddFileSet:output_type -> google.protobuf.Empty
+	140, // 270: pfs_v2.API.RenewFileSet:output_type -> google.protobuf.Empty
+	80,  // 271: pfs_v2.API.ComposeFileSet:output_type -> pfs_v2.CreateFileSetResponse
+	87,  // 272: pfs_v2.API.ShardFileSet:output_type -> pfs_v2.ShardFileSetResponse
+	90,  // 273: pfs_v2.API.CheckStorage:output_type -> pfs_v2.CheckStorageResponse
+	96,  // 274: pfs_v2.API.InspectStorage:output_type -> pfs_v2.InspectStorageResponse
+	140, // 275: pfs_v2.API.PutCache:output_type -> google.protobuf.Empty
+	99,  // 276: pfs_v2.API.GetCache:output_type -> pfs_v2.GetCacheResponse
+	140, // 277: pfs_v2.API.ClearCache:output_type -> google.protobuf.Empty
+	142, // 278: pfs_v2.API.ListTask:output_type -> taskapi.TaskInfo
+	106, // 279: pfs_v2.API.Egress:output_type -> pfs_v2.EgressResponse
+	140, // 280: pfs_v2.API.CreateProject:output_type -> google.protobuf.Empty
+	22,  // 281: pfs_v2.API.InspectProject:output_type -> pfs_v2.ProjectInfo
+	22,  // 282: pfs_v2.API.ListProject:output_type -> pfs_v2.ProjectInfo
+	140, // 283: pfs_v2.API.DeleteProject:output_type -> google.protobuf.Empty
+	60,  // 284: pfs_v2.API.EditMetadata:output_type -> pfs_v2.EditMetadataResponse
+	140, // 285: pfs_v2.API.SetRetentionPolicy:output_type -> google.protobuf.Empty
+	63,  // 286: pfs_v2.API.ApplyRetentionPolicy:output_type -> pfs_v2.ApplyRetentionPolicyResponse
+	66,  // 287: pfs_v2.API.PurgePath:output_type -> pfs_v2.PurgePathResponse
+	230, // [230:288] is the sub-list for method output_type
+	172, // [172:230] is the sub-list for method input_type
+	172, // [172:172] is the sub-list for extension type_name
+	172, // [172:172] is the sub-list for extension extendee
+	0,   // [0:172] is the sub-list for field type_name
+}
+
+func init() { file_pfs_pfs_proto_init() }
+func file_pfs_pfs_proto_init() {
+	if File_pfs_pfs_proto != nil {
+		return
 	}
 	if !protoimpl.UnsafeEnabled {
 		file_pfs_pfs_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
@@ -6802,8 +9407,128 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Branch); i {
+		file_pfs_pfs_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Branch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfs_pfs_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Tag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfs_pfs_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*File); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfs_pfs_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepoInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfs_pfs_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfs_pfs_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BranchInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfs_pfs_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetentionPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfs_pfs_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Trigger); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfs_pfs_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitOrigin); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfs_pfs_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Commit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfs_pfs_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6814,8 +9539,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*File); i {
+		file_pfs_pfs_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitSet); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6826,8 +9551,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RepoInfo); i {
+		file_pfs_pfs_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitSetInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6838,8 +9563,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthInfo); i {
+		file_pfs_pfs_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6850,8 +9575,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BranchInfo); i {
+		file_pfs_pfs_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Project); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6862,8 +9587,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Trigger); i {
+		file_pfs_pfs_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProjectInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6874,8 +9599,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CommitOrigin); i {
+		file_pfs_pfs_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRepoRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6886,8 +9611,224 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Commit); i {
+		file_pfs_pfs_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InspectRepoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfs_pfs_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRepoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfs_pfs_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteRepoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfs_pfs_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteReposRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfs_pfs_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteRepoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfs_pfs_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteReposResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfs_pfs_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartCommitRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfs_pfs_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FinishCommitRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfs_pfs_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InspectCommitRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfs_pfs_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCommitRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfs_pfs_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InspectCommitSetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfs_pfs_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCommitSetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfs_pfs_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SquashCommitSetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfs_pfs_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DropCommitSetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfs_pfs_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeCommitRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfs_pfs_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClearCommitRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfs_pfs_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SquashCommitRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfs_pfs_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SquashCommitResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfs_pfs_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DropCommitRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6898,8 +9839,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CommitInfo); i {
+		file_pfs_pfs_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DropCommitResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6910,8 +9851,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CommitSet); i {
+		file_pfs_pfs_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBranchRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6922,8 +9863,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CommitSetInfo); i {
+		file_pfs_pfs_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindCommitsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6934,8 +9875,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FileInfo); i {
+		file_pfs_pfs_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindCommitsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6946,8 +9887,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Project); i {
+		file_pfs_pfs_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InspectBranchRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6958,8 +9899,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProjectInfo); i {
+		file_pfs_pfs_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBranchRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6970,8 +9911,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateRepoRequest); i {
+		file_pfs_pfs_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteBranchRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6982,8 +9923,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InspectRepoRequest); i {
+		file_pfs_pfs_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TagInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6994,8 +9935,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListRepoRequest); i {
+		file_pfs_pfs_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateTagRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7006,8 +9947,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteRepoRequest); i {
+		file_pfs_pfs_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InspectTagRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7018,8 +9959,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteReposRequest); i {
+		file_pfs_pfs_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTagRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7030,8 +9971,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteRepoResponse); i {
+		file_pfs_pfs_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteTagRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7042,8 +9983,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteReposResponse); i {
+		file_pfs_pfs_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateProjectRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7054,8 +9995,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StartCommitRequest); i {
+		file_pfs_pfs_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InspectProjectRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7066,8 +10007,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FinishCommitRequest); i {
+		file_pfs_pfs_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListProjectRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7078,8 +10019,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InspectCommitRequest); i {
+		file_pfs_pfs_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteProjectRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7090,8 +10031,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListCommitRequest); i {
+		file_pfs_pfs_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EditMetadataRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7102,8 +10043,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InspectCommitSetRequest); i {
+		file_pfs_pfs_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EditMetadataResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7114,8 +10055,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListCommitSetRequest); i {
+		file_pfs_pfs_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetRetentionPolicyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7126,8 +10067,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SquashCommitSetRequest); i {
+		file_pfs_pfs_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyRetentionPolicyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7138,8 +10079,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DropCommitSetRequest); i {
+		file_pfs_pfs_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyRetentionPolicyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7150,8 +10091,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeCommitRequest); i {
+		file_pfs_pfs_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PurgePathRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7162,8 +10103,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClearCommitRequest); i {
+		file_pfs_pfs_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PurgedDatums); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7174,8 +10115,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SquashCommitRequest); i {
+		file_pfs_pfs_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PurgePathResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7186,8 +10127,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SquashCommitResponse); i {
+		file_pfs_pfs_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddFile); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7198,8 +10139,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DropCommitRequest); i {
+		file_pfs_pfs_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteFile); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7210,8 +10151,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DropCommitResponse); i {
+		file_pfs_pfs_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CopyFile); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7222,8 +10163,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateBranchRequest); i {
+		file_pfs_pfs_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModifyFileRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7234,8 +10175,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FindCommitsRequest); i {
+		file_pfs_pfs_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFileRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7246,8 +10187,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FindCommitsResponse); i {
+		file_pfs_pfs_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InspectFileRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7258,8 +10199,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InspectBranchRequest); i {
+		file_pfs_pfs_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFileRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7270,8 +10211,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListBranchRequest); i {
+		file_pfs_pfs_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WalkFileRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7282,8 +10223,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteBranchRequest); i {
+		file_pfs_pfs_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GlobFileRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7294,8 +10235,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateProjectRequest); i {
+		file_pfs_pfs_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffFileRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7306,8 +10247,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InspectProjectRequest); i {
+		file_pfs_pfs_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffFileResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7318,8 +10259,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListProjectRequest); i {
+		file_pfs_pfs_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FsckRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7330,8 +10271,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteProjectRequest); i {
+		file_pfs_pfs_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FsckResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7342,8 +10283,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddFile); i {
+		file_pfs_pfs_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateFileSetResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7354,8 +10295,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteFile); i {
+		file_pfs_pfs_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFileSetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7366,8 +10307,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CopyFile); i {
+		file_pfs_pfs_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddFileSetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7378,8 +10319,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ModifyFileRequest); i {
+		file_pfs_pfs_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenewFileSetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7390,8 +10331,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetFileRequest); i {
+		file_pfs_pfs_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ComposeFileSetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7402,8 +10343,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InspectFileRequest); i {
+		file_pfs_pfs_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShardFileSetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7414,8 +10355,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListFileRequest); i {
+		file_pfs_pfs_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PathRange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7426,8 +10367,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WalkFileRequest); i {
+		file_pfs_pfs_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShardFileSetResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7438,8 +10379,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GlobFileRequest); i {
+		file_pfs_pfs_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckStorageRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7450,8 +10391,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DiffFileRequest); i {
+		file_pfs_pfs_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StorageConsistency); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7462,8 +10403,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DiffFileResponse); i {
+		file_pfs_pfs_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckStorageResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7474,8 +10415,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FsckRequest); i {
+		file_pfs_pfs_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InspectStorageRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7486,8 +10427,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FsckResponse); i {
+		file_pfs_pfs_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StorageStats); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7498,8 +10439,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateFileSetResponse); i {
+		file_pfs_pfs_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepoStorageStats); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7510,8 +10451,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetFileSetRequest); i {
+		file_pfs_pfs_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProjectStorageStats); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7522,8 +10463,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddFileSetRequest); i {
+		file_pfs_pfs_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SharedStorageStats); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7534,8 +10475,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RenewFileSetRequest); i {
+		file_pfs_pfs_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InspectStorageResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7546,8 +10487,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ComposeFileSetRequest); i {
+		file_pfs_pfs_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PutCacheRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7558,8 +10499,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ShardFileSetRequest); i {
+		file_pfs_pfs_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCacheRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7570,8 +10511,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PathRange); i {
+		file_pfs_pfs_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCacheResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7582,8 +10523,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ShardFileSetResponse); i {
+		file_pfs_pfs_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClearCacheRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7594,8 +10535,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckStorageRequest); i {
+		file_pfs_pfs_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActivateAuthRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7606,8 +10547,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckStorageResponse); i {
+		file_pfs_pfs_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActivateAuthResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7618,8 +10559,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PutCacheRequest); i {
+		file_pfs_pfs_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ObjectStorageEgress); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7630,8 +10571,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCacheRequest); i {
+		file_pfs_pfs_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SQLDatabaseEgress); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7642,8 +10583,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCacheResponse); i {
+		file_pfs_pfs_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EgressRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7654,8 +10595,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClearCacheRequest); i {
+		file_pfs_pfs_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EgressResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7666,8 +10607,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ActivateAuthRequest); i {
+		file_pfs_pfs_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepoInfo_Details); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7678,8 +10619,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ActivateAuthResponse); i {
+		file_pfs_pfs_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitInfo_Details); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7690,8 +10631,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ObjectStorageEgress); i {
+		file_pfs_pfs_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EditMetadataRequest_Edit); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7702,8 +10643,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SQLDatabaseEgress); i {
+		file_pfs_pfs_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EditMetadataRequest_Edit_Replace); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7714,8 +10655,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EgressRequest); i {
+		file_pfs_pfs_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EditMetadataRequest_Edit_AddKey); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7726,8 +10667,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EgressResponse); i {
+		file_pfs_pfs_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EditMetadataRequest_Edit_EditKey); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7738,8 +10679,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RepoInfo_Details); i {
+		file_pfs_pfs_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EditMetadataRequest_Edit_DeleteKey); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7750,8 +10691,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CommitInfo_Details); i {
+		file_pfs_pfs_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddFile_URLSource); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7762,8 +10703,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddFile_URLSource); i {
+		file_pfs_pfs_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SQLDatabaseEgress_FileFormat); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7774,8 +10715,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SQLDatabaseEgress_FileFormat); i {
+		file_pfs_pfs_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SQLDatabaseEgress_Secret); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7786,8 +10727,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SQLDatabaseEgress_Secret); i {
+		file_pfs_pfs_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SQLDatabaseEgress_PrimaryKey); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7798,7 +10739,7 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+		file_pfs_pfs_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EgressResponse_ObjectStorageResult); i {
 			case 0:
 				return &v.state
@@ -7810,7 +10751,7 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+		file_pfs_pfs_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EgressResponse_SQLDatabaseResult); i {
 			case 0:
 				return &v.state
@@ -7823,39 +10764,57 @@ func file_pfs_pfs_proto_init() {
 			}
 		}
 	}
-	file_pfs_pfs_proto_msgTypes[38].OneofWrappers = []interface{}{
+	file_pfs_pfs_proto_msgTypes[40].OneofWrappers = []interface{}{
 		(*FindCommitsResponse_FoundCommit)(nil),
 		(*FindCommitsResponse_LastSearchedCommit)(nil),
 	}
-	file_pfs_pfs_proto_msgTypes[46].OneofWrappers = []interface{}{
+	file_pfs_pfs_proto_msgTypes[55].OneofWrappers = []interface{}{
+		(*SetRetentionPolicyRequest_Repo)(nil),
+		(*SetRetentionPolicyRequest_Branch)(nil),
+	}
+	file_pfs_pfs_proto_msgTypes[56].OneofWrappers = []interface{}{
+		(*ApplyRetentionPolicyRequest_Repo)(nil),
+		(*ApplyRetentionPolicyRequest_Branch)(nil),
+	}
+	file_pfs_pfs_proto_msgTypes[61].OneofWrappers = []interface{}{
 		(*AddFile_Raw)(nil),
 		(*AddFile_Url)(nil),
 	}
-	file_pfs_pfs_proto_msgTypes[49].OneofWrappers = []interface{}{
+	file_pfs_pfs_proto_msgTypes[64].OneofWrappers = []interface{}{
 		(*ModifyFileRequest_SetCommit)(nil),
 		(*ModifyFileRequest_AddFile)(nil),
 		(*ModifyFileRequest_DeleteFile)(nil),
 		(*ModifyFileRequest_CopyFile)(nil),
 	}
-	file_pfs_pfs_proto_msgTypes[57].OneofWrappers = []interface{}{
+	file_pfs_pfs_proto_msgTypes[72].OneofWrappers = []interface{}{
 		(*FsckRequest_ZombieTarget)(nil),
 		(*FsckRequest_ZombieAll)(nil),
 	}
-	file_pfs_pfs_proto_msgTypes[77].OneofWrappers = []interface{}{
+	file_pfs_pfs_proto_msgTypes[99].OneofWrappers = []interface{}{
 		(*EgressRequest_ObjectStorage)(nil),
 		(*EgressRequest_SqlDatabase)(nil),
 	}
-	file_pfs_pfs_proto_msgTypes[78].OneofWrappers = []interface{}{
+	file_pfs_pfs_proto_msgTypes[100].OneofWrappers = []interface{}{
 		(*EgressResponse_ObjectStorage)(nil),
 		(*EgressResponse_SqlDatabase)(nil),
 	}
+	file_pfs_pfs_proto_msgTypes[111].OneofWrappers = []interface{}{
+		(*EditMetadataRequest_Edit_Project)(nil),
+		(*EditMetadataRequest_Edit_Repo)(nil),
+		(*EditMetadataRequest_Edit_Branch)(nil),
+		(*EditMetadataRequest_Edit_Commit)(nil),
+		(*EditMetadataRequest_Edit_Replace_)(nil),
+		(*EditMetadataRequest_Edit_AddKey_)(nil),
+		(*EditMetadataRequest_Edit_EditKey_)(nil),
+		(*EditMetadataRequest_Edit_DeleteKey_)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pfs_pfs_proto_rawDesc,
-			NumEnums:      5,
-			NumMessages:   87,
+			NumEnums:      6,
+			NumMessages:   129,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -299,6 +299,135 @@ var _ interface {
 	ErrorName() string
 } = BranchValidationError{}
 
+// Validate checks the field values on Tag with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Tag) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Tag with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in TagMultiError, or nil if none found.
+func (m *Tag) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Tag) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRepo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TagValidationError{
+					field:  "Repo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TagValidationError{
+					field:  "Repo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRepo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TagValidationError{
+				field:  "Repo",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return TagMultiError(errors)
+	}
+
+	return nil
+}
+
+// TagMultiError is an error wrapping multiple validation errors returned by
+// Tag.ValidateAll() if the designated constraints aren't met.
+type TagMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TagMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TagMultiError) AllErrors() []error { return m }
+
+// TagValidationError is the validation error returned by Tag.Validate if the
+// designated constraints aren't met.
+type TagValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TagValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TagValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TagValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TagValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TagValidationError) ErrorName() string { return "TagValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TagValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTag.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TagValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TagValidationError{}
+
 // Validate checks the field values on File with the rules defined in the proto
 // definition for this message. If any rules are violated, the first error
 // encountered is returned, or nil if there are no violations.
@@ -606,6 +735,37 @@ func (m *RepoInfo) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for Metadata
+
+	if all {
+		switch v := interface{}(m.GetRetentionPolicy()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RepoInfoValidationError{
+					field:  "RetentionPolicy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RepoInfoValidationError{
+					field:  "RetentionPolicy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRetentionPolicy()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RepoInfoValidationError{
+				field:  "RetentionPolicy",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return RepoInfoMultiError(errors)
 	}
@@ -993,6 +1153,37 @@ func (m *BranchInfo) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for Metadata
+
+	if all {
+		switch v := interface{}(m.GetRetentionPolicy()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BranchInfoValidationError{
+					field:  "RetentionPolicy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BranchInfoValidationError{
+					field:  "RetentionPolicy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRetentionPolicy()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BranchInfoValidationError{
+				field:  "RetentionPolicy",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return BranchInfoMultiError(errors)
 	}
@@ -1070,6 +1261,139 @@ var _ interface {
 	ErrorName() string
 } = BranchInfoValidationError{}
 
+// Validate checks the field values on RetentionPolicy with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RetentionPolicy) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RetentionPolicy with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RetentionPolicyMultiError, or nil if none found.
+func (m *RetentionPolicy) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RetentionPolicy) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for KeepLast
+
+	if all {
+		switch v := interface{}(m.GetKeepNewerThan()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RetentionPolicyValidationError{
+					field:  "KeepNewerThan",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RetentionPolicyValidationError{
+					field:  "KeepNewerThan",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetKeepNewerThan()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RetentionPolicyValidationError{
+				field:  "KeepNewerThan",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for KeepMetadata
+
+	if len(errors) > 0 {
+		return RetentionPolicyMultiError(errors)
+	}
+
+	return nil
+}
+
+// RetentionPolicyMultiError is an error wrapping multiple validation errors
+// returned by RetentionPolicy.ValidateAll() if the designated constraints
+// aren't met.
+type RetentionPolicyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RetentionPolicyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RetentionPolicyMultiError) AllErrors() []error { return m }
+
+// RetentionPolicyValidationError is the validation error returned by
+// RetentionPolicy.Validate if the designated constraints aren't met.
+type RetentionPolicyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RetentionPolicyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RetentionPolicyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RetentionPolicyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RetentionPolicyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RetentionPolicyValidationError) ErrorName() string { return "RetentionPolicyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RetentionPolicyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRetentionPolicy.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RetentionPolicyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RetentionPolicyValidationError{}
+
 // Validate checks the field values on Trigger with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -1738,6 +2062,8 @@ func (m *CommitInfo) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for Metadata
+
 	if len(errors) > 0 {
 		return CommitInfoMultiError(errors)
 	}
@@ -2165,6 +2491,10 @@ func (m *FileInfo) validate(all bool) error {
 
 	// no validation rules for Hash
 
+	// no validation rules for ContentType
+
+	// no validation rules for Metadata
+
 	if len(errors) > 0 {
 		return FileInfoMultiError(errors)
 	}
@@ -2453,6 +2783,8 @@ func (m *ProjectInfo) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for Metadata
+
 	if len(errors) > 0 {
 		return ProjectInfoMultiError(errors)
 	}
@@ -2854,6 +3186,8 @@ func (m *ListRepoRequest) validate(all bool) error {
 
 	}
 
+	// no validation rules for Metadata
+
 	if len(errors) > 0 {
 		return ListRepoRequestMultiError(errors)
 	}
@@ -4023,6 +4357,8 @@ func (m *ListCommitRequest) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for Metadata
+
 	if len(errors) > 0 {
 		return ListCommitRequestMultiError(errors)
 	}
@@ -6379,22 +6715,21 @@ var _ interface {
 	ErrorName() string
 } = DeleteBranchRequestValidationError{}
 
-// Validate checks the field values on CreateProjectRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateProjectRequest) Validate() error {
+// Validate checks the field values on TagInfo with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TagInfo) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateProjectRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// CreateProjectRequestMultiError, or nil if none found.
-func (m *CreateProjectRequest) ValidateAll() error {
+// ValidateAll checks the field values on TagInfo with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in TagInfoMultiError, or nil if none found.
+func (m *TagInfo) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateProjectRequest) validate(all bool) error {
+func (m *TagInfo) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -6402,52 +6737,105 @@ func (m *CreateProjectRequest) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetProject()).(type) {
+		switch v := interface{}(m.GetTag()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateProjectRequestValidationError{
-					field:  "Project",
+				errors = append(errors, TagInfoValidationError{
+					field:  "Tag",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateProjectRequestValidationError{
-					field:  "Project",
+				errors = append(errors, TagInfoValidationError{
+					field:  "Tag",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetProject()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetTag()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return CreateProjectRequestValidationError{
-				field:  "Project",
+			return TagInfoValidationError{
+				field:  "Tag",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	// no validation rules for Description
+	if all {
+		switch v := interface{}(m.GetCommit()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TagInfoValidationError{
+					field:  "Commit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TagInfoValidationError{
+					field:  "Commit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCommit()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TagInfoValidationError{
+				field:  "Commit",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
-	// no validation rules for Update
+	if all {
+		switch v := interface{}(m.GetCreated()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TagInfoValidationError{
+					field:  "Created",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TagInfoValidationError{
+					field:  "Created",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreated()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TagInfoValidationError{
+				field:  "Created",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return CreateProjectRequestMultiError(errors)
+		return TagInfoMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateProjectRequestMultiError is an error wrapping multiple validation
-// errors returned by CreateProjectRequest.ValidateAll() if the designated
-// constraints aren't met.
-type CreateProjectRequestMultiError []error
+// TagInfoMultiError is an error wrapping multiple validation errors returned
+// by TagInfo.ValidateAll() if the designated constraints aren't met.
+type TagInfoMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateProjectRequestMultiError) Error() string {
+func (m TagInfoMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6456,11 +6844,11 @@ func (m CreateProjectRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateProjectRequestMultiError) AllErrors() []error { return m }
+func (m TagInfoMultiError) AllErrors() []error { return m }
 
-// CreateProjectRequestValidationError is the validation error returned by
-// CreateProjectRequest.Validate if the designated constraints aren't met.
-type CreateProjectRequestValidationError struct {
+// TagInfoValidationError is the validation error returned by TagInfo.Validate
+// if the designated constraints aren't met.
+type TagInfoValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6468,24 +6856,22 @@ type CreateProjectRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateProjectRequestValidationError) Field() string { return e.field }
+func (e TagInfoValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateProjectRequestValidationError) Reason() string { return e.reason }
+func (e TagInfoValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateProjectRequestValidationError) Cause() error { return e.cause }
+func (e TagInfoValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateProjectRequestValidationError) Key() bool { return e.key }
+func (e TagInfoValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateProjectRequestValidationError) ErrorName() string {
-	return "CreateProjectRequestValidationError"
-}
+func (e TagInfoValidationError) ErrorName() string { return "TagInfoValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CreateProjectRequestValidationError) Error() string {
+func (e TagInfoValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6497,14 +6883,14 @@ func (e CreateProjectRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateProjectRequest.%s: %s%s",
+		"invalid %sTagInfo.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateProjectRequestValidationError{}
+var _ error = TagInfoValidationError{}
 
 var _ interface {
 	Field() string
@@ -6512,33 +6898,33 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateProjectRequestValidationError{}
+} = TagInfoValidationError{}
 
-// Validate checks the field values on InspectProjectRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *InspectProjectRequest) Validate() error {
+// Validate checks the field values on CreateTagRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreateTagRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on InspectProjectRequest with the rules
+// ValidateAll checks the field values on CreateTagRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// InspectProjectRequestMultiError, or nil if none found.
-func (m *InspectProjectRequest) ValidateAll() error {
+// CreateTagRequestMultiError, or nil if none found.
+func (m *CreateTagRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *InspectProjectRequest) validate(all bool) error {
+func (m *CreateTagRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetProject() == nil {
-		err := InspectProjectRequestValidationError{
-			field:  "Project",
+	if m.GetTag() == nil {
+		err := CreateTagRequestValidationError{
+			field:  "Tag",
 			reason: "value is required",
 		}
 		if !all {
@@ -6548,28 +6934,68 @@ func (m *InspectProjectRequest) validate(all bool) error {
 	}
 
 	if all {
-		switch v := interface{}(m.GetProject()).(type) {
+		switch v := interface{}(m.GetTag()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, InspectProjectRequestValidationError{
-					field:  "Project",
+				errors = append(errors, CreateTagRequestValidationError{
+					field:  "Tag",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, InspectProjectRequestValidationError{
-					field:  "Project",
+				errors = append(errors, CreateTagRequestValidationError{
+					field:  "Tag",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetProject()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetTag()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return InspectProjectRequestValidationError{
-				field:  "Project",
+			return CreateTagRequestValidationError{
+				field:  "Tag",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetCommit() == nil {
+		err := CreateTagRequestValidationError{
+			field:  "Commit",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetCommit()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateTagRequestValidationError{
+					field:  "Commit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateTagRequestValidationError{
+					field:  "Commit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCommit()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateTagRequestValidationError{
+				field:  "Commit",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -6577,19 +7003,19 @@ func (m *InspectProjectRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return InspectProjectRequestMultiError(errors)
+		return CreateTagRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// InspectProjectRequestMultiError is an error wrapping multiple validation
-// errors returned by InspectProjectRequest.ValidateAll() if the designated
-// constraints aren't met.
-type InspectProjectRequestMultiError []error
+// CreateTagRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateTagRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreateTagRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m InspectProjectRequestMultiError) Error() string {
+func (m CreateTagRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6598,11 +7024,11 @@ func (m InspectProjectRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m InspectProjectRequestMultiError) AllErrors() []error { return m }
+func (m CreateTagRequestMultiError) AllErrors() []error { return m }
 
-// InspectProjectRequestValidationError is the validation error returned by
-// InspectProjectRequest.Validate if the designated constraints aren't met.
-type InspectProjectRequestValidationError struct {
+// CreateTagRequestValidationError is the validation error returned by
+// CreateTagRequest.Validate if the designated constraints aren't met.
+type CreateTagRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6610,24 +7036,22 @@ type InspectProjectRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e InspectProjectRequestValidationError) Field() string { return e.field }
+func (e CreateTagRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e InspectProjectRequestValidationError) Reason() string { return e.reason }
+func (e CreateTagRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e InspectProjectRequestValidationError) Cause() error { return e.cause }
+func (e CreateTagRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e InspectProjectRequestValidationError) Key() bool { return e.key }
+func (e CreateTagRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e InspectProjectRequestValidationError) ErrorName() string {
-	return "InspectProjectRequestValidationError"
-}
+func (e CreateTagRequestValidationError) ErrorName() string { return "CreateTagRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e InspectProjectRequestValidationError) Error() string {
+func (e CreateTagRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6639,14 +7063,14 @@ func (e InspectProjectRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sInspectProjectRequest.%s: %s%s",
+		"invalid %sCreateTagRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = InspectProjectRequestValidationError{}
+var _ error = CreateTagRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -6654,44 +7078,84 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = InspectProjectRequestValidationError{}
+} = CreateTagRequestValidationError{}
 
-// Validate checks the field values on ListProjectRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListProjectRequest) Validate() error {
+// Validate checks the field values on InspectTagRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *InspectTagRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListProjectRequest with the rules
+// ValidateAll checks the field values on InspectTagRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListProjectRequestMultiError, or nil if none found.
-func (m *ListProjectRequest) ValidateAll() error {
+// InspectTagRequestMultiError, or nil if none found.
+func (m *InspectTagRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListProjectRequest) validate(all bool) error {
+func (m *InspectTagRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if m.GetTag() == nil {
+		err := InspectTagRequestValidationError{
+			field:  "Tag",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetTag()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InspectTagRequestValidationError{
+					field:  "Tag",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InspectTagRequestValidationError{
+					field:  "Tag",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTag()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InspectTagRequestValidationError{
+				field:  "Tag",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return ListProjectRequestMultiError(errors)
+		return InspectTagRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListProjectRequestMultiError is an error wrapping multiple validation errors
-// returned by ListProjectRequest.ValidateAll() if the designated constraints
+// InspectTagRequestMultiError is an error wrapping multiple validation errors
+// returned by InspectTagRequest.ValidateAll() if the designated constraints
 // aren't met.
-type ListProjectRequestMultiError []error
+type InspectTagRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListProjectRequestMultiError) Error() string {
+func (m InspectTagRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6700,11 +7164,11 @@ func (m ListProjectRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListProjectRequestMultiError) AllErrors() []error { return m }
+func (m InspectTagRequestMultiError) AllErrors() []error { return m }
 
-// ListProjectRequestValidationError is the validation error returned by
-// ListProjectRequest.Validate if the designated constraints aren't met.
-type ListProjectRequestValidationError struct {
+// InspectTagRequestValidationError is the validation error returned by
+// InspectTagRequest.Validate if the designated constraints aren't met.
+type InspectTagRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6712,24 +7176,24 @@ type ListProjectRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListProjectRequestValidationError) Field() string { return e.field }
+func (e InspectTagRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListProjectRequestValidationError) Reason() string { return e.reason }
+func (e InspectTagRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListProjectRequestValidationError) Cause() error { return e.cause }
+func (e InspectTagRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListProjectRequestValidationError) Key() bool { return e.key }
+func (e InspectTagRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListProjectRequestValidationError) ErrorName() string {
-	return "ListProjectRequestValidationError"
+func (e InspectTagRequestValidationError) ErrorName() string {
+	return "InspectTagRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListProjectRequestValidationError) Error() string {
+func (e InspectTagRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6741,14 +7205,14 @@ func (e ListProjectRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListProjectRequest.%s: %s%s",
+		"invalid %sInspectTagRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListProjectRequestValidationError{}
+var _ error = InspectTagRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -6756,75 +7220,84 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListProjectRequestValidationError{}
+} = InspectTagRequestValidationError{}
 
-// Validate checks the field values on DeleteProjectRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteProjectRequest) Validate() error {
+// Validate checks the field values on ListTagRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ListTagRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteProjectRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// DeleteProjectRequestMultiError, or nil if none found.
-func (m *DeleteProjectRequest) ValidateAll() error {
+// ValidateAll checks the field values on ListTagRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ListTagRequestMultiError,
+// or nil if none found.
+func (m *ListTagRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteProjectRequest) validate(all bool) error {
+func (m *ListTagRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if m.GetRepo() == nil {
+		err := ListTagRequestValidationError{
+			field:  "Repo",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if all {
-		switch v := interface{}(m.GetProject()).(type) {
+		switch v := interface{}(m.GetRepo()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, DeleteProjectRequestValidationError{
-					field:  "Project",
+				errors = append(errors, ListTagRequestValidationError{
+					field:  "Repo",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, DeleteProjectRequestValidationError{
-					field:  "Project",
+				errors = append(errors, ListTagRequestValidationError{
+					field:  "Repo",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetProject()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetRepo()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return DeleteProjectRequestValidationError{
-				field:  "Project",
+			return ListTagRequestValidationError{
+				field:  "Repo",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	// no validation rules for Force
-
 	if len(errors) > 0 {
-		return DeleteProjectRequestMultiError(errors)
+		return ListTagRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteProjectRequestMultiError is an error wrapping multiple validation
-// errors returned by DeleteProjectRequest.ValidateAll() if the designated
-// constraints aren't met.
-type DeleteProjectRequestMultiError []error
+// ListTagRequestMultiError is an error wrapping multiple validation errors
+// returned by ListTagRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListTagRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteProjectRequestMultiError) Error() string {
+func (m ListTagRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6833,11 +7306,11 @@ func (m DeleteProjectRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteProjectRequestMultiError) AllErrors() []error { return m }
+func (m ListTagRequestMultiError) AllErrors() []error { return m }
 
-// DeleteProjectRequestValidationError is the validation error returned by
-// DeleteProjectRequest.Validate if the designated constraints aren't met.
-type DeleteProjectRequestValidationError struct {
+// ListTagRequestValidationError is the validation error returned by
+// ListTagRequest.Validate if the designated constraints aren't met.
+type ListTagRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6845,24 +7318,22 @@ type DeleteProjectRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteProjectRequestValidationError) Field() string { return e.field }
+func (e ListTagRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteProjectRequestValidationError) Reason() string { return e.reason }
+func (e ListTagRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteProjectRequestValidationError) Cause() error { return e.cause }
+func (e ListTagRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteProjectRequestValidationError) Key() bool { return e.key }
+func (e ListTagRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteProjectRequestValidationError) ErrorName() string {
-	return "DeleteProjectRequestValidationError"
-}
+func (e ListTagRequestValidationError) ErrorName() string { return "ListTagRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DeleteProjectRequestValidationError) Error() string {
+func (e ListTagRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6874,14 +7345,14 @@ func (e DeleteProjectRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteProjectRequest.%s: %s%s",
+		"invalid %sListTagRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteProjectRequestValidationError{}
+var _ error = ListTagRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -6889,133 +7360,84 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteProjectRequestValidationError{}
+} = ListTagRequestValidationError{}
 
-// Validate checks the field values on AddFile with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *AddFile) Validate() error {
+// Validate checks the field values on DeleteTagRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeleteTagRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AddFile with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in AddFileMultiError, or nil if none found.
-func (m *AddFile) ValidateAll() error {
+// ValidateAll checks the field values on DeleteTagRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteTagRequestMultiError, or nil if none found.
+func (m *DeleteTagRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AddFile) validate(all bool) error {
+func (m *DeleteTagRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Path
-
-	// no validation rules for Datum
-
-	switch v := m.Source.(type) {
-	case *AddFile_Raw:
-		if v == nil {
-			err := AddFileValidationError{
-				field:  "Source",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
+	if m.GetTag() == nil {
+		err := DeleteTagRequestValidationError{
+			field:  "Tag",
+			reason: "value is required",
 		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-		if all {
-			switch v := interface{}(m.GetRaw()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AddFileValidationError{
-						field:  "Raw",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, AddFileValidationError{
-						field:  "Raw",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetRaw()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return AddFileValidationError{
-					field:  "Raw",
+	if all {
+		switch v := interface{}(m.GetTag()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeleteTagRequestValidationError{
+					field:  "Tag",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
-			}
-		}
-
-	case *AddFile_Url:
-		if v == nil {
-			err := AddFileValidationError{
-				field:  "Source",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-		if all {
-			switch v := interface{}(m.GetUrl()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AddFileValidationError{
-						field:  "Url",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, AddFileValidationError{
-						field:  "Url",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+				})
 			}
-		} else if v, ok := interface{}(m.GetUrl()).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return AddFileValidationError{
-					field:  "Url",
+				errors = append(errors, DeleteTagRequestValidationError{
+					field:  "Tag",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTag()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeleteTagRequestValidationError{
+				field:  "Tag",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
-	default:
-		_ = v // ensures v is used
 	}
 
 	if len(errors) > 0 {
-		return AddFileMultiError(errors)
+		return DeleteTagRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AddFileMultiError is an error wrapping multiple validation errors returned
-// by AddFile.ValidateAll() if the designated constraints aren't met.
-type AddFileMultiError []error
+// DeleteTagRequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteTagRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteTagRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AddFileMultiError) Error() string {
+func (m DeleteTagRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7024,11 +7446,11 @@ func (m AddFileMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AddFileMultiError) AllErrors() []error { return m }
+func (m DeleteTagRequestMultiError) AllErrors() []error { return m }
 
-// AddFileValidationError is the validation error returned by AddFile.Validate
-// if the designated constraints aren't met.
-type AddFileValidationError struct {
+// DeleteTagRequestValidationError is the validation error returned by
+// DeleteTagRequest.Validate if the designated constraints aren't met.
+type DeleteTagRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7036,22 +7458,22 @@ type AddFileValidationError struct {
 }
 
 // Field function returns field value.
-func (e AddFileValidationError) Field() string { return e.field }
+func (e DeleteTagRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AddFileValidationError) Reason() string { return e.reason }
+func (e DeleteTagRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AddFileValidationError) Cause() error { return e.cause }
+func (e DeleteTagRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AddFileValidationError) Key() bool { return e.key }
+func (e DeleteTagRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AddFileValidationError) ErrorName() string { return "AddFileValidationError" }
+func (e DeleteTagRequestValidationError) ErrorName() string { return "DeleteTagRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e AddFileValidationError) Error() string {
+func (e DeleteTagRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7063,14 +7485,14 @@ func (e AddFileValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAddFile.%s: %s%s",
+		"invalid %sDeleteTagRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AddFileValidationError{}
+var _ error = DeleteTagRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -7078,47 +7500,77 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AddFileValidationError{}
+} = DeleteTagRequestValidationError{}
 
-// Validate checks the field values on DeleteFile with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *DeleteFile) Validate() error {
+// Validate checks the field values on CreateProjectRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateProjectRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteFile with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in DeleteFileMultiError, or
-// nil if none found.
-func (m *DeleteFile) ValidateAll() error {
+// ValidateAll checks the field values on CreateProjectRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateProjectRequestMultiError, or nil if none found.
+func (m *CreateProjectRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteFile) validate(all bool) error {
+func (m *CreateProjectRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Path
+	if all {
+		switch v := interface{}(m.GetProject()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateProjectRequestValidationError{
+					field:  "Project",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateProjectRequestValidationError{
+					field:  "Project",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProject()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateProjectRequestValidationError{
+				field:  "Project",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
-	// no validation rules for Datum
+	// no validation rules for Description
+
+	// no validation rules for Update
 
 	if len(errors) > 0 {
-		return DeleteFileMultiError(errors)
+		return CreateProjectRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteFileMultiError is an error wrapping multiple validation errors
-// returned by DeleteFile.ValidateAll() if the designated constraints aren't met.
-type DeleteFileMultiError []error
+// CreateProjectRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateProjectRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateProjectRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteFileMultiError) Error() string {
+func (m CreateProjectRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7127,11 +7579,11 @@ func (m DeleteFileMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteFileMultiError) AllErrors() []error { return m }
+func (m CreateProjectRequestMultiError) AllErrors() []error { return m }
 
-// DeleteFileValidationError is the validation error returned by
-// DeleteFile.Validate if the designated constraints aren't met.
-type DeleteFileValidationError struct {
+// CreateProjectRequestValidationError is the validation error returned by
+// CreateProjectRequest.Validate if the designated constraints aren't met.
+type CreateProjectRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7139,22 +7591,24 @@ type DeleteFileValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteFileValidationError) Field() string { return e.field }
+func (e CreateProjectRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteFileValidationError) Reason() string { return e.reason }
+func (e CreateProjectRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteFileValidationError) Cause() error { return e.cause }
+func (e CreateProjectRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteFileValidationError) Key() bool { return e.key }
+func (e CreateProjectRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteFileValidationError) ErrorName() string { return "DeleteFileValidationError" }
+func (e CreateProjectRequestValidationError) ErrorName() string {
+	return "CreateProjectRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e DeleteFileValidationError) Error() string {
+func (e CreateProjectRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7166,14 +7620,14 @@ func (e DeleteFileValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteFile.%s: %s%s",
+		"invalid %sCreateProjectRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteFileValidationError{}
+var _ error = CreateProjectRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -7181,37 +7635,33 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteFileValidationError{}
+} = CreateProjectRequestValidationError{}
 
-// Validate checks the field values on CopyFile with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *CopyFile) Validate() error {
+// Validate checks the field values on InspectProjectRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InspectProjectRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CopyFile with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in CopyFileMultiError, or nil
-// if none found.
-func (m *CopyFile) ValidateAll() error {
+// ValidateAll checks the field values on InspectProjectRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InspectProjectRequestMultiError, or nil if none found.
+func (m *InspectProjectRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CopyFile) validate(all bool) error {
+func (m *InspectProjectRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Dst
-
-	// no validation rules for Datum
-
-	if m.GetSrc() == nil {
-		err := CopyFileValidationError{
-			field:  "Src",
+	if m.GetProject() == nil {
+		err := InspectProjectRequestValidationError{
+			field:  "Project",
 			reason: "value is required",
 		}
 		if !all {
@@ -7221,49 +7671,48 @@ func (m *CopyFile) validate(all bool) error {
 	}
 
 	if all {
-		switch v := interface{}(m.GetSrc()).(type) {
+		switch v := interface{}(m.GetProject()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CopyFileValidationError{
-					field:  "Src",
+				errors = append(errors, InspectProjectRequestValidationError{
+					field:  "Project",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CopyFileValidationError{
-					field:  "Src",
+				errors = append(errors, InspectProjectRequestValidationError{
+					field:  "Project",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetSrc()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetProject()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return CopyFileValidationError{
-				field:  "Src",
+			return InspectProjectRequestValidationError{
+				field:  "Project",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	// no validation rules for Append
-
 	if len(errors) > 0 {
-		return CopyFileMultiError(errors)
+		return InspectProjectRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CopyFileMultiError is an error wrapping multiple validation errors returned
-// by CopyFile.ValidateAll() if the designated constraints aren't met.
-type CopyFileMultiError []error
+// InspectProjectRequestMultiError is an error wrapping multiple validation
+// errors returned by InspectProjectRequest.ValidateAll() if the designated
+// constraints aren't met.
+type InspectProjectRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CopyFileMultiError) Error() string {
+func (m InspectProjectRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7272,11 +7721,11 @@ func (m CopyFileMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CopyFileMultiError) AllErrors() []error { return m }
+func (m InspectProjectRequestMultiError) AllErrors() []error { return m }
 
-// CopyFileValidationError is the validation error returned by
-// CopyFile.Validate if the designated constraints aren't met.
-type CopyFileValidationError struct {
+// InspectProjectRequestValidationError is the validation error returned by
+// InspectProjectRequest.Validate if the designated constraints aren't met.
+type InspectProjectRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7284,22 +7733,24 @@ type CopyFileValidationError struct {
 }
 
 // Field function returns field value.
-func (e CopyFileValidationError) Field() string { return e.field }
+func (e InspectProjectRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CopyFileValidationError) Reason() string { return e.reason }
+func (e InspectProjectRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CopyFileValidationError) Cause() error { return e.cause }
+func (e InspectProjectRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CopyFileValidationError) Key() bool { return e.key }
+func (e InspectProjectRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CopyFileValidationError) ErrorName() string { return "CopyFileValidationError" }
+func (e InspectProjectRequestValidationError) ErrorName() string {
+	return "InspectProjectRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e CopyFileValidationError) Error() string {
+func (e InspectProjectRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7311,14 +7762,14 @@ func (e CopyFileValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCopyFile.%s: %s%s",
+		"invalid %sInspectProjectRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CopyFileValidationError{}
+var _ error = InspectProjectRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -7326,295 +7777,82 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CopyFileValidationError{}
+} = InspectProjectRequestValidationError{}
 
-// Validate checks the field values on ModifyFileRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ModifyFileRequest) Validate() error {
+// Validate checks the field values on ListProjectRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListProjectRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ModifyFileRequest with the rules
+// ValidateAll checks the field values on ListProjectRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ModifyFileRequestMultiError, or nil if none found.
-func (m *ModifyFileRequest) ValidateAll() error {
+// ListProjectRequestMultiError, or nil if none found.
+func (m *ListProjectRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ModifyFileRequest) validate(all bool) error {
+func (m *ListProjectRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	switch v := m.Body.(type) {
-	case *ModifyFileRequest_SetCommit:
-		if v == nil {
-			err := ModifyFileRequestValidationError{
-				field:  "Body",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+	if len(errors) > 0 {
+		return ListProjectRequestMultiError(errors)
+	}
 
-		if m.GetSetCommit() == nil {
-			err := ModifyFileRequestValidationError{
-				field:  "SetCommit",
-				reason: "value is required",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+	return nil
+}
 
-		if all {
-			switch v := interface{}(m.GetSetCommit()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ModifyFileRequestValidationError{
-						field:  "SetCommit",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ModifyFileRequestValidationError{
-						field:  "SetCommit",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetSetCommit()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ModifyFileRequestValidationError{
-					field:  "SetCommit",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+// ListProjectRequestMultiError is an error wrapping multiple validation errors
+// returned by ListProjectRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListProjectRequestMultiError []error
 
-	case *ModifyFileRequest_AddFile:
-		if v == nil {
-			err := ModifyFileRequestValidationError{
-				field:  "Body",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListProjectRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
 
-		if m.GetAddFile() == nil {
-			err := ModifyFileRequestValidationError{
-				field:  "AddFile",
-				reason: "value is required",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+// AllErrors returns a list of validation violation errors.
+func (m ListProjectRequestMultiError) AllErrors() []error { return m }
 
-		if all {
-			switch v := interface{}(m.GetAddFile()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ModifyFileRequestValidationError{
-						field:  "AddFile",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ModifyFileRequestValidationError{
-						field:  "AddFile",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetAddFile()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ModifyFileRequestValidationError{
-					field:  "AddFile",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+// ListProjectRequestValidationError is the validation error returned by
+// ListProjectRequest.Validate if the designated constraints aren't met.
+type ListProjectRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
 
-	case *ModifyFileRequest_DeleteFile:
-		if v == nil {
-			err := ModifyFileRequestValidationError{
-				field:  "Body",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+// Field function returns field value.
+func (e ListProjectRequestValidationError) Field() string { return e.field }
 
-		if m.GetDeleteFile() == nil {
-			err := ModifyFileRequestValidationError{
-				field:  "DeleteFile",
-				reason: "value is required",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-		if all {
-			switch v := interface{}(m.GetDeleteFile()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ModifyFileRequestValidationError{
-						field:  "DeleteFile",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ModifyFileRequestValidationError{
-						field:  "DeleteFile",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetDeleteFile()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ModifyFileRequestValidationError{
-					field:  "DeleteFile",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	case *ModifyFileRequest_CopyFile:
-		if v == nil {
-			err := ModifyFileRequestValidationError{
-				field:  "Body",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-		if m.GetCopyFile() == nil {
-			err := ModifyFileRequestValidationError{
-				field:  "CopyFile",
-				reason: "value is required",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-		if all {
-			switch v := interface{}(m.GetCopyFile()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ModifyFileRequestValidationError{
-						field:  "CopyFile",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ModifyFileRequestValidationError{
-						field:  "CopyFile",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetCopyFile()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ModifyFileRequestValidationError{
-					field:  "CopyFile",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	default:
-		_ = v // ensures v is used
-	}
-
-	if len(errors) > 0 {
-		return ModifyFileRequestMultiError(errors)
-	}
-
-	return nil
-}
-
-// ModifyFileRequestMultiError is an error wrapping multiple validation errors
-// returned by ModifyFileRequest.ValidateAll() if the designated constraints
-// aren't met.
-type ModifyFileRequestMultiError []error
-
-// Error returns a concatenation of all the error messages it wraps.
-func (m ModifyFileRequestMultiError) Error() string {
-	var msgs []string
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
-	}
-	return strings.Join(msgs, "; ")
-}
-
-// AllErrors returns a list of validation violation errors.
-func (m ModifyFileRequestMultiError) AllErrors() []error { return m }
-
-// ModifyFileRequestValidationError is the validation error returned by
-// ModifyFileRequest.Validate if the designated constraints aren't met.
-type ModifyFileRequestValidationError struct {
-	field  string
-	reason string
-	cause  error
-	key    bool
-}
-
-// Field function returns field value.
-func (e ModifyFileRequestValidationError) Field() string { return e.field }
-
-// Reason function returns reason value.
-func (e ModifyFileRequestValidationError) Reason() string { return e.reason }
+// Reason function returns reason value.
+func (e ListProjectRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ModifyFileRequestValidationError) Cause() error { return e.cause }
+func (e ListProjectRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ModifyFileRequestValidationError) Key() bool { return e.key }
+func (e ListProjectRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ModifyFileRequestValidationError) ErrorName() string {
-	return "ModifyFileRequestValidationError"
+func (e ListProjectRequestValidationError) ErrorName() string {
+	return "ListProjectRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ModifyFileRequestValidationError) Error() string {
+func (e ListProjectRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7626,14 +7864,14 @@ func (e ModifyFileRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sModifyFileRequest.%s: %s%s",
+		"invalid %sListProjectRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ModifyFileRequestValidationError{}
+var _ error = ListProjectRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -7641,24 +7879,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ModifyFileRequestValidationError{}
+} = ListProjectRequestValidationError{}
 
-// Validate checks the field values on GetFileRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *GetFileRequest) Validate() error {
+// Validate checks the field values on DeleteProjectRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteProjectRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetFileRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in GetFileRequestMultiError,
-// or nil if none found.
-func (m *GetFileRequest) ValidateAll() error {
+// ValidateAll checks the field values on DeleteProjectRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteProjectRequestMultiError, or nil if none found.
+func (m *DeleteProjectRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetFileRequest) validate(all bool) error {
+func (m *DeleteProjectRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -7666,81 +7904,50 @@ func (m *GetFileRequest) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetFile()).(type) {
+		switch v := interface{}(m.GetProject()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetFileRequestValidationError{
-					field:  "File",
+				errors = append(errors, DeleteProjectRequestValidationError{
+					field:  "Project",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetFileRequestValidationError{
-					field:  "File",
+				errors = append(errors, DeleteProjectRequestValidationError{
+					field:  "Project",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetFile()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetProject()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return GetFileRequestValidationError{
-				field:  "File",
+			return DeleteProjectRequestValidationError{
+				field:  "Project",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	// no validation rules for URL
-
-	// no validation rules for Offset
-
-	if all {
-		switch v := interface{}(m.GetPathRange()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetFileRequestValidationError{
-					field:  "PathRange",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GetFileRequestValidationError{
-					field:  "PathRange",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetPathRange()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetFileRequestValidationError{
-				field:  "PathRange",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Force
 
 	if len(errors) > 0 {
-		return GetFileRequestMultiError(errors)
+		return DeleteProjectRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetFileRequestMultiError is an error wrapping multiple validation errors
-// returned by GetFileRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetFileRequestMultiError []error
+// DeleteProjectRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteProjectRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteProjectRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetFileRequestMultiError) Error() string {
+func (m DeleteProjectRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7749,11 +7956,11 @@ func (m GetFileRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetFileRequestMultiError) AllErrors() []error { return m }
+func (m DeleteProjectRequestMultiError) AllErrors() []error { return m }
 
-// GetFileRequestValidationError is the validation error returned by
-// GetFileRequest.Validate if the designated constraints aren't met.
-type GetFileRequestValidationError struct {
+// DeleteProjectRequestValidationError is the validation error returned by
+// DeleteProjectRequest.Validate if the designated constraints aren't met.
+type DeleteProjectRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7761,22 +7968,24 @@ type GetFileRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetFileRequestValidationError) Field() string { return e.field }
+func (e DeleteProjectRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetFileRequestValidationError) Reason() string { return e.reason }
+func (e DeleteProjectRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetFileRequestValidationError) Cause() error { return e.cause }
+func (e DeleteProjectRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetFileRequestValidationError) Key() bool { return e.key }
+func (e DeleteProjectRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetFileRequestValidationError) ErrorName() string { return "GetFileRequestValidationError" }
+func (e DeleteProjectRequestValidationError) ErrorName() string {
+	return "DeleteProjectRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetFileRequestValidationError) Error() string {
+func (e DeleteProjectRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7788,14 +7997,14 @@ func (e GetFileRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetFileRequest.%s: %s%s",
+		"invalid %sDeleteProjectRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetFileRequestValidationError{}
+var _ error = DeleteProjectRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -7803,73 +8012,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetFileRequestValidationError{}
+} = DeleteProjectRequestValidationError{}
 
-// Validate checks the field values on InspectFileRequest with the rules
+// Validate checks the field values on EditMetadataRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *InspectFileRequest) Validate() error {
+func (m *EditMetadataRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on InspectFileRequest with the rules
+// ValidateAll checks the field values on EditMetadataRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// InspectFileRequestMultiError, or nil if none found.
-func (m *InspectFileRequest) ValidateAll() error {
+// EditMetadataRequestMultiError, or nil if none found.
+func (m *EditMetadataRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *InspectFileRequest) validate(all bool) error {
+func (m *EditMetadataRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetFile()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, InspectFileRequestValidationError{
-					field:  "File",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	for idx, item := range m.GetEdits() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, EditMetadataRequestValidationError{
+						field:  fmt.Sprintf("Edits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, EditMetadataRequestValidationError{
+						field:  fmt.Sprintf("Edits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, InspectFileRequestValidationError{
-					field:  "File",
+				return EditMetadataRequestValidationError{
+					field:  fmt.Sprintf("Edits[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetFile()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return InspectFileRequestValidationError{
-				field:  "File",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return InspectFileRequestMultiError(errors)
+		return EditMetadataRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// InspectFileRequestMultiError is an error wrapping multiple validation errors
-// returned by InspectFileRequest.ValidateAll() if the designated constraints
-// aren't met.
-type InspectFileRequestMultiError []error
+// EditMetadataRequestMultiError is an error wrapping multiple validation
+// errors returned by EditMetadataRequest.ValidateAll() if the designated
+// constraints aren't met.
+type EditMetadataRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m InspectFileRequestMultiError) Error() string {
+func (m EditMetadataRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7878,11 +8092,11 @@ func (m InspectFileRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m InspectFileRequestMultiError) AllErrors() []error { return m }
+func (m EditMetadataRequestMultiError) AllErrors() []error { return m }
 
-// InspectFileRequestValidationError is the validation error returned by
-// InspectFileRequest.Validate if the designated constraints aren't met.
-type InspectFileRequestValidationError struct {
+// EditMetadataRequestValidationError is the validation error returned by
+// EditMetadataRequest.Validate if the designated constraints aren't met.
+type EditMetadataRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7890,24 +8104,24 @@ type InspectFileRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e InspectFileRequestValidationError) Field() string { return e.field }
+func (e EditMetadataRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e InspectFileRequestValidationError) Reason() string { return e.reason }
+func (e EditMetadataRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e InspectFileRequestValidationError) Cause() error { return e.cause }
+func (e EditMetadataRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e InspectFileRequestValidationError) Key() bool { return e.key }
+func (e EditMetadataRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e InspectFileRequestValidationError) ErrorName() string {
-	return "InspectFileRequestValidationError"
+func (e EditMetadataRequestValidationError) ErrorName() string {
+	return "EditMetadataRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e InspectFileRequestValidationError) Error() string {
+func (e EditMetadataRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7919,14 +8133,14 @@ func (e InspectFileRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sInspectFileRequest.%s: %s%s",
+		"invalid %sEditMetadataRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = InspectFileRequestValidationError{}
+var _ error = EditMetadataRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -7934,106 +8148,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = InspectFileRequestValidationError{}
+} = EditMetadataRequestValidationError{}
 
-// Validate checks the field values on ListFileRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ListFileRequest) Validate() error {
+// Validate checks the field values on EditMetadataResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EditMetadataResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListFileRequest with the rules
+// ValidateAll checks the field values on EditMetadataResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListFileRequestMultiError, or nil if none found.
-func (m *ListFileRequest) ValidateAll() error {
+// EditMetadataResponseMultiError, or nil if none found.
+func (m *EditMetadataResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListFileRequest) validate(all bool) error {
+func (m *EditMetadataResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetFile()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ListFileRequestValidationError{
-					field:  "File",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ListFileRequestValidationError{
-					field:  "File",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetFile()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ListFileRequestValidationError{
-				field:  "File",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if all {
-		switch v := interface{}(m.GetPaginationMarker()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ListFileRequestValidationError{
-					field:  "PaginationMarker",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ListFileRequestValidationError{
-					field:  "PaginationMarker",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetPaginationMarker()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ListFileRequestValidationError{
-				field:  "PaginationMarker",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	// no validation rules for Number
-
-	// no validation rules for Reverse
-
 	if len(errors) > 0 {
-		return ListFileRequestMultiError(errors)
+		return EditMetadataResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListFileRequestMultiError is an error wrapping multiple validation errors
-// returned by ListFileRequest.ValidateAll() if the designated constraints
-// aren't met.
-type ListFileRequestMultiError []error
+// EditMetadataResponseMultiError is an error wrapping multiple validation
+// errors returned by EditMetadataResponse.ValidateAll() if the designated
+// constraints aren't met.
+type EditMetadataResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListFileRequestMultiError) Error() string {
+func (m EditMetadataResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8042,11 +8194,11 @@ func (m ListFileRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListFileRequestMultiError) AllErrors() []error { return m }
+func (m EditMetadataResponseMultiError) AllErrors() []error { return m }
 
-// ListFileRequestValidationError is the validation error returned by
-// ListFileRequest.Validate if the designated constraints aren't met.
-type ListFileRequestValidationError struct {
+// EditMetadataResponseValidationError is the validation error returned by
+// EditMetadataResponse.Validate if the designated constraints aren't met.
+type EditMetadataResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8054,22 +8206,24 @@ type ListFileRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListFileRequestValidationError) Field() string { return e.field }
+func (e EditMetadataResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListFileRequestValidationError) Reason() string { return e.reason }
+func (e EditMetadataResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListFileRequestValidationError) Cause() error { return e.cause }
+func (e EditMetadataResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListFileRequestValidationError) Key() bool { return e.key }
+func (e EditMetadataResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListFileRequestValidationError) ErrorName() string { return "ListFileRequestValidationError" }
+func (e EditMetadataResponseValidationError) ErrorName() string {
+	return "EditMetadataResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ListFileRequestValidationError) Error() string {
+func (e EditMetadataResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8081,14 +8235,14 @@ func (e ListFileRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListFileRequest.%s: %s%s",
+		"invalid %sEditMetadataResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListFileRequestValidationError{}
+var _ error = EditMetadataResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -8096,24 +8250,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListFileRequestValidationError{}
+} = EditMetadataResponseValidationError{}
 
-// Validate checks the field values on WalkFileRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *WalkFileRequest) Validate() error {
+// Validate checks the field values on SetRetentionPolicyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetRetentionPolicyRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on WalkFileRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on SetRetentionPolicyRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// WalkFileRequestMultiError, or nil if none found.
-func (m *WalkFileRequest) ValidateAll() error {
+// SetRetentionPolicyRequestMultiError, or nil if none found.
+func (m *SetRetentionPolicyRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *WalkFileRequest) validate(all bool) error {
+func (m *SetRetentionPolicyRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -8121,81 +8275,135 @@ func (m *WalkFileRequest) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetFile()).(type) {
+		switch v := interface{}(m.GetPolicy()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, WalkFileRequestValidationError{
-					field:  "File",
+				errors = append(errors, SetRetentionPolicyRequestValidationError{
+					field:  "Policy",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, WalkFileRequestValidationError{
-					field:  "File",
+				errors = append(errors, SetRetentionPolicyRequestValidationError{
+					field:  "Policy",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetFile()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetPolicy()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return WalkFileRequestValidationError{
-				field:  "File",
+			return SetRetentionPolicyRequestValidationError{
+				field:  "Policy",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	if all {
-		switch v := interface{}(m.GetPaginationMarker()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, WalkFileRequestValidationError{
-					field:  "PaginationMarker",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	switch v := m.Target.(type) {
+	case *SetRetentionPolicyRequest_Repo:
+		if v == nil {
+			err := SetRetentionPolicyRequestValidationError{
+				field:  "Target",
+				reason: "oneof value cannot be a typed-nil",
 			}
-		case interface{ Validate() error }:
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetRepo()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SetRetentionPolicyRequestValidationError{
+						field:  "Repo",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SetRetentionPolicyRequestValidationError{
+						field:  "Repo",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRepo()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, WalkFileRequestValidationError{
-					field:  "PaginationMarker",
+				return SetRetentionPolicyRequestValidationError{
+					field:  "Repo",
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
+				}
 			}
 		}
-	} else if v, ok := interface{}(m.GetPaginationMarker()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return WalkFileRequestValidationError{
-				field:  "PaginationMarker",
-				reason: "embedded message failed validation",
-				cause:  err,
+
+	case *SetRetentionPolicyRequest_Branch:
+		if v == nil {
+			err := SetRetentionPolicyRequestValidationError{
+				field:  "Target",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
 			}
+			errors = append(errors, err)
 		}
-	}
 
-	// no validation rules for Number
+		if all {
+			switch v := interface{}(m.GetBranch()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SetRetentionPolicyRequestValidationError{
+						field:  "Branch",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SetRetentionPolicyRequestValidationError{
+						field:  "Branch",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetBranch()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SetRetentionPolicyRequestValidationError{
+					field:  "Branch",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-	// no validation rules for Reverse
+	default:
+		_ = v // ensures v is used
+	}
 
 	if len(errors) > 0 {
-		return WalkFileRequestMultiError(errors)
+		return SetRetentionPolicyRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// WalkFileRequestMultiError is an error wrapping multiple validation errors
-// returned by WalkFileRequest.ValidateAll() if the designated constraints
-// aren't met.
-type WalkFileRequestMultiError []error
+// SetRetentionPolicyRequestMultiError is an error wrapping multiple validation
+// errors returned by SetRetentionPolicyRequest.ValidateAll() if the
+// designated constraints aren't met.
+type SetRetentionPolicyRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m WalkFileRequestMultiError) Error() string {
+func (m SetRetentionPolicyRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8204,11 +8412,11 @@ func (m WalkFileRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m WalkFileRequestMultiError) AllErrors() []error { return m }
+func (m SetRetentionPolicyRequestMultiError) AllErrors() []error { return m }
 
-// WalkFileRequestValidationError is the validation error returned by
-// WalkFileRequest.Validate if the designated constraints aren't met.
-type WalkFileRequestValidationError struct {
+// SetRetentionPolicyRequestValidationError is the validation error returned by
+// SetRetentionPolicyRequest.Validate if the designated constraints aren't met.
+type SetRetentionPolicyRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8216,22 +8424,24 @@ type WalkFileRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e WalkFileRequestValidationError) Field() string { return e.field }
+func (e SetRetentionPolicyRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e WalkFileRequestValidationError) Reason() string { return e.reason }
+func (e SetRetentionPolicyRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e WalkFileRequestValidationError) Cause() error { return e.cause }
+func (e SetRetentionPolicyRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e WalkFileRequestValidationError) Key() bool { return e.key }
+func (e SetRetentionPolicyRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e WalkFileRequestValidationError) ErrorName() string { return "WalkFileRequestValidationError" }
+func (e SetRetentionPolicyRequestValidationError) ErrorName() string {
+	return "SetRetentionPolicyRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e WalkFileRequestValidationError) Error() string {
+func (e SetRetentionPolicyRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8243,14 +8453,14 @@ func (e WalkFileRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sWalkFileRequest.%s: %s%s",
+		"invalid %sSetRetentionPolicyRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = WalkFileRequestValidationError{}
+var _ error = SetRetentionPolicyRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -8258,104 +8468,133 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = WalkFileRequestValidationError{}
+} = SetRetentionPolicyRequestValidationError{}
 
-// Validate checks the field values on GlobFileRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GlobFileRequest) Validate() error {
+// Validate checks the field values on ApplyRetentionPolicyRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ApplyRetentionPolicyRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GlobFileRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ApplyRetentionPolicyRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GlobFileRequestMultiError, or nil if none found.
-func (m *GlobFileRequest) ValidateAll() error {
+// ApplyRetentionPolicyRequestMultiError, or nil if none found.
+func (m *ApplyRetentionPolicyRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GlobFileRequest) validate(all bool) error {
+func (m *ApplyRetentionPolicyRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetCommit()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GlobFileRequestValidationError{
-					field:  "Commit",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	// no validation rules for DryRun
+
+	switch v := m.Target.(type) {
+	case *ApplyRetentionPolicyRequest_Repo:
+		if v == nil {
+			err := ApplyRetentionPolicyRequestValidationError{
+				field:  "Target",
+				reason: "oneof value cannot be a typed-nil",
 			}
-		case interface{ Validate() error }:
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetRepo()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ApplyRetentionPolicyRequestValidationError{
+						field:  "Repo",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ApplyRetentionPolicyRequestValidationError{
+						field:  "Repo",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRepo()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GlobFileRequestValidationError{
-					field:  "Commit",
+				return ApplyRetentionPolicyRequestValidationError{
+					field:  "Repo",
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
+				}
 			}
 		}
-	} else if v, ok := interface{}(m.GetCommit()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GlobFileRequestValidationError{
-				field:  "Commit",
-				reason: "embedded message failed validation",
-				cause:  err,
+
+	case *ApplyRetentionPolicyRequest_Branch:
+		if v == nil {
+			err := ApplyRetentionPolicyRequestValidationError{
+				field:  "Target",
+				reason: "oneof value cannot be a typed-nil",
 			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
 		}
-	}
-
-	// no validation rules for Pattern
 
-	if all {
-		switch v := interface{}(m.GetPathRange()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GlobFileRequestValidationError{
-					field:  "PathRange",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+		if all {
+			switch v := interface{}(m.GetBranch()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ApplyRetentionPolicyRequestValidationError{
+						field:  "Branch",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ApplyRetentionPolicyRequestValidationError{
+						field:  "Branch",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(m.GetBranch()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GlobFileRequestValidationError{
-					field:  "PathRange",
+				return ApplyRetentionPolicyRequestValidationError{
+					field:  "Branch",
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetPathRange()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GlobFileRequestValidationError{
-				field:  "PathRange",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
+	default:
+		_ = v // ensures v is used
 	}
 
 	if len(errors) > 0 {
-		return GlobFileRequestMultiError(errors)
+		return ApplyRetentionPolicyRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GlobFileRequestMultiError is an error wrapping multiple validation errors
-// returned by GlobFileRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GlobFileRequestMultiError []error
+// ApplyRetentionPolicyRequestMultiError is an error wrapping multiple
+// validation errors returned by ApplyRetentionPolicyRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ApplyRetentionPolicyRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GlobFileRequestMultiError) Error() string {
+func (m ApplyRetentionPolicyRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8364,11 +8603,12 @@ func (m GlobFileRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GlobFileRequestMultiError) AllErrors() []error { return m }
+func (m ApplyRetentionPolicyRequestMultiError) AllErrors() []error { return m }
 
-// GlobFileRequestValidationError is the validation error returned by
-// GlobFileRequest.Validate if the designated constraints aren't met.
-type GlobFileRequestValidationError struct {
+// ApplyRetentionPolicyRequestValidationError is the validation error returned
+// by ApplyRetentionPolicyRequest.Validate if the designated constraints
+// aren't met.
+type ApplyRetentionPolicyRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8376,22 +8616,24 @@ type GlobFileRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GlobFileRequestValidationError) Field() string { return e.field }
+func (e ApplyRetentionPolicyRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GlobFileRequestValidationError) Reason() string { return e.reason }
+func (e ApplyRetentionPolicyRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GlobFileRequestValidationError) Cause() error { return e.cause }
+func (e ApplyRetentionPolicyRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GlobFileRequestValidationError) Key() bool { return e.key }
+func (e ApplyRetentionPolicyRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GlobFileRequestValidationError) ErrorName() string { return "GlobFileRequestValidationError" }
+func (e ApplyRetentionPolicyRequestValidationError) ErrorName() string {
+	return "ApplyRetentionPolicyRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GlobFileRequestValidationError) Error() string {
+func (e ApplyRetentionPolicyRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8403,14 +8645,14 @@ func (e GlobFileRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGlobFileRequest.%s: %s%s",
+		"invalid %sApplyRetentionPolicyRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GlobFileRequestValidationError{}
+var _ error = ApplyRetentionPolicyRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -8418,104 +8660,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GlobFileRequestValidationError{}
+} = ApplyRetentionPolicyRequestValidationError{}
 
-// Validate checks the field values on DiffFileRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *DiffFileRequest) Validate() error {
+// Validate checks the field values on ApplyRetentionPolicyResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ApplyRetentionPolicyResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DiffFileRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ApplyRetentionPolicyResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DiffFileRequestMultiError, or nil if none found.
-func (m *DiffFileRequest) ValidateAll() error {
+// ApplyRetentionPolicyResponseMultiError, or nil if none found.
+func (m *ApplyRetentionPolicyResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DiffFileRequest) validate(all bool) error {
+func (m *ApplyRetentionPolicyResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetNewFile()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, DiffFileRequestValidationError{
-					field:  "NewFile",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, DiffFileRequestValidationError{
-					field:  "NewFile",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetNewFile()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return DiffFileRequestValidationError{
-				field:  "NewFile",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	for idx, item := range m.GetExpired() {
+		_, _ = idx, item
 
-	if all {
-		switch v := interface{}(m.GetOldFile()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, DiffFileRequestValidationError{
-					field:  "OldFile",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ApplyRetentionPolicyResponseValidationError{
+						field:  fmt.Sprintf("Expired[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ApplyRetentionPolicyResponseValidationError{
+						field:  fmt.Sprintf("Expired[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, DiffFileRequestValidationError{
-					field:  "OldFile",
+				return ApplyRetentionPolicyResponseValidationError{
+					field:  fmt.Sprintf("Expired[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetOldFile()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return DiffFileRequestValidationError{
-				field:  "OldFile",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
-	}
 
-	// no validation rules for Shallow
+	}
 
 	if len(errors) > 0 {
-		return DiffFileRequestMultiError(errors)
+		return ApplyRetentionPolicyResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DiffFileRequestMultiError is an error wrapping multiple validation errors
-// returned by DiffFileRequest.ValidateAll() if the designated constraints
-// aren't met.
-type DiffFileRequestMultiError []error
+// ApplyRetentionPolicyResponseMultiError is an error wrapping multiple
+// validation errors returned by ApplyRetentionPolicyResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ApplyRetentionPolicyResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DiffFileRequestMultiError) Error() string {
+func (m ApplyRetentionPolicyResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8524,11 +8740,12 @@ func (m DiffFileRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DiffFileRequestMultiError) AllErrors() []error { return m }
+func (m ApplyRetentionPolicyResponseMultiError) AllErrors() []error { return m }
 
-// DiffFileRequestValidationError is the validation error returned by
-// DiffFileRequest.Validate if the designated constraints aren't met.
-type DiffFileRequestValidationError struct {
+// ApplyRetentionPolicyResponseValidationError is the validation error returned
+// by ApplyRetentionPolicyResponse.Validate if the designated constraints
+// aren't met.
+type ApplyRetentionPolicyResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8536,22 +8753,24 @@ type DiffFileRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DiffFileRequestValidationError) Field() string { return e.field }
+func (e ApplyRetentionPolicyResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DiffFileRequestValidationError) Reason() string { return e.reason }
+func (e ApplyRetentionPolicyResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DiffFileRequestValidationError) Cause() error { return e.cause }
+func (e ApplyRetentionPolicyResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DiffFileRequestValidationError) Key() bool { return e.key }
+func (e ApplyRetentionPolicyResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DiffFileRequestValidationError) ErrorName() string { return "DiffFileRequestValidationError" }
+func (e ApplyRetentionPolicyResponseValidationError) ErrorName() string {
+	return "ApplyRetentionPolicyResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e DiffFileRequestValidationError) Error() string {
+func (e ApplyRetentionPolicyResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8563,14 +8782,14 @@ func (e DiffFileRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDiffFileRequest.%s: %s%s",
+		"invalid %sApplyRetentionPolicyResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DiffFileRequestValidationError{}
+var _ error = ApplyRetentionPolicyResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -8578,24 +8797,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DiffFileRequestValidationError{}
+} = ApplyRetentionPolicyResponseValidationError{}
 
-// Validate checks the field values on DiffFileResponse with the rules defined
+// Validate checks the field values on PurgePathRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *DiffFileResponse) Validate() error {
+func (m *PurgePathRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DiffFileResponse with the rules
+// ValidateAll checks the field values on PurgePathRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DiffFileResponseMultiError, or nil if none found.
-func (m *DiffFileResponse) ValidateAll() error {
+// PurgePathRequestMultiError, or nil if none found.
+func (m *PurgePathRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DiffFileResponse) validate(all bool) error {
+func (m *PurgePathRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -8603,77 +8822,50 @@ func (m *DiffFileResponse) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetNewFile()).(type) {
+		switch v := interface{}(m.GetRepo()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, DiffFileResponseValidationError{
-					field:  "NewFile",
+				errors = append(errors, PurgePathRequestValidationError{
+					field:  "Repo",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, DiffFileResponseValidationError{
-					field:  "NewFile",
+				errors = append(errors, PurgePathRequestValidationError{
+					field:  "Repo",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetNewFile()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetRepo()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return DiffFileResponseValidationError{
-				field:  "NewFile",
+			return PurgePathRequestValidationError{
+				field:  "Repo",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	if all {
-		switch v := interface{}(m.GetOldFile()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, DiffFileResponseValidationError{
-					field:  "OldFile",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, DiffFileResponseValidationError{
-					field:  "OldFile",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetOldFile()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return DiffFileResponseValidationError{
-				field:  "OldFile",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Path
 
 	if len(errors) > 0 {
-		return DiffFileResponseMultiError(errors)
+		return PurgePathRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DiffFileResponseMultiError is an error wrapping multiple validation errors
-// returned by DiffFileResponse.ValidateAll() if the designated constraints
+// PurgePathRequestMultiError is an error wrapping multiple validation errors
+// returned by PurgePathRequest.ValidateAll() if the designated constraints
 // aren't met.
-type DiffFileResponseMultiError []error
+type PurgePathRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DiffFileResponseMultiError) Error() string {
+func (m PurgePathRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8682,11 +8874,11 @@ func (m DiffFileResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DiffFileResponseMultiError) AllErrors() []error { return m }
+func (m PurgePathRequestMultiError) AllErrors() []error { return m }
 
-// DiffFileResponseValidationError is the validation error returned by
-// DiffFileResponse.Validate if the designated constraints aren't met.
-type DiffFileResponseValidationError struct {
+// PurgePathRequestValidationError is the validation error returned by
+// PurgePathRequest.Validate if the designated constraints aren't met.
+type PurgePathRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8694,22 +8886,22 @@ type DiffFileResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DiffFileResponseValidationError) Field() string { return e.field }
+func (e PurgePathRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DiffFileResponseValidationError) Reason() string { return e.reason }
+func (e PurgePathRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DiffFileResponseValidationError) Cause() error { return e.cause }
+func (e PurgePathRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DiffFileResponseValidationError) Key() bool { return e.key }
+func (e PurgePathRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DiffFileResponseValidationError) ErrorName() string { return "DiffFileResponseValidationError" }
+func (e PurgePathRequestValidationError) ErrorName() string { return "PurgePathRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DiffFileResponseValidationError) Error() string {
+func (e PurgePathRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8721,14 +8913,14 @@ func (e DiffFileResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDiffFileResponse.%s: %s%s",
+		"invalid %sPurgePathRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DiffFileResponseValidationError{}
+var _ error = PurgePathRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -8736,103 +8928,72 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DiffFileResponseValidationError{}
+} = PurgePathRequestValidationError{}
 
-// Validate checks the field values on FsckRequest with the rules defined in
+// Validate checks the field values on PurgedDatums with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *FsckRequest) Validate() error {
+func (m *PurgedDatums) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on FsckRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in FsckRequestMultiError, or
+// ValidateAll checks the field values on PurgedDatums with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PurgedDatumsMultiError, or
 // nil if none found.
-func (m *FsckRequest) ValidateAll() error {
+func (m *PurgedDatums) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *FsckRequest) validate(all bool) error {
+func (m *PurgedDatums) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Fix
-
-	switch v := m.ZombieCheck.(type) {
-	case *FsckRequest_ZombieTarget:
-		if v == nil {
-			err := FsckRequestValidationError{
-				field:  "ZombieCheck",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-		if all {
-			switch v := interface{}(m.GetZombieTarget()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, FsckRequestValidationError{
-						field:  "ZombieTarget",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, FsckRequestValidationError{
-						field:  "ZombieTarget",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetRepo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PurgedDatumsValidationError{
+					field:  "Repo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(m.GetZombieTarget()).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return FsckRequestValidationError{
-					field:  "ZombieTarget",
+				errors = append(errors, PurgedDatumsValidationError{
+					field:  "Repo",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
 			}
 		}
-
-	case *FsckRequest_ZombieAll:
-		if v == nil {
-			err := FsckRequestValidationError{
-				field:  "ZombieCheck",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
+	} else if v, ok := interface{}(m.GetRepo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PurgedDatumsValidationError{
+				field:  "Repo",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
-			errors = append(errors, err)
 		}
-		// no validation rules for ZombieAll
-	default:
-		_ = v // ensures v is used
 	}
 
 	if len(errors) > 0 {
-		return FsckRequestMultiError(errors)
+		return PurgedDatumsMultiError(errors)
 	}
 
 	return nil
 }
 
-// FsckRequestMultiError is an error wrapping multiple validation errors
-// returned by FsckRequest.ValidateAll() if the designated constraints aren't met.
-type FsckRequestMultiError []error
+// PurgedDatumsMultiError is an error wrapping multiple validation errors
+// returned by PurgedDatums.ValidateAll() if the designated constraints aren't met.
+type PurgedDatumsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m FsckRequestMultiError) Error() string {
+func (m PurgedDatumsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8841,11 +9002,11 @@ func (m FsckRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m FsckRequestMultiError) AllErrors() []error { return m }
+func (m PurgedDatumsMultiError) AllErrors() []error { return m }
 
-// FsckRequestValidationError is the validation error returned by
-// FsckRequest.Validate if the designated constraints aren't met.
-type FsckRequestValidationError struct {
+// PurgedDatumsValidationError is the validation error returned by
+// PurgedDatums.Validate if the designated constraints aren't met.
+type PurgedDatumsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8853,22 +9014,22 @@ type FsckRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e FsckRequestValidationError) Field() string { return e.field }
+func (e PurgedDatumsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e FsckRequestValidationError) Reason() string { return e.reason }
+func (e PurgedDatumsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e FsckRequestValidationError) Cause() error { return e.cause }
+func (e PurgedDatumsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e FsckRequestValidationError) Key() bool { return e.key }
+func (e PurgedDatumsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e FsckRequestValidationError) ErrorName() string { return "FsckRequestValidationError" }
+func (e PurgedDatumsValidationError) ErrorName() string { return "PurgedDatumsValidationError" }
 
 // Error satisfies the builtin error interface
-func (e FsckRequestValidationError) Error() string {
+func (e PurgedDatumsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8880,14 +9041,14 @@ func (e FsckRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sFsckRequest.%s: %s%s",
+		"invalid %sPurgedDatums.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = FsckRequestValidationError{}
+var _ error = PurgedDatumsValidationError{}
 
 var _ interface {
 	Field() string
@@ -8895,47 +9056,112 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = FsckRequestValidationError{}
+} = PurgedDatumsValidationError{}
 
-// Validate checks the field values on FsckResponse with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *FsckResponse) Validate() error {
+// Validate checks the field values on PurgePathResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PurgePathResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on FsckResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in FsckResponseMultiError, or
-// nil if none found.
-func (m *FsckResponse) ValidateAll() error {
+// ValidateAll checks the field values on PurgePathResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PurgePathResponseMultiError, or nil if none found.
+func (m *PurgePathResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *FsckResponse) validate(all bool) error {
+func (m *PurgePathResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Fix
+	for idx, item := range m.GetCommits() {
+		_, _ = idx, item
 
-	// no validation rules for Error
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PurgePathResponseValidationError{
+						field:  fmt.Sprintf("Commits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PurgePathResponseValidationError{
+						field:  fmt.Sprintf("Commits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PurgePathResponseValidationError{
+					field:  fmt.Sprintf("Commits[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetDatums() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PurgePathResponseValidationError{
+						field:  fmt.Sprintf("Datums[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PurgePathResponseValidationError{
+						field:  fmt.Sprintf("Datums[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PurgePathResponseValidationError{
+					field:  fmt.Sprintf("Datums[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return FsckResponseMultiError(errors)
+		return PurgePathResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// FsckResponseMultiError is an error wrapping multiple validation errors
-// returned by FsckResponse.ValidateAll() if the designated constraints aren't met.
-type FsckResponseMultiError []error
+// PurgePathResponseMultiError is an error wrapping multiple validation errors
+// returned by PurgePathResponse.ValidateAll() if the designated constraints
+// aren't met.
+type PurgePathResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m FsckResponseMultiError) Error() string {
+func (m PurgePathResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8944,11 +9170,11 @@ func (m FsckResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m FsckResponseMultiError) AllErrors() []error { return m }
+func (m PurgePathResponseMultiError) AllErrors() []error { return m }
 
-// FsckResponseValidationError is the validation error returned by
-// FsckResponse.Validate if the designated constraints aren't met.
-type FsckResponseValidationError struct {
+// PurgePathResponseValidationError is the validation error returned by
+// PurgePathResponse.Validate if the designated constraints aren't met.
+type PurgePathResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8956,22 +9182,24 @@ type FsckResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e FsckResponseValidationError) Field() string { return e.field }
+func (e PurgePathResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e FsckResponseValidationError) Reason() string { return e.reason }
+func (e PurgePathResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e FsckResponseValidationError) Cause() error { return e.cause }
+func (e PurgePathResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e FsckResponseValidationError) Key() bool { return e.key }
+func (e PurgePathResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e FsckResponseValidationError) ErrorName() string { return "FsckResponseValidationError" }
+func (e PurgePathResponseValidationError) ErrorName() string {
+	return "PurgePathResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e FsckResponseValidationError) Error() string {
+func (e PurgePathResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8983,14 +9211,14 @@ func (e FsckResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sFsckResponse.%s: %s%s",
+		"invalid %sPurgePathResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = FsckResponseValidationError{}
+var _ error = PurgePathResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -8998,46 +9226,137 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = FsckResponseValidationError{}
+} = PurgePathResponseValidationError{}
 
-// Validate checks the field values on CreateFileSetResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateFileSetResponse) Validate() error {
+// Validate checks the field values on AddFile with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AddFile) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateFileSetResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// CreateFileSetResponseMultiError, or nil if none found.
-func (m *CreateFileSetResponse) ValidateAll() error {
-	return m.validate(true)
-}
+// ValidateAll checks the field values on AddFile with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in AddFileMultiError, or nil if none found.
+func (m *AddFile) ValidateAll() error {
+	return m.validate(true)
+}
 
-func (m *CreateFileSetResponse) validate(all bool) error {
+func (m *AddFile) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for FileSetId
+	// no validation rules for Path
+
+	// no validation rules for Datum
+
+	// no validation rules for ContentType
+
+	// no validation rules for Metadata
+
+	switch v := m.Source.(type) {
+	case *AddFile_Raw:
+		if v == nil {
+			err := AddFileValidationError{
+				field:  "Source",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetRaw()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AddFileValidationError{
+						field:  "Raw",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AddFileValidationError{
+						field:  "Raw",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRaw()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AddFileValidationError{
+					field:  "Raw",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *AddFile_Url:
+		if v == nil {
+			err := AddFileValidationError{
+				field:  "Source",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetUrl()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AddFileValidationError{
+						field:  "Url",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AddFileValidationError{
+						field:  "Url",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetUrl()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AddFileValidationError{
+					field:  "Url",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
 
 	if len(errors) > 0 {
-		return CreateFileSetResponseMultiError(errors)
+		return AddFileMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateFileSetResponseMultiError is an error wrapping multiple validation
-// errors returned by CreateFileSetResponse.ValidateAll() if the designated
-// constraints aren't met.
-type CreateFileSetResponseMultiError []error
+// AddFileMultiError is an error wrapping multiple validation errors returned
+// by AddFile.ValidateAll() if the designated constraints aren't met.
+type AddFileMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateFileSetResponseMultiError) Error() string {
+func (m AddFileMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9046,11 +9365,11 @@ func (m CreateFileSetResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateFileSetResponseMultiError) AllErrors() []error { return m }
+func (m AddFileMultiError) AllErrors() []error { return m }
 
-// CreateFileSetResponseValidationError is the validation error returned by
-// CreateFileSetResponse.Validate if the designated constraints aren't met.
-type CreateFileSetResponseValidationError struct {
+// AddFileValidationError is the validation error returned by AddFile.Validate
+// if the designated constraints aren't met.
+type AddFileValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9058,24 +9377,22 @@ type CreateFileSetResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateFileSetResponseValidationError) Field() string { return e.field }
+func (e AddFileValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateFileSetResponseValidationError) Reason() string { return e.reason }
+func (e AddFileValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateFileSetResponseValidationError) Cause() error { return e.cause }
+func (e AddFileValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateFileSetResponseValidationError) Key() bool { return e.key }
+func (e AddFileValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateFileSetResponseValidationError) ErrorName() string {
-	return "CreateFileSetResponseValidationError"
-}
+func (e AddFileValidationError) ErrorName() string { return "AddFileValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CreateFileSetResponseValidationError) Error() string {
+func (e AddFileValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9087,14 +9404,14 @@ func (e CreateFileSetResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateFileSetResponse.%s: %s%s",
+		"invalid %sAddFile.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateFileSetResponseValidationError{}
+var _ error = AddFileValidationError{}
 
 var _ interface {
 	Field() string
@@ -9102,73 +9419,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateFileSetResponseValidationError{}
+} = AddFileValidationError{}
 
-// Validate checks the field values on GetFileSetRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetFileSetRequest) Validate() error {
+// Validate checks the field values on DeleteFile with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DeleteFile) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetFileSetRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetFileSetRequestMultiError, or nil if none found.
-func (m *GetFileSetRequest) ValidateAll() error {
+// ValidateAll checks the field values on DeleteFile with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DeleteFileMultiError, or
+// nil if none found.
+func (m *DeleteFile) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetFileSetRequest) validate(all bool) error {
+func (m *DeleteFile) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetCommit()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetFileSetRequestValidationError{
-					field:  "Commit",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GetFileSetRequestValidationError{
-					field:  "Commit",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetCommit()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetFileSetRequestValidationError{
-				field:  "Commit",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Path
+
+	// no validation rules for Datum
 
 	if len(errors) > 0 {
-		return GetFileSetRequestMultiError(errors)
+		return DeleteFileMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetFileSetRequestMultiError is an error wrapping multiple validation errors
-// returned by GetFileSetRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetFileSetRequestMultiError []error
+// DeleteFileMultiError is an error wrapping multiple validation errors
+// returned by DeleteFile.ValidateAll() if the designated constraints aren't met.
+type DeleteFileMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetFileSetRequestMultiError) Error() string {
+func (m DeleteFileMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9177,11 +9468,11 @@ func (m GetFileSetRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetFileSetRequestMultiError) AllErrors() []error { return m }
+func (m DeleteFileMultiError) AllErrors() []error { return m }
 
-// GetFileSetRequestValidationError is the validation error returned by
-// GetFileSetRequest.Validate if the designated constraints aren't met.
-type GetFileSetRequestValidationError struct {
+// DeleteFileValidationError is the validation error returned by
+// DeleteFile.Validate if the designated constraints aren't met.
+type DeleteFileValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9189,24 +9480,22 @@ type GetFileSetRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetFileSetRequestValidationError) Field() string { return e.field }
+func (e DeleteFileValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetFileSetRequestValidationError) Reason() string { return e.reason }
+func (e DeleteFileValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetFileSetRequestValidationError) Cause() error { return e.cause }
+func (e DeleteFileValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetFileSetRequestValidationError) Key() bool { return e.key }
+func (e DeleteFileValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetFileSetRequestValidationError) ErrorName() string {
-	return "GetFileSetRequestValidationError"
-}
+func (e DeleteFileValidationError) ErrorName() string { return "DeleteFileValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetFileSetRequestValidationError) Error() string {
+func (e DeleteFileValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9218,14 +9507,14 @@ func (e GetFileSetRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetFileSetRequest.%s: %s%s",
+		"invalid %sDeleteFile.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetFileSetRequestValidationError{}
+var _ error = DeleteFileValidationError{}
 
 var _ interface {
 	Field() string
@@ -9233,75 +9522,89 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetFileSetRequestValidationError{}
+} = DeleteFileValidationError{}
 
-// Validate checks the field values on AddFileSetRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *AddFileSetRequest) Validate() error {
+// Validate checks the field values on CopyFile with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CopyFile) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AddFileSetRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AddFileSetRequestMultiError, or nil if none found.
-func (m *AddFileSetRequest) ValidateAll() error {
+// ValidateAll checks the field values on CopyFile with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CopyFileMultiError, or nil
+// if none found.
+func (m *CopyFile) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AddFileSetRequest) validate(all bool) error {
+func (m *CopyFile) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetCommit()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AddFileSetRequestValidationError{
-					field:  "Commit",
+	// no validation rules for Dst
+
+	// no validation rules for Datum
+
+	if m.GetSrc() == nil {
+		err := CopyFileValidationError{
+			field:  "Src",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetSrc()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CopyFileValidationError{
+					field:  "Src",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, AddFileSetRequestValidationError{
-					field:  "Commit",
+				errors = append(errors, CopyFileValidationError{
+					field:  "Src",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetCommit()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetSrc()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return AddFileSetRequestValidationError{
-				field:  "Commit",
+			return CopyFileValidationError{
+				field:  "Src",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	// no validation rules for FileSetId
+	// no validation rules for Append
 
 	if len(errors) > 0 {
-		return AddFileSetRequestMultiError(errors)
+		return CopyFileMultiError(errors)
 	}
 
 	return nil
 }
 
-// AddFileSetRequestMultiError is an error wrapping multiple validation errors
-// returned by AddFileSetRequest.ValidateAll() if the designated constraints
-// aren't met.
-type AddFileSetRequestMultiError []error
+// CopyFileMultiError is an error wrapping multiple validation errors returned
+// by CopyFile.ValidateAll() if the designated constraints aren't met.
+type CopyFileMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AddFileSetRequestMultiError) Error() string {
+func (m CopyFileMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9310,11 +9613,11 @@ func (m AddFileSetRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AddFileSetRequestMultiError) AllErrors() []error { return m }
+func (m CopyFileMultiError) AllErrors() []error { return m }
 
-// AddFileSetRequestValidationError is the validation error returned by
-// AddFileSetRequest.Validate if the designated constraints aren't met.
-type AddFileSetRequestValidationError struct {
+// CopyFileValidationError is the validation error returned by
+// CopyFile.Validate if the designated constraints aren't met.
+type CopyFileValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9322,24 +9625,22 @@ type AddFileSetRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AddFileSetRequestValidationError) Field() string { return e.field }
+func (e CopyFileValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AddFileSetRequestValidationError) Reason() string { return e.reason }
+func (e CopyFileValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AddFileSetRequestValidationError) Cause() error { return e.cause }
+func (e CopyFileValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AddFileSetRequestValidationError) Key() bool { return e.key }
+func (e CopyFileValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AddFileSetRequestValidationError) ErrorName() string {
-	return "AddFileSetRequestValidationError"
-}
+func (e CopyFileValidationError) ErrorName() string { return "CopyFileValidationError" }
 
 // Error satisfies the builtin error interface
-func (e AddFileSetRequestValidationError) Error() string {
+func (e CopyFileValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9351,14 +9652,14 @@ func (e AddFileSetRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAddFileSetRequest.%s: %s%s",
+		"invalid %sCopyFile.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AddFileSetRequestValidationError{}
+var _ error = CopyFileValidationError{}
 
 var _ interface {
 	Field() string
@@ -9366,86 +9667,3321 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AddFileSetRequestValidationError{}
+} = CopyFileValidationError{}
 
-// Validate checks the field values on RenewFileSetRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RenewFileSetRequest) Validate() error {
+// Validate checks the field values on ModifyFileRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ModifyFileRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RenewFileSetRequest with the rules
+// ValidateAll checks the field values on ModifyFileRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RenewFileSetRequestMultiError, or nil if none found.
-func (m *RenewFileSetRequest) ValidateAll() error {
+// ModifyFileRequestMultiError, or nil if none found.
+func (m *ModifyFileRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RenewFileSetRequest) validate(all bool) error {
+func (m *ModifyFileRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for FileSetId
-
-	// no validation rules for TtlSeconds
-
-	if len(errors) > 0 {
-		return RenewFileSetRequestMultiError(errors)
-	}
-
-	return nil
-}
-
-// RenewFileSetRequestMultiError is an error wrapping multiple validation
-// errors returned by RenewFileSetRequest.ValidateAll() if the designated
-// constraints aren't met.
-type RenewFileSetRequestMultiError []error
-
-// Error returns a concatenation of all the error messages it wraps.
-func (m RenewFileSetRequestMultiError) Error() string {
-	var msgs []string
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
-	}
-	return strings.Join(msgs, "; ")
-}
+	switch v := m.Body.(type) {
+	case *ModifyFileRequest_SetCommit:
+		if v == nil {
+			err := ModifyFileRequestValidationError{
+				field:  "Body",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
-// AllErrors returns a list of validation violation errors.
-func (m RenewFileSetRequestMultiError) AllErrors() []error { return m }
+		if m.GetSetCommit() == nil {
+			err := ModifyFileRequestValidationError{
+				field:  "SetCommit",
+				reason: "value is required",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
-// RenewFileSetRequestValidationError is the validation error returned by
-// RenewFileSetRequest.Validate if the designated constraints aren't met.
-type RenewFileSetRequestValidationError struct {
-	field  string
-	reason string
-	cause  error
-	key    bool
-}
+		if all {
+			switch v := interface{}(m.GetSetCommit()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ModifyFileRequestValidationError{
+						field:  "SetCommit",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ModifyFileRequestValidationError{
+						field:  "SetCommit",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSetCommit()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ModifyFileRequestValidationError{
+					field:  "SetCommit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-// Field function returns field value.
-func (e RenewFileSetRequestValidationError) Field() string { return e.field }
+	case *ModifyFileRequest_AddFile:
+		if v == nil {
+			err := ModifyFileRequestValidationError{
+				field:  "Body",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
-// Reason function returns reason value.
-func (e RenewFileSetRequestValidationError) Reason() string { return e.reason }
+		if m.GetAddFile() == nil {
+			err := ModifyFileRequestValidationError{
+				field:  "AddFile",
+				reason: "value is required",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
-// Cause function returns cause value.
-func (e RenewFileSetRequestValidationError) Cause() error { return e.cause }
+		if all {
+			switch v := interface{}(m.GetAddFile()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ModifyFileRequestValidationError{
+						field:  "AddFile",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ModifyFileRequestValidationError{
+						field:  "AddFile",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetAddFile()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ModifyFileRequestValidationError{
+					field:  "AddFile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-// Key function returns key value.
-func (e RenewFileSetRequestValidationError) Key() bool { return e.key }
+	case *ModifyFileRequest_DeleteFile:
+		if v == nil {
+			err := ModifyFileRequestValidationError{
+				field:  "Body",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
-// ErrorName returns error name.
-func (e RenewFileSetRequestValidationError) ErrorName() string {
-	return "RenewFileSetRequestValidationError"
-}
+		if m.GetDeleteFile() == nil {
+			err := ModifyFileRequestValidationError{
+				field:  "DeleteFile",
+				reason: "value is required",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
-// Error satisfies the builtin error interface
-func (e RenewFileSetRequestValidationError) Error() string {
+		if all {
+			switch v := interface{}(m.GetDeleteFile()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ModifyFileRequestValidationError{
+						field:  "DeleteFile",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ModifyFileRequestValidationError{
+						field:  "DeleteFile",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetDeleteFile()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ModifyFileRequestValidationError{
+					field:  "DeleteFile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ModifyFileRequest_CopyFile:
+		if v == nil {
+			err := ModifyFileRequestValidationError{
+				field:  "Body",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if m.GetCopyFile() == nil {
+			err := ModifyFileRequestValidationError{
+				field:  "CopyFile",
+				reason: "value is required",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetCopyFile()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ModifyFileRequestValidationError{
+						field:  "CopyFile",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ModifyFileRequestValidationError{
+						field:  "CopyFile",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCopyFile()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ModifyFileRequestValidationError{
+					field:  "CopyFile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return ModifyFileRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ModifyFileRequestMultiError is an error wrapping multiple validation errors
+// returned by ModifyFileRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ModifyFileRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ModifyFileRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ModifyFileRequestMultiError) AllErrors() []error { return m }
+
+// ModifyFileRequestValidationError is the validation error returned by
+// ModifyFileRequest.Validate if the designated constraints aren't met.
+type ModifyFileRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ModifyFileRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ModifyFileRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ModifyFileRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ModifyFileRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ModifyFileRequestValidationError) ErrorName() string {
+	return "ModifyFileRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ModifyFileRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sModifyFileRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ModifyFileRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ModifyFileRequestValidationError{}
+
+// Validate checks the field values on GetFileRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetFileRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetFileRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetFileRequestMultiError,
+// or nil if none found.
+func (m *GetFileRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetFileRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetFile()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetFileRequestValidationError{
+					field:  "File",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetFileRequestValidationError{
+					field:  "File",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFile()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetFileRequestValidationError{
+				field:  "File",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for URL
+
+	if m.GetOffset() < 0 {
+		err := GetFileRequestValidationError{
+			field:  "Offset",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetPathRange()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetFileRequestValidationError{
+					field:  "PathRange",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetFileRequestValidationError{
+					field:  "PathRange",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPathRange()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetFileRequestValidationError{
+				field:  "PathRange",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetSizeBytes() < 0 {
+		err := GetFileRequestValidationError{
+			field:  "SizeBytes",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetFileRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetFileRequestMultiError is an error wrapping multiple validation errors
+// returned by GetFileRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetFileRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetFileRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetFileRequestMultiError) AllErrors() []error { return m }
+
+// GetFileRequestValidationError is the validation error returned by
+// GetFileRequest.Validate if the designated constraints aren't met.
+type GetFileRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetFileRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetFileRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetFileRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetFileRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetFileRequestValidationError) ErrorName() string { return "GetFileRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetFileRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetFileRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetFileRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetFileRequestValidationError{}
+
+// Validate checks the field values on InspectFileRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InspectFileRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InspectFileRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InspectFileRequestMultiError, or nil if none found.
+func (m *InspectFileRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InspectFileRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetFile()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InspectFileRequestValidationError{
+					field:  "File",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InspectFileRequestValidationError{
+					field:  "File",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFile()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InspectFileRequestValidationError{
+				field:  "File",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return InspectFileRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InspectFileRequestMultiError is an error wrapping multiple validation errors
+// returned by InspectFileRequest.ValidateAll() if the designated constraints
+// aren't met.
+type InspectFileRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InspectFileRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InspectFileRequestMultiError) AllErrors() []error { return m }
+
+// InspectFileRequestValidationError is the validation error returned by
+// InspectFileRequest.Validate if the designated constraints aren't met.
+type InspectFileRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InspectFileRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InspectFileRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InspectFileRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InspectFileRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InspectFileRequestValidationError) ErrorName() string {
+	return "InspectFileRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InspectFileRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInspectFileRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InspectFileRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InspectFileRequestValidationError{}
+
+// Validate checks the field values on ListFileRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListFileRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListFileRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListFileRequestMultiError, or nil if none found.
+func (m *ListFileRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListFileRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetFile()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListFileRequestValidationError{
+					field:  "File",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListFileRequestValidationError{
+					field:  "File",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFile()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListFileRequestValidationError{
+				field:  "File",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPaginationMarker()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListFileRequestValidationError{
+					field:  "PaginationMarker",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListFileRequestValidationError{
+					field:  "PaginationMarker",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPaginationMarker()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListFileRequestValidationError{
+				field:  "PaginationMarker",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Number
+
+	// no validation rules for Reverse
+
+	if len(errors) > 0 {
+		return ListFileRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListFileRequestMultiError is an error wrapping multiple validation errors
+// returned by ListFileRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListFileRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListFileRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListFileRequestMultiError) AllErrors() []error { return m }
+
+// ListFileRequestValidationError is the validation error returned by
+// ListFileRequest.Validate if the designated constraints aren't met.
+type ListFileRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListFileRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListFileRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListFileRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListFileRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListFileRequestValidationError) ErrorName() string { return "ListFileRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListFileRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListFileRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListFileRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListFileRequestValidationError{}
+
+// Validate checks the field values on WalkFileRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *WalkFileRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WalkFileRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WalkFileRequestMultiError, or nil if none found.
+func (m *WalkFileRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WalkFileRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetFile()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WalkFileRequestValidationError{
+					field:  "File",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WalkFileRequestValidationError{
+					field:  "File",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFile()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WalkFileRequestValidationError{
+				field:  "File",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPaginationMarker()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WalkFileRequestValidationError{
+					field:  "PaginationMarker",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WalkFileRequestValidationError{
+					field:  "PaginationMarker",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPaginationMarker()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WalkFileRequestValidationError{
+				field:  "PaginationMarker",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Number
+
+	// no validation rules for Reverse
+
+	if len(errors) > 0 {
+		return WalkFileRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WalkFileRequestMultiError is an error wrapping multiple validation errors
+// returned by WalkFileRequest.ValidateAll() if the designated constraints
+// aren't met.
+type WalkFileRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WalkFileRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WalkFileRequestMultiError) AllErrors() []error { return m }
+
+// WalkFileRequestValidationError is the validation error returned by
+// WalkFileRequest.Validate if the designated constraints aren't met.
+type WalkFileRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WalkFileRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WalkFileRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WalkFileRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WalkFileRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WalkFileRequestValidationError) ErrorName() string { return "WalkFileRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WalkFileRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWalkFileRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WalkFileRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WalkFileRequestValidationError{}
+
+// Validate checks the field values on GlobFileRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GlobFileRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GlobFileRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GlobFileRequestMultiError, or nil if none found.
+func (m *GlobFileRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GlobFileRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCommit()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GlobFileRequestValidationError{
+					field:  "Commit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GlobFileRequestValidationError{
+					field:  "Commit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCommit()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GlobFileRequestValidationError{
+				field:  "Commit",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Pattern
+
+	if all {
+		switch v := interface{}(m.GetPathRange()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GlobFileRequestValidationError{
+					field:  "PathRange",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GlobFileRequestValidationError{
+					field:  "PathRange",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPathRange()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GlobFileRequestValidationError{
+				field:  "PathRange",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GlobFileRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GlobFileRequestMultiError is an error wrapping multiple validation errors
+// returned by GlobFileRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GlobFileRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GlobFileRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GlobFileRequestMultiError) AllErrors() []error { return m }
+
+// GlobFileRequestValidationError is the validation error returned by
+// GlobFileRequest.Validate if the designated constraints aren't met.
+type GlobFileRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GlobFileRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GlobFileRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GlobFileRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GlobFileRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GlobFileRequestValidationError) ErrorName() string { return "GlobFileRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GlobFileRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGlobFileRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GlobFileRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GlobFileRequestValidationError{}
+
+// Validate checks the field values on DiffFileRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DiffFileRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DiffFileRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DiffFileRequestMultiError, or nil if none found.
+func (m *DiffFileRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DiffFileRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetNewFile()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DiffFileRequestValidationError{
+					field:  "NewFile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DiffFileRequestValidationError{
+					field:  "NewFile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNewFile()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DiffFileRequestValidationError{
+				field:  "NewFile",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetOldFile()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DiffFileRequestValidationError{
+					field:  "OldFile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DiffFileRequestValidationError{
+					field:  "OldFile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOldFile()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DiffFileRequestValidationError{
+				field:  "OldFile",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Shallow
+
+	if len(errors) > 0 {
+		return DiffFileRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DiffFileRequestMultiError is an error wrapping multiple validation errors
+// returned by DiffFileRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DiffFileRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DiffFileRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DiffFileRequestMultiError) AllErrors() []error { return m }
+
+// DiffFileRequestValidationError is the validation error returned by
+// DiffFileRequest.Validate if the designated constraints aren't met.
+type DiffFileRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DiffFileRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DiffFileRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DiffFileRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DiffFileRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DiffFileRequestValidationError) ErrorName() string { return "DiffFileRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DiffFileRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDiffFileRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DiffFileRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DiffFileRequestValidationError{}
+
+// Validate checks the field values on DiffFileResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DiffFileResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DiffFileResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DiffFileResponseMultiError, or nil if none found.
+func (m *DiffFileResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DiffFileResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetNewFile()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DiffFileResponseValidationError{
+					field:  "NewFile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DiffFileResponseValidationError{
+					field:  "NewFile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNewFile()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DiffFileResponseValidationError{
+				field:  "NewFile",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetOldFile()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DiffFileResponseValidationError{
+					field:  "OldFile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DiffFileResponseValidationError{
+					field:  "OldFile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOldFile()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DiffFileResponseValidationError{
+				field:  "OldFile",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DiffFileResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DiffFileResponseMultiError is an error wrapping multiple validation errors
+// returned by DiffFileResponse.ValidateAll() if the designated constraints
+// aren't met.
+type DiffFileResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DiffFileResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DiffFileResponseMultiError) AllErrors() []error { return m }
+
+// DiffFileResponseValidationError is the validation error returned by
+// DiffFileResponse.Validate if the designated constraints aren't met.
+type DiffFileResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DiffFileResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DiffFileResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DiffFileResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DiffFileResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DiffFileResponseValidationError) ErrorName() string { return "DiffFileResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DiffFileResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDiffFileResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DiffFileResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DiffFileResponseValidationError{}
+
+// Validate checks the field values on FsckRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FsckRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FsckRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FsckRequestMultiError, or
+// nil if none found.
+func (m *FsckRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FsckRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Fix
+
+	switch v := m.ZombieCheck.(type) {
+	case *FsckRequest_ZombieTarget:
+		if v == nil {
+			err := FsckRequestValidationError{
+				field:  "ZombieCheck",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetZombieTarget()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, FsckRequestValidationError{
+						field:  "ZombieTarget",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, FsckRequestValidationError{
+						field:  "ZombieTarget",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetZombieTarget()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return FsckRequestValidationError{
+					field:  "ZombieTarget",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *FsckRequest_ZombieAll:
+		if v == nil {
+			err := FsckRequestValidationError{
+				field:  "ZombieCheck",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for ZombieAll
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return FsckRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// FsckRequestMultiError is an error wrapping multiple validation errors
+// returned by FsckRequest.ValidateAll() if the designated constraints aren't met.
+type FsckRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FsckRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FsckRequestMultiError) AllErrors() []error { return m }
+
+// FsckRequestValidationError is the validation error returned by
+// FsckRequest.Validate if the designated constraints aren't met.
+type FsckRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FsckRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FsckRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FsckRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FsckRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FsckRequestValidationError) ErrorName() string { return "FsckRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FsckRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFsckRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FsckRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FsckRequestValidationError{}
+
+// Validate checks the field values on FsckResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FsckResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FsckResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FsckResponseMultiError, or
+// nil if none found.
+func (m *FsckResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FsckResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Fix
+
+	// no validation rules for Error
+
+	if len(errors) > 0 {
+		return FsckResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// FsckResponseMultiError is an error wrapping multiple validation errors
+// returned by FsckResponse.ValidateAll() if the designated constraints aren't met.
+type FsckResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FsckResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FsckResponseMultiError) AllErrors() []error { return m }
+
+// FsckResponseValidationError is the validation error returned by
+// FsckResponse.Validate if the designated constraints aren't met.
+type FsckResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FsckResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FsckResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FsckResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FsckResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FsckResponseValidationError) ErrorName() string { return "FsckResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FsckResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFsckResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FsckResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FsckResponseValidationError{}
+
+// Validate checks the field values on CreateFileSetResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateFileSetResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateFileSetResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateFileSetResponseMultiError, or nil if none found.
+func (m *CreateFileSetResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateFileSetResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for FileSetId
+
+	if len(errors) > 0 {
+		return CreateFileSetResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateFileSetResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateFileSetResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateFileSetResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateFileSetResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateFileSetResponseMultiError) AllErrors() []error { return m }
+
+// CreateFileSetResponseValidationError is the validation error returned by
+// CreateFileSetResponse.Validate if the designated constraints aren't met.
+type CreateFileSetResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateFileSetResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateFileSetResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateFileSetResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateFileSetResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateFileSetResponseValidationError) ErrorName() string {
+	return "CreateFileSetResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateFileSetResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateFileSetResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateFileSetResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateFileSetResponseValidationError{}
+
+// Validate checks the field values on GetFileSetRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetFileSetRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetFileSetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetFileSetRequestMultiError, or nil if none found.
+func (m *GetFileSetRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetFileSetRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCommit()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetFileSetRequestValidationError{
+					field:  "Commit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetFileSetRequestValidationError{
+					field:  "Commit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCommit()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetFileSetRequestValidationError{
+				field:  "Commit",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetFileSetRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetFileSetRequestMultiError is an error wrapping multiple validation errors
+// returned by GetFileSetRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetFileSetRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetFileSetRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetFileSetRequestMultiError) AllErrors() []error { return m }
+
+// GetFileSetRequestValidationError is the validation error returned by
+// GetFileSetRequest.Validate if the designated constraints aren't met.
+type GetFileSetRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetFileSetRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetFileSetRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetFileSetRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetFileSetRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetFileSetRequestValidationError) ErrorName() string {
+	return "GetFileSetRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetFileSetRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetFileSetRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetFileSetRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetFileSetRequestValidationError{}
+
+// Validate checks the field values on AddFileSetRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AddFileSetRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddFileSetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddFileSetRequestMultiError, or nil if none found.
+func (m *AddFileSetRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddFileSetRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCommit()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AddFileSetRequestValidationError{
+					field:  "Commit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AddFileSetRequestValidationError{
+					field:  "Commit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCommit()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AddFileSetRequestValidationError{
+				field:  "Commit",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for FileSetId
+
+	if len(errors) > 0 {
+		return AddFileSetRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddFileSetRequestMultiError is an error wrapping multiple validation errors
+// returned by AddFileSetRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AddFileSetRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddFileSetRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddFileSetRequestMultiError) AllErrors() []error { return m }
+
+// AddFileSetRequestValidationError is the validation error returned by
+// AddFileSetRequest.Validate if the designated constraints aren't met.
+type AddFileSetRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddFileSetRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddFileSetRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddFileSetRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddFileSetRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddFileSetRequestValidationError) ErrorName() string {
+	return "AddFileSetRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddFileSetRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddFileSetRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddFileSetRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddFileSetRequestValidationError{}
+
+// Validate checks the field values on RenewFileSetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RenewFileSetRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RenewFileSetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RenewFileSetRequestMultiError, or nil if none found.
+func (m *RenewFileSetRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RenewFileSetRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for FileSetId
+
+	// no validation rules for TtlSeconds
+
+	if len(errors) > 0 {
+		return RenewFileSetRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RenewFileSetRequestMultiError is an error wrapping multiple validation
+// errors returned by RenewFileSetRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RenewFileSetRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RenewFileSetRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RenewFileSetRequestMultiError) AllErrors() []error { return m }
+
+// RenewFileSetRequestValidationError is the validation error returned by
+// RenewFileSetRequest.Validate if the designated constraints aren't met.
+type RenewFileSetRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RenewFileSetRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RenewFileSetRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RenewFileSetRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RenewFileSetRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RenewFileSetRequestValidationError) ErrorName() string {
+	return "RenewFileSetRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RenewFileSetRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRenewFileSetRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RenewFileSetRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RenewFileSetRequestValidationError{}
+
+// Validate checks the field values on ComposeFileSetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ComposeFileSetRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ComposeFileSetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ComposeFileSetRequestMultiError, or nil if none found.
+func (m *ComposeFileSetRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ComposeFileSetRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TtlSeconds
+
+	// no validation rules for Compact
+
+	if len(errors) > 0 {
+		return ComposeFileSetRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ComposeFileSetRequestMultiError is an error wrapping multiple validation
+// errors returned by ComposeFileSetRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ComposeFileSetRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ComposeFileSetRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ComposeFileSetRequestMultiError) AllErrors() []error { return m }
+
+// ComposeFileSetRequestValidationError is the validation error returned by
+// ComposeFileSetRequest.Validate if the designated constraints aren't met.
+type ComposeFileSetRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ComposeFileSetRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ComposeFileSetRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ComposeFileSetRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ComposeFileSetRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ComposeFileSetRequestValidationError) ErrorName() string {
+	return "ComposeFileSetRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ComposeFileSetRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sComposeFileSetRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ComposeFileSetRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ComposeFileSetRequestValidationError{}
+
+// Validate checks the field values on ShardFileSetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ShardFileSetRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ShardFileSetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ShardFileSetRequestMultiError, or nil if none found.
+func (m *ShardFileSetRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ShardFileSetRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for FileSetId
+
+	if len(errors) > 0 {
+		return ShardFileSetRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ShardFileSetRequestMultiError is an error wrapping multiple validation
+// errors returned by ShardFileSetRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ShardFileSetRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ShardFileSetRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ShardFileSetRequestMultiError) AllErrors() []error { return m }
+
+// ShardFileSetRequestValidationError is the validation error returned by
+// ShardFileSetRequest.Validate if the designated constraints aren't met.
+type ShardFileSetRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ShardFileSetRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ShardFileSetRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ShardFileSetRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ShardFileSetRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ShardFileSetRequestValidationError) ErrorName() string {
+	return "ShardFileSetRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ShardFileSetRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sShardFileSetRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ShardFileSetRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ShardFileSetRequestValidationError{}
+
+// Validate checks the field values on PathRange with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PathRange) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PathRange with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PathRangeMultiError, or nil
+// if none found.
+func (m *PathRange) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PathRange) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Lower
+
+	// no validation rules for Upper
+
+	if len(errors) > 0 {
+		return PathRangeMultiError(errors)
+	}
+
+	return nil
+}
+
+// PathRangeMultiError is an error wrapping multiple validation errors returned
+// by PathRange.ValidateAll() if the designated constraints aren't met.
+type PathRangeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PathRangeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PathRangeMultiError) AllErrors() []error { return m }
+
+// PathRangeValidationError is the validation error returned by
+// PathRange.Validate if the designated constraints aren't met.
+type PathRangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PathRangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PathRangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PathRangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PathRangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PathRangeValidationError) ErrorName() string { return "PathRangeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PathRangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPathRange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PathRangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PathRangeValidationError{}
+
+// Validate checks the field values on ShardFileSetResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ShardFileSetResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ShardFileSetResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ShardFileSetResponseMultiError, or nil if none found.
+func (m *ShardFileSetResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ShardFileSetResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetShards() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ShardFileSetResponseValidationError{
+						field:  fmt.Sprintf("Shards[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ShardFileSetResponseValidationError{
+						field:  fmt.Sprintf("Shards[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ShardFileSetResponseValidationError{
+					field:  fmt.Sprintf("Shards[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ShardFileSetResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ShardFileSetResponseMultiError is an error wrapping multiple validation
+// errors returned by ShardFileSetResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ShardFileSetResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ShardFileSetResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ShardFileSetResponseMultiError) AllErrors() []error { return m }
+
+// ShardFileSetResponseValidationError is the validation error returned by
+// ShardFileSetResponse.Validate if the designated constraints aren't met.
+type ShardFileSetResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ShardFileSetResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ShardFileSetResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ShardFileSetResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ShardFileSetResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ShardFileSetResponseValidationError) ErrorName() string {
+	return "ShardFileSetResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ShardFileSetResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sShardFileSetResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ShardFileSetResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ShardFileSetResponseValidationError{}
+
+// Validate checks the field values on CheckStorageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CheckStorageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckStorageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CheckStorageRequestMultiError, or nil if none found.
+func (m *CheckStorageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckStorageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ReadChunkData
+
+	// no validation rules for ChunkBegin
+
+	// no validation rules for ChunkEnd
+
+	// no validation rules for CheckConsistency
+
+	// no validation rules for ConsistencyLimit
+
+	if len(errors) > 0 {
+		return CheckStorageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckStorageRequestMultiError is an error wrapping multiple validation
+// errors returned by CheckStorageRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CheckStorageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckStorageRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckStorageRequestMultiError) AllErrors() []error { return m }
+
+// CheckStorageRequestValidationError is the validation error returned by
+// CheckStorageRequest.Validate if the designated constraints aren't met.
+type CheckStorageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckStorageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckStorageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckStorageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckStorageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckStorageRequestValidationError) ErrorName() string {
+	return "CheckStorageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CheckStorageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckStorageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckStorageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckStorageRequestValidationError{}
+
+// Validate checks the field values on StorageConsistency with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StorageConsistency) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StorageConsistency with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StorageConsistencyMultiError, or nil if none found.
+func (m *StorageConsistency) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StorageConsistency) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MissingObjectCount
+
+	// no validation rules for UntrackedEntryCount
+
+	// no validation rules for OrphanedObjectCount
+
+	// no validation rules for GarbageObjectCount
+
+	// no validation rules for GarbageBytes
+
+	if len(errors) > 0 {
+		return StorageConsistencyMultiError(errors)
+	}
+
+	return nil
+}
+
+// StorageConsistencyMultiError is an error wrapping multiple validation errors
+// returned by StorageConsistency.ValidateAll() if the designated constraints
+// aren't met.
+type StorageConsistencyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StorageConsistencyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StorageConsistencyMultiError) AllErrors() []error { return m }
+
+// StorageConsistencyValidationError is the validation error returned by
+// StorageConsistency.Validate if the designated constraints aren't met.
+type StorageConsistencyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StorageConsistencyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StorageConsistencyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StorageConsistencyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StorageConsistencyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StorageConsistencyValidationError) ErrorName() string {
+	return "StorageConsistencyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StorageConsistencyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStorageConsistency.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StorageConsistencyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StorageConsistencyValidationError{}
+
+// Validate checks the field values on CheckStorageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CheckStorageResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckStorageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CheckStorageResponseMultiError, or nil if none found.
+func (m *CheckStorageResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckStorageResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ChunkObjectCount
+
+	if all {
+		switch v := interface{}(m.GetConsistency()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CheckStorageResponseValidationError{
+					field:  "Consistency",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CheckStorageResponseValidationError{
+					field:  "Consistency",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConsistency()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CheckStorageResponseValidationError{
+				field:  "Consistency",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CheckStorageResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckStorageResponseMultiError is an error wrapping multiple validation
+// errors returned by CheckStorageResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CheckStorageResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckStorageResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckStorageResponseMultiError) AllErrors() []error { return m }
+
+// CheckStorageResponseValidationError is the validation error returned by
+// CheckStorageResponse.Validate if the designated constraints aren't met.
+type CheckStorageResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckStorageResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckStorageResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckStorageResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckStorageResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckStorageResponseValidationError) ErrorName() string {
+	return "CheckStorageResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CheckStorageResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckStorageResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckStorageResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckStorageResponseValidationError{}
+
+// Validate checks the field values on InspectStorageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InspectStorageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InspectStorageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InspectStorageRequestMultiError, or nil if none found.
+func (m *InspectStorageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InspectStorageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Limit
+
+	if len(errors) > 0 {
+		return InspectStorageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InspectStorageRequestMultiError is an error wrapping multiple validation
+// errors returned by InspectStorageRequest.ValidateAll() if the designated
+// constraints aren't met.
+type InspectStorageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InspectStorageRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InspectStorageRequestMultiError) AllErrors() []error { return m }
+
+// InspectStorageRequestValidationError is the validation error returned by
+// InspectStorageRequest.Validate if the designated constraints aren't met.
+type InspectStorageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InspectStorageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InspectStorageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InspectStorageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InspectStorageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InspectStorageRequestValidationError) ErrorName() string {
+	return "InspectStorageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InspectStorageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInspectStorageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InspectStorageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InspectStorageRequestValidationError{}
+
+// Validate checks the field values on StorageStats with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *StorageStats) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StorageStats with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in StorageStatsMultiError, or
+// nil if none found.
+func (m *StorageStats) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StorageStats) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for LogicalBytes
+
+	// no validation rules for PhysicalBytes
+
+	// no validation rules for UniqueBytes
+
+	// no validation rules for ChunkCount
+
+	if len(errors) > 0 {
+		return StorageStatsMultiError(errors)
+	}
+
+	return nil
+}
+
+// StorageStatsMultiError is an error wrapping multiple validation errors
+// returned by StorageStats.ValidateAll() if the designated constraints aren't met.
+type StorageStatsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StorageStatsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StorageStatsMultiError) AllErrors() []error { return m }
+
+// StorageStatsValidationError is the validation error returned by
+// StorageStats.Validate if the designated constraints aren't met.
+type StorageStatsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StorageStatsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StorageStatsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StorageStatsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StorageStatsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StorageStatsValidationError) ErrorName() string { return "StorageStatsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StorageStatsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStorageStats.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StorageStatsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StorageStatsValidationError{}
+
+// Validate checks the field values on RepoStorageStats with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RepoStorageStats) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RepoStorageStats with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RepoStorageStatsMultiError, or nil if none found.
+func (m *RepoStorageStats) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RepoStorageStats) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRepo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RepoStorageStatsValidationError{
+					field:  "Repo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RepoStorageStatsValidationError{
+					field:  "Repo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRepo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RepoStorageStatsValidationError{
+				field:  "Repo",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetStats()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RepoStorageStatsValidationError{
+					field:  "Stats",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RepoStorageStatsValidationError{
+					field:  "Stats",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStats()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RepoStorageStatsValidationError{
+				field:  "Stats",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RepoStorageStatsMultiError(errors)
+	}
+
+	return nil
+}
+
+// RepoStorageStatsMultiError is an error wrapping multiple validation errors
+// returned by RepoStorageStats.ValidateAll() if the designated constraints
+// aren't met.
+type RepoStorageStatsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RepoStorageStatsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RepoStorageStatsMultiError) AllErrors() []error { return m }
+
+// RepoStorageStatsValidationError is the validation error returned by
+// RepoStorageStats.Validate if the designated constraints aren't met.
+type RepoStorageStatsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RepoStorageStatsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RepoStorageStatsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RepoStorageStatsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RepoStorageStatsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RepoStorageStatsValidationError) ErrorName() string { return "RepoStorageStatsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RepoStorageStatsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9457,14 +12993,14 @@ func (e RenewFileSetRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRenewFileSetRequest.%s: %s%s",
+		"invalid %sRepoStorageStats.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RenewFileSetRequestValidationError{}
+var _ error = RepoStorageStatsValidationError{}
 
 var _ interface {
 	Field() string
@@ -9472,48 +13008,266 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RenewFileSetRequestValidationError{}
+} = RepoStorageStatsValidationError{}
 
-// Validate checks the field values on ComposeFileSetRequest with the rules
+// Validate checks the field values on ProjectStorageStats with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ComposeFileSetRequest) Validate() error {
+func (m *ProjectStorageStats) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ComposeFileSetRequest with the rules
+// ValidateAll checks the field values on ProjectStorageStats with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ComposeFileSetRequestMultiError, or nil if none found.
-func (m *ComposeFileSetRequest) ValidateAll() error {
+// ProjectStorageStatsMultiError, or nil if none found.
+func (m *ProjectStorageStats) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ComposeFileSetRequest) validate(all bool) error {
+func (m *ProjectStorageStats) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetProject()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ProjectStorageStatsValidationError{
+					field:  "Project",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ProjectStorageStatsValidationError{
+					field:  "Project",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProject()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ProjectStorageStatsValidationError{
+				field:  "Project",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetStats()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ProjectStorageStatsValidationError{
+					field:  "Stats",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ProjectStorageStatsValidationError{
+					field:  "Stats",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStats()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ProjectStorageStatsValidationError{
+				field:  "Stats",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ProjectStorageStatsMultiError(errors)
+	}
+
+	return nil
+}
+
+// ProjectStorageStatsMultiError is an error wrapping multiple validation
+// errors returned by ProjectStorageStats.ValidateAll() if the designated
+// constraints aren't met.
+type ProjectStorageStatsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ProjectStorageStatsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ProjectStorageStatsMultiError) AllErrors() []error { return m }
+
+// ProjectStorageStatsValidationError is the validation error returned by
+// ProjectStorageStats.Validate if the designated constraints aren't met.
+type ProjectStorageStatsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProjectStorageStatsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProjectStorageStatsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProjectStorageStatsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProjectStorageStatsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProjectStorageStatsValidationError) ErrorName() string {
+	return "ProjectStorageStatsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ProjectStorageStatsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProjectStorageStats.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProjectStorageStatsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProjectStorageStatsValidationError{}
+
+// Validate checks the field values on SharedStorageStats with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SharedStorageStats) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SharedStorageStats with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SharedStorageStatsMultiError, or nil if none found.
+func (m *SharedStorageStats) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SharedStorageStats) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	var errors []error
-
-	// no validation rules for TtlSeconds
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRepoA()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SharedStorageStatsValidationError{
+					field:  "RepoA",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SharedStorageStatsValidationError{
+					field:  "RepoA",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRepoA()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SharedStorageStatsValidationError{
+				field:  "RepoA",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRepoB()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SharedStorageStatsValidationError{
+					field:  "RepoB",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SharedStorageStatsValidationError{
+					field:  "RepoB",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRepoB()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SharedStorageStatsValidationError{
+				field:  "RepoB",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for SharedBytes
 
-	// no validation rules for Compact
+	// no validation rules for SharedRatio
 
 	if len(errors) > 0 {
-		return ComposeFileSetRequestMultiError(errors)
+		return SharedStorageStatsMultiError(errors)
 	}
 
 	return nil
 }
 
-// ComposeFileSetRequestMultiError is an error wrapping multiple validation
-// errors returned by ComposeFileSetRequest.ValidateAll() if the designated
-// constraints aren't met.
-type ComposeFileSetRequestMultiError []error
+// SharedStorageStatsMultiError is an error wrapping multiple validation errors
+// returned by SharedStorageStats.ValidateAll() if the designated constraints
+// aren't met.
+type SharedStorageStatsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ComposeFileSetRequestMultiError) Error() string {
+func (m SharedStorageStatsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9522,11 +13276,11 @@ func (m ComposeFileSetRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ComposeFileSetRequestMultiError) AllErrors() []error { return m }
+func (m SharedStorageStatsMultiError) AllErrors() []error { return m }
 
-// ComposeFileSetRequestValidationError is the validation error returned by
-// ComposeFileSetRequest.Validate if the designated constraints aren't met.
-type ComposeFileSetRequestValidationError struct {
+// SharedStorageStatsValidationError is the validation error returned by
+// SharedStorageStats.Validate if the designated constraints aren't met.
+type SharedStorageStatsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9534,24 +13288,24 @@ type ComposeFileSetRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ComposeFileSetRequestValidationError) Field() string { return e.field }
+func (e SharedStorageStatsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ComposeFileSetRequestValidationError) Reason() string { return e.reason }
+func (e SharedStorageStatsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ComposeFileSetRequestValidationError) Cause() error { return e.cause }
+func (e SharedStorageStatsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ComposeFileSetRequestValidationError) Key() bool { return e.key }
+func (e SharedStorageStatsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ComposeFileSetRequestValidationError) ErrorName() string {
-	return "ComposeFileSetRequestValidationError"
+func (e SharedStorageStatsValidationError) ErrorName() string {
+	return "SharedStorageStatsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ComposeFileSetRequestValidationError) Error() string {
+func (e SharedStorageStatsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9563,14 +13317,14 @@ func (e ComposeFileSetRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sComposeFileSetRequest.%s: %s%s",
+		"invalid %sSharedStorageStats.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ComposeFileSetRequestValidationError{}
+var _ error = SharedStorageStatsValidationError{}
 
 var _ interface {
 	Field() string
@@ -9578,46 +13332,204 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ComposeFileSetRequestValidationError{}
+} = SharedStorageStatsValidationError{}
 
-// Validate checks the field values on ShardFileSetRequest with the rules
+// Validate checks the field values on InspectStorageResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ShardFileSetRequest) Validate() error {
+func (m *InspectStorageResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ShardFileSetRequest with the rules
+// ValidateAll checks the field values on InspectStorageResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ShardFileSetRequestMultiError, or nil if none found.
-func (m *ShardFileSetRequest) ValidateAll() error {
+// InspectStorageResponseMultiError, or nil if none found.
+func (m *InspectStorageResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ShardFileSetRequest) validate(all bool) error {
+func (m *InspectStorageResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for FileSetId
+	if all {
+		switch v := interface{}(m.GetTotal()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InspectStorageResponseValidationError{
+					field:  "Total",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InspectStorageResponseValidationError{
+					field:  "Total",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTotal()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InspectStorageResponseValidationError{
+				field:  "Total",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetProjects() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InspectStorageResponseValidationError{
+						field:  fmt.Sprintf("Projects[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InspectStorageResponseValidationError{
+						field:  fmt.Sprintf("Projects[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InspectStorageResponseValidationError{
+					field:  fmt.Sprintf("Projects[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetRepos() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InspectStorageResponseValidationError{
+						field:  fmt.Sprintf("Repos[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InspectStorageResponseValidationError{
+						field:  fmt.Sprintf("Repos[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InspectStorageResponseValidationError{
+					field:  fmt.Sprintf("Repos[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetShared() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InspectStorageResponseValidationError{
+						field:  fmt.Sprintf("Shared[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InspectStorageResponseValidationError{
+						field:  fmt.Sprintf("Shared[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InspectStorageResponseValidationError{
+					field:  fmt.Sprintf("Shared[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetComputedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InspectStorageResponseValidationError{
+					field:  "ComputedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InspectStorageResponseValidationError{
+					field:  "ComputedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetComputedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InspectStorageResponseValidationError{
+				field:  "ComputedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return ShardFileSetRequestMultiError(errors)
+		return InspectStorageResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ShardFileSetRequestMultiError is an error wrapping multiple validation
-// errors returned by ShardFileSetRequest.ValidateAll() if the designated
+// InspectStorageResponseMultiError is an error wrapping multiple validation
+// errors returned by InspectStorageResponse.ValidateAll() if the designated
 // constraints aren't met.
-type ShardFileSetRequestMultiError []error
+type InspectStorageResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ShardFileSetRequestMultiError) Error() string {
+func (m InspectStorageResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9626,11 +13538,11 @@ func (m ShardFileSetRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ShardFileSetRequestMultiError) AllErrors() []error { return m }
+func (m InspectStorageResponseMultiError) AllErrors() []error { return m }
 
-// ShardFileSetRequestValidationError is the validation error returned by
-// ShardFileSetRequest.Validate if the designated constraints aren't met.
-type ShardFileSetRequestValidationError struct {
+// InspectStorageResponseValidationError is the validation error returned by
+// InspectStorageResponse.Validate if the designated constraints aren't met.
+type InspectStorageResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9638,24 +13550,24 @@ type ShardFileSetRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ShardFileSetRequestValidationError) Field() string { return e.field }
+func (e InspectStorageResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ShardFileSetRequestValidationError) Reason() string { return e.reason }
+func (e InspectStorageResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ShardFileSetRequestValidationError) Cause() error { return e.cause }
+func (e InspectStorageResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ShardFileSetRequestValidationError) Key() bool { return e.key }
+func (e InspectStorageResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ShardFileSetRequestValidationError) ErrorName() string {
-	return "ShardFileSetRequestValidationError"
+func (e InspectStorageResponseValidationError) ErrorName() string {
+	return "InspectStorageResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ShardFileSetRequestValidationError) Error() string {
+func (e InspectStorageResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9667,14 +13579,14 @@ func (e ShardFileSetRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sShardFileSetRequest.%s: %s%s",
+		"invalid %sInspectStorageResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ShardFileSetRequestValidationError{}
+var _ error = InspectStorageResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -9682,47 +13594,77 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ShardFileSetRequestValidationError{}
+} = InspectStorageResponseValidationError{}
 
-// Validate checks the field values on PathRange with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *PathRange) Validate() error {
+// Validate checks the field values on PutCacheRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PutCacheRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PathRange with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in PathRangeMultiError, or nil
-// if none found.
-func (m *PathRange) ValidateAll() error {
+// ValidateAll checks the field values on PutCacheRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PutCacheRequestMultiError, or nil if none found.
+func (m *PutCacheRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PathRange) validate(all bool) error {
+func (m *PutCacheRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Lower
+	// no validation rules for Key
 
-	// no validation rules for Upper
+	if all {
+		switch v := interface{}(m.GetValue()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PutCacheRequestValidationError{
+					field:  "Value",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PutCacheRequestValidationError{
+					field:  "Value",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetValue()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PutCacheRequestValidationError{
+				field:  "Value",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Tag
 
 	if len(errors) > 0 {
-		return PathRangeMultiError(errors)
+		return PutCacheRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// PathRangeMultiError is an error wrapping multiple validation errors returned
-// by PathRange.ValidateAll() if the designated constraints aren't met.
-type PathRangeMultiError []error
-
+// PutCacheRequestMultiError is an error wrapping multiple validation errors
+// returned by PutCacheRequest.ValidateAll() if the designated constraints
+// aren't met.
+type PutCacheRequestMultiError []error
+
 // Error returns a concatenation of all the error messages it wraps.
-func (m PathRangeMultiError) Error() string {
+func (m PutCacheRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9731,11 +13673,11 @@ func (m PathRangeMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PathRangeMultiError) AllErrors() []error { return m }
+func (m PutCacheRequestMultiError) AllErrors() []error { return m }
 
-// PathRangeValidationError is the validation error returned by
-// PathRange.Validate if the designated constraints aren't met.
-type PathRangeValidationError struct {
+// PutCacheRequestValidationError is the validation error returned by
+// PutCacheRequest.Validate if the designated constraints aren't met.
+type PutCacheRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9743,22 +13685,22 @@ type PathRangeValidationError struct {
 }
 
 // Field function returns field value.
-func (e PathRangeValidationError) Field() string { return e.field }
+func (e PutCacheRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PathRangeValidationError) Reason() string { return e.reason }
+func (e PutCacheRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PathRangeValidationError) Cause() error { return e.cause }
+func (e PutCacheRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PathRangeValidationError) Key() bool { return e.key }
+func (e PutCacheRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PathRangeValidationError) ErrorName() string { return "PathRangeValidationError" }
+func (e PutCacheRequestValidationError) ErrorName() string { return "PutCacheRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e PathRangeValidationError) Error() string {
+func (e PutCacheRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9770,14 +13712,14 @@ func (e PathRangeValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPathRange.%s: %s%s",
+		"invalid %sPutCacheRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PathRangeValidationError{}
+var _ error = PutCacheRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -9785,78 +13727,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PathRangeValidationError{}
+} = PutCacheRequestValidationError{}
 
-// Validate checks the field values on ShardFileSetResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ShardFileSetResponse) Validate() error {
+// Validate checks the field values on GetCacheRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetCacheRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ShardFileSetResponse with the rules
+// ValidateAll checks the field values on GetCacheRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ShardFileSetResponseMultiError, or nil if none found.
-func (m *ShardFileSetResponse) ValidateAll() error {
+// GetCacheRequestMultiError, or nil if none found.
+func (m *GetCacheRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ShardFileSetResponse) validate(all bool) error {
+func (m *GetCacheRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetShards() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ShardFileSetResponseValidationError{
-						field:  fmt.Sprintf("Shards[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ShardFileSetResponseValidationError{
-						field:  fmt.Sprintf("Shards[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ShardFileSetResponseValidationError{
-					field:  fmt.Sprintf("Shards[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
+	// no validation rules for Key
 
 	if len(errors) > 0 {
-		return ShardFileSetResponseMultiError(errors)
+		return GetCacheRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ShardFileSetResponseMultiError is an error wrapping multiple validation
-// errors returned by ShardFileSetResponse.ValidateAll() if the designated
-// constraints aren't met.
-type ShardFileSetResponseMultiError []error
+// GetCacheRequestMultiError is an error wrapping multiple validation errors
+// returned by GetCacheRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetCacheRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ShardFileSetResponseMultiError) Error() string {
+func (m GetCacheRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9865,11 +13775,11 @@ func (m ShardFileSetResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ShardFileSetResponseMultiError) AllErrors() []error { return m }
+func (m GetCacheRequestMultiError) AllErrors() []error { return m }
 
-// ShardFileSetResponseValidationError is the validation error returned by
-// ShardFileSetResponse.Validate if the designated constraints aren't met.
-type ShardFileSetResponseValidationError struct {
+// GetCacheRequestValidationError is the validation error returned by
+// GetCacheRequest.Validate if the designated constraints aren't met.
+type GetCacheRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9877,24 +13787,22 @@ type ShardFileSetResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ShardFileSetResponseValidationError) Field() string { return e.field }
+func (e GetCacheRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ShardFileSetResponseValidationError) Reason() string { return e.reason }
+func (e GetCacheRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ShardFileSetResponseValidationError) Cause() error { return e.cause }
+func (e GetCacheRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ShardFileSetResponseValidationError) Key() bool { return e.key }
+func (e GetCacheRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ShardFileSetResponseValidationError) ErrorName() string {
-	return "ShardFileSetResponseValidationError"
-}
+func (e GetCacheRequestValidationError) ErrorName() string { return "GetCacheRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ShardFileSetResponseValidationError) Error() string {
+func (e GetCacheRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9906,14 +13814,14 @@ func (e ShardFileSetResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sShardFileSetResponse.%s: %s%s",
+		"invalid %sGetCacheRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ShardFileSetResponseValidationError{}
+var _ error = GetCacheRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -9921,50 +13829,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ShardFileSetResponseValidationError{}
+} = GetCacheRequestValidationError{}
 
-// Validate checks the field values on CheckStorageRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CheckStorageRequest) Validate() error {
+// Validate checks the field values on GetCacheResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetCacheResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CheckStorageRequest with the rules
+// ValidateAll checks the field values on GetCacheResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CheckStorageRequestMultiError, or nil if none found.
-func (m *CheckStorageRequest) ValidateAll() error {
+// GetCacheResponseMultiError, or nil if none found.
+func (m *GetCacheResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CheckStorageRequest) validate(all bool) error {
+func (m *GetCacheResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ReadChunkData
-
-	// no validation rules for ChunkBegin
-
-	// no validation rules for ChunkEnd
+	if all {
+		switch v := interface{}(m.GetValue()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetCacheResponseValidationError{
+					field:  "Value",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetCacheResponseValidationError{
+					field:  "Value",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetValue()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetCacheResponseValidationError{
+				field:  "Value",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return CheckStorageRequestMultiError(errors)
+		return GetCacheResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CheckStorageRequestMultiError is an error wrapping multiple validation
-// errors returned by CheckStorageRequest.ValidateAll() if the designated
-// constraints aren't met.
-type CheckStorageRequestMultiError []error
+// GetCacheResponseMultiError is an error wrapping multiple validation errors
+// returned by GetCacheResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetCacheResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CheckStorageRequestMultiError) Error() string {
+func (m GetCacheResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9973,11 +13904,11 @@ func (m CheckStorageRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CheckStorageRequestMultiError) AllErrors() []error { return m }
+func (m GetCacheResponseMultiError) AllErrors() []error { return m }
 
-// CheckStorageRequestValidationError is the validation error returned by
-// CheckStorageRequest.Validate if the designated constraints aren't met.
-type CheckStorageRequestValidationError struct {
+// GetCacheResponseValidationError is the validation error returned by
+// GetCacheResponse.Validate if the designated constraints aren't met.
+type GetCacheResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9985,24 +13916,22 @@ type CheckStorageRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CheckStorageRequestValidationError) Field() string { return e.field }
+func (e GetCacheResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CheckStorageRequestValidationError) Reason() string { return e.reason }
+func (e GetCacheResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CheckStorageRequestValidationError) Cause() error { return e.cause }
+func (e GetCacheResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CheckStorageRequestValidationError) Key() bool { return e.key }
+func (e GetCacheResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CheckStorageRequestValidationError) ErrorName() string {
-	return "CheckStorageRequestValidationError"
-}
+func (e GetCacheResponseValidationError) ErrorName() string { return "GetCacheResponseValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CheckStorageRequestValidationError) Error() string {
+func (e GetCacheResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10014,14 +13943,14 @@ func (e CheckStorageRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCheckStorageRequest.%s: %s%s",
+		"invalid %sGetCacheResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CheckStorageRequestValidationError{}
+var _ error = GetCacheResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -10029,46 +13958,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CheckStorageRequestValidationError{}
+} = GetCacheResponseValidationError{}
 
-// Validate checks the field values on CheckStorageResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CheckStorageResponse) Validate() error {
+// Validate checks the field values on ClearCacheRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ClearCacheRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CheckStorageResponse with the rules
+// ValidateAll checks the field values on ClearCacheRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CheckStorageResponseMultiError, or nil if none found.
-func (m *CheckStorageResponse) ValidateAll() error {
+// ClearCacheRequestMultiError, or nil if none found.
+func (m *ClearCacheRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CheckStorageResponse) validate(all bool) error {
+func (m *ClearCacheRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ChunkObjectCount
+	// no validation rules for TagPrefix
 
 	if len(errors) > 0 {
-		return CheckStorageResponseMultiError(errors)
+		return ClearCacheRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CheckStorageResponseMultiError is an error wrapping multiple validation
-// errors returned by CheckStorageResponse.ValidateAll() if the designated
-// constraints aren't met.
-type CheckStorageResponseMultiError []error
+// ClearCacheRequestMultiError is an error wrapping multiple validation errors
+// returned by ClearCacheRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ClearCacheRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CheckStorageResponseMultiError) Error() string {
+func (m ClearCacheRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10077,11 +14006,11 @@ func (m CheckStorageResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CheckStorageResponseMultiError) AllErrors() []error { return m }
+func (m ClearCacheRequestMultiError) AllErrors() []error { return m }
 
-// CheckStorageResponseValidationError is the validation error returned by
-// CheckStorageResponse.Validate if the designated constraints aren't met.
-type CheckStorageResponseValidationError struct {
+// ClearCacheRequestValidationError is the validation error returned by
+// ClearCacheRequest.Validate if the designated constraints aren't met.
+type ClearCacheRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10089,24 +14018,24 @@ type CheckStorageResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CheckStorageResponseValidationError) Field() string { return e.field }
+func (e ClearCacheRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CheckStorageResponseValidationError) Reason() string { return e.reason }
+func (e ClearCacheRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CheckStorageResponseValidationError) Cause() error { return e.cause }
+func (e ClearCacheRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CheckStorageResponseValidationError) Key() bool { return e.key }
+func (e ClearCacheRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CheckStorageResponseValidationError) ErrorName() string {
-	return "CheckStorageResponseValidationError"
+func (e ClearCacheRequestValidationError) ErrorName() string {
+	return "ClearCacheRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CheckStorageResponseValidationError) Error() string {
+func (e ClearCacheRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10118,14 +14047,14 @@ func (e CheckStorageResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCheckStorageResponse.%s: %s%s",
+		"invalid %sClearCacheRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CheckStorageResponseValidationError{}
+var _ error = ClearCacheRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -10133,77 +14062,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CheckStorageResponseValidationError{}
+} = ClearCacheRequestValidationError{}
 
-// Validate checks the field values on PutCacheRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *PutCacheRequest) Validate() error {
+// Validate checks the field values on ActivateAuthRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ActivateAuthRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PutCacheRequest with the rules
+// ValidateAll checks the field values on ActivateAuthRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// PutCacheRequestMultiError, or nil if none found.
-func (m *PutCacheRequest) ValidateAll() error {
+// ActivateAuthRequestMultiError, or nil if none found.
+func (m *ActivateAuthRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PutCacheRequest) validate(all bool) error {
+func (m *ActivateAuthRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Key
-
-	if all {
-		switch v := interface{}(m.GetValue()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, PutCacheRequestValidationError{
-					field:  "Value",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, PutCacheRequestValidationError{
-					field:  "Value",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetValue()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return PutCacheRequestValidationError{
-				field:  "Value",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	// no validation rules for Tag
-
 	if len(errors) > 0 {
-		return PutCacheRequestMultiError(errors)
+		return ActivateAuthRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// PutCacheRequestMultiError is an error wrapping multiple validation errors
-// returned by PutCacheRequest.ValidateAll() if the designated constraints
-// aren't met.
-type PutCacheRequestMultiError []error
+// ActivateAuthRequestMultiError is an error wrapping multiple validation
+// errors returned by ActivateAuthRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ActivateAuthRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PutCacheRequestMultiError) Error() string {
+func (m ActivateAuthRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10212,11 +14108,11 @@ func (m PutCacheRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PutCacheRequestMultiError) AllErrors() []error { return m }
+func (m ActivateAuthRequestMultiError) AllErrors() []error { return m }
 
-// PutCacheRequestValidationError is the validation error returned by
-// PutCacheRequest.Validate if the designated constraints aren't met.
-type PutCacheRequestValidationError struct {
+// ActivateAuthRequestValidationError is the validation error returned by
+// ActivateAuthRequest.Validate if the designated constraints aren't met.
+type ActivateAuthRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10224,22 +14120,24 @@ type PutCacheRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e PutCacheRequestValidationError) Field() string { return e.field }
+func (e ActivateAuthRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PutCacheRequestValidationError) Reason() string { return e.reason }
+func (e ActivateAuthRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PutCacheRequestValidationError) Cause() error { return e.cause }
+func (e ActivateAuthRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PutCacheRequestValidationError) Key() bool { return e.key }
+func (e ActivateAuthRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PutCacheRequestValidationError) ErrorName() string { return "PutCacheRequestValidationError" }
+func (e ActivateAuthRequestValidationError) ErrorName() string {
+	return "ActivateAuthRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e PutCacheRequestValidationError) Error() string {
+func (e ActivateAuthRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10251,14 +14149,14 @@ func (e PutCacheRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPutCacheRequest.%s: %s%s",
+		"invalid %sActivateAuthRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PutCacheRequestValidationError{}
+var _ error = ActivateAuthRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -10266,46 +14164,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PutCacheRequestValidationError{}
+} = ActivateAuthRequestValidationError{}
 
-// Validate checks the field values on GetCacheRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetCacheRequest) Validate() error {
+// Validate checks the field values on ActivateAuthResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ActivateAuthResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetCacheRequest with the rules
+// ValidateAll checks the field values on ActivateAuthResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetCacheRequestMultiError, or nil if none found.
-func (m *GetCacheRequest) ValidateAll() error {
+// ActivateAuthResponseMultiError, or nil if none found.
+func (m *ActivateAuthResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetCacheRequest) validate(all bool) error {
+func (m *ActivateAuthResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Key
-
 	if len(errors) > 0 {
-		return GetCacheRequestMultiError(errors)
+		return ActivateAuthResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetCacheRequestMultiError is an error wrapping multiple validation errors
-// returned by GetCacheRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetCacheRequestMultiError []error
+// ActivateAuthResponseMultiError is an error wrapping multiple validation
+// errors returned by ActivateAuthResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ActivateAuthResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetCacheRequestMultiError) Error() string {
+func (m ActivateAuthResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10314,11 +14210,11 @@ func (m GetCacheRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetCacheRequestMultiError) AllErrors() []error { return m }
+func (m ActivateAuthResponseMultiError) AllErrors() []error { return m }
 
-// GetCacheRequestValidationError is the validation error returned by
-// GetCacheRequest.Validate if the designated constraints aren't met.
-type GetCacheRequestValidationError struct {
+// ActivateAuthResponseValidationError is the validation error returned by
+// ActivateAuthResponse.Validate if the designated constraints aren't met.
+type ActivateAuthResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10326,22 +14222,24 @@ type GetCacheRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetCacheRequestValidationError) Field() string { return e.field }
+func (e ActivateAuthResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetCacheRequestValidationError) Reason() string { return e.reason }
+func (e ActivateAuthResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetCacheRequestValidationError) Cause() error { return e.cause }
+func (e ActivateAuthResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetCacheRequestValidationError) Key() bool { return e.key }
+func (e ActivateAuthResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetCacheRequestValidationError) ErrorName() string { return "GetCacheRequestValidationError" }
+func (e ActivateAuthResponseValidationError) ErrorName() string {
+	return "ActivateAuthResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetCacheRequestValidationError) Error() string {
+func (e ActivateAuthResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10353,14 +14251,14 @@ func (e GetCacheRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetCacheRequest.%s: %s%s",
+		"invalid %sActivateAuthResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetCacheRequestValidationError{}
+var _ error = ActivateAuthResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -10368,73 +14266,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetCacheRequestValidationError{}
+} = ActivateAuthResponseValidationError{}
 
-// Validate checks the field values on GetCacheResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetCacheResponse) Validate() error {
+// Validate checks the field values on ObjectStorageEgress with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ObjectStorageEgress) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetCacheResponse with the rules
+// ValidateAll checks the field values on ObjectStorageEgress with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetCacheResponseMultiError, or nil if none found.
-func (m *GetCacheResponse) ValidateAll() error {
+// ObjectStorageEgressMultiError, or nil if none found.
+func (m *ObjectStorageEgress) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetCacheResponse) validate(all bool) error {
+func (m *ObjectStorageEgress) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetValue()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetCacheResponseValidationError{
-					field:  "Value",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GetCacheResponseValidationError{
-					field:  "Value",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetValue()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetCacheResponseValidationError{
-				field:  "Value",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Url
 
 	if len(errors) > 0 {
-		return GetCacheResponseMultiError(errors)
+		return ObjectStorageEgressMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetCacheResponseMultiError is an error wrapping multiple validation errors
-// returned by GetCacheResponse.ValidateAll() if the designated constraints
-// aren't met.
-type GetCacheResponseMultiError []error
+// ObjectStorageEgressMultiError is an error wrapping multiple validation
+// errors returned by ObjectStorageEgress.ValidateAll() if the designated
+// constraints aren't met.
+type ObjectStorageEgressMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetCacheResponseMultiError) Error() string {
+func (m ObjectStorageEgressMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10443,11 +14314,11 @@ func (m GetCacheResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetCacheResponseMultiError) AllErrors() []error { return m }
+func (m ObjectStorageEgressMultiError) AllErrors() []error { return m }
 
-// GetCacheResponseValidationError is the validation error returned by
-// GetCacheResponse.Validate if the designated constraints aren't met.
-type GetCacheResponseValidationError struct {
+// ObjectStorageEgressValidationError is the validation error returned by
+// ObjectStorageEgress.Validate if the designated constraints aren't met.
+type ObjectStorageEgressValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10455,22 +14326,24 @@ type GetCacheResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetCacheResponseValidationError) Field() string { return e.field }
+func (e ObjectStorageEgressValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetCacheResponseValidationError) Reason() string { return e.reason }
+func (e ObjectStorageEgressValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetCacheResponseValidationError) Cause() error { return e.cause }
+func (e ObjectStorageEgressValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetCacheResponseValidationError) Key() bool { return e.key }
+func (e ObjectStorageEgressValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetCacheResponseValidationError) ErrorName() string { return "GetCacheResponseValidationError" }
+func (e ObjectStorageEgressValidationError) ErrorName() string {
+	return "ObjectStorageEgressValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetCacheResponseValidationError) Error() string {
+func (e ObjectStorageEgressValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10482,14 +14355,14 @@ func (e GetCacheResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetCacheResponse.%s: %s%s",
+		"invalid %sObjectStorageEgress.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetCacheResponseValidationError{}
+var _ error = ObjectStorageEgressValidationError{}
 
 var _ interface {
 	Field() string
@@ -10497,46 +14370,152 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetCacheResponseValidationError{}
+} = ObjectStorageEgressValidationError{}
 
-// Validate checks the field values on ClearCacheRequest with the rules defined
+// Validate checks the field values on SQLDatabaseEgress with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *ClearCacheRequest) Validate() error {
+func (m *SQLDatabaseEgress) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ClearCacheRequest with the rules
+// ValidateAll checks the field values on SQLDatabaseEgress with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ClearCacheRequestMultiError, or nil if none found.
-func (m *ClearCacheRequest) ValidateAll() error {
+// SQLDatabaseEgressMultiError, or nil if none found.
+func (m *SQLDatabaseEgress) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ClearCacheRequest) validate(all bool) error {
+func (m *SQLDatabaseEgress) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for TagPrefix
+	// no validation rules for Url
+
+	if all {
+		switch v := interface{}(m.GetFileFormat()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SQLDatabaseEgressValidationError{
+					field:  "FileFormat",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SQLDatabaseEgressValidationError{
+					field:  "FileFormat",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFileFormat()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SQLDatabaseEgressValidationError{
+				field:  "FileFormat",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetSecret()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SQLDatabaseEgressValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SQLDatabaseEgressValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSecret()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SQLDatabaseEgressValidationError{
+				field:  "Secret",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Mode
+
+	{
+		sorted_keys := make([]string, len(m.GetPrimaryKeys()))
+		i := 0
+		for key := range m.GetPrimaryKeys() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetPrimaryKeys()[key]
+			_ = val
+
+			// no validation rules for PrimaryKeys[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, SQLDatabaseEgressValidationError{
+							field:  fmt.Sprintf("PrimaryKeys[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, SQLDatabaseEgressValidationError{
+							field:  fmt.Sprintf("PrimaryKeys[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return SQLDatabaseEgressValidationError{
+						field:  fmt.Sprintf("PrimaryKeys[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
 
 	if len(errors) > 0 {
-		return ClearCacheRequestMultiError(errors)
+		return SQLDatabaseEgressMultiError(errors)
 	}
 
 	return nil
 }
 
-// ClearCacheRequestMultiError is an error wrapping multiple validation errors
-// returned by ClearCacheRequest.ValidateAll() if the designated constraints
+// SQLDatabaseEgressMultiError is an error wrapping multiple validation errors
+// returned by SQLDatabaseEgress.ValidateAll() if the designated constraints
 // aren't met.
-type ClearCacheRequestMultiError []error
+type SQLDatabaseEgressMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ClearCacheRequestMultiError) Error() string {
+func (m SQLDatabaseEgressMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10545,11 +14524,11 @@ func (m ClearCacheRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ClearCacheRequestMultiError) AllErrors() []error { return m }
+func (m SQLDatabaseEgressMultiError) AllErrors() []error { return m }
 
-// ClearCacheRequestValidationError is the validation error returned by
-// ClearCacheRequest.Validate if the designated constraints aren't met.
-type ClearCacheRequestValidationError struct {
+// SQLDatabaseEgressValidationError is the validation error returned by
+// SQLDatabaseEgress.Validate if the designated constraints aren't met.
+type SQLDatabaseEgressValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10557,24 +14536,24 @@ type ClearCacheRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ClearCacheRequestValidationError) Field() string { return e.field }
+func (e SQLDatabaseEgressValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ClearCacheRequestValidationError) Reason() string { return e.reason }
+func (e SQLDatabaseEgressValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ClearCacheRequestValidationError) Cause() error { return e.cause }
+func (e SQLDatabaseEgressValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ClearCacheRequestValidationError) Key() bool { return e.key }
+func (e SQLDatabaseEgressValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ClearCacheRequestValidationError) ErrorName() string {
-	return "ClearCacheRequestValidationError"
+func (e SQLDatabaseEgressValidationError) ErrorName() string {
+	return "SQLDatabaseEgressValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ClearCacheRequestValidationError) Error() string {
+func (e SQLDatabaseEgressValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10586,14 +14565,14 @@ func (e ClearCacheRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sClearCacheRequest.%s: %s%s",
+		"invalid %sSQLDatabaseEgress.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ClearCacheRequestValidationError{}
+var _ error = SQLDatabaseEgressValidationError{}
 
 var _ interface {
 	Field() string
@@ -10601,44 +14580,160 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ClearCacheRequestValidationError{}
+} = SQLDatabaseEgressValidationError{}
 
-// Validate checks the field values on ActivateAuthRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ActivateAuthRequest) Validate() error {
+// Validate checks the field values on EgressRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *EgressRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ActivateAuthRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ActivateAuthRequestMultiError, or nil if none found.
-func (m *ActivateAuthRequest) ValidateAll() error {
+// ValidateAll checks the field values on EgressRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in EgressRequestMultiError, or
+// nil if none found.
+func (m *EgressRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ActivateAuthRequest) validate(all bool) error {
+func (m *EgressRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if all {
+		switch v := interface{}(m.GetCommit()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EgressRequestValidationError{
+					field:  "Commit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EgressRequestValidationError{
+					field:  "Commit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCommit()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EgressRequestValidationError{
+				field:  "Commit",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	switch v := m.Target.(type) {
+	case *EgressRequest_ObjectStorage:
+		if v == nil {
+			err := EgressRequestValidationError{
+				field:  "Target",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetObjectStorage()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, EgressRequestValidationError{
+						field:  "ObjectStorage",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, EgressRequestValidationError{
+						field:  "ObjectStorage",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetObjectStorage()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EgressRequestValidationError{
+					field:  "ObjectStorage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *EgressRequest_SqlDatabase:
+		if v == nil {
+			err := EgressRequestValidationError{
+				field:  "Target",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetSqlDatabase()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, EgressRequestValidationError{
+						field:  "SqlDatabase",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, EgressRequestValidationError{
+						field:  "SqlDatabase",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSqlDatabase()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EgressRequestValidationError{
+					field:  "SqlDatabase",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
 	if len(errors) > 0 {
-		return ActivateAuthRequestMultiError(errors)
+		return EgressRequestMultiError(errors)
 	}
 
 	return nil
-}
-
-// ActivateAuthRequestMultiError is an error wrapping multiple validation
-// errors returned by ActivateAuthRequest.ValidateAll() if the designated
-// constraints aren't met.
-type ActivateAuthRequestMultiError []error
+}
+
+// EgressRequestMultiError is an error wrapping multiple validation errors
+// returned by EgressRequest.ValidateAll() if the designated constraints
+// aren't met.
+type EgressRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ActivateAuthRequestMultiError) Error() string {
+func (m EgressRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10647,11 +14742,11 @@ func (m ActivateAuthRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ActivateAuthRequestMultiError) AllErrors() []error { return m }
+func (m EgressRequestMultiError) AllErrors() []error { return m }
 
-// ActivateAuthRequestValidationError is the validation error returned by
-// ActivateAuthRequest.Validate if the designated constraints aren't met.
-type ActivateAuthRequestValidationError struct {
+// EgressRequestValidationError is the validation error returned by
+// EgressRequest.Validate if the designated constraints aren't met.
+type EgressRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10659,24 +14754,22 @@ type ActivateAuthRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ActivateAuthRequestValidationError) Field() string { return e.field }
+func (e EgressRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ActivateAuthRequestValidationError) Reason() string { return e.reason }
+func (e EgressRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ActivateAuthRequestValidationError) Cause() error { return e.cause }
+func (e EgressRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ActivateAuthRequestValidationError) Key() bool { return e.key }
+func (e EgressRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ActivateAuthRequestValidationError) ErrorName() string {
-	return "ActivateAuthRequestValidationError"
-}
+func (e EgressRequestValidationError) ErrorName() string { return "EgressRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ActivateAuthRequestValidationError) Error() string {
+func (e EgressRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10688,14 +14781,14 @@ func (e ActivateAuthRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sActivateAuthRequest.%s: %s%s",
+		"invalid %sEgressRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ActivateAuthRequestValidationError{}
+var _ error = EgressRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -10703,44 +14796,131 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ActivateAuthRequestValidationError{}
+} = EgressRequestValidationError{}
 
-// Validate checks the field values on ActivateAuthResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ActivateAuthResponse) Validate() error {
+// Validate checks the field values on EgressResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *EgressResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ActivateAuthResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ActivateAuthResponseMultiError, or nil if none found.
-func (m *ActivateAuthResponse) ValidateAll() error {
+// ValidateAll checks the field values on EgressResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in EgressResponseMultiError,
+// or nil if none found.
+func (m *EgressResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ActivateAuthResponse) validate(all bool) error {
+func (m *EgressResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	switch v := m.Result.(type) {
+	case *EgressResponse_ObjectStorage:
+		if v == nil {
+			err := EgressResponseValidationError{
+				field:  "Result",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetObjectStorage()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, EgressResponseValidationError{
+						field:  "ObjectStorage",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, EgressResponseValidationError{
+						field:  "ObjectStorage",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetObjectStorage()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EgressResponseValidationError{
+					field:  "ObjectStorage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *EgressResponse_SqlDatabase:
+		if v == nil {
+			err := EgressResponseValidationError{
+				field:  "Result",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetSqlDatabase()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, EgressResponseValidationError{
+						field:  "SqlDatabase",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, EgressResponseValidationError{
+						field:  "SqlDatabase",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSqlDatabase()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EgressResponseValidationError{
+					field:  "SqlDatabase",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
 	if len(errors) > 0 {
-		return ActivateAuthResponseMultiError(errors)
+		return EgressResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ActivateAuthResponseMultiError is an error wrapping multiple validation
-// errors returned by ActivateAuthResponse.ValidateAll() if the designated
-// constraints aren't met.
-type ActivateAuthResponseMultiError []error
+// EgressResponseMultiError is an error wrapping multiple validation errors
+// returned by EgressResponse.ValidateAll() if the designated constraints
+// aren't met.
+type EgressResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ActivateAuthResponseMultiError) Error() string {
+func (m EgressResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10749,11 +14929,11 @@ func (m ActivateAuthResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ActivateAuthResponseMultiError) AllErrors() []error { return m }
+func (m EgressResponseMultiError) AllErrors() []error { return m }
 
-// ActivateAuthResponseValidationError is the validation error returned by
-// ActivateAuthResponse.Validate if the designated constraints aren't met.
-type ActivateAuthResponseValidationError struct {
+// EgressResponseValidationError is the validation error returned by
+// EgressResponse.Validate if the designated constraints aren't met.
+type EgressResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10761,24 +14941,22 @@ type ActivateAuthResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ActivateAuthResponseValidationError) Field() string { return e.field }
+func (e EgressResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ActivateAuthResponseValidationError) Reason() string { return e.reason }
+func (e EgressResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ActivateAuthResponseValidationError) Cause() error { return e.cause }
+func (e EgressResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ActivateAuthResponseValidationError) Key() bool { return e.key }
+func (e EgressResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ActivateAuthResponseValidationError) ErrorName() string {
-	return "ActivateAuthResponseValidationError"
-}
+func (e EgressResponseValidationError) ErrorName() string { return "EgressResponseValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ActivateAuthResponseValidationError) Error() string {
+func (e EgressResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10790,14 +14968,14 @@ func (e ActivateAuthResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sActivateAuthResponse.%s: %s%s",
+		"invalid %sEgressResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ActivateAuthResponseValidationError{}
+var _ error = EgressResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -10805,46 +14983,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ActivateAuthResponseValidationError{}
+} = EgressResponseValidationError{}
 
-// Validate checks the field values on ObjectStorageEgress with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ObjectStorageEgress) Validate() error {
+// Validate checks the field values on RepoInfo_Details with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RepoInfo_Details) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ObjectStorageEgress with the rules
+// ValidateAll checks the field values on RepoInfo_Details with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ObjectStorageEgressMultiError, or nil if none found.
-func (m *ObjectStorageEgress) ValidateAll() error {
+// RepoInfo_DetailsMultiError, or nil if none found.
+func (m *RepoInfo_Details) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ObjectStorageEgress) validate(all bool) error {
+func (m *RepoInfo_Details) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Url
+	// no validation rules for SizeBytes
 
 	if len(errors) > 0 {
-		return ObjectStorageEgressMultiError(errors)
+		return RepoInfo_DetailsMultiError(errors)
 	}
 
 	return nil
 }
 
-// ObjectStorageEgressMultiError is an error wrapping multiple validation
-// errors returned by ObjectStorageEgress.ValidateAll() if the designated
-// constraints aren't met.
-type ObjectStorageEgressMultiError []error
+// RepoInfo_DetailsMultiError is an error wrapping multiple validation errors
+// returned by RepoInfo_Details.ValidateAll() if the designated constraints
+// aren't met.
+type RepoInfo_DetailsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ObjectStorageEgressMultiError) Error() string {
+func (m RepoInfo_DetailsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10853,11 +15031,11 @@ func (m ObjectStorageEgressMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ObjectStorageEgressMultiError) AllErrors() []error { return m }
+func (m RepoInfo_DetailsMultiError) AllErrors() []error { return m }
 
-// ObjectStorageEgressValidationError is the validation error returned by
-// ObjectStorageEgress.Validate if the designated constraints aren't met.
-type ObjectStorageEgressValidationError struct {
+// RepoInfo_DetailsValidationError is the validation error returned by
+// RepoInfo_Details.Validate if the designated constraints aren't met.
+type RepoInfo_DetailsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10865,24 +15043,22 @@ type ObjectStorageEgressValidationError struct {
 }
 
 // Field function returns field value.
-func (e ObjectStorageEgressValidationError) Field() string { return e.field }
+func (e RepoInfo_DetailsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ObjectStorageEgressValidationError) Reason() string { return e.reason }
+func (e RepoInfo_DetailsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ObjectStorageEgressValidationError) Cause() error { return e.cause }
+func (e RepoInfo_DetailsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ObjectStorageEgressValidationError) Key() bool { return e.key }
+func (e RepoInfo_DetailsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ObjectStorageEgressValidationError) ErrorName() string {
-	return "ObjectStorageEgressValidationError"
-}
+func (e RepoInfo_DetailsValidationError) ErrorName() string { return "RepoInfo_DetailsValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ObjectStorageEgressValidationError) Error() string {
+func (e RepoInfo_DetailsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10894,14 +15070,14 @@ func (e ObjectStorageEgressValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sObjectStorageEgress.%s: %s%s",
+		"invalid %sRepoInfo_Details.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ObjectStorageEgressValidationError{}
+var _ error = RepoInfo_DetailsValidationError{}
 
 var _ interface {
 	Field() string
@@ -10909,55 +15085,55 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ObjectStorageEgressValidationError{}
+} = RepoInfo_DetailsValidationError{}
 
-// Validate checks the field values on SQLDatabaseEgress with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *SQLDatabaseEgress) Validate() error {
+// Validate checks the field values on CommitInfo_Details with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CommitInfo_Details) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SQLDatabaseEgress with the rules
+// ValidateAll checks the field values on CommitInfo_Details with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SQLDatabaseEgressMultiError, or nil if none found.
-func (m *SQLDatabaseEgress) ValidateAll() error {
+// CommitInfo_DetailsMultiError, or nil if none found.
+func (m *CommitInfo_Details) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SQLDatabaseEgress) validate(all bool) error {
+func (m *CommitInfo_Details) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Url
+	// no validation rules for SizeBytes
 
 	if all {
-		switch v := interface{}(m.GetFileFormat()).(type) {
+		switch v := interface{}(m.GetCompactingTime()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SQLDatabaseEgressValidationError{
-					field:  "FileFormat",
+				errors = append(errors, CommitInfo_DetailsValidationError{
+					field:  "CompactingTime",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, SQLDatabaseEgressValidationError{
-					field:  "FileFormat",
+				errors = append(errors, CommitInfo_DetailsValidationError{
+					field:  "CompactingTime",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetFileFormat()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetCompactingTime()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return SQLDatabaseEgressValidationError{
-				field:  "FileFormat",
+			return CommitInfo_DetailsValidationError{
+				field:  "CompactingTime",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -10965,28 +15141,28 @@ func (m *SQLDatabaseEgress) validate(all bool) error {
 	}
 
 	if all {
-		switch v := interface{}(m.GetSecret()).(type) {
+		switch v := interface{}(m.GetValidatingTime()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SQLDatabaseEgressValidationError{
-					field:  "Secret",
+				errors = append(errors, CommitInfo_DetailsValidationError{
+					field:  "ValidatingTime",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, SQLDatabaseEgressValidationError{
-					field:  "Secret",
+				errors = append(errors, CommitInfo_DetailsValidationError{
+					field:  "ValidatingTime",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetSecret()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetValidatingTime()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return SQLDatabaseEgressValidationError{
-				field:  "Secret",
+			return CommitInfo_DetailsValidationError{
+				field:  "ValidatingTime",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -10994,19 +15170,19 @@ func (m *SQLDatabaseEgress) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return SQLDatabaseEgressMultiError(errors)
+		return CommitInfo_DetailsMultiError(errors)
 	}
 
 	return nil
 }
 
-// SQLDatabaseEgressMultiError is an error wrapping multiple validation errors
-// returned by SQLDatabaseEgress.ValidateAll() if the designated constraints
+// CommitInfo_DetailsMultiError is an error wrapping multiple validation errors
+// returned by CommitInfo_Details.ValidateAll() if the designated constraints
 // aren't met.
-type SQLDatabaseEgressMultiError []error
+type CommitInfo_DetailsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SQLDatabaseEgressMultiError) Error() string {
+func (m CommitInfo_DetailsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11015,11 +15191,11 @@ func (m SQLDatabaseEgressMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SQLDatabaseEgressMultiError) AllErrors() []error { return m }
+func (m CommitInfo_DetailsMultiError) AllErrors() []error { return m }
 
-// SQLDatabaseEgressValidationError is the validation error returned by
-// SQLDatabaseEgress.Validate if the designated constraints aren't met.
-type SQLDatabaseEgressValidationError struct {
+// CommitInfo_DetailsValidationError is the validation error returned by
+// CommitInfo_Details.Validate if the designated constraints aren't met.
+type CommitInfo_DetailsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11027,24 +15203,24 @@ type SQLDatabaseEgressValidationError struct {
 }
 
 // Field function returns field value.
-func (e SQLDatabaseEgressValidationError) Field() string { return e.field }
+func (e CommitInfo_DetailsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SQLDatabaseEgressValidationError) Reason() string { return e.reason }
+func (e CommitInfo_DetailsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SQLDatabaseEgressValidationError) Cause() error { return e.cause }
+func (e CommitInfo_DetailsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SQLDatabaseEgressValidationError) Key() bool { return e.key }
+func (e CommitInfo_DetailsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SQLDatabaseEgressValidationError) ErrorName() string {
-	return "SQLDatabaseEgressValidationError"
+func (e CommitInfo_DetailsValidationError) ErrorName() string {
+	return "CommitInfo_DetailsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SQLDatabaseEgressValidationError) Error() string {
+func (e CommitInfo_DetailsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11056,14 +15232,14 @@ func (e SQLDatabaseEgressValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSQLDatabaseEgress.%s: %s%s",
+		"invalid %sCommitInfo_Details.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SQLDatabaseEgressValidationError{}
+var _ error = CommitInfo_DetailsValidationError{}
 
 var _ interface {
 	Field() string
@@ -11071,64 +15247,285 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SQLDatabaseEgressValidationError{}
+} = CommitInfo_DetailsValidationError{}
 
-// Validate checks the field values on EgressRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *EgressRequest) Validate() error {
+// Validate checks the field values on EditMetadataRequest_Edit with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EditMetadataRequest_Edit) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on EgressRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in EgressRequestMultiError, or
-// nil if none found.
-func (m *EgressRequest) ValidateAll() error {
+// ValidateAll checks the field values on EditMetadataRequest_Edit with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EditMetadataRequest_EditMultiError, or nil if none found.
+func (m *EditMetadataRequest_Edit) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *EgressRequest) validate(all bool) error {
+func (m *EditMetadataRequest_Edit) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetCommit()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, EgressRequestValidationError{
+	switch v := m.Target.(type) {
+	case *EditMetadataRequest_Edit_Project:
+		if v == nil {
+			err := EditMetadataRequest_EditValidationError{
+				field:  "Target",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetProject()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, EditMetadataRequest_EditValidationError{
+						field:  "Project",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, EditMetadataRequest_EditValidationError{
+						field:  "Project",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetProject()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EditMetadataRequest_EditValidationError{
+					field:  "Project",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *EditMetadataRequest_Edit_Repo:
+		if v == nil {
+			err := EditMetadataRequest_EditValidationError{
+				field:  "Target",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetRepo()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, EditMetadataRequest_EditValidationError{
+						field:  "Repo",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, EditMetadataRequest_EditValidationError{
+						field:  "Repo",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRepo()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EditMetadataRequest_EditValidationError{
+					field:  "Repo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *EditMetadataRequest_Edit_Branch:
+		if v == nil {
+			err := EditMetadataRequest_EditValidationError{
+				field:  "Target",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetBranch()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, EditMetadataRequest_EditValidationError{
+						field:  "Branch",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, EditMetadataRequest_EditValidationError{
+						field:  "Branch",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetBranch()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EditMetadataRequest_EditValidationError{
+					field:  "Branch",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *EditMetadataRequest_Edit_Commit:
+		if v == nil {
+			err := EditMetadataRequest_EditValidationError{
+				field:  "Target",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetCommit()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, EditMetadataRequest_EditValidationError{
+						field:  "Commit",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, EditMetadataRequest_EditValidationError{
+						field:  "Commit",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCommit()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EditMetadataRequest_EditValidationError{
 					field:  "Commit",
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+	switch v := m.Op.(type) {
+	case *EditMetadataRequest_Edit_Replace_:
+		if v == nil {
+			err := EditMetadataRequest_EditValidationError{
+				field:  "Op",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetReplace()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, EditMetadataRequest_EditValidationError{
+						field:  "Replace",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, EditMetadataRequest_EditValidationError{
+						field:  "Replace",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetReplace()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EditMetadataRequest_EditValidationError{
+					field:  "Replace",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *EditMetadataRequest_Edit_AddKey_:
+		if v == nil {
+			err := EditMetadataRequest_EditValidationError{
+				field:  "Op",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
 			}
-		case interface{ Validate() error }:
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetAddKey()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, EditMetadataRequest_EditValidationError{
+						field:  "AddKey",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, EditMetadataRequest_EditValidationError{
+						field:  "AddKey",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetAddKey()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, EgressRequestValidationError{
-					field:  "Commit",
+				return EditMetadataRequest_EditValidationError{
+					field:  "AddKey",
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetCommit()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return EgressRequestValidationError{
-				field:  "Commit",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
-	}
 
-	switch v := m.Target.(type) {
-	case *EgressRequest_ObjectStorage:
+	case *EditMetadataRequest_Edit_EditKey_:
 		if v == nil {
-			err := EgressRequestValidationError{
-				field:  "Target",
+			err := EditMetadataRequest_EditValidationError{
+				field:  "Op",
 				reason: "oneof value cannot be a typed-nil",
 			}
 			if !all {
@@ -11138,38 +15535,38 @@ func (m *EgressRequest) validate(all bool) error {
 		}
 
 		if all {
-			switch v := interface{}(m.GetObjectStorage()).(type) {
+			switch v := interface{}(m.GetEditKey()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, EgressRequestValidationError{
-						field:  "ObjectStorage",
+					errors = append(errors, EditMetadataRequest_EditValidationError{
+						field:  "EditKey",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, EgressRequestValidationError{
-						field:  "ObjectStorage",
+					errors = append(errors, EditMetadataRequest_EditValidationError{
+						field:  "EditKey",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetObjectStorage()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetEditKey()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return EgressRequestValidationError{
-					field:  "ObjectStorage",
+				return EditMetadataRequest_EditValidationError{
+					field:  "EditKey",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *EgressRequest_SqlDatabase:
+	case *EditMetadataRequest_Edit_DeleteKey_:
 		if v == nil {
-			err := EgressRequestValidationError{
-				field:  "Target",
+			err := EditMetadataRequest_EditValidationError{
+				field:  "Op",
 				reason: "oneof value cannot be a typed-nil",
 			}
 			if !all {
@@ -11179,28 +15576,28 @@ func (m *EgressRequest) validate(all bool) error {
 		}
 
 		if all {
-			switch v := interface{}(m.GetSqlDatabase()).(type) {
+			switch v := interface{}(m.GetDeleteKey()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, EgressRequestValidationError{
-						field:  "SqlDatabase",
+					errors = append(errors, EditMetadataRequest_EditValidationError{
+						field:  "DeleteKey",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, EgressRequestValidationError{
-						field:  "SqlDatabase",
+					errors = append(errors, EditMetadataRequest_EditValidationError{
+						field:  "DeleteKey",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetSqlDatabase()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetDeleteKey()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return EgressRequestValidationError{
-					field:  "SqlDatabase",
+				return EditMetadataRequest_EditValidationError{
+					field:  "DeleteKey",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -11212,19 +15609,19 @@ func (m *EgressRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return EgressRequestMultiError(errors)
+		return EditMetadataRequest_EditMultiError(errors)
 	}
 
 	return nil
 }
 
-// EgressRequestMultiError is an error wrapping multiple validation errors
-// returned by EgressRequest.ValidateAll() if the designated constraints
-// aren't met.
-type EgressRequestMultiError []error
+// EditMetadataRequest_EditMultiError is an error wrapping multiple validation
+// errors returned by EditMetadataRequest_Edit.ValidateAll() if the designated
+// constraints aren't met.
+type EditMetadataRequest_EditMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m EgressRequestMultiError) Error() string {
+func (m EditMetadataRequest_EditMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11233,11 +15630,11 @@ func (m EgressRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m EgressRequestMultiError) AllErrors() []error { return m }
+func (m EditMetadataRequest_EditMultiError) AllErrors() []error { return m }
 
-// EgressRequestValidationError is the validation error returned by
-// EgressRequest.Validate if the designated constraints aren't met.
-type EgressRequestValidationError struct {
+// EditMetadataRequest_EditValidationError is the validation error returned by
+// EditMetadataRequest_Edit.Validate if the designated constraints aren't met.
+type EditMetadataRequest_EditValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11245,22 +15642,24 @@ type EgressRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e EgressRequestValidationError) Field() string { return e.field }
+func (e EditMetadataRequest_EditValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e EgressRequestValidationError) Reason() string { return e.reason }
+func (e EditMetadataRequest_EditValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e EgressRequestValidationError) Cause() error { return e.cause }
+func (e EditMetadataRequest_EditValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e EgressRequestValidationError) Key() bool { return e.key }
+func (e EditMetadataRequest_EditValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e EgressRequestValidationError) ErrorName() string { return "EgressRequestValidationError" }
+func (e EditMetadataRequest_EditValidationError) ErrorName() string {
+	return "EditMetadataRequest_EditValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e EgressRequestValidationError) Error() string {
+func (e EditMetadataRequest_EditValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11272,14 +15671,14 @@ func (e EgressRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sEgressRequest.%s: %s%s",
+		"invalid %sEditMetadataRequest_Edit.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = EgressRequestValidationError{}
+var _ error = EditMetadataRequest_EditValidationError{}
 
 var _ interface {
 	Field() string
@@ -11287,131 +15686,155 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = EgressRequestValidationError{}
+} = EditMetadataRequest_EditValidationError{}
 
-// Validate checks the field values on EgressResponse with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *EgressResponse) Validate() error {
+// Validate checks the field values on EditMetadataRequest_Edit_Replace with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *EditMetadataRequest_Edit_Replace) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on EgressResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in EgressResponseMultiError,
-// or nil if none found.
-func (m *EgressResponse) ValidateAll() error {
+// ValidateAll checks the field values on EditMetadataRequest_Edit_Replace with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// EditMetadataRequest_Edit_ReplaceMultiError, or nil if none found.
+func (m *EditMetadataRequest_Edit_Replace) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *EgressResponse) validate(all bool) error {
+func (m *EditMetadataRequest_Edit_Replace) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	switch v := m.Result.(type) {
-	case *EgressResponse_ObjectStorage:
-		if v == nil {
-			err := EgressResponseValidationError{
-				field:  "Result",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+	// no validation rules for Replacement
 
-		if all {
-			switch v := interface{}(m.GetObjectStorage()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, EgressResponseValidationError{
-						field:  "ObjectStorage",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, EgressResponseValidationError{
-						field:  "ObjectStorage",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetObjectStorage()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return EgressResponseValidationError{
-					field:  "ObjectStorage",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	if len(errors) > 0 {
+		return EditMetadataRequest_Edit_ReplaceMultiError(errors)
+	}
 
-	case *EgressResponse_SqlDatabase:
-		if v == nil {
-			err := EgressResponseValidationError{
-				field:  "Result",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+	return nil
+}
 
-		if all {
-			switch v := interface{}(m.GetSqlDatabase()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, EgressResponseValidationError{
-						field:  "SqlDatabase",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, EgressResponseValidationError{
-						field:  "SqlDatabase",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetSqlDatabase()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return EgressResponseValidationError{
-					field:  "SqlDatabase",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+// EditMetadataRequest_Edit_ReplaceMultiError is an error wrapping multiple
+// validation errors returned by
+// EditMetadataRequest_Edit_Replace.ValidateAll() if the designated
+// constraints aren't met.
+type EditMetadataRequest_Edit_ReplaceMultiError []error
 
-	default:
-		_ = v // ensures v is used
+// Error returns a concatenation of all the error messages it wraps.
+func (m EditMetadataRequest_Edit_ReplaceMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EditMetadataRequest_Edit_ReplaceMultiError) AllErrors() []error { return m }
+
+// EditMetadataRequest_Edit_ReplaceValidationError is the validation error
+// returned by EditMetadataRequest_Edit_Replace.Validate if the designated
+// constraints aren't met.
+type EditMetadataRequest_Edit_ReplaceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EditMetadataRequest_Edit_ReplaceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EditMetadataRequest_Edit_ReplaceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EditMetadataRequest_Edit_ReplaceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EditMetadataRequest_Edit_ReplaceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EditMetadataRequest_Edit_ReplaceValidationError) ErrorName() string {
+	return "EditMetadataRequest_Edit_ReplaceValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EditMetadataRequest_Edit_ReplaceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEditMetadataRequest_Edit_Replace.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EditMetadataRequest_Edit_ReplaceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EditMetadataRequest_Edit_ReplaceValidationError{}
+
+// Validate checks the field values on EditMetadataRequest_Edit_AddKey with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EditMetadataRequest_Edit_AddKey) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EditMetadataRequest_Edit_AddKey with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// EditMetadataRequest_Edit_AddKeyMultiError, or nil if none found.
+func (m *EditMetadataRequest_Edit_AddKey) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EditMetadataRequest_Edit_AddKey) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
+	var errors []error
+
+	// no validation rules for Key
+
+	// no validation rules for Value
+
 	if len(errors) > 0 {
-		return EgressResponseMultiError(errors)
+		return EditMetadataRequest_Edit_AddKeyMultiError(errors)
 	}
 
 	return nil
 }
 
-// EgressResponseMultiError is an error wrapping multiple validation errors
-// returned by EgressResponse.ValidateAll() if the designated constraints
-// aren't met.
-type EgressResponseMultiError []error
+// EditMetadataRequest_Edit_AddKeyMultiError is an error wrapping multiple
+// validation errors returned by EditMetadataRequest_Edit_AddKey.ValidateAll()
+// if the designated constraints aren't met.
+type EditMetadataRequest_Edit_AddKeyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m EgressResponseMultiError) Error() string {
+func (m EditMetadataRequest_Edit_AddKeyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11420,11 +15843,12 @@ func (m EgressResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m EgressResponseMultiError) AllErrors() []error { return m }
+func (m EditMetadataRequest_Edit_AddKeyMultiError) AllErrors() []error { return m }
 
-// EgressResponseValidationError is the validation error returned by
-// EgressResponse.Validate if the designated constraints aren't met.
-type EgressResponseValidationError struct {
+// EditMetadataRequest_Edit_AddKeyValidationError is the validation error
+// returned by EditMetadataRequest_Edit_AddKey.Validate if the designated
+// constraints aren't met.
+type EditMetadataRequest_Edit_AddKeyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11432,22 +15856,24 @@ type EgressResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e EgressResponseValidationError) Field() string { return e.field }
+func (e EditMetadataRequest_Edit_AddKeyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e EgressResponseValidationError) Reason() string { return e.reason }
+func (e EditMetadataRequest_Edit_AddKeyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e EgressResponseValidationError) Cause() error { return e.cause }
+func (e EditMetadataRequest_Edit_AddKeyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e EgressResponseValidationError) Key() bool { return e.key }
+func (e EditMetadataRequest_Edit_AddKeyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e EgressResponseValidationError) ErrorName() string { return "EgressResponseValidationError" }
+func (e EditMetadataRequest_Edit_AddKeyValidationError) ErrorName() string {
+	return "EditMetadataRequest_Edit_AddKeyValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e EgressResponseValidationError) Error() string {
+func (e EditMetadataRequest_Edit_AddKeyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11459,14 +15885,14 @@ func (e EgressResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sEgressResponse.%s: %s%s",
+		"invalid %sEditMetadataRequest_Edit_AddKey.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = EgressResponseValidationError{}
+var _ error = EditMetadataRequest_Edit_AddKeyValidationError{}
 
 var _ interface {
 	Field() string
@@ -11474,46 +15900,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = EgressResponseValidationError{}
+} = EditMetadataRequest_Edit_AddKeyValidationError{}
 
-// Validate checks the field values on RepoInfo_Details with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *RepoInfo_Details) Validate() error {
+// Validate checks the field values on EditMetadataRequest_Edit_EditKey with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *EditMetadataRequest_Edit_EditKey) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RepoInfo_Details with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// RepoInfo_DetailsMultiError, or nil if none found.
-func (m *RepoInfo_Details) ValidateAll() error {
+// ValidateAll checks the field values on EditMetadataRequest_Edit_EditKey with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// EditMetadataRequest_Edit_EditKeyMultiError, or nil if none found.
+func (m *EditMetadataRequest_Edit_EditKey) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RepoInfo_Details) validate(all bool) error {
+func (m *EditMetadataRequest_Edit_EditKey) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for SizeBytes
+	// no validation rules for Key
+
+	// no validation rules for Value
 
 	if len(errors) > 0 {
-		return RepoInfo_DetailsMultiError(errors)
+		return EditMetadataRequest_Edit_EditKeyMultiError(errors)
 	}
 
 	return nil
 }
 
-// RepoInfo_DetailsMultiError is an error wrapping multiple validation errors
-// returned by RepoInfo_Details.ValidateAll() if the designated constraints
-// aren't met.
-type RepoInfo_DetailsMultiError []error
+// EditMetadataRequest_Edit_EditKeyMultiError is an error wrapping multiple
+// validation errors returned by
+// EditMetadataRequest_Edit_EditKey.ValidateAll() if the designated
+// constraints aren't met.
+type EditMetadataRequest_Edit_EditKeyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RepoInfo_DetailsMultiError) Error() string {
+func (m EditMetadataRequest_Edit_EditKeyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11522,11 +15952,12 @@ func (m RepoInfo_DetailsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RepoInfo_DetailsMultiError) AllErrors() []error { return m }
+func (m EditMetadataRequest_Edit_EditKeyMultiError) AllErrors() []error { return m }
 
-// RepoInfo_DetailsValidationError is the validation error returned by
-// RepoInfo_Details.Validate if the designated constraints aren't met.
-type RepoInfo_DetailsValidationError struct {
+// EditMetadataRequest_Edit_EditKeyValidationError is the validation error
+// returned by EditMetadataRequest_Edit_EditKey.Validate if the designated
+// constraints aren't met.
+type EditMetadataRequest_Edit_EditKeyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11534,22 +15965,24 @@ type RepoInfo_DetailsValidationError struct {
 }
 
 // Field function returns field value.
-func (e RepoInfo_DetailsValidationError) Field() string { return e.field }
+func (e EditMetadataRequest_Edit_EditKeyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RepoInfo_DetailsValidationError) Reason() string { return e.reason }
+func (e EditMetadataRequest_Edit_EditKeyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RepoInfo_DetailsValidationError) Cause() error { return e.cause }
+func (e EditMetadataRequest_Edit_EditKeyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RepoInfo_DetailsValidationError) Key() bool { return e.key }
+func (e EditMetadataRequest_Edit_EditKeyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RepoInfo_DetailsValidationError) ErrorName() string { return "RepoInfo_DetailsValidationError" }
+func (e EditMetadataRequest_Edit_EditKeyValidationError) ErrorName() string {
+	return "EditMetadataRequest_Edit_EditKeyValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e RepoInfo_DetailsValidationError) Error() string {
+func (e EditMetadataRequest_Edit_EditKeyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11561,14 +15994,14 @@ func (e RepoInfo_DetailsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRepoInfo_Details.%s: %s%s",
+		"invalid %sEditMetadataRequest_Edit_EditKey.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RepoInfo_DetailsValidationError{}
+var _ error = EditMetadataRequest_Edit_EditKeyValidationError{}
 
 var _ interface {
 	Field() string
@@ -11576,104 +16009,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RepoInfo_DetailsValidationError{}
+} = EditMetadataRequest_Edit_EditKeyValidationError{}
 
-// Validate checks the field values on CommitInfo_Details with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CommitInfo_Details) Validate() error {
+// Validate checks the field values on EditMetadataRequest_Edit_DeleteKey with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *EditMetadataRequest_Edit_DeleteKey) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CommitInfo_Details with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// CommitInfo_DetailsMultiError, or nil if none found.
-func (m *CommitInfo_Details) ValidateAll() error {
+// ValidateAll checks the field values on EditMetadataRequest_Edit_DeleteKey
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// EditMetadataRequest_Edit_DeleteKeyMultiError, or nil if none found.
+func (m *EditMetadataRequest_Edit_DeleteKey) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CommitInfo_Details) validate(all bool) error {
+func (m *EditMetadataRequest_Edit_DeleteKey) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for SizeBytes
-
-	if all {
-		switch v := interface{}(m.GetCompactingTime()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CommitInfo_DetailsValidationError{
-					field:  "CompactingTime",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CommitInfo_DetailsValidationError{
-					field:  "CompactingTime",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetCompactingTime()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CommitInfo_DetailsValidationError{
-				field:  "CompactingTime",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if all {
-		switch v := interface{}(m.GetValidatingTime()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CommitInfo_DetailsValidationError{
-					field:  "ValidatingTime",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CommitInfo_DetailsValidationError{
-					field:  "ValidatingTime",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetValidatingTime()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CommitInfo_DetailsValidationError{
-				field:  "ValidatingTime",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Key
 
 	if len(errors) > 0 {
-		return CommitInfo_DetailsMultiError(errors)
+		return EditMetadataRequest_Edit_DeleteKeyMultiError(errors)
 	}
 
 	return nil
 }
 
-// CommitInfo_DetailsMultiError is an error wrapping multiple validation errors
-// returned by CommitInfo_Details.ValidateAll() if the designated constraints
-// aren't met.
-type CommitInfo_DetailsMultiError []error
+// EditMetadataRequest_Edit_DeleteKeyMultiError is an error wrapping multiple
+// validation errors returned by
+// EditMetadataRequest_Edit_DeleteKey.ValidateAll() if the designated
+// constraints aren't met.
+type EditMetadataRequest_Edit_DeleteKeyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CommitInfo_DetailsMultiError) Error() string {
+func (m EditMetadataRequest_Edit_DeleteKeyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11682,11 +16059,12 @@ func (m CommitInfo_DetailsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CommitInfo_DetailsMultiError) AllErrors() []error { return m }
+func (m EditMetadataRequest_Edit_DeleteKeyMultiError) AllErrors() []error { return m }
 
-// CommitInfo_DetailsValidationError is the validation error returned by
-// CommitInfo_Details.Validate if the designated constraints aren't met.
-type CommitInfo_DetailsValidationError struct {
+// EditMetadataRequest_Edit_DeleteKeyValidationError is the validation error
+// returned by EditMetadataRequest_Edit_DeleteKey.Validate if the designated
+// constraints aren't met.
+type EditMetadataRequest_Edit_DeleteKeyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11694,24 +16072,24 @@ type CommitInfo_DetailsValidationError struct {
 }
 
 // Field function returns field value.
-func (e CommitInfo_DetailsValidationError) Field() string { return e.field }
+func (e EditMetadataRequest_Edit_DeleteKeyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CommitInfo_DetailsValidationError) Reason() string { return e.reason }
+func (e EditMetadataRequest_Edit_DeleteKeyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CommitInfo_DetailsValidationError) Cause() error { return e.cause }
+func (e EditMetadataRequest_Edit_DeleteKeyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CommitInfo_DetailsValidationError) Key() bool { return e.key }
+func (e EditMetadataRequest_Edit_DeleteKeyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CommitInfo_DetailsValidationError) ErrorName() string {
-	return "CommitInfo_DetailsValidationError"
+func (e EditMetadataRequest_Edit_DeleteKeyValidationError) ErrorName() string {
+	return "EditMetadataRequest_Edit_DeleteKeyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CommitInfo_DetailsValidationError) Error() string {
+func (e EditMetadataRequest_Edit_DeleteKeyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11723,14 +16101,14 @@ func (e CommitInfo_DetailsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCommitInfo_Details.%s: %s%s",
+		"invalid %sEditMetadataRequest_Edit_DeleteKey.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CommitInfo_DetailsValidationError{}
+var _ error = EditMetadataRequest_Edit_DeleteKeyValidationError{}
 
 var _ interface {
 	Field() string
@@ -11738,7 +16116,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CommitInfo_DetailsValidationError{}
+} = EditMetadataRequest_Edit_DeleteKeyValidationError{}
 
 // Validate checks the field values on AddFile_URLSource with the rules defined
 // in the proto definition for this message. If any rules are violated, the
@@ -12059,6 +16437,109 @@ var _ interface {
 	ErrorName() string
 } = SQLDatabaseEgress_SecretValidationError{}
 
+// Validate checks the field values on SQLDatabaseEgress_PrimaryKey with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SQLDatabaseEgress_PrimaryKey) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SQLDatabaseEgress_PrimaryKey with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SQLDatabaseEgress_PrimaryKeyMultiError, or nil if none found.
+func (m *SQLDatabaseEgress_PrimaryKey) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SQLDatabaseEgress_PrimaryKey) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return SQLDatabaseEgress_PrimaryKeyMultiError(errors)
+	}
+
+	return nil
+}
+
+// SQLDatabaseEgress_PrimaryKeyMultiError is an error wrapping multiple
+// validation errors returned by SQLDatabaseEgress_PrimaryKey.ValidateAll() if
+// the designated constraints aren't met.
+type SQLDatabaseEgress_PrimaryKeyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SQLDatabaseEgress_PrimaryKeyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SQLDatabaseEgress_PrimaryKeyMultiError) AllErrors() []error { return m }
+
+// SQLDatabaseEgress_PrimaryKeyValidationError is the validation error returned
+// by SQLDatabaseEgress_PrimaryKey.Validate if the designated constraints
+// aren't met.
+type SQLDatabaseEgress_PrimaryKeyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SQLDatabaseEgress_PrimaryKeyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SQLDatabaseEgress_PrimaryKeyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SQLDatabaseEgress_PrimaryKeyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SQLDatabaseEgress_PrimaryKeyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SQLDatabaseEgress_PrimaryKeyValidationError) ErrorName() string {
+	return "SQLDatabaseEgress_PrimaryKeyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SQLDatabaseEgress_PrimaryKeyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSQLDatabaseEgress_PrimaryKey.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SQLDatabaseEgress_PrimaryKeyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SQLDatabaseEgress_PrimaryKeyValidationError{}
+
 // Validate checks the field values on EgressResponse_ObjectStorageResult with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the first error encountered is returned, or nil if there are
@@ -12191,6 +16672,41 @@ func (m *EgressResponse_SQLDatabaseResult) validate(all bool) error {
 
 	// no validation rules for RowsWritten
 
+	// no validation rules for RowsInserted
+
+	// no validation rules for RowsUpdated
+
+	// no validation rules for RowsDeleted
+
+	if all {
+		switch v := interface{}(m.GetBaseCommit()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EgressResponse_SQLDatabaseResultValidationError{
+					field:  "BaseCommit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EgressResponse_SQLDatabaseResultValidationError{
+					field:  "BaseCommit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBaseCommit()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EgressResponse_SQLDatabaseResultValidationError{
+				field:  "BaseCommit",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return EgressResponse_SQLDatabaseResultMultiError(errors)
 	}
@@ -29,6 +29,15 @@ func (x *Branch) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	return nil
 }
 
+func (x *Tag) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddObject("repo", x.Repo)
+	enc.AddString("name", x.Name)
+	return nil
+}
+
 func (x *File) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	if x == nil {
 		return nil
@@ -56,6 +65,13 @@ func (x *RepoInfo) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	enc.AddArray("branches", zapcore.ArrayMarshalerFunc(branchesArrMarshaller))
 	enc.AddObject("auth_info", x.AuthInfo)
 	enc.AddObject("details", x.Details)
+	enc.AddObject("metadata", zapcore.ObjectMarshalerFunc(func(enc zapcore.ObjectEncoder) error {
+		for k, v := range x.Metadata {
+			enc.AddString(fmt.Sprintf("%v", k), v)
+		}
+		return nil
+	}))
+	enc.AddObject("retention_policy", x.RetentionPolicy)
 	return nil
 }
 
@@ -116,6 +132,28 @@ func (x *BranchInfo) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	}
 	enc.AddArray("direct_provenance", zapcore.ArrayMarshalerFunc(direct_provenanceArrMarshaller))
 	enc.AddObject("trigger", x.Trigger)
+	enc.AddObject("metadata", zapcore.ObjectMarshalerFunc(func(enc zapcore.ObjectEncoder) error {
+		for k, v := range x.Metadata {
+			enc.AddString(fmt.Sprintf("%v", k), v)
+		}
+		return nil
+	}))
+	enc.AddObject("retention_policy", x.RetentionPolicy)
+	return nil
+}
+
+func (x *RetentionPolicy) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddInt64("keep_last", x.KeepLast)
+	protoextensions.AddDuration(enc, "keep_newer_than", x.KeepNewerThan)
+	enc.AddObject("keep_metadata", zapcore.ObjectMarshalerFunc(func(enc zapcore.ObjectEncoder) error {
+		for k, v := range x.KeepMetadata {
+			enc.AddString(fmt.Sprintf("%v", k), v)
+		}
+		return nil
+	}))
 	return nil
 }
 
@@ -178,6 +216,12 @@ func (x *CommitInfo) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	enc.AddString("error", x.Error)
 	enc.AddInt64("size_bytes_upper_bound", x.SizeBytesUpperBound)
 	enc.AddObject("details", x.Details)
+	enc.AddObject("metadata", zapcore.ObjectMarshalerFunc(func(enc zapcore.ObjectEncoder) error {
+		for k, v := range x.Metadata {
+			enc.AddString(fmt.Sprintf("%v", k), v)
+		}
+		return nil
+	}))
 	return nil
 }
 
@@ -223,6 +267,13 @@ func (x *FileInfo) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	protoextensions.AddTimestamp(enc, "committed", x.Committed)
 	enc.AddInt64("size_bytes", x.SizeBytes)
 	protoextensions.AddBytes(enc, "hash", x.Hash)
+	enc.AddString("content_type", x.ContentType)
+	enc.AddObject("metadata", zapcore.ObjectMarshalerFunc(func(enc zapcore.ObjectEncoder) error {
+		for k, v := range x.Metadata {
+			enc.AddString(fmt.Sprintf("%v", k), v)
+		}
+		return nil
+	}))
 	return nil
 }
 
@@ -242,6 +293,12 @@ func (x *ProjectInfo) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	enc.AddString("description", x.Description)
 	enc.AddObject("auth_info", x.AuthInfo)
 	protoextensions.AddTimestamp(enc, "created_at", x.CreatedAt)
+	enc.AddObject("metadata", zapcore.ObjectMarshalerFunc(func(enc zapcore.ObjectEncoder) error {
+		for k, v := range x.Metadata {
+			enc.AddString(fmt.Sprintf("%v", k), v)
+		}
+		return nil
+	}))
 	return nil
 }
 
@@ -275,6 +332,12 @@ func (x *ListRepoRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 		return nil
 	}
 	enc.AddArray("projects", zapcore.ArrayMarshalerFunc(projectsArrMarshaller))
+	enc.AddObject("metadata", zapcore.ObjectMarshalerFunc(func(enc zapcore.ObjectEncoder) error {
+		for k, v := range x.Metadata {
+			enc.AddString(fmt.Sprintf("%v", k), v)
+		}
+		return nil
+	}))
 	return nil
 }
 
@@ -367,6 +430,12 @@ func (x *ListCommitRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	enc.AddBool("all", x.All)
 	enc.AddString("origin_kind", x.OriginKind.String())
 	protoextensions.AddTimestamp(enc, "started_time", x.StartedTime)
+	enc.AddObject("metadata", zapcore.ObjectMarshalerFunc(func(enc zapcore.ObjectEncoder) error {
+		for k, v := range x.Metadata {
+			enc.AddString(fmt.Sprintf("%v", k), v)
+		}
+		return nil
+	}))
 	return nil
 }
 
@@ -520,6 +589,49 @@ func (x *DeleteBranchRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error
 	return nil
 }
 
+func (x *TagInfo) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddObject("tag", x.Tag)
+	enc.AddObject("commit", x.Commit)
+	protoextensions.AddTimestamp(enc, "created", x.Created)
+	return nil
+}
+
+func (x *CreateTagRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddObject("tag", x.Tag)
+	enc.AddObject("commit", x.Commit)
+	return nil
+}
+
+func (x *InspectTagRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddObject("tag", x.Tag)
+	return nil
+}
+
+func (x *ListTagRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddObject("repo", x.Repo)
+	return nil
+}
+
+func (x *DeleteTagRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddObject("tag", x.Tag)
+	return nil
+}
+
 func (x *CreateProjectRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	if x == nil {
 		return nil
@@ -554,6 +666,167 @@ func (x *DeleteProjectRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error
 	return nil
 }
 
+func (x *EditMetadataRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	editsArrMarshaller := func(enc zapcore.ArrayEncoder) error {
+		for _, v := range x.Edits {
+			enc.AppendObject(v)
+		}
+		return nil
+	}
+	enc.AddArray("edits", zapcore.ArrayMarshalerFunc(editsArrMarshaller))
+	return nil
+}
+
+func (x *EditMetadataRequest_Edit) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddObject("project", x.GetProject())
+	enc.AddObject("repo", x.GetRepo())
+	enc.AddObject("branch", x.GetBranch())
+	enc.AddObject("commit", x.GetCommit())
+	enc.AddObject("replace", x.GetReplace())
+	enc.AddObject("add_key", x.GetAddKey())
+	enc.AddObject("edit_key", x.GetEditKey())
+	enc.AddObject("delete_key", x.GetDeleteKey())
+	return nil
+}
+
+func (x *EditMetadataRequest_Edit_Replace) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddObject("replacement", zapcore.ObjectMarshalerFunc(func(enc zapcore.ObjectEncoder) error {
+		for k, v := range x.Replacement {
+			enc.AddString(fmt.Sprintf("%v", k), v)
+		}
+		return nil
+	}))
+	return nil
+}
+
+func (x *EditMetadataRequest_Edit_AddKey) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddString("key", x.Key)
+	enc.AddString("value", x.Value)
+	return nil
+}
+
+func (x *EditMetadataRequest_Edit_EditKey) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddString("key", x.Key)
+	enc.AddString("value", x.Value)
+	return nil
+}
+
+func (x *EditMetadataRequest_Edit_DeleteKey) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddString("key", x.Key)
+	return nil
+}
+
+func (x *EditMetadataResponse) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	return nil
+}
+
+func (x *SetRetentionPolicyRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddObject("repo", x.GetRepo())
+	enc.AddObject("branch", x.GetBranch())
+	enc.AddObject("policy", x.Policy)
+	return nil
+}
+
+func (x *ApplyRetentionPolicyRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddObject("repo", x.GetRepo())
+	enc.AddObject("branch", x.GetBranch())
+	enc.AddBool("dry_run", x.DryRun)
+	return nil
+}
+
+func (x *ApplyRetentionPolicyResponse) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	expiredArrMarshaller := func(enc zapcore.ArrayEncoder) error {
+		for _, v := range x.Expired {
+			enc.AppendObject(v)
+		}
+		return nil
+	}
+	enc.AddArray("expired", zapcore.ArrayMarshalerFunc(expiredArrMarshaller))
+	return nil
+}
+
+func (x *PurgePathRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddObject("repo", x.Repo)
+	enc.AddString("path", x.Path)
+	return nil
+}
+
+func (x *PurgedDatums) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddObject("repo", x.Repo)
+	datumsArrMarshaller := func(enc zapcore.ArrayEncoder) error {
+		for _, v := range x.Datums {
+			enc.AppendString(v)
+		}
+		return nil
+	}
+	enc.AddArray("datums", zapcore.ArrayMarshalerFunc(datumsArrMarshaller))
+	return nil
+}
+
+func (x *PurgePathResponse) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	commitsArrMarshaller := func(enc zapcore.ArrayEncoder) error {
+		for _, v := range x.Commits {
+			enc.AppendObject(v)
+		}
+		return nil
+	}
+	enc.AddArray("commits", zapcore.ArrayMarshalerFunc(commitsArrMarshaller))
+	datumsArrMarshaller := func(enc zapcore.ArrayEncoder) error {
+		for _, v := range x.Datums {
+			enc.AppendObject(v)
+		}
+		return nil
+	}
+	enc.AddArray("datums", zapcore.ArrayMarshalerFunc(datumsArrMarshaller))
+	unreferenced_chunksArrMarshaller := func(enc zapcore.ArrayEncoder) error {
+		for _, v := range x.UnreferencedChunks {
+			enc.AppendString(v)
+		}
+		return nil
+	}
+	enc.AddArray("unreferenced_chunks", zapcore.ArrayMarshalerFunc(unreferenced_chunksArrMarshaller))
+	return nil
+}
+
 func (x *AddFile) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	if x == nil {
 		return nil
@@ -562,6 +835,13 @@ func (x *AddFile) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	enc.AddString("datum", x.Datum)
 	protoextensions.AddBytesValue(enc, "raw", x.GetRaw())
 	enc.AddObject("url", x.GetUrl())
+	enc.AddString("content_type", x.ContentType)
+	enc.AddObject("metadata", zapcore.ObjectMarshalerFunc(func(enc zapcore.ObjectEncoder) error {
+		for k, v := range x.Metadata {
+			enc.AddString(fmt.Sprintf("%v", k), v)
+		}
+		return nil
+	}))
 	return nil
 }
 
@@ -614,6 +894,7 @@ func (x *GetFileRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	enc.AddString("URL", x.URL)
 	enc.AddInt64("offset", x.Offset)
 	enc.AddObject("path_range", x.PathRange)
+	enc.AddInt64("size_bytes", x.SizeBytes)
 	return nil
 }
 
@@ -783,6 +1064,41 @@ func (x *CheckStorageRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error
 	enc.AddBool("read_chunk_data", x.ReadChunkData)
 	protoextensions.AddBytes(enc, "chunk_begin", x.ChunkBegin)
 	protoextensions.AddBytes(enc, "chunk_end", x.ChunkEnd)
+	enc.AddBool("check_consistency", x.CheckConsistency)
+	enc.AddInt64("consistency_limit", x.ConsistencyLimit)
+	return nil
+}
+
+func (x *StorageConsistency) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddInt64("missing_object_count", x.MissingObjectCount)
+	missing_objectsArrMarshaller := func(enc zapcore.ArrayEncoder) error {
+		for _, v := range x.MissingObjects {
+			enc.AppendString(v)
+		}
+		return nil
+	}
+	enc.AddArray("missing_objects", zapcore.ArrayMarshalerFunc(missing_objectsArrMarshaller))
+	enc.AddInt64("untracked_entry_count", x.UntrackedEntryCount)
+	untracked_entriesArrMarshaller := func(enc zapcore.ArrayEncoder) error {
+		for _, v := range x.UntrackedEntries {
+			enc.AppendString(v)
+		}
+		return nil
+	}
+	enc.AddArray("untracked_entries", zapcore.ArrayMarshalerFunc(untracked_entriesArrMarshaller))
+	enc.AddInt64("orphaned_object_count", x.OrphanedObjectCount)
+	orphaned_objectsArrMarshaller := func(enc zapcore.ArrayEncoder) error {
+		for _, v := range x.OrphanedObjects {
+			enc.AppendString(v)
+		}
+		return nil
+	}
+	enc.AddArray("orphaned_objects", zapcore.ArrayMarshalerFunc(orphaned_objectsArrMarshaller))
+	enc.AddInt64("garbage_object_count", x.GarbageObjectCount)
+	enc.AddInt64("garbage_bytes", x.GarbageBytes)
 	return nil
 }
 
@@ -791,6 +1107,85 @@ func (x *CheckStorageResponse) MarshalLogObject(enc zapcore.ObjectEncoder) error
 		return nil
 	}
 	enc.AddInt64("chunk_object_count", x.ChunkObjectCount)
+	enc.AddObject("consistency", x.Consistency)
+	return nil
+}
+
+func (x *InspectStorageRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddInt64("limit", x.Limit)
+	return nil
+}
+
+func (x *StorageStats) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddInt64("logical_bytes", x.LogicalBytes)
+	enc.AddInt64("physical_bytes", x.PhysicalBytes)
+	enc.AddInt64("unique_bytes", x.UniqueBytes)
+	enc.AddInt64("chunk_count", x.ChunkCount)
+	return nil
+}
+
+func (x *RepoStorageStats) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddObject("repo", x.Repo)
+	enc.AddObject("stats", x.Stats)
+	return nil
+}
+
+func (x *ProjectStorageStats) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddObject("project", x.Project)
+	enc.AddObject("stats", x.Stats)
+	return nil
+}
+
+func (x *SharedStorageStats) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddObject("repo_a", x.RepoA)
+	enc.AddObject("repo_b", x.RepoB)
+	enc.AddInt64("shared_bytes", x.SharedBytes)
+	enc.AddFloat64("shared_ratio", x.SharedRatio)
+	return nil
+}
+
+func (x *InspectStorageResponse) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddObject("total", x.Total)
+	projectsArrMarshaller := func(enc zapcore.ArrayEncoder) error {
+		for _, v := range x.Projects {
+			enc.AppendObject(v)
+		}
+		return nil
+	}
+	enc.AddArray("projects", zapcore.ArrayMarshalerFunc(projectsArrMarshaller))
+	reposArrMarshaller := func(enc zapcore.ArrayEncoder) error {
+		for _, v := range x.Repos {
+			enc.AppendObject(v)
+		}
+		return nil
+	}
+	enc.AddArray("repos", zapcore.ArrayMarshalerFunc(reposArrMarshaller))
+	sharedArrMarshaller := func(enc zapcore.ArrayEncoder) error {
+		for _, v := range x.Shared {
+			enc.AppendObject(v)
+		}
+		return nil
+	}
+	enc.AddArray("shared", zapcore.ArrayMarshalerFunc(sharedArrMarshaller))
+	protoextensions.AddTimestamp(enc, "computed_at", x.ComputedAt)
 	return nil
 }
 
@@ -864,6 +1259,13 @@ func (x *SQLDatabaseEgress) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	enc.AddString("url", x.Url)
 	enc.AddObject("file_format", x.FileFormat)
 	enc.AddObject("secret", x.Secret)
+	enc.AddString("mode", x.Mode.String())
+	enc.AddObject("primary_keys", zapcore.ObjectMarshalerFunc(func(enc zapcore.ObjectEncoder) error {
+		for k, v := range x.PrimaryKeys {
+			enc.AddObject(fmt.Sprintf("%v", k), v)
+		}
+		return nil
+	}))
 	return nil
 }
 
@@ -891,6 +1293,20 @@ func (x *SQLDatabaseEgress_Secret) MarshalLogObject(enc zapcore.ObjectEncoder) e
 	return nil
 }
 
+func (x *SQLDatabaseEgress_PrimaryKey) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	columnsArrMarshaller := func(enc zapcore.ArrayEncoder) error {
+		for _, v := range x.Columns {
+			enc.AppendString(v)
+		}
+		return nil
+	}
+	enc.AddArray("columns", zapcore.ArrayMarshalerFunc(columnsArrMarshaller))
+	return nil
+}
+
 func (x *EgressRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	if x == nil {
 		return nil
@@ -928,5 +1344,24 @@ func (x *EgressResponse_SQLDatabaseResult) MarshalLogObject(enc zapcore.ObjectEn
 		}
 		return nil
 	}))
+	enc.AddObject("rows_inserted", zapcore.ObjectMarshalerFunc(func(enc zapcore.ObjectEncoder) error {
+		for k, v := range x.RowsInserted {
+			enc.AddInt64(fmt.Sprintf("%v", k), v)
+		}
+		return nil
+	}))
+	enc.AddObject("rows_updated", zapcore.ObjectMarshalerFunc(func(enc zapcore.ObjectEncoder) error {
+		for k, v := range x.RowsUpdated {
+			enc.AddInt64(fmt.Sprintf("%v", k), v)
+		}
+		return nil
+	}))
+	enc.AddObject("rows_deleted", zapcore.ObjectMarshalerFunc(func(enc zapcore.ObjectEncoder) error {
+		for k, v := range x.RowsDeleted {
+			enc.AddInt64(fmt.Sprintf("%v", k), v)
+		}
+		return nil
+	}))
+	enc.AddObject("base_commit", x.BaseCommit)
 	return nil
 }
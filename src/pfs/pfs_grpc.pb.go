@@ -22,55 +22,64 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	API_CreateRepo_FullMethodName       = "/pfs_v2.API/CreateRepo"
-	API_InspectRepo_FullMethodName      = "/pfs_v2.API/InspectRepo"
-	API_ListRepo_FullMethodName         = "/pfs_v2.API/ListRepo"
-	API_DeleteRepo_FullMethodName       = "/pfs_v2.API/DeleteRepo"
-	API_DeleteRepos_FullMethodName      = "/pfs_v2.API/DeleteRepos"
-	API_StartCommit_FullMethodName      = "/pfs_v2.API/StartCommit"
-	API_FinishCommit_FullMethodName     = "/pfs_v2.API/FinishCommit"
-	API_ClearCommit_FullMethodName      = "/pfs_v2.API/ClearCommit"
-	API_InspectCommit_FullMethodName    = "/pfs_v2.API/InspectCommit"
-	API_ListCommit_FullMethodName       = "/pfs_v2.API/ListCommit"
-	API_SubscribeCommit_FullMethodName  = "/pfs_v2.API/SubscribeCommit"
-	API_SquashCommit_FullMethodName     = "/pfs_v2.API/SquashCommit"
-	API_DropCommit_FullMethodName       = "/pfs_v2.API/DropCommit"
-	API_InspectCommitSet_FullMethodName = "/pfs_v2.API/InspectCommitSet"
-	API_ListCommitSet_FullMethodName    = "/pfs_v2.API/ListCommitSet"
-	API_SquashCommitSet_FullMethodName  = "/pfs_v2.API/SquashCommitSet"
-	API_DropCommitSet_FullMethodName    = "/pfs_v2.API/DropCommitSet"
-	API_FindCommits_FullMethodName      = "/pfs_v2.API/FindCommits"
-	API_CreateBranch_FullMethodName     = "/pfs_v2.API/CreateBranch"
-	API_InspectBranch_FullMethodName    = "/pfs_v2.API/InspectBranch"
-	API_ListBranch_FullMethodName       = "/pfs_v2.API/ListBranch"
-	API_DeleteBranch_FullMethodName     = "/pfs_v2.API/DeleteBranch"
-	API_ModifyFile_FullMethodName       = "/pfs_v2.API/ModifyFile"
-	API_GetFile_FullMethodName          = "/pfs_v2.API/GetFile"
-	API_GetFileTAR_FullMethodName       = "/pfs_v2.API/GetFileTAR"
-	API_InspectFile_FullMethodName      = "/pfs_v2.API/InspectFile"
-	API_ListFile_FullMethodName         = "/pfs_v2.API/ListFile"
-	API_WalkFile_FullMethodName         = "/pfs_v2.API/WalkFile"
-	API_GlobFile_FullMethodName         = "/pfs_v2.API/GlobFile"
-	API_DiffFile_FullMethodName         = "/pfs_v2.API/DiffFile"
-	API_ActivateAuth_FullMethodName     = "/pfs_v2.API/ActivateAuth"
-	API_DeleteAll_FullMethodName        = "/pfs_v2.API/DeleteAll"
-	API_Fsck_FullMethodName             = "/pfs_v2.API/Fsck"
-	API_CreateFileSet_FullMethodName    = "/pfs_v2.API/CreateFileSet"
-	API_GetFileSet_FullMethodName       = "/pfs_v2.API/GetFileSet"
-	API_AddFileSet_FullMethodName       = "/pfs_v2.API/AddFileSet"
-	API_RenewFileSet_FullMethodName     = "/pfs_v2.API/RenewFileSet"
-	API_ComposeFileSet_FullMethodName   = "/pfs_v2.API/ComposeFileSet"
-	API_ShardFileSet_FullMethodName     = "/pfs_v2.API/ShardFileSet"
-	API_CheckStorage_FullMethodName     = "/pfs_v2.API/CheckStorage"
-	API_PutCache_FullMethodName         = "/pfs_v2.API/PutCache"
-	API_GetCache_FullMethodName         = "/pfs_v2.API/GetCache"
-	API_ClearCache_FullMethodName       = "/pfs_v2.API/ClearCache"
-	API_ListTask_FullMethodName         = "/pfs_v2.API/ListTask"
-	API_Egress_FullMethodName           = "/pfs_v2.API/Egress"
-	API_CreateProject_FullMethodName    = "/pfs_v2.API/CreateProject"
-	API_InspectProject_FullMethodName   = "/pfs_v2.API/InspectProject"
-	API_ListProject_FullMethodName      = "/pfs_v2.API/ListProject"
-	API_DeleteProject_FullMethodName    = "/pfs_v2.API/DeleteProject"
+	API_CreateRepo_FullMethodName           = "/pfs_v2.API/CreateRepo"
+	API_InspectRepo_FullMethodName          = "/pfs_v2.API/InspectRepo"
+	API_ListRepo_FullMethodName             = "/pfs_v2.API/ListRepo"
+	API_DeleteRepo_FullMethodName           = "/pfs_v2.API/DeleteRepo"
+	API_DeleteRepos_FullMethodName          = "/pfs_v2.API/DeleteRepos"
+	API_StartCommit_FullMethodName          = "/pfs_v2.API/StartCommit"
+	API_FinishCommit_FullMethodName         = "/pfs_v2.API/FinishCommit"
+	API_ClearCommit_FullMethodName          = "/pfs_v2.API/ClearCommit"
+	API_InspectCommit_FullMethodName        = "/pfs_v2.API/InspectCommit"
+	API_ListCommit_FullMethodName           = "/pfs_v2.API/ListCommit"
+	API_SubscribeCommit_FullMethodName      = "/pfs_v2.API/SubscribeCommit"
+	API_SquashCommit_FullMethodName         = "/pfs_v2.API/SquashCommit"
+	API_DropCommit_FullMethodName           = "/pfs_v2.API/DropCommit"
+	API_InspectCommitSet_FullMethodName     = "/pfs_v2.API/InspectCommitSet"
+	API_ListCommitSet_FullMethodName        = "/pfs_v2.API/ListCommitSet"
+	API_SquashCommitSet_FullMethodName      = "/pfs_v2.API/SquashCommitSet"
+	API_DropCommitSet_FullMethodName        = "/pfs_v2.API/DropCommitSet"
+	API_FindCommits_FullMethodName          = "/pfs_v2.API/FindCommits"
+	API_CreateBranch_FullMethodName         = "/pfs_v2.API/CreateBranch"
+	API_InspectBranch_FullMethodName        = "/pfs_v2.API/InspectBranch"
+	API_ListBranch_FullMethodName           = "/pfs_v2.API/ListBranch"
+	API_DeleteBranch_FullMethodName         = "/pfs_v2.API/DeleteBranch"
+	API_CreateTag_FullMethodName            = "/pfs_v2.API/CreateTag"
+	API_InspectTag_FullMethodName           = "/pfs_v2.API/InspectTag"
+	API_ListTag_FullMethodName              = "/pfs_v2.API/ListTag"
+	API_DeleteTag_FullMethodName            = "/pfs_v2.API/DeleteTag"
+	API_ModifyFile_FullMethodName           = "/pfs_v2.API/ModifyFile"
+	API_GetFile_FullMethodName              = "/pfs_v2.API/GetFile"
+	API_GetFileTAR_FullMethodName           = "/pfs_v2.API/GetFileTAR"
+	API_InspectFile_FullMethodName          = "/pfs_v2.API/InspectFile"
+	API_ListFile_FullMethodName             = "/pfs_v2.API/ListFile"
+	API_WalkFile_FullMethodName             = "/pfs_v2.API/WalkFile"
+	API_GlobFile_FullMethodName             = "/pfs_v2.API/GlobFile"
+	API_DiffFile_FullMethodName             = "/pfs_v2.API/DiffFile"
+	API_ActivateAuth_FullMethodName         = "/pfs_v2.API/ActivateAuth"
+	API_DeleteAll_FullMethodName            = "/pfs_v2.API/DeleteAll"
+	API_Fsck_FullMethodName                 = "/pfs_v2.API/Fsck"
+	API_CreateFileSet_FullMethodName        = "/pfs_v2.API/CreateFileSet"
+	API_GetFileSet_FullMethodName           = "/pfs_v2.API/GetFileSet"
+	API_AddFileSet_FullMethodName           = "/pfs_v2.API/AddFileSet"
+	API_RenewFileSet_FullMethodName         = "/pfs_v2.API/RenewFileSet"
+	API_ComposeFileSet_FullMethodName       = "/pfs_v2.API/ComposeFileSet"
+	API_ShardFileSet_FullMethodName         = "/pfs_v2.API/ShardFileSet"
+	API_CheckStorage_FullMethodName         = "/pfs_v2.API/CheckStorage"
+	API_InspectStorage_FullMethodName       = "/pfs_v2.API/InspectStorage"
+	API_PutCache_FullMethodName             = "/pfs_v2.API/PutCache"
+	API_GetCache_FullMethodName             = "/pfs_v2.API/GetCache"
+	API_ClearCache_FullMethodName           = "/pfs_v2.API/ClearCache"
+	API_ListTask_FullMethodName             = "/pfs_v2.API/ListTask"
+	API_Egress_FullMethodName               = "/pfs_v2.API/Egress"
+	API_CreateProject_FullMethodName        = "/pfs_v2.API/CreateProject"
+	API_InspectProject_FullMethodName       = "/pfs_v2.API/InspectProject"
+	API_ListProject_FullMethodName          = "/pfs_v2.API/ListProject"
+	API_DeleteProject_FullMethodName        = "/pfs_v2.API/DeleteProject"
+	API_EditMetadata_FullMethodName         = "/pfs_v2.API/EditMetadata"
+	API_SetRetentionPolicy_FullMethodName   = "/pfs_v2.API/SetRetentionPolicy"
+	API_ApplyRetentionPolicy_FullMethodName = "/pfs_v2.API/ApplyRetentionPolicy"
+	API_PurgePath_FullMethodName            = "/pfs_v2.API/PurgePath"
 )
 
 // APIClient is the client API for API service.
@@ -128,6 +137,14 @@ type APIClient interface {
 	ListBranch(ctx context.Context, in *ListBranchRequest, opts ...grpc.CallOption) (API_ListBranchClient, error)
 	// DeleteBranch deletes a branch; note that the commits still exist.
 	DeleteBranch(ctx context.Context, in *DeleteBranchRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// CreateTag creates an immutable name for a commit.
+	CreateTag(ctx context.Context, in *CreateTagRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// InspectTag returns info about a tag.
+	InspectTag(ctx context.Context, in *InspectTagRequest, opts ...grpc.CallOption) (*TagInfo, error)
+	// ListTag returns info about the tags in a repo.
+	ListTag(ctx context.Context, in *ListTagRequest, opts ...grpc.CallOption) (API_ListTagClient, error)
+	// DeleteTag deletes a tag; note that the commit still exists.
+	DeleteTag(ctx context.Context, in *DeleteTagRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// ModifyFile performs modifications on a set of files.
 	ModifyFile(ctx context.Context, opts ...grpc.CallOption) (API_ModifyFileClient, error)
 	// GetFile returns the contents of a single file
@@ -164,6 +181,10 @@ type APIClient interface {
 	ShardFileSet(ctx context.Context, in *ShardFileSetRequest, opts ...grpc.CallOption) (*ShardFileSetResponse, error)
 	// CheckStorage runs integrity checks for the storage layer.
 	CheckStorage(ctx context.Context, in *CheckStorageRequest, opts ...grpc.CallOption) (*CheckStorageResponse, error)
+	// InspectStorage reports the storage used by each repo and project, and how
+	// much of it is saved by sharing chunks, as of the last time pachd computed
+	// it.
+	InspectStorage(ctx context.Context, in *InspectStorageRequest, opts ...grpc.CallOption) (*InspectStorageResponse, error)
 	PutCache(ctx context.Context, in *PutCacheRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetCache(ctx context.Context, in *GetCacheRequest, opts ...grpc.CallOption) (*GetCacheResponse, error)
 	ClearCache(ctx context.Context, in *ClearCacheRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
@@ -180,6 +201,17 @@ type APIClient interface {
 	ListProject(ctx context.Context, in *ListProjectRequest, opts ...grpc.CallOption) (API_ListProjectClient, error)
 	// DeleteProject deletes a project.
 	DeleteProject(ctx context.Context, in *DeleteProjectRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// EditMetadata edits the metadata of projects, repos, branches and commits.
+	EditMetadata(ctx context.Context, in *EditMetadataRequest, opts ...grpc.CallOption) (*EditMetadataResponse, error)
+	// SetRetentionPolicy sets the retention policy of a repo or branch.
+	SetRetentionPolicy(ctx context.Context, in *SetRetentionPolicyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ApplyRetentionPolicy squashes commits that have expired under the
+	// retention policy, or previews which commits would be squashed.
+	ApplyRetentionPolicy(ctx context.Context, in *ApplyRetentionPolicyRequest, opts ...grpc.CallOption) (*ApplyRetentionPolicyResponse, error)
+	// PurgePath permanently removes a path from every commit of a repo, and the
+	// output derived from it from the repos downstream.  It requires the cluster
+	// REPO_DELETE_COMMIT permission.
+	PurgePath(ctx context.Context, in *PurgePathRequest, opts ...grpc.CallOption) (*PurgePathResponse, error)
 }
 
 type aPIClient struct {
@@ -549,8 +581,67 @@ func (c *aPIClient) DeleteBranch(ctx context.Context, in *DeleteBranchRequest, o
 	return out, nil
 }
 
+func (c *aPIClient) CreateTag(ctx context.Context, in *CreateTagRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, API_CreateTag_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) InspectTag(ctx context.Context, in *InspectTagRequest, opts ...grpc.CallOption) (*TagInfo, error) {
+	out := new(TagInfo)
+	err := c.cc.Invoke(ctx, API_InspectTag_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) ListTag(ctx context.Context, in *ListTagRequest, opts ...grpc.CallOption) (API_ListTagClient, error) {
+	stream, err := c.cc.NewStream(ctx, &API_ServiceDesc.Streams[7], API_ListTag_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIListTagClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type API_ListTagClient interface {
+	Recv() (*TagInfo, error)
+	grpc.ClientStream
+}
+
+type aPIListTagClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIListTagClient) Recv() (*TagInfo, error) {
+	m := new(TagInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *aPIClient) DeleteTag(ctx context.Context, in *DeleteTagRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, API_DeleteTag_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aPIClient) ModifyFile(ctx context.Context, opts ...grpc.CallOption) (API_ModifyFileClient, error) {
-	stream, err := c.cc.NewStream(ctx, &API_ServiceDesc.Streams[7], API_ModifyFile_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &API_ServiceDesc.Streams[8], API_ModifyFile_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -584,7 +675,7 @@ func (x *aPIModifyFileClient) CloseAndRecv() (*emptypb.Empty, error) {
 }
 
 func (c *aPIClient) GetFile(ctx context.Context, in *GetFileRequest, opts ...grpc.CallOption) (API_GetFileClient, error) {
-	stream, err := c.cc.NewStream(ctx, &API_ServiceDesc.Streams[8], API_GetFile_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &API_ServiceDesc.Streams[9], API_GetFile_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -616,7 +707,7 @@ func (x *aPIGetFileClient) Recv() (*wrapperspb.BytesValue, error) {
 }
 
 func (c *aPIClient) GetFileTAR(ctx context.Context, in *GetFileRequest, opts ...grpc.CallOption) (API_GetFileTARClient, error) {
-	stream, err := c.cc.NewStream(ctx, &API_ServiceDesc.Streams[9], API_GetFileTAR_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &API_ServiceDesc.Streams[10], API_GetFileTAR_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -657,7 +748,7 @@ func (c *aPIClient) InspectFile(ctx context.Context, in *InspectFileRequest, opt
 }
 
 func (c *aPIClient) ListFile(ctx context.Context, in *ListFileRequest, opts ...grpc.CallOption) (API_ListFileClient, error) {
-	stream, err := c.cc.NewStream(ctx, &API_ServiceDesc.Streams[10], API_ListFile_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &API_ServiceDesc.Streams[11], API_ListFile_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -689,7 +780,7 @@ func (x *aPIListFileClient) Recv() (*FileInfo, error) {
 }
 
 func (c *aPIClient) WalkFile(ctx context.Context, in *WalkFileRequest, opts ...grpc.CallOption) (API_WalkFileClient, error) {
-	stream, err := c.cc.NewStream(ctx, &API_ServiceDesc.Streams[11], API_WalkFile_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &API_ServiceDesc.Streams[12], API_WalkFile_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -721,7 +812,7 @@ func (x *aPIWalkFileClient) Recv() (*FileInfo, error) {
 }
 
 func (c *aPIClient) GlobFile(ctx context.Context, in *GlobFileRequest, opts ...grpc.CallOption) (API_GlobFileClient, error) {
-	stream, err := c.cc.NewStream(ctx, &API_ServiceDesc.Streams[12], API_GlobFile_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &API_ServiceDesc.Streams[13], API_GlobFile_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -753,7 +844,7 @@ func (x *aPIGlobFileClient) Recv() (*FileInfo, error) {
 }
 
 func (c *aPIClient) DiffFile(ctx context.Context, in *DiffFileRequest, opts ...grpc.CallOption) (API_DiffFileClient, error) {
-	stream, err := c.cc.NewStream(ctx, &API_ServiceDesc.Streams[13], API_DiffFile_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &API_ServiceDesc.Streams[14], API_DiffFile_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -803,7 +894,7 @@ func (c *aPIClient) DeleteAll(ctx context.Context, in *emptypb.Empty, opts ...gr
 }
 
 func (c *aPIClient) Fsck(ctx context.Context, in *FsckRequest, opts ...grpc.CallOption) (API_FsckClient, error) {
-	stream, err := c.cc.NewStream(ctx, &API_ServiceDesc.Streams[14], API_Fsck_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &API_ServiceDesc.Streams[15], API_Fsck_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -835,7 +926,7 @@ func (x *aPIFsckClient) Recv() (*FsckResponse, error) {
 }
 
 func (c *aPIClient) CreateFileSet(ctx context.Context, opts ...grpc.CallOption) (API_CreateFileSetClient, error) {
-	stream, err := c.cc.NewStream(ctx, &API_ServiceDesc.Streams[15], API_CreateFileSet_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &API_ServiceDesc.Streams[16], API_CreateFileSet_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -922,6 +1013,15 @@ func (c *aPIClient) CheckStorage(ctx context.Context, in *CheckStorageRequest, o
 	return out, nil
 }
 
+func (c *aPIClient) InspectStorage(ctx context.Context, in *InspectStorageRequest, opts ...grpc.CallOption) (*InspectStorageResponse, error) {
+	out := new(InspectStorageResponse)
+	err := c.cc.Invoke(ctx, API_InspectStorage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aPIClient) PutCache(ctx context.Context, in *PutCacheRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, API_PutCache_FullMethodName, in, out, opts...)
@@ -950,7 +1050,7 @@ func (c *aPIClient) ClearCache(ctx context.Context, in *ClearCacheRequest, opts
 }
 
 func (c *aPIClient) ListTask(ctx context.Context, in *task.ListTaskRequest, opts ...grpc.CallOption) (API_ListTaskClient, error) {
-	stream, err := c.cc.NewStream(ctx, &API_ServiceDesc.Streams[16], API_ListTask_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &API_ServiceDesc.Streams[17], API_ListTask_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1009,7 +1109,7 @@ func (c *aPIClient) InspectProject(ctx context.Context, in *InspectProjectReques
 }
 
 func (c *aPIClient) ListProject(ctx context.Context, in *ListProjectRequest, opts ...grpc.CallOption) (API_ListProjectClient, error) {
-	stream, err := c.cc.NewStream(ctx, &API_ServiceDesc.Streams[17], API_ListProject_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &API_ServiceDesc.Streams[18], API_ListProject_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1049,6 +1149,42 @@ func (c *aPIClient) DeleteProject(ctx context.Context, in *DeleteProjectRequest,
 	return out, nil
 }
 
+func (c *aPIClient) EditMetadata(ctx context.Context, in *EditMetadataRequest, opts ...grpc.CallOption) (*EditMetadataResponse, error) {
+	out := new(EditMetadataResponse)
+	err := c.cc.Invoke(ctx, API_EditMetadata_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) SetRetentionPolicy(ctx context.Context, in *SetRetentionPolicyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, API_SetRetentionPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) ApplyRetentionPolicy(ctx context.Context, in *ApplyRetentionPolicyRequest, opts ...grpc.CallOption) (*ApplyRetentionPolicyResponse, error) {
+	out := new(ApplyRetentionPolicyResponse)
+	err := c.cc.Invoke(ctx, API_ApplyRetentionPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) PurgePath(ctx context.Context, in *PurgePathRequest, opts ...grpc.CallOption) (*PurgePathResponse, error) {
+	out := new(PurgePathResponse)
+	err := c.cc.Invoke(ctx, API_PurgePath_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // APIServer is the server API for API service.
 // All implementations must embed UnimplementedAPIServer
 // for forward compatibility
@@ -1104,6 +1240,14 @@ type APIServer interface {
 	ListBranch(*ListBranchRequest, API_ListBranchServer) error
 	// DeleteBranch deletes a branch; note that the commits still exist.
 	DeleteBranch(context.Context, *DeleteBranchRequest) (*emptypb.Empty, error)
+	// CreateTag creates an immutable name for a commit.
+	CreateTag(context.Context, *CreateTagRequest) (*emptypb.Empty, error)
+	// InspectTag returns info about a tag.
+	InspectTag(context.Context, *InspectTagRequest) (*TagInfo, error)
+	// ListTag returns info about the tags in a repo.
+	ListTag(*ListTagRequest, API_ListTagServer) error
+	// DeleteTag deletes a tag; note that the commit still exists.
+	DeleteTag(context.Context, *DeleteTagRequest) (*emptypb.Empty, error)
 	// ModifyFile performs modifications on a set of files.
 	ModifyFile(API_ModifyFileServer) error
 	// GetFile returns the contents of a single file
@@ -1140,6 +1284,10 @@ type APIServer interface {
 	ShardFileSet(context.Context, *ShardFileSetRequest) (*ShardFileSetResponse, error)
 	// CheckStorage runs integrity checks for the storage layer.
 	CheckStorage(context.Context, *CheckStorageRequest) (*CheckStorageResponse, error)
+	// InspectStorage reports the storage used by each repo and project, and how
+	// much of it is saved by sharing chunks, as of the last time pachd computed
+	// it.
+	InspectStorage(context.Context, *InspectStorageRequest) (*InspectStorageResponse, error)
 	PutCache(context.Context, *PutCacheRequest) (*emptypb.Empty, error)
 	GetCache(context.Context, *GetCacheRequest) (*GetCacheResponse, error)
 	ClearCache(context.Context, *ClearCacheRequest) (*emptypb.Empty, error)
@@ -1156,6 +1304,17 @@ type APIServer interface {
 	ListProject(*ListProjectRequest, API_ListProjectServer) error
 	// DeleteProject deletes a project.
 	DeleteProject(context.Context, *DeleteProjectRequest) (*emptypb.Empty, error)
+	// EditMetadata edits the metadata of projects, repos, branches and commits.
+	EditMetadata(context.Context, *EditMetadataRequest) (*EditMetadataResponse, error)
+	// SetRetentionPolicy sets the retention policy of a repo or branch.
+	SetRetentionPolicy(context.Context, *SetRetentionPolicyRequest) (*emptypb.Empty, error)
+	// ApplyRetentionPolicy squashes commits that have expired under the
+	// retention policy, or previews which commits would be squashed.
+	ApplyRetentionPolicy(context.Context, *ApplyRetentionPolicyRequest) (*ApplyRetentionPolicyResponse, error)
+	// PurgePath permanently removes a path from every commit of a repo, and the
+	// output derived from it from the repos downstream.  It requires the cluster
+	// REPO_DELETE_COMMIT permission.
+	PurgePath(context.Context, *PurgePathRequest) (*PurgePathResponse, error)
 	mustEmbedUnimplementedAPIServer()
 }
 
@@ -1229,6 +1388,18 @@ func (UnimplementedAPIServer) ListBranch(*ListBranchRequest, API_ListBranchServe
 func (UnimplementedAPIServer) DeleteBranch(context.Context, *DeleteBranchRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteBranch not implemented")
 }
+func (UnimplementedAPIServer) CreateTag(context.Context, *CreateTagRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTag not implemented")
+}
+func (UnimplementedAPIServer) InspectTag(context.Context, *InspectTagRequest) (*TagInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InspectTag not implemented")
+}
+func (UnimplementedAPIServer) ListTag(*ListTagRequest, API_ListTagServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListTag not implemented")
+}
+func (UnimplementedAPIServer) DeleteTag(context.Context, *DeleteTagRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTag not implemented")
+}
 func (UnimplementedAPIServer) ModifyFile(API_ModifyFileServer) error {
 	return status.Errorf(codes.Unimplemented, "method ModifyFile not implemented")
 }
@@ -1283,6 +1454,9 @@ func (UnimplementedAPIServer) ShardFileSet(context.Context, *ShardFileSetRequest
 func (UnimplementedAPIServer) CheckStorage(context.Context, *CheckStorageRequest) (*CheckStorageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckStorage not implemented")
 }
+func (UnimplementedAPIServer) InspectStorage(context.Context, *InspectStorageRequest) (*InspectStorageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InspectStorage not implemented")
+}
 func (UnimplementedAPIServer) PutCache(context.Context, *PutCacheRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PutCache not implemented")
 }
@@ -1310,6 +1484,18 @@ func (UnimplementedAPIServer) ListProject(*ListProjectRequest, API_ListProjectSe
 func (UnimplementedAPIServer) DeleteProject(context.Context, *DeleteProjectRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteProject not implemented")
 }
+func (UnimplementedAPIServer) EditMetadata(context.Context, *EditMetadataRequest) (*EditMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditMetadata not implemented")
+}
+func (UnimplementedAPIServer) SetRetentionPolicy(context.Context, *SetRetentionPolicyRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRetentionPolicy not implemented")
+}
+func (UnimplementedAPIServer) ApplyRetentionPolicy(context.Context, *ApplyRetentionPolicyRequest) (*ApplyRetentionPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyRetentionPolicy not implemented")
+}
+func (UnimplementedAPIServer) PurgePath(context.Context, *PurgePathRequest) (*PurgePathResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgePath not implemented")
+}
 func (UnimplementedAPIServer) mustEmbedUnimplementedAPIServer() {}
 
 // UnsafeAPIServer may be embedded to opt out of forward compatibility for this service.
@@ -1740,6 +1926,81 @@ func _API_DeleteBranch_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _API_CreateTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).CreateTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_CreateTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).CreateTag(ctx, req.(*CreateTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_InspectTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InspectTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).InspectTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_InspectTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).InspectTag(ctx, req.(*InspectTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_ListTag_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListTagRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServer).ListTag(m, &aPIListTagServer{stream})
+}
+
+type API_ListTagServer interface {
+	Send(*TagInfo) error
+	grpc.ServerStream
+}
+
+type aPIListTagServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIListTagServer) Send(m *TagInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_DeleteTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).DeleteTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_DeleteTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DeleteTag(ctx, req.(*DeleteTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _API_ModifyFile_Handler(srv interface{}, stream grpc.ServerStream) error {
 	return srv.(APIServer).ModifyFile(&aPIModifyFileServer{stream})
 }
@@ -2101,6 +2362,24 @@ func _API_CheckStorage_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _API_InspectStorage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InspectStorageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).InspectStorage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_InspectStorage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).InspectStorage(ctx, req.(*InspectStorageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _API_PutCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PutCacheRequest)
 	if err := dec(in); err != nil {
@@ -2269,6 +2548,78 @@ func _API_DeleteProject_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _API_EditMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).EditMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_EditMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).EditMetadata(ctx, req.(*EditMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_SetRetentionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRetentionPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).SetRetentionPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_SetRetentionPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).SetRetentionPolicy(ctx, req.(*SetRetentionPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_ApplyRetentionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyRetentionPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).ApplyRetentionPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_ApplyRetentionPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ApplyRetentionPolicy(ctx, req.(*ApplyRetentionPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_PurgePath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgePathRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).PurgePath(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_PurgePath_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).PurgePath(ctx, req.(*PurgePathRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // API_ServiceDesc is the grpc.ServiceDesc for API service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -2336,6 +2687,18 @@ var API_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteBranch",
 			Handler:    _API_DeleteBranch_Handler,
 		},
+		{
+			MethodName: "CreateTag",
+			Handler:    _API_CreateTag_Handler,
+		},
+		{
+			MethodName: "InspectTag",
+			Handler:    _API_InspectTag_Handler,
+		},
+		{
+			MethodName: "DeleteTag",
+			Handler:    _API_DeleteTag_Handler,
+		},
 		{
 			MethodName: "InspectFile",
 			Handler:    _API_InspectFile_Handler,
@@ -2372,6 +2735,10 @@ var API_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CheckStorage",
 			Handler:    _API_CheckStorage_Handler,
 		},
+		{
+			MethodName: "InspectStorage",
+			Handler:    _API_InspectStorage_Handler,
+		},
 		{
 			MethodName: "PutCache",
 			Handler:    _API_PutCache_Handler,
@@ -2400,6 +2767,22 @@ var API_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteProject",
 			Handler:    _API_DeleteProject_Handler,
 		},
+		{
+			MethodName: "EditMetadata",
+			Handler:    _API_EditMetadata_Handler,
+		},
+		{
+			MethodName: "SetRetentionPolicy",
+			Handler:    _API_SetRetentionPolicy_Handler,
+		},
+		{
+			MethodName: "ApplyRetentionPolicy",
+			Handler:    _API_ApplyRetentionPolicy_Handler,
+		},
+		{
+			MethodName: "PurgePath",
+			Handler:    _API_PurgePath_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -2437,6 +2820,11 @@ var API_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _API_ListBranch_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ListTag",
+			Handler:       _API_ListTag_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "ModifyFile",
 			Handler:       _API_ModifyFile_Handler,
@@ -1219,6 +1219,35 @@ func (m *PFSInput) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetWindow()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PFSInputValidationError{
+					field:  "Window",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PFSInputValidationError{
+					field:  "Window",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWindow()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PFSInputValidationError{
+				field:  "Window",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return PFSInputMultiError(errors)
 	}
@@ -1296,6 +1325,136 @@ var _ interface {
 	ErrorName() string
 } = PFSInputValidationError{}
 
+// Validate checks the field values on PFSWindow with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PFSWindow) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PFSWindow with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PFSWindowMultiError, or nil
+// if none found.
+func (m *PFSWindow) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PFSWindow) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Commits
+
+	if all {
+		switch v := interface{}(m.GetDuration()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PFSWindowValidationError{
+					field:  "Duration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PFSWindowValidationError{
+					field:  "Duration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDuration()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PFSWindowValidationError{
+				field:  "Duration",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PFSWindowMultiError(errors)
+	}
+
+	return nil
+}
+
+// PFSWindowMultiError is an error wrapping multiple validation errors returned
+// by PFSWindow.ValidateAll() if the designated constraints aren't met.
+type PFSWindowMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PFSWindowMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PFSWindowMultiError) AllErrors() []error { return m }
+
+// PFSWindowValidationError is the validation error returned by
+// PFSWindow.Validate if the designated constraints aren't met.
+type PFSWindowValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PFSWindowValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PFSWindowValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PFSWindowValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PFSWindowValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PFSWindowValidationError) ErrorName() string { return "PFSWindowValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PFSWindowValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPFSWindow.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PFSWindowValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PFSWindowValidationError{}
+
 // Validate checks the field values on CronInput with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -1436,6 +1595,121 @@ var _ interface {
 	ErrorName() string
 } = CronInputValidationError{}
 
+// Validate checks the field values on BucketInput with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BucketInput) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BucketInput with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BucketInputMultiError, or
+// nil if none found.
+func (m *BucketInput) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BucketInput) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Project
+
+	// no validation rules for Repo
+
+	// no validation rules for Commit
+
+	// no validation rules for Url
+
+	// no validation rules for Spec
+
+	// no validation rules for Glob
+
+	// no validation rules for Secret
+
+	if len(errors) > 0 {
+		return BucketInputMultiError(errors)
+	}
+
+	return nil
+}
+
+// BucketInputMultiError is an error wrapping multiple validation errors
+// returned by BucketInput.ValidateAll() if the designated constraints aren't met.
+type BucketInputMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BucketInputMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BucketInputMultiError) AllErrors() []error { return m }
+
+// BucketInputValidationError is the validation error returned by
+// BucketInput.Validate if the designated constraints aren't met.
+type BucketInputValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BucketInputValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BucketInputValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BucketInputValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BucketInputValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BucketInputValidationError) ErrorName() string { return "BucketInputValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BucketInputValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBucketInput.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BucketInputValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BucketInputValidationError{}
+
 // Validate checks the field values on Input with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -1651,6 +1925,35 @@ func (m *Input) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetBucket()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InputValidationError{
+					field:  "Bucket",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InputValidationError{
+					field:  "Bucket",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBucket()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InputValidationError{
+				field:  "Bucket",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return InputMultiError(errors)
 	}
@@ -3751,6 +4054,46 @@ func (m *JobInfo) validate(all bool) error {
 
 	// no validation rules for DataRecovered
 
+	// no validation rules for DataQuarantined
+
+	// no validation rules for DataCacheHits
+
+	// no validation rules for DataCacheMisses
+
+	for idx, item := range m.GetWindowInputs() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobInfoValidationError{
+						field:  fmt.Sprintf("WindowInputs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobInfoValidationError{
+						field:  fmt.Sprintf("WindowInputs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobInfoValidationError{
+					field:  fmt.Sprintf("WindowInputs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if all {
 		switch v := interface{}(m.GetStats()).(type) {
 		case interface{ ValidateAll() error }:
@@ -5986,6 +6329,46 @@ func (m *UpdateJobStateRequest) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for DataQuarantined
+
+	// no validation rules for DataCacheHits
+
+	// no validation rules for DataCacheMisses
+
+	for idx, item := range m.GetWindowInputs() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpdateJobStateRequestValidationError{
+						field:  fmt.Sprintf("WindowInputs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpdateJobStateRequestValidationError{
+						field:  fmt.Sprintf("WindowInputs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateJobStateRequestValidationError{
+					field:  fmt.Sprintf("WindowInputs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return UpdateJobStateRequestMultiError(errors)
 	}
@@ -7028,6 +7411,168 @@ var _ interface {
 	ErrorName() string
 } = DatumSetSpecValidationError{}
 
+// Validate checks the field values on DatumRetryPolicy with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DatumRetryPolicy) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DatumRetryPolicy with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DatumRetryPolicyMultiError, or nil if none found.
+func (m *DatumRetryPolicy) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DatumRetryPolicy) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetBackoff()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DatumRetryPolicyValidationError{
+					field:  "Backoff",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DatumRetryPolicyValidationError{
+					field:  "Backoff",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBackoff()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DatumRetryPolicyValidationError{
+				field:  "Backoff",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for BackoffMultiplier
+
+	if all {
+		switch v := interface{}(m.GetMaxBackoff()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DatumRetryPolicyValidationError{
+					field:  "MaxBackoff",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DatumRetryPolicyValidationError{
+					field:  "MaxBackoff",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMaxBackoff()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DatumRetryPolicyValidationError{
+				field:  "MaxBackoff",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for OnFailure
+
+	if len(errors) > 0 {
+		return DatumRetryPolicyMultiError(errors)
+	}
+
+	return nil
+}
+
+// DatumRetryPolicyMultiError is an error wrapping multiple validation errors
+// returned by DatumRetryPolicy.ValidateAll() if the designated constraints
+// aren't met.
+type DatumRetryPolicyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DatumRetryPolicyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DatumRetryPolicyMultiError) AllErrors() []error { return m }
+
+// DatumRetryPolicyValidationError is the validation error returned by
+// DatumRetryPolicy.Validate if the designated constraints aren't met.
+type DatumRetryPolicyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DatumRetryPolicyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DatumRetryPolicyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DatumRetryPolicyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DatumRetryPolicyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DatumRetryPolicyValidationError) ErrorName() string { return "DatumRetryPolicyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DatumRetryPolicyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDatumRetryPolicy.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DatumRetryPolicyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DatumRetryPolicyValidationError{}
+
 // Validate checks the field values on SchedulingSpec with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -7936,6 +8481,37 @@ func (m *CreatePipelineRequest) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetDatumRetryPolicy()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreatePipelineRequestValidationError{
+					field:  "DatumRetryPolicy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreatePipelineRequestValidationError{
+					field:  "DatumRetryPolicy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDatumRetryPolicy()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreatePipelineRequestValidationError{
+				field:  "DatumRetryPolicy",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for CacheDatumOutput
+
 	if len(errors) > 0 {
 		return CreatePipelineRequestMultiError(errors)
 	}
@@ -13398,6 +13974,37 @@ func (m *JobInfo_Details) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetDatumRetryPolicy()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobInfo_DetailsValidationError{
+					field:  "DatumRetryPolicy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JobInfo_DetailsValidationError{
+					field:  "DatumRetryPolicy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDatumRetryPolicy()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobInfo_DetailsValidationError{
+				field:  "DatumRetryPolicy",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for CacheDatumOutput
+
 	if len(errors) > 0 {
 		return JobInfo_DetailsMultiError(errors)
 	}
@@ -14142,6 +14749,37 @@ func (m *PipelineInfo_Details) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetDatumRetryPolicy()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PipelineInfo_DetailsValidationError{
+					field:  "DatumRetryPolicy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PipelineInfo_DetailsValidationError{
+					field:  "DatumRetryPolicy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDatumRetryPolicy()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PipelineInfo_DetailsValidationError{
+				field:  "DatumRetryPolicy",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for CacheDatumOutput
+
 	if len(errors) > 0 {
 		return PipelineInfo_DetailsMultiError(errors)
 	}
@@ -187,6 +187,16 @@ func (x *PFSInput) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	enc.AddBool("empty_files", x.EmptyFiles)
 	enc.AddBool("s3", x.S3)
 	enc.AddObject("trigger", x.Trigger)
+	enc.AddObject("window", x.Window)
+	return nil
+}
+
+func (x *PFSWindow) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddInt64("commits", x.Commits)
+	protoextensions.AddDuration(enc, "duration", x.Duration)
 	return nil
 }
 
@@ -204,6 +214,21 @@ func (x *CronInput) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	return nil
 }
 
+func (x *BucketInput) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddString("name", x.Name)
+	enc.AddString("project", x.Project)
+	enc.AddString("repo", x.Repo)
+	enc.AddString("commit", x.Commit)
+	enc.AddString("url", x.Url)
+	enc.AddString("spec", x.Spec)
+	enc.AddString("glob", x.Glob)
+	enc.AddString("secret", x.Secret)
+	return nil
+}
+
 func (x *Input) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	if x == nil {
 		return nil
@@ -238,6 +263,7 @@ func (x *Input) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	}
 	enc.AddArray("union", zapcore.ArrayMarshalerFunc(unionArrMarshaller))
 	enc.AddObject("cron", x.Cron)
+	enc.AddObject("bucket", x.Bucket)
 	return nil
 }
 
@@ -406,6 +432,16 @@ func (x *JobInfo) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	enc.AddInt64("data_total", x.DataTotal)
 	enc.AddInt64("data_failed", x.DataFailed)
 	enc.AddInt64("data_recovered", x.DataRecovered)
+	enc.AddInt64("data_quarantined", x.DataQuarantined)
+	enc.AddInt64("data_cache_hits", x.DataCacheHits)
+	enc.AddInt64("data_cache_misses", x.DataCacheMisses)
+	window_inputsArrMarshaller := func(enc zapcore.ArrayEncoder) error {
+		for _, v := range x.WindowInputs {
+			enc.AppendObject(v)
+		}
+		return nil
+	}
+	enc.AddArray("window_inputs", zapcore.ArrayMarshalerFunc(window_inputsArrMarshaller))
 	enc.AddObject("stats", x.Stats)
 	enc.AddString("state", x.State.String())
 	enc.AddString("reason", x.Reason)
@@ -446,6 +482,8 @@ func (x *JobInfo_Details) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	enc.AddString("pod_spec", x.PodSpec)
 	enc.AddString("pod_patch", x.PodPatch)
 	enc.AddObject("sidecar_resource_requests", x.SidecarResourceRequests)
+	enc.AddObject("datum_retry_policy", x.DatumRetryPolicy)
+	enc.AddBool("cache_datum_output", x.CacheDatumOutput)
 	return nil
 }
 
@@ -545,6 +583,8 @@ func (x *PipelineInfo_Details) MarshalLogObject(enc zapcore.ObjectEncoder) error
 	enc.AddObject("determined", x.Determined)
 	protoextensions.AddDuration(enc, "maximum_expected_uptime", x.MaximumExpectedUptime)
 	protoextensions.AddTimestamp(enc, "workers_started_at", x.WorkersStartedAt)
+	enc.AddObject("datum_retry_policy", x.DatumRetryPolicy)
+	enc.AddBool("cache_datum_output", x.CacheDatumOutput)
 	return nil
 }
 
@@ -677,6 +717,16 @@ func (x *UpdateJobStateRequest) MarshalLogObject(enc zapcore.ObjectEncoder) erro
 	enc.AddInt64("data_recovered", x.DataRecovered)
 	enc.AddInt64("data_total", x.DataTotal)
 	enc.AddObject("stats", x.Stats)
+	enc.AddInt64("data_quarantined", x.DataQuarantined)
+	enc.AddInt64("data_cache_hits", x.DataCacheHits)
+	enc.AddInt64("data_cache_misses", x.DataCacheMisses)
+	window_inputsArrMarshaller := func(enc zapcore.ArrayEncoder) error {
+		for _, v := range x.WindowInputs {
+			enc.AppendObject(v)
+		}
+		return nil
+	}
+	enc.AddArray("window_inputs", zapcore.ArrayMarshalerFunc(window_inputsArrMarshaller))
 	return nil
 }
 
@@ -785,6 +835,24 @@ func (x *DatumSetSpec) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	return nil
 }
 
+func (x *DatumRetryPolicy) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	protoextensions.AddDuration(enc, "backoff", x.Backoff)
+	enc.AddFloat64("backoff_multiplier", x.BackoffMultiplier)
+	protoextensions.AddDuration(enc, "max_backoff", x.MaxBackoff)
+	retry_exit_codesArrMarshaller := func(enc zapcore.ArrayEncoder) error {
+		for _, v := range x.RetryExitCodes {
+			enc.AppendInt32(v)
+		}
+		return nil
+	}
+	enc.AddArray("retry_exit_codes", zapcore.ArrayMarshalerFunc(retry_exit_codesArrMarshaller))
+	enc.AddString("on_failure", x.OnFailure.String())
+	return nil
+}
+
 func (x *SchedulingSpec) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	if x == nil {
 		return nil
@@ -851,6 +919,8 @@ func (x *CreatePipelineRequest) MarshalLogObject(enc zapcore.ObjectEncoder) erro
 	enc.AddBool("dry_run", x.DryRun)
 	enc.AddObject("determined", x.Determined)
 	protoextensions.AddDuration(enc, "maximum_expected_uptime", x.MaximumExpectedUptime)
+	enc.AddObject("datum_retry_policy", x.DatumRetryPolicy)
+	enc.AddBool("cache_datum_output", x.CacheDatumOutput)
 	return nil
 }
 
@@ -148,6 +148,20 @@ func ProjectInputBranches(projectName string, input *Input) []*pfs.Branch {
 			}
 			result = append(result, b)
 		}
+		if input.Bucket != nil {
+			b := &pfs.Branch{
+				Repo: &pfs.Repo{
+					Project: &pfs.Project{Name: input.Bucket.Project},
+					Name:    input.Bucket.Repo,
+					Type:    pfs.UserRepoType,
+				},
+				Name: "master",
+			}
+			if input.Bucket.Project == "" {
+				b.Repo.Project.Name = projectName
+			}
+			result = append(result, b)
+		}
 		return nil
 	})
 	return result
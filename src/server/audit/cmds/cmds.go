@@ -0,0 +1,127 @@
+package cmds
+
+import (
+	"context"
+	"io"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pachyderm/pachyderm/v2/src/audit"
+	"github.com/pachyderm/pachyderm/v2/src/internal/cmdutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachctl"
+	"github.com/pachyderm/pachyderm/v2/src/internal/tabwriter"
+	"github.com/pachyderm/pachyderm/v2/src/server/audit/pretty"
+)
+
+// Cmds returns the set of commands used for reading the audit log with the Pachyderm CLI tool
+// pachctl.
+func Cmds(mainCtx context.Context, pachctlCfg *pachctl.Config) []*cobra.Command {
+	var commands []*cobra.Command
+
+	var raw bool
+	var output string
+	outputFlags := cmdutil.OutputFlags(&raw, &output)
+
+	auditDocs := &cobra.Command{
+		Short: "Read the audit log.",
+		Long: "The audit log records every API call that changes the state of the cluster, " +
+			"along with the principal that made it, the resource it acted on and its result. " +
+			"Reading it requires the auditLogReader role.",
+	}
+	commands = append(commands, cmdutil.CreateDocsAlias(auditDocs, "audit", " audit$"))
+
+	var principal, resource, since, until string
+	var limit int64
+	listEvent := &cobra.Command{
+		Short: "Return events from the audit log.",
+		Long: "This command returns events from the audit log, newest first. " +
+			"Filtering by resource also returns events for the resources beneath it, so `--resource default/images` " +
+			"includes events for the branches, commits and files of that repo.",
+		Example: `
+# Return the events of the last day
+$ {{alias}} --since 24h
+
+# Return everything robot:ci did to the images repo in the default project
+$ {{alias}} --principal robot:ci --resource default/images
+
+# Return the events of a given time range
+$ {{alias}} --since 2024-05-01T00:00:00Z --until 2024-05-02T00:00:00Z`,
+		Run: cmdutil.RunFixedArgs(0, func([]string) error {
+			if !raw && output != "" {
+				return errors.New("cannot set --output (-o) without --raw")
+			}
+			req := &audit.ListEventRequest{
+				Principal: principal,
+				Resource:  resource,
+				Limit:     limit,
+			}
+			var err error
+			if req.Since, err = parseTime(since); err != nil {
+				return errors.Wrap(err, "parse --since")
+			}
+			if req.Until, err = parseTime(until); err != nil {
+				return errors.Wrap(err, "parse --until")
+			}
+			c, err := pachctlCfg.NewOnUserMachine(mainCtx, false)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			events, err := c.Audit.ListEvent(c.Ctx(), req)
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			encoder := cmdutil.Encoder(output, os.Stdout)
+			writer := tabwriter.NewWriter(os.Stdout, pretty.EventHeader)
+			for {
+				e, err := events.Recv()
+				if errors.Is(err, io.EOF) {
+					break
+				} else if err != nil {
+					return grpcutil.ScrubGRPC(err)
+				}
+				if raw {
+					if err := encoder.EncodeProto(e); err != nil {
+						return errors.EnsureStack(err)
+					}
+					continue
+				}
+				pretty.PrintEvent(writer, e)
+			}
+			if raw {
+				return nil
+			}
+			return writer.Flush()
+		}),
+	}
+	listEvent.Flags().StringVar(&principal, "principal", "", "Only return events for calls made by this principal, e.g. user:alice@example.com.")
+	listEvent.Flags().StringVar(&resource, "resource", "", "Only return events for this resource and the resources beneath it, e.g. default/images@master.")
+	listEvent.Flags().StringVar(&since, "since", "", "Only return events at or after this time, given in RFC 3339 format or as a duration before now, e.g. 1h.")
+	listEvent.Flags().StringVar(&until, "until", "", "Only return events before this time, given in RFC 3339 format or as a duration before now, e.g. 1h.")
+	listEvent.Flags().Int64Var(&limit, "limit", 100, "Return at most this many events. 0 returns all matching events.")
+	listEvent.Flags().AddFlagSet(outputFlags)
+	commands = append(commands, cmdutil.CreateAlias(listEvent, "audit list"))
+
+	return commands
+}
+
+// parseTime parses a time given either in RFC 3339 format or as a duration before now.  The empty
+// string is parsed as no time.
+func parseTime(s string) (*timestamppb.Timestamp, error) {
+	if s == "" {
+		return nil, nil
+	}
+	if d, err := time.ParseDuration(s); err == nil {
+		return timestamppb.New(time.Now().Add(-d)), nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return nil, errors.Errorf("%q is neither an RFC 3339 time nor a duration", s)
+	}
+	return timestamppb.New(t), nil
+}
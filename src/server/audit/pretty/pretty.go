@@ -0,0 +1,30 @@
+package pretty
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/pachyderm/pachyderm/v2/src/audit"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pretty"
+)
+
+// EventHeader is the header for audit events.
+const EventHeader = "TIME\tPRINCIPAL\tMETHOD\tRESOURCE\tCODE\t\n"
+
+// PrintEvent prints a short summary of an audit event to the provided device.
+func PrintEvent(w io.Writer, e *audit.Event) {
+	fmt.Fprintf(w, "%s\t", pretty.Ago(e.Time))
+	fmt.Fprintf(w, "%s\t", orDash(e.Principal))
+	fmt.Fprintf(w, "%s\t", strings.TrimPrefix(e.Method, "/"))
+	fmt.Fprintf(w, "%s\t", orDash(e.Resource))
+	fmt.Fprintf(w, "%s\t", e.Code)
+	fmt.Fprintln(w)
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
@@ -0,0 +1,26 @@
+// Package server implements the audit API, which serves the audit log recorded by the audit
+// interceptor.
+package server
+
+import (
+	"github.com/pachyderm/pachyderm/v2/src/audit"
+	"github.com/pachyderm/pachyderm/v2/src/internal/auditdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+)
+
+type apiServer struct {
+	audit.UnsafeAPIServer
+	env Env
+}
+
+// NewAPIServer creates a new audit API server.
+func NewAPIServer(env Env) audit.APIServer {
+	return &apiServer{env: env}
+}
+
+// ListEvent implements the audit.ListEvent RPC.
+func (a *apiServer) ListEvent(req *audit.ListEventRequest, srv audit.API_ListEventServer) error {
+	return auditdb.ListEvents(srv.Context(), a.env.DB, req, func(e *auditdb.Event) error {
+		return errors.EnsureStack(srv.Send(e.ToProto()))
+	})
+}
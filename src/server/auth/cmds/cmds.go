@@ -947,6 +947,108 @@ func RolesForPermissionCmd(ctx context.Context, pachctlCfg *pachctl.Config) *cob
 	return cmdutil.CreateAlias(rotateRootToken, "auth roles-for-permission")
 }
 
+// CreateRoleCmd returns a cobra command that creates a custom role
+func CreateRoleCmd(ctx context.Context, pachctlCfg *pachctl.Config) *cobra.Command {
+	var permissions, boundTo []string
+	createRole := &cobra.Command{
+		Use:   "{{alias}} <role>",
+		Short: "Create a custom role",
+		Long: "This command creates a custom role that grants exactly the given permissions. " +
+			"Custom roles are bound to principals with the 'auth set' commands, like the built-in roles.",
+		Example: "\t- {{alias}} repoAppender --permission repo_read --permission repo_write --bound-to repo" +
+			"\t- {{alias}} auditor --permission cluster_get_audit_log --bound-to cluster",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			role := &auth.Role{Name: args[0]}
+			for _, p := range permissions {
+				permission, ok := auth.Permission_value[strings.ToUpper(p)]
+				if !ok {
+					return errors.Errorf("unknown permission %q", p)
+				}
+				role.Permissions = append(role.Permissions, auth.Permission(permission))
+			}
+			for _, t := range boundTo {
+				resourceType, ok := auth.ResourceType_value[strings.ToUpper(t)]
+				if !ok {
+					return errors.Errorf("unknown resource type %q", t)
+				}
+				role.CanBeBoundTo = append(role.CanBeBoundTo, auth.ResourceType(resourceType))
+			}
+			c, err := pachctlCfg.NewOnUserMachine(ctx, false)
+			if err != nil {
+				return errors.Wrapf(err, "could not connect")
+			}
+			defer c.Close()
+			if _, err := c.CreateRole(c.Ctx(), &auth.CreateRoleRequest{Role: role}); err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			return nil
+		}),
+	}
+	createRole.Flags().StringSliceVar(&permissions, "permission", nil, "A permission granted by the role, e.g. repo_write. May be repeated.")
+	createRole.Flags().StringSliceVar(&boundTo, "bound-to", nil, "A type of resource (cluster, project or repo) the role can be bound to. May be repeated. Defaults to all three.")
+	return cmdutil.CreateAliases(createRole, "auth create role", "roles")
+}
+
+// ListRoleCmd returns a cobra command that lists the built-in and custom roles
+func ListRoleCmd(ctx context.Context, pachctlCfg *pachctl.Config) *cobra.Command {
+	var verbose bool
+	listRole := &cobra.Command{
+		Short: "List the built-in and custom roles",
+		Long:  "This command lists the built-in and custom roles, and the resources each can be bound to.",
+		Run: cmdutil.RunFixedArgs(0, func([]string) error {
+			c, err := pachctlCfg.NewOnUserMachine(ctx, false)
+			if err != nil {
+				return errors.Wrapf(err, "could not connect")
+			}
+			defer c.Close()
+			resp, err := c.ListRole(c.Ctx(), &auth.ListRoleRequest{})
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			for _, r := range resp.Roles {
+				kind := "built-in"
+				if r.Custom {
+					kind = "custom"
+				}
+				var types []string
+				for _, t := range r.CanBeBoundTo {
+					types = append(types, strings.ToLower(t.String()))
+				}
+				fmt.Printf("%s (%s): %s\n", r.Name, kind, strings.Join(types, ", "))
+				if verbose {
+					for _, p := range r.Permissions {
+						fmt.Printf("\t%s\n", strings.ToLower(p.String()))
+					}
+				}
+			}
+			return nil
+		}),
+	}
+	listRole.Flags().BoolVarP(&verbose, "verbose", "v", false, "Also list the permissions granted by each role.")
+	return cmdutil.CreateAliases(listRole, "auth list role", "roles")
+}
+
+// DeleteRoleCmd returns a cobra command that deletes a custom role
+func DeleteRoleCmd(ctx context.Context, pachctlCfg *pachctl.Config) *cobra.Command {
+	deleteRole := &cobra.Command{
+		Use:   "{{alias}} <role>",
+		Short: "Delete a custom role",
+		Long:  "This command deletes a custom role. A role cannot be deleted while it is bound to any principal.",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			c, err := pachctlCfg.NewOnUserMachine(ctx, false)
+			if err != nil {
+				return errors.Wrapf(err, "could not connect")
+			}
+			defer c.Close()
+			if _, err := c.DeleteRole(c.Ctx(), &auth.DeleteRoleRequest{Name: args[0]}); err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			return nil
+		}),
+	}
+	return cmdutil.CreateAliases(deleteRole, "auth delete role", "roles")
+}
+
 // Cmds returns a list of cobra commands for authenticating and authorizing
 // users in an auth-enabled Pachyderm cluster.
 func Cmds(mainCtx context.Context, pachCtx *config.Context, pachctlCfg *pachctl.Config) []*cobra.Command {
@@ -972,7 +1074,25 @@ func Cmds(mainCtx context.Context, pachCtx *config.Context, pachctlCfg *pachctl.
 		Long:  "Check whether a subject has a permission on a resource",
 	}
 
+	create := &cobra.Command{
+		Short: "Create a custom auth resource",
+		Long:  "Create a custom auth resource",
+	}
+
+	list := &cobra.Command{
+		Short: "List auth resources",
+		Long:  "List auth resources",
+	}
+
+	deleteCmd := &cobra.Command{
+		Short: "Delete a custom auth resource",
+		Long:  "Delete a custom auth resource",
+	}
+
 	commands = append(commands, cmdutil.CreateAlias(auth, "auth"))
+	commands = append(commands, cmdutil.CreateAlias(create, "auth create"))
+	commands = append(commands, cmdutil.CreateAlias(list, "auth list"))
+	commands = append(commands, cmdutil.CreateAlias(deleteCmd, "auth delete"))
 	commands = append(commands, cmdutil.CreateAlias(get, "auth get"))
 	commands = append(commands, cmdutil.CreateAlias(set, "auth set"))
 	commands = append(commands, cmdutil.CreateAlias(check, "auth check"))
@@ -999,5 +1119,8 @@ func Cmds(mainCtx context.Context, pachCtx *config.Context, pachctlCfg *pachctl.
 	commands = append(commands, SetEnterpriseRoleBindingCmd(mainCtx, pachctlCfg))
 	commands = append(commands, RotateRootToken(mainCtx, pachctlCfg))
 	commands = append(commands, RolesForPermissionCmd(mainCtx, pachctlCfg))
+	commands = append(commands, CreateRoleCmd(mainCtx, pachctlCfg))
+	commands = append(commands, ListRoleCmd(mainCtx, pachctlCfg))
+	commands = append(commands, DeleteRoleCmd(mainCtx, pachctlCfg))
 	return commands
 }
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"os"
 	"path"
+	"sort"
 	"strings"
 	"time"
 
@@ -72,6 +73,8 @@ type apiServer struct {
 	members col.PostgresCollection
 	// groups is a collection of group -> usernames mappings.
 	groups col.PostgresCollection
+	// customRoles is a collection of role name -> role definitions for roles created with CreateRole.
+	customRoles col.PostgresCollection
 	// collection containing the auth config (under the key configKey)
 	authConfig col.PostgresCollection
 	// oidcStates  contains the set of OIDC nonces for requests that are in progress
@@ -107,6 +110,7 @@ func NewAuthServer(env Env, public, requireNoncriticalServers, watchesEnabled bo
 		roleBindings:   authdb.RoleBindingCollection(env.DB, env.Listener),
 		members:        authdb.MembersCollection(env.DB, env.Listener),
 		groups:         authdb.GroupsCollection(env.DB, env.Listener),
+		customRoles:    authdb.RoleCollection(env.DB, env.Listener),
 		oidcStates:     oidcStates,
 		public:         public,
 		watchesEnabled: watchesEnabled,
@@ -595,6 +599,9 @@ func (a *apiServer) Deactivate(ctx context.Context, req *auth.DeactivateRequest)
 		if err := a.groups.ReadWrite(sqlTx).DeleteAll(); err != nil {
 			return errors.EnsureStack(err)
 		}
+		if err := a.customRoles.ReadWrite(sqlTx).DeleteAll(); err != nil {
+			return errors.EnsureStack(err)
+		}
 		if err := a.authConfig.ReadWrite(sqlTx).DeleteAll(); err != nil {
 			return errors.EnsureStack(err)
 		}
@@ -693,7 +700,7 @@ func (a *apiServer) Authenticate(ctx context.Context, req *auth.AuthenticateRequ
 }
 
 func (a *apiServer) evaluateRoleBindingInTransaction(txnCtx *txncontext.TransactionContext, principal string, resource *auth.Resource, permissions map[auth.Permission]bool) (*authorizeRequest, error) {
-	request := newAuthorizeRequest(principal, permissions, a.getGroupsInTransaction)
+	request := newAuthorizeRequest(principal, permissions, a.getGroupsInTransaction, a.getRoleInTransaction)
 
 	// Special-case making spec repos world-readable, because the alternative breaks reading pipelines.
 	// TOOD: 2.0 - should we make this a user-configurable cluster binding instead of hard-coding it?
@@ -916,24 +923,39 @@ func (a *apiServer) DeleteRoleBindingInTransaction(txnCtx *txncontext.Transactio
 	return nil
 }
 
-// rolesFromRoleSlice converts a slice of strings into *auth.Roles,
-// validating that each role name is valid.
-func rolesFromRoleSlice(rs []string) (*auth.Roles, error) {
-	if len(rs) == 0 {
-		return nil, nil
-	}
-
+// rolesFromRoleSliceInTransaction converts a slice of strings into *auth.Roles,
+// validating that each role name is a built-in role, or a custom role that can
+// be bound to resource.  Custom roles are locked until the transaction ends, so
+// that they can't be deleted while they are being bound.
+func (a *apiServer) rolesFromRoleSliceInTransaction(txnCtx *txncontext.TransactionContext, resource *auth.Resource, rs []string) (*auth.Roles, error) {
 	for _, r := range rs {
-		if _, err := getRole(r); err != nil {
+		if _, ok := roles[r]; ok {
+			continue
+		}
+		if err := lockCustomRole(txnCtx, r); err != nil {
+			return nil, err
+		}
+		role, err := a.getRoleInTransaction(txnCtx, r)
+		if err != nil {
 			return nil, err
 		}
+		if !resourceTypeIn(resource.Type, role.CanBeBoundTo) {
+			return nil, errors.Errorf("role %q cannot be bound to resources of type %v", r, resource.Type)
+		}
 	}
+	return rolesFromRoleSlice(rs), nil
+}
 
+// rolesFromRoleSlice converts a slice of strings into *auth.Roles.
+func rolesFromRoleSlice(rs []string) *auth.Roles {
+	if len(rs) == 0 {
+		return nil
+	}
 	roles := &auth.Roles{Roles: make(map[string]bool)}
 	for _, r := range rs {
 		roles.Roles[r] = true
 	}
-	return roles, nil
+	return roles
 }
 
 // CreateRoleBindingInTransaction is an internal-only API to create a role binding for a new resource.
@@ -950,7 +972,7 @@ func (a *apiServer) CreateRoleBindingInTransaction(txnCtx *txncontext.Transactio
 			return err
 		}
 
-		roles, err := rolesFromRoleSlice(roleSlice)
+		roles, err := a.rolesFromRoleSliceInTransaction(txnCtx, resource, roleSlice)
 		if err != nil {
 			return err
 		}
@@ -1084,7 +1106,7 @@ func (a *apiServer) ModifyRoleBindingInTransaction(
 }
 
 func (a *apiServer) setUserRoleBindingInTransaction(txnCtx *txncontext.TransactionContext, resource *auth.Resource, principal string, roleSlice []string) error {
-	roles, err := rolesFromRoleSlice(roleSlice)
+	roles, err := a.rolesFromRoleSliceInTransaction(txnCtx, resource, roleSlice)
 	if err != nil {
 		return err
 	}
@@ -1126,10 +1148,7 @@ func (a *apiServer) ModifyRoleBinding(ctx context.Context, req *auth.ModifyRoleB
 
 	// If the request is not in a transaction, block until the cache is updated
 	if req.Resource.Type == auth.ResourceType_CLUSTER {
-		expected, err := rolesFromRoleSlice(req.Roles)
-		if err != nil {
-			return nil, err
-		}
+		expected := rolesFromRoleSlice(req.Roles)
 		if err := backoff.Retry(func() error {
 			bindings, ok := a.clusterRoleBindingCache.Load().(*auth.RoleBinding)
 			if !ok {
@@ -1476,7 +1495,100 @@ func (a *apiServer) GetUsers(ctx context.Context, req *auth.GetUsersRequest) (re
 
 // GetRolesForPermission implements the protobuf auth.GetRolesForPermission RPC
 func (a *apiServer) GetRolesForPermission(ctx context.Context, req *auth.GetRolesForPermissionRequest) (resp *auth.GetRolesForPermissionResponse, retErr error) {
-	return &auth.GetRolesForPermissionResponse{Roles: rolesForPermission(req.Permission)}, nil
+	customRoles, err := a.listCustomRoles(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &auth.GetRolesForPermissionResponse{Roles: rolesForPermission(req.Permission, customRoles)}, nil
+}
+
+// CreateRole implements the protobuf auth.CreateRole RPC
+func (a *apiServer) CreateRole(ctx context.Context, req *auth.CreateRoleRequest) (resp *auth.CreateRoleResponse, retErr error) {
+	if err := a.isActive(ctx); err != nil {
+		return nil, err
+	}
+	role := proto.Clone(req.Role).(*auth.Role)
+	if err := validateCustomRole(role); err != nil {
+		return nil, err
+	}
+	if err := dbutil.WithTx(ctx, a.env.DB, func(ctx context.Context, sqlTx *pachsql.Tx) error {
+		if err := a.customRoles.ReadWrite(sqlTx).Create(role.Name, role); err != nil {
+			if col.IsErrExists(err) {
+				return errors.Errorf("role %q already exists", role.Name)
+			}
+			return errors.EnsureStack(err)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return &auth.CreateRoleResponse{}, nil
+}
+
+// ListRole implements the protobuf auth.ListRole RPC
+func (a *apiServer) ListRole(ctx context.Context, req *auth.ListRoleRequest) (resp *auth.ListRoleResponse, retErr error) {
+	customRoles, err := a.listCustomRoles(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp = &auth.ListRoleResponse{}
+	for _, r := range roles {
+		resp.Roles = append(resp.Roles, r.role)
+	}
+	sort.Slice(resp.Roles, func(i, j int) bool { return resp.Roles[i].Name < resp.Roles[j].Name })
+	resp.Roles = append(resp.Roles, customRoles...)
+	return resp, nil
+}
+
+// DeleteRole implements the protobuf auth.DeleteRole RPC
+func (a *apiServer) DeleteRole(ctx context.Context, req *auth.DeleteRoleRequest) (resp *auth.DeleteRoleResponse, retErr error) {
+	if err := a.isActive(ctx); err != nil {
+		return nil, err
+	}
+	if _, ok := roles[req.Name]; ok {
+		return nil, errors.Errorf("cannot delete built-in role %q", req.Name)
+	}
+	if err := dbutil.WithTx(ctx, a.env.DB, func(ctx context.Context, sqlTx *pachsql.Tx) error {
+		// The role is deleted before its bindings are checked: deleting it waits for any
+		// transaction binding it to commit, so that binding is seen by the check.
+		if err := a.customRoles.ReadWrite(sqlTx).Delete(req.Name); err != nil {
+			if col.IsErrNotFound(err) {
+				return errors.Errorf("unknown role %q", req.Name)
+			}
+			return errors.EnsureStack(err)
+		}
+		// Refuse to delete a role that is still bound, as authorization would fail for every
+		// principal bound to it.
+		var binding auth.RoleBinding
+		if err := a.roleBindings.ReadWrite(sqlTx).List(&binding, col.DefaultOptions(), func(key string) error {
+			for principal, rs := range binding.Entries {
+				if rs.GetRoles()[req.Name] {
+					return errors.Errorf("role %q is bound to %s on %s", req.Name, principal, key)
+				}
+			}
+			return nil
+		}); err != nil {
+			return errors.EnsureStack(err)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return &auth.DeleteRoleResponse{}, nil
+}
+
+// listCustomRoles returns all custom roles, sorted by name.
+func (a *apiServer) listCustomRoles(ctx context.Context) ([]*auth.Role, error) {
+	var customRoles []*auth.Role
+	role := &auth.Role{}
+	if err := a.customRoles.ReadOnly(ctx).List(role, col.DefaultOptions(), func(string) error {
+		customRoles = append(customRoles, proto.Clone(role).(*auth.Role))
+		return nil
+	}); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	sort.Slice(customRoles, func(i, j int) bool { return customRoles[i].Name < customRoles[j].Name })
+	return customRoles, nil
 }
 
 func setToList(set map[string]bool) []string {
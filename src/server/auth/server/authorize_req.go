@@ -10,6 +10,8 @@ import (
 
 type groupLookupFn func(txnCtx *txncontext.TransactionContext, subject string) ([]string, error)
 
+type roleLookupFn func(txnCtx *txncontext.TransactionContext, name string) (*auth.Role, error)
+
 // authorizeRequest is a helper struct used to evaluate an incoming Authorize request.
 // It's initialized with the subject and set of permissions required for an Operation,
 // and it looks at role bindings to figure out which permissions are satisfied.
@@ -23,14 +25,16 @@ type authorizeRequest struct {
 	satisfiedPermissions []auth.Permission
 	groupsForSubject     groupLookupFn
 	groups               []string
+	getRole              roleLookupFn
 }
 
-func newAuthorizeRequest(subject string, permissions map[auth.Permission]bool, groupsForSubject groupLookupFn) *authorizeRequest {
+func newAuthorizeRequest(subject string, permissions map[auth.Permission]bool, groupsForSubject groupLookupFn, getRole roleLookupFn) *authorizeRequest {
 	return &authorizeRequest{
 		subject:              subject,
 		roleMap:              make(map[string]*auth.Role),
 		permissions:          permissions,
 		groupsForSubject:     groupsForSubject,
+		getRole:              getRole,
 		satisfiedPermissions: make([]auth.Permission, 0),
 	}
 }
@@ -74,7 +78,7 @@ func (r *authorizeRequest) missing() []auth.Permission {
 // - role bindings that refer to allClusterUsers
 // - role bindings that refer to any group the subject belongs to
 func (r *authorizeRequest) evaluateRoleBinding(txnCtx *txncontext.TransactionContext, binding *auth.RoleBinding) error {
-	if err := r.evaluateRoleBindingForSubject(r.subject, binding); err != nil {
+	if err := r.evaluateRoleBindingForSubject(txnCtx, r.subject, binding); err != nil {
 		return err
 	}
 
@@ -82,7 +86,7 @@ func (r *authorizeRequest) evaluateRoleBinding(txnCtx *txncontext.TransactionCon
 		return nil
 	}
 
-	if err := r.evaluateRoleBindingForSubject(auth.AllClusterUsersSubject, binding); err != nil {
+	if err := r.evaluateRoleBindingForSubject(txnCtx, auth.AllClusterUsersSubject, binding); err != nil {
 		return err
 	}
 
@@ -102,7 +106,7 @@ func (r *authorizeRequest) evaluateRoleBinding(txnCtx *txncontext.TransactionCon
 	}
 
 	for _, g := range r.groups {
-		if err := r.evaluateRoleBindingForSubject(g, binding); err != nil {
+		if err := r.evaluateRoleBindingForSubject(txnCtx, g, binding); err != nil {
 			return err
 		}
 	}
@@ -110,7 +114,9 @@ func (r *authorizeRequest) evaluateRoleBinding(txnCtx *txncontext.TransactionCon
 	return nil
 }
 
-func (r *authorizeRequest) evaluateRoleBindingForSubject(subject string, binding *auth.RoleBinding) error {
+// evaluateRoleBindingForSubject looks up built-in and custom roles the same way, so a custom role
+// grants exactly the permissions it lists.
+func (r *authorizeRequest) evaluateRoleBindingForSubject(txnCtx *txncontext.TransactionContext, subject string, binding *auth.RoleBinding) error {
 	if binding.Entries == nil {
 		return nil
 	}
@@ -122,14 +128,14 @@ func (r *authorizeRequest) evaluateRoleBindingForSubject(subject string, binding
 				continue
 			}
 
-			roleDefinition, err := getRole(role)
+			roleDefinition, err := r.getRole(txnCtx, role)
 			if err != nil {
 				return err
 			}
 
-			r.roleMap[role] = roleDefinition.role
+			r.roleMap[role] = roleDefinition
 
-			for _, permission := range roleDefinition.role.Permissions {
+			for _, permission := range roleDefinition.Permissions {
 				if _, ok := r.permissions[permission]; ok {
 					r.satisfiedPermissions = append(r.satisfiedPermissions, permission)
 					delete(r.permissions, permission)
@@ -1,8 +1,13 @@
 package server
 
 import (
+	"database/sql"
+	"regexp"
+
 	"github.com/pachyderm/pachyderm/v2/src/auth"
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
 )
 
 type internalRole struct {
@@ -26,12 +31,79 @@ func registerRole(r *auth.Role) *auth.Role {
 	return r
 }
 
-func getRole(name string) (*internalRole, error) {
-	r, ok := roles[name]
-	if !ok {
-		return nil, errors.Errorf("unknown role %q", name)
+// getRoleInTransaction returns the definition of a built-in or custom role.
+func (a *apiServer) getRoleInTransaction(txnCtx *txncontext.TransactionContext, name string) (*auth.Role, error) {
+	if r, ok := roles[name]; ok {
+		return r.role, nil
+	}
+	var role auth.Role
+	if err := a.customRoles.ReadWrite(txnCtx.SqlTx).Get(name, &role); err != nil {
+		if col.IsErrNotFound(err) {
+			return nil, errors.Errorf("unknown role %q", name)
+		}
+		return nil, errors.Wrapf(err, "get role %q", name)
+	}
+	return &role, nil
+}
+
+// lockCustomRole takes a shared lock on the row of a custom role, which DeleteRole must wait
+// for.  A role that is deleted before the lock is taken is reported as unknown.
+func lockCustomRole(txnCtx *txncontext.TransactionContext, name string) error {
+	var n int
+	if err := txnCtx.SqlTx.Get(&n, `SELECT 1 FROM collections.roles WHERE key = $1 FOR SHARE`, name); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return errors.Errorf("unknown role %q", name)
+		}
+		return errors.Wrapf(err, "lock role %q", name)
 	}
-	return r, nil
+	return nil
+}
+
+// customRoleResourceTypes are the resource types that custom roles can be bound to.
+var customRoleResourceTypes = []auth.ResourceType{auth.ResourceType_CLUSTER, auth.ResourceType_PROJECT, auth.ResourceType_REPO}
+
+var customRoleNameRegex = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
+// validateCustomRole checks that r can be created as a custom role, and fills in the defaults
+// for the resource types it can be bound to and is returned for.
+func validateCustomRole(r *auth.Role) error {
+	if !customRoleNameRegex.MatchString(r.Name) {
+		return errors.Errorf("invalid role name %q: role names may only contain letters, numbers, '-' and '_'", r.Name)
+	}
+	if _, ok := roles[r.Name]; ok {
+		return errors.Errorf("%q is a built-in role", r.Name)
+	}
+	if len(r.Permissions) == 0 {
+		return errors.Errorf("role %q must have at least one permission", r.Name)
+	}
+	for _, p := range r.Permissions {
+		if _, ok := auth.Permission_name[int32(p)]; !ok || p == auth.Permission_PERMISSION_UNKNOWN {
+			return errors.Errorf("role %q has unknown permission %v", r.Name, p)
+		}
+	}
+	if len(r.CanBeBoundTo) == 0 {
+		r.CanBeBoundTo = customRoleResourceTypes
+	}
+	for _, t := range r.CanBeBoundTo {
+		if !resourceTypeIn(t, customRoleResourceTypes) {
+			return errors.Errorf("custom roles cannot be bound to resources of type %v", t)
+		}
+	}
+	if len(r.ReturnedFor) == 0 {
+		r.ReturnedFor = r.CanBeBoundTo
+	}
+	r.Permissions = combinePermissions(r.Permissions)
+	r.Custom = true
+	return nil
+}
+
+func resourceTypeIn(t auth.ResourceType, ts []auth.ResourceType) bool {
+	for _, u := range ts {
+		if t == u {
+			return true
+		}
+	}
+	return false
 }
 
 func init() {
@@ -46,6 +118,7 @@ func init() {
 			auth.Permission_REPO_INSPECT_COMMIT,
 			auth.Permission_REPO_LIST_COMMIT,
 			auth.Permission_REPO_LIST_BRANCH,
+			auth.Permission_REPO_LIST_TAG,
 			auth.Permission_REPO_LIST_FILE,
 			auth.Permission_REPO_INSPECT_FILE,
 			auth.Permission_REPO_ADD_PIPELINE_READER,
@@ -66,6 +139,7 @@ func init() {
 			auth.Permission_REPO_DELETE_COMMIT,
 			auth.Permission_REPO_CREATE_BRANCH,
 			auth.Permission_REPO_DELETE_BRANCH,
+			auth.Permission_REPO_CREATE_TAG,
 			auth.Permission_REPO_ADD_PIPELINE_WRITER,
 		}),
 	})
@@ -79,6 +153,7 @@ func init() {
 		Permissions: combinePermissions(repoWriterRole.Permissions, []auth.Permission{
 			auth.Permission_REPO_MODIFY_BINDINGS,
 			auth.Permission_REPO_DELETE,
+			auth.Permission_REPO_DELETE_TAG,
 		}),
 	})
 
@@ -193,6 +268,50 @@ func init() {
 		},
 	})
 
+	// webhookAdmin has the ability to create, list and delete webhooks
+	webhookAdminRole := registerRole(&auth.Role{
+		Name:         auth.WebhookAdminRole,
+		CanBeBoundTo: []auth.ResourceType{auth.ResourceType_CLUSTER},
+		ReturnedFor:  []auth.ResourceType{auth.ResourceType_CLUSTER},
+		Permissions: []auth.Permission{
+			auth.Permission_CLUSTER_CREATE_WEBHOOK,
+			auth.Permission_CLUSTER_LIST_WEBHOOKS,
+			auth.Permission_CLUSTER_DELETE_WEBHOOK,
+		},
+	})
+
+	// pjsUser has the ability to create, inspect, cancel and delete PJS jobs
+	pjsUserRole := registerRole(&auth.Role{
+		Name:         auth.PJSUserRole,
+		CanBeBoundTo: []auth.ResourceType{auth.ResourceType_CLUSTER},
+		ReturnedFor:  []auth.ResourceType{auth.ResourceType_CLUSTER},
+		Permissions: []auth.Permission{
+			auth.Permission_CLUSTER_PJS_WRITE_JOB,
+			auth.Permission_CLUSTER_PJS_READ_JOB,
+		},
+	})
+
+	// pjsWorker has the ability to inspect PJS queues and process their jobs
+	pjsWorkerRole := registerRole(&auth.Role{
+		Name:         auth.PJSWorkerRole,
+		CanBeBoundTo: []auth.ResourceType{auth.ResourceType_CLUSTER},
+		ReturnedFor:  []auth.ResourceType{auth.ResourceType_CLUSTER},
+		Permissions: []auth.Permission{
+			auth.Permission_CLUSTER_PJS_READ_JOB,
+			auth.Permission_CLUSTER_PJS_PROCESS_QUEUE,
+		},
+	})
+
+	// auditLogReader has the ability to read the audit log
+	auditLogReaderRole := registerRole(&auth.Role{
+		Name:         auth.AuditLogReaderRole,
+		CanBeBoundTo: []auth.ResourceType{auth.ResourceType_CLUSTER},
+		ReturnedFor:  []auth.ResourceType{auth.ResourceType_CLUSTER},
+		Permissions: []auth.Permission{
+			auth.Permission_CLUSTER_GET_AUDIT_LOG,
+		},
+	})
+
 	// Project related roles
 	projectViewerRole := registerRole(&auth.Role{
 		Name:         auth.ProjectViewerRole,
@@ -248,6 +367,10 @@ func init() {
 			licenseAdminRole.Permissions,
 			secretAdminRole.Permissions,
 			pachdLogReaderRole.Permissions,
+			auditLogReaderRole.Permissions,
+			webhookAdminRole.Permissions,
+			pjsUserRole.Permissions,
+			pjsWorkerRole.Permissions,
 			projectOwnerRole.Permissions,
 			projectCreatorRole.Permissions,
 			[]auth.Permission{
@@ -263,6 +386,8 @@ func init() {
 				auth.Permission_CLUSTER_AUTH_EXTRACT_TOKENS,
 				auth.Permission_CLUSTER_AUTH_RESTORE_TOKEN,
 				auth.Permission_CLUSTER_AUTH_ROTATE_ROOT_TOKEN,
+				auth.Permission_CLUSTER_AUTH_CREATE_ROLE,
+				auth.Permission_CLUSTER_AUTH_DELETE_ROLE,
 				auth.Permission_CLUSTER_AUTH_DELETE_EXPIRED_TOKENS,
 				auth.Permission_CLUSTER_AUTH_GET_PERMISSIONS_FOR_PRINCIPAL,
 				auth.Permission_CLUSTER_AUTH_REVOKE_USER_TOKENS,
@@ -293,20 +418,23 @@ func combinePermissions(permissionSets ...[]auth.Permission) []auth.Permission {
 }
 
 func roleReturnedForResource(r *auth.Role, rt auth.ResourceType) bool {
-	for _, t := range r.ReturnedFor {
-		if t == rt {
-			return true
-		}
-	}
-	return false
+	return resourceTypeIn(rt, r.ReturnedFor)
 }
 
-func rolesForPermission(permission auth.Permission) []*auth.Role {
+func rolesForPermission(permission auth.Permission, customRoles []*auth.Role) []*auth.Role {
 	resp := make([]*auth.Role, 0)
 	for _, r := range roles {
 		if _, ok := r.permissionsIndex[permission]; ok {
 			resp = append(resp, r.role)
 		}
 	}
+	for _, r := range customRoles {
+		for _, p := range r.Permissions {
+			if p == permission {
+				resp = append(resp, r)
+				break
+			}
+		}
+	}
 	return resp
 }
@@ -995,14 +995,17 @@ func TestListAndInspectRepo(t *testing.T) {
 			auth.Permission_REPO_ADD_PIPELINE_READER,
 			auth.Permission_REPO_ADD_PIPELINE_WRITER,
 			auth.Permission_REPO_CREATE_BRANCH,
+			auth.Permission_REPO_CREATE_TAG,
 			auth.Permission_REPO_DELETE_BRANCH,
 			auth.Permission_REPO_DELETE_COMMIT,
+			auth.Permission_REPO_DELETE_TAG,
 			auth.Permission_REPO_DELETE,
 			auth.Permission_REPO_INSPECT_COMMIT,
 			auth.Permission_REPO_INSPECT_FILE,
 			auth.Permission_REPO_LIST_BRANCH,
 			auth.Permission_REPO_LIST_COMMIT,
 			auth.Permission_REPO_LIST_FILE,
+			auth.Permission_REPO_LIST_TAG,
 			auth.Permission_REPO_MODIFY_BINDINGS,
 			auth.Permission_REPO_READ,
 			auth.Permission_REPO_REMOVE_PIPELINE_READER,
@@ -1013,6 +1016,7 @@ func TestListAndInspectRepo(t *testing.T) {
 			auth.Permission_REPO_ADD_PIPELINE_READER,
 			auth.Permission_REPO_ADD_PIPELINE_WRITER,
 			auth.Permission_REPO_CREATE_BRANCH,
+			auth.Permission_REPO_CREATE_TAG,
 			auth.Permission_REPO_DELETE_BRANCH,
 			auth.Permission_REPO_DELETE_COMMIT,
 			auth.Permission_REPO_INSPECT_COMMIT,
@@ -1020,6 +1024,7 @@ func TestListAndInspectRepo(t *testing.T) {
 			auth.Permission_REPO_LIST_BRANCH,
 			auth.Permission_REPO_LIST_COMMIT,
 			auth.Permission_REPO_LIST_FILE,
+			auth.Permission_REPO_LIST_TAG,
 			auth.Permission_REPO_READ,
 			auth.Permission_REPO_REMOVE_PIPELINE_READER,
 			auth.Permission_REPO_WRITE,
@@ -1032,6 +1037,7 @@ func TestListAndInspectRepo(t *testing.T) {
 			auth.Permission_REPO_LIST_BRANCH,
 			auth.Permission_REPO_LIST_COMMIT,
 			auth.Permission_REPO_LIST_FILE,
+			auth.Permission_REPO_LIST_TAG,
 			auth.Permission_REPO_READ,
 			auth.Permission_REPO_REMOVE_PIPELINE_READER,
 		},
@@ -1203,6 +1209,92 @@ func TestProjectWriter(t *testing.T) {
 	require.NoError(t, alice.CreatePipeline(project, "pipeline", "", []string{"cp", "/pfs/in/*", "/pfs/out"}, nil, nil, &pps.Input{Pfs: &pps.PFSInput{Project: project, Repo: repo, Glob: "/*", Name: "in"}}, "", false))
 }
 
+// TestCustomRole creates a custom role that can write to a repo but not delete it, and checks
+// that it is resolved like a built-in role.
+func TestCustomRole(t *testing.T) {
+	t.Parallel()
+	env := envWithAuth(t)
+	c := env.PachClient
+
+	admin := tu.AuthenticateClient(t, c, auth.RootUser)
+	aliceName, alice := tu.RandomRobot(t, c, "alice")
+	role, repo := tu.UniqueString("repoAppender"), tu.UniqueString("repo")
+	require.NoError(t, admin.CreateRepo(pfs.DefaultProjectName, repo))
+
+	// Custom roles can't shadow built-in roles, and only cluster admins can create them.
+	_, err := admin.CreateRole(admin.Ctx(), &auth.CreateRoleRequest{Role: &auth.Role{
+		Name:        auth.RepoWriterRole,
+		Permissions: []auth.Permission{auth.Permission_REPO_WRITE},
+	}})
+	require.YesError(t, err)
+	appender := &auth.Role{
+		Name: role,
+		Permissions: []auth.Permission{
+			auth.Permission_REPO_READ,
+			auth.Permission_REPO_WRITE,
+			auth.Permission_REPO_INSPECT_COMMIT,
+			auth.Permission_REPO_LIST_COMMIT,
+		},
+		CanBeBoundTo: []auth.ResourceType{auth.ResourceType_REPO},
+	}
+	_, err = alice.CreateRole(alice.Ctx(), &auth.CreateRoleRequest{Role: appender})
+	require.ErrorContains(t, err, "not authorized")
+	_, err = admin.CreateRole(admin.Ctx(), &auth.CreateRoleRequest{Role: appender})
+	require.NoError(t, err)
+
+	resp, err := alice.ListRole(alice.Ctx(), &auth.ListRoleRequest{})
+	require.NoError(t, err)
+	var found bool
+	for _, r := range resp.Roles {
+		if r.Name == role {
+			found = true
+			require.True(t, r.Custom)
+			require.ElementsEqual(t, []auth.ResourceType{auth.ResourceType_REPO}, r.ReturnedFor)
+		}
+	}
+	require.True(t, found)
+
+	// Alice can write to the repo with the custom role, but not delete it.
+	commit := client.NewCommit(pfs.DefaultProjectName, repo, "master", "")
+	require.ErrorContains(t, alice.PutFile(commit, "/file", strings.NewReader("1")), "not authorized")
+	_, err = admin.ModifyRoleBinding(admin.Ctx(), &auth.ModifyRoleBindingRequest{
+		Principal: aliceName,
+		Roles:     []string{role},
+		Resource:  &auth.Resource{Type: auth.ResourceType_PROJECT, Name: pfs.DefaultProjectName},
+	})
+	require.ErrorContains(t, err, "cannot be bound to")
+	_, err = admin.ModifyRoleBinding(admin.Ctx(), &auth.ModifyRoleBindingRequest{
+		Principal: aliceName,
+		Roles:     []string{role},
+		Resource:  &auth.Resource{Type: auth.ResourceType_REPO, Name: pfs.DefaultProjectName + "/" + repo},
+	})
+	require.NoError(t, err)
+	require.NoError(t, alice.PutFile(commit, "/file", strings.NewReader("1")))
+	require.ErrorContains(t, alice.DeleteRepo(pfs.DefaultProjectName, repo, false), "not authorized")
+	perms, err := alice.GetPermissions(alice.Ctx(), &auth.GetPermissionsRequest{
+		Resource: &auth.Resource{Type: auth.ResourceType_REPO, Name: pfs.DefaultProjectName + "/" + repo},
+	})
+	require.NoError(t, err)
+	require.OneOfEquals(t, role, perms.Roles)
+
+	// The role can't be deleted while it's bound.
+	_, err = admin.DeleteRole(admin.Ctx(), &auth.DeleteRoleRequest{Name: role})
+	require.ErrorContains(t, err, "is bound to")
+	_, err = admin.ModifyRoleBinding(admin.Ctx(), &auth.ModifyRoleBindingRequest{
+		Principal: aliceName,
+		Resource:  &auth.Resource{Type: auth.ResourceType_REPO, Name: pfs.DefaultProjectName + "/" + repo},
+	})
+	require.NoError(t, err)
+	_, err = admin.DeleteRole(admin.Ctx(), &auth.DeleteRoleRequest{Name: role})
+	require.NoError(t, err)
+	_, err = admin.ModifyRoleBinding(admin.Ctx(), &auth.ModifyRoleBindingRequest{
+		Principal: aliceName,
+		Roles:     []string{role},
+		Resource:  &auth.Resource{Type: auth.ResourceType_REPO, Name: pfs.DefaultProjectName + "/" + repo},
+	})
+	require.ErrorContains(t, err, "unknown role")
+}
+
 // Creating a pipeline when the output repo already exists gives is not allowed
 func TestCreatePipelineRepoAlreadyExists(t *testing.T) {
 	t.Parallel()
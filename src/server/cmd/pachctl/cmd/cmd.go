@@ -29,6 +29,7 @@ import (
 	taskcmds "github.com/pachyderm/pachyderm/v2/src/internal/task/cmds"
 	"github.com/pachyderm/pachyderm/v2/src/pps"
 	admincmds "github.com/pachyderm/pachyderm/v2/src/server/admin/cmds"
+	auditcmds "github.com/pachyderm/pachyderm/v2/src/server/audit/cmds"
 	authcmds "github.com/pachyderm/pachyderm/v2/src/server/auth/cmds"
 	"github.com/pachyderm/pachyderm/v2/src/server/cmd/pachctl/shell"
 	configcmds "github.com/pachyderm/pachyderm/v2/src/server/config"
@@ -38,8 +39,10 @@ import (
 	licensecmds "github.com/pachyderm/pachyderm/v2/src/server/license/cmds"
 	misccmds "github.com/pachyderm/pachyderm/v2/src/server/misc/cmds"
 	pfscmds "github.com/pachyderm/pachyderm/v2/src/server/pfs/cmds"
+	pjscmds "github.com/pachyderm/pachyderm/v2/src/server/pjs/cmds"
 	ppscmds "github.com/pachyderm/pachyderm/v2/src/server/pps/cmds"
 	txncmds "github.com/pachyderm/pachyderm/v2/src/server/transaction/cmds"
+	webhookcmds "github.com/pachyderm/pachyderm/v2/src/server/webhook/cmds"
 	"github.com/pachyderm/pachyderm/v2/src/version"
 	"github.com/pachyderm/pachyderm/v2/src/version/versionpb"
 
@@ -854,9 +857,30 @@ This resets the cluster to its initial state.`,
 	}
 	subcommands = append(subcommands, cmdutil.CreateAlias(rerunDocs, "rerun"))
 
+	setDocs := &cobra.Command{
+		Short: "Set a property of an existing Pachyderm resource.",
+		Long:  "Set a property of an existing Pachyderm resource.",
+	}
+	subcommands = append(subcommands, cmdutil.CreateAlias(setDocs, "set"))
+
+	applyDocs := &cobra.Command{
+		Short: "Apply a policy to an existing Pachyderm resource now.",
+		Long:  "Apply a policy to an existing Pachyderm resource now, rather than waiting for it to be applied in the background.",
+	}
+	subcommands = append(subcommands, cmdutil.CreateAlias(applyDocs, "apply"))
+
+	purgeDocs := &cobra.Command{
+		Short: "Permanently remove data from every commit of a Pachyderm resource.",
+		Long:  "Permanently remove data from every commit of a Pachyderm resource.",
+	}
+	subcommands = append(subcommands, cmdutil.CreateAlias(purgeDocs, "purge"))
+
 	subcommands = append(subcommands, pfscmds.Cmds(mainCtx, pachCtx, pachctlCfg)...)
 	subcommands = append(subcommands, ppscmds.Cmds(mainCtx, pachCtx, pachctlCfg)...)
+	subcommands = append(subcommands, pjscmds.Cmds(mainCtx, pachctlCfg)...)
 	subcommands = append(subcommands, authcmds.Cmds(mainCtx, pachCtx, pachctlCfg)...)
+	subcommands = append(subcommands, auditcmds.Cmds(mainCtx, pachctlCfg)...)
+	subcommands = append(subcommands, webhookcmds.Cmds(mainCtx, pachctlCfg)...)
 	subcommands = append(subcommands, enterprisecmds.Cmds(mainCtx, pachctlCfg)...)
 	subcommands = append(subcommands, licensecmds.Cmds(mainCtx, pachctlCfg)...)
 	subcommands = append(subcommands, identitycmds.Cmds(mainCtx, pachctlCfg)...)
@@ -26,7 +26,7 @@ var (
 )
 
 func init() {
-	flag.StringVar(&mode, "mode", "full", "Pachd currently supports four modes: full, enterprise, sidecar and paused. Full includes everything you need in a full pachd node. Enterprise runs the Enterprise Server. Sidecar runs only PFS, the Auth service, and a stripped-down version of PPS.  Paused runs all APIs other than PFS and PPS; it is intended to enable taking database backups.")
+	flag.StringVar(&mode, "mode", "full", "Pachd currently supports five modes: full, local, enterprise, sidecar and paused. Full includes everything you need in a full pachd node. Local is full mode without Kubernetes, running pipeline workers as local processes. Enterprise runs the Enterprise Server. Sidecar runs only PFS, the Auth service, and a stripped-down version of PPS.  Paused runs all APIs other than PFS and PPS; it is intended to enable taking database backups.")
 	flag.BoolVar(&readiness, "readiness", false, "Run readiness check.")
 	flag.Parse()
 }
@@ -52,6 +52,9 @@ func main() {
 		// i.e., default — mode.
 		logMode("full")
 		cmdutil.Main(ctx, pachd.FullMode, &pachconfig.PachdFullConfiguration{})
+	case mode == "local":
+		logMode("local")
+		cmdutil.Main(ctx, pachd.LocalMode, &pachconfig.PachdFullConfiguration{})
 	case mode == "enterprise":
 		logMode("enterprise")
 		cmdutil.Main(ctx, pachd.EnterpriseMode, &pachconfig.EnterpriseServerConfiguration{})
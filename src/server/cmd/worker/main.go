@@ -48,7 +48,16 @@ func main() {
 func do(ctx context.Context, config *pachconfig.WorkerFullConfiguration) error {
 	// must run InstallJaegerTracer before InitWithKube/pach client initialization
 	tracing.InstallJaegerTracerFromEnv()
-	env := serviceenv.InitWithKube(ctx, pachconfig.NewConfiguration(config))
+	// Workers that pachd runs as local processes have no Kubernetes to talk
+	// to, and are given a root directory and address of their own.
+	rootDir, listenHost := "/", ""
+	var env *serviceenv.NonblockingServiceEnv
+	if config.WorkerRoot != "" {
+		rootDir, listenHost = config.WorkerRoot, config.PPSWorkerIP
+		env = serviceenv.InitServiceEnv(ctx, pachconfig.NewConfiguration(config))
+	} else {
+		env = serviceenv.InitWithKube(ctx, pachconfig.NewConfiguration(config))
+	}
 
 	// Enable cloud profilers if the configuration allows.
 	profileutil.StartCloudProfiler(ctx, "pachyderm-worker", env.Config())
@@ -72,7 +81,7 @@ func do(ctx context.Context, config *pachconfig.WorkerFullConfiguration) error {
 	ctx = pachClient.AddMetadata(ctx)
 
 	// Construct worker API server.
-	workerInstance, err := worker.NewWorker(pctx.Child(ctx, ""), env, pachClient, pipelineInfo, "/")
+	workerInstance, err := worker.NewWorker(pctx.Child(ctx, ""), env, pachClient, pipelineInfo, rootDir)
 	if err != nil {
 		return err
 	}
@@ -136,7 +145,7 @@ func do(ctx context.Context, config *pachconfig.WorkerFullConfiguration) error {
 	}
 
 	// If server ever exits, return error
-	if _, err := server.ListenTCP("", env.Config().PPSWorkerPort); err != nil {
+	if _, err := server.ListenTCP(listenHost, env.Config().PPSWorkerPort); err != nil {
 		return err
 	}
 	return server.Wait()
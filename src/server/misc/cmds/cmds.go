@@ -12,6 +12,8 @@ import (
 	"net/url"
 	"os"
 	"os/signal"
+	"path"
+	"slices"
 	"sort"
 	"strings"
 	"syscall"
@@ -145,11 +147,15 @@ func Cmds(ctx context.Context, pachctlCfg *pachctl.Config) []*cobra.Command {
 	// NOTE(jonathan): This can move out of misc when we add OAuth support to the download
 	// endpoint, and tell pachd what its externally-accessible URL is (so the link works when
 	// you click it).
+	var archiveFormat string
 	generateURL := &cobra.Command{
 		Use:   "{{alias}} project/repo@branch_or_commit:/file_or_directory ...",
 		Short: "Generates the encoded part of an archive download URL.",
 		Long:  "Generates the encoded part of an archive download URL.",
 		Run: cmdutil.Run(func(args []string) error {
+			if !slices.Contains(archiveserver.ArchiveFormats, archiveserver.ArchiveFormat(archiveFormat)) {
+				return errors.Errorf("unknown archive format %q", archiveFormat)
+			}
 			path, err := archiveserver.EncodeV1(args)
 			if err != nil {
 				return errors.Wrap(err, "encode")
@@ -166,7 +172,7 @@ func Cmds(ctx context.Context, pachctlCfg *pachctl.Config) []*cobra.Command {
 				defer c.Close()
 
 				info, _ := c.ClusterInfo()
-				fmt.Println(info.GetWebResources().GetArchiveDownloadBaseUrl() + path + ".zip")
+				fmt.Println(info.GetWebResources().GetArchiveDownloadBaseUrl() + path + "." + archiveFormat)
 				return nil
 			}
 			if err := getPrefix(); err != nil {
@@ -177,6 +183,7 @@ func Cmds(ctx context.Context, pachctlCfg *pachctl.Config) []*cobra.Command {
 			return nil
 		}),
 	}
+	generateURL.Flags().StringVar(&archiveFormat, "format", string(archiveserver.ArchiveFormatZip), "The archive format to download; 'zip', 'tar', 'tar.gz', or 'tar.zst'.")
 	commands = append(commands, cmdutil.CreateAlias(generateURL, "misc generate-download-url"))
 
 	decodeURL := &cobra.Command{
@@ -191,7 +198,7 @@ func Cmds(ctx context.Context, pachctlCfg *pachctl.Config) []*cobra.Command {
 			if !strings.HasPrefix(u.Path, "/archive/") {
 				u.Path = "/archive/" + u.Path
 			}
-			if !strings.HasSuffix(u.Path, ".zip") {
+			if !strings.Contains(path.Base(u.Path), ".") {
 				u.Path = u.Path + ".zip"
 			}
 			req, err := archiveserver.ArchiveFromURL(u)
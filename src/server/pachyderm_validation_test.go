@@ -67,7 +67,7 @@ func TestInvalidCreatePipeline(t *testing.T) {
 	require.YesError(t, err)
 	require.Matches(t, "glob", err.Error())
 
-	// Create pipeline with input commit
+	// Create pipeline with an input commit that isn't a tag
 	err = c.CreatePipeline(projectName,
 		pipelineName,
 		"",
@@ -89,7 +89,7 @@ func TestInvalidCreatePipeline(t *testing.T) {
 		false,
 	)
 	require.YesError(t, err)
-	require.Matches(t, "input cannot come from a commit", err.Error())
+	require.Matches(t, "must name a tag", err.Error())
 }
 
 // Make sure that pipeline validation checks that all inputs exist
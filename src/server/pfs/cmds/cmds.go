@@ -21,6 +21,7 @@ import (
 	"github.com/mattn/go-isatty"
 	"github.com/spf13/cobra"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/pachyderm/pachyderm/v2/src/debug"
@@ -55,6 +56,7 @@ const (
 	files    = "files"
 	repos    = "repos"
 	projects = "projects"
+	tags     = "tags"
 )
 
 // Cmds returns a slice containing pfs commands.
@@ -203,6 +205,7 @@ func Cmds(mainCtx context.Context, pachCtx *config.Context, pachctlCfg *pachctl.
 
 	var all bool
 	var repoType string
+	var metadataFilter map[string]string
 	listRepo := &cobra.Command{
 		Short: "Return a list of repos.",
 		Long: "This command returns a list of repos that you have permissions to view. By default, it does not show system repos like pipeline metadata. \n" +
@@ -212,6 +215,7 @@ func Cmds(mainCtx context.Context, pachCtx *config.Context, pachctlCfg *pachctl.
 			"\t- To view repos of a specific type, use the `--type` flag; options include `USER`, `META`, & `SPEC` \n" +
 			"\t- To view repos of a specific type across projects, use the `--type` and `-A` flags \n" +
 			"\t- To view repos of a specific type in a specific project, use the `--type` and `--project` flags \n" +
+			"\t- To view repos with specific metadata, use the `--metadata` flag \n" +
 			"\n" +
 			"For information on roles and permissions, see the documentation: https://docs.pachyderm.com/latest/set-up/authorization/permissions/",
 		Example: "\t- pachctl list repos \n" +
@@ -236,7 +240,7 @@ func Cmds(mainCtx context.Context, pachCtx *config.Context, pachctlCfg *pachctl.
 			if allProjects {
 				projectsFilter = nil
 			}
-			repoInfos, err := c.ListProjectRepo(&pfs.ListRepoRequest{Type: repoType, Projects: projectsFilter})
+			repoInfos, err := c.ListProjectRepo(&pfs.ListRepoRequest{Type: repoType, Projects: projectsFilter, Metadata: metadataFilter})
 			if err != nil {
 				return errors.Wrap(err, "cannot list repos")
 			}
@@ -270,6 +274,7 @@ func Cmds(mainCtx context.Context, pachCtx *config.Context, pachctlCfg *pachctl.
 	listRepo.Flags().StringVar(&repoType, "type", pfs.UserRepoType, "Set a repo type for scoped results.")
 	listRepo.Flags().StringVar(&project, "project", project, "Specify the project (by name) where the repos are located.")
 	listRepo.Flags().BoolVarP(&allProjects, "all-projects", "A", false, "Specify all repos across all projects should be returned.")
+	listRepo.Flags().StringToStringVar(&metadataFilter, "metadata", nil, "Specify results should only include repos whose metadata contains all of the given key=value pairs.")
 	commands = append(commands, cmdutil.CreateAliases(listRepo, "list repo", repos))
 
 	var force bool
@@ -544,12 +549,14 @@ func Cmds(mainCtx context.Context, pachCtx *config.Context, pachctlCfg *pachctl.
 			"\t- To specify the number of commits to return, use the `--number` flag \n" +
 			"\t- To list all commits that have come after a certain commit, use the `--from` flag \n" +
 			"\t- To specify the origin of the commit, use the `--origin` flag; options include `AUTO`, `FSCK`, & `USER`. Requires at least repo name in command \n" +
-			"\t- To expand the commit to include all of its sub-commits, use the `--expand` flag \n",
+			"\t- To expand the commit to include all of its sub-commits, use the `--expand` flag \n" +
+			"\t- To only return commits with specific metadata, use the `--metadata` flag. Requires at least repo name in command \n",
 		Example: "\t- {{alias}} foo ➔ returns all commits in repo foo \n" +
 			"\t- {{alias}} foo@master ➔ returns all commits in repo foo on branch master \n" +
 			"\t- {{alias}} foo@master --number 10 ➔ returns the last 10 commits in repo foo on branch master \n" +
 			"\t- {{alias}} foo@master --from 0001a0100b1c10d01111e001fg00h00i ➔ returns all commits in repo foo on branch master since <commit> \n" +
 			"\t- {{alias}} foo@master --origin user ➔ returns all commits in repo foo on branch master originating from \n" +
+			"\t- {{alias}} foo@master --metadata reviewed=true ➔ returns all commits in repo foo on branch master with metadata key reviewed set to true \n" +
 			"\t- {{alias}} 0001a0100b1c10d01111e001fg00h00i ➔ returns all commits with ID <commit-id> \n" +
 			"\t- {{alias}} 0001a0100b1c10d01111e001fg00h00i --expand ➔ returns all sub-commits on new lines, along with columns of more information \n" +
 			"\t- {{alias}} foo@master --raw -o yaml ➔ returns all commits in repo foo on branch master in YAML format \n",
@@ -570,6 +577,8 @@ func Cmds(mainCtx context.Context, pachCtx *config.Context, pachctlCfg *pachctl.
 				// Outputting all commitsets
 				if originStr != "" {
 					return errors.Errorf("cannot specify --origin when listing all commits")
+				} else if len(metadataFilter) > 0 {
+					return errors.Errorf("cannot specify --metadata when listing all commits")
 				} else if from != "" {
 					return errors.Errorf("cannot specify --from when listing all commits")
 				}
@@ -651,6 +660,8 @@ func Cmds(mainCtx context.Context, pachCtx *config.Context, pachctlCfg *pachctl.
 					return errors.Errorf("cannot specify --all when listing subcommits")
 				} else if originStr != "" {
 					return errors.Errorf("cannot specify --origin when listing subcommits")
+				} else if len(metadataFilter) > 0 {
+					return errors.Errorf("cannot specify --metadata when listing subcommits")
 				}
 
 				commitInfos, err := c.InspectCommitSet(args[0])
@@ -710,6 +721,7 @@ func Cmds(mainCtx context.Context, pachCtx *config.Context, pachctlCfg *pachctl.
 					Number:     number,
 					All:        all,
 					OriginKind: origin,
+					Metadata:   metadataFilter,
 				})
 				if err != nil {
 					return grpcutil.ScrubGRPC(err)
@@ -738,6 +750,7 @@ func Cmds(mainCtx context.Context, pachCtx *config.Context, pachctlCfg *pachctl.
 	listCommit.Flags().BoolVar(&all, "all", false, "Specify all types of commits (AUTO, FSCK, USER) should be returned; default only includes USER.")
 	listCommit.Flags().BoolVarP(&expand, "expand", "x", false, "Specify results should return one line for each sub-commit and include more columns.")
 	listCommit.Flags().StringVar(&originStr, "origin", "", "Specify the type of commit to scope returned results by; options include AUTO, FSCK, & USER.")
+	listCommit.Flags().StringToStringVar(&metadataFilter, "metadata", nil, "Specify results should only include commits whose metadata contains all of the given key=value pairs.")
 	listCommit.Flags().AddFlagSet(outputFlags)
 	listCommit.Flags().AddFlagSet(timestampFlags)
 	listCommit.Flags().StringVar(&project, "project", project, "Specify the project (by name) containing the commit.")
@@ -1187,6 +1200,156 @@ func Cmds(mainCtx context.Context, pachCtx *config.Context, pachctlCfg *pachctl.
 	shell.RegisterCompletionFunc(deleteBranch, shell.BranchCompletion)
 	commands = append(commands, cmdutil.CreateAliases(deleteBranch, "delete branch", branches))
 
+	// TAG COMMANDS
+
+	tagDocs := &cobra.Command{
+		Short: "Docs for tags.",
+		Long: "A tag in Pachyderm is an immutable, named reference to a commit in a repo. " +
+			"Unlike a branch, a tag never moves, and the commit it refers to can't be squashed or dropped until the tag is deleted. " +
+			"Any pachctl command that can take a commit can take a tag name instead (e.g. `foo@v1`), as can S3 gateway bucket names and the `commit` field of a pipeline's PFS input. " +
+			"If a repo has a branch and a tag with the same name, the branch takes precedence.",
+	}
+	commands = append(commands, cmdutil.CreateDocsAliases(tagDocs, "tag", " tag$", tags))
+
+	createTag := &cobra.Command{
+		Use:   "{{alias}} <repo>@<tag> <branch-or-commit>",
+		Short: "Create a tag pointing at a commit.",
+		Long: "This command creates an immutable tag on a repo that refers to the given commit. " +
+			"If a branch is given, the tag refers to the branch's current head commit. \n" +
+			"\n" +
+			"\t- To create a tag for a repo in a particular project, use the `--project` flag \n",
+		Example: "\t- {{alias}} foo@v1 master \n" +
+			"\t- {{alias}} foo@v1 master^ --project bar \n" +
+			"\t- {{alias}} foo@v1 0001a0100b1c10d01111e001fg00h00i \n",
+		Run: cmdutil.RunFixedArgs(2, func(args []string) error {
+			tag, err := cmdutil.ParseTag(project, args[0])
+			if err != nil {
+				return err
+			}
+			c, err := pachctlCfg.NewOnUserMachine(mainCtx, false)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			_, err = c.PfsAPIClient.CreateTag(c.Ctx(), &pfs.CreateTagRequest{
+				Tag:    tag,
+				Commit: tag.Repo.NewCommit("", args[1]),
+			})
+			return grpcutil.ScrubGRPC(err)
+		}),
+	}
+	createTag.Flags().StringVar(&project, "project", project, "Specify the project (by name) containing the tag's repo.")
+	shell.RegisterCompletionFunc(createTag, shell.RepoCompletion)
+	commands = append(commands, cmdutil.CreateAliases(createTag, "create tag", tags))
+
+	inspectTag := &cobra.Command{
+		Use:   "{{alias}} <repo>@<tag>",
+		Short: "Return info about a tag.",
+		Long: "This command returns info about a tag, such as the commit it refers to. \n" +
+			"\n" +
+			"\t- To inspect a tag from a repo in another project, use the `--project` flag \n" +
+			"\t- To get additional details about the tag, use the `--raw` flag \n",
+		Example: "\t- {{alias}} foo@v1 \n" +
+			"\t- {{alias}} foo@v1 --project bar \n" +
+			"\t- {{alias}} foo@v1 --raw \n",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			tag, err := cmdutil.ParseTag(project, args[0])
+			if err != nil {
+				return err
+			}
+			c, err := pachctlCfg.NewOnUserMachine(mainCtx, false)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			tagInfo, err := c.PfsAPIClient.InspectTag(c.Ctx(), &pfs.InspectTagRequest{Tag: tag})
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			if raw {
+				return errors.EnsureStack(cmdutil.Encoder(output, os.Stdout).EncodeProto(tagInfo))
+			} else if output != "" {
+				return errors.New("cannot set --output (-o) without --raw")
+			}
+			return pretty.PrintDetailedTagInfo(tagInfo)
+		}),
+	}
+	inspectTag.Flags().AddFlagSet(outputFlags)
+	inspectTag.Flags().StringVar(&project, "project", project, "Specify the project (by name) containing the tag's repo.")
+	shell.RegisterCompletionFunc(inspectTag, shell.RepoCompletion)
+	commands = append(commands, cmdutil.CreateAliases(inspectTag, "inspect tag", tags))
+
+	listTag := &cobra.Command{
+		Use:   "{{alias}} <repo>",
+		Short: "Return all tags on a repo.",
+		Long: "This command returns all tags on a repo, ordered by name. \n" +
+			"\n" +
+			"\t- To list tags from a repo in another project, use the `--project` flag \n" +
+			"\t- To get additional details about the tags, use the `--raw` flag \n",
+		Example: "\t- {{alias}} foo \n" +
+			"\t- {{alias}} foo --project bar \n" +
+			"\t- {{alias}} foo --raw -o yaml \n",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			c, err := pachctlCfg.NewOnUserMachine(mainCtx, false)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			tagClient, err := c.PfsAPIClient.ListTag(c.Ctx(), &pfs.ListTagRequest{Repo: cmdutil.ParseRepo(project, args[0])})
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			if raw {
+				encoder := cmdutil.Encoder(output, os.Stdout)
+				err := grpcutil.ForEach[*pfs.TagInfo](tagClient, func(tagInfo *pfs.TagInfo) error {
+					return errors.EnsureStack(encoder.EncodeProto(tagInfo))
+				})
+				return grpcutil.ScrubGRPC(err)
+			} else if output != "" {
+				return errors.New("cannot set --output (-o) without --raw")
+			}
+			writer := tabwriter.NewWriter(os.Stdout, pretty.TagHeader)
+			if err := grpcutil.ForEach[*pfs.TagInfo](tagClient, func(tagInfo *pfs.TagInfo) error {
+				pretty.PrintTag(writer, tagInfo, fullTimestamps)
+				return nil
+			}); err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			return writer.Flush()
+		}),
+	}
+	listTag.Flags().AddFlagSet(outputFlags)
+	listTag.Flags().AddFlagSet(timestampFlags)
+	listTag.Flags().StringVar(&project, "project", project, "Specify the project (by name) containing the tags' repo.")
+	shell.RegisterCompletionFunc(listTag, shell.RepoCompletion)
+	commands = append(commands, cmdutil.CreateAliases(listTag, "list tag", tags))
+
+	deleteTag := &cobra.Command{
+		Use:   "{{alias}} <repo>@<tag>",
+		Short: "Delete a tag.",
+		Long: "This command deletes a tag while leaving the commit it refers to intact. \n" +
+			"\n" +
+			"\t- To delete a tag from a repo in another project, use the `--project` flag \n",
+		Example: "\t- {{alias}} foo@v1 \n" +
+			"\t- {{alias}} foo@v1 --project bar \n",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			tag, err := cmdutil.ParseTag(project, args[0])
+			if err != nil {
+				return err
+			}
+			c, err := pachctlCfg.NewOnUserMachine(mainCtx, false)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			_, err = c.PfsAPIClient.DeleteTag(c.Ctx(), &pfs.DeleteTagRequest{Tag: tag})
+			return grpcutil.ScrubGRPC(err)
+		}),
+	}
+	deleteTag.Flags().StringVar(&project, "project", project, "Specify the project (by name) containing the tag's repo.")
+	shell.RegisterCompletionFunc(deleteTag, shell.RepoCompletion)
+	commands = append(commands, cmdutil.CreateAliases(deleteTag, "delete tag", tags))
+
 	// COMMIT COMMANDS
 
 	FindCommits := &cobra.Command{
@@ -1483,6 +1646,306 @@ func Cmds(mainCtx context.Context, pachCtx *config.Context, pachctlCfg *pachctl.
 	deleteProject.Flags().BoolVarP(&force, "force", "f", false, "Force delete the project regardless of errors; use with caution.")
 	commands = append(commands, cmdutil.CreateAliases(deleteProject, "delete project", projects))
 
+	// METADATA COMMANDS
+
+	editMetadata := &cobra.Command{
+		Use:   "{{alias}} <project|repo|branch|commit> <object> <add|edit|delete|replace> <data>",
+		Short: "Edit the user metadata of a project, repo, branch or commit.",
+		Long: "This command edits the key/value metadata attached to a project, repo, branch or commit. \n" +
+			"\n" +
+			"\t- To add a new key, use `add key=value`; this fails if the key already exists \n" +
+			"\t- To set a key regardless of whether it exists, use `edit key=value` \n" +
+			"\t- To remove a key, use `delete key` \n" +
+			"\t- To replace all metadata at once, use `replace` with a JSON object, e.g. `replace '{\"k\":\"v\"}'` \n",
+		Example: "\t- {{alias}} project foo add owner=alice \n" +
+			"\t- {{alias}} repo images edit team=vision \n" +
+			"\t- {{alias}} branch images@master delete team \n" +
+			"\t- {{alias}} commit images@master replace '{\"reviewed\":\"true\"}' \n" +
+			"\t- {{alias}} repo images add team=vision --project bar \n",
+		Run: cmdutil.RunFixedArgs(4, func(args []string) error {
+			edit, err := parseMetadataEdit(project, args)
+			if err != nil {
+				return err
+			}
+			c, err := pachctlCfg.NewOnUserMachine(mainCtx, false)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			_, err = c.PfsAPIClient.EditMetadata(c.Ctx(), &pfs.EditMetadataRequest{
+				Edits: []*pfs.EditMetadataRequest_Edit{edit},
+			})
+			return grpcutil.ScrubGRPC(err)
+		}),
+	}
+	editMetadata.Flags().StringVar(&project, "project", project, "Specify the project (by name) containing the object to edit.")
+	commands = append(commands, cmdutil.CreateAlias(editMetadata, "edit metadata"))
+
+	// RETENTION COMMANDS
+
+	var keepLast int64
+	var keepNewerThan time.Duration
+	var keepMetadata map[string]string
+	setRetentionPolicy := &cobra.Command{
+		Use:   "{{alias}} <repo>[@<branch>]",
+		Short: "Set the commit retention policy of a repo or branch.",
+		Long: "This command sets which commits of a repo or branch are kept. Commits that are not kept expire, and are squashed in the background. " +
+			"The head of a branch never expires, and a branch's policy overrides the policy of its repo. \n" +
+			"\n" +
+			"\t- To keep the most recent commits, use the `--keep-last` flag \n" +
+			"\t- To keep commits started within a duration, use the `--keep-newer-than` flag \n" +
+			"\t- To keep commits with specific metadata, use the `--keep-metadata` flag \n" +
+			"\t- To remove the policy, run the command without any of these flags \n",
+		Example: "\t- {{alias}} foo --keep-last 10 \n" +
+			"\t- {{alias}} foo@master --keep-newer-than 720h --keep-metadata release=true \n" +
+			"\t- {{alias}} foo ➔ removes the retention policy of repo foo \n",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			c, err := pachctlCfg.NewOnUserMachine(mainCtx, false)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			var policy *pfs.RetentionPolicy
+			if keepLast > 0 || keepNewerThan > 0 || len(keepMetadata) > 0 {
+				policy = &pfs.RetentionPolicy{KeepLast: keepLast, KeepMetadata: keepMetadata}
+				if keepNewerThan > 0 {
+					policy.KeepNewerThan = durationpb.New(keepNewerThan)
+				}
+			}
+			request := &pfs.SetRetentionPolicyRequest{Policy: policy}
+			if strings.Contains(args[0], "@") {
+				branch, err := cmdutil.ParseBranch(project, args[0])
+				if err != nil {
+					return err
+				}
+				request.Target = &pfs.SetRetentionPolicyRequest_Branch{Branch: branch}
+			} else {
+				request.Target = &pfs.SetRetentionPolicyRequest_Repo{Repo: cmdutil.ParseRepo(project, args[0])}
+			}
+			_, err = c.PfsAPIClient.SetRetentionPolicy(c.Ctx(), request)
+			return grpcutil.ScrubGRPC(err)
+		}),
+	}
+	setRetentionPolicy.Flags().Int64Var(&keepLast, "keep-last", 0, "Keep the given number of most recent commits on each branch.")
+	setRetentionPolicy.Flags().DurationVar(&keepNewerThan, "keep-newer-than", 0, "Keep commits started within the given duration.")
+	setRetentionPolicy.Flags().StringToStringVar(&keepMetadata, "keep-metadata", nil, "Keep commits whose metadata contains all of the given key=value pairs.")
+	setRetentionPolicy.Flags().StringVar(&project, "project", project, "Specify the project (by name) containing the repo.")
+	shell.RegisterCompletionFunc(setRetentionPolicy, shell.RepoCompletion)
+	commands = append(commands, cmdutil.CreateAlias(setRetentionPolicy, "set retention-policy"))
+
+	var dryRun bool
+	applyRetentionPolicy := &cobra.Command{
+		Use:   "{{alias}} <repo>[@<branch>]",
+		Short: "Squash the commits that have expired under a retention policy.",
+		Long: "This command immediately squashes the commits of a repo or branch that have expired under their retention policy, and prints the squashed commits. " +
+			"The PFS master also does this periodically in the background. \n" +
+			"\n" +
+			"\t- To preview which commits would be squashed without squashing them, use the `--dry-run` flag \n",
+		Example: "\t- {{alias}} foo \n" +
+			"\t- {{alias}} foo@master --dry-run \n",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			c, err := pachctlCfg.NewOnUserMachine(mainCtx, false)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			request := &pfs.ApplyRetentionPolicyRequest{DryRun: dryRun}
+			if strings.Contains(args[0], "@") {
+				branch, err := cmdutil.ParseBranch(project, args[0])
+				if err != nil {
+					return err
+				}
+				request.Target = &pfs.ApplyRetentionPolicyRequest_Branch{Branch: branch}
+			} else {
+				request.Target = &pfs.ApplyRetentionPolicyRequest_Repo{Repo: cmdutil.ParseRepo(project, args[0])}
+			}
+			resp, err := c.PfsAPIClient.ApplyRetentionPolicy(c.Ctx(), request)
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			if raw {
+				return errors.EnsureStack(cmdutil.Encoder(output, os.Stdout).EncodeProto(resp))
+			} else if output != "" {
+				return errors.New("cannot set --output (-o) without --raw")
+			}
+			for _, commit := range resp.Expired {
+				fmt.Println(commit.Key())
+			}
+			return nil
+		}),
+	}
+	applyRetentionPolicy.Flags().BoolVar(&dryRun, "dry-run", false, "Print the commits that would be squashed without squashing them.")
+	applyRetentionPolicy.Flags().StringVar(&project, "project", project, "Specify the project (by name) containing the repo.")
+	applyRetentionPolicy.Flags().AddFlagSet(outputFlags)
+	shell.RegisterCompletionFunc(applyRetentionPolicy, shell.RepoCompletion)
+	commands = append(commands, cmdutil.CreateAlias(applyRetentionPolicy, "apply retention-policy"))
+
+	purgeFile := &cobra.Command{
+		Use:   "{{alias}} <repo>:<path>",
+		Short: "Permanently remove a file from every commit of a repo.",
+		Long: "This command rewrites every commit of a repo without the file at the given path, or without every file under it if the path is a directory. " +
+			"The output that downstream pipelines derived from the file is purged as well, so their next jobs reprocess the affected datums. \n" +
+			"\n" +
+			"Once the rewritten commits' previous data expires, garbage collection deletes the chunks that only the purged files referenced. " +
+			"Every commit of the affected repos must be finished, and purging requires the cluster-wide permission to delete commits. " +
+			"The purge fails if the purged data is still referenced outside of the affected repos, e.g. by a copy of a purged file in another repo. \n",
+		Example: "\t- {{alias}} foo:/customers/1234.json \n" +
+			"\t- {{alias}} foo:/customers/1234 --project bar \n",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			repoName, path, ok := strings.Cut(args[0], ":")
+			if !ok || path == "" {
+				return errors.Errorf("invalid format %q: must be <repo>:<path>", args[0])
+			}
+			c, err := pachctlCfg.NewOnUserMachine(mainCtx, false)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			resp, err := c.PfsAPIClient.PurgePath(c.Ctx(), &pfs.PurgePathRequest{
+				Repo: cmdutil.ParseRepo(project, repoName),
+				Path: path,
+			})
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			if raw {
+				return errors.EnsureStack(cmdutil.Encoder(output, os.Stdout).EncodeProto(resp))
+			} else if output != "" {
+				return errors.New("cannot set --output (-o) without --raw")
+			}
+			for _, commit := range resp.Commits {
+				fmt.Printf("rewrote commit %s\n", commit.Key())
+			}
+			for _, datums := range resp.Datums {
+				fmt.Printf("purged %d datum(s) from %s\n", len(datums.Datums), datums.Repo.Key())
+			}
+			fmt.Printf("%d chunk(s) are no longer referenced\n", len(resp.UnreferencedChunks))
+			return nil
+		}),
+	}
+	purgeFile.Flags().StringVar(&project, "project", project, "Specify the project (by name) containing the repo.")
+	purgeFile.Flags().AddFlagSet(outputFlags)
+	shell.RegisterCompletionFunc(purgeFile, shell.RepoCompletion)
+	commands = append(commands, cmdutil.CreateAlias(purgeFile, "purge file"))
+
+	var storageLimit int64
+	inspectStorage := &cobra.Command{
+		Use:   "{{alias}}",
+		Short: "Report the storage used by each project and repo.",
+		Long: "This command reports how much object storage each project and repo uses, and how much of it is saved by deduplication. " +
+			"Sizes are measured in the chunks that data is stored in, after compression and encryption, and only finished commits are counted. \n" +
+			"\n" +
+			"\t- LOGICAL is the size the commits would have if no chunks were shared between them \n" +
+			"\t- PHYSICAL is the size of the distinct chunks that the commits reference \n" +
+			"\t- UNIQUE is the size of the chunks that no other project or repo references \n" +
+			"\n" +
+			"The pairs of repos that share the most data are listed last. Analyzing every commit in the cluster is expensive, so pachd does it periodically in the background " +
+			"(see STORAGE_STATS_PERIOD), and this command reports the result of the last analysis. This command requires the REPO_READ permission on the cluster.",
+		Example: "\t- {{alias}} \n" +
+			"\t- {{alias}} --limit 0 \n" +
+			"\t- {{alias}} --raw \n",
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			c, err := pachctlCfg.NewOnUserMachine(mainCtx, false)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			resp, err := c.PfsAPIClient.InspectStorage(c.Ctx(), &pfs.InspectStorageRequest{Limit: storageLimit})
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			if raw {
+				return errors.EnsureStack(cmdutil.Encoder(output, os.Stdout).EncodeProto(resp))
+			} else if output != "" {
+				return errors.New("cannot set --output (-o) without --raw")
+			}
+			pretty.PrintStorageComputedAt(os.Stdout, resp)
+			fmt.Print("Total: ")
+			pretty.PrintStorageStats(os.Stdout, resp.Total)
+			fmt.Println()
+			writer := tabwriter.NewWriter(os.Stdout, pretty.ProjectStorageHeader)
+			for _, stats := range resp.Projects {
+				pretty.PrintProjectStorageStats(writer, stats)
+			}
+			if err := writer.Flush(); err != nil {
+				return err
+			}
+			fmt.Println()
+			writer = tabwriter.NewWriter(os.Stdout, pretty.RepoStorageHeader)
+			for _, stats := range resp.Repos {
+				pretty.PrintRepoStorageStats(writer, stats)
+			}
+			if err := writer.Flush(); err != nil {
+				return err
+			}
+			if len(resp.Shared) == 0 {
+				return nil
+			}
+			fmt.Println()
+			writer = tabwriter.NewWriter(os.Stdout, pretty.SharedStorageHeader)
+			for _, stats := range resp.Shared {
+				pretty.PrintSharedStorageStats(writer, stats)
+			}
+			return writer.Flush()
+		}),
+	}
+	inspectStorage.Flags().Int64Var(&storageLimit, "limit", 10, "Show at most this many repos and pairs of repos, or all of them if 0.")
+	inspectStorage.Flags().AddFlagSet(outputFlags)
+	commands = append(commands, cmdutil.CreateAlias(inspectStorage, "inspect storage"))
+
+	var readChunkData, checkConsistency bool
+	var consistencyLimit int64
+	checkStorage := &cobra.Command{
+		Use:   "{{alias}}",
+		Short: "Check the integrity of object storage.",
+		Long: "This command checks that every chunk that PFS knows about is present in object storage. " +
+			"With --read-chunk-data, the content of each chunk is also read and verified. \n" +
+			"\n" +
+			"With --consistency, the objects in object storage are also cross-checked against the chunk entries in the database and the tracker, and anything that garbage collection will never clean up is reported: \n" +
+			"\n" +
+			"\t- missing objects are chunks that PFS knows about but that are not in object storage \n" +
+			"\t- untracked chunk entries are chunks that nothing can ever delete \n" +
+			"\t- orphaned objects are objects in object storage that PFS does not know about \n" +
+			"\n" +
+			"Objects that are waiting to be garbage collected are counted, but are not a problem. The command fails if any problem is found.",
+		Example: "\t- {{alias}} \n" +
+			"\t- {{alias}} --read-chunk-data \n" +
+			"\t- {{alias}} --consistency --limit 100 \n",
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			c, err := pachctlCfg.NewOnUserMachine(mainCtx, false)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			resp, err := c.PfsAPIClient.CheckStorage(c.Ctx(), &pfs.CheckStorageRequest{
+				ReadChunkData:    readChunkData,
+				CheckConsistency: checkConsistency,
+				ConsistencyLimit: consistencyLimit,
+			})
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			if raw {
+				return errors.EnsureStack(cmdutil.Encoder(output, os.Stdout).EncodeProto(resp))
+			} else if output != "" {
+				return errors.New("cannot set --output (-o) without --raw")
+			}
+			fmt.Printf("Checked %d chunk objects.\n", resp.ChunkObjectCount)
+			if cons := resp.Consistency; cons != nil {
+				pretty.PrintStorageConsistency(os.Stdout, cons)
+				if cons.MissingObjectCount > 0 || cons.UntrackedEntryCount > 0 || cons.OrphanedObjectCount > 0 {
+					return errors.New("storage is inconsistent")
+				}
+			}
+			return nil
+		}),
+	}
+	checkStorage.Flags().BoolVar(&readChunkData, "read-chunk-data", false, "Read and verify the content of every chunk.")
+	checkStorage.Flags().BoolVar(&checkConsistency, "consistency", false, "Cross-check object storage against the chunk entries and the tracker.")
+	checkStorage.Flags().Int64Var(&consistencyLimit, "limit", 10, "Show at most this many examples of each problem, or all of them if 0.")
+	checkStorage.Flags().AddFlagSet(outputFlags)
+	commands = append(commands, cmdutil.CreateAlias(checkStorage, "check storage"))
+
 	// FILE COMMANDS
 
 	fileDocs := &cobra.Command{
@@ -1500,6 +1963,8 @@ func Cmds(mainCtx context.Context, pachCtx *config.Context, pachctlCfg *pachctl.
 	var enableProgress bool
 	var fullPath bool
 	var untar bool
+	var contentType string
+	var fileMetadata map[string]string
 	putFile := &cobra.Command{
 		Use:   "{{alias}} <repo>@<branch-or-commit>[:<path/to/file>]",
 		Short: "Put a file into the filesystem.",
@@ -1518,6 +1983,11 @@ func Cmds(mainCtx context.Context, pachCtx *config.Context, pachctlCfg *pachctl.
 			"\t- To define the maximum number of files that can be uploaded in parallel, use the `-p` flag \n" +
 			"\t- To append to an existing file, use the `-a` flag \n" +
 			"\n" +
+			"Metadata: \n" +
+			"\t- To set the content type of the files, use the `--content-type` flag \n" +
+			"\t- To store key=value metadata with the files, use the `--metadata` flag \n" +
+			"\t- Setting either replaces the content type and metadata that the files already have \n" +
+			"\n" +
 			"Other: \n" +
 			"\t- To enable progress bars, use the `-P` flag \n",
 
@@ -1531,7 +2001,8 @@ func Cmds(mainCtx context.Context, pachCtx *config.Context, pachctlCfg *pachctl.
 			"\t- {{alias}} repo@branch -i file \n" +
 			"\t- {{alias}} repo@branch -i http://host/path \n" +
 			"\t- {{alias}} repo@branch -f -untar dir.tar \n" +
-			"\t- {{alias}} repo@branch -f -c image.png \n",
+			"\t- {{alias}} repo@branch -f -c image.png \n" +
+			"\t- {{alias}} repo@branch -f image.png --content-type image/png --metadata source=camera-1 \n",
 
 		Run: cmdutil.RunFixedArgs(1, func(args []string) (retErr error) {
 			if !enableProgress {
@@ -1604,6 +2075,13 @@ func Cmds(mainCtx context.Context, pachCtx *config.Context, pachctlCfg *pachctl.
 				sources = filePaths
 			}
 
+			var putFileOpts []client.PutFileOption
+			if contentType != "" {
+				putFileOpts = append(putFileOpts, client.WithContentTypePutFile(contentType))
+			}
+			if len(fileMetadata) > 0 {
+				putFileOpts = append(putFileOpts, client.WithMetadataPutFile(fileMetadata))
+			}
 			return c.WithModifyFileClient(file.Commit, func(mf client.ModifyFile) error {
 				for _, source := range sources {
 					source := source
@@ -1616,13 +2094,13 @@ func Cmds(mainCtx context.Context, pachCtx *config.Context, pachctlCfg *pachctl.
 						if !fullPath {
 							target = filepath.Base(source)
 						}
-						if err := putFileHelper(mf, joinPaths("", target), source, recursive, appendFile, untar); err != nil {
+						if err := putFileHelper(mf, joinPaths("", target), source, recursive, appendFile, untar, putFileOpts...); err != nil {
 							return err
 						}
 					} else if len(sources) == 1 {
 						// We have a single source and the user has specified a path,
 						// we use the path and ignore source (in terms of naming the file).
-						if err := putFileHelper(mf, file.Path, source, recursive, appendFile, untar); err != nil {
+						if err := putFileHelper(mf, file.Path, source, recursive, appendFile, untar, putFileOpts...); err != nil {
 							return err
 						}
 					} else {
@@ -1632,7 +2110,7 @@ func Cmds(mainCtx context.Context, pachCtx *config.Context, pachctlCfg *pachctl.
 						if !fullPath {
 							target = filepath.Base(source)
 						}
-						if err := putFileHelper(mf, joinPaths(file.Path, target), source, recursive, appendFile, untar); err != nil {
+						if err := putFileHelper(mf, joinPaths(file.Path, target), source, recursive, appendFile, untar, putFileOpts...); err != nil {
 							return err
 						}
 					}
@@ -1650,6 +2128,8 @@ func Cmds(mainCtx context.Context, pachCtx *config.Context, pachctlCfg *pachctl.
 	putFile.Flags().BoolVar(&enableProgress, "progress", isatty.IsTerminal(os.Stdout.Fd()) || isatty.IsCygwinTerminal(os.Stdout.Fd()), "Print progress bars.")
 	putFile.Flags().BoolVar(&fullPath, "full-path", false, "Specify entire path provided to -f should be the target filename in PFS; by default only the base of the path is used.")
 	putFile.Flags().BoolVar(&untar, "untar", false, "Specify file(s) with the extension .tar should be untarred and put as a separate file for each file within the tar stream(s); gzipped (.tar.gz or .tgz) tar file(s) are handled as well")
+	putFile.Flags().StringVar(&contentType, "content-type", "", "Set the content type (MIME type) of the files, e.g. image/png.")
+	putFile.Flags().StringToStringVar(&fileMetadata, "metadata", nil, "Store the given key=value pairs as metadata with the files.")
 	putFile.Flags().StringVar(&project, "project", project, "Specify the project (by name) where the repo for uploading this file is located.")
 	shell.RegisterCompletionFunc(putFile,
 		func(flag, text string, maxCompletions int64) ([]prompt.Suggest, shell.CacheFunc) {
@@ -1720,6 +2200,7 @@ func Cmds(mainCtx context.Context, pachCtx *config.Context, pachctlCfg *pachctl.
 
 	var outputPath string
 	var offsetBytes int64
+	var byteRange string
 	var retry bool
 	getFile := &cobra.Command{
 		Use:   "{{alias}} <repo>@<branch-or-commit>:<path/in/pfs>",
@@ -1730,6 +2211,7 @@ func Cmds(mainCtx context.Context, pachCtx *config.Context, pachctlCfg *pachctl.
 			"\t- To specify the project where the repo is located, use the --project flag \n" +
 			"\t- To specify the output path, use the --output flag \n" +
 			"\t- To specify the number of bytes to offset the read by, use the --offset-bytes flag \n" +
+			"\t- To read only part of the file, use the --range flag with an inclusive range of bytes (e.g., `0-99`), an open-ended range (e.g., `100-`), or a number of bytes from the end (e.g., `-100`) \n" +
 			"\t- To retry the operation if it fails, use the --retry flag \n",
 
 		Example: "\t- {{alias}} foo@master:image.png \n" +
@@ -1738,6 +2220,8 @@ func Cmds(mainCtx context.Context, pachCtx *config.Context, pachctlCfg *pachctl.
 			"\t- {{alias}} foo@master:image.png --output /path/to/image.png \n" +
 			"\t- {{alias}} foo@master:/logs/log.txt--offset-bytes 100 \n" +
 			"\t- {{alias}} foo@master:image.png --retry \n" +
+			"\t- {{alias}} foo@master:/logs/log.txt --range 0-1023 \n" +
+			"\t- {{alias}} foo@master:/logs/log.txt --range -100 \n" +
 			"\t- {{alias}} foo@master:/logs/log.txt --output /path/to/image.png --offset-bytes 100 --retry \n" +
 			"\t- {{alias}} foo@master^:chart.png \n" +
 			"\t- {{alias}} foo@master^2:chart.png  \n" +
@@ -1758,6 +2242,20 @@ func Cmds(mainCtx context.Context, pachCtx *config.Context, pachctlCfg *pachctl.
 			}
 			defer c.Close()
 			defer progress.Wait()
+			var sizeBytes int64
+			if byteRange != "" {
+				if recursive || retry || offsetBytes != 0 {
+					return errors.Errorf("--range cannot be used with --recursive, --retry or --offset")
+				}
+				fi, err := c.InspectFile(file.Commit, file.Path)
+				if err != nil {
+					return err
+				}
+				offsetBytes, sizeBytes, err = parseByteRange(byteRange, fi.SizeBytes)
+				if err != nil {
+					return err
+				}
+			}
 			// TODO: Decide what progress should look like in the recursive case. The files are downloaded in a batch in 2.x.
 			if recursive {
 				if outputPath == "" {
@@ -1783,24 +2281,31 @@ func Cmds(mainCtx context.Context, pachCtx *config.Context, pachctlCfg *pachctl.
 				w = os.Stdout
 			} else {
 				if url, err := url.Parse(outputPath); err == nil && url.Scheme != "" {
+					if byteRange != "" {
+						return errors.Errorf("--range cannot be used when the output is a URL")
+					}
 					return c.GetFileURL(file.Commit, file.Path, url.String())
 				}
 				fi, err := c.InspectFile(file.Commit, file.Path)
 				if err != nil {
 					return err
 				}
+				size := fi.SizeBytes - offsetBytes
+				if sizeBytes > 0 {
+					size = sizeBytes
+				}
 				var f *progress.File
 				if ofi, err := os.Stat(outputPath); retry && err == nil {
 					// when retrying, just write the unwritten bytes
 					if offsetBytes == 0 {
 						offsetBytes = ofi.Size()
 					}
-					f, err = progress.OpenAppend(outputPath, int64(fi.SizeBytes)-offsetBytes)
+					f, err = progress.OpenAppend(outputPath, fi.SizeBytes-offsetBytes)
 					if err != nil {
 						return err
 					}
 				} else {
-					f, err = progress.Create(outputPath, int64(fi.SizeBytes)-offsetBytes)
+					f, err = progress.Create(outputPath, size)
 					if err != nil {
 						return err
 					}
@@ -1808,7 +2313,7 @@ func Cmds(mainCtx context.Context, pachCtx *config.Context, pachctlCfg *pachctl.
 				defer f.Close()
 				w = f
 			}
-			if err := c.GetFile(file.Commit, file.Path, w, client.WithOffset(offsetBytes)); err != nil {
+			if err := c.GetFile(file.Commit, file.Path, w, client.WithRange(offsetBytes, sizeBytes)); err != nil {
 				msg := err.Error()
 				if strings.Contains(msg, pfsserver.GetFileTARSuggestion) {
 					err = errors.New(strings.ReplaceAll(msg, pfsserver.GetFileTARSuggestion, "Try again with the -r flag"))
@@ -1822,6 +2327,7 @@ func Cmds(mainCtx context.Context, pachCtx *config.Context, pachctlCfg *pachctl.
 	getFile.Flags().StringVarP(&outputPath, "output", "o", "", "Set the path where data will be downloaded.")
 	getFile.Flags().BoolVar(&enableProgress, "progress", isatty.IsTerminal(os.Stdout.Fd()) || isatty.IsCygwinTerminal(os.Stdout.Fd()), "{true|false} Whether or not to print the progress bars.")
 	getFile.Flags().Int64Var(&offsetBytes, "offset", 0, "Set the number of bytes in the file to skip ahead when reading.")
+	getFile.Flags().StringVar(&byteRange, "range", "", "Read only the given range of bytes: 'start-end' (inclusive), 'start-' (to the end of the file) or '-n' (the last n bytes).")
 	getFile.Flags().BoolVar(&retry, "retry", false, "{true|false} Whether to append the missing bytes to an existing file. No-op if the file doesn't exist.")
 	getFile.Flags().StringVar(&project, "project", project, "Specify the project (by name) where the file's repo is located.")
 	shell.RegisterCompletionFunc(getFile, shell.FileCompletion)
@@ -2254,10 +2760,12 @@ func Cmds(mainCtx context.Context, pachCtx *config.Context, pachctlCfg *pachctl.
 	return commands
 }
 
-func putFileHelper(mf client.ModifyFile, path, source string, recursive, appendFile, untar bool) (retErr error) {
+// putFileHelper puts source at path.  metadataOpts set the content type and metadata of the files
+// that it puts.
+func putFileHelper(mf client.ModifyFile, path, source string, recursive, appendFile, untar bool, metadataOpts ...client.PutFileOption) (retErr error) {
 	// Resolve the path and convert to unix path in case we're on windows.
 	path = filepath.ToSlash(filepath.Clean(path))
-	var opts []client.PutFileOption
+	opts := append([]client.PutFileOption{}, metadataOpts...)
 	if appendFile {
 		opts = append(opts, client.WithAppendPutFile())
 	}
@@ -2288,7 +2796,7 @@ func putFileHelper(mf client.ModifyFile, path, source string, recursive, appendF
 			// don't do a second recursive 'put file', just put the one file at
 			// filePath into childDest, and then this walk loop will go on to the
 			// next one
-			return putFileHelper(mf, childDest, filePath, false, appendFile, untar)
+			return putFileHelper(mf, childDest, filePath, false, appendFile, untar, metadataOpts...)
 		})
 		return errors.EnsureStack(err)
 	}
@@ -2411,6 +2919,90 @@ func newClient(ctx context.Context, pachctlCfg *pachctl.Config, options ...clien
 	return pachctlCfg.NewOnUserMachine(ctx, false, options...)
 }
 
+// parseMetadataEdit converts the arguments of 'edit metadata' into an
+// EditMetadataRequest_Edit.
+func parseMetadataEdit(project string, args []string) (*pfs.EditMetadataRequest_Edit, error) {
+	kind, object, op, data := args[0], args[1], args[2], args[3]
+	edit := &pfs.EditMetadataRequest_Edit{}
+	switch strings.ToLower(kind) {
+	case "project":
+		edit.Target = &pfs.EditMetadataRequest_Edit_Project{Project: &pfs.Project{Name: object}}
+	case "repo":
+		edit.Target = &pfs.EditMetadataRequest_Edit_Repo{Repo: cmdutil.ParseRepo(project, object)}
+	case "branch":
+		branch, err := cmdutil.ParseBranch(project, object)
+		if err != nil {
+			return nil, err
+		}
+		edit.Target = &pfs.EditMetadataRequest_Edit_Branch{Branch: branch}
+	case "commit":
+		commit, err := cmdutil.ParseCommit(project, object)
+		if err != nil {
+			return nil, err
+		}
+		edit.Target = &pfs.EditMetadataRequest_Edit_Commit{Commit: commit}
+	default:
+		return nil, errors.Errorf("unknown object type %q, must be one of: project, repo, branch, commit", kind)
+	}
+	switch strings.ToLower(op) {
+	case "add", "edit":
+		key, value, ok := strings.Cut(data, "=")
+		if !ok || key == "" {
+			return nil, errors.Errorf("metadata %q must be of the form key=value", data)
+		}
+		if strings.ToLower(op) == "add" {
+			edit.Op = &pfs.EditMetadataRequest_Edit_AddKey_{AddKey: &pfs.EditMetadataRequest_Edit_AddKey{Key: key, Value: value}}
+		} else {
+			edit.Op = &pfs.EditMetadataRequest_Edit_EditKey_{EditKey: &pfs.EditMetadataRequest_Edit_EditKey{Key: key, Value: value}}
+		}
+	case "delete":
+		edit.Op = &pfs.EditMetadataRequest_Edit_DeleteKey_{DeleteKey: &pfs.EditMetadataRequest_Edit_DeleteKey{Key: data}}
+	case "replace":
+		replacement := make(map[string]string)
+		if err := json.Unmarshal([]byte(data), &replacement); err != nil {
+			return nil, errors.Wrapf(err, "replacement metadata must be a JSON object of strings")
+		}
+		edit.Op = &pfs.EditMetadataRequest_Edit_Replace_{Replace: &pfs.EditMetadataRequest_Edit_Replace{Replacement: replacement}}
+	default:
+		return nil, errors.Errorf("unknown metadata operation %q, must be one of: add, edit, delete, replace", op)
+	}
+	return edit, nil
+}
+
+// parseByteRange parses the --range flag of get file for a file of the given size, and returns the
+// offset and number of bytes to read.  The range is one of "start-end" (inclusive), "start-" or
+// "-n", as in an HTTP Range header.
+func parseByteRange(rng string, size int64) (offset, length int64, _ error) {
+	first, last, ok := strings.Cut(rng, "-")
+	if !ok {
+		return 0, 0, errors.Errorf("invalid range %q; expected start-end, start- or -n", rng)
+	}
+	if first == "" {
+		n, err := strconv.ParseInt(last, 10, 64)
+		if err != nil || n <= 0 {
+			return 0, 0, errors.Errorf("invalid range %q; the number of bytes must be a positive integer", rng)
+		}
+		n = min(n, size)
+		return size - n, n, nil
+	}
+	start, err := strconv.ParseInt(first, 10, 64)
+	if err != nil || start < 0 {
+		return 0, 0, errors.Errorf("invalid range %q; the start must be a non-negative integer", rng)
+	}
+	if start >= size {
+		return 0, 0, errors.Errorf("range %q starts after the end of the file (%d bytes)", rng, size)
+	}
+	end := size - 1
+	if last != "" {
+		e, err := strconv.ParseInt(last, 10, 64)
+		if err != nil || e < start {
+			return 0, 0, errors.Errorf("invalid range %q; the end must be an integer no less than the start", rng)
+		}
+		end = min(e, end)
+	}
+	return start, end - start + 1, nil
+}
+
 func parseOriginKind(input string) (pfs.OriginKind, error) {
 	if input == "" {
 		return pfs.OriginKind_ORIGIN_KIND_UNKNOWN, nil
@@ -623,3 +623,31 @@ func TestDeleteProject(t *testing.T) {
 		"pipeline", tu.UniqueString("pipeline"),
 	).Run())
 }
+
+func TestParseByteRange(t *testing.T) {
+	for _, test := range []struct {
+		rng            string
+		offset, length int64
+		wantErr        bool
+	}{
+		{rng: "0-9", offset: 0, length: 10},
+		{rng: "90-", offset: 90, length: 10},
+		{rng: "-5", offset: 95, length: 5},
+		{rng: "-500", offset: 0, length: 100},
+		{rng: "50-500", offset: 50, length: 50},
+		{rng: "100-", wantErr: true},
+		{rng: "9-0", wantErr: true},
+		{rng: "-0", wantErr: true},
+		{rng: "9", wantErr: true},
+		{rng: "a-b", wantErr: true},
+	} {
+		offset, length, err := parseByteRange(test.rng, 100)
+		if test.wantErr {
+			require.YesError(t, err, "range %q", test.rng)
+			continue
+		}
+		require.NoError(t, err, "range %q", test.rng)
+		require.Equal(t, test.offset, offset, "offset of range %q", test.rng)
+		require.Equal(t, test.length, length, "length of range %q", test.rng)
+	}
+}
@@ -1223,6 +1223,9 @@ func (mm *MountManager) parseInput(datumInput *pps.Input) error {
 		if input.Cron != nil {
 			return errors.New("cron input type not supported in mounting datums")
 		}
+		if input.Bucket != nil {
+			return errors.New("bucket input type not supported in mounting datums")
+		}
 		if input.Join != nil {
 			simpleInput = false
 			set++
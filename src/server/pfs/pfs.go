@@ -514,5 +514,13 @@ func ValidateSQLDatabaseEgress(sql *pfs.SQLDatabaseEgress) error {
 	if secret.Name == "" || secret.Key == "" {
 		return errors.Errorf("egress.sql_database.secret.name and egress.sql_database.secret.key are required")
 	}
+	for table, key := range sql.GetPrimaryKeys() {
+		if len(key.GetColumns()) == 0 {
+			return errors.Errorf("egress.sql_database.primary_keys[%q] must have at least one column", table)
+		}
+	}
+	if sql.Mode == pfs.SQLDatabaseEgress_UPSERT && len(sql.GetPrimaryKeys()) == 0 {
+		return errors.Errorf("egress.sql_database.primary_keys is required in UPSERT mode")
+	}
 	return nil
 }
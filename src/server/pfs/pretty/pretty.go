@@ -5,6 +5,7 @@ import (
 	"html/template"
 	"io"
 	"os"
+	"sort"
 	"strings"
 
 	units "github.com/docker/go-units"
@@ -29,6 +30,8 @@ const (
 	CommitSetHeader = "ID\tSUBCOMMITS\tPROGRESS\tCREATED\tMODIFIED\t\n"
 	// BranchHeader is the header for branches.
 	BranchHeader = "BRANCH\tHEAD\tTRIGGER\t\n"
+	// TagHeader is the header for tags.
+	TagHeader = "TAG\tCOMMIT\tCREATED\t\n"
 	// ProjectHeader is the header for the projects.
 	ProjectHeader = "ACTIVE\tPROJECT\tCREATED\tDESCRIPTION\n"
 	// ProjectAuthHeader is the header for the projects with auth info attached.
@@ -40,6 +43,12 @@ const (
 	FileHeaderWithCommit = "COMMIT\tNAME\tTYPE\tCOMMITTED\tSIZE\t\n"
 	// DiffFileHeader is the header for files produced by diff file.
 	DiffFileHeader = "OP\t" + FileHeader
+	// ProjectStorageHeader is the header for the storage used by projects.
+	ProjectStorageHeader = "PROJECT\tLOGICAL\tPHYSICAL\tUNIQUE\tCHUNKS\tDEDUP\t\n"
+	// RepoStorageHeader is the header for the storage used by repos.
+	RepoStorageHeader = "PROJECT\tREPO\tLOGICAL\tPHYSICAL\tUNIQUE\tCHUNKS\tDEDUP\t\n"
+	// SharedStorageHeader is the header for the storage shared by pairs of repos.
+	SharedStorageHeader = "REPO\tREPO\tSHARED\tRATIO\t\n"
 )
 
 // PrintRepoInfo pretty-prints repo info.
@@ -86,7 +95,9 @@ func NewPrintableRepoInfo(ri *pfs.RepoInfo) *PrintableRepoInfo {
 func PrintDetailedRepoInfo(repoInfo *PrintableRepoInfo) error {
 	template, err := template.New("RepoInfo").Funcs(funcMap).Parse(
 		`Name: {{.Repo.Name}}{{if .Description}}
-Description: {{.Description}}{{end}}{{if .FullTimestamps}}
+Description: {{.Description}}{{end}}{{if .Metadata}}
+Metadata: {{printMetadata .Metadata}}{{end}}{{if .RetentionPolicy}}
+Retention Policy: {{printRetentionPolicy .RetentionPolicy}}{{end}}{{if .FullTimestamps}}
 Created: {{prettyTime .Created}}{{else}}
 Created: {{prettyAgo .Created}}{{end}}{{if .Details}}
 Size of HEAD on master: {{prettySize .Details.SizeBytes}}{{end}}{{if .AuthInfo}}
@@ -138,6 +149,18 @@ func PrintBranch(w io.Writer, branchInfo *pfs.BranchInfo) {
 	fmt.Fprintln(w)
 }
 
+// PrintTag pretty-prints a tag.
+func PrintTag(w io.Writer, tagInfo *pfs.TagInfo, fullTimestamps bool) {
+	fmt.Fprintf(w, "%s\t", tagInfo.Tag.Name)
+	fmt.Fprintf(w, "%s\t", tagInfo.Commit.Id)
+	if fullTimestamps {
+		fmt.Fprintf(w, "%s\t", pretty.Timestamp(tagInfo.Created))
+	} else {
+		fmt.Fprintf(w, "%s\t", pretty.Ago(tagInfo.Created))
+	}
+	fmt.Fprintln(w)
+}
+
 // PrintProjectInfo pretty-prints a project.
 func PrintProjectInfo(w io.Writer, projectInfo *pfs.ProjectInfo, currentProject *pfs.Project) {
 	var line []string
@@ -174,7 +197,9 @@ func PrintDetailedBranchInfo(branchInfo *pfs.BranchInfo) error {
 		`Name: {{.Branch.Repo.Name}}@{{.Branch.Name}}{{if .Head}}
 Head Commit: {{ .Head.Branch.Repo.Name}}@{{.Head.Id}} {{end}}{{if .Provenance}}
 Provenance: {{range .Provenance}}{{.Repo.Name}}@{{.Name}} {{end}}{{end}}{{if .Trigger}}
-Trigger: {{printTrigger .Trigger}} {{end}}
+Trigger: {{printTrigger .Trigger}} {{end}}{{if .Metadata}}
+Metadata: {{printMetadata .Metadata}}{{end}}{{if .RetentionPolicy}}
+Retention Policy: {{printRetentionPolicy .RetentionPolicy}}{{end}}
 `)
 	if err != nil {
 		return errors.EnsureStack(err)
@@ -182,6 +207,20 @@ Trigger: {{printTrigger .Trigger}} {{end}}
 	return errors.EnsureStack(template.Execute(os.Stdout, branchInfo))
 }
 
+// PrintDetailedTagInfo pretty-prints detailed tag info.
+func PrintDetailedTagInfo(tagInfo *pfs.TagInfo) error {
+	template, err := template.New("TagInfo").Funcs(funcMap).Parse(
+		`Name: {{.Tag.Repo.Name}}@{{.Tag.Name}}
+Commit: {{.Commit.Repo.Name}}@{{.Commit.Id}}{{if .Commit.Branch}}
+Branch: {{.Commit.Branch.Name}}{{end}}
+Created: {{prettyAgo .Created}}
+`)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	return errors.EnsureStack(template.Execute(os.Stdout, tagInfo))
+}
+
 // PrintDetailedProjectInfo pretty-prints detailed project info.
 func PrintDetailedProjectInfo(projectInfo *pfs.ProjectInfo) error {
 	template, err := template.New("ProjectInfo").Funcs(funcMap).Parse(
@@ -189,6 +228,9 @@ func PrintDetailedProjectInfo(projectInfo *pfs.ProjectInfo) error {
 {{- if .Description }}
 Description: {{ .Description}}
 {{- end -}}
+{{- if .Metadata }}
+Metadata: {{ printMetadata .Metadata }}
+{{- end -}}
 {{- if .CreatedAt }}
 Created at: {{ prettyTime .CreatedAt }}
 {{- end -}}
@@ -342,7 +384,8 @@ func PrintDetailedCommitInfo(w io.Writer, commitInfo *PrintableCommitInfo) error
 	template, err := template.New("CommitInfo").Funcs(funcMap).Parse(
 		`Commit: {{.Commit.Branch.Repo.Name}}@{{.Commit.Id}}
 Original Branch: {{.Commit.Branch.Name}}{{if .Description}}
-Description: {{.Description}}{{end}}{{if .ParentCommit}}
+Description: {{.Description}}{{end}}{{if .Metadata}}
+Metadata: {{printMetadata .Metadata}}{{end}}{{if .ParentCommit}}
 Parent: {{.ParentCommit.Id}}{{end}}{{if .FullTimestamps}}
 Started: {{prettyTime .Started}}{{else}}
 Started: {{prettyAgo .Started}}{{end}}{{if .Finished}}{{if .FullTimestamps}}
@@ -378,7 +421,9 @@ func PrintDetailedFileInfo(fileInfo *pfs.FileInfo) error {
 		`Path: {{.File.Path}}
 Datum: {{.File.Datum}}
 Type: {{fileType .FileType}}
-Size: {{prettySize .SizeBytes}}
+Size: {{prettySize .SizeBytes}}{{if .ContentType}}
+Content Type: {{.ContentType}}{{end}}{{if .Metadata}}
+Metadata: {{printMetadata .Metadata}}{{end}}
 `)
 	if err != nil {
 		return errors.EnsureStack(err)
@@ -386,6 +431,77 @@ Size: {{prettySize .SizeBytes}}
 	return errors.EnsureStack(template.Execute(os.Stdout, fileInfo))
 }
 
+// PrintStorageComputedAt pretty-prints when storage statistics were computed.
+func PrintStorageComputedAt(w io.Writer, resp *pfs.InspectStorageResponse) {
+	fmt.Fprintf(w, "Computed: %s\n", pretty.Ago(resp.ComputedAt))
+}
+
+// PrintStorageStats pretty-prints the storage used by some data, on one line.
+func PrintStorageStats(w io.Writer, stats *pfs.StorageStats) {
+	fmt.Fprintf(w, "logical %s, physical %s, %d chunks, %s deduplication\n",
+		units.BytesSize(float64(stats.LogicalBytes)), units.BytesSize(float64(stats.PhysicalBytes)), stats.ChunkCount, dedupRatio(stats))
+}
+
+// PrintProjectStorageStats pretty-prints the storage used by a project.
+func PrintProjectStorageStats(w io.Writer, stats *pfs.ProjectStorageStats) {
+	fmt.Fprintf(w, "%s\t", stats.Project.Name)
+	printStorageColumns(w, stats.Stats)
+}
+
+// PrintRepoStorageStats pretty-prints the storage used by a repo.
+func PrintRepoStorageStats(w io.Writer, stats *pfs.RepoStorageStats) {
+	fmt.Fprintf(w, "%s\t", stats.Repo.Project.GetName())
+	if stats.Repo.Type == pfs.UserRepoType {
+		fmt.Fprintf(w, "%s\t", stats.Repo.Name)
+	} else {
+		fmt.Fprintf(w, "%s.%s\t", stats.Repo.Name, stats.Repo.Type)
+	}
+	printStorageColumns(w, stats.Stats)
+}
+
+// PrintStorageConsistency pretty-prints the result of a storage consistency check.
+func PrintStorageConsistency(w io.Writer, c *pfs.StorageConsistency) {
+	printProblem := func(what string, count int64, keys []string) {
+		fmt.Fprintf(w, "%s: %d\n", what, count)
+		for _, key := range keys {
+			fmt.Fprintf(w, "  %s\n", key)
+		}
+		if int64(len(keys)) < count {
+			fmt.Fprintf(w, "  ... and %d more\n", count-int64(len(keys)))
+		}
+	}
+	printProblem("Missing objects", c.MissingObjectCount, c.MissingObjects)
+	printProblem("Untracked chunk entries", c.UntrackedEntryCount, c.UntrackedEntries)
+	printProblem("Orphaned objects", c.OrphanedObjectCount, c.OrphanedObjects)
+	fmt.Fprintf(w, "Awaiting garbage collection: %d objects, %s\n", c.GarbageObjectCount, units.BytesSize(float64(c.GarbageBytes)))
+}
+
+// PrintSharedStorageStats pretty-prints the storage shared by a pair of repos.
+func PrintSharedStorageStats(w io.Writer, stats *pfs.SharedStorageStats) {
+	fmt.Fprintf(w, "%s\t", stats.RepoA)
+	fmt.Fprintf(w, "%s\t", stats.RepoB)
+	fmt.Fprintf(w, "%s\t", units.BytesSize(float64(stats.SharedBytes)))
+	fmt.Fprintf(w, "%.1f%%\t", 100*stats.SharedRatio)
+	fmt.Fprintln(w)
+}
+
+func printStorageColumns(w io.Writer, stats *pfs.StorageStats) {
+	fmt.Fprintf(w, "%s\t", units.BytesSize(float64(stats.LogicalBytes)))
+	fmt.Fprintf(w, "%s\t", units.BytesSize(float64(stats.PhysicalBytes)))
+	fmt.Fprintf(w, "%s\t", units.BytesSize(float64(stats.UniqueBytes)))
+	fmt.Fprintf(w, "%d\t", stats.ChunkCount)
+	fmt.Fprintf(w, "%s\t", dedupRatio(stats))
+	fmt.Fprintln(w)
+}
+
+// dedupRatio returns the ratio of logical to physical bytes, e.g. "2.5x".
+func dedupRatio(stats *pfs.StorageStats) string {
+	if stats.PhysicalBytes == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%.1fx", float64(stats.LogicalBytes)/float64(stats.PhysicalBytes))
+}
+
 func fileType(fileType pfs.FileType) string {
 	if fileType == pfs.FileType_FILE {
 		return "file"
@@ -393,13 +509,49 @@ func fileType(fileType pfs.FileType) string {
 	return "dir"
 }
 
+// printMetadata renders a metadata map as a sorted, comma-separated list of
+// key=value pairs.
+func printMetadata(metadata map[string]string) string {
+	keys := make([]string, 0, len(metadata))
+	for k := range metadata {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	pairs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		pairs = append(pairs, fmt.Sprintf("%s=%s", k, metadata[k]))
+	}
+	return strings.Join(pairs, ", ")
+}
+
+// printRetentionPolicy renders the conditions under which 'policy' keeps a
+// commit.
+func printRetentionPolicy(policy *pfs.RetentionPolicy) string {
+	var conds []string
+	if policy.KeepLast > 0 {
+		conds = append(conds, fmt.Sprintf("last %d commits", policy.KeepLast))
+	}
+	if policy.KeepNewerThan != nil {
+		conds = append(conds, fmt.Sprintf("newer than %v", policy.KeepNewerThan.AsDuration()))
+	}
+	if len(policy.KeepMetadata) > 0 {
+		conds = append(conds, fmt.Sprintf("metadata %s", printMetadata(policy.KeepMetadata)))
+	}
+	if len(conds) == 0 || (policy.KeepLast <= 0 && policy.KeepNewerThan == nil) {
+		return "keep all commits"
+	}
+	return "keep " + strings.Join(conds, " or ")
+}
+
 var funcMap = template.FuncMap{
-	"prettyAgo":    pretty.Ago,
-	"prettySize":   pretty.Size,
-	"prettyTime":   pretty.Timestamp,
-	"fileType":     fileType,
-	"printTrigger": printTrigger,
-	"commafy":      pretty.Commafy,
+	"prettyAgo":            pretty.Ago,
+	"prettySize":           pretty.Size,
+	"prettyTime":           pretty.Timestamp,
+	"fileType":             fileType,
+	"printTrigger":         printTrigger,
+	"commafy":              pretty.Commafy,
+	"printMetadata":        printMetadata,
+	"printRetentionPolicy": printRetentionPolicy,
 }
 
 // CompactPrintCommit renders 'c' as a compact string, e.g.
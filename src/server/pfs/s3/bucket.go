@@ -245,12 +245,80 @@ func (c *controller) DeleteBucket(r *http.Request, bucketName string) error {
 }
 
 func (c *controller) ListObjectVersions(r *http.Request, bucketName, prefix, keyMarker, versionIDMarker string, delimiter string, maxKeys int) (*s2.ListObjectVersionsResult, error) {
-	// NOTE: because this endpoint isn't implemented, conformance tests will
-	// fail on teardown. It's nevertheless unimplemented because it's too
-	// expensive to pull off with PFS until this is implemented:
-	// https://github.com/pachyderm/pachyderm/v2/issues/3896
 	defer log.Span(r.Context(), "ListObjectVersions", zap.String("bucketName", bucketName), zap.String("prefix", prefix), zap.String("keyMarker", keyMarker), zap.String("versionIDMarker", versionIDMarker), zap.String("delimiter", delimiter), zap.Int("maxKeys", maxKeys))()
-	return nil, s2.NotImplementedError(r)
+
+	prefix = strings.TrimPrefix(prefix, "/")
+
+	pc := c.requestClient(r)
+	if delimiter != "" && delimiter != "/" {
+		return nil, invalidDelimiterError(r)
+	}
+
+	bucket, err := c.driver.bucket(pc, r, bucketName)
+	if err != nil {
+		return nil, err
+	}
+	bucketCaps, err := c.driver.bucketCapabilities(pc, r, bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	result := s2.ListObjectVersionsResult{
+		Versions:      []*s2.Version{},
+		DeleteMarkers: []*s2.DeleteMarker{},
+	}
+
+	if !bucketCaps.readable {
+		return &result, nil
+	}
+
+	// s2 has no common prefixes for versions, so with a delimiter only the
+	// objects directly under the prefix are listed.
+	include := func(key string) bool {
+		if !strings.HasPrefix(key, prefix) {
+			return false
+		}
+		return delimiter == "" || !strings.Contains(key[len(prefix):], delimiter)
+	}
+
+	versions := newVersionPage(keyMarker, versionIDMarker, maxKeys)
+	if bucketCaps.historicVersions {
+		// Only diff the directory that holds the prefix.
+		dir := "/"
+		if i := strings.LastIndex(prefix, "/"); i >= 0 {
+			dir += prefix[:i]
+		}
+		if err := walkObjectVersions(pc, bucket.Commit, dir, func(v *objectVersion) error {
+			if include(v.key) {
+				versions.add(v)
+			}
+			return nil
+		}); err != nil {
+			return nil, maybeNotFoundError(r, err)
+		}
+	} else {
+		// Buckets without history have one version of each object: the
+		// current one.
+		if err := pc.GlobFile(bucket.Commit, fmt.Sprintf("%s**", glob.QuoteMeta(prefix)), func(fileInfo *pfsClient.FileInfo) error {
+			if fileInfo.FileType == pfsClient.FileType_FILE && include(fileInfo.File.Path[1:]) {
+				versions.add(currentVersion(fileInfo))
+			}
+			return nil
+		}); err != nil {
+			return nil, maybeNotFoundError(r, err)
+		}
+	}
+
+	page, truncated := versions.page()
+	for _, v := range page {
+		if v.isDeleteMarker() {
+			result.DeleteMarkers = append(result.DeleteMarkers, newDeleteMarker(v))
+		} else {
+			result.Versions = append(result.Versions, newVersion(v))
+		}
+	}
+	result.IsTruncated = truncated
+	return &result, nil
 }
 
 func (c *controller) GetBucketVersioning(r *http.Request, bucketName string) (string, error) {
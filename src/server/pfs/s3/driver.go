@@ -10,6 +10,7 @@ import (
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/client"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
 	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
@@ -77,6 +78,8 @@ func bucketNameToCommit(bucketName string) (*pfs.Commit, error) {
 
 	// the name is [[commitID.]branch.]repoName[.project]
 	// If unspecified, the branch is 'master', and the project is 'default'.
+	// A tag name may be used in place of the branch; pachd resolves it to the
+	// tagged commit if there's no branch with that name.
 	// However, two-component buckets are always interpreted as '<branch>.<repo>'.
 	// So, to specify a non-default project, the branch must be set explicitly.
 	//
@@ -127,7 +130,21 @@ func (d *MasterDriver) bucket(pc *client.APIClient, r *http.Request, name string
 func (d *MasterDriver) bucketCapabilities(pc *client.APIClient, r *http.Request, bucket *Bucket) (bucketCapabilities, error) {
 	_, err := pc.PfsAPIClient.InspectBranch(pc.Ctx(), &pfs.InspectBranchRequest{Branch: bucket.Commit.Branch})
 	if err != nil {
-		return bucketCapabilities{}, maybeNotFoundError(r, grpcutil.ScrubGRPC(err))
+		err = grpcutil.ScrubGRPC(err)
+		if !errutil.IsNotFoundError(err) || bucket.Commit.Id != "" {
+			return bucketCapabilities{}, maybeNotFoundError(r, err)
+		}
+		// The bucket may name a tag rather than a branch.  Tags are
+		// immutable, so the bucket is read-only and has a single version.
+		branch := bucket.Commit.Branch
+		if _, tagErr := pc.PfsAPIClient.InspectTag(pc.Ctx(), &pfs.InspectTagRequest{Tag: branch.Repo.NewTag(branch.Name)}); tagErr != nil {
+			return bucketCapabilities{}, maybeNotFoundError(r, err)
+		}
+		return bucketCapabilities{
+			readable:         true,
+			writable:         false,
+			historicVersions: false,
+		}, nil
 	}
 
 	return bucketCapabilities{
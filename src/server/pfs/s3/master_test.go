@@ -84,6 +84,22 @@ func masterGetObject(t *testing.T, pachClient *client.APIClient, minioClient *mi
 	require.Equal(t, "content", fetchedContent)
 }
 
+func masterGetObjectRange(t *testing.T, pachClient *client.APIClient, minioClient *minio.Client) {
+	repo := tu.UniqueString("testgetobjectrange")
+	require.NoError(t, pachClient.CreateRepo(pfs.DefaultProjectName, repo))
+	commit := client.NewCommit(pfs.DefaultProjectName, repo, "master", "")
+	require.NoError(t, pachClient.PutFile(commit, "file", strings.NewReader("0123456789")))
+
+	opts := minio.GetObjectOptions{}
+	require.NoError(t, opts.SetRange(2, 5))
+	obj, err := minioClient.GetObject(fmt.Sprintf("master.%s", repo), "file", opts)
+	require.NoError(t, err)
+	defer obj.Close()
+	content, err := io.ReadAll(obj)
+	require.NoError(t, err)
+	require.Equal(t, "2345", string(content))
+}
+
 func masterGetObjectInBranch(t *testing.T, pachClient *client.APIClient, minioClient *minio.Client) {
 	repo := tu.UniqueString("testgetobjectinbranch")
 	require.NoError(t, pachClient.CreateRepo(pfs.DefaultProjectName, repo))
@@ -137,6 +153,37 @@ func masterPutObject(t *testing.T, pachClient *client.APIClient, minioClient *mi
 	require.Equal(t, "content2", fetchedContent)
 }
 
+func masterObjectMetadata(t *testing.T, pachClient *client.APIClient, minioClient *minio.Client) {
+	repo := tu.UniqueString("testobjectmetadata")
+	require.NoError(t, pachClient.CreateRepo(pfs.DefaultProjectName, repo))
+
+	r := strings.NewReader("content")
+	_, err := minioClient.PutObject(fmt.Sprintf("master.%s", repo), "file", r, int64(r.Len()), minio.PutObjectOptions{
+		ContentType:  "text/csv",
+		UserMetadata: map[string]string{"Owner": "alice"},
+	})
+	require.NoError(t, err)
+
+	fileInfo, err := pachClient.InspectFile(client.NewCommit(pfs.DefaultProjectName, repo, "master", ""), "file")
+	require.NoError(t, err)
+	require.Equal(t, "text/csv", fileInfo.ContentType)
+	require.Equal(t, map[string]string{"owner": "alice"}, fileInfo.Metadata)
+
+	info, err := minioClient.StatObject(fmt.Sprintf("master.%s", repo), "file", minio.StatObjectOptions{})
+	require.NoError(t, err)
+	require.Equal(t, "text/csv", info.ContentType)
+	require.Equal(t, "alice", info.Metadata.Get("X-Amz-Meta-Owner"))
+
+	// Copies keep the content type and metadata of their source.
+	dst, err := minio.NewDestinationInfo(fmt.Sprintf("master.%s", repo), "copy", nil, nil)
+	require.NoError(t, err)
+	require.NoError(t, minioClient.CopyObject(dst, minio.NewSourceInfo(fmt.Sprintf("master.%s", repo), "file", nil)))
+	info, err = minioClient.StatObject(fmt.Sprintf("master.%s", repo), "copy", minio.StatObjectOptions{})
+	require.NoError(t, err)
+	require.Equal(t, "text/csv", info.ContentType)
+	require.Equal(t, "alice", info.Metadata.Get("X-Amz-Meta-Owner"))
+}
+
 func masterRemoveObject(t *testing.T, pachClient *client.APIClient, minioClient *minio.Client) {
 	repo := tu.UniqueString("testremoveobject")
 	require.NoError(t, pachClient.CreateRepo(pfs.DefaultProjectName, repo))
@@ -519,6 +566,9 @@ func TestMasterDriver(t *testing.T) {
 		t.Run("GetObject", func(t *testing.T) {
 			masterGetObject(t, pachClient, minioClient)
 		})
+		t.Run("GetObjectRange", func(t *testing.T) {
+			masterGetObjectRange(t, pachClient, minioClient)
+		})
 		t.Run("GetObjectInBranch", func(t *testing.T) {
 			masterGetObjectInBranch(t, pachClient, minioClient)
 		})
@@ -528,6 +578,9 @@ func TestMasterDriver(t *testing.T) {
 		t.Run("PutObject", func(t *testing.T) {
 			masterPutObject(t, pachClient, minioClient)
 		})
+		t.Run("ObjectMetadata", func(t *testing.T) {
+			masterObjectMetadata(t, pachClient, minioClient)
+		})
 		t.Run("RemoveObject", func(t *testing.T) {
 			masterRemoveObject(t, pachClient, minioClient)
 		})
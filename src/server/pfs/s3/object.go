@@ -3,12 +3,17 @@
 package s3
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"net/http"
 	"strings"
 
+	"github.com/pachyderm/pachyderm/v2/src/internal/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/httprange"
 	"github.com/pachyderm/pachyderm/v2/src/internal/log"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
 	pfsServer "github.com/pachyderm/pachyderm/v2/src/server/pfs"
@@ -34,25 +39,28 @@ func (c *controller) GetObject(r *http.Request, bucketName, file, version string
 		return nil, s2.NoSuchKeyError(r)
 	}
 
-	commitID := bucket.Commit.Id
+	commit := bucket.Commit
 	if version != "" {
 		if !bucketCaps.historicVersions {
 			return nil, s2.NotImplementedError(r)
 		}
-		commitID = version
+		commit = bucket.Commit.Branch.NewCommit(version)
 	}
 
 	// We use listFileResult[0] rather than InspectFile result since InspectFile
 	// on a path that has both a file and a directory in it returns the
 	// directory. However, ListFile will show it as a file, if it exists.
 	var firstFile *pfs.FileInfo
-	err = pc.ListFile(bucket.Commit, file, func(fi *pfs.FileInfo) (retErr error) {
+	err = pc.ListFile(commit, file, func(fi *pfs.FileInfo) (retErr error) {
 		if firstFile == nil {
 			firstFile = fi
 		}
 		return errutil.ErrBreak
 	})
 	if err != nil {
+		if version != "" && pfsServer.IsCommitNotFoundErr(err) {
+			return nil, s2.NoSuchVersionError(r)
+		}
 		return nil, maybeNotFoundError(r, err)
 	}
 	if firstFile == nil {
@@ -69,23 +77,163 @@ func (c *controller) GetObject(r *http.Request, bucketName, file, version string
 	}
 
 	modTime := fileInfo.Committed.AsTime()
+	// s2 also calls GetObject for the source of CopyObject, whose response isn't the object.
+	if r.Method == http.MethodGet || r.Method == http.MethodHead {
+		setMetadataHeaders(r, fileInfo)
+	}
 
-	content, err := pc.GetFileReadSeeker(bucket.Commit, file)
-	if err != nil {
-		return nil, err
+	content := &objectContent{
+		pc:     pc,
+		commit: commit,
+		path:   file,
+		size:   fileInfo.SizeBytes,
+	}
+	// http.ServeContent ignores the range if If-Range doesn't match, and reads the whole
+	// object instead; in that case we mustn't limit the reads to the requested ranges.
+	if r.Header.Get("If-Range") == "" {
+		ranges, _ := httprange.Parse(r.Header.Get("Range"), content.size)
+		for _, rng := range ranges {
+			if content.lengths == nil {
+				content.lengths = make(map[int64]int64)
+			}
+			content.lengths[rng.Start] = rng.Length
+		}
 	}
 
 	result := s2.GetObjectResult{
 		ModTime:      modTime,
 		Content:      content,
 		ETag:         fmt.Sprintf("%x", fileInfo.Hash),
-		Version:      commitID,
+		Version:      commit.Id,
 		DeleteMarker: false,
 	}
 
 	return &result, nil
 }
 
+// metadataHeaderPrefix is the prefix of the headers that carry the user metadata of an object.
+// Go canonicalizes header names, so this is how the prefix appears in http.Header.
+const metadataHeaderPrefix = "X-Amz-Meta-"
+
+type responseWriterKey struct{}
+
+// withResponseWriter makes the response writer available to the controller through the request
+// context.  s2 only passes the request to the controller, but GetObject has to set headers that
+// s2 doesn't know about.
+func withResponseWriter(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), responseWriterKey{}, w)))
+	})
+}
+
+// setMetadataHeaders sets the Content-Type and x-amz-meta-* headers of the response to r from the
+// content type and metadata of a file.
+func setMetadataHeaders(r *http.Request, fileInfo *pfs.FileInfo) {
+	w, ok := r.Context().Value(responseWriterKey{}).(http.ResponseWriter)
+	if !ok {
+		return
+	}
+	if fileInfo.ContentType != "" {
+		w.Header().Set("Content-Type", fileInfo.ContentType)
+	}
+	for k, v := range fileInfo.Metadata {
+		w.Header().Set(metadataHeaderPrefix+k, v)
+	}
+}
+
+// metadataOptions returns options that store the Content-Type and x-amz-meta-* headers of r as
+// the content type and metadata of the file that r writes.  Like S3, the metadata keys are
+// lower-cased.
+func metadataOptions(r *http.Request) []client.PutFileOption {
+	var opts []client.PutFileOption
+	if ct := r.Header.Get("Content-Type"); ct != "" {
+		opts = append(opts, client.WithContentTypePutFile(ct))
+	}
+	md := make(map[string]string)
+	for k, vs := range r.Header {
+		if key, ok := strings.CutPrefix(k, metadataHeaderPrefix); ok && key != "" {
+			md[strings.ToLower(key)] = strings.Join(vs, ",")
+		}
+	}
+	if len(md) > 0 {
+		opts = append(opts, client.WithMetadataPutFile(md))
+	}
+	return opts
+}
+
+// copyMetadataOptions returns options that set the content type and metadata of the copy of
+// srcFile made by r.  Like S3, the copy keeps the content type and metadata of the source, unless
+// the x-amz-metadata-directive header of r is REPLACE, in which case they're taken from r.
+func copyMetadataOptions(r *http.Request, pc *client.APIClient, srcCommit *pfs.Commit, srcFile string) ([]client.PutFileOption, error) {
+	if strings.EqualFold(r.Header.Get("x-amz-metadata-directive"), "REPLACE") {
+		return metadataOptions(r), nil
+	}
+	fileInfo, err := pc.InspectFile(srcCommit, srcFile)
+	if err != nil {
+		return nil, err
+	}
+	var opts []client.PutFileOption
+	if fileInfo.ContentType != "" {
+		opts = append(opts, client.WithContentTypePutFile(fileInfo.ContentType))
+	}
+	if len(fileInfo.Metadata) > 0 {
+		opts = append(opts, client.WithMetadataPutFile(fileInfo.Metadata))
+	}
+	return opts, nil
+}
+
+// objectContent is the content of an object, served by http.ServeContent.  ServeContent seeks to
+// the start of each requested range before reading it; each seek starts a new read, which is
+// limited to the requested range so that only the chunks that the range overlaps are fetched.
+type objectContent struct {
+	pc      *client.APIClient
+	commit  *pfs.Commit
+	path    string
+	size    int64
+	lengths map[int64]int64 // The length of each requested range, by its start.
+	offset  int64
+	r       io.ReadCloser
+}
+
+func (o *objectContent) Read(p []byte) (int, error) {
+	if o.r == nil {
+		if o.offset >= o.size {
+			return 0, io.EOF
+		}
+		r, err := o.pc.GetFileRange(o.commit, o.path, o.offset, o.lengths[o.offset])
+		if err != nil {
+			return 0, err
+		}
+		o.r = r
+	}
+	n, err := o.r.Read(p)
+	o.offset += int64(n)
+	return n, err //nolint:wrapcheck
+}
+
+func (o *objectContent) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += o.offset
+	case io.SeekEnd:
+		offset += o.size
+	default:
+		return o.offset, errors.Errorf("invalid whence %d", whence)
+	}
+	if offset < 0 {
+		return o.offset, errors.Errorf("negative offset %d", offset)
+	}
+	if o.r != nil {
+		if err := o.r.Close(); err != nil {
+			return o.offset, errors.EnsureStack(err)
+		}
+		o.r = nil
+	}
+	o.offset = offset
+	return offset, nil
+}
+
 func (c *controller) CopyObject(r *http.Request, srcBucketName, srcFile string, srcObj *s2.GetObjectResult, destBucketName, destFile string) (string, error) {
 	defer log.Span(r.Context(), "CopyObject", zap.String("srcBucketName", srcBucketName), zap.String("srcFile", srcFile), zap.Any("srcObj", srcObj), zap.String("destBucketName", destBucketName), zap.String("destFile", destFile))()
 
@@ -111,7 +259,25 @@ func (c *controller) CopyObject(r *http.Request, srcBucketName, srcFile string,
 		return "", s2.NotImplementedError(r)
 	}
 
-	if err = pc.CopyFile(destBucket.Commit, destFile, srcBucket.Commit, srcFile); err != nil {
+	// Copy the version of the source that s2 got, which is only different from the bucket's
+	// commit if the request names a version.
+	srcCommit := srcBucket.Commit
+	if srcObj.Version != srcCommit.Id {
+		srcCommit = srcBucket.Commit.Branch.NewCommit(srcObj.Version)
+	}
+	mdOpts, err := copyMetadataOptions(r, pc, srcCommit, srcFile)
+	if err != nil {
+		return "", maybeNotFoundError(r, err)
+	}
+	if err = pc.WithModifyFileClient(destBucket.Commit, func(mf client.ModifyFile) error {
+		if err := mf.CopyFile(destFile, srcCommit.NewFile(srcFile)); err != nil {
+			return err
+		}
+		if len(mdOpts) == 0 {
+			return nil
+		}
+		return mf.PutFile(destFile, &bytes.Buffer{}, append(mdOpts, client.WithAppendPutFile())...)
+	}); err != nil {
 		if errutil.IsWriteToOutputBranchError(err) {
 			return "", writeToOutputBranchError(r)
 		} else if errutil.IsNotADirectoryError(err) {
@@ -153,7 +319,7 @@ func (c *controller) PutObject(r *http.Request, bucketName, file string, reader
 	}
 
 	bucketCommit := bucket.Commit
-	if err := pc.PutFile(bucketCommit, file, reader); err != nil {
+	if err := pc.PutFile(bucketCommit, file, reader, metadataOptions(r)...); err != nil {
 		if errutil.IsWriteToOutputBranchError(err) {
 			return nil, writeToOutputBranchError(r)
 		} else if errutil.IsNotADirectoryError(err) {
@@ -183,9 +349,6 @@ func (c *controller) DeleteObject(r *http.Request, bucketName, file, version str
 
 	pc := c.requestClient(r)
 	file = strings.TrimSuffix(file, "/")
-	if version != "" {
-		return nil, s2.NotImplementedError(r)
-	}
 
 	bucket, err := c.driver.bucket(pc, r, bucketName)
 	if err != nil {
@@ -198,6 +361,12 @@ func (c *controller) DeleteObject(r *http.Request, bucketName, file, version str
 	if !bucketCaps.writable {
 		return nil, s2.NotImplementedError(r)
 	}
+	if version != "" {
+		if !bucketCaps.historicVersions {
+			return nil, s2.NotImplementedError(r)
+		}
+		return c.deleteObjectVersion(r, pc, bucket, file, version)
+	}
 
 	if err = pc.DeleteFile(bucket.Commit, file); err != nil {
 		if errutil.IsWriteToOutputBranchError(err) {
@@ -213,3 +382,41 @@ func (c *controller) DeleteObject(r *http.Request, bucketName, file, version str
 
 	return &result, nil
 }
+
+// deleteObjectVersion deletes one version of an object.  PFS history is
+// immutable, so only the latest version can be deleted, by deleting the object
+// from the branch; deleting an older version or a delete marker is not
+// implemented.
+func (c *controller) deleteObjectVersion(r *http.Request, pc *client.APIClient, bucket *Bucket, file, version string) (*s2.DeleteObjectResult, error) {
+	var found *objectVersion
+	latest := true
+	if err := walkObjectVersions(pc, bucket.Commit, "/"+file, func(v *objectVersion) error {
+		if v.key != file {
+			return nil
+		}
+		if v.id == version {
+			found = v
+			found.isLatest = latest
+			return errutil.ErrBreak
+		}
+		latest = false
+		return nil
+	}); err != nil {
+		return nil, maybeNotFoundError(r, err)
+	}
+	if found == nil {
+		return nil, s2.NoSuchVersionError(r)
+	}
+
+	if !found.isLatest || found.isDeleteMarker() {
+		return nil, s2.NotImplementedError(r)
+	}
+	if err := pc.DeleteFile(bucket.Commit, file); err != nil {
+		if errutil.IsWriteToOutputBranchError(err) {
+			return nil, writeToOutputBranchError(r)
+		}
+		return nil, maybeNotFoundError(r, err)
+	}
+
+	return &s2.DeleteObjectResult{Version: version}, nil
+}
@@ -103,7 +103,9 @@ func Router(ctx context.Context, driver Driver, clientFactory ClientFactory) *mu
 	s3Server.Bucket = c
 	s3Server.Object = c
 	s3Server.Multipart = c
-	return s3Server.Router()
+	router := s3Server.Router()
+	router.Use(withResponseWriter)
+	return router
 }
 
 // S3Server wraps an HTTP server with an S3-like API for PFS. This allows you to
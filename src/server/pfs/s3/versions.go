@@ -0,0 +1,202 @@
+package s3
+
+import (
+	"container/heap"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/client"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/s2"
+)
+
+// objectVersion is a change to an object in some commit of a bucket's
+// history.  The version ID of the change is the ID of the commit.  A change
+// that removed the object is a delete marker, and has no fileInfo.
+type objectVersion struct {
+	key          string
+	id           string
+	lastModified time.Time
+	fileInfo     *pfs.FileInfo
+	isLatest     bool
+}
+
+func (v *objectVersion) isDeleteMarker() bool {
+	return v.fileInfo == nil
+}
+
+// currentVersion is the version of an object that fileInfo describes.
+func currentVersion(fileInfo *pfs.FileInfo) *objectVersion {
+	return &objectVersion{
+		key:          fileInfo.File.Path[1:],
+		id:           fileInfo.File.Commit.Id,
+		lastModified: fileInfo.Committed.AsTime(),
+		fileInfo:     fileInfo,
+	}
+}
+
+// walkObjectVersions calls cb with every change to the objects under dir in
+// the history of commit, newest first.  Each commit is diffed against its
+// parent, so this costs a diff per commit in the history; callers keep only
+// the versions they need, rather than every version in the history.
+func walkObjectVersions(pc *client.APIClient, commit *pfs.Commit, dir string, cb func(*objectVersion) error) error {
+	return pc.ListCommitF(commit.Repo, commit, nil, 0, false, func(ci *pfs.CommitInfo) error {
+		return pc.DiffFile(ci.Commit, dir, nil, "", false, func(newFi, oldFi *pfs.FileInfo) error {
+			switch {
+			case newFi != nil && newFi.FileType == pfs.FileType_FILE:
+				v := currentVersion(newFi)
+				v.id = ci.Commit.Id
+				return cb(v)
+			case oldFi != nil && oldFi.FileType == pfs.FileType_FILE:
+				modified := ci.Started
+				if ci.Finished != nil {
+					modified = ci.Finished
+				}
+				return cb(&objectVersion{key: oldFi.File.Path[1:], id: ci.Commit.Id, lastModified: modified.AsTime()})
+			}
+			return nil
+		})
+	})
+}
+
+// versionPage collects the page of versions that follows keyMarker and
+// versionIDMarker as the versions are walked, so that listing the versions of
+// a long history doesn't hold all of them in memory.  Only the first
+// maxKeys+1 versions after the markers, in listing order, are kept: enough to
+// tell whether the page is truncated.  Versions of each key must be added
+// newest first.
+type versionPage struct {
+	keyMarker, versionIDMarker string
+	maxKeys                    int
+	// afterMarker is set once versionIDMarker has been added, and the
+	// following versions of keyMarker are on the page.
+	afterMarker bool
+	added       int
+	versions    versionHeap
+	keys        map[string]int
+}
+
+func newVersionPage(keyMarker, versionIDMarker string, maxKeys int) *versionPage {
+	return &versionPage{
+		keyMarker:       keyMarker,
+		versionIDMarker: versionIDMarker,
+		maxKeys:         maxKeys,
+		keys:            make(map[string]int),
+	}
+}
+
+// add adds v to the page if it belongs there, and marks it as the latest
+// version of its key if it's the first one added.
+func (p *versionPage) add(v *objectVersion) {
+	seq := p.added
+	p.added++
+	if v.key < p.keyMarker {
+		return
+	}
+	if p.keyMarker != "" && v.key == p.keyMarker {
+		// Without a version marker, or with one we don't know, the page
+		// starts at the next key.
+		if !p.afterMarker {
+			p.afterMarker = p.versionIDMarker != "" && v.id == p.versionIDMarker
+			return
+		}
+	} else if p.keys[v.key] == 0 {
+		// A full page drops its last versions first, and once a key's
+		// version is dropped its older versions can't join the page, so a
+		// key with no versions on the page hasn't been added before.
+		v.isLatest = true
+	}
+	e := versionEntry{version: v, seq: seq}
+	if len(p.versions) > p.maxKeys {
+		if !e.less(p.versions[0]) {
+			return
+		}
+		p.keys[heap.Pop(&p.versions).(versionEntry).version.key]--
+	}
+	heap.Push(&p.versions, e)
+	p.keys[v.key]++
+}
+
+// page returns the page of versions, sorted by key and newest first within a
+// key, and whether there are more versions after it.
+//
+// s2 doesn't let us choose the markers of the next page: it uses the greatest
+// key and the greatest version ID on the page.  Version IDs are commit IDs,
+// which aren't ordered, so a truncated page is cut after its last version
+// whose ID is greater than the IDs of all the versions before it.  The
+// markers s2 computes then name that version, and the next page resumes
+// right after it.
+func (p *versionPage) page() ([]*objectVersion, bool) {
+	entries := append([]versionEntry(nil), p.versions...)
+	sort.Slice(entries, func(i, j int) bool { return entries[i].less(entries[j]) })
+	var versions []*objectVersion
+	for _, e := range entries {
+		versions = append(versions, e.version)
+	}
+	if len(versions) <= p.maxKeys {
+		return versions, false
+	}
+	if p.maxKeys == 0 {
+		return nil, false
+	}
+	versions = versions[:p.maxKeys]
+	cut, highest := 0, ""
+	for i, v := range versions {
+		if v.id >= highest {
+			cut, highest = i, v.id
+		}
+	}
+	return versions[:cut+1], true
+}
+
+// versionEntry is a version on a versionPage, with the order it was added in.
+type versionEntry struct {
+	version *objectVersion
+	seq     int
+}
+
+func (e versionEntry) less(other versionEntry) bool {
+	if e.version.key != other.version.key {
+		return e.version.key < other.version.key
+	}
+	return e.seq < other.seq
+}
+
+// versionHeap is a max-heap of versions in listing order, so that the last
+// version on a full page is the one to drop.
+type versionHeap []versionEntry
+
+func (h versionHeap) Len() int           { return len(h) }
+func (h versionHeap) Less(i, j int) bool { return h[j].less(h[i]) }
+func (h versionHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *versionHeap) Push(x any)        { *h = append(*h, x.(versionEntry)) }
+func (h *versionHeap) Pop() any {
+	old := *h
+	x := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return x
+}
+
+func newVersion(v *objectVersion) *s2.Version {
+	return &s2.Version{
+		Key:          v.key,
+		Version:      v.id,
+		IsLatest:     v.isLatest,
+		LastModified: v.lastModified,
+		ETag:         fmt.Sprintf("%x", v.fileInfo.Hash),
+		Size:         uint64(v.fileInfo.SizeBytes),
+		StorageClass: globalStorageClass,
+		Owner:        defaultUser,
+	}
+}
+
+func newDeleteMarker(v *objectVersion) *s2.DeleteMarker {
+	return &s2.DeleteMarker{
+		Key:          v.key,
+		Version:      v.id,
+		IsLatest:     v.isLatest,
+		LastModified: v.lastModified,
+		Owner:        defaultUser,
+	}
+}
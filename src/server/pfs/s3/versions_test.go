@@ -0,0 +1,89 @@
+package s3
+
+import (
+	"testing"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+)
+
+func versionIDs(versions []*objectVersion) []string {
+	var ids []string
+	for _, v := range versions {
+		ids = append(ids, v.key+"@"+v.id)
+	}
+	return ids
+}
+
+func TestPageVersions(t *testing.T) {
+	versions := []*objectVersion{
+		{key: "a", id: "c3"},
+		{key: "a", id: "a1"},
+		{key: "b", id: "f2"},
+		{key: "b", id: "b9"},
+		{key: "b", id: "d4"},
+		{key: "c", id: "e5"},
+	}
+	// Versions are walked commit by commit, so keys arrive interleaved.
+	walked := []int{2, 0, 5, 3, 1, 4}
+	pageVersions := func(versions []*objectVersion, keyMarker, versionIDMarker string, maxKeys int) ([]*objectVersion, bool) {
+		p := newVersionPage(keyMarker, versionIDMarker, maxKeys)
+		for _, i := range walked {
+			v := *versions[i]
+			p.add(&v)
+		}
+		require.True(t, len(p.versions) <= maxKeys+1)
+		return p.page()
+	}
+
+	page, truncated := pageVersions(versions, "", "", 1000)
+	require.False(t, truncated)
+	require.Equal(t, versionIDs(versions), versionIDs(page))
+	var latest []string
+	for _, v := range page {
+		if v.isLatest {
+			latest = append(latest, v.key+"@"+v.id)
+		}
+	}
+	require.Equal(t, []string{"a@c3", "b@f2", "c@e5"}, latest)
+
+	page, truncated = pageVersions(versions, "", "", 0)
+	require.False(t, truncated)
+	require.Equal(t, 0, len(page))
+
+	// The second version's ID is lower than the first's, so a page of two
+	// is cut after the first.
+	page, truncated = pageVersions(versions, "", "", 2)
+	require.True(t, truncated)
+	require.Equal(t, []string{"a@c3"}, versionIDs(page))
+
+	page, truncated = pageVersions(versions, "", "", 3)
+	require.True(t, truncated)
+	require.Equal(t, []string{"a@c3", "a@a1", "b@f2"}, versionIDs(page))
+
+	page, truncated = pageVersions(versions, "b", "f2", 2)
+	require.True(t, truncated)
+	require.Equal(t, []string{"b@b9", "b@d4"}, versionIDs(page))
+
+	// Following the markers s2 derives from each page visits every version
+	// exactly once.
+	var all []string
+	keyMarker, versionIDMarker := "", ""
+	for {
+		page, truncated := pageVersions(versions, keyMarker, versionIDMarker, 2)
+		all = append(all, versionIDs(page)...)
+		if !truncated {
+			break
+		}
+		keyMarker, versionIDMarker = "", ""
+		for _, v := range page {
+			keyMarker = max(keyMarker, v.key)
+			versionIDMarker = max(versionIDMarker, v.id)
+		}
+	}
+	require.Equal(t, versionIDs(versions), all)
+
+	// A key marker without a version marker skips the whole key.
+	page, truncated = pageVersions(versions, "a", "", 1000)
+	require.False(t, truncated)
+	require.Equal(t, []string{"b@f2", "b@b9", "b@d4", "c@e5"}, versionIDs(page))
+}
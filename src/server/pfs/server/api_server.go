@@ -23,6 +23,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/chunk"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset/index"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/metrics"
 	"github.com/pachyderm/pachyderm/v2/src/internal/task"
 	txnenv "github.com/pachyderm/pachyderm/v2/src/internal/transactionenv"
@@ -168,6 +169,9 @@ func (a *apiServer) ListRepo(request *pfs.ListRepoRequest, srv pfs.API_ListRepoS
 		return err
 	}
 	for _, repo := range repos {
+		if !passesMetadataFilter(repo.Metadata, request.Metadata) {
+			continue
+		}
 		if err := errors.Wrap(srv.Send(repo), "sending repo"); err != nil {
 			return err
 		}
@@ -288,7 +292,7 @@ func (a *apiServer) InspectCommit(ctx context.Context, request *pfs.InspectCommi
 // ListCommit implements the protobuf pfs.ListCommit RPC
 func (a *apiServer) ListCommit(request *pfs.ListCommitRequest, respServer pfs.API_ListCommitServer) (retErr error) {
 	request.GetRepo().EnsureProject()
-	return a.driver.listCommit(respServer.Context(), request.Repo, request.To, request.From, request.StartedTime, request.Number, request.Reverse, request.All, request.OriginKind, func(ci *pfs.CommitInfo) error {
+	return a.driver.listCommit(respServer.Context(), request.Repo, request.To, request.From, request.StartedTime, request.Number, request.Reverse, request.All, request.OriginKind, request.Metadata, func(ci *pfs.CommitInfo) error {
 		return errors.EnsureStack(respServer.Send(ci))
 	})
 }
@@ -449,6 +453,49 @@ func (a *apiServer) DeleteBranch(ctx context.Context, request *pfs.DeleteBranchR
 	return &emptypb.Empty{}, nil
 }
 
+// ensureRepo fills in the default project and type of a repo named in a
+// request.
+func ensureRepo(repo *pfs.Repo) {
+	if repo == nil {
+		return
+	}
+	repo.EnsureProject()
+	if repo.Type == "" {
+		repo.Type = pfs.UserRepoType
+	}
+}
+
+// CreateTag implements the protobuf pfs.CreateTag RPC
+func (a *apiServer) CreateTag(ctx context.Context, request *pfs.CreateTagRequest) (*emptypb.Empty, error) {
+	ensureRepo(request.GetTag().GetRepo())
+	ensureRepo(request.GetCommit().GetRepo())
+	if err := a.driver.createTag(ctx, request.Tag, request.Commit); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// InspectTag implements the protobuf pfs.InspectTag RPC
+func (a *apiServer) InspectTag(ctx context.Context, request *pfs.InspectTagRequest) (*pfs.TagInfo, error) {
+	ensureRepo(request.GetTag().GetRepo())
+	return a.driver.inspectTag(ctx, request.Tag)
+}
+
+// ListTag implements the protobuf pfs.ListTag RPC
+func (a *apiServer) ListTag(request *pfs.ListTagRequest, srv pfs.API_ListTagServer) error {
+	ensureRepo(request.Repo)
+	return a.driver.listTag(srv.Context(), request.Repo, srv.Send)
+}
+
+// DeleteTag implements the protobuf pfs.DeleteTag RPC
+func (a *apiServer) DeleteTag(ctx context.Context, request *pfs.DeleteTagRequest) (*emptypb.Empty, error) {
+	ensureRepo(request.GetTag().GetRepo())
+	if err := a.driver.deleteTag(ctx, request.Tag); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
 // CreateProject implements the protobuf pfs.CreateProject RPC
 func (a *apiServer) CreateProject(ctx context.Context, request *pfs.CreateProjectRequest) (*emptypb.Empty, error) {
 	if err := a.driver.createProject(ctx, request); err != nil {
@@ -477,6 +524,65 @@ func (a *apiServer) DeleteProject(ctx context.Context, request *pfs.DeleteProjec
 	return &emptypb.Empty{}, nil
 }
 
+// EditMetadata implements the protobuf pfs.EditMetadata RPC
+func (a *apiServer) EditMetadata(ctx context.Context, request *pfs.EditMetadataRequest) (*pfs.EditMetadataResponse, error) {
+	if err := a.env.TxnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		return a.driver.editMetadata(ctx, txnCtx, request.Edits)
+	}); err != nil {
+		return nil, err
+	}
+	return &pfs.EditMetadataResponse{}, nil
+}
+
+// SetRetentionPolicy implements the protobuf pfs.SetRetentionPolicy RPC
+func (a *apiServer) SetRetentionPolicy(ctx context.Context, request *pfs.SetRetentionPolicyRequest) (*emptypb.Empty, error) {
+	if err := a.env.TxnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		return a.driver.setRetentionPolicy(ctx, txnCtx, request)
+	}); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// ApplyRetentionPolicy implements the protobuf pfs.ApplyRetentionPolicy RPC
+func (a *apiServer) ApplyRetentionPolicy(ctx context.Context, request *pfs.ApplyRetentionPolicyRequest) (*pfs.ApplyRetentionPolicyResponse, error) {
+	var repo *pfs.Repo
+	var branch *pfs.Branch
+	switch target := request.GetTarget().(type) {
+	case *pfs.ApplyRetentionPolicyRequest_Repo:
+		repo = target.Repo
+	case *pfs.ApplyRetentionPolicyRequest_Branch:
+		branch = target.Branch
+		repo = branch.GetRepo()
+	default:
+		return nil, errors.Errorf("a repo or branch must be specified")
+	}
+	repo.EnsureProject()
+	if repo.Type == "" {
+		repo.Type = pfs.UserRepoType
+	}
+	expired, err := a.driver.applyRetentionPolicy(ctx, repo, branch, request.DryRun)
+	if err != nil {
+		return nil, err
+	}
+	return &pfs.ApplyRetentionPolicyResponse{Expired: expired}, nil
+}
+
+// PurgePath implements the protobuf pfs.PurgePath RPC
+func (a *apiServer) PurgePath(ctx context.Context, request *pfs.PurgePathRequest) (*pfs.PurgePathResponse, error) {
+	if request.Repo == nil {
+		return nil, errors.Errorf("a repo must be specified")
+	}
+	if request.Path == "" {
+		return nil, errors.Errorf("a path must be specified")
+	}
+	request.Repo.EnsureProject()
+	if request.Repo.Type == "" {
+		request.Repo.Type = pfs.UserRepoType
+	}
+	return a.driver.purgePath(ctx, request.Repo, request.Path)
+}
+
 func (a *apiServer) ModifyFile(server pfs.API_ModifyFileServer) (retErr error) {
 	commit, err := readCommit(server)
 	if err != nil {
@@ -520,14 +626,15 @@ func (a *apiServer) modifyFile(ctx context.Context, uw *fileset.UnorderedWriter,
 			var n int64
 			p := mod.AddFile.Path
 			t := mod.AddFile.Datum
+			md := fileMetadata(mod.AddFile)
 			switch src := mod.AddFile.Source.(type) {
 			case *pfs.AddFile_Raw:
-				n, err = putFileRaw(ctx, uw, p, t, src.Raw)
+				n, err = putFileRaw(ctx, uw, p, t, md, src.Raw)
 			case *pfs.AddFile_Url:
-				n, err = putFileURL(ctx, a.env.TaskService, uw, p, t, src.Url)
+				n, err = putFileURL(ctx, a.env.TaskService, uw, p, t, md, src.Url)
 			default:
 				// need to write empty data to path
-				n, err = putFileRaw(ctx, uw, p, t, &wrapperspb.BytesValue{})
+				n, err = putFileRaw(ctx, uw, p, t, md, &wrapperspb.BytesValue{})
 			}
 			if err != nil {
 				return bytesRead, err
@@ -551,13 +658,25 @@ func (a *apiServer) modifyFile(ctx context.Context, uw *fileset.UnorderedWriter,
 	return bytesRead, nil
 }
 
-func putFileRaw(ctx context.Context, uw *fileset.UnorderedWriter, path, tag string, src *wrapperspb.BytesValue) (int64, error) {
-	if err := uw.Put(ctx, path, tag, true, bytes.NewReader(src.Value)); err != nil {
+func putFileRaw(ctx context.Context, uw *fileset.UnorderedWriter, path, tag string, md *index.FileMetadata, src *wrapperspb.BytesValue) (int64, error) {
+	if err := uw.PutWithMetadata(ctx, path, tag, true, md, bytes.NewReader(src.Value)); err != nil {
 		return 0, err
 	}
 	return int64(len(src.Value)), nil
 }
 
+// fileMetadata returns the metadata to store with the file added by af, or nil if af leaves the
+// file's metadata unchanged.
+func fileMetadata(af *pfs.AddFile) *index.FileMetadata {
+	if af.ContentType == "" && len(af.Metadata) == 0 {
+		return nil
+	}
+	return &index.FileMetadata{
+		ContentType: af.ContentType,
+		Attributes:  af.Metadata,
+	}
+}
+
 func deleteFile(ctx context.Context, uw *fileset.UnorderedWriter, request *pfs.DeleteFile) error {
 	return uw.Delete(ctx, request.Path, request.Datum)
 }
@@ -601,9 +720,9 @@ func (a *apiServer) GetFile(request *pfs.GetFileRequest, server pfs.API_GetFileS
 		}
 		var n int64
 		if err := src.Iterate(ctx, func(fi *pfs.FileInfo, file fileset.File) error {
-			n = fileset.SizeFromIndex(file.Index())
+			n = rangeSize(fileset.SizeFromIndex(file.Index()), request.Offset, request.SizeBytes)
 			return grpcutil.WithStreamingBytesWriter(server, func(w io.Writer) error {
-				return errors.EnsureStack(file.Content(ctx, w, chunk.WithOffsetBytes(request.Offset)))
+				return errors.EnsureStack(file.Content(ctx, w, chunk.WithOffsetBytes(request.Offset), chunk.WithLimitBytes(request.SizeBytes)))
 			})
 		}); err != nil {
 			return 0, errors.EnsureStack(err)
@@ -612,6 +731,16 @@ func (a *apiServer) GetFile(request *pfs.GetFileRequest, server pfs.API_GetFileS
 	})
 }
 
+// rangeSize returns the number of bytes in the range of a file of the given size that starts at
+// offset and is at most sizeBytes long, or runs to the end of the file if sizeBytes is 0.
+func rangeSize(size, offset, sizeBytes int64) int64 {
+	n := max(size-offset, 0)
+	if sizeBytes > 0 {
+		n = min(n, sizeBytes)
+	}
+	return n
+}
+
 func withGetFileWriter(w io.Writer, cb func(io.Writer) error) (int64, error) {
 	gfw := &getFileWriter{w: w}
 	err := cb(gfw)
@@ -836,9 +965,31 @@ func (a *apiServer) CheckStorage(ctx context.Context, req *pfs.CheckStorageReque
 	if err != nil {
 		return nil, err
 	}
-	return &pfs.CheckStorageResponse{
+	resp := &pfs.CheckStorageResponse{
 		ChunkObjectCount: int64(count),
-	}, nil
+	}
+	if req.CheckConsistency {
+		report, err := chunks.CheckConsistency(ctx, int(req.ConsistencyLimit))
+		if err != nil {
+			return nil, err
+		}
+		resp.Consistency = &pfs.StorageConsistency{
+			MissingObjectCount:  report.MissingObjects,
+			MissingObjects:      report.MissingObjectKeys,
+			UntrackedEntryCount: report.UntrackedEntries,
+			UntrackedEntries:    report.UntrackedEntryKeys,
+			OrphanedObjectCount: report.OrphanedObjects,
+			OrphanedObjects:     report.OrphanedObjectKeys,
+			GarbageObjectCount:  report.GarbageObjects,
+			GarbageBytes:        report.GarbageBytes,
+		}
+	}
+	return resp, nil
+}
+
+// InspectStorage implements the protobuf pfs.InspectStorage RPC
+func (a *apiServer) InspectStorage(ctx context.Context, req *pfs.InspectStorageRequest) (*pfs.InspectStorageResponse, error) {
+	return a.driver.inspectStorage(ctx, req.Limit)
 }
 
 func (a *apiServer) PutCache(ctx context.Context, req *pfs.PutCacheRequest) (resp *emptypb.Empty, retErr error) {
@@ -902,11 +1053,14 @@ func (a *apiServer) Egress(ctx context.Context, req *pfs.EgressRequest) (*pfs.Eg
 		return &pfs.EgressResponse{Result: &pfs.EgressResponse_ObjectStorage{ObjectStorage: result}}, nil
 
 	case *pfs.EgressRequest_SqlDatabase:
-		src, err := a.driver.getFile(ctx, file, nil)
-		if err != nil {
-			return nil, err
+		if target.SqlDatabase.Mode == pfs.SQLDatabaseEgress_UPSERT {
+			result, err := a.driver.upsertToSQLDB(ctx, req.Commit, target.SqlDatabase)
+			if err != nil {
+				return nil, errors.EnsureStack(err)
+			}
+			return &pfs.EgressResponse{Result: &pfs.EgressResponse_SqlDatabase{SqlDatabase: result}}, nil
 		}
-		result, err := copyToSQLDB(ctx, src, target.SqlDatabase.Url, target.SqlDatabase.FileFormat)
+		result, err := a.driver.replaceToSQLDB(ctx, req.Commit, target.SqlDatabase)
 		if err != nil {
 			return nil, errors.EnsureStack(err)
 		}
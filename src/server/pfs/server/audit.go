@@ -0,0 +1,149 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/internal/auditdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/log"
+	authmw "github.com/pachyderm/pachyderm/v2/src/internal/middleware/auth"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset"
+	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+)
+
+const (
+	auditRepoDescription = "Audit log of mutating API calls, exported by pachd."
+	auditExportBatchSize = 1000
+	// auditGapTimeout is how long the exporter waits for the event of an ID it skipped.  IDs are
+	// allocated when an event is inserted, not when it is committed, so an event may commit after
+	// one with a larger ID was exported; an ID whose insert was rolled back never gets an event.
+	auditGapTimeout = time.Hour
+)
+
+// exportAuditLog copies new events from the audit log into repo every period, until ctx is
+// done.  repo is an audit system repo, which is created along with its user repo if needed.
+func (d *driver) exportAuditLog(ctx context.Context, repo *pfs.Repo, period time.Duration) error {
+	if err := d.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		userRepo := &pfs.Repo{Project: repo.Project, Name: repo.Name, Type: pfs.UserRepoType}
+		if _, err := pfsdb.GetRepoByName(ctx, txnCtx.SqlTx, userRepo.Project.Name, userRepo.Name, userRepo.Type); err != nil {
+			if !pfsdb.IsErrRepoNotFound(err) {
+				return errors.EnsureStack(err)
+			}
+			if err := d.createRepo(ctx, txnCtx, userRepo, "", false); err != nil {
+				return err
+			}
+		}
+		return d.createRepo(ctx, txnCtx, repo, auditRepoDescription, true)
+	}); err != nil {
+		return errors.Wrapf(err, "create audit repo %q", repo)
+	}
+	ticker := time.NewTicker(period)
+	defer ticker.Stop()
+	for {
+		if err := d.exportAuditEvents(ctx, repo); err != nil {
+			log.Error(ctx, "error exporting audit log", zap.Stringer("repo", repo), zap.Error(err))
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return errors.EnsureStack(context.Cause(ctx))
+		}
+	}
+}
+
+// checkAuditRepoWrite returns an error if repo is an audit repo and ctx does not belong to one of
+// pachd's own background tasks, so that the exported log can't be changed through the API.
+func checkAuditRepoWrite(ctx context.Context, repo *pfs.Repo) error {
+	if repo.GetType() != pfs.AuditRepoType || strings.HasPrefix(authmw.GetWhoAmI(ctx), auth.InternalPrefix) {
+		return nil
+	}
+	return errors.Errorf("repo %q holds the audit log, which only pachd can change", repo)
+}
+
+// exportAuditEvents writes every unexported event to the master branch of repo, in batches.
+// Each batch is a file of newline-delimited JSON events named after the ID of its first event,
+// so a batch that is exported again after a failure replaces the earlier copy.
+//
+// The IDs that a batch skips over are recorded as gaps, and their events are exported in a
+// later batch once they are committed.
+func (d *driver) exportAuditEvents(ctx context.Context, repo *pfs.Repo) error {
+	key := repo.String()
+	for {
+		pos, err := auditdb.GetExportPosition(ctx, d.env.DB, key)
+		if err != nil {
+			return err
+		}
+		gaps, err := auditdb.ListExportGaps(ctx, d.env.DB, key)
+		if err != nil {
+			return err
+		}
+		events, err := auditdb.ListEventsAfter(ctx, d.env.DB, pos, gaps, auditExportBatchSize)
+		if err != nil {
+			return err
+		}
+		if len(events) == 0 {
+			return nil
+		}
+		buf := &bytes.Buffer{}
+		for _, e := range events {
+			js, err := protojson.Marshal(e.ToProto())
+			if err != nil {
+				return errors.Wrap(err, "marshal audit event")
+			}
+			buf.Write(js)
+			buf.WriteByte('\n')
+		}
+		path := fmt.Sprintf("/events/%020d.jsonl", events[0].ID)
+		if err := d.modifyFile(ctx, repo.NewCommit("master", ""), func(uw *fileset.UnorderedWriter) error {
+			return uw.Put(ctx, path, "", false, buf)
+		}); err != nil {
+			return errors.Wrapf(err, "write %v", path)
+		}
+		isGap := make(map[int64]bool)
+		for _, id := range gaps {
+			isGap[id] = true
+		}
+		last := pos
+		var filled, missing []int64
+		for _, e := range events {
+			if isGap[e.ID] {
+				filled = append(filled, e.ID)
+				continue
+			}
+			// Nothing precedes the first event ever exported.
+			if last > 0 {
+				for id := last + 1; id < e.ID; id++ {
+					missing = append(missing, id)
+				}
+			}
+			last = e.ID
+		}
+		now := time.Now()
+		if err := dbutil.WithTx(ctx, d.env.DB, func(ctx context.Context, tx *pachsql.Tx) error {
+			if err := auditdb.AddExportGaps(ctx, tx, key, missing, now); err != nil {
+				return err
+			}
+			if err := auditdb.DeleteExportGaps(ctx, tx, key, filled, now.Add(-auditGapTimeout)); err != nil {
+				return err
+			}
+			return auditdb.SetExportPosition(ctx, tx, key, last)
+		}); err != nil {
+			return err
+		}
+		if len(events) < auditExportBatchSize {
+			return nil
+		}
+	}
+}
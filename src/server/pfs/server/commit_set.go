@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"strings"
 
 	"google.golang.org/protobuf/proto"
 
@@ -234,6 +235,18 @@ func (d *driver) squashCommitSet(ctx context.Context, txnCtx *txncontext.Transac
 // 3. updating the ChildCommits pointers of deletedCommit.ParentCommit
 // 4. updating the ParentCommit pointer of deletedCommit.ChildCommits
 func (d *driver) deleteCommit(ctx context.Context, txnCtx *txncontext.TransactionContext, ci *pfs.CommitInfo) error {
+	if err := checkAuditRepoWrite(ctx, ci.Commit.Repo); err != nil {
+		return err
+	}
+	// Tags are immutable, so a tagged commit can't be squashed or dropped
+	// until its tags are deleted.
+	tags, err := pfsdb.ListCommitTags(ctx, txnCtx.SqlTx, ci.Commit)
+	if err != nil {
+		return errors.Wrapf(err, "list tags of commit %s", ci.Commit)
+	}
+	if len(tags) > 0 {
+		return errors.Errorf("cannot delete commit %s because it is tagged as %s", ci.Commit, strings.Join(tags, ", "))
+	}
 	for _, child := range ci.ChildCommits {
 		childInfo, err := pfsdb.GetCommitByCommitKey(ctx, txnCtx.SqlTx, child)
 		if err != nil {
@@ -389,6 +389,9 @@ func (d *driver) deleteRepo(ctx context.Context, txnCtx *txncontext.TransactionC
 	}
 	var bis []*pfs.BranchInfo
 	for _, repoInfoWithID := range related {
+		if err := checkAuditRepoWrite(ctx, repoInfoWithID.RepoInfo.Repo); err != nil {
+			return false, err
+		}
 		bs, err := d.listRepoBranches(ctx, txnCtx, repoInfoWithID.RepoInfo)
 		if err != nil {
 			return false, err
@@ -833,6 +836,9 @@ func (d *driver) startCommit(
 	if branch == nil || branch.Name == "" {
 		return nil, errors.Errorf("branch must be specified")
 	}
+	if err := checkAuditRepoWrite(ctx, branch.Repo); err != nil {
+		return nil, err
+	}
 	// Check that caller is authorized
 	if err := d.env.Auth.CheckRepoIsAuthorizedInTransaction(txnCtx, branch.Repo, auth.Permission_REPO_WRITE); err != nil {
 		return nil, errors.EnsureStack(err)
@@ -910,6 +916,9 @@ func (d *driver) finishCommit(ctx context.Context, txnCtx *txncontext.Transactio
 		return err
 	}
 	commitInfo := commitWithID.CommitInfo
+	if err := checkAuditRepoWrite(ctx, commitInfo.Commit.Repo); err != nil {
+		return err
+	}
 	if commitInfo.Finishing != nil {
 		return pfsserver.ErrCommitFinished{
 			Commit: commitInfo.Commit,
@@ -1185,21 +1194,43 @@ func (d *driver) resolveCommitWithID(ctx context.Context, sqlTx *pachsql.Tx, use
 	// Now that ancestry has been parsed out, check if the ID is a branch name
 	if commit.Id != "" && !uuid.IsUUIDWithoutDashes(commit.Id) {
 		if commit.Branch.GetName() != "" {
-			return nil, errors.Errorf("invalid commit ID given with a branch (%s): %s\n", commit.Branch, commit.Id)
+			// The only non-UUID ID that can be given with a branch is a tag,
+			// e.g. in the commit of a pipeline input.
+			tagInfo, err := pfsdb.GetTag(ctx, sqlTx, commit.Repo.NewTag(commit.Id))
+			if err != nil {
+				if pfsdb.IsNotFoundError(err) {
+					return nil, errors.Errorf("invalid commit ID given with a branch (%s): %s\n", commit.Branch, commit.Id)
+				}
+				return nil, errors.Wrap(err, "resolve commit")
+			}
+			commit.Branch = tagInfo.Commit.Branch
+			commit.Id = tagInfo.Commit.Id
+		} else {
+			commit.Branch = commit.Repo.NewBranch(commit.Id)
+			commit.Id = ""
 		}
-		commit.Branch = commit.Repo.NewBranch(commit.Id)
-		commit.Id = ""
 	}
 	// If commit.ID is unspecified, get it from the branch head
 	if commit.Id == "" {
 		branchInfo, err := pfsdb.GetBranchInfoWithID(ctx, sqlTx, commit.Branch)
 		if err != nil {
-			if pfsdb.IsNotFoundError(err) {
-				return nil, errors.Join(err, pfsserver.ErrBranchNotFound{Branch: commit.Branch})
+			if !pfsdb.IsNotFoundError(err) {
+				return nil, errors.Wrap(err, "resolve commit")
 			}
-			return nil, errors.Wrap(err, "resolve commit")
+			// There's no such branch, but there may be a tag with that name.
+			// Branches take precedence over tags.
+			tagInfo, tagErr := pfsdb.GetTag(ctx, sqlTx, commit.Branch.Repo.NewTag(commit.Branch.Name))
+			if tagErr != nil {
+				if pfsdb.IsNotFoundError(tagErr) {
+					return nil, errors.Join(err, pfsserver.ErrBranchNotFound{Branch: commit.Branch})
+				}
+				return nil, errors.Wrap(tagErr, "resolve commit")
+			}
+			commit.Branch = tagInfo.Commit.Branch
+			commit.Id = tagInfo.Commit.Id
+		} else {
+			commit.Id = branchInfo.Head.Id
 		}
-		commit.Id = branchInfo.Head.Id
 	}
 	commitWithID, err := pfsdb.GetCommitWithIDByKey(ctx, sqlTx, commit)
 	if err != nil {
@@ -1327,6 +1358,7 @@ func (d *driver) listCommit(
 	reverse bool,
 	all bool,
 	originKind pfs.OriginKind,
+	metadata map[string]string,
 	cb func(*pfs.CommitInfo) error,
 ) error {
 	// Validate arguments
@@ -1415,7 +1447,7 @@ func (d *driver) listCommit(
 				}
 				lastRev = createRev
 			}
-			if passesCommitOriginFilter(ci, all, originKind) {
+			if passesCommitOriginFilter(ci, all, originKind) && passesMetadataFilter(ci.Metadata, metadata) {
 				if startTime != nil {
 					createdAt := time.Unix(ci.Started.GetSeconds(), int64(ci.Started.GetNanos())).UTC()
 					fromTime := time.Unix(startTime.GetSeconds(), int64(startTime.GetNanos())).UTC()
@@ -1464,7 +1496,7 @@ func (d *driver) listCommit(
 			}); err != nil {
 				return errors.Wrap(err, "list commit")
 			}
-			if passesCommitOriginFilter(commitInfo, all, originKind) {
+			if passesCommitOriginFilter(commitInfo, all, originKind) && passesMetadataFilter(commitInfo.Metadata, metadata) {
 				var err error
 				commitInfo.SizeBytesUpperBound, err = d.commitSizeUpperBound(ctx, commitInfo.Commit)
 				if err != nil && !pfsserver.IsBaseCommitNotFinishedErr(err) {
@@ -1546,6 +1578,9 @@ func (d *driver) clearCommit(ctx context.Context, commit *pfs.Commit) error {
 	if err != nil {
 		return err
 	}
+	if err := checkAuditRepoWrite(ctx, commitInfo.Commit.Repo); err != nil {
+		return err
+	}
 	if commitInfo.Finishing != nil {
 		return errors.Errorf("cannot clear finished commit")
 	}
@@ -1762,6 +1797,9 @@ func (d *driver) createBranch(ctx context.Context, txnCtx *txncontext.Transactio
 		return errors.New("a branch cannot have both provenance and a trigger")
 	}
 	var err error
+	if err := checkAuditRepoWrite(ctx, branch.Repo); err != nil {
+		return err
+	}
 	if err := d.env.Auth.CheckRepoIsAuthorizedInTransaction(txnCtx, branch.Repo, auth.Permission_REPO_CREATE_BRANCH); err != nil {
 		return errors.EnsureStack(err)
 	}
@@ -1774,6 +1812,13 @@ func (d *driver) createBranch(ctx context.Context, txnCtx *txncontext.Transactio
 			return err
 		}
 	}
+	// Branches take precedence over tags when resolving a commit reference,
+	// so a branch with the name of a tag would hide the tag.
+	if _, err := pfsdb.GetTag(ctx, txnCtx.SqlTx, &pfs.Tag{Repo: branch.Repo, Name: branch.Name}); err == nil {
+		return errors.Errorf("cannot create branch %q: a tag with that name already exists", branch)
+	} else if !pfsdb.IsErrTagNotFound(err) {
+		return errors.Wrapf(err, "create branch %q", branch)
+	}
 	// Create any of the provenance branches that don't exist yet, and give them an empty commit
 	if err := d.fillNewBranches(ctx, txnCtx, branch, provenance); err != nil {
 		return err
@@ -1969,6 +2014,9 @@ func (d *driver) listBranchInTransaction(ctx context.Context, txnCtx *txncontext
 }
 
 func (d *driver) deleteBranch(ctx context.Context, txnCtx *txncontext.TransactionContext, branch *pfs.Branch, force bool) error {
+	if err := checkAuditRepoWrite(ctx, branch.Repo); err != nil {
+		return err
+	}
 	if err := d.env.Auth.CheckRepoIsAuthorizedInTransaction(txnCtx, branch.Repo, auth.Permission_REPO_DELETE_BRANCH); err != nil {
 		return errors.EnsureStack(err)
 	}
@@ -59,6 +59,9 @@ func (d *driver) modifyFile(ctx context.Context, commit *pfs.Commit, cb func(*fi
 				return parentID, nil
 			}))
 		}
+		if err := checkAuditRepoWrite(ctx, commitInfo.Commit.Repo); err != nil {
+			return err
+		}
 		return d.withCommitUnorderedWriter(ctx, renewer, commitInfo.Commit, cb)
 	})
 }
@@ -35,7 +35,9 @@ func (d *driver) copyToObjectStorage(ctx context.Context, taskService task.Servi
 	return result, nil
 }
 
-func copyToSQLDB(ctx context.Context, src Source, destURL string, fileFormat *pfs.SQLDatabaseEgress_FileFormat) (*pfs.EgressResponse_SQLDatabaseResult, error) {
+// openEgressDB connects to the SQL database at destURL, using the password
+// provided to the process.
+func openEgressDB(destURL string) (*pachsql.DB, error) {
 	url, err := pachsql.ParseURL(destURL)
 	if err != nil {
 		return nil, errors.EnsureStack(err)
@@ -50,6 +52,14 @@ func copyToSQLDB(ctx context.Context, src Source, destURL string, fileFormat *pf
 	if err != nil {
 		return nil, errors.EnsureStack(err)
 	}
+	return db, nil
+}
+
+func copyToSQLDB(ctx context.Context, src Source, destURL string, fileFormat *pfs.SQLDatabaseEgress_FileFormat) (*pfs.EgressResponse_SQLDatabaseResult, error) {
+	db, err := openEgressDB(destURL)
+	if err != nil {
+		return nil, err
+	}
 	defer db.Close()
 
 	// all table are written through a single transaction
@@ -68,7 +78,7 @@ func copyToSQLDB(ctx context.Context, src Source, destURL string, fileFormat *pf
 			return nil
 		}
 
-		tableName := strings.Split(fi.File.Path, "/")[1]
+		tableName := egressTableName(fi.File.Path)
 		tableInfo, ok := tableInfos[tableName]
 		if !ok {
 			// first time interacting with table, so do a full drop first
@@ -84,35 +94,85 @@ func copyToSQLDB(ctx context.Context, src Source, destURL string, fileFormat *pf
 			}
 		}
 
-		if err := miscutil.WithPipe(
-			func(w io.Writer) error {
-				return errors.EnsureStack(file.Content(ctx, w))
-			},
-			func(r io.Reader) error {
-				var tr sdata.TupleReader
-				switch fileFormat.Type {
-				case pfs.SQLDatabaseEgress_FileFormat_CSV:
-					tr = sdata.NewCSVParser(r).WithHeaderFields(fileFormat.Columns)
-				case pfs.SQLDatabaseEgress_FileFormat_JSON:
-					tr = sdata.NewJSONParser(r, fileFormat.Columns)
-				default:
-					return errors.Errorf("unknown file format %v", fileFormat.Type)
-				}
-				tw := sdata.NewSQLTupleWriter(tx, tableInfo)
-				tuple, err := sdata.NewTupleFromTableInfo(tableInfo)
-				if err != nil {
-					return errors.EnsureStack(err)
-				}
-				n, err := sdata.Copy(tw, tr, tuple)
-				result.RowsWritten[tableName] += int64(n)
+		return readTuples(ctx, file, tableInfo, fileFormat, func(tr sdata.TupleReader) error {
+			tw := sdata.NewSQLTupleWriter(tx, tableInfo)
+			tuple, err := sdata.NewTupleFromTableInfo(tableInfo)
+			if err != nil {
 				return errors.EnsureStack(err)
-			}); err != nil {
+			}
+			n, err := sdata.Copy(tw, tr, tuple)
+			result.RowsWritten[tableName] += int64(n)
 			return errors.EnsureStack(err)
-		}
-		return nil
+		})
 	})
 	if err != nil {
 		return nil, errors.EnsureStack(err)
 	}
 	return result, errors.EnsureStack(tx.Commit())
 }
+
+// egressTableName returns the name of the table that the file at path is egressed to.
+func egressTableName(path string) string {
+	return strings.Split(path, "/")[1]
+}
+
+// readTuples passes a reader for the tuples in file to cb.
+func readTuples(ctx context.Context, file fileset.File, tableInfo *pachsql.TableInfo, fileFormat *pfs.SQLDatabaseEgress_FileFormat, cb func(sdata.TupleReader) error) error {
+	if fileFormat.Type == pfs.SQLDatabaseEgress_FileFormat_PARQUET {
+		return readParquetFile(ctx, file, parquetColumns(tableInfo, fileFormat.Columns), cb)
+	}
+	return errors.EnsureStack(miscutil.WithPipe(
+		func(w io.Writer) error {
+			return errors.EnsureStack(file.Content(ctx, w))
+		},
+		func(r io.Reader) error {
+			var tr sdata.TupleReader
+			switch fileFormat.Type {
+			case pfs.SQLDatabaseEgress_FileFormat_CSV:
+				tr = sdata.NewCSVParser(r).WithHeaderFields(fileFormat.Columns)
+			case pfs.SQLDatabaseEgress_FileFormat_JSON:
+				tr = sdata.NewJSONParser(r, fileFormat.Columns)
+			default:
+				return errors.Errorf("unknown file format %v", fileFormat.Type)
+			}
+			return cb(tr)
+		}))
+}
+
+// readParquetFile spools a Parquet file to local disk, since Parquet files can
+// only be read with random access, and passes a parser for it to cb.
+func readParquetFile(ctx context.Context, file fileset.File, columns []string, cb func(sdata.TupleReader) error) (retErr error) {
+	f, err := os.CreateTemp("", "egress-*.parquet")
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer func() {
+		errors.Close(&retErr, f, "close temporary file")
+		errors.Invoke1(&retErr, os.Remove, f.Name(), "remove temporary file")
+	}()
+	if err := file.Content(ctx, f); err != nil {
+		return errors.EnsureStack(err)
+	}
+	size, err := f.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	tr, err := sdata.NewParquetParser(f, size, columns)
+	if err != nil {
+		return err
+	}
+	return cb(tr)
+}
+
+// parquetColumns returns the Parquet columns to read for each column in the table.
+// Parquet files are self-describing, so if no columns are given the column names
+// of the table are used.
+func parquetColumns(tableInfo *pachsql.TableInfo, columns []string) []string {
+	if len(columns) > 0 {
+		return columns
+	}
+	for _, col := range tableInfo.Columns {
+		columns = append(columns, col.Name)
+	}
+	return columns
+}
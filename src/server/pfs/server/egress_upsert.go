@@ -0,0 +1,578 @@
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/sdata"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset/index"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+)
+
+// upsertToSQLDB egresses commit to a SQL database in UPSERT mode.  Only the
+// files which changed since the last commit from the same repo was egressed to
+// the database are read, and only the rows within them which changed are written.
+//
+// The record of the last egressed commit is cleared before the database is
+// written, and the egressed commit is only recorded once the database
+// transaction commits.  If the egress fails or pachd stops between the two,
+// no commit is recorded and the next egress reloads every table from scratch,
+// since the database may hold either commit's rows.
+func (d *driver) upsertToSQLDB(ctx context.Context, commit *pfs.Commit, egress *pfs.SQLDatabaseEgress) (*pfs.EgressResponse_SQLDatabaseResult, error) {
+	commitInfo, err := d.inspectCommit(ctx, commit, pfs.CommitState_FINISHED)
+	if err != nil {
+		return nil, err
+	}
+	commit = commitInfo.Commit
+	target, err := sqlEgressTarget(egress.Url)
+	if err != nil {
+		return nil, err
+	}
+	var base *pfs.Commit
+	if err := dbutil.WithTx(ctx, d.env.DB, func(ctx context.Context, tx *pachsql.Tx) error {
+		base, err = pfsdb.GetSQLEgressCommit(ctx, tx, target, commit.Repo)
+		return err
+	}, dbutil.WithReadOnly()); err != nil {
+		return nil, err
+	}
+	if base != nil && base.Id == commit.Id {
+		return &pfs.EgressResponse_SQLDatabaseResult{BaseCommit: base}, nil
+	}
+	if err := d.forgetSQLEgressCommit(ctx, target, commit.Repo); err != nil {
+		return nil, err
+	}
+	var result *pfs.EgressResponse_SQLDatabaseResult
+	if base == nil {
+		// Nothing has been egressed to this database yet, or the last egress
+		// didn't complete, so load every table from scratch.
+		src, err := d.getFile(ctx, commit.NewFile("/"), nil)
+		if err != nil {
+			return nil, err
+		}
+		result, err = copyToSQLDB(ctx, src, egress.Url, egress.FileFormat)
+		if err != nil {
+			return nil, err
+		}
+		result.RowsInserted = result.RowsWritten
+	} else {
+		result, err = d.applySQLEgressDiff(ctx, base, commit, egress)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := d.recordSQLEgressCommit(ctx, target, commit); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// replaceToSQLDB egresses commit to a SQL database in REPLACE mode, reloading
+// every table written to.  As with upsertToSQLDB, the record of the last
+// egressed commit is cleared first, and if the commit is finished it is
+// recorded once the database transaction commits, so that a later UPSERT
+// egress only applies what changed since.  An open commit can still change, so
+// it is never recorded.
+func (d *driver) replaceToSQLDB(ctx context.Context, commit *pfs.Commit, egress *pfs.SQLDatabaseEgress) (*pfs.EgressResponse_SQLDatabaseResult, error) {
+	commitInfo, err := d.inspectCommit(ctx, commit, pfs.CommitState_STARTED)
+	if err != nil {
+		return nil, err
+	}
+	commit = commitInfo.Commit
+	target, err := sqlEgressTarget(egress.Url)
+	if err != nil {
+		return nil, err
+	}
+	if err := d.forgetSQLEgressCommit(ctx, target, commit.Repo); err != nil {
+		return nil, err
+	}
+	src, err := d.getFile(ctx, commit.NewFile("/"), nil)
+	if err != nil {
+		return nil, err
+	}
+	result, err := copyToSQLDB(ctx, src, egress.Url, egress.FileFormat)
+	if err != nil {
+		return nil, err
+	}
+	if commitInfo.Finished == nil {
+		return result, nil
+	}
+	if err := d.recordSQLEgressCommit(ctx, target, commit); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (d *driver) forgetSQLEgressCommit(ctx context.Context, target string, repo *pfs.Repo) error {
+	return errors.Wrap(dbutil.WithTx(ctx, d.env.DB, func(ctx context.Context, tx *pachsql.Tx) error {
+		return pfsdb.DeleteSQLEgressCommit(ctx, tx, target, repo)
+	}), "forget egressed commit")
+}
+
+func (d *driver) recordSQLEgressCommit(ctx context.Context, target string, commit *pfs.Commit) error {
+	return errors.Wrap(dbutil.WithTx(ctx, d.env.DB, func(ctx context.Context, tx *pachsql.Tx) error {
+		return pfsdb.UpsertSQLEgressCommit(ctx, tx, target, commit)
+	}), "record egressed commit")
+}
+
+// sqlEgressTarget identifies the database at destURL, without its password.
+func sqlEgressTarget(destURL string) (string, error) {
+	u, err := url.Parse(destURL)
+	if err != nil {
+		return "", errors.EnsureStack(err)
+	}
+	if u.User != nil {
+		u.User = url.User(u.User.Username())
+	}
+	return u.String(), nil
+}
+
+// sqlEgressChange is a file which differs between the base commit and the
+// commit being egressed.  Either side may be nil if the file was added or removed.
+type sqlEgressChange struct {
+	oldFile, newFile *pfs.FileInfo
+}
+
+func (d *driver) applySQLEgressDiff(ctx context.Context, base, commit *pfs.Commit, egress *pfs.SQLDatabaseEgress) (*pfs.EgressResponse_SQLDatabaseResult, error) {
+	var changes []sqlEgressChange
+	if err := d.diffFile(ctx, base.NewFile("/"), commit.NewFile("/"), func(oldFi, newFi *pfs.FileInfo) error {
+		var c sqlEgressChange
+		if oldFi != nil && oldFi.FileType == pfs.FileType_FILE {
+			c.oldFile = oldFi
+		}
+		if newFi != nil && newFi.FileType == pfs.FileType_FILE {
+			c.newFile = newFi
+		}
+		if c.oldFile != nil || c.newFile != nil {
+			changes = append(changes, c)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	baseInfo, baseFs, err := d.openCommit(ctx, base)
+	if err != nil {
+		return nil, err
+	}
+	commitInfo, commitFs, err := d.openCommit(ctx, commit)
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := openEgressDB(egress.Url)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+	// all tables are written through a single transaction
+	tx, err := db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	defer tx.Rollback()
+
+	result := &pfs.EgressResponse_SQLDatabaseResult{
+		RowsWritten:  make(map[string]int64),
+		RowsInserted: make(map[string]int64),
+		RowsUpdated:  make(map[string]int64),
+		RowsDeleted:  make(map[string]int64),
+		BaseCommit:   base,
+	}
+	tables := make(map[string]*upsertTable)
+	for _, c := range changes {
+		path := c.newFile.GetFile().GetPath()
+		if c.newFile == nil {
+			path = c.oldFile.File.Path
+		}
+		tableName := egressTableName(path)
+		t, ok := tables[tableName]
+		if !ok {
+			t, err = newUpsertTable(tx, tableName, egress.PrimaryKeys[tableName].GetColumns())
+			if err != nil {
+				return nil, err
+			}
+			tables[tableName] = t
+		}
+		var oldRows map[string]oldRow
+		if c.oldFile != nil {
+			if err := withCommitFile(ctx, baseInfo, baseFs, c.oldFile.File.Path, func(f fileset.File) error {
+				var err error
+				oldRows, err = t.readRows(ctx, f, egress.FileFormat)
+				return err
+			}); err != nil {
+				return nil, err
+			}
+		}
+		if c.newFile != nil {
+			if err := withCommitFile(ctx, commitInfo, commitFs, c.newFile.File.Path, func(f fileset.File) error {
+				return t.upsertRows(ctx, f, egress.FileFormat, oldRows, result)
+			}); err != nil {
+				return nil, err
+			}
+		}
+		t.deleteRows(oldRows)
+	}
+	for tableName, t := range tables {
+		if err := t.flush(ctx, result); err != nil {
+			return nil, errors.Wrapf(err, "table %s", tableName)
+		}
+	}
+	for tableName := range tables {
+		result.RowsWritten[tableName] = result.RowsInserted[tableName] + result.RowsUpdated[tableName]
+	}
+	return result, errors.EnsureStack(tx.Commit())
+}
+
+// withCommitFile calls cb with the file at path in a commit opened with openCommit.
+func withCommitFile(ctx context.Context, commitInfo *pfs.CommitInfo, fs fileset.FileSet, path string, cb func(fileset.File) error) error {
+	src := NewSource(commitInfo, fs,
+		WithPrefix(path),
+		WithFilter(func(fs fileset.FileSet) fileset.FileSet {
+			return fileset.NewIndexFilter(fs, func(idx *index.Index) bool {
+				return idx.Path == path
+			})
+		}),
+	)
+	return errors.EnsureStack(src.Iterate(ctx, func(fi *pfs.FileInfo, f fileset.File) error {
+		if fi.FileType != pfs.FileType_FILE {
+			return nil
+		}
+		return cb(f)
+	}))
+}
+
+// upsertTable accumulates the changes to a single table.
+//
+// Rows can move between files, so a row removed from one file may have been
+// added to another.  Deletes are therefore deferred until every changed file
+// has been read, and a row is only deleted if no file wrote its key.
+type upsertTable struct {
+	tx   *pachsql.Tx
+	name string
+	info *pachsql.TableInfo
+	// key holds the indices of the primary key columns within info.Columns.
+	key   []int
+	isKey map[int]bool
+
+	inserts  *sdata.SQLTupleWriter
+	upserted map[string]bool
+	// deletes holds the key values of the rows to delete.
+	deletes map[string][]interface{}
+	// inserted holds the keys of the rows written through inserts.
+	inserted map[string]bool
+	// pending holds the changed rows whose keys have not yet been looked up
+	// in the table.
+	pending []sdata.Tuple
+
+	updateStmt, deleteStmt string
+}
+
+// upsertBatchSize is the number of changed rows whose keys are looked up in
+// the table with a single query.
+const upsertBatchSize = 100
+
+func newUpsertTable(tx *pachsql.Tx, tableName string, keyColumns []string) (*upsertTable, error) {
+	if len(keyColumns) == 0 {
+		return nil, errors.Errorf("no primary key declared for table %s", tableName)
+	}
+	info, err := pachsql.GetTableInfoTx(tx, tableName)
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	t := &upsertTable{
+		tx:       tx,
+		name:     tableName,
+		info:     info,
+		isKey:    make(map[int]bool),
+		inserts:  sdata.NewSQLTupleWriter(tx, info),
+		upserted: make(map[string]bool),
+		deletes:  make(map[string][]interface{}),
+		inserted: make(map[string]bool),
+	}
+	for _, name := range keyColumns {
+		i := columnIndex(info, name)
+		if i < 0 {
+			return nil, errors.Errorf("primary key column %s not found in table %s", name, tableName)
+		}
+		t.key = append(t.key, i)
+		t.isKey[i] = true
+	}
+	var where []string
+	for n, i := range t.key {
+		where = append(where, fmt.Sprintf("%s = %s", info.Columns[i].Name, pachsql.Placeholder(info.Driver, n)))
+	}
+	var set []string
+	for i, col := range info.Columns {
+		if !t.isKey[i] {
+			set = append(set, fmt.Sprintf("%s = %s", col.Name, pachsql.Placeholder(info.Driver, len(set))))
+		}
+	}
+	table := fmt.Sprintf("%s.%s", info.Schema, info.Name)
+	t.deleteStmt = fmt.Sprintf("DELETE FROM %s WHERE %s", table, strings.Join(where, " AND "))
+	if len(set) > 0 {
+		// The key placeholders follow the placeholders for the updated columns.
+		where = where[:0]
+		for n, i := range t.key {
+			where = append(where, fmt.Sprintf("%s = %s", info.Columns[i].Name, pachsql.Placeholder(info.Driver, len(set)+n)))
+		}
+		t.updateStmt = fmt.Sprintf("UPDATE %s SET %s WHERE %s", table, strings.Join(set, ", "), strings.Join(where, " AND "))
+	}
+	return t, nil
+}
+
+func columnIndex(info *pachsql.TableInfo, name string) int {
+	for i, col := range info.Columns {
+		if strings.EqualFold(col.Name, name) {
+			return i
+		}
+	}
+	return -1
+}
+
+// oldRow is what is kept of a row of a file in the base commit: its key
+// values, to delete it, and a digest of its values, to tell whether it changed.
+type oldRow struct {
+	key []interface{}
+	sum [sha256.Size]byte
+}
+
+// readRows streams the rows of f, keyed by primary key.  Only the key values
+// and a digest of each row are kept, rather than the row itself.
+func (t *upsertTable) readRows(ctx context.Context, f fileset.File, fileFormat *pfs.SQLDatabaseEgress_FileFormat) (map[string]oldRow, error) {
+	rows := make(map[string]oldRow)
+	err := t.eachRow(ctx, f, fileFormat, func(row sdata.Tuple) error {
+		k, err := t.rowKey(row)
+		if err != nil {
+			return err
+		}
+		sum, err := rowDigest(row)
+		if err != nil {
+			return err
+		}
+		rows[k] = oldRow{key: sdata.CloneTuple(t.keyValues(row)), sum: sum}
+		return nil
+	})
+	return rows, err
+}
+
+// upsertRows writes the rows of f which are not identical in oldRows.  Rows
+// found in oldRows are removed from it, leaving only the rows which f no
+// longer contains.
+func (t *upsertTable) upsertRows(ctx context.Context, f fileset.File, fileFormat *pfs.SQLDatabaseEgress_FileFormat, oldRows map[string]oldRow, result *pfs.EgressResponse_SQLDatabaseResult) error {
+	return t.eachRow(ctx, f, fileFormat, func(row sdata.Tuple) error {
+		k, err := t.rowKey(row)
+		if err != nil {
+			return err
+		}
+		oldRow, ok := oldRows[k]
+		delete(oldRows, k)
+		t.upserted[k] = true
+		delete(t.deletes, k)
+		if ok {
+			sum, err := rowDigest(row)
+			if err != nil {
+				return err
+			}
+			if sum == oldRow.sum {
+				return nil
+			}
+		}
+		t.pending = append(t.pending, sdata.CloneTuple(row))
+		if len(t.pending) < upsertBatchSize {
+			return nil
+		}
+		return t.flushPending(ctx, result)
+	})
+}
+
+// flushPending looks up the keys of the pending rows in the table with a
+// single query, and then inserts the rows which are missing and updates the
+// rest.
+func (t *upsertTable) flushPending(ctx context.Context, result *pfs.EgressResponse_SQLDatabaseResult) error {
+	if len(t.pending) == 0 {
+		return nil
+	}
+	existing, err := t.existingKeys(ctx, t.pending)
+	if err != nil {
+		return err
+	}
+	for _, row := range t.pending {
+		k, err := t.rowKey(row)
+		if err != nil {
+			return err
+		}
+		if !existing[k] && !t.inserted[k] {
+			if err := t.inserts.WriteTuple(row); err != nil {
+				return errors.EnsureStack(err)
+			}
+			t.inserted[k] = true
+			result.RowsInserted[t.name]++
+			continue
+		}
+		if t.inserted[k] {
+			// The row may still be buffered by the insert writer.
+			if err := t.inserts.Flush(); err != nil {
+				return errors.EnsureStack(err)
+			}
+		}
+		if t.updateStmt != "" {
+			var args []interface{}
+			for i := range row {
+				if !t.isKey[i] {
+					args = append(args, row[i])
+				}
+			}
+			if _, err := t.tx.ExecContext(ctx, t.updateStmt, append(args, t.keyValues(row)...)...); err != nil {
+				return errors.EnsureStack(err)
+			}
+		}
+		result.RowsUpdated[t.name]++
+	}
+	t.pending = t.pending[:0]
+	return nil
+}
+
+// existingKeys returns the keys of rows which are already in the table.
+func (t *upsertTable) existingKeys(ctx context.Context, rows []sdata.Tuple) (map[string]bool, error) {
+	var cols, conds []string
+	for _, i := range t.key {
+		cols = append(cols, t.info.Columns[i].Name)
+	}
+	var args []interface{}
+	for _, row := range rows {
+		var cond []string
+		for _, i := range t.key {
+			cond = append(cond, fmt.Sprintf("%s = %s", t.info.Columns[i].Name, pachsql.Placeholder(t.info.Driver, len(args))))
+			args = append(args, row[i])
+		}
+		conds = append(conds, "("+strings.Join(cond, " AND ")+")")
+	}
+	q := fmt.Sprintf("SELECT %s FROM %s.%s WHERE %s", strings.Join(cols, ", "), t.info.Schema, t.info.Name, strings.Join(conds, " OR "))
+	sqlRows, err := t.tx.QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	defer sqlRows.Close()
+	key := make(sdata.Tuple, len(t.key))
+	for n, i := range t.key {
+		key[n] = rows[0][i]
+	}
+	key = sdata.CloneTuple(key)
+	existing := make(map[string]bool)
+	for sqlRows.Next() {
+		if err := sqlRows.Scan(key...); err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		k, err := keyString(key)
+		if err != nil {
+			return nil, err
+		}
+		existing[k] = true
+	}
+	return existing, errors.EnsureStack(sqlRows.Err())
+}
+
+// deleteRows schedules the deletion of rows, unless their keys have been
+// written by another file.
+func (t *upsertTable) deleteRows(rows map[string]oldRow) {
+	for k, row := range rows {
+		if !t.upserted[k] {
+			t.deletes[k] = row.key
+		}
+	}
+}
+
+// flush writes any buffered inserts and then applies the scheduled deletes.
+func (t *upsertTable) flush(ctx context.Context, result *pfs.EgressResponse_SQLDatabaseResult) error {
+	if err := t.flushPending(ctx, result); err != nil {
+		return err
+	}
+	if err := t.inserts.Flush(); err != nil {
+		return errors.EnsureStack(err)
+	}
+	for _, key := range t.deletes {
+		res, err := t.tx.ExecContext(ctx, t.deleteStmt, key...)
+		if err != nil {
+			return errors.EnsureStack(err)
+		}
+		n, err := res.RowsAffected()
+		if err != nil {
+			return errors.EnsureStack(err)
+		}
+		result.RowsDeleted[t.name] += n
+	}
+	return nil
+}
+
+func (t *upsertTable) eachRow(ctx context.Context, f fileset.File, fileFormat *pfs.SQLDatabaseEgress_FileFormat, cb func(sdata.Tuple) error) error {
+	return readTuples(ctx, f, t.info, fileFormat, func(tr sdata.TupleReader) error {
+		row, err := sdata.NewTupleFromTableInfo(t.info)
+		if err != nil {
+			return errors.EnsureStack(err)
+		}
+		for {
+			if err := tr.Next(row); err != nil {
+				if errors.Is(err, io.EOF) {
+					return nil
+				}
+				return errors.EnsureStack(err)
+			}
+			if err := cb(row); err != nil {
+				return err
+			}
+		}
+	})
+}
+
+func (t *upsertTable) keyValues(row sdata.Tuple) []interface{} {
+	var key []interface{}
+	for _, i := range t.key {
+		key = append(key, row[i])
+	}
+	return key
+}
+
+func (t *upsertTable) rowKey(row sdata.Tuple) (string, error) {
+	return keyString(t.keyValues(row))
+}
+
+// rowDigest returns a digest of the values of row, encoded as by keyString.
+func rowDigest(row sdata.Tuple) ([sha256.Size]byte, error) {
+	k, err := keyString(row)
+	if err != nil {
+		return [sha256.Size]byte{}, err
+	}
+	return sha256.Sum256([]byte(k)), nil
+}
+
+// keyString encodes the values of a primary key.  Times are converted to UTC,
+// since a database may return them in another location than the files hold.
+func keyString(key []interface{}) (string, error) {
+	normalized := make([]interface{}, len(key))
+	for i, v := range key {
+		switch v := v.(type) {
+		case *time.Time:
+			normalized[i] = v.UTC()
+		case *sql.NullTime:
+			normalized[i] = sql.NullTime{Time: v.Time.UTC(), Valid: v.Valid}
+		default:
+			normalized[i] = v
+		}
+	}
+	k, err := json.Marshal(normalized)
+	if err != nil {
+		return "", errors.EnsureStack(err)
+	}
+	return string(k), nil
+}
@@ -28,8 +28,10 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/task"
 	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
 	"github.com/pachyderm/pachyderm/v2/src/internal/watch/postgres"
+	"github.com/pachyderm/pachyderm/v2/src/internal/webhookdb"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
 	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
+	webhook_server "github.com/pachyderm/pachyderm/v2/src/server/webhook/server"
 )
 
 const (
@@ -97,6 +99,101 @@ func (m *Master) Run(ctx context.Context) error {
 				return gc.RunForever(pctx.Child(ctx, "chunk-gc"))
 			})
 		}
+		rewrapPeriod := time.Second * time.Duration(m.env.StorageConfig.StorageKeyRewrapPeriod)
+		if m.env.StorageConfig.StorageKeyDir == "" || rewrapPeriod <= 0 {
+			log.Info(ctx, "Skipping Chunk Key Rewrapping")
+		} else {
+			eg.Go(func() error {
+				lock := dlock.NewDLock(m.driver.etcdClient, path.Join(m.driver.prefix, masterLockPath, "chunk-rewrap"))
+				log.Info(ctx, "Starting Chunk Key Rewrapping", zap.Duration("period", rewrapPeriod))
+				ctx, err := lock.Lock(ctx)
+				if err != nil {
+					return errors.EnsureStack(err)
+				}
+				defer func() {
+					if err := lock.Unlock(ctx); err != nil {
+						log.Error(ctx, "error unlocking in pfs master (chunk rewrap)", zap.Error(err))
+					}
+				}()
+				rw := chunk.NewRewrapper(m.driver.storage.Chunks, rewrapPeriod)
+				return rw.RunForever(pctx.Child(ctx, "chunk-rewrap"))
+			})
+		}
+		tieringPeriod := time.Second * time.Duration(m.env.StorageConfig.StorageTieringPeriod)
+		if m.env.StorageConfig.StorageHotTierPath == "" || tieringPeriod <= 0 {
+			log.Info(ctx, "Skipping Chunk Tiering")
+		} else {
+			eg.Go(func() error {
+				lock := dlock.NewDLock(m.driver.etcdClient, path.Join(m.driver.prefix, masterLockPath, "chunk-tiering"))
+				log.Info(ctx, "Starting Chunk Tiering", zap.Duration("period", tieringPeriod))
+				ctx, err := lock.Lock(ctx)
+				if err != nil {
+					return errors.EnsureStack(err)
+				}
+				defer func() {
+					if err := lock.Unlock(ctx); err != nil {
+						log.Error(ctx, "error unlocking in pfs master (chunk tiering)", zap.Error(err))
+					}
+				}()
+				t := chunk.NewTierer(m.driver.storage.Chunks, tieringPeriod, m.driver.tieringPolicy())
+				return t.RunForever(pctx.Child(ctx, "chunk-tiering"))
+			})
+		}
+		statsPeriod := time.Second * time.Duration(m.env.StorageConfig.StorageStatsPeriod)
+		if statsPeriod <= 0 {
+			log.Info(ctx, "Skipping Storage Stats")
+		} else {
+			eg.Go(func() error {
+				lock := dlock.NewDLock(m.driver.etcdClient, path.Join(m.driver.prefix, masterLockPath, "storage-stats"))
+				log.Info(ctx, "Starting Storage Stats", zap.Duration("period", statsPeriod))
+				ctx, err := lock.Lock(ctx)
+				if err != nil {
+					return errors.EnsureStack(err)
+				}
+				defer func() {
+					if err := lock.Unlock(ctx); err != nil {
+						log.Error(ctx, "error unlocking in pfs master (storage stats)", zap.Error(err))
+					}
+				}()
+				return m.driver.updateStorageStats(pctx.Child(ctx, "storage-stats"), statsPeriod)
+			})
+		}
+		if m.env.AuditRepo == nil || m.env.AuditExportPeriod <= 0 {
+			log.Info(ctx, "Skipping Audit Log Export")
+		} else {
+			eg.Go(func() error {
+				lock := dlock.NewDLock(m.driver.etcdClient, path.Join(m.driver.prefix, masterLockPath, "audit-export"))
+				log.Info(ctx, "Starting Audit Log Export", zap.Stringer("repo", m.env.AuditRepo), zap.Duration("period", m.env.AuditExportPeriod))
+				ctx, err := lock.Lock(ctx)
+				if err != nil {
+					return errors.EnsureStack(err)
+				}
+				defer func() {
+					if err := lock.Unlock(ctx); err != nil {
+						log.Error(ctx, "error unlocking in pfs master (audit export)", zap.Error(err))
+					}
+				}()
+				return m.driver.exportAuditLog(pctx.Child(ctx, "audit-export"), m.env.AuditRepo, m.env.AuditExportPeriod)
+			})
+		}
+		if m.env.Webhooks.Period <= 0 {
+			log.Info(ctx, "Skipping Webhook Delivery")
+		} else {
+			eg.Go(func() error {
+				lock := dlock.NewDLock(m.driver.etcdClient, path.Join(m.driver.prefix, masterLockPath, "webhook-delivery"))
+				log.Info(ctx, "Starting Webhook Delivery", zap.Duration("period", m.env.Webhooks.Period))
+				ctx, err := lock.Lock(ctx)
+				if err != nil {
+					return errors.EnsureStack(err)
+				}
+				defer func() {
+					if err := lock.Unlock(ctx); err != nil {
+						log.Error(ctx, "error unlocking in pfs master (webhook delivery)", zap.Error(err))
+					}
+				}()
+				return webhook_server.NewDispatcher(m.env.DB, m.env.Webhooks).RunForever(pctx.Child(ctx, "webhook-delivery"))
+			})
+		}
 		eg.Go(func() error {
 			return m.watchRepos(ctx)
 		})
@@ -215,6 +312,14 @@ func (d *driver) manageRepo(ctx context.Context, ring *consistenthashing.Ring, r
 				return nil
 			})
 		})
+		eg.Go(func() error {
+			return backoff.RetryUntilCancel(ctx, func() error {
+				return d.enforceRetention(ctx, repoPair)
+			}, backoff.NewInfiniteBackOff(), func(err error, d time.Duration) error {
+				log.Error(ctx, "enforcing repo retention policy", zap.Uint64("repo id", uint64(repoPair.ID)), zap.String("repo", key), zap.Error(err), zap.Duration("retryAfter", d))
+				return nil
+			})
+		})
 		return errors.EnsureStack(eg.Wait())
 	}, backoff.NewInfiniteBackOff(), func(err error, d time.Duration) error {
 		log.Error(ctx, "managing repo", zap.String("repo", key), zap.Error(err), zap.Duration("retryAfter", d))
@@ -494,6 +599,9 @@ func (d *driver) finalizeCommit(ctx context.Context, commitWithID *pfsdb.CommitW
 			}
 			if commitInfo.Commit.Repo.Type == pfs.UserRepoType {
 				txnCtx.FinishJob(commitInfo)
+				if err := webhookdb.CommitFinished(ctx, txnCtx.SqlTx, commitInfo); err != nil {
+					return err
+				}
 			}
 			if commitInfo.Error == "" {
 				return d.triggerCommit(ctx, txnCtx, commitInfo)
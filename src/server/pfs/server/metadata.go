@@ -0,0 +1,154 @@
+package server
+
+import (
+	"context"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
+)
+
+// editMetadata applies each edit in 'edits' to the metadata of its target.
+// The edits are applied in order, so later edits observe earlier ones.
+func (d *driver) editMetadata(ctx context.Context, txnCtx *txncontext.TransactionContext, edits []*pfs.EditMetadataRequest_Edit) error {
+	for i, edit := range edits {
+		if err := d.editMetadataTarget(ctx, txnCtx, edit); err != nil {
+			return errors.Wrapf(err, "edit #%d", i)
+		}
+	}
+	return nil
+}
+
+func (d *driver) editMetadataTarget(ctx context.Context, txnCtx *txncontext.TransactionContext, edit *pfs.EditMetadataRequest_Edit) error {
+	switch target := edit.GetTarget().(type) {
+	case *pfs.EditMetadataRequest_Edit_Project:
+		project := target.Project
+		if err := d.env.Auth.CheckProjectIsAuthorizedInTransaction(txnCtx, project, auth.Permission_PROJECT_MODIFY_BINDINGS); err != nil {
+			return errors.Wrapf(err, "could not edit metadata of project %q", project)
+		}
+		id, err := pfsdb.GetProjectID(ctx, txnCtx.SqlTx, project.GetName())
+		if err != nil {
+			if pfsdb.IsNotFoundError(err) {
+				return errors.Join(err, pfsserver.ErrProjectNotFound{Project: project})
+			}
+			return errors.Wrapf(err, "get project %q", project)
+		}
+		projectInfo, err := pfsdb.GetProject(ctx, txnCtx.SqlTx, id)
+		if err != nil {
+			return errors.Wrapf(err, "get project %q", project)
+		}
+		md, err := applyMetadataEdit(projectInfo.Metadata, edit)
+		if err != nil {
+			return err
+		}
+		return errors.Wrapf(pfsdb.UpdateProjectMetadata(ctx, txnCtx.SqlTx, id, md), "update metadata of project %q", project)
+	case *pfs.EditMetadataRequest_Edit_Repo:
+		repo := target.Repo
+		repo.EnsureProject()
+		if repo.Type == "" {
+			repo.Type = pfs.UserRepoType
+		}
+		if err := d.env.Auth.CheckRepoIsAuthorizedInTransaction(txnCtx, repo, auth.Permission_REPO_WRITE); err != nil {
+			return errors.Wrapf(err, "could not edit metadata of repo %q", repo)
+		}
+		if err := checkAuditRepoWrite(ctx, repo); err != nil {
+			return err
+		}
+		repoInfo, err := d.inspectRepo(ctx, txnCtx, repo, false)
+		if err != nil {
+			return err
+		}
+		id, err := pfsdb.GetRepoID(ctx, txnCtx.SqlTx, repo.Project.Name, repo.Name, repo.Type)
+		if err != nil {
+			return errors.Wrapf(err, "get repo %q", repo)
+		}
+		md, err := applyMetadataEdit(repoInfo.Metadata, edit)
+		if err != nil {
+			return err
+		}
+		return errors.Wrapf(pfsdb.UpdateRepoMetadata(ctx, txnCtx.SqlTx, id, md), "update metadata of repo %q", repo)
+	case *pfs.EditMetadataRequest_Edit_Branch:
+		branch := target.Branch
+		branch.GetRepo().EnsureProject()
+		if err := d.env.Auth.CheckRepoIsAuthorizedInTransaction(txnCtx, branch.GetRepo(), auth.Permission_REPO_WRITE); err != nil {
+			return errors.Wrapf(err, "could not edit metadata of branch %q", branch)
+		}
+		if err := checkAuditRepoWrite(ctx, branch.GetRepo()); err != nil {
+			return err
+		}
+		branchInfo, err := pfsdb.GetBranchInfoWithID(ctx, txnCtx.SqlTx, branch)
+		if err != nil {
+			if pfsdb.IsNotFoundError(err) {
+				return errors.Join(err, pfsserver.ErrBranchNotFound{Branch: branch})
+			}
+			return errors.Wrapf(err, "get branch %q", branch)
+		}
+		md, err := applyMetadataEdit(branchInfo.Metadata, edit)
+		if err != nil {
+			return err
+		}
+		return errors.Wrapf(pfsdb.UpdateBranchMetadata(ctx, txnCtx.SqlTx, branchInfo.ID, md), "update metadata of branch %q", branch)
+	case *pfs.EditMetadataRequest_Edit_Commit:
+		commit := target.Commit
+		commit.GetRepo().EnsureProject()
+		if err := d.env.Auth.CheckRepoIsAuthorizedInTransaction(txnCtx, commit.GetRepo(), auth.Permission_REPO_WRITE); err != nil {
+			return errors.Wrapf(err, "could not edit metadata of commit %q", commit)
+		}
+		commitWithID, err := d.resolveCommitWithID(ctx, txnCtx.SqlTx, commit)
+		if err != nil {
+			return err
+		}
+		if err := checkAuditRepoWrite(ctx, commitWithID.CommitInfo.Commit.Repo); err != nil {
+			return err
+		}
+		md, err := applyMetadataEdit(commitWithID.CommitInfo.Metadata, edit)
+		if err != nil {
+			return err
+		}
+		return errors.Wrapf(pfsdb.UpdateCommitMetadata(ctx, txnCtx.SqlTx, commitWithID.ID, md), "update metadata of commit %q", commit)
+	default:
+		return errors.Errorf("unknown edit target %T", target)
+	}
+}
+
+// applyMetadataEdit returns a copy of 'md' with the operation in 'edit' applied.
+func applyMetadataEdit(md map[string]string, edit *pfs.EditMetadataRequest_Edit) (map[string]string, error) {
+	result := make(map[string]string, len(md))
+	for k, v := range md {
+		result[k] = v
+	}
+	switch op := edit.GetOp().(type) {
+	case *pfs.EditMetadataRequest_Edit_Replace_:
+		result = make(map[string]string, len(op.Replace.GetReplacement()))
+		for k, v := range op.Replace.GetReplacement() {
+			result[k] = v
+		}
+	case *pfs.EditMetadataRequest_Edit_AddKey_:
+		key := op.AddKey.GetKey()
+		if _, ok := result[key]; ok {
+			return nil, errors.Errorf("key %q already exists", key)
+		}
+		result[key] = op.AddKey.GetValue()
+	case *pfs.EditMetadataRequest_Edit_EditKey_:
+		result[op.EditKey.GetKey()] = op.EditKey.GetValue()
+	case *pfs.EditMetadataRequest_Edit_DeleteKey_:
+		delete(result, op.DeleteKey.GetKey())
+	default:
+		return nil, errors.Errorf("unknown edit operation %T", op)
+	}
+	return result, nil
+}
+
+// passesMetadataFilter returns true if 'md' contains every key/value pair in
+// 'filter'.  An empty filter matches everything.
+func passesMetadataFilter(md, filter map[string]string) bool {
+	for k, v := range filter {
+		if got, ok := md[k]; !ok || got != v {
+			return false
+		}
+	}
+	return true
+}
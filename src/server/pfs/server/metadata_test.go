@@ -0,0 +1,50 @@
+//go:build unit_test
+
+package server
+
+import (
+	"testing"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+)
+
+func TestApplyMetadataEdit(t *testing.T) {
+	md := map[string]string{"a": "1"}
+	add := func(k, v string) *pfs.EditMetadataRequest_Edit {
+		return &pfs.EditMetadataRequest_Edit{Op: &pfs.EditMetadataRequest_Edit_AddKey_{AddKey: &pfs.EditMetadataRequest_Edit_AddKey{Key: k, Value: v}}}
+	}
+
+	got, err := applyMetadataEdit(md, add("b", "2"))
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"a": "1", "b": "2"}, got)
+	require.Equal(t, map[string]string{"a": "1"}, md, "input should not be modified")
+
+	_, err = applyMetadataEdit(md, add("a", "2"))
+	require.YesError(t, err)
+
+	got, err = applyMetadataEdit(md, &pfs.EditMetadataRequest_Edit{Op: &pfs.EditMetadataRequest_Edit_EditKey_{EditKey: &pfs.EditMetadataRequest_Edit_EditKey{Key: "a", Value: "3"}}})
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"a": "3"}, got)
+
+	got, err = applyMetadataEdit(md, &pfs.EditMetadataRequest_Edit{Op: &pfs.EditMetadataRequest_Edit_DeleteKey_{DeleteKey: &pfs.EditMetadataRequest_Edit_DeleteKey{Key: "a"}}})
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{}, got)
+
+	got, err = applyMetadataEdit(md, &pfs.EditMetadataRequest_Edit{Op: &pfs.EditMetadataRequest_Edit_Replace_{Replace: &pfs.EditMetadataRequest_Edit_Replace{Replacement: map[string]string{"c": "4"}}}})
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"c": "4"}, got)
+
+	_, err = applyMetadataEdit(md, &pfs.EditMetadataRequest_Edit{})
+	require.YesError(t, err)
+}
+
+func TestPassesMetadataFilter(t *testing.T) {
+	md := map[string]string{"a": "1", "b": "2"}
+	require.True(t, passesMetadataFilter(md, nil))
+	require.True(t, passesMetadataFilter(md, map[string]string{"a": "1"}))
+	require.True(t, passesMetadataFilter(md, map[string]string{"a": "1", "b": "2"}))
+	require.False(t, passesMetadataFilter(md, map[string]string{"a": "2"}))
+	require.False(t, passesMetadataFilter(md, map[string]string{"c": "3"}))
+	require.False(t, passesMetadataFilter(nil, map[string]string{"a": "1"}))
+}
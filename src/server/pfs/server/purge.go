@@ -0,0 +1,544 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfsfile"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/chunk"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset/index"
+	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/server/worker/common"
+)
+
+// purgePath removes the files at or under 'p' from every commit of 'repo'.
+// A datum of a downstream pipeline that read a purged file is purged too: its
+// output and meta files are removed from every commit of the pipeline's output
+// and meta repos, which in turn purges the datums downstream of that output,
+// and its cached output is cleared.
+//
+// Everything to purge is found before anything is rewritten, so that every
+// commit of the purged repos is rewritten without a reference to the chunks of
+// the purged files, whichever commit those files were in.  If a purged chunk
+// is still referenced afterwards, e.g. by a copy of a purged file in a repo
+// that isn't downstream, the purge fails.
+func (d *driver) purgePath(ctx context.Context, repo *pfs.Repo, p string) (*pfs.PurgePathResponse, error) {
+	p = pfsfile.CleanPath(p)
+	if p == "/" {
+		return nil, errors.Errorf("path must name a file or directory below the root")
+	}
+	dir := p + "/"
+	resp := &pfs.PurgePathResponse{}
+	plan := &purgePlan{chunks: make(map[string]chunk.ID)}
+	if err := d.storage.Filesets.WithRenewer(ctx, defaultTTL, func(ctx context.Context, renewer *fileset.Renewer) error {
+		paths, err := d.planPurge(ctx, renewer, plan, repo, func(idx *index.Index) bool {
+			return idx.Path == p || strings.HasPrefix(idx.Path, dir)
+		})
+		if err != nil {
+			return err
+		}
+		if err := d.planPurgeDownstream(ctx, renewer, plan, repo, paths); err != nil {
+			return err
+		}
+		chunks := plan.chunkIDs()
+		for _, target := range plan.targets {
+			purged, err := d.purgeRepo(ctx, renewer, target.repo, target.purge, chunks, resp)
+			if err != nil {
+				return err
+			}
+			// Commits made since the plan was made may hold more chunks.
+			plan.addChunks(purged)
+		}
+		// Cached output holds the purged files too.
+		for _, tag := range plan.cacheTags {
+			if err := d.clearCache(ctx, tag); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	resp.Datums = plan.datums
+	// The renewer is closed, so the file sets that the purge replaced
+	// only stay around until they expire.
+	unreferenced, referenced, err := d.chunkReferences(ctx, plan.chunkIDs())
+	if err != nil {
+		return nil, err
+	}
+	if len(referenced) > 0 {
+		return nil, errors.Errorf("purged files were rewritten in %d commits, but chunks of their data are still referenced, e.g. by copies outside of the repos downstream of %q: %v", len(resp.Commits), repo, referenced)
+	}
+	resp.UnreferencedChunks = unreferenced
+	return resp, nil
+}
+
+// purgePlan is what a purge removes, found before anything is rewritten.
+type purgePlan struct {
+	targets []*purgeTarget
+	// chunks holds the chunks that the purged files reference, in any commit.
+	chunks    map[string]chunk.ID
+	datums    []*pfs.PurgedDatums
+	cacheTags []string
+}
+
+// purgeTarget is a repo and the files to purge from it.
+type purgeTarget struct {
+	repo  *pfs.Repo
+	purge func(*index.Index) bool
+}
+
+// addTarget adds the files of 'repo' that match 'purge' to the plan.
+func (plan *purgePlan) addTarget(repo *pfs.Repo, purge func(*index.Index) bool) {
+	for _, target := range plan.targets {
+		if target.repo.Key() == repo.Key() {
+			prev := target.purge
+			target.purge = func(idx *index.Index) bool { return prev(idx) || purge(idx) }
+			return
+		}
+	}
+	plan.targets = append(plan.targets, &purgeTarget{repo: repo, purge: purge})
+}
+
+func (plan *purgePlan) addChunks(purged []*index.Index) {
+	for _, idx := range purged {
+		for _, id := range index.PointsTo(idx) {
+			plan.chunks[string(id)] = id
+		}
+	}
+}
+
+func (plan *purgePlan) chunkIDs() []chunk.ID {
+	ids := make([]chunk.ID, 0, len(plan.chunks))
+	for _, id := range plan.chunks {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return string(ids[i]) < string(ids[j]) })
+	return ids
+}
+
+// planPurge adds the files of 'repo' that match 'purge' to 'plan', along with
+// the chunks they reference in any commit, and returns their paths.
+func (d *driver) planPurge(ctx context.Context, renewer *fileset.Renewer, plan *purgePlan, repo *pfs.Repo, purge func(*index.Index) bool) (map[string]bool, error) {
+	commitInfos, err := d.purgeableCommits(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+	paths := make(map[string]bool)
+	for _, ci := range commitInfos {
+		ids, err := d.commitFileSets(ctx, renewer, ci.Commit)
+		if err != nil {
+			return nil, err
+		}
+		fs, err := d.storage.Filesets.Open(ctx, ids)
+		if err != nil {
+			return nil, err
+		}
+		var purged []*index.Index
+		if err := fs.Iterate(ctx, func(f fileset.File) error {
+			if idx := f.Index(); purge(idx) {
+				paths[idx.Path] = true
+				purged = append(purged, idx)
+			}
+			return nil
+		}); err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		plan.addChunks(purged)
+	}
+	plan.addTarget(repo, purge)
+	return paths, nil
+}
+
+// commitFileSets returns the diff file set of 'commit' and, if it has one,
+// its total file set, and keeps them alive with 'renewer'.
+func (d *driver) commitFileSets(ctx context.Context, renewer *fileset.Renewer, commit *pfs.Commit) ([]fileset.ID, error) {
+	diffID, err := d.commitStore.GetDiffFileSet(ctx, commit)
+	if err != nil {
+		return nil, err
+	}
+	ids := []fileset.ID{*diffID}
+	totalID, err := d.commitStore.GetTotalFileSet(ctx, commit)
+	if err != nil && !errors.Is(err, errNoTotalFileSet) {
+		return nil, err
+	}
+	if totalID != nil {
+		ids = append(ids, *totalID)
+	}
+	for _, id := range ids {
+		if err := renewer.Add(ctx, id); err != nil {
+			return nil, err
+		}
+	}
+	return ids, nil
+}
+
+// planPurgeDownstream adds the datums of the pipelines directly downstream of
+// 'repo' that read any of 'paths' to 'plan', and then recursively the datums
+// downstream of their output.
+func (d *driver) planPurgeDownstream(ctx context.Context, renewer *fileset.Renewer, plan *purgePlan, repo *pfs.Repo, paths map[string]bool) error {
+	if len(paths) == 0 {
+		return nil
+	}
+	outputRepos, err := d.downstreamPipelineRepos(ctx, repo)
+	if err != nil {
+		return err
+	}
+	for _, outputRepo := range outputRepos {
+		metaRepo := &pfs.Repo{Project: outputRepo.Project, Name: outputRepo.Name, Type: pfs.MetaRepoType}
+		datums, err := d.datumsReading(ctx, renewer, metaRepo, repo, paths)
+		if err != nil {
+			return err
+		}
+		if len(datums) == 0 {
+			continue
+		}
+		byDatum := func(idx *index.Index) bool { return datums[idx.File.Datum] }
+		if _, err := d.planPurge(ctx, renewer, plan, metaRepo, byDatum); err != nil {
+			return err
+		}
+		outputPaths, err := d.planPurge(ctx, renewer, plan, outputRepo, byDatum)
+		if err != nil {
+			return err
+		}
+		purgedDatums := &pfs.PurgedDatums{Repo: outputRepo}
+		for datum := range datums {
+			purgedDatums.Datums = append(purgedDatums.Datums, datum)
+		}
+		sort.Strings(purgedDatums.Datums)
+		plan.datums = append(plan.datums, purgedDatums)
+		for _, datum := range purgedDatums.Datums {
+			plan.cacheTags = append(plan.cacheTags, common.DatumOutputCacheTag(outputRepo.Project.GetName(), datum))
+		}
+		if err := d.planPurgeDownstream(ctx, renewer, plan, outputRepo, outputPaths); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// downstreamPipelineRepos returns the output repos of the pipelines that
+// read a branch of 'repo'.
+func (d *driver) downstreamPipelineRepos(ctx context.Context, repo *pfs.Repo) ([]*pfs.Repo, error) {
+	var result []*pfs.Repo
+	if err := d.txnEnv.WithReadContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		branchInfos, err := pfsdb.ListBranches(ctx, txnCtx.SqlTx, &pfs.Branch{Repo: repo})
+		if err != nil {
+			return errors.Wrapf(err, "list branches of repo %q", repo)
+		}
+		seen := make(map[string]bool)
+		for _, branchInfo := range branchInfos {
+			for _, sub := range branchInfo.BranchInfo.Subvenance {
+				subRepo := sub.Repo
+				if subRepo.Type != pfs.UserRepoType || seen[subRepo.Key()] {
+					continue
+				}
+				subInfo, err := pfsdb.GetBranchInfoWithID(ctx, txnCtx.SqlTx, sub)
+				if err != nil {
+					return errors.Wrapf(err, "get branch %q", sub)
+				}
+				direct := false
+				for _, prov := range subInfo.DirectProvenance {
+					if prov.Repo.Key() == repo.Key() {
+						direct = true
+					}
+				}
+				if !direct {
+					continue
+				}
+				// Only pipelines have a meta repo that records what their
+				// datums read.
+				if _, err := pfsdb.GetRepoByName(ctx, txnCtx.SqlTx, subRepo.Project.Name, subRepo.Name, pfs.MetaRepoType); err != nil {
+					if pfsdb.IsErrRepoNotFound(err) {
+						continue
+					}
+					return errors.EnsureStack(err)
+				}
+				seen[subRepo.Key()] = true
+				result = append(result, subRepo)
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Key() < result[j].Key() })
+	return result, nil
+}
+
+// datumsReading returns the IDs of the datums recorded in any commit of
+// 'metaRepo' that read one of 'paths' from 'inputRepo', or a directory that
+// contains one of them.
+func (d *driver) datumsReading(ctx context.Context, renewer *fileset.Renewer, metaRepo, inputRepo *pfs.Repo, paths map[string]bool) (map[string]bool, error) {
+	dirs := make(map[string]bool)
+	for p := range paths {
+		for dir := p; dir != "/"; {
+			dir = pfsfile.CleanPath(dir[:strings.LastIndex(dir, "/")])
+			dirs[dir] = true
+		}
+	}
+	reads := func(p string) bool {
+		p = pfsfile.CleanPath(p)
+		return paths[p] || dirs[p]
+	}
+	commitInfos, err := d.purgeableCommits(ctx, metaRepo)
+	if err != nil {
+		return nil, err
+	}
+	datums := make(map[string]bool)
+	for _, ci := range commitInfos {
+		id, err := d.commitStore.GetTotalFileSet(ctx, ci.Commit)
+		if err != nil {
+			if errors.Is(err, errNoTotalFileSet) {
+				continue
+			}
+			return nil, err
+		}
+		if err := renewer.Add(ctx, *id); err != nil {
+			return nil, err
+		}
+		fs, err := d.storage.Filesets.Open(ctx, []fileset.ID{*id})
+		if err != nil {
+			return nil, err
+		}
+		if err := fs.Iterate(ctx, func(f fileset.File) error {
+			idx := f.Index()
+			if datums[idx.File.Datum] || !strings.HasSuffix(idx.Path, "/"+common.MetaFileName) {
+				return nil
+			}
+			buf := &strings.Builder{}
+			if err := f.Content(ctx, buf); err != nil {
+				return errors.EnsureStack(err)
+			}
+			inputs, err := metaInputs([]byte(buf.String()))
+			if err != nil {
+				return errors.Wrapf(err, "read datum meta file %q in commit %q", idx.Path, ci.Commit)
+			}
+			for _, input := range inputs {
+				file := input.GetFileInfo().GetFile()
+				if file.GetCommit().GetRepo().Key() == inputRepo.Key() && reads(file.Path) {
+					datums[idx.File.Datum] = true
+				}
+			}
+			return nil
+		}, index.WithPrefix("/"+common.MetaPrefix+"/")); err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+	}
+	return datums, nil
+}
+
+// metaInputs returns the inputs recorded in a datum's meta file.  The meta
+// file holds a datum.Meta, which the worker package defines; only its inputs
+// are needed here.
+func metaInputs(data []byte) ([]*common.Input, error) {
+	var meta struct {
+		Inputs []json.RawMessage `json:"inputs"`
+	}
+	if err := json.Unmarshal(data, &meta); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	var inputs []*common.Input
+	for _, raw := range meta.Inputs {
+		input := &common.Input{}
+		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(raw, input); err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		inputs = append(inputs, input)
+	}
+	return inputs, nil
+}
+
+// purgeableCommits checks that the caller may purge 'repo' and returns its
+// commits.  Every commit must be finished, since the file sets of an open
+// commit are still being written.
+func (d *driver) purgeableCommits(ctx context.Context, repo *pfs.Repo) ([]*pfs.CommitInfo, error) {
+	var commitInfos []*pfs.CommitInfo
+	if err := checkAuditRepoWrite(ctx, repo); err != nil {
+		return nil, err
+	}
+	if err := d.txnEnv.WithReadContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		if err := d.env.Auth.CheckRepoIsAuthorizedInTransaction(txnCtx, repo, auth.Permission_REPO_WRITE); err != nil {
+			return errors.Wrapf(err, "could not purge repo %q", repo)
+		}
+		if _, err := pfsdb.GetRepoByName(ctx, txnCtx.SqlTx, repo.Project.Name, repo.Name, repo.Type); err != nil {
+			if pfsdb.IsErrRepoNotFound(err) {
+				return pfsserver.ErrRepoNotFound{Repo: repo}
+			}
+			return errors.EnsureStack(err)
+		}
+		var err error
+		commitInfos, err = pfsdb.ListCommitTxByFilter(ctx, txnCtx.SqlTx, &pfs.Commit{Repo: repo})
+		return errors.Wrapf(err, "list commits of repo %q", repo)
+	}); err != nil {
+		return nil, err
+	}
+	for _, ci := range commitInfos {
+		if ci.Finished == nil {
+			return nil, errors.Errorf("commit %q is not finished; purge once it has finished", ci.Commit)
+		}
+	}
+	return commitInfos, nil
+}
+
+// purgeRepo rewrites the file sets of every commit of 'repo' without the
+// files that match 'purge', and without any reference to 'chunks', and
+// returns the indexes of the purged files.  Commits made while it runs are
+// purged in another pass.
+func (d *driver) purgeRepo(ctx context.Context, renewer *fileset.Renewer, repo *pfs.Repo, purge func(*index.Index) bool, chunks []chunk.ID, resp *pfs.PurgePathResponse) ([]*index.Index, error) {
+	var purged []*index.Index
+	done := make(map[string]bool)
+	for {
+		commitInfos, err := d.purgeableCommits(ctx, repo)
+		if err != nil {
+			return nil, err
+		}
+		var todo []*pfs.CommitInfo
+		for _, ci := range commitInfos {
+			if !done[pfsdb.CommitKey(ci.Commit)] {
+				todo = append(todo, ci)
+			}
+		}
+		if len(todo) == 0 {
+			return purged, nil
+		}
+		for _, ci := range todo {
+			done[pfsdb.CommitKey(ci.Commit)] = true
+			rewritten, commitPurged, err := d.purgeCommit(ctx, renewer, ci.Commit, purge, chunks)
+			if err != nil {
+				return nil, errors.Wrapf(err, "purge commit %q", ci.Commit)
+			}
+			if rewritten {
+				resp.Commits = append(resp.Commits, ci.Commit)
+			}
+			purged = append(purged, commitPurged...)
+		}
+	}
+}
+
+// purgeCommit replaces the diff and total file sets of 'commit' with copies
+// that leave out the files that match 'purge' and any reference to 'chunks'.
+// It reports whether it replaced either, and returns the indexes of the files
+// left out.
+func (d *driver) purgeCommit(ctx context.Context, renewer *fileset.Renewer, commit *pfs.Commit, purge func(*index.Index) bool, chunks []chunk.ID) (bool, []*index.Index, error) {
+	purgeFileSet := func(id *fileset.ID) (*fileset.ID, []*index.Index, error) {
+		if err := renewer.Add(ctx, *id); err != nil {
+			return nil, nil, err
+		}
+		purgedID, purged, err := d.storage.Filesets.Purge(ctx, *id, purge, chunks, defaultTTL)
+		if err != nil || purgedID == nil {
+			return nil, nil, err
+		}
+		if err := renewer.Add(ctx, *purgedID); err != nil {
+			return nil, nil, err
+		}
+		return purgedID, purged, nil
+	}
+	diffID, err := d.commitStore.GetDiffFileSet(ctx, commit)
+	if err != nil {
+		return false, nil, err
+	}
+	newDiffID, diffPurged, err := purgeFileSet(diffID)
+	if err != nil {
+		return false, nil, err
+	}
+	var newTotalID *fileset.ID
+	var totalPurged []*index.Index
+	totalID, err := d.commitStore.GetTotalFileSet(ctx, commit)
+	if err != nil && !errors.Is(err, errNoTotalFileSet) {
+		return false, nil, err
+	}
+	if totalID != nil {
+		if newTotalID, totalPurged, err = purgeFileSet(totalID); err != nil {
+			return false, nil, err
+		}
+	}
+	if newDiffID == nil && newTotalID == nil {
+		return false, nil, nil
+	}
+	if err := dbutil.WithTx(ctx, d.env.DB, func(ctx context.Context, tx *pachsql.Tx) error {
+		if newDiffID != nil {
+			if err := d.commitStore.SetDiffFileSetTx(tx, commit, *newDiffID); err != nil {
+				return errors.EnsureStack(err)
+			}
+		}
+		if newTotalID == nil {
+			return nil
+		}
+		if err := d.commitStore.SetTotalFileSetTx(tx, commit, *newTotalID); err != nil {
+			return errors.EnsureStack(err)
+		}
+		commitWithID, err := pfsdb.GetCommitWithIDByKey(ctx, tx, commit)
+		if err != nil {
+			return errors.Wrap(err, "get commit")
+		}
+		ci := commitWithID.CommitInfo
+		if ci.Details == nil {
+			return nil
+		}
+		for _, idx := range totalPurged {
+			ci.Details.SizeBytes -= index.SizeBytes(idx)
+		}
+		ci.SizeBytesUpperBound = ci.Details.SizeBytes
+		return errors.Wrap(pfsdb.UpdateCommit(ctx, tx, commitWithID.ID, ci, pfsdb.AncestryOpt{SkipParent: true, SkipChildren: true}), "update commit size")
+	}); err != nil {
+		return false, nil, err
+	}
+	// Cached compactions of the commit still reference the purged files.
+	if err := d.clearCache(ctx, pfsdb.CommitKey(commit)); err != nil {
+		return false, nil, err
+	}
+	return true, append(diffPurged, totalPurged...), nil
+}
+
+// chunkReferences splits the chunks in 'ids' by whether they are still
+// referenced, and returns their hex IDs.  A chunk is unreferenced if it is
+// only reachable from file sets and other chunks, and not from a commit or
+// anything else that keeps file sets alive; garbage collection deletes it
+// once those file sets expire.
+func (d *driver) chunkReferences(ctx context.Context, ids []chunk.ID) (unreferenced, referenced []string, _ error) {
+	tracker := d.storage.Tracker
+	for _, id := range ids {
+		isReferenced := false
+		seen := make(map[string]bool)
+		queue := []string{id.TrackerID()}
+		for len(queue) > 0 && !isReferenced {
+			upstream, err := tracker.GetUpstream(ctx, queue[0])
+			if err != nil {
+				return nil, nil, errors.EnsureStack(err)
+			}
+			queue = queue[1:]
+			for _, up := range upstream {
+				if seen[up] {
+					continue
+				}
+				seen[up] = true
+				if !strings.HasPrefix(up, fileset.TrackerPrefix) && !strings.HasPrefix(up, chunk.TrackerPrefix) {
+					isReferenced = true
+					break
+				}
+				queue = append(queue, up)
+			}
+		}
+		if isReferenced {
+			referenced = append(referenced, id.HexString())
+		} else {
+			unreferenced = append(unreferenced, id.HexString())
+		}
+	}
+	sort.Strings(unreferenced)
+	sort.Strings(referenced)
+	return unreferenced, referenced, nil
+}
@@ -0,0 +1,218 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/log"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
+)
+
+// retentionPeriod is how often the PFS master applies the retention policies
+// of a repo.
+const retentionPeriod = 10 * time.Minute
+
+func (d *driver) setRetentionPolicy(ctx context.Context, txnCtx *txncontext.TransactionContext, request *pfs.SetRetentionPolicyRequest) error {
+	switch target := request.GetTarget().(type) {
+	case *pfs.SetRetentionPolicyRequest_Repo:
+		repo := target.Repo
+		repo.EnsureProject()
+		if repo.Type == "" {
+			repo.Type = pfs.UserRepoType
+		}
+		if err := checkAuditRepoWrite(ctx, repo); err != nil {
+			return err
+		}
+		if err := d.env.Auth.CheckRepoIsAuthorizedInTransaction(txnCtx, repo, auth.Permission_REPO_WRITE); err != nil {
+			return errors.Wrapf(err, "could not set retention policy of repo %q", repo)
+		}
+		id, err := pfsdb.GetRepoID(ctx, txnCtx.SqlTx, repo.Project.Name, repo.Name, repo.Type)
+		if err != nil {
+			if pfsdb.IsErrRepoNotFound(err) {
+				return pfsserver.ErrRepoNotFound{Repo: repo}
+			}
+			return errors.Wrapf(err, "get repo %q", repo)
+		}
+		return errors.Wrapf(pfsdb.SetRepoRetentionPolicy(ctx, txnCtx.SqlTx, id, request.Policy), "set retention policy of repo %q", repo)
+	case *pfs.SetRetentionPolicyRequest_Branch:
+		branch := target.Branch
+		branch.GetRepo().EnsureProject()
+		if err := checkAuditRepoWrite(ctx, branch.GetRepo()); err != nil {
+			return err
+		}
+		if err := d.env.Auth.CheckRepoIsAuthorizedInTransaction(txnCtx, branch.GetRepo(), auth.Permission_REPO_WRITE); err != nil {
+			return errors.Wrapf(err, "could not set retention policy of branch %q", branch)
+		}
+		branchInfo, err := pfsdb.GetBranchInfoWithID(ctx, txnCtx.SqlTx, branch)
+		if err != nil {
+			if pfsdb.IsNotFoundError(err) {
+				return errors.Join(err, pfsserver.ErrBranchNotFound{Branch: branch})
+			}
+			return errors.Wrapf(err, "get branch %q", branch)
+		}
+		return errors.Wrapf(pfsdb.SetBranchRetentionPolicy(ctx, txnCtx.SqlTx, branchInfo.ID, request.Policy), "set retention policy of branch %q", branch)
+	default:
+		return errors.Errorf("a repo or branch must be specified")
+	}
+}
+
+// applyRetentionPolicy squashes the commits in 'repo' that have expired under
+// the retention policy of their branch.  If 'branch' is set, only the commits
+// of that branch are considered.  The expired commits are returned; if
+// 'dryRun' is set they are not squashed.
+func (d *driver) applyRetentionPolicy(ctx context.Context, repo *pfs.Repo, branch *pfs.Branch, dryRun bool) ([]*pfs.Commit, error) {
+	now := time.Now()
+	var expired []*pfs.CommitInfo
+	if err := d.txnEnv.WithReadContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		permission := auth.Permission_REPO_WRITE
+		if dryRun {
+			permission = auth.Permission_REPO_READ
+		}
+		if err := d.env.Auth.CheckRepoIsAuthorizedInTransaction(txnCtx, repo, permission); err != nil {
+			return errors.Wrapf(err, "could not apply retention policy of repo %q", repo)
+		}
+		repoInfo, err := d.inspectRepo(ctx, txnCtx, repo, false)
+		if err != nil {
+			return err
+		}
+		var branchInfos []*pfs.BranchInfo
+		if branch != nil {
+			branchInfo, err := d.inspectBranchInTransaction(ctx, txnCtx, branch)
+			if err != nil {
+				return err
+			}
+			branchInfos = append(branchInfos, branchInfo)
+		} else {
+			branchInfoWithIDs, err := pfsdb.ListBranches(ctx, txnCtx.SqlTx, &pfs.Branch{Repo: repoInfo.Repo})
+			if err != nil {
+				return errors.Wrapf(err, "list branches of repo %q", repo)
+			}
+			for _, branchInfoWithID := range branchInfoWithIDs {
+				branchInfos = append(branchInfos, branchInfoWithID.BranchInfo)
+			}
+		}
+		seen := make(map[string]bool)
+		for _, branchInfo := range branchInfos {
+			policy := branchInfo.RetentionPolicy
+			if policy == nil {
+				policy = repoInfo.RetentionPolicy
+			}
+			if !retentionPolicyExpires(policy) {
+				continue
+			}
+			history, err := branchHistory(ctx, txnCtx.SqlTx, branchInfo)
+			if err != nil {
+				return err
+			}
+			for _, ci := range expiredCommits(now, policy, history) {
+				if key := pfsdb.CommitKey(ci.Commit); !seen[key] {
+					seen[key] = true
+					expired = append(expired, ci)
+				}
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	var result []*pfs.Commit
+	for _, ci := range expired {
+		if !dryRun {
+			if err := d.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+				return d.squashCommit(ctx, txnCtx, ci.Commit, true)
+			}); err != nil {
+				// The commit may already have been squashed as the
+				// subvenance of another expired commit, or it may not be
+				// squashable (e.g. it updated a pipeline); skip it.
+				if !pfsserver.IsCommitNotFoundErr(err) {
+					log.Info(ctx, "not squashing expired commit", zap.Stringer("commit", ci.Commit), zap.Error(err))
+				}
+				continue
+			}
+		}
+		result = append(result, ci.Commit)
+	}
+	return result, nil
+}
+
+// enforceRetention periodically squashes the expired commits of a repo.
+func (d *driver) enforceRetention(ctx context.Context, repoPair pfsdb.RepoInfoWithID) error {
+	repo := repoPair.RepoInfo.Repo
+	ticker := time.NewTicker(retentionPeriod)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return errors.EnsureStack(context.Cause(ctx))
+		}
+		squashed, err := d.applyRetentionPolicy(ctx, repo, nil, false)
+		if err != nil {
+			return err
+		}
+		if len(squashed) > 0 {
+			keys := make([]string, len(squashed))
+			for i, commit := range squashed {
+				keys[i] = commit.Key()
+			}
+			log.Info(ctx, "squashed commits expired by retention policy", zap.String("repo", repo.Key()), zap.Strings("commits", keys))
+		}
+	}
+}
+
+// branchHistory returns the commits made on 'branchInfo', newest first,
+// starting from its head and stopping at the first ancestor that was made on
+// another branch.
+func branchHistory(ctx context.Context, tx *pachsql.Tx, branchInfo *pfs.BranchInfo) ([]*pfs.CommitInfo, error) {
+	ci, err := pfsdb.GetCommitByCommitKey(ctx, tx, branchInfo.Head)
+	if err != nil {
+		return nil, errors.Wrapf(err, "get head of branch %q", branchInfo.Branch)
+	}
+	history := []*pfs.CommitInfo{ci}
+	for ci.ParentCommit != nil {
+		ci, err = pfsdb.GetCommitByCommitKey(ctx, tx, ci.ParentCommit)
+		if err != nil {
+			return nil, errors.Wrapf(err, "get ancestor of branch %q", branchInfo.Branch)
+		}
+		if ci.Commit.GetBranch().GetName() != branchInfo.Branch.Name {
+			break
+		}
+		history = append(history, ci)
+	}
+	return history, nil
+}
+
+// retentionPolicyExpires returns true if 'policy' can expire any commits.
+func retentionPolicyExpires(policy *pfs.RetentionPolicy) bool {
+	return policy.GetKeepLast() > 0 || policy.GetKeepNewerThan() != nil
+}
+
+// expiredCommits returns the finished commits in 'history' (which is ordered
+// newest first) that are not kept by 'policy'.  The first commit in history is
+// the branch head and never expires.
+func expiredCommits(now time.Time, policy *pfs.RetentionPolicy, history []*pfs.CommitInfo) []*pfs.CommitInfo {
+	if !retentionPolicyExpires(policy) {
+		return nil
+	}
+	var expired []*pfs.CommitInfo
+	for i, ci := range history {
+		switch {
+		case i == 0:
+		case policy.KeepLast > 0 && int64(i) < policy.KeepLast:
+		case policy.KeepNewerThan != nil && now.Sub(ci.Started.AsTime()) < policy.KeepNewerThan.AsDuration():
+		case len(policy.KeepMetadata) > 0 && passesMetadataFilter(ci.Metadata, policy.KeepMetadata):
+		case ci.Finished == nil:
+		default:
+			expired = append(expired, ci)
+		}
+	}
+	return expired
+}
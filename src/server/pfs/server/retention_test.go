@@ -0,0 +1,57 @@
+//go:build unit_test
+
+package server
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+)
+
+// testHistory returns 'n' finished commits started an hour apart, newest
+// first.
+func testHistory(now time.Time, n int) []*pfs.CommitInfo {
+	var history []*pfs.CommitInfo
+	for i := 0; i < n; i++ {
+		history = append(history, &pfs.CommitInfo{
+			Commit:   &pfs.Commit{Id: fmt.Sprint(i)},
+			Started:  timestamppb.New(now.Add(-time.Duration(i) * time.Hour)),
+			Finished: timestamppb.New(now.Add(-time.Duration(i) * time.Hour)),
+		})
+	}
+	return history
+}
+
+func commitIDs(cis []*pfs.CommitInfo) []string {
+	var ids []string
+	for _, ci := range cis {
+		ids = append(ids, ci.Commit.Id)
+	}
+	return ids
+}
+
+func TestExpiredCommits(t *testing.T) {
+	now := time.Now()
+	history := testHistory(now, 5)
+	history[3].Metadata = map[string]string{"release": "true"}
+	history[4].Finished = nil
+
+	require.Equal(t, 0, len(expiredCommits(now, nil, history)))
+	require.Equal(t, 0, len(expiredCommits(now, &pfs.RetentionPolicy{KeepMetadata: map[string]string{"a": "b"}}, history)))
+	require.Equal(t, []string{"2", "3"}, commitIDs(expiredCommits(now, &pfs.RetentionPolicy{KeepLast: 2}, history)))
+	require.Equal(t, []string{"2"}, commitIDs(expiredCommits(now, &pfs.RetentionPolicy{
+		KeepLast:     2,
+		KeepMetadata: map[string]string{"release": "true"},
+	}, history)))
+	require.Equal(t, []string{"3"}, commitIDs(expiredCommits(now, &pfs.RetentionPolicy{
+		KeepNewerThan: durationpb.New(150 * time.Minute),
+	}, history)))
+	// The head never expires.
+	require.Equal(t, []string{"1", "2", "3"}, commitIDs(expiredCommits(now, &pfs.RetentionPolicy{KeepNewerThan: durationpb.New(time.Nanosecond)}, history)))
+}
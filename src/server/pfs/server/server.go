@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"time"
 
 	"github.com/pachyderm/pachyderm/v2/src/auth"
 	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
@@ -14,6 +15,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
 	"github.com/pachyderm/pachyderm/v2/src/pps"
 	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
+	webhook_server "github.com/pachyderm/pachyderm/v2/src/server/webhook/server"
 	etcd "go.etcd.io/etcd/client/v3"
 )
 
@@ -51,6 +53,14 @@ type Env struct {
 	GetPipelineInspector func() PipelineInspector
 
 	StorageConfig pachconfig.StorageConfiguration
+
+	// If AuditRepo is set, the PFS master exports the audit log to it every AuditExportPeriod.
+	AuditRepo         *pfs.Repo
+	AuditExportPeriod time.Duration
+
+	// Webhooks configures the PFS master's delivery of webhook events.  If its period is zero,
+	// events are not delivered.
+	Webhooks webhook_server.DispatcherConfig
 }
 
 // NewAPIServer creates an APIServer.
@@ -96,6 +96,9 @@ func (s *source) Iterate(ctx context.Context, cb func(*pfs.FileInfo, fileset.Fil
 		}
 		if fileset.IsDir(idx.Path) {
 			fi.FileType = pfs.FileType_DIR
+		} else if md := idx.File.GetMetadata(); md != nil {
+			fi.ContentType = md.ContentType
+			fi.Metadata = md.Attributes
 		}
 		cachedFi, ok, err := s.checkFileInfoCache(ctx, cache, f)
 		if err != nil {
@@ -0,0 +1,262 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/log"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/chunk"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+)
+
+// inspectStorage returns the storage statistics last computed by updateStorageStats.  At most
+// 'limit' repos and pairs of repos are returned, unless 'limit' is zero.
+func (d *driver) inspectStorage(ctx context.Context, limit int64) (*pfs.InspectStorageResponse, error) {
+	resp, err := pfsdb.GetStorageStats(ctx, d.env.DB)
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil {
+		return nil, status.Error(codes.Unavailable, "storage statistics have not been computed yet")
+	}
+	limitStorageStats(resp, limit)
+	return resp, nil
+}
+
+// updateStorageStats recomputes the storage statistics every 'period' until ctx is done.  Walking
+// every commit is expensive, so requests read the statistics stored by the last walk instead.
+func (d *driver) updateStorageStats(ctx context.Context, period time.Duration) error {
+	ticker := time.NewTicker(period)
+	defer ticker.Stop()
+	for {
+		if err := d.computeStorageStats(ctx); err != nil {
+			log.Error(ctx, "error computing storage stats", zap.Error(err))
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return errors.EnsureStack(context.Cause(ctx))
+		}
+	}
+}
+
+// computeStorageStats computes and stores the storage used by each repo and project.  The
+// storage used by a repo is the set of chunks that the tracker can reach from the total file
+// sets of its finished commits; open commits are not counted.
+func (d *driver) computeStorageStats(ctx context.Context) error {
+	start := time.Now()
+	sizes := make(map[string]int64)
+	if err := d.storage.Chunks.IterateSizes(ctx, func(id chunk.ID, sizeBytes int64) error {
+		sizes[id.TrackerID()] = sizeBytes
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "list chunk sizes")
+	}
+	a := newStorageAnalysis(sizes)
+	if err := pfsdb.ForEachCommit(ctx, d.env.DB, nil, func(commitWithID pfsdb.CommitWithID) error {
+		chunks, err := d.commitChunks(ctx, commitWithID.CommitInfo)
+		if err != nil {
+			return err
+		}
+		if chunks != nil {
+			a.addCommit(commitWithID.CommitInfo.Commit.Repo, chunks)
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "walk commits")
+	}
+	resp := a.response()
+	resp.ComputedAt = timestamppb.New(start)
+	if err := dbutil.WithTx(ctx, d.env.DB, func(ctx context.Context, tx *pachsql.Tx) error {
+		return pfsdb.PutStorageStats(ctx, tx, resp)
+	}); err != nil {
+		return errors.Wrap(err, "store storage stats")
+	}
+	log.Info(ctx, "computed storage stats", zap.Int("repos", len(resp.Repos)), zap.Duration("duration", time.Since(start)))
+	return nil
+}
+
+// commitChunks returns the tracker IDs of the chunks that the tracker can reach from the total
+// file set of a finished commit.  It returns nil if the commit is not finished.
+func (d *driver) commitChunks(ctx context.Context, info *pfs.CommitInfo) ([]string, error) {
+	commit := info.Commit
+	if info.Finished == nil {
+		return nil, nil
+	}
+	var total *fileset.ID
+	if err := dbutil.WithTx(ctx, d.env.DB, func(ctx context.Context, tx *pachsql.Tx) error {
+		var err error
+		total, err = getTotal(tx, commit)
+		return err
+	}); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			// The commit is still being finished.
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "get total file set of commit %q", commit)
+	}
+	chunks := []string{}
+	if err := d.storage.Tracker.IterateReachable(ctx, total.TrackerID(), chunk.TrackerPrefix, func(id string) error {
+		chunks = append(chunks, id)
+		return nil
+	}); err != nil {
+		return nil, errors.Wrapf(err, "list chunks of commit %q", commit)
+	}
+	return chunks, nil
+}
+
+// storageAnalysis accumulates the chunks referenced by the commits of each repo.
+type storageAnalysis struct {
+	sizes map[string]int64 // The size of each chunk, by tracker ID.
+	repos map[string]*repoChunks
+}
+
+// repoChunks is the set of chunks referenced by the commits of a repo.
+type repoChunks struct {
+	repo         *pfs.Repo
+	logicalBytes int64
+	chunks       map[string]struct{}
+}
+
+func newStorageAnalysis(sizes map[string]int64) *storageAnalysis {
+	return &storageAnalysis{
+		sizes: sizes,
+		repos: make(map[string]*repoChunks),
+	}
+}
+
+// addCommit records that a commit to 'repo' references 'chunks', given by tracker ID.
+func (a *storageAnalysis) addCommit(repo *pfs.Repo, chunks []string) {
+	rc, ok := a.repos[repo.Key()]
+	if !ok {
+		rc = &repoChunks{repo: repo, chunks: make(map[string]struct{})}
+		a.repos[repo.Key()] = rc
+	}
+	for _, id := range chunks {
+		rc.logicalBytes += a.sizes[id]
+		rc.chunks[id] = struct{}{}
+	}
+}
+
+func (a *storageAnalysis) response() *pfs.InspectStorageResponse {
+	repos := make([]*repoChunks, 0, len(a.repos))
+	for _, rc := range a.repos {
+		repos = append(repos, rc)
+	}
+	sort.Slice(repos, func(i, j int) bool { return repos[i].repo.Key() < repos[j].repo.Key() })
+	// owners lists the repos that reference each chunk, in the order of 'repos'.
+	owners := make(map[string][]int)
+	for i, rc := range repos {
+		for id := range rc.chunks {
+			owners[id] = append(owners[id], i)
+		}
+	}
+
+	total := &pfs.StorageStats{}
+	repoStats := make([]*pfs.StorageStats, len(repos))
+	projectStats := make(map[string]*pfs.StorageStats)
+	for i, rc := range repos {
+		repoStats[i] = &pfs.StorageStats{LogicalBytes: rc.logicalBytes}
+		project := rc.repo.GetProject().GetName()
+		if projectStats[project] == nil {
+			projectStats[project] = &pfs.StorageStats{}
+		}
+		projectStats[project].LogicalBytes += rc.logicalBytes
+		total.LogicalBytes += rc.logicalBytes
+	}
+	type repoPair struct{ a, b int }
+	shared := make(map[repoPair]int64)
+	for id, rs := range owners {
+		size := a.sizes[id]
+		total.PhysicalBytes += size
+		total.UniqueBytes += size
+		total.ChunkCount++
+		projects := make(map[string]bool)
+		for _, r := range rs {
+			addChunk(repoStats[r], size, len(rs) == 1)
+			projects[repos[r].repo.GetProject().GetName()] = true
+		}
+		for project := range projects {
+			addChunk(projectStats[project], size, len(projects) == 1)
+		}
+		for i := range rs {
+			for j := i + 1; j < len(rs); j++ {
+				shared[repoPair{rs[i], rs[j]}] += size
+			}
+		}
+	}
+
+	resp := &pfs.InspectStorageResponse{Total: total}
+	for project, stats := range projectStats {
+		resp.Projects = append(resp.Projects, &pfs.ProjectStorageStats{
+			Project: &pfs.Project{Name: project},
+			Stats:   stats,
+		})
+	}
+	sort.Slice(resp.Projects, func(i, j int) bool { return resp.Projects[i].Project.Name < resp.Projects[j].Project.Name })
+	for i, rc := range repos {
+		resp.Repos = append(resp.Repos, &pfs.RepoStorageStats{Repo: rc.repo, Stats: repoStats[i]})
+	}
+	// The repos are already sorted by key, so ties stay in that order.
+	sort.SliceStable(resp.Repos, func(i, j int) bool {
+		return resp.Repos[i].Stats.UniqueBytes > resp.Repos[j].Stats.UniqueBytes
+	})
+	for p, bytes := range shared {
+		union := repoStats[p.a].PhysicalBytes + repoStats[p.b].PhysicalBytes - bytes
+		s := &pfs.SharedStorageStats{
+			RepoA:       repos[p.a].repo,
+			RepoB:       repos[p.b].repo,
+			SharedBytes: bytes,
+		}
+		if union > 0 {
+			s.SharedRatio = float64(bytes) / float64(union)
+		}
+		resp.Shared = append(resp.Shared, s)
+	}
+	sort.Slice(resp.Shared, func(i, j int) bool {
+		a, b := resp.Shared[i], resp.Shared[j]
+		if a.SharedBytes != b.SharedBytes {
+			return a.SharedBytes > b.SharedBytes
+		}
+		if a.RepoA.Key() != b.RepoA.Key() {
+			return a.RepoA.Key() < b.RepoA.Key()
+		}
+		return a.RepoB.Key() < b.RepoB.Key()
+	})
+	return resp
+}
+
+// limitStorageStats keeps at most 'limit' repos and pairs of repos in resp, unless 'limit' is
+// zero.
+func limitStorageStats(resp *pfs.InspectStorageResponse, limit int64) {
+	if limit <= 0 {
+		return
+	}
+	if int64(len(resp.Repos)) > limit {
+		resp.Repos = resp.Repos[:limit]
+	}
+	if int64(len(resp.Shared)) > limit {
+		resp.Shared = resp.Shared[:limit]
+	}
+}
+
+// addChunk adds a chunk of 'size' bytes to 'stats'.
+func addChunk(stats *pfs.StorageStats, size int64, unique bool) {
+	stats.PhysicalBytes += size
+	stats.ChunkCount++
+	if unique {
+		stats.UniqueBytes += size
+	}
+}
@@ -0,0 +1,59 @@
+//go:build unit_test
+
+package server
+
+import (
+	"testing"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+)
+
+func TestStorageAnalysis(t *testing.T) {
+	sizes := map[string]int64{"chunk/a": 10, "chunk/b": 20, "chunk/c": 40, "chunk/d": 80}
+	images := &pfs.Repo{Project: &pfs.Project{Name: "default"}, Name: "images", Type: pfs.UserRepoType}
+	edges := &pfs.Repo{Project: &pfs.Project{Name: "default"}, Name: "edges", Type: pfs.UserRepoType}
+	raw := &pfs.Repo{Project: &pfs.Project{Name: "other"}, Name: "raw", Type: pfs.UserRepoType}
+	a := newStorageAnalysis(sizes)
+	// Two commits to images, the second of which shares a chunk with the first.
+	a.addCommit(images, []string{"chunk/a"})
+	a.addCommit(images, []string{"chunk/a", "chunk/b"})
+	a.addCommit(edges, []string{"chunk/b", "chunk/c"})
+	a.addCommit(raw, []string{"chunk/a", "chunk/d"})
+
+	resp := a.response()
+	require.Equal(t, int64(150), resp.Total.PhysicalBytes)
+	require.Equal(t, int64(10+30+60+90), resp.Total.LogicalBytes)
+	require.Equal(t, int64(4), resp.Total.ChunkCount)
+
+	require.Equal(t, 3, len(resp.Repos))
+	require.Equal(t, raw.Key(), resp.Repos[0].Repo.Key())
+	require.Equal(t, int64(80), resp.Repos[0].Stats.UniqueBytes)
+	require.Equal(t, edges.Key(), resp.Repos[1].Repo.Key())
+	require.Equal(t, int64(40), resp.Repos[1].Stats.UniqueBytes)
+	require.Equal(t, images.Key(), resp.Repos[2].Repo.Key())
+	require.Equal(t, int64(0), resp.Repos[2].Stats.UniqueBytes)
+	require.Equal(t, int64(30), resp.Repos[2].Stats.PhysicalBytes)
+	require.Equal(t, int64(40), resp.Repos[2].Stats.LogicalBytes)
+
+	require.Equal(t, 2, len(resp.Projects))
+	require.Equal(t, "default", resp.Projects[0].Project.Name)
+	require.Equal(t, int64(70), resp.Projects[0].Stats.PhysicalBytes)
+	require.Equal(t, int64(60), resp.Projects[0].Stats.UniqueBytes)
+	require.Equal(t, "other", resp.Projects[1].Project.Name)
+	require.Equal(t, int64(80), resp.Projects[1].Stats.UniqueBytes)
+
+	require.Equal(t, 2, len(resp.Shared))
+	require.Equal(t, edges.Key(), resp.Shared[0].RepoA.Key())
+	require.Equal(t, images.Key(), resp.Shared[0].RepoB.Key())
+	require.Equal(t, int64(20), resp.Shared[0].SharedBytes)
+	require.Equal(t, 20.0/70.0, resp.Shared[0].SharedRatio)
+	require.Equal(t, images.Key(), resp.Shared[1].RepoA.Key())
+	require.Equal(t, raw.Key(), resp.Shared[1].RepoB.Key())
+	require.Equal(t, int64(10), resp.Shared[1].SharedBytes)
+
+	limited := a.response()
+	limitStorageStats(limited, 1)
+	require.Equal(t, 1, len(limited.Repos))
+	require.Equal(t, 1, len(limited.Shared))
+}
@@ -0,0 +1,107 @@
+package server
+
+import (
+	"context"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ancestry"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
+	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+)
+
+// validateTag checks that 'tag' is well-formed.  Tag names share a namespace
+// with branch names in commit references, so they follow the same rules, and
+// must not be confusable with commit IDs.
+func validateTag(tag *pfs.Tag) error {
+	if tag.GetRepo() == nil {
+		return errors.New("tag repo cannot be nil")
+	}
+	if err := ancestry.ValidateName(tag.Name); err != nil {
+		return err
+	}
+	if uuid.IsUUIDWithoutDashes(tag.Name) {
+		return errors.Errorf("tag name %q cannot be a commit ID", tag.Name)
+	}
+	return nil
+}
+
+func (d *driver) createTag(ctx context.Context, tag *pfs.Tag, commit *pfs.Commit) error {
+	if err := validateTag(tag); err != nil {
+		return err
+	}
+	return d.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		if err := d.env.Auth.CheckRepoIsAuthorizedInTransaction(txnCtx, tag.Repo, auth.Permission_REPO_CREATE_TAG); err != nil {
+			return errors.Wrapf(err, "could not create tag %q", tag)
+		}
+		if err := checkAuditRepoWrite(ctx, tag.Repo); err != nil {
+			return err
+		}
+		// Branches take precedence over tags when resolving a commit
+		// reference, so a tag with the name of a branch could never be used.
+		if _, err := pfsdb.GetBranchInfoWithID(ctx, txnCtx.SqlTx, tag.Repo.NewBranch(tag.Name)); err == nil {
+			return errors.Errorf("cannot create tag %q: a branch with that name already exists", tag)
+		} else if !pfsdb.IsNotFoundError(err) {
+			return errors.Wrapf(err, "create tag %q", tag)
+		}
+		if commit.GetRepo() == nil {
+			commit.Repo = tag.Repo
+		}
+		// Pin the tag to a concrete commit, so that it doesn't move with the
+		// branch (or tag) it was created from.
+		commitWithID, err := d.resolveCommitWithID(ctx, txnCtx.SqlTx, commit)
+		if err != nil {
+			return err
+		}
+		return errors.EnsureStack(pfsdb.CreateTag(ctx, txnCtx.SqlTx, tag, commitWithID.CommitInfo.Commit))
+	})
+}
+
+func (d *driver) inspectTag(ctx context.Context, tag *pfs.Tag) (*pfs.TagInfo, error) {
+	var tagInfo *pfs.TagInfo
+	if err := d.txnEnv.WithReadContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		if err := d.env.Auth.CheckRepoIsAuthorizedInTransaction(txnCtx, tag.GetRepo(), auth.Permission_REPO_LIST_TAG); err != nil {
+			return errors.Wrapf(err, "could not inspect tag %q", tag)
+		}
+		var err error
+		tagInfo, err = pfsdb.GetTag(ctx, txnCtx.SqlTx, tag)
+		return errors.EnsureStack(err)
+	}); err != nil {
+		return nil, err
+	}
+	return tagInfo, nil
+}
+
+func (d *driver) listTag(ctx context.Context, repo *pfs.Repo, cb func(*pfs.TagInfo) error) error {
+	var tagInfos []*pfs.TagInfo
+	if err := d.txnEnv.WithReadContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		if err := d.env.Auth.CheckRepoIsAuthorizedInTransaction(txnCtx, repo, auth.Permission_REPO_LIST_TAG); err != nil {
+			return errors.Wrapf(err, "could not list tags of repo %q", repo)
+		}
+		var err error
+		tagInfos, err = pfsdb.ListTags(ctx, txnCtx.SqlTx, repo)
+		return errors.EnsureStack(err)
+	}); err != nil {
+		return err
+	}
+	for _, tagInfo := range tagInfos {
+		if err := cb(tagInfo); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *driver) deleteTag(ctx context.Context, tag *pfs.Tag) error {
+	return d.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		if err := d.env.Auth.CheckRepoIsAuthorizedInTransaction(txnCtx, tag.GetRepo(), auth.Permission_REPO_DELETE_TAG); err != nil {
+			return errors.Wrapf(err, "could not delete tag %q", tag)
+		}
+		if err := checkAuditRepoWrite(ctx, tag.GetRepo()); err != nil {
+			return err
+		}
+		return errors.EnsureStack(pfsdb.DeleteTag(ctx, txnCtx.SqlTx, tag))
+	})
+}
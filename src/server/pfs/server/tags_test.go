@@ -0,0 +1,20 @@
+//go:build unit_test
+
+package server
+
+import (
+	"testing"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+)
+
+func TestValidateTag(t *testing.T) {
+	repo := &pfs.Repo{Name: "repo", Type: pfs.UserRepoType, Project: &pfs.Project{Name: pfs.DefaultProjectName}}
+	require.NoError(t, validateTag(repo.NewTag("v1_0")))
+	require.YesError(t, validateTag(&pfs.Tag{Name: "v1"}))
+	require.YesError(t, validateTag(repo.NewTag("")))
+	require.YesError(t, validateTag(repo.NewTag("v1^")))
+	require.YesError(t, validateTag(repo.NewTag(uuid.NewWithoutDashes())))
+}
@@ -0,0 +1,163 @@
+//go:build unit_test
+
+package testing
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dockertestenv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pctx"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/internal/testpachd/realenv"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/server/worker/common"
+	"github.com/pachyderm/pachyderm/v2/src/server/worker/datum"
+)
+
+// TestPurgePath purges an input file that a downstream pipeline read.  There
+// are no workers here, so the pipeline's output and meta commits are written
+// by hand, one datum per input file.
+func TestPurgePath(t *testing.T) {
+	ctx := pctx.TestContext(t)
+	env := realenv.NewRealEnv(ctx, t, dockertestenv.NewTestDBConfig(t).PachConfigOption)
+	c := env.PachClient
+	project := pfs.DefaultProjectName
+
+	inRepo := client.NewRepo(project, "in")
+	outRepo := client.NewRepo(project, "out")
+	metaRepo := client.NewSystemRepo(project, "out", pfs.MetaRepoType)
+	require.NoError(t, c.CreateRepo(project, "in"))
+	require.NoError(t, c.CreateRepo(project, "out"))
+	_, err := c.PfsAPIClient.CreateRepo(c.Ctx(), &pfs.CreateRepoRequest{Repo: metaRepo})
+	require.NoError(t, err)
+	for _, repo := range []*pfs.Repo{outRepo, metaRepo} {
+		_, err := c.PfsAPIClient.CreateBranch(c.Ctx(), &pfs.CreateBranchRequest{
+			Branch:     repo.NewBranch("master"),
+			Provenance: []*pfs.Branch{inRepo.NewBranch("master")},
+		})
+		require.NoError(t, err)
+	}
+
+	finishAll := func(id string) {
+		for _, repo := range []*pfs.Repo{inRepo, outRepo, metaRepo} {
+			commit := repo.NewCommit("master", id)
+			_, err := c.PfsAPIClient.FinishCommit(c.Ctx(), &pfs.FinishCommitRequest{Commit: commit})
+			if err != nil && !pfsserver.IsCommitFinishedErr(err) {
+				require.NoError(t, err)
+			}
+			_, err = c.PfsAPIClient.InspectCommit(c.Ctx(), &pfs.InspectCommitRequest{Commit: commit, Wait: pfs.CommitState_FINISHED})
+			require.NoError(t, err)
+		}
+	}
+
+	// The first commit adds the input files, and the pipeline's output for
+	// each.  The second only adds an input file, so its output and meta
+	// commits inherit everything.
+	inCommit1, err := c.StartCommit(project, "in", "master")
+	require.NoError(t, err)
+	require.NoError(t, c.PutFile(inCommit1, "/a", strings.NewReader("secret")))
+	require.NoError(t, c.PutFile(inCommit1, "/b", strings.NewReader("public")))
+	require.NoError(t, finishCommit(c, "in", "master", inCommit1.Id))
+	datumIDs := make(map[string]string)
+	for _, name := range []string{"a", "b"} {
+		inputs := []*common.Input{{
+			Name:     "in",
+			FileInfo: &pfs.FileInfo{File: inCommit1.NewFile("/" + name)},
+		}}
+		id := common.DatumID(inputs)
+		datumIDs[name] = id
+		meta, err := protojson.Marshal(&datum.Meta{Inputs: inputs})
+		require.NoError(t, err)
+		require.NoError(t, c.PutFile(metaRepo.NewCommit("master", inCommit1.Id), common.MetaFilePath(id), bytes.NewReader(meta), client.WithDatumPutFile(id)))
+		require.NoError(t, c.PutFile(outRepo.NewCommit("master", inCommit1.Id), "/"+name+".out", strings.NewReader(name+" output"), client.WithDatumPutFile(id)))
+	}
+	finishAll(inCommit1.Id)
+	inCommit2, err := c.StartCommit(project, "in", "master")
+	require.NoError(t, err)
+	require.NoError(t, c.PutFile(inCommit2, "/c", strings.NewReader("later")))
+	finishAll(inCommit2.Id)
+
+	// A datum of a pipeline with cache_datum_output set cached its output.
+	cacheKey := "datum-output/" + project + "/purged"
+	resp, err := c.WithCreateFileSetClient(func(mf client.ModifyFile) error {
+		return mf.PutFile("/a.out", strings.NewReader("a output"))
+	})
+	require.NoError(t, err)
+	value, err := anypb.New(&pfs.File{Path: "/a.out"})
+	require.NoError(t, err)
+	_, err = c.PfsAPIClient.PutCache(c.Ctx(), &pfs.PutCacheRequest{
+		Key:        cacheKey,
+		Value:      value,
+		FileSetIds: []string{resp.FileSetId},
+		Tag:        common.DatumOutputCacheTag(project, datumIDs["a"]),
+	})
+	require.NoError(t, err)
+
+	purgeResp, err := c.PfsAPIClient.PurgePath(c.Ctx(), &pfs.PurgePathRequest{Repo: inRepo, Path: "/a"})
+	require.NoError(t, err)
+
+	// Every commit that held a purged file, directly or inherited from its
+	// parent, was rewritten.
+	rewritten := make(map[string]bool)
+	for _, commit := range purgeResp.Commits {
+		rewritten[pfsdb.CommitKey(commit)] = true
+	}
+	for _, repo := range []*pfs.Repo{inRepo, outRepo, metaRepo} {
+		for _, id := range []string{inCommit1.Id, inCommit2.Id} {
+			commit := repo.NewCommit("master", id)
+			ci, err := c.PfsAPIClient.InspectCommit(c.Ctx(), &pfs.InspectCommitRequest{Commit: commit})
+			require.NoError(t, err)
+			require.True(t, rewritten[pfsdb.CommitKey(ci.Commit)], "commit %v was not rewritten", ci.Commit)
+		}
+	}
+
+	// The purged datum is reported, and its output and meta files are gone
+	// from every commit, while the other datum's remain.
+	require.Equal(t, 1, len(purgeResp.Datums))
+	require.Equal(t, outRepo.Key(), purgeResp.Datums[0].Repo.Key())
+	require.Equal(t, []string{datumIDs["a"]}, purgeResp.Datums[0].Datums)
+	for _, id := range []string{inCommit1.Id, inCommit2.Id} {
+		paths := func(commit *pfs.Commit) []string {
+			var result []string
+			require.NoError(t, c.GlobFile(commit, "**", func(fi *pfs.FileInfo) error {
+				if fi.FileType == pfs.FileType_FILE {
+					result = append(result, fi.File.Path)
+				}
+				return nil
+			}))
+			return result
+		}
+		inPaths := []string{"/b"}
+		if id == inCommit2.Id {
+			inPaths = append(inPaths, "/c")
+		}
+		require.Equal(t, inPaths, paths(inRepo.NewCommit("master", id)))
+		require.Equal(t, []string{"/b.out"}, paths(outRepo.NewCommit("master", id)))
+		require.Equal(t, []string{"/" + common.MetaFilePath(datumIDs["b"])}, paths(metaRepo.NewCommit("master", id)))
+		buf := &bytes.Buffer{}
+		require.NoError(t, c.GetFile(inRepo.NewCommit("master", id), "/b", buf))
+		require.Equal(t, "public", buf.String())
+	}
+
+	// The purged datum's cached output was cleared.
+	_, err = c.PfsAPIClient.GetCache(c.Ctx(), &pfs.GetCacheRequest{Key: cacheKey})
+	require.YesError(t, err)
+
+	// The chunks that held the purged data are no longer referenced.
+	require.True(t, len(purgeResp.UnreferencedChunks) > 0)
+
+	// Purging again finds nothing left to purge.
+	purgeResp, err = c.PfsAPIClient.PurgePath(c.Ctx(), &pfs.PurgePathRequest{Repo: inRepo, Path: "/a"})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(purgeResp.Commits))
+	require.Equal(t, 0, len(purgeResp.Datums))
+	require.Equal(t, 0, len(purgeResp.UnreferencedChunks))
+}
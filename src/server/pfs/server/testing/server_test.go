@@ -7706,6 +7706,96 @@ func TestEgressToPostgres(_suite *testing.T) {
 	}
 }
 
+func TestEgressToPostgresUpsert(t *testing.T) {
+	os.Setenv("PACHYDERM_SQL_PASSWORD", dockertestenv.DefaultPostgresPassword)
+
+	type Schema struct {
+		Id    int    `column:"ID" dtype:"INT" constraint:"PRIMARY KEY"`
+		A     string `column:"A" dtype:"VARCHAR(100)"`
+		Datum string `column:"DATUM" dtype:"INT"`
+	}
+
+	ctx := pctx.TestContext(t)
+	env := realenv.NewRealEnv(ctx, t, dockertestenv.NewTestDBConfig(t).PachConfigOption)
+	dbName := tu.GenerateEphemeralDBName(t)
+	tu.CreateEphemeralDB(t, sqlx.NewDb(env.ServiceEnv.GetDBClient().DB, "postgres"), dbName)
+	db := tu.OpenDB(t,
+		dbutil.WithMaxOpenConns(1),
+		dbutil.WithUserPassword(dockertestenv.DefaultPostgresUser, dockertestenv.DefaultPostgresPassword),
+		dbutil.WithHostPort(dockertestenv.PGBouncerHost(), dockertestenv.PGBouncerPort),
+		dbutil.WithDBName(dbName),
+	)
+	require.NoError(t, pachsql.CreateTestTable(db, "test_table", Schema{}))
+	require.NoError(t, env.PachClient.CreateRepo(pfs.DefaultProjectName, dbName))
+
+	egressMode := func(commit *pfs.Commit, mode pfs.SQLDatabaseEgress_Mode) *pfs.EgressResponse_SQLDatabaseResult {
+		resp, err := env.PachClient.Egress(env.PachClient.Ctx(),
+			&pfs.EgressRequest{
+				Commit: commit,
+				Target: &pfs.EgressRequest_SqlDatabase{
+					SqlDatabase: &pfs.SQLDatabaseEgress{
+						Url:         fmt.Sprintf("postgres://%s@%s:%d/%s", dockertestenv.DefaultPostgresUser, dockertestenv.PGBouncerHost(), dockertestenv.PGBouncerPort, dbName),
+						FileFormat:  &pfs.SQLDatabaseEgress_FileFormat{Type: pfs.SQLDatabaseEgress_FileFormat_CSV},
+						Secret:      &pfs.SQLDatabaseEgress_Secret{Name: "does not matter", Key: "does not matter"},
+						Mode:        mode,
+						PrimaryKeys: map[string]*pfs.SQLDatabaseEgress_PrimaryKey{"test_table": {Columns: []string{"ID"}}},
+					},
+				},
+			})
+		require.NoError(t, err)
+		return resp.GetSqlDatabase()
+	}
+	egress := func(commit *pfs.Commit) *pfs.EgressResponse_SQLDatabaseResult {
+		return egressMode(commit, pfs.SQLDatabaseEgress_UPSERT)
+	}
+
+	// the first egress has no base commit, so every row is inserted
+	commit1, err := env.PachClient.StartCommit(pfs.DefaultProjectName, dbName, "master")
+	require.NoError(t, err)
+	require.NoError(t, env.PachClient.PutFile(commit1, "/test_table/0000", strings.NewReader("1,Foo,101\n2,Bar,102")))
+	require.NoError(t, env.PachClient.PutFile(commit1, "/test_table/0001", strings.NewReader("3,Hello,103\n4,World,104")))
+	require.NoError(t, env.PachClient.FinishCommit(pfs.DefaultProjectName, dbName, "master", commit1.Id))
+	res := egress(commit1)
+	require.Equal(t, map[string]int64{"test_table": 4}, res.RowsInserted)
+	require.Nil(t, res.BaseCommit)
+
+	// the second egress only applies the rows which changed since the first
+	commit2, err := env.PachClient.StartCommit(pfs.DefaultProjectName, dbName, "master")
+	require.NoError(t, err)
+	require.NoError(t, env.PachClient.PutFile(commit2, "/test_table/0000", strings.NewReader("1,Foo,101\n2,Baz,102\n5,New,105")))
+	require.NoError(t, env.PachClient.DeleteFile(commit2, "/test_table/0001"))
+	require.NoError(t, env.PachClient.FinishCommit(pfs.DefaultProjectName, dbName, "master", commit2.Id))
+	res = egress(commit2)
+	require.Equal(t, commit1.Id, res.BaseCommit.GetId())
+	require.Equal(t, int64(1), res.RowsInserted["test_table"])
+	require.Equal(t, int64(1), res.RowsUpdated["test_table"])
+	require.Equal(t, int64(2), res.RowsDeleted["test_table"])
+
+	var count int64
+	require.NoError(t, db.QueryRow("select count(*) from test_table").Scan(&count))
+	require.Equal(t, int64(3), count)
+	var a string
+	require.NoError(t, db.QueryRow("select a from test_table where id = 2").Scan(&a))
+	require.Equal(t, "Baz", a)
+
+	// egressing the same commit again is a no-op
+	res = egress(commit2)
+	require.Equal(t, 0, len(res.RowsInserted)+len(res.RowsUpdated)+len(res.RowsDeleted))
+
+	// a REPLACE egress becomes the base of the next UPSERT egress
+	commit3, err := env.PachClient.StartCommit(pfs.DefaultProjectName, dbName, "master")
+	require.NoError(t, err)
+	require.NoError(t, env.PachClient.PutFile(commit3, "/test_table/0000", strings.NewReader("1,Foo,101\n6,Six,106")))
+	require.NoError(t, env.PachClient.FinishCommit(pfs.DefaultProjectName, dbName, "master", commit3.Id))
+	res = egressMode(commit3, pfs.SQLDatabaseEgress_REPLACE)
+	require.Equal(t, map[string]int64{"test_table": 2}, res.RowsWritten)
+	res = egress(commit3)
+	require.Equal(t, commit3.Id, res.BaseCommit.GetId())
+	require.Equal(t, 0, len(res.RowsInserted)+len(res.RowsUpdated)+len(res.RowsDeleted))
+	require.NoError(t, db.QueryRow("select count(*) from test_table").Scan(&count))
+	require.Equal(t, int64(2), count)
+}
+
 var (
 	randSeed = int64(0)
 	randMu   sync.Mutex
@@ -0,0 +1,62 @@
+//go:build unit_test
+
+package testing
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dockertestenv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pctx"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/internal/testpachd/realenv"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+)
+
+// TestTagBranchNames checks that tags and branches can't share a name, since
+// a branch would hide a tag of the same name when resolving a commit.
+func TestTagBranchNames(t *testing.T) {
+	ctx := pctx.TestContext(t)
+	env := realenv.NewRealEnv(ctx, t, dockertestenv.NewTestDBConfig(t).PachConfigOption)
+	c := env.PachClient
+	project := pfs.DefaultProjectName
+
+	repo := client.NewRepo(project, "data")
+	require.NoError(t, c.CreateRepo(project, "data"))
+	commit, err := c.StartCommit(project, "data", "master")
+	require.NoError(t, err)
+	require.NoError(t, c.PutFile(commit, "/a", strings.NewReader("a")))
+	require.NoError(t, finishCommit(c, "data", "master", commit.Id))
+
+	_, err = c.PfsAPIClient.CreateTag(c.Ctx(), &pfs.CreateTagRequest{
+		Tag:    &pfs.Tag{Repo: repo, Name: "v1"},
+		Commit: repo.NewCommit("master", ""),
+	})
+	require.NoError(t, err)
+
+	// A branch can't be created with the name of a tag.
+	_, err = c.PfsAPIClient.CreateBranch(c.Ctx(), &pfs.CreateBranchRequest{
+		Branch: repo.NewBranch("v1"),
+		Head:   commit,
+	})
+	require.YesError(t, err)
+	require.Matches(t, "a tag with that name already exists", err.Error())
+
+	// Nor can a tag be created with the name of a branch.
+	_, err = c.PfsAPIClient.CreateTag(c.Ctx(), &pfs.CreateTagRequest{
+		Tag:    &pfs.Tag{Repo: repo, Name: "master"},
+		Commit: commit,
+	})
+	require.YesError(t, err)
+	require.Matches(t, "a branch with that name already exists", err.Error())
+
+	// Once the tag is deleted, its name is free for a branch.
+	_, err = c.PfsAPIClient.DeleteTag(c.Ctx(), &pfs.DeleteTagRequest{Tag: &pfs.Tag{Repo: repo, Name: "v1"}})
+	require.NoError(t, err)
+	_, err = c.PfsAPIClient.CreateBranch(c.Ctx(), &pfs.CreateBranchRequest{
+		Branch: repo.NewBranch("v1"),
+		Head:   commit,
+	})
+	require.NoError(t, err)
+}
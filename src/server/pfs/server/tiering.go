@@ -0,0 +1,71 @@
+package server
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/chunk"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+)
+
+// tieringPolicy returns the policy that decides which chunks are copied to the hot tier.  The
+// chunks of the configured hot tier repos are pinned there.
+func (d *driver) tieringPolicy() chunk.TieringPolicy {
+	conf := d.env.StorageConfig
+	policy := chunk.TieringPolicy{
+		PromoteAccesses: conf.StorageTierPromoteAccesses,
+		DemoteAfter:     time.Duration(conf.StorageTierDemoteAfter) * time.Second,
+		MaxHotBytes:     conf.StorageHotTierMaxBytes,
+	}
+	if repos := parseHotTierRepos(conf.StorageHotTierRepos); len(repos) > 0 {
+		policy.Pinned = func(ctx context.Context) (map[string]struct{}, error) {
+			return d.reposChunks(ctx, repos)
+		}
+	}
+	return policy
+}
+
+// parseHotTierRepos parses a comma-separated list of repos, each given as project/repo or as
+// repo in the default project.
+func parseHotTierRepos(s string) []*pfs.Repo {
+	var repos []*pfs.Repo
+	for _, name := range strings.Split(s, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		project, repo, ok := strings.Cut(name, "/")
+		if !ok {
+			project, repo = pfs.DefaultProjectName, name
+		}
+		repos = append(repos, &pfs.Repo{
+			Project: &pfs.Project{Name: project},
+			Name:    repo,
+			Type:    pfs.UserRepoType,
+		})
+	}
+	return repos
+}
+
+// reposChunks returns the tracker IDs of the chunks referenced by the finished commits of repos.
+func (d *driver) reposChunks(ctx context.Context, repos []*pfs.Repo) (map[string]struct{}, error) {
+	chunks := make(map[string]struct{})
+	for _, repo := range repos {
+		if err := pfsdb.ForEachCommit(ctx, d.env.DB, &pfs.Commit{Repo: repo}, func(commitWithID pfsdb.CommitWithID) error {
+			ids, err := d.commitChunks(ctx, commitWithID.CommitInfo)
+			if err != nil {
+				return err
+			}
+			for _, id := range ids {
+				chunks[id] = struct{}{}
+			}
+			return nil
+		}); err != nil {
+			return nil, errors.Wrapf(err, "list chunks of repo %q", repo)
+		}
+	}
+	return chunks, nil
+}
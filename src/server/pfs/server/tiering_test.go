@@ -0,0 +1,18 @@
+//go:build unit_test
+
+package server
+
+import (
+	"testing"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+)
+
+func TestParseHotTierRepos(t *testing.T) {
+	require.Equal(t, 0, len(parseHotTierRepos("")))
+	repos := parseHotTierRepos("images, other/raw,")
+	require.Equal(t, 2, len(repos))
+	require.Equal(t, pfs.DefaultProjectName+"/images", repos[0].String())
+	require.Equal(t, "other/raw", repos[1].String())
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/obj"
 	"github.com/pachyderm/pachyderm/v2/src/internal/promutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset/index"
 	"github.com/pachyderm/pachyderm/v2/src/internal/task"
 	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
@@ -33,7 +34,7 @@ var (
 	defaultSizeThreshold       = int64(units.GB)
 )
 
-func putFileURL(ctx context.Context, taskService task.Service, uw *fileset.UnorderedWriter, dstPath, tag string, src *pfs.AddFile_URLSource) (n int64, retErr error) {
+func putFileURL(ctx context.Context, taskService task.Service, uw *fileset.UnorderedWriter, dstPath, tag string, md *index.FileMetadata, src *pfs.AddFile_URLSource) (n int64, retErr error) {
 	url, err := url.Parse(src.URL)
 	if err != nil {
 		return 0, errors.EnsureStack(err)
@@ -54,9 +55,12 @@ func putFileURL(ctx context.Context, taskService task.Service, uw *fileset.Unord
 			return 0, errors.Errorf("error retrieving content from %q: %s", src.URL, resp.Status)
 		}
 		defer errors.Close(&retErr, resp.Body, "close http body")
-		return 0, uw.Put(ctx, dstPath, tag, true, resp.Body)
+		return 0, uw.PutWithMetadata(ctx, dstPath, tag, true, md, resp.Body)
 	default:
 		if src.Recursive {
+			if md != nil {
+				return 0, errors.Errorf("cannot set the content type or metadata of files added from a recursive URL")
+			}
 			return 0, putFileURLRecursive(ctx, taskService, uw, dstPath, tag, src)
 		}
 		url, err := obj.ParseURL(src.URL)
@@ -73,7 +77,7 @@ func putFileURL(ctx context.Context, taskService task.Service, uw *fileset.Unord
 			return 0, errors.EnsureStack(err)
 		}
 		defer errors.Close(&retErr, r, "close reader for bucket %v", url.Bucket)
-		return 0, uw.Put(ctx, dstPath, tag, true, r)
+		return 0, uw.PutWithMetadata(ctx, dstPath, tag, true, md, r)
 	}
 }
 
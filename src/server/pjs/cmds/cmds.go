@@ -0,0 +1,368 @@
+package cmds
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/cmdutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachctl"
+	"github.com/pachyderm/pachyderm/v2/src/internal/serde"
+	"github.com/pachyderm/pachyderm/v2/src/internal/tabwriter"
+	"github.com/pachyderm/pachyderm/v2/src/pjs"
+	"github.com/pachyderm/pachyderm/v2/src/server/pjs/pretty"
+)
+
+// Cmds returns the set of commands used for interacting with the PJS (Pachyderm Job System)
+// API with the Pachyderm CLI tool pachctl.
+func Cmds(mainCtx context.Context, pachctlCfg *pachctl.Config) []*cobra.Command {
+	var commands []*cobra.Command
+
+	var raw bool
+	var output string
+	outputFlags := cmdutil.OutputFlags(&raw, &output)
+
+	var jobContext string
+	contextFlag := func(cmd *cobra.Command) {
+		cmd.Flags().StringVar(&jobContext, "context", "", "The job context token to act on behalf of. Only set this from within a running job.")
+	}
+
+	pjsDocs := &cobra.Command{
+		Short: "Interact with the Pachyderm Job System.",
+		Long: "The Pachyderm Job System (PJS) runs trees of jobs. " +
+			"Each job has a spec describing the code to run, and is placed in the queue for its spec until a worker processes it. " +
+			"Jobs created from within a running job (using its context) become children of that job.",
+	}
+	commands = append(commands, cmdutil.CreateDocsAlias(pjsDocs, "pjs", " pjs$"))
+	for _, verb := range []struct{ name, short string }{
+		{"create", "Create a PJS resource."},
+		{"inspect", "Return info about a PJS resource."},
+		{"list", "Return all PJS resources of a kind."},
+		{"walk", "Walk a tree of PJS resources."},
+		{"cancel", "Cancel a PJS resource."},
+		{"delete", "Delete a PJS resource."},
+	} {
+		commands = append(commands, cmdutil.CreateAlias(&cobra.Command{Short: verb.short, Long: verb.short}, "pjs "+verb.name))
+	}
+
+	var file string
+	var cacheRead, cacheWrite bool
+	createJob := &cobra.Command{
+		Short: "Create a new job.",
+		Long: "This command creates a new job from a CreateJobRequest in JSON or YAML format. " +
+			"The spec is a google.protobuf.Any and must use a type known to pachctl.",
+		Example: `
+# Create a job from a request file
+$ {{alias}} -f job.json
+
+# Create a job, reusing the output of an identical job if one exists
+$ {{alias}} -f job.json --cache-read`,
+		Run: cmdutil.RunFixedArgs(0, func([]string) error {
+			req, err := readCreateJobRequest(file)
+			if err != nil {
+				return err
+			}
+			if jobContext != "" {
+				req.Context = jobContext
+			}
+			req.CacheRead = req.CacheRead || cacheRead
+			req.CacheWrite = req.CacheWrite || cacheWrite
+			c, err := pachctlCfg.NewOnUserMachine(mainCtx, false)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			resp, err := c.PJS.CreateJob(c.Ctx(), req)
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			fmt.Println(resp.Id.Id)
+			return nil
+		}),
+	}
+	createJob.Flags().StringVarP(&file, "file", "f", "", "The file containing the CreateJobRequest (use \"-\" to read from stdin).")
+	createJob.Flags().BoolVar(&cacheRead, "cache-read", false, "Reuse the output of a previous job with the same spec and input, if one exists.")
+	createJob.Flags().BoolVar(&cacheWrite, "cache-write", false, "Allow the output of this job to be reused by later jobs.")
+	contextFlag(createJob)
+	commands = append(commands, cmdutil.CreateAlias(createJob, "pjs create job"))
+
+	inspectJob := &cobra.Command{
+		Use:   "{{alias}} [<job>]",
+		Short: "Return info about a job.",
+		Long:  "This command returns detailed info about a job. If no job is provided, the job associated with --context is inspected.",
+		Run: cmdutil.RunBoundedArgs(0, 1, func(args []string) error {
+			job, err := parseOptionalJob(args)
+			if err != nil {
+				return err
+			}
+			c, err := pachctlCfg.NewOnUserMachine(mainCtx, false)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			resp, err := c.PJS.InspectJob(c.Ctx(), &pjs.InspectJobRequest{Context: jobContext, Job: job})
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			if raw {
+				return errors.EnsureStack(cmdutil.Encoder(output, os.Stdout).EncodeProto(resp.Details))
+			} else if output != "" {
+				return errors.New("cannot set --output (-o) without --raw")
+			}
+			writer := tabwriter.NewWriter(os.Stdout, pretty.JobHeader)
+			pretty.PrintJobInfo(writer, resp.Details.JobInfo)
+			return writer.Flush()
+		}),
+	}
+	inspectJob.Flags().AddFlagSet(outputFlags)
+	contextFlag(inspectJob)
+	commands = append(commands, cmdutil.CreateAlias(inspectJob, "pjs inspect job"))
+
+	listJob := &cobra.Command{
+		Use:   "{{alias}} [<parent-job>]",
+		Short: "Return info about the children of a job.",
+		Long: "This command lists the children of a job. " +
+			"If no job is provided, the children of the job associated with --context are listed, or the root jobs if there is no context.",
+		Run: cmdutil.RunBoundedArgs(0, 1, func(args []string) error {
+			job, err := parseOptionalJob(args)
+			if err != nil {
+				return err
+			}
+			c, err := pachctlCfg.NewOnUserMachine(mainCtx, false)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			jobs, err := c.PJS.ListJob(c.Ctx(), &pjs.ListJobRequest{Context: jobContext, Job: job})
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			return printJobs(jobs, raw, output)
+		}),
+	}
+	listJob.Flags().AddFlagSet(outputFlags)
+	contextFlag(listJob)
+	commands = append(commands, cmdutil.CreateAlias(listJob, "pjs list job"))
+
+	walkJob := &cobra.Command{
+		Use:   "{{alias}} [<job>]",
+		Short: "Return info about a job and all of its descendants.",
+		Long:  "This command lists a job and all of its descendants in pre-order. If no job is provided, the job associated with --context is walked.",
+		Run: cmdutil.RunBoundedArgs(0, 1, func(args []string) error {
+			job, err := parseOptionalJob(args)
+			if err != nil {
+				return err
+			}
+			c, err := pachctlCfg.NewOnUserMachine(mainCtx, false)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			jobs, err := c.PJS.WalkJob(c.Ctx(), &pjs.WalkJobRequest{Context: jobContext, Job: job})
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			return printJobs(jobs, raw, output)
+		}),
+	}
+	walkJob.Flags().AddFlagSet(outputFlags)
+	contextFlag(walkJob)
+	commands = append(commands, cmdutil.CreateAlias(walkJob, "pjs walk job"))
+
+	cancelJob := &cobra.Command{
+		Use:   "{{alias}} <job>",
+		Short: "Cancel a job.",
+		Long: "This command cancels a job and all of its queued and processing descendants. " +
+			"A child job can only be canceled with its parent's context.",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			job, err := parseJob(args[0])
+			if err != nil {
+				return err
+			}
+			c, err := pachctlCfg.NewOnUserMachine(mainCtx, false)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			if _, err := c.PJS.CancelJob(c.Ctx(), &pjs.CancelJobRequest{Context: jobContext, Job: job}); err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			return nil
+		}),
+	}
+	contextFlag(cancelJob)
+	commands = append(commands, cmdutil.CreateAlias(cancelJob, "pjs cancel job"))
+
+	deleteJob := &cobra.Command{
+		Use:   "{{alias}} <job>",
+		Short: "Delete a job.",
+		Long: "This command cancels a job, then deletes it, its descendants, and the filesets associated with them. " +
+			"A child job can only be deleted with its parent's context.",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			job, err := parseJob(args[0])
+			if err != nil {
+				return err
+			}
+			c, err := pachctlCfg.NewOnUserMachine(mainCtx, false)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			if _, err := c.PJS.DeleteJob(c.Ctx(), &pjs.DeleteJobRequest{Context: jobContext, Job: job}); err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			return nil
+		}),
+	}
+	contextFlag(deleteJob)
+	commands = append(commands, cmdutil.CreateAlias(deleteJob, "pjs delete job"))
+
+	listQueue := &cobra.Command{
+		Short: "Return info about queues.",
+		Long:  "This command lists queues. There is one queue for each distinct job spec.",
+		Run: cmdutil.RunFixedArgs(0, func([]string) error {
+			c, err := pachctlCfg.NewOnUserMachine(mainCtx, false)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			queues, err := c.PJS.ListQueue(c.Ctx(), &pjs.ListQueueRequest{})
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			if !raw && output != "" {
+				return errors.New("cannot set --output (-o) without --raw")
+			}
+			encoder := cmdutil.Encoder(output, os.Stdout)
+			writer := tabwriter.NewWriter(os.Stdout, pretty.QueueHeader)
+			for {
+				resp, err := queues.Recv()
+				if errors.Is(err, io.EOF) {
+					break
+				} else if err != nil {
+					return grpcutil.ScrubGRPC(err)
+				}
+				if raw {
+					if err := encoder.EncodeProto(resp.Details); err != nil {
+						return errors.EnsureStack(err)
+					}
+					continue
+				}
+				pretty.PrintQueueInfo(writer, resp.Info, resp.Details.GetSize())
+			}
+			if raw {
+				return nil
+			}
+			return writer.Flush()
+		}),
+	}
+	listQueue.Flags().AddFlagSet(outputFlags)
+	commands = append(commands, cmdutil.CreateAlias(listQueue, "pjs list queue"))
+
+	inspectQueue := &cobra.Command{
+		Use:   "{{alias}} <queue>",
+		Short: "Return info about a queue.",
+		Long:  "This command returns detailed info about a queue, identified by the hex-encoded ID printed by `pachctl pjs list queue`.",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			id, err := hex.DecodeString(args[0])
+			if err != nil {
+				return errors.Wrapf(err, "could not parse queue ID %q", args[0])
+			}
+			c, err := pachctlCfg.NewOnUserMachine(mainCtx, false)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			resp, err := c.PJS.InspectQueue(c.Ctx(), &pjs.InspectQueueRequest{Queue: &pjs.Queue{Id: id}})
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			if raw {
+				return errors.EnsureStack(cmdutil.Encoder(output, os.Stdout).EncodeProto(resp.Details))
+			} else if output != "" {
+				return errors.New("cannot set --output (-o) without --raw")
+			}
+			writer := tabwriter.NewWriter(os.Stdout, pretty.QueueHeader)
+			pretty.PrintQueueInfo(writer, resp.Details.QueueInfo, resp.Details.Size)
+			return writer.Flush()
+		}),
+	}
+	inspectQueue.Flags().AddFlagSet(outputFlags)
+	commands = append(commands, cmdutil.CreateAlias(inspectQueue, "pjs inspect queue"))
+
+	return commands
+}
+
+func readCreateJobRequest(file string) (*pjs.CreateJobRequest, error) {
+	var data []byte
+	var err error
+	switch file {
+	case "":
+		return nil, errors.New("must set input file (use \"-\" to read from stdin)")
+	case "-":
+		data, err = io.ReadAll(os.Stdin)
+	default:
+		data, err = os.ReadFile(file)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read job request from %q", file)
+	}
+	req := &pjs.CreateJobRequest{}
+	if err := serde.Decode(data, req); err != nil {
+		return nil, errors.Wrapf(err, "could not parse job request")
+	}
+	return req, nil
+}
+
+func parseJob(arg string) (*pjs.Job, error) {
+	id, err := strconv.ParseInt(arg, 10, 64)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not parse job ID %q", arg)
+	}
+	return &pjs.Job{Id: id}, nil
+}
+
+func parseOptionalJob(args []string) (*pjs.Job, error) {
+	if len(args) == 0 {
+		return nil, nil
+	}
+	return parseJob(args[0])
+}
+
+type jobClient interface {
+	Recv() (*pjs.ListJobResponse, error)
+}
+
+func printJobs(c jobClient, raw bool, output string) error {
+	if !raw && output != "" {
+		return errors.New("cannot set --output (-o) without --raw")
+	}
+	encoder := cmdutil.Encoder(output, os.Stdout)
+	writer := tabwriter.NewWriter(os.Stdout, pretty.JobHeader)
+	for {
+		resp, err := c.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return grpcutil.ScrubGRPC(err)
+		}
+		if raw {
+			if err := encoder.EncodeProto(resp.Info); err != nil {
+				return errors.EnsureStack(err)
+			}
+			continue
+		}
+		pretty.PrintJobInfo(writer, resp.Info)
+	}
+	if raw {
+		return nil
+	}
+	return writer.Flush()
+}
@@ -0,0 +1,50 @@
+package pretty
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io"
+
+	"github.com/pachyderm/pachyderm/v2/src/pjs"
+)
+
+const (
+	// JobHeader is the header for PJS jobs.
+	JobHeader = "ID\tPARENT\tSTATE\tSPEC\tRESULT\t\n"
+	// QueueHeader is the header for PJS queues.
+	QueueHeader = "ID\tSPEC\tSIZE\t\n"
+)
+
+// PrintJobInfo prints a short summary of a job to the provided device.
+func PrintJobInfo(w io.Writer, info *pjs.JobInfo) {
+	fmt.Fprintf(w, "%d\t", info.Job.GetId())
+	if info.ParentJob != nil {
+		fmt.Fprintf(w, "%d\t", info.ParentJob.Id)
+	} else {
+		fmt.Fprintf(w, "-\t")
+	}
+	fmt.Fprintf(w, "%s\t", info.State)
+	fmt.Fprintf(w, "%s\t", info.Spec.GetTypeUrl())
+	switch res := info.Result.(type) {
+	case *pjs.JobInfo_Output:
+		fmt.Fprintf(w, "output (%d filesets)\t", len(res.Output.GetFilesets()))
+	case *pjs.JobInfo_Error:
+		fmt.Fprintf(w, "%s\t", res.Error)
+	default:
+		fmt.Fprintf(w, "-\t")
+	}
+	fmt.Fprintln(w)
+}
+
+// PrintQueueInfo prints a short summary of a queue to the provided device.
+func PrintQueueInfo(w io.Writer, info *pjs.QueueInfo, size int64) {
+	fmt.Fprintf(w, "%s\t", QueueID(info.Queue))
+	fmt.Fprintf(w, "%s\t", info.Spec.GetTypeUrl())
+	fmt.Fprintf(w, "%d\t", size)
+	fmt.Fprintln(w)
+}
+
+// QueueID formats the ID of a queue as it is accepted by pachctl.
+func QueueID(q *pjs.Queue) string {
+	return hex.EncodeToString(q.GetId())
+}
@@ -0,0 +1,556 @@
+// Package server implements the PJS (Pachyderm Job System) API.
+package server
+
+import (
+	"context"
+	"encoding/binary"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/log"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachhash"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pjsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/track"
+	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
+	"github.com/pachyderm/pachyderm/v2/src/pjs"
+)
+
+// DefaultPollInterval is the default value for Env.PollInterval.
+const DefaultPollInterval = time.Second
+
+type apiServer struct {
+	pjs.UnsafeAPIServer
+	env Env
+}
+
+// NewAPIServer creates a new PJS API server.
+func NewAPIServer(env Env) pjs.APIServer {
+	if env.PollInterval == 0 {
+		env.PollInterval = DefaultPollInterval
+	}
+	return &apiServer{env: env}
+}
+
+// CreateJob implements the pjs.CreateJob RPC.
+func (a *apiServer) CreateJob(ctx context.Context, req *pjs.CreateJobRequest) (*pjs.CreateJobResponse, error) {
+	if req.Spec == nil {
+		return nil, status.Error(codes.InvalidArgument, "spec must be set")
+	}
+	input := req.Input
+	if input == nil {
+		input = &pjs.QueueElement{}
+	}
+	inputHash, err := a.hashElement(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	var id pjsdb.JobID
+	if err := dbutil.WithTx(ctx, a.env.DB, func(ctx context.Context, tx *pachsql.Tx) error {
+		parent, err := a.resolveContext(ctx, tx, req.Context)
+		if err != nil {
+			return err
+		}
+		pinnedInput, err := a.importElement(tx, input)
+		if err != nil {
+			return err
+		}
+		createReq := pjsdb.CreateJobRequest{
+			Spec:       req.Spec,
+			Input:      pinnedInput,
+			InputHash:  inputHash,
+			CacheRead:  req.CacheRead,
+			CacheWrite: req.CacheWrite,
+		}
+		if parent != nil {
+			createReq.Parent = parent.ID
+		}
+		if req.CacheRead {
+			output, err := a.readCache(ctx, tx, req, inputHash)
+			if err != nil {
+				return err
+			}
+			createReq.Output = output
+		}
+		id, err = pjsdb.CreateJob(ctx, tx, createReq)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return &pjs.CreateJobResponse{Id: &pjs.Job{Id: int64(id)}}, nil
+}
+
+// readCache returns a pinned copy of the output of a previous job with the same spec and input,
+// or nil if there is no such job.
+func (a *apiServer) readCache(ctx context.Context, tx *pachsql.Tx, req *pjs.CreateJobRequest, inputHash []byte) (*pjs.QueueElement, error) {
+	specHash, err := pjsdb.HashSpec(req.Spec)
+	if err != nil {
+		return nil, err
+	}
+	cached, err := pjsdb.LookupCache(ctx, tx, specHash, inputHash)
+	if err != nil || cached == nil {
+		return nil, err
+	}
+	output, err := cached.OutputElement()
+	if err != nil {
+		return nil, err
+	}
+	log.Debug(ctx, "pjs cache hit", zap.Int64("cachedJob", int64(cached.ID)))
+	return a.importElement(tx, output)
+}
+
+// CancelJob implements the pjs.CancelJob RPC.
+func (a *apiServer) CancelJob(ctx context.Context, req *pjs.CancelJobRequest) (*pjs.CancelJobResponse, error) {
+	if err := dbutil.WithTx(ctx, a.env.DB, func(ctx context.Context, tx *pachsql.Tx) error {
+		job, err := a.getChildJob(ctx, tx, req.Context, req.Job)
+		if err != nil {
+			return err
+		}
+		_, err = pjsdb.CancelJob(ctx, tx, job.ID)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return &pjs.CancelJobResponse{}, nil
+}
+
+// DeleteJob implements the pjs.DeleteJob RPC.
+func (a *apiServer) DeleteJob(ctx context.Context, req *pjs.DeleteJobRequest) (*pjs.DeleteJobResponse, error) {
+	var deleted []*pjsdb.Job
+	if err := dbutil.WithTx(ctx, a.env.DB, func(ctx context.Context, tx *pachsql.Tx) error {
+		job, err := a.getChildJob(ctx, tx, req.Context, req.Job)
+		if err != nil {
+			return err
+		}
+		if _, err := pjsdb.CancelJob(ctx, tx, job.ID); err != nil {
+			return err
+		}
+		deleted, err = pjsdb.DeleteJob(ctx, tx, job.ID)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	// The filesets are only released once the metadata referencing them is gone.
+	for _, job := range deleted {
+		if err := a.releaseJob(ctx, job); err != nil {
+			log.Error(ctx, "could not release pjs job filesets", zap.Int64("job", int64(job.ID)), zap.Error(err))
+		}
+	}
+	return &pjs.DeleteJobResponse{}, nil
+}
+
+// ListJob implements the pjs.ListJob RPC.
+func (a *apiServer) ListJob(req *pjs.ListJobRequest, srv pjs.API_ListJobServer) error {
+	ctx := srv.Context()
+	var jobs []*pjsdb.Job
+	if err := dbutil.WithTx(ctx, a.env.DB, func(ctx context.Context, tx *pachsql.Tx) error {
+		parent, err := a.getAccessibleJob(ctx, tx, req.Context, req.Job)
+		if err != nil {
+			return err
+		}
+		var parentID pjsdb.JobID
+		if parent != nil {
+			parentID = parent.ID
+		}
+		jobs, err = pjsdb.ListJobChildren(ctx, tx, parentID)
+		return err
+	}, dbutil.WithReadOnly()); err != nil {
+		return err
+	}
+	return a.sendJobs(ctx, jobs, srv.Send)
+}
+
+// WalkJob implements the pjs.WalkJob RPC.
+func (a *apiServer) WalkJob(req *pjs.WalkJobRequest, srv pjs.API_WalkJobServer) error {
+	ctx := srv.Context()
+	var jobs []*pjsdb.Job
+	if err := dbutil.WithTx(ctx, a.env.DB, func(ctx context.Context, tx *pachsql.Tx) error {
+		root, err := a.getAccessibleJob(ctx, tx, req.Context, req.Job)
+		if err != nil {
+			return err
+		}
+		if root == nil {
+			return status.Error(codes.InvalidArgument, "job or context must be set")
+		}
+		jobs, err = pjsdb.WalkJob(ctx, tx, root.ID)
+		return err
+	}, dbutil.WithReadOnly()); err != nil {
+		return err
+	}
+	return a.sendJobs(ctx, jobs, srv.Send)
+}
+
+// InspectJob implements the pjs.InspectJob RPC.
+func (a *apiServer) InspectJob(ctx context.Context, req *pjs.InspectJobRequest) (*pjs.InspectJobResponse, error) {
+	var info *pjs.JobInfo
+	if err := dbutil.WithTx(ctx, a.env.DB, func(ctx context.Context, tx *pachsql.Tx) error {
+		job, err := a.getAccessibleJob(ctx, tx, req.Context, req.Job)
+		if err != nil {
+			return err
+		}
+		if job == nil {
+			return status.Error(codes.InvalidArgument, "job or context must be set")
+		}
+		info, err = a.exportJobInfo(tx, job)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return &pjs.InspectJobResponse{Details: &pjs.JobInfoDetails{JobInfo: info}}, nil
+}
+
+// ProcessQueue implements the pjs.ProcessQueue RPC.
+func (a *apiServer) ProcessQueue(srv pjs.API_ProcessQueueServer) error {
+	ctx := srv.Context()
+	req, err := srv.Recv()
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	if req.Queue == nil {
+		return status.Error(codes.InvalidArgument, "the first request must set queue")
+	}
+	for {
+		job, token, err := a.awaitJob(ctx, req.Queue.Id)
+		if err != nil {
+			return err
+		}
+		if err := a.processJob(ctx, srv, job, token); err != nil {
+			return err
+		}
+	}
+}
+
+// awaitJob blocks until a job can be dequeued from the queue, and returns it along with its context token.
+func (a *apiServer) awaitJob(ctx context.Context, queue []byte) (*pjsdb.Job, string, error) {
+	for {
+		token := uuid.NewWithoutDashes()
+		var job *pjsdb.Job
+		if err := dbutil.WithTx(ctx, a.env.DB, func(ctx context.Context, tx *pachsql.Tx) error {
+			var err error
+			job, err = pjsdb.DequeueJob(ctx, tx, queue, token)
+			return err
+		}); err == nil {
+			return job, token, nil
+		} else if !errors.Is(err, pjsdb.ErrQueueEmpty) {
+			return nil, "", err
+		}
+		select {
+		case <-ctx.Done():
+			return nil, "", errors.EnsureStack(context.Cause(ctx))
+		case <-time.After(a.env.PollInterval):
+		}
+	}
+}
+
+type processResult struct {
+	req *pjs.ProcessQueueRequest
+	err error
+}
+
+// processJob sends a dequeued job to the worker and records the worker's result.
+func (a *apiServer) processJob(ctx context.Context, srv pjs.API_ProcessQueueServer, job *pjsdb.Job, token string) error {
+	var input *pjs.QueueElement
+	if err := dbutil.WithTx(ctx, a.env.DB, func(_ context.Context, tx *pachsql.Tx) error {
+		elem, err := job.InputElement()
+		if err != nil {
+			return err
+		}
+		input, err = a.exportElement(tx, elem)
+		return err
+	}); err != nil {
+		return a.failJob(ctx, job.ID, pjs.JobErrorCode_DISCONNECTED, err)
+	}
+	if err := srv.Send(&pjs.ProcessQueueResponse{Context: token, Input: input}); err != nil {
+		return a.failJob(ctx, job.ID, pjs.JobErrorCode_DISCONNECTED, errors.EnsureStack(err))
+	}
+	resultC := make(chan processResult, 1)
+	go func() {
+		req, err := srv.Recv()
+		resultC <- processResult{req: req, err: err}
+	}()
+	ticker := time.NewTicker(a.env.PollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case res := <-resultC:
+			if res.err != nil {
+				return a.failJob(ctx, job.ID, pjs.JobErrorCode_DISCONNECTED, errors.EnsureStack(res.err))
+			}
+			return a.finishJob(ctx, job.ID, res.req)
+		case <-ticker.C:
+			current, err := pjsdb.GetJob(ctx, a.env.DB, job.ID)
+			if err != nil {
+				if errors.Is(err, &pjsdb.JobNotFoundError{}) {
+					return status.Errorf(codes.Canceled, "job %d was deleted", job.ID)
+				}
+				return err
+			}
+			if current.State() == pjs.JobState_DONE {
+				return status.Errorf(codes.Canceled, "job %d was canceled", job.ID)
+			}
+		case <-ctx.Done():
+			return a.failJob(ctx, job.ID, pjs.JobErrorCode_DISCONNECTED, errors.EnsureStack(context.Cause(ctx)))
+		}
+	}
+}
+
+// finishJob records the result sent by a worker.
+func (a *apiServer) finishJob(ctx context.Context, id pjsdb.JobID, req *pjs.ProcessQueueRequest) error {
+	switch res := req.Result.(type) {
+	case *pjs.ProcessQueueRequest_Output:
+		var done bool
+		if err := dbutil.WithTx(ctx, a.env.DB, func(ctx context.Context, tx *pachsql.Tx) error {
+			output, err := a.importElement(tx, res.Output)
+			if err != nil {
+				return err
+			}
+			done, err = pjsdb.CompleteJob(ctx, tx, id, output)
+			if err != nil {
+				return err
+			}
+			if !done {
+				// Roll back the pinned output, the job was canceled in the meantime.
+				return errJobNotProcessing
+			}
+			return nil
+		}); err != nil {
+			if errors.Is(err, errJobNotProcessing) {
+				return status.Errorf(codes.Canceled, "job %d was canceled", id)
+			}
+			return err
+		}
+		return nil
+	case *pjs.ProcessQueueRequest_Failed:
+		return a.failJob(ctx, id, pjs.JobErrorCode_FAILED, nil)
+	default:
+		return a.failJob(ctx, id, pjs.JobErrorCode_DISCONNECTED, status.Error(codes.InvalidArgument, "result must be set"))
+	}
+}
+
+var errJobNotProcessing = errors.New("job is not processing")
+
+// failJob transitions a job to DONE with an error code, and returns cause.
+// The job is updated even if ctx has been canceled, since that is how a disconnect is detected.
+func (a *apiServer) failJob(ctx context.Context, id pjsdb.JobID, code pjs.JobErrorCode, cause error) error {
+	if err := dbutil.WithTx(context.WithoutCancel(ctx), a.env.DB, func(ctx context.Context, tx *pachsql.Tx) error {
+		_, err := pjsdb.ErrorJob(ctx, tx, id, code)
+		return err
+	}); err != nil {
+		return errors.Join(cause, err)
+	}
+	return cause
+}
+
+// ListQueue implements the pjs.ListQueue RPC.
+func (a *apiServer) ListQueue(req *pjs.ListQueueRequest, srv pjs.API_ListQueueServer) error {
+	queues, err := pjsdb.ListQueues(srv.Context(), a.env.DB)
+	if err != nil {
+		return err
+	}
+	for _, q := range queues {
+		info, err := q.ToQueueInfo()
+		if err != nil {
+			return err
+		}
+		if err := srv.Send(&pjs.ListQueueResponse{
+			Id:      info.Queue,
+			Info:    info,
+			Details: &pjs.QueueInfoDetails{QueueInfo: info, Size: q.Size},
+		}); err != nil {
+			return errors.EnsureStack(err)
+		}
+	}
+	return nil
+}
+
+// InspectQueue implements the pjs.InspectQueue RPC.
+func (a *apiServer) InspectQueue(ctx context.Context, req *pjs.InspectQueueRequest) (*pjs.InspectQueueResponse, error) {
+	if req.Queue == nil {
+		return nil, status.Error(codes.InvalidArgument, "queue must be set")
+	}
+	q, err := pjsdb.GetQueue(ctx, a.env.DB, req.Queue.Id)
+	if err != nil {
+		return nil, err
+	}
+	info, err := q.ToQueueInfo()
+	if err != nil {
+		return nil, err
+	}
+	return &pjs.InspectQueueResponse{Details: &pjs.QueueInfoDetails{QueueInfo: info, Size: q.Size}}, nil
+}
+
+// resolveContext returns the job associated with a context token, or nil if the token is empty.
+func (a *apiServer) resolveContext(ctx context.Context, tx *pachsql.Tx, token string) (*pjsdb.Job, error) {
+	if token == "" {
+		return nil, nil
+	}
+	return pjsdb.GetJobByContext(ctx, tx, token)
+}
+
+// getChildJob returns the job, which must be a child of the context's job.
+// Without a context, only root jobs may be returned.
+func (a *apiServer) getChildJob(ctx context.Context, tx *pachsql.Tx, token string, job *pjs.Job) (*pjsdb.Job, error) {
+	if job == nil {
+		return nil, status.Error(codes.InvalidArgument, "job must be set")
+	}
+	parent, err := a.resolveContext(ctx, tx, token)
+	if err != nil {
+		return nil, err
+	}
+	j, err := pjsdb.GetJob(ctx, tx, pjsdb.JobID(job.Id))
+	if err != nil {
+		return nil, err
+	}
+	var parentID pjsdb.JobID
+	if parent != nil {
+		parentID = parent.ID
+	}
+	if j.ParentID() != parentID {
+		return nil, status.Errorf(codes.PermissionDenied, "job %d can only be modified with its parent's context", job.Id)
+	}
+	return j, nil
+}
+
+// getAccessibleJob returns the job if set, otherwise the context's job.
+// If both are set, the job must be the context's job or one of its descendants.
+// If neither are set, nil is returned.
+func (a *apiServer) getAccessibleJob(ctx context.Context, tx *pachsql.Tx, token string, job *pjs.Job) (*pjsdb.Job, error) {
+	ctxJob, err := a.resolveContext(ctx, tx, token)
+	if err != nil {
+		return nil, err
+	}
+	if job == nil {
+		return ctxJob, nil
+	}
+	id := pjsdb.JobID(job.Id)
+	if ctxJob != nil {
+		ok, err := pjsdb.IsDescendant(ctx, tx, ctxJob.ID, id)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, status.Errorf(codes.PermissionDenied, "job %d is not a descendant of the context's job", job.Id)
+		}
+	}
+	return pjsdb.GetJob(ctx, tx, id)
+}
+
+func (a *apiServer) sendJobs(ctx context.Context, jobs []*pjsdb.Job, send func(*pjs.ListJobResponse) error) error {
+	for _, job := range jobs {
+		var info *pjs.JobInfo
+		if err := dbutil.WithTx(ctx, a.env.DB, func(_ context.Context, tx *pachsql.Tx) error {
+			var err error
+			info, err = a.exportJobInfo(tx, job)
+			return err
+		}); err != nil {
+			return err
+		}
+		if err := send(&pjs.ListJobResponse{Id: info.Job, Info: info}); err != nil {
+			return errors.EnsureStack(err)
+		}
+	}
+	return nil
+}
+
+// exportJobInfo converts a job to a JobInfo with freshly minted fileset handles.
+func (a *apiServer) exportJobInfo(tx *pachsql.Tx, job *pjsdb.Job) (*pjs.JobInfo, error) {
+	info, err := job.ToJobInfo()
+	if err != nil {
+		return nil, err
+	}
+	if info.Input, err = a.exportElement(tx, info.Input); err != nil {
+		return nil, err
+	}
+	if out, ok := info.Result.(*pjs.JobInfo_Output); ok {
+		if out.Output, err = a.exportElement(tx, out.Output); err != nil {
+			return nil, err
+		}
+	}
+	return info, nil
+}
+
+// importElement pins the filesets referenced by an element crossing into the PJS API.
+// The returned element references clones which live until they are released.
+func (a *apiServer) importElement(tx *pachsql.Tx, elem *pjs.QueueElement) (*pjs.QueueElement, error) {
+	return a.cloneElement(tx, elem, track.NoTTL)
+}
+
+// exportElement mints new handles for the filesets referenced by an element leaving the PJS API.
+func (a *apiServer) exportElement(tx *pachsql.Tx, elem *pjs.QueueElement) (*pjs.QueueElement, error) {
+	return a.cloneElement(tx, elem, client.DefaultTTL)
+}
+
+func (a *apiServer) cloneElement(tx *pachsql.Tx, elem *pjs.QueueElement, ttl time.Duration) (*pjs.QueueElement, error) {
+	res := &pjs.QueueElement{Data: elem.Data}
+	for _, handle := range elem.Filesets {
+		id, err := fileset.ParseID(handle)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid fileset handle %q: %v", handle, err)
+		}
+		clone, err := a.env.Storage.CloneTx(tx, *id, ttl)
+		if err != nil {
+			return nil, errors.Wrapf(err, "cloning fileset %v", handle)
+		}
+		res.Filesets = append(res.Filesets, clone.HexString())
+	}
+	return res, nil
+}
+
+// releaseJob drops the pinned filesets referenced by a deleted job's input and output.
+func (a *apiServer) releaseJob(ctx context.Context, job *pjsdb.Job) error {
+	input, err := job.InputElement()
+	if err != nil {
+		return err
+	}
+	output, err := job.OutputElement()
+	if err != nil {
+		return err
+	}
+	handles := input.Filesets
+	if output != nil {
+		handles = append(handles, output.Filesets...)
+	}
+	for _, handle := range handles {
+		id, err := fileset.ParseID(handle)
+		if err != nil {
+			return err
+		}
+		if err := a.env.Storage.Drop(ctx, *id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// hashElement computes a hash of an element's data and the content of its
+// filesets.  Each field is length-prefixed so that bytes can't shift between
+// them without changing the hash.
+func (a *apiServer) hashElement(ctx context.Context, elem *pjs.QueueElement) ([]byte, error) {
+	var ids []fileset.ID
+	for _, handle := range elem.Filesets {
+		id, err := fileset.ParseID(handle)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid fileset handle %q: %v", handle, err)
+		}
+		ids = append(ids, *id)
+	}
+	fingerprint, err := a.env.Storage.Fingerprint(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	h := pachhash.New()
+	for _, field := range [][]byte{elem.Data, fingerprint} {
+		if err := binary.Write(h, binary.BigEndian, int64(len(field))); err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		h.Write(field)
+	}
+	return h.Sum(nil), nil
+}
@@ -0,0 +1,19 @@
+package server
+
+import (
+	"time"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset"
+)
+
+// Env is the set of dependencies required by a PJS API server.
+type Env struct {
+	DB *pachsql.DB
+	// Storage is used to pin the filesets referenced by queue elements.
+	Storage *fileset.Storage
+	// PollInterval is how often ProcessQueue checks for new jobs in an empty queue,
+	// and how often it checks whether the job being processed was canceled.
+	// Defaults to DefaultPollInterval.
+	PollInterval time.Duration
+}
@@ -94,6 +94,8 @@ func makeGraph(pis []*pps.PipelineInfo) ([]*vertex, error) {
 				name = input.Pfs.Name
 			} else if input.Cron != nil {
 				name = input.Cron.Name
+			} else if input.Bucket != nil {
+				name = input.Bucket.Name
 			} else {
 				return nil
 			}
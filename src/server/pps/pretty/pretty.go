@@ -279,6 +279,9 @@ Processed: {{.DataProcessed}}
 Failed: {{.DataFailed}}
 Skipped: {{.DataSkipped}}
 Recovered: {{.DataRecovered}}
+Quarantined: {{.DataQuarantined}}{{if .Details.CacheDatumOutput}}
+Cache Hits: {{.DataCacheHits}}
+Cache Misses: {{.DataCacheMisses}}{{end}}
 Total: {{.DataTotal}}
 Data Downloaded: {{prettySize .Stats.DownloadBytes}}
 Data Uploaded: {{prettySize .Stats.UploadBytes}}
@@ -314,6 +317,9 @@ Service:
 	{{ if .Details.Service.InternalPort }}InternalPort: {{ .Details.Service.InternalPort }} {{end}}
 	{{ if .Details.Service.ExternalPort }}ExternalPort: {{ .Details.Service.ExternalPort }} {{end}} {{end}}
 Input: {{jobInput . }}
+{{- if .WindowInputs }}
+Window Inputs:{{range .WindowInputs}}
+  {{.Name}}: {{.Commit.Id}}{{end}}{{end}}
 Transform: {{prettyTransform .Details.Transform}} {{if .OutputCommit}}
 Output Commit: {{.OutputCommit.Id}} {{end}}{{ if .Details.Egress }}
 Egress: {{egress .Details.Egress}} {{end}}
@@ -493,6 +499,8 @@ func datumState(datumState ppsclient.DatumState) string {
 		return color.New(color.FgRed).SprintFunc()("failed")
 	case ppsclient.DatumState_RECOVERED:
 		return color.New(color.FgYellow).SprintFunc()("recovered")
+	case ppsclient.DatumState_QUARANTINED:
+		return color.New(color.FgYellow).SprintFunc()("quarantined")
 	case ppsclient.DatumState_SUCCESS:
 		return color.New(color.FgGreen).SprintFunc()("success")
 	case ppsclient.DatumState_UNKNOWN:
@@ -628,6 +636,8 @@ func ShorthandInput(input *ppsclient.Input) string {
 		return "(" + strings.Join(subInput, " ∪ ") + ")"
 	case input.Cron != nil:
 		return fmt.Sprintf("%s:%s", input.Cron.Name, input.Cron.Spec)
+	case input.Bucket != nil:
+		return fmt.Sprintf("%s:%s", input.Bucket.Name, input.Bucket.Url)
 	}
 	return ""
 }
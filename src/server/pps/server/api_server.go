@@ -57,6 +57,8 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/lokiutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/metrics"
 	"github.com/pachyderm/pachyderm/v2/src/internal/miscutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/obj"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachconfig"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pachtmpl"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pctx"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pfsfile"
@@ -131,6 +133,9 @@ type apiServer struct {
 	jobs            col.PostgresCollection
 	clusterDefaults col.PostgresCollection
 	projectDefaults col.PostgresCollection
+
+	localDriverOnce sync.Once
+	localDriver     *localDriver
 }
 
 func (a *apiServer) validateInput(pipeline *pps.Pipeline, input *pps.Input) error {
@@ -167,8 +172,14 @@ func (a *apiServer) validateInput(pipeline *pps.Pipeline, input *pps.Input) erro
 				return errors.Errorf("input cannot specify both 's3' and " +
 					"'empty_files', as 's3' requires input data to be accessed via " +
 					"Pachyderm's S3 gateway rather than the file system")
-			case input.Pfs.Commit != "":
-				return errors.Errorf("input cannot come from a commit; use a branch with head pointing to the commit")
+			case uuid.IsUUIDWithoutDashes(input.Pfs.Commit):
+				return errors.Errorf("input cannot come from a commit; use a branch with head pointing to the commit, or a tag")
+			case input.Pfs.S3 && input.Pfs.Window != nil:
+				return errors.Errorf("input cannot specify both 's3' and 'window', as " +
+					"the S3 gateway only exposes the input's latest commit")
+			}
+			if err := validateWindow(input.Pfs.Window); err != nil {
+				return errors.Wrapf(err, "invalid window for input %q", input.Pfs.Name)
 			}
 		}
 		if input.Cross != nil {
@@ -223,6 +234,40 @@ func (a *apiServer) validateInput(pipeline *pps.Pipeline, input *pps.Input) erro
 				return errors.Wrapf(err, "error parsing cron-spec")
 			}
 		}
+		if input.Bucket != nil {
+			if input.Bucket.Project == "" {
+				input.Bucket.Project = pipeline.Project.GetName()
+			}
+			if set {
+				return errors.Errorf("multiple input types set")
+			}
+			set = true
+			u, err := obj.ParseURL(input.Bucket.Url)
+			if err != nil {
+				return errors.Wrapf(err, "error parsing bucket url")
+			}
+			// Local directories are only visible to workers that share
+			// pachd's host.
+			if u.Scheme == "local" && a.env.Config.PPSInfraDriver != pachconfig.LocalInfraDriver {
+				return errors.Errorf("local bucket urls are only supported when pachd runs workers locally")
+			}
+			// Buckets are read with the credentials in the input's secret,
+			// never with pachd's own, and local workers can't mount it.
+			if u.Scheme != "local" {
+				if input.Bucket.Secret == "" {
+					return errors.Errorf("bucket input %s must name a secret holding the credentials for its bucket", input.Bucket.Name)
+				}
+				if a.env.Config.PPSInfraDriver == pachconfig.LocalInfraDriver {
+					return errors.Errorf("only local bucket urls are supported when pachd runs workers locally")
+				}
+			}
+			if _, err := cronutil.ParseCronExpression(input.Bucket.Spec); err != nil {
+				return errors.Wrapf(err, "error parsing bucket spec")
+			}
+			if len(input.Bucket.Glob) == 0 {
+				return errors.Errorf("input must specify a glob")
+			}
+		}
 		if !set {
 			return errors.Errorf("no input set")
 		}
@@ -446,10 +491,14 @@ func (a *apiServer) UpdateJobStateInTransaction(ctx context.Context, txnCtx *txn
 	jobInfo.DataSkipped = request.DataSkipped
 	jobInfo.DataFailed = request.DataFailed
 	jobInfo.DataRecovered = request.DataRecovered
+	jobInfo.DataQuarantined = request.DataQuarantined
+	jobInfo.DataCacheHits = request.DataCacheHits
+	jobInfo.DataCacheMisses = request.DataCacheMisses
+	jobInfo.WindowInputs = request.WindowInputs
 	jobInfo.DataTotal = request.DataTotal
 	jobInfo.Stats = request.Stats
 
-	return ppsutil.UpdateJobState(a.pipelines.ReadWrite(txnCtx.SqlTx), jobs, jobInfo, request.State, request.Reason)
+	return ppsutil.UpdateJobState(ctx, txnCtx.SqlTx, a.pipelines.ReadWrite(txnCtx.SqlTx), jobs, jobInfo, request.State, request.Reason)
 }
 
 // InspectJob implements the protobuf pps.InspectJob RPC
@@ -728,6 +777,8 @@ func (a *apiServer) getJobDetails(ctx context.Context, jobInfo *pps.JobInfo) err
 	details.DatumTimeout = pipelineInfo.Details.DatumTimeout
 	details.JobTimeout = pipelineInfo.Details.JobTimeout
 	details.DatumTries = pipelineInfo.Details.DatumTries
+	details.DatumRetryPolicy = pipelineInfo.Details.DatumRetryPolicy
+	details.CacheDatumOutput = pipelineInfo.Details.CacheDatumOutput
 	details.SchedulingSpec = pipelineInfo.Details.SchedulingSpec
 	details.PodSpec = pipelineInfo.Details.PodSpec
 	details.PodPatch = pipelineInfo.Details.PodPatch
@@ -1000,7 +1051,7 @@ func (a *apiServer) stopJob(ctx context.Context, txnCtx *txncontext.TransactionC
 	// TODO: We can still not update a job's state if we fail here. This is
 	// probably fine for now since we are likely to have a more comprehensive
 	// solution to this with global ids.
-	if err := ppsutil.UpdateJobState(a.pipelines.ReadWrite(txnCtx.SqlTx), jobs, jobInfo, pps.JobState_JOB_KILLED, reason); err != nil && !ppsServer.IsJobFinishedErr(err) {
+	if err := ppsutil.UpdateJobState(ctx, txnCtx.SqlTx, a.pipelines.ReadWrite(txnCtx.SqlTx), jobs, jobInfo, pps.JobState_JOB_KILLED, reason); err != nil && !ppsServer.IsJobFinishedErr(err) {
 		return err
 	}
 	return nil
@@ -1166,7 +1217,9 @@ func (a *apiServer) listDatumInput(ctx context.Context, input *pps.Input, cb fun
 	if visitErr := pps.VisitInput(input, func(input *pps.Input) error {
 		if input.Pfs != nil {
 			pachClient := a.env.GetPachClient(ctx)
-			ci, err := pachClient.InspectCommit(input.Pfs.Project, input.Pfs.Repo, input.Pfs.Branch, "")
+			// The commit may name a tag, which pins the input to the tagged
+			// commit rather than the head of the branch.
+			ci, err := pachClient.InspectCommit(input.Pfs.Project, input.Pfs.Repo, input.Pfs.Branch, input.Pfs.Commit)
 			if err != nil {
 				return err
 			}
@@ -1175,6 +1228,9 @@ func (a *apiServer) listDatumInput(ctx context.Context, input *pps.Input, cb fun
 		if input.Cron != nil {
 			return errors.Errorf("can't list datums with a cron input, there will be no datums until the pipeline is created")
 		}
+		if input.Bucket != nil {
+			return errors.Errorf("can't list datums with a bucket input, there will be no datums until the pipeline is created")
+		}
 		return nil
 	}); visitErr != nil {
 		return visitErr
@@ -1217,6 +1273,8 @@ func convertDatumState(state datum.State) pps.DatumState {
 		return pps.DatumState_FAILED
 	case datum.State_RECOVERED:
 		return pps.DatumState_RECOVERED
+	case datum.State_QUARANTINED:
+		return pps.DatumState_QUARANTINED
 	default:
 		return pps.DatumState_SUCCESS
 	}
@@ -1870,7 +1928,18 @@ func (a *apiServer) validateSecret(ctx context.Context, req *pps.CreatePipelineR
 			return errors.Errorf("Kubernetes secret %s missing key %s", s.Name, s.Key)
 		}
 	}
-	return nil
+	return errors.EnsureStack(pps.VisitInput(req.GetInput(), func(in *pps.Input) error {
+		if in.Bucket.GetSecret() == "" {
+			return nil
+		}
+		if _, err := a.env.KubeClient.CoreV1().Secrets(a.namespace).Get(ctx, in.Bucket.Secret, metav1.GetOptions{}); err != nil {
+			if k8serrors.IsNotFound(err) {
+				return errors.Errorf("missing Kubernetes secret %s", in.Bucket.Secret)
+			}
+			return errors.Wrapf(err, "could not get Kubernetes secret %s", in.Bucket.Secret)
+		}
+		return nil
+	}))
 }
 
 // validateEgress validates the egress field.
@@ -1938,6 +2007,58 @@ func (a *apiServer) validatePipeline(pipelineInfo *pps.PipelineInfo) error {
 			return errors.Errorf("spout pipelines (without a service) must not have an input")
 		}
 	}
+	if err := validateDatumRetryPolicy(pipelineInfo.Details.DatumRetryPolicy); err != nil {
+		return errors.Wrapf(err, "invalid datum retry policy")
+	}
+	if pipelineInfo.Details.CacheDatumOutput {
+		if pipelineInfo.Details.S3Out {
+			return errors.New("pipelines that write their output through the s3 gateway cannot cache datum output")
+		}
+		if pipelineInfo.Details.Service != nil || pipelineInfo.Details.Spout != nil {
+			return errors.New("services and spouts cannot cache datum output")
+		}
+		// Output is cached by the image ID of the user container, which local
+		// workers don't have, so cached output could be reused after the user
+		// code has changed.
+		if a.env.Config.PPSInfraDriver == pachconfig.LocalInfraDriver {
+			return errors.New("pipelines cannot cache datum output when pachd runs workers locally")
+		}
+	}
+	return nil
+}
+
+func validateDatumRetryPolicy(policy *pps.DatumRetryPolicy) error {
+	if policy == nil {
+		return nil
+	}
+	if policy.Backoff.AsDuration() < 0 {
+		return errors.New("backoff cannot be negative")
+	}
+	if policy.MaxBackoff.AsDuration() < 0 {
+		return errors.New("max_backoff cannot be negative")
+	}
+	if policy.BackoffMultiplier < 0 {
+		return errors.New("backoff_multiplier cannot be negative")
+	}
+	if _, ok := pps.DatumRetryPolicy_Action_name[int32(policy.OnFailure)]; !ok {
+		return errors.Errorf("unknown on_failure action %v", policy.OnFailure)
+	}
+	return nil
+}
+
+func validateWindow(window *pps.PFSWindow) error {
+	if window == nil {
+		return nil
+	}
+	if window.Commits < 0 {
+		return errors.New("commits cannot be negative")
+	}
+	if window.Duration.AsDuration() < 0 {
+		return errors.New("duration cannot be negative")
+	}
+	if window.Commits == 0 && window.Duration.AsDuration() == 0 {
+		return errors.New("window must limit its commits or its duration")
+	}
 	return nil
 }
 
@@ -1958,6 +2079,13 @@ func branchProvenance(project *pfs.Project, input *pps.Input) []*pfs.Branch {
 			}
 			result = append(result, client.NewBranch(projectName, input.Cron.Repo, "master"))
 		}
+		if input.Bucket != nil {
+			var projectName = input.Bucket.Project
+			if projectName == "" {
+				projectName = project.GetName()
+			}
+			result = append(result, client.NewBranch(projectName, input.Bucket.Repo, "master"))
+		}
 		return nil
 	})
 	return result
@@ -1990,6 +2118,14 @@ func (a *apiServer) fixPipelineInputRepoACLsInTransaction(txnCtx *txncontext.Tra
 				} else {
 					repo.Project = &pfs.Project{Name: input.Cron.Project}
 				}
+			case input.Bucket != nil:
+				repo = new(pfs.Repo)
+				repo.Name = input.Bucket.Repo
+				if input.Bucket.Project == "" {
+					repo.Project = prevPipelineInfo.Pipeline.Project
+				} else {
+					repo.Project = &pfs.Project{Name: input.Bucket.Project}
+				}
 			default:
 				return nil // no scope to set: input is not a repo
 			}
@@ -2032,6 +2168,14 @@ func (a *apiServer) fixPipelineInputRepoACLsInTransaction(txnCtx *txncontext.Tra
 					repo.Project = &pfs.Project{Name: input.Cron.Project}
 				}
 				repo.Name = input.Cron.Repo
+			case input.Bucket != nil:
+				repo = new(pfs.Repo)
+				if input.Bucket.Project == "" {
+					repo.Project = pipelineInfo.Pipeline.Project
+				} else {
+					repo.Project = &pfs.Project{Name: input.Bucket.Project}
+				}
+				repo.Name = input.Bucket.Repo
 			default:
 				return nil // no scope to set: input is not a repo
 			}
@@ -2039,7 +2183,7 @@ func (a *apiServer) fixPipelineInputRepoACLsInTransaction(txnCtx *txncontext.Tra
 				delete(remove, repo.String())
 			} else {
 				addRead[repo.String()] = repo
-				if input.Cron != nil {
+				if input.Cron != nil || input.Bucket != nil {
 					addWrite[repo.String()] = repo
 				}
 			}
@@ -2385,6 +2529,8 @@ func (a *apiServer) initializePipelineInfo(txn *pps.CreatePipelineTransaction, o
 			Tolerations:             request.Tolerations,
 			Determined:              request.Determined,
 			MaximumExpectedUptime:   request.MaximumExpectedUptime,
+			DatumRetryPolicy:        request.DatumRetryPolicy,
+			CacheDatumOutput:        request.CacheDatumOutput,
 		},
 		UserSpecJson:      txn.UserJson,
 		EffectiveSpecJson: txn.EffectiveJson,
@@ -2434,19 +2580,29 @@ func (a *apiServer) CreatePipelineInTransaction(ctx context.Context, txnCtx *txn
 	if err != nil {
 		return err
 	}
-	// Verify that all input repos exist (create cron repos if necessary).
+	// Verify that all input repos exist (create cron and bucket repos if necessary).
 	if visitErr := pps.VisitInput(newPipelineInfo.Details.Input, func(input *pps.Input) error {
 		if input.Pfs != nil {
 			if input.Pfs.Project == "" {
 				input.Pfs.Project = projectName
 			}
+			repo := client.NewSystemRepo(input.Pfs.Project, input.Pfs.Repo, input.Pfs.RepoType)
 			if _, err := a.env.PFSServer.InspectRepoInTransaction(ctx, txnCtx,
 				&pfs.InspectRepoRequest{
-					Repo: client.NewSystemRepo(input.Pfs.Project, input.Pfs.Repo, input.Pfs.RepoType),
+					Repo: repo,
 				},
 			); err != nil {
 				return errors.EnsureStack(err)
 			}
+			if input.Pfs.Commit != "" {
+				if _, err := a.env.PFSServer.InspectCommitInTransaction(ctx, txnCtx,
+					&pfs.InspectCommitRequest{
+						Commit: repo.NewCommit(input.Pfs.Branch, input.Pfs.Commit),
+					},
+				); err != nil {
+					return errors.Wrapf(err, "input commit %q must name a tag in repo %s", input.Pfs.Commit, repo)
+				}
+			}
 		}
 		if input.Cron != nil {
 			if err := a.env.PFSServer.CreateRepoInTransaction(ctx, txnCtx,
@@ -2458,6 +2614,16 @@ func (a *apiServer) CreatePipelineInTransaction(ctx context.Context, txnCtx *txn
 				return errors.EnsureStack(err)
 			}
 		}
+		if input.Bucket != nil {
+			if err := a.env.PFSServer.CreateRepoInTransaction(ctx, txnCtx,
+				&pfs.CreateRepoRequest{
+					Repo:        client.NewRepo(projectName, input.Bucket.Repo),
+					Description: fmt.Sprintf("Bucket snapshot repo for pipeline %s.", request.Pipeline),
+				},
+			); err != nil && !errutil.IsAlreadyExistError(err) {
+				return errors.EnsureStack(err)
+			}
+		}
 		return nil
 	}); visitErr != nil {
 		return visitErr
@@ -2721,6 +2887,14 @@ func setInputDefaults(pipelineName string, input *pps.Input) {
 				input.Cron.Repo = fmt.Sprintf("%s_%s", pipelineName, input.Cron.Name)
 			}
 		}
+		if input.Bucket != nil {
+			if input.Bucket.Repo == "" {
+				input.Bucket.Repo = fmt.Sprintf("%s_%s", pipelineName, input.Bucket.Name)
+			}
+			if input.Bucket.Glob != "" {
+				input.Bucket.Glob = pfsfile.CleanPath(input.Bucket.Glob)
+			}
+		}
 		return nil
 	})
 }
@@ -3112,12 +3286,16 @@ func (a *apiServer) deletePipelineInTransaction(ctx context.Context, txnCtx *txn
 		return nil, errors.Wrapf(err, "inspect meta repo for pipeline %q", pipelineName)
 	}
 	// delete cron after main repo is deleted or has provenance removed
-	// cron repos are only used to trigger jobs, so don't keep them even with KeepRepo
+	// cron repos are only used to trigger jobs, so don't keep them even with KeepRepo.
+	// Bucket repos only hold snapshots of a bucket's listing, so they go too.
 	if pipelineInfo.Details != nil {
 		if err := pps.VisitInput(pipelineInfo.Details.Input, func(input *pps.Input) error {
 			if input.Cron != nil {
 				deleteRepos = append(deleteRepos, client.NewRepo(projectName, input.Cron.Repo))
 			}
+			if input.Bucket != nil {
+				deleteRepos = append(deleteRepos, client.NewRepo(projectName, input.Bucket.Repo))
+			}
 			return nil
 		}); err != nil {
 			return nil, err
@@ -3383,7 +3561,7 @@ func (a *apiServer) propagateJobs(ctx context.Context, txnCtx *txncontext.Transa
 			AuthToken:       token,
 			Created:         timestamppb.Now(),
 		}
-		if err := ppsutil.UpdateJobState(pipelines, jobs, jobPtr, pps.JobState_JOB_CREATED, ""); err != nil {
+		if err := ppsutil.UpdateJobState(ctx, txnCtx.SqlTx, pipelines, jobs, jobPtr, pps.JobState_JOB_CREATED, ""); err != nil {
 			return err
 		}
 	}
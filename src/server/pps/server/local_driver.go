@@ -0,0 +1,354 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/backoff"
+	"github.com/pachyderm/pachyderm/v2/src/internal/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/log"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachconfig"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pctx"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ppsutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+	"github.com/pachyderm/pachyderm/v2/src/version"
+	"go.uber.org/zap"
+)
+
+// localWorkerStopTimeout is how long a local worker has to exit after it is
+// sent SIGTERM, before it is killed.
+const localWorkerStopTimeout = 10 * time.Second
+
+// localDriver is an InfraDriver that runs each pipeline's workers as processes
+// on pachd's host, one per replica, rather than as Kubernetes pods.  It keeps
+// an in-memory replication controller for each pipeline, starts and stops
+// workers as the RC is scaled, restarts workers that exit, and reports their
+// state to WatchPipelinePods as pod events, so that the pipeline controller
+// can't tell it apart from the kubeDriver.
+//
+// Each worker gets its own loopback address (in 127.1.0.0/16), which it
+// listens on and registers in etcd, and its own directory under
+// LocalWorkerRoot, which it uses in place of "/".  Workers talk to pachd
+// itself at PEER_PORT, as they would to their sidecar.
+type localDriver struct {
+	ctx    context.Context
+	binary string
+	root   string
+
+	mu        sync.Mutex
+	pipelines map[pipelineKey]*localPipeline
+	ips       map[string]bool
+	watchers  map[chan watch.Event]chan struct{}
+}
+
+type localPipeline struct {
+	rc      *v1.ReplicationController
+	pi      *pps.PipelineInfo
+	workers []*localWorker // indexed by replica
+}
+
+type localWorker struct {
+	name   string
+	ip     string
+	dir    string
+	cancel func()
+}
+
+func newLocalDriver(ctx context.Context, config pachconfig.Configuration) *localDriver {
+	return &localDriver{
+		ctx:       pctx.Child(ctx, "localDriver"),
+		binary:    config.LocalWorkerBinary,
+		root:      config.LocalWorkerRoot,
+		pipelines: make(map[pipelineKey]*localPipeline),
+		ips:       make(map[string]bool),
+		watchers:  make(map[chan watch.Event]chan struct{}),
+	}
+}
+
+// Creates a pipeline's RC, replacing (and stopping the workers of) any RC the
+// pipeline already has.  Like the kubeDriver, the RC starts with 0 replicas.
+func (ld *localDriver) CreatePipelineResources(ctx context.Context, pi *pps.PipelineInfo) error {
+	log.Info(ctx, "creating local resources for pipeline")
+	ld.mu.Lock()
+	defer ld.mu.Unlock()
+	key := toKey(pi.Pipeline)
+	if p, ok := ld.pipelines[key]; ok {
+		ld.scale(p, 0)
+	}
+	ld.pipelines[key] = &localPipeline{rc: localRC(pi), pi: pi}
+	return nil
+}
+
+// Deletes a pipeline's RC and stops its workers.
+func (ld *localDriver) DeletePipelineResources(ctx context.Context, pipeline *pps.Pipeline) error {
+	log.Info(ctx, "deleting local resources for pipeline")
+	ld.mu.Lock()
+	defer ld.mu.Unlock()
+	key := toKey(pipeline)
+	if p, ok := ld.pipelines[key]; ok {
+		ld.scale(p, 0)
+		delete(ld.pipelines, key)
+	}
+	return nil
+}
+
+func (ld *localDriver) ReadReplicationController(ctx context.Context, pi *pps.PipelineInfo) (*v1.ReplicationControllerList, error) {
+	ld.mu.Lock()
+	defer ld.mu.Unlock()
+	rcs := &v1.ReplicationControllerList{}
+	if p, ok := ld.pipelines[toKey(pi.Pipeline)]; ok {
+		rcs.Items = append(rcs.Items, *p.rc.DeepCopy())
+	}
+	return rcs, nil
+}
+
+// UpdateReplicationController applies update to the pipeline's RC and then
+// starts or stops workers to match its replica count.
+func (ld *localDriver) UpdateReplicationController(ctx context.Context, old *v1.ReplicationController, update func(rc *v1.ReplicationController) bool) error {
+	rc := old.DeepCopy()
+	if !update(rc) {
+		return nil
+	}
+	ld.mu.Lock()
+	defer ld.mu.Unlock()
+	key := toKey(newPipeline(rc.Labels[pipelineProjectLabel], rc.Labels[pipelineNameLabel]))
+	p, ok := ld.pipelines[key]
+	if !ok || p.rc.Name != rc.Name {
+		return newRetriableError(errors.Errorf("rc %q not found", rc.Name), "error updating RC")
+	}
+	p.rc = rc
+	var replicas int
+	if rc.Spec.Replicas != nil {
+		replicas = int(*rc.Spec.Replicas)
+	}
+	ld.scale(p, replicas)
+	return nil
+}
+
+func (ld *localDriver) ListReplicationControllers(ctx context.Context) (*v1.ReplicationControllerList, error) {
+	ld.mu.Lock()
+	defer ld.mu.Unlock()
+	rcs := &v1.ReplicationControllerList{}
+	for _, p := range ld.pipelines {
+		rcs.Items = append(rcs.Items, *p.rc.DeepCopy())
+	}
+	return rcs, nil
+}
+
+func (ld *localDriver) WatchPipelinePods(ctx context.Context) (<-chan watch.Event, func(), error) {
+	ch, done := make(chan watch.Event), make(chan struct{})
+	ld.mu.Lock()
+	ld.watchers[ch] = done
+	ld.mu.Unlock()
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			ld.mu.Lock()
+			delete(ld.watchers, ch)
+			ld.mu.Unlock()
+			close(done)
+		})
+	}, nil
+}
+
+// localRC returns the RC that the kubeDriver would create for pi, minus the
+// pod template.
+func localRC(pi *pps.PipelineInfo) *v1.ReplicationController {
+	annotations := map[string]string{
+		pipelineNameAnnotation:       pi.Pipeline.Name,
+		pachVersionAnnotation:        version.PrettyVersion(),
+		pipelineVersionAnnotation:    strconv.FormatUint(pi.Version, 10),
+		pipelineSpecCommitAnnotation: pi.SpecCommit.Id,
+		hashedAuthTokenAnnotation:    hashAuthToken(pi.AuthToken),
+	}
+	if projectName := pi.Pipeline.Project.GetName(); projectName != "" {
+		annotations[pipelineProjectAnnotation] = projectName
+	}
+	return &v1.ReplicationController{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        ppsutil.PipelineRcName(pi),
+			Labels:      pipelineLabels(pi.Pipeline.Project.GetName(), pi.Pipeline.Name, pi.Version),
+			Annotations: annotations,
+		},
+		Spec: v1.ReplicationControllerSpec{
+			Replicas: &zero,
+		},
+	}
+}
+
+// scale starts or stops workers so that p has n of them.  ld.mu must be held.
+func (ld *localDriver) scale(p *localPipeline, n int) {
+	for len(p.workers) > n {
+		w := p.workers[len(p.workers)-1]
+		w.cancel()
+		p.workers = p.workers[:len(p.workers)-1]
+	}
+	for len(p.workers) < n {
+		ip, err := ld.allocateIP()
+		if err != nil {
+			log.Error(ld.ctx, "could not start local worker", zap.String("rc", p.rc.Name), zap.Error(err))
+			return
+		}
+		name := fmt.Sprintf("%s-%s", p.rc.Name, uuid.NewWithoutDashes()[:5])
+		ctx, cancel := pctx.WithCancel(pctx.Child(ld.ctx, "", pctx.WithFields(zap.String("worker", name))))
+		w := &localWorker{
+			name:   name,
+			ip:     ip,
+			dir:    filepath.Join(ld.root, name),
+			cancel: cancel,
+		}
+		p.workers = append(p.workers, w)
+		go ld.runWorker(ctx, w, localPod(p.rc, w), ld.workerEnv(p.pi, w))
+	}
+}
+
+// allocateIP returns an unused address in 127.1.0.0/16.  ld.mu must be held.
+func (ld *localDriver) allocateIP() (string, error) {
+	for i := 1; i < 1<<16-1; i++ {
+		ip := net.IPv4(127, 1, byte(i>>8), byte(i)).String()
+		if !ld.ips[ip] {
+			ld.ips[ip] = true
+			return ip, nil
+		}
+	}
+	return "", errors.New("no loopback addresses left for local workers")
+}
+
+// localWorkerEnv lists the variables of pachd's environment that local workers
+// inherit on top of ppsutil.LocalHostEnv: the database, etcd, storage and
+// logging configuration that the kubeDriver sets on worker containers.
+var localWorkerEnv = []string{
+	"POSTGRES_SSL", "POSTGRES_HOST", "POSTGRES_PORT", "POSTGRES_DATABASE", "POSTGRES_USER", "POSTGRES_PASSWORD",
+	"PG_BOUNCER_HOST", "PG_BOUNCER_PORT",
+	"ETCD_SERVICE_HOST", "ETCD_SERVICE_PORT", "ETCD_PREFIX", "PPS_ETCD_PREFIX",
+	"PACH_ROOT", "PACH_NAMESPACE", "STORAGE_BACKEND", client.PeerPortEnv,
+	"PACHD_SERVICE_HOST", "PACHD_SERVICE_PORT", "S3GATEWAY_PORT",
+	"LOKI_SERVICE_HOST", "LOKI_SERVICE_PORT", "LOKI_LOGGING",
+	log.EnvLogLevel, log.EnvDevelopmentLogger, log.EnvDisableLogSampling,
+	"GOCOVERDIR",
+}
+
+// workerEnv returns the environment of a local worker: the allowlisted part of
+// pachd's own, the transform's, and the variables that the kubeDriver sets on
+// worker containers.
+func (ld *localDriver) workerEnv(pi *pps.PipelineInfo, w *localWorker) []string {
+	env := append(ppsutil.HostEnv(ppsutil.LocalHostEnv), ppsutil.HostEnv(localWorkerEnv)...)
+	for name, value := range pi.Details.Transform.Env {
+		env = append(env, name+"="+value)
+	}
+	return append(env,
+		"PACH_IN_WORKER=true",
+		client.PPSWorkerIPEnv+"="+w.ip,
+		client.PPSPodNameEnv+"="+w.name,
+		client.PPSSpecCommitEnv+"="+pi.SpecCommit.Id,
+		client.PPSProjectNameEnv+"="+pi.Pipeline.Project.GetName(),
+		client.PPSPipelineNameEnv+"="+pi.Pipeline.Name,
+		"PPS_WORKER_ROOT="+w.dir,
+	)
+}
+
+// runWorker runs w's process until ctx is done, restarting it with backoff
+// whenever it exits.  w's address is only released once its process is gone,
+// so that a new worker can't fail to listen on it.
+func (ld *localDriver) runWorker(ctx context.Context, w *localWorker, pod *v1.Pod, env []string) {
+	defer func() {
+		if err := os.RemoveAll(w.dir); err != nil {
+			log.Error(ctx, "could not remove local worker directory", zap.String("dir", w.dir), zap.Error(err))
+		}
+		ld.mu.Lock()
+		delete(ld.ips, w.ip)
+		ld.mu.Unlock()
+	}()
+	backoff.RetryUntilCancel(ctx, func() error { //nolint:errcheck
+		if err := os.MkdirAll(w.dir, 0777); err != nil {
+			ld.sendPod(pod, v1.PodPending, v1.ContainerState{Waiting: &v1.ContainerStateWaiting{
+				Reason:  "CreateContainerConfigError",
+				Message: err.Error(),
+			}})
+			return errors.EnsureStack(err)
+		}
+		cmd := exec.CommandContext(ctx, ld.binary)
+		cmd.Dir, cmd.Env = w.dir, env
+		cmd.Stdout, cmd.Stderr = os.Stdout, os.Stderr
+		cmd.SysProcAttr = localWorkerSysProcAttr()
+		cmd.Cancel = func() error { return errors.EnsureStack(cmd.Process.Signal(syscall.SIGTERM)) }
+		cmd.WaitDelay = localWorkerStopTimeout
+		if err := cmd.Start(); err != nil {
+			ld.sendPod(pod, v1.PodPending, v1.ContainerState{Waiting: &v1.ContainerStateWaiting{
+				Reason:  "CreateContainerError",
+				Message: err.Error(),
+			}})
+			return errors.Wrapf(err, "start local worker %s", w.name)
+		}
+		ld.sendPod(pod, v1.PodRunning, v1.ContainerState{Running: &v1.ContainerStateRunning{
+			StartedAt: metav1.Now(),
+		}})
+		err := cmd.Wait()
+		if ctx.Err() != nil {
+			return nil
+		}
+		ld.sendPod(pod, v1.PodFailed, v1.ContainerState{Terminated: &v1.ContainerStateTerminated{
+			ExitCode:   int32(cmd.ProcessState.ExitCode()),
+			Reason:     "Error",
+			FinishedAt: metav1.Now(),
+		}})
+		if err == nil {
+			err = errors.New("exited")
+		}
+		return errors.Wrapf(err, "local worker %s", w.name)
+	}, backoff.NewInfiniteBackOff(), backoff.NotifyCtx(ctx, "local worker"))
+}
+
+// localPod returns the pod that the kubeDriver would create for w.
+func localPod(rc *v1.ReplicationController, w *localWorker) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        w.name,
+			Labels:      rc.Labels,
+			Annotations: rc.Annotations,
+		},
+		Status: v1.PodStatus{
+			PodIP: w.ip,
+		},
+	}
+}
+
+// sendPod sends a pod event, with the user container in the given state, to
+// every watcher.
+func (ld *localDriver) sendPod(pod *v1.Pod, phase v1.PodPhase, state v1.ContainerState) {
+	pod = pod.DeepCopy()
+	pod.Status.Phase = phase
+	pod.Status.ContainerStatuses = []v1.ContainerStatus{{
+		Name:  client.PPSWorkerUserContainerName,
+		State: state,
+		Ready: state.Running != nil,
+	}}
+	ev := watch.Event{Type: watch.Modified, Object: pod}
+	ld.mu.Lock()
+	watchers := make(map[chan watch.Event]chan struct{}, len(ld.watchers))
+	for ch, done := range ld.watchers {
+		watchers[ch] = done
+	}
+	ld.mu.Unlock()
+	for ch, done := range watchers {
+		select {
+		case ch <- ev:
+		case <-done:
+		case <-ld.ctx.Done():
+		}
+	}
+}
@@ -0,0 +1,11 @@
+//go:build linux
+
+package server
+
+import "syscall"
+
+// localWorkerSysProcAttr has local workers killed when pachd exits, so that
+// they aren't left running without it.
+func localWorkerSysProcAttr() *syscall.SysProcAttr {
+	return &syscall.SysProcAttr{Pdeathsig: syscall.SIGKILL}
+}
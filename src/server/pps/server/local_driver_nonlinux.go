@@ -0,0 +1,11 @@
+//go:build !linux
+
+package server
+
+import "syscall"
+
+// On OSes other than Linux, there is no way to have local workers killed when
+// pachd exits.
+func localWorkerSysProcAttr() *syscall.SysProcAttr {
+	return nil
+}
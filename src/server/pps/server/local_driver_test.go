@@ -0,0 +1,131 @@
+//go:build linux
+
+package server
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/watch"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachconfig"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pctx"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+)
+
+func newTestLocalDriver(t *testing.T, binary string) (*localDriver, <-chan watch.Event) {
+	root := t.TempDir()
+	ld := newLocalDriver(pctx.TestContext(t), pachconfig.Configuration{
+		PachdSpecificConfiguration: &pachconfig.PachdSpecificConfiguration{
+			LocalWorkerBinary: binary,
+			LocalWorkerRoot:   root,
+		},
+	})
+	events, cancel, err := ld.WatchPipelinePods(pctx.TestContext(t))
+	require.NoError(t, err)
+	t.Cleanup(cancel)
+	return ld, events
+}
+
+func testLocalPipelineInfo() *pps.PipelineInfo {
+	return &pps.PipelineInfo{
+		Pipeline:   &pps.Pipeline{Project: &pfs.Project{Name: "default"}, Name: "edges"},
+		Version:    1,
+		SpecCommit: &pfs.Commit{Id: "0123456789abcdef"},
+		Details:    &pps.PipelineInfo_Details{Transform: &pps.Transform{Env: map[string]string{"A": "1"}}},
+	}
+}
+
+func scaleLocalPipeline(t *testing.T, ld *localDriver, pi *pps.PipelineInfo, n int32) {
+	ctx := pctx.TestContext(t)
+	rcs, err := ld.ReadReplicationController(ctx, pi)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(rcs.Items))
+	require.NoError(t, ld.UpdateReplicationController(ctx, &rcs.Items[0], func(rc *v1.ReplicationController) bool {
+		rc.Spec.Replicas = &n
+		return true
+	}))
+}
+
+func nextLocalPod(t *testing.T, events <-chan watch.Event) *v1.Pod {
+	select {
+	case ev := <-events:
+		return ev.Object.(*v1.Pod)
+	case <-time.After(30 * time.Second):
+		t.Fatal("timed out waiting for a pod event")
+	}
+	return nil
+}
+
+func TestLocalDriver(t *testing.T) {
+	binary := filepath.Join(t.TempDir(), "worker")
+	require.NoError(t, os.WriteFile(binary, []byte("#!/bin/sh\nenv > env\nexec sleep 600\n"), 0755))
+	ld, events := newTestLocalDriver(t, binary)
+	pi := testLocalPipelineInfo()
+	ctx := pctx.TestContext(t)
+	require.NoError(t, ld.CreatePipelineResources(ctx, pi))
+	rcs, err := ld.ListReplicationControllers(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(rcs.Items))
+	require.True(t, rcIsFresh(ctx, pi, &rcs.Items[0]))
+
+	scaleLocalPipeline(t, ld, pi, 2)
+	ips := make(map[string]bool)
+	var dirs []string
+	for i := 0; i < 2; i++ {
+		pod := nextLocalPod(t, events)
+		require.Equal(t, v1.PodRunning, pod.Status.Phase)
+		require.Equal(t, "edges", pod.Annotations[pipelineNameAnnotation])
+		require.Equal(t, "default", pod.Annotations[pipelineProjectAnnotation])
+		ips[pod.Status.PodIP] = true
+		dirs = append(dirs, filepath.Join(ld.root, pod.Name))
+	}
+	require.Equal(t, 2, len(ips), "workers have their own addresses")
+	for _, dir := range dirs {
+		require.NoErrorWithinTRetry(t, 10*time.Second, func() error {
+			env, err := os.ReadFile(filepath.Join(dir, "env"))
+			if err != nil {
+				return errors.EnsureStack(err)
+			}
+			for _, v := range []string{"A=1", "PPS_PIPELINE_NAME=edges", "PPS_SPEC_COMMIT=0123456789abcdef", "PPS_WORKER_ROOT=" + dir} {
+				if !slices.Contains(strings.Split(string(env), "\n"), v) {
+					return errors.Errorf("worker env is missing %q", v)
+				}
+			}
+			return nil
+		})
+	}
+
+	require.NoError(t, ld.DeletePipelineResources(ctx, pi.Pipeline))
+	for _, dir := range dirs {
+		require.NoErrorWithinTRetry(t, 20*time.Second, func() error {
+			if _, err := os.Stat(dir); !os.IsNotExist(err) {
+				return errors.Errorf("worker directory %s still exists", dir)
+			}
+			return nil
+		})
+	}
+	rcs, err = ld.ListReplicationControllers(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(rcs.Items))
+}
+
+func TestLocalDriverMissingBinary(t *testing.T) {
+	ld, events := newTestLocalDriver(t, filepath.Join(t.TempDir(), "missing"))
+	pi := testLocalPipelineInfo()
+	require.NoError(t, ld.CreatePipelineResources(pctx.TestContext(t), pi))
+	scaleLocalPipeline(t, ld, pi, 1)
+	pod := nextLocalPod(t, events)
+	require.Equal(t, 1, len(pod.Status.ContainerStatuses))
+	waiting := pod.Status.ContainerStatuses[0].State.Waiting
+	require.NotNil(t, waiting)
+	require.True(t, failures[waiting.Reason], "a worker that can't start crashes its pipeline")
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/log"
 	middleware_auth "github.com/pachyderm/pachyderm/v2/src/internal/middleware/auth"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachconfig"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pctx"
 	"github.com/pachyderm/pachyderm/v2/src/internal/ppsutil"
@@ -150,7 +151,7 @@ func (a *apiServer) master(ctx context.Context) {
 		}
 		defer masterLock.Unlock(ctx) //nolint:errcheck
 		log.Info(ctx, "PPS master: launching master process")
-		kd := newKubeDriver(a.env.KubeClient, a.env.Config)
+		kd := a.infraDriver()
 		sd := newPipelineStateDriver(a.env.DB, a.pipelines, a.txnEnv, a.env.PFSServer)
 		m := newMaster(ctx, a.env, a.etcdPrefix, kd, sd)
 		m.run()
@@ -162,6 +163,19 @@ func (a *apiServer) master(ctx context.Context) {
 	})
 }
 
+// infraDriver returns the InfraDriver that runs pipeline workers.  The local
+// driver owns the worker processes that it starts, so one is kept for the life
+// of pachd rather than made each time this pachd becomes the master.
+func (a *apiServer) infraDriver() InfraDriver {
+	if a.env.Config.PPSInfraDriver != pachconfig.LocalInfraDriver {
+		return newKubeDriver(a.env.KubeClient, a.env.Config)
+	}
+	a.localDriverOnce.Do(func() {
+		a.localDriver = newLocalDriver(a.env.BackgroundContext, a.env.Config)
+	})
+	return a.localDriver
+}
+
 func (m *ppsMaster) setPipelineCrashing(ctx context.Context, specCommit *pfs.Commit, reason string) error {
 	if err := m.sd.SetState(ctx, specCommit, pps.PipelineState_PIPELINE_CRASHING, reason); err != nil {
 		return errors.Wrapf(err, "failed to set pipeline to crashing state")
@@ -14,6 +14,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/cronutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/log"
+	"github.com/pachyderm/pachyderm/v2/src/internal/obj"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pctx"
 	"github.com/pachyderm/pachyderm/v2/src/internal/ppsutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/tracing"
@@ -23,6 +24,7 @@ import (
 	workerserver "github.com/pachyderm/pachyderm/v2/src/server/worker/server"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // startMonitor starts a new goroutine running monitorPipeline for
@@ -97,6 +99,15 @@ func (pc *pipelineController) monitorPipeline(ctx context.Context, pipelineInfo
 					backoff.NotifyCtx(cctx, "cron for "+in.Cron.Name))
 			})
 		}
+		if in.Bucket != nil {
+			eg.Go(func() error {
+				cctx := pctx.Child(ctx, "makeBucketCommits")
+				return backoff.RetryNotify(func() error {
+					return makeBucketCommits(cctx, pc.env, in)
+				}, backoff.NewInfiniteBackOff(),
+					backoff.NotifyCtx(cctx, "bucket for "+in.Bucket.Name))
+			})
+		}
 		return nil
 	})
 	if pipelineInfo.Details.Autoscaling {
@@ -392,3 +403,49 @@ func getLatestCronTime(ctx context.Context, env Env, in *pps.Input) (retTime tim
 	startTime := in.Cron.Start.AsTime()
 	return startTime, nil
 }
+
+// makeBucketCommits lists a single bucket input's bucket on every tick of its
+// spec, and commits a snapshot of the listing to the input's repo whenever it
+// changes.  It's a helper function called by monitorPipeline.
+func makeBucketCommits(ctx context.Context, env Env, in *pps.Input) error {
+	schedule, err := cronutil.ParseCronExpression(in.Bucket.Spec)
+	if err != nil {
+		return errors.EnsureStack(err) // Shouldn't happen, as the input is validated in CreatePipeline
+	}
+	url, err := obj.ParseURL(in.Bucket.Url)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	var secret map[string][]byte
+	if in.Bucket.Secret != "" {
+		s, err := env.KubeClient.CoreV1().Secrets(env.Config.Namespace).Get(ctx, in.Bucket.Secret, metav1.GetOptions{})
+		if err != nil {
+			return errors.Wrapf(err, "get secret %s of bucket %s", in.Bucket.Secret, in.Bucket.Url)
+		}
+		secret = s.Data
+	}
+	objClient, err := obj.NewBucketClient(ctx, url, secret)
+	if err != nil {
+		return errors.Wrapf(err, "create client for bucket %s", in.Bucket.Url)
+	}
+	pachClient := env.GetPachClient(ctx)
+	for {
+		committed, err := ppsutil.SnapshotBucket(ctx, pachClient, objClient, in.Bucket)
+		if err != nil {
+			return errors.Wrap(err, "SnapshotBucket")
+		}
+		if committed {
+			log.Info(ctx, "bucket snapshot committed", zap.String("url", in.Bucket.Url))
+		}
+		next := schedule.Next(time.Now())
+		if next.IsZero() {
+			log.Debug(ctx, "no more scheduled polls; exiting loop")
+			return nil
+		}
+		select {
+		case <-time.After(time.Until(next)):
+		case <-ctx.Done():
+			return errors.EnsureStack(context.Cause(ctx))
+		}
+	}
+}
@@ -59,6 +59,8 @@ const (
 	StorageMaxOpenFileSetsEnvVar               = "STORAGE_FILESETS_MAX_OPEN"
 	StorageDiskCacheSizeEnvVar                 = "STORAGE_DISK_CACHE_SIZE"
 	StorageMemoryCacheSizeEnvVar               = "STORAGE_MEMORY_CACHE_SIZE"
+	StorageCompressionEnvVar                   = "STORAGE_COMPRESSION"
+	StorageKeyDirEnvVar                        = "STORAGE_KEY_DIR"
 	SidecarMemoryRequestEnvVar                 = "K8S_MEMORY_REQUEST"
 	SidecarMemoryLimitEnvVar                   = "K8S_MEMORY_LIMIT"
 )
@@ -125,6 +127,23 @@ func getTLSCertSecretVolumeAndMount(secret, mountPath string) (v1.Volume, v1.Vol
 		}
 }
 
+// getStorageKeySecretVolumeAndMount returns a Volume and VolumeMount object
+// configured for the secret holding the storage key encryption keys.
+func getStorageKeySecretVolumeAndMount(secret, mountPath string) (v1.Volume, v1.VolumeMount) {
+	return v1.Volume{
+			Name: "storage-keys",
+			VolumeSource: v1.VolumeSource{
+				Secret: &v1.SecretVolumeSource{
+					SecretName: secret,
+				},
+			},
+		}, v1.VolumeMount{
+			Name:      "storage-keys",
+			MountPath: mountPath,
+			ReadOnly:  true,
+		}
+}
+
 func (kd *kubeDriver) workerPodSpec(ctx context.Context, options *workerOptions, pipelineInfo *pps.PipelineInfo) (v1.PodSpec, error) {
 	pullPolicy := kd.config.WorkerImagePullPolicy
 	if pullPolicy == "" {
@@ -351,6 +370,13 @@ func (kd *kubeDriver) workerPodSpec(ctx context.Context, options *workerOptions,
 		sidecarVolumeMounts = append(sidecarVolumeMounts, certSecretMount)
 	}
 
+	// sidecars create chunks, so they need the key encryption keys as well
+	if kd.config.StorageKeySecretName != "" && kd.config.StorageKeyDir != "" {
+		keyVolume, keyMount := getStorageKeySecretVolumeAndMount(kd.config.StorageKeySecretName, kd.config.StorageKeyDir)
+		options.volumes = append(options.volumes, keyVolume)
+		sidecarVolumeMounts = append(sidecarVolumeMounts, keyMount)
+	}
+
 	// mount secret for spouts using pachctl
 	if pipelineInfo.Details.Spout != nil {
 		pachctlSecretVolume, pachctlSecretMount := getPachctlSecretVolumeAndMount(spoutSecretName(pipelineInfo.Pipeline))
@@ -627,6 +653,18 @@ func (kd *kubeDriver) getStorageEnvVars(pipelineInfo *pps.PipelineInfo) []v1.Env
 			Value: strconv.FormatInt(int64(kd.config.StorageMemoryCacheSize), 10),
 		})
 	}
+	if kd.config.StorageCompression != "none" {
+		vars = append(vars, v1.EnvVar{
+			Name:  StorageCompressionEnvVar,
+			Value: kd.config.StorageCompression,
+		})
+	}
+	if kd.config.StorageKeyDir != "" {
+		vars = append(vars, v1.EnvVar{
+			Name:  StorageKeyDirEnvVar,
+			Value: kd.config.StorageKeyDir,
+		})
+	}
 	return vars
 }
 
@@ -791,6 +829,32 @@ func (kd *kubeDriver) getWorkerOptions(ctx context.Context, pipelineInfo *pps.Pi
 		}
 	}
 
+	// Workers fetch the objects of bucket inputs with the credentials in their
+	// secrets.
+	bucketSecrets := make(map[string]bool)
+	pps.VisitInput(pipelineInfo.Details.Input, func(in *pps.Input) error { //nolint:errcheck
+		if in.Bucket.GetSecret() == "" || bucketSecrets[in.Bucket.Secret] {
+			return nil
+		}
+		// Secret names may not be valid volume names.
+		name := fmt.Sprintf("bucket-secret-%d", len(bucketSecrets))
+		bucketSecrets[in.Bucket.Secret] = true
+		volumes = append(volumes, v1.Volume{
+			Name: name,
+			VolumeSource: v1.VolumeSource{
+				Secret: &v1.SecretVolumeSource{
+					SecretName: in.Bucket.Secret,
+				},
+			},
+		})
+		volumeMounts = append(volumeMounts, v1.VolumeMount{
+			Name:      name,
+			MountPath: ppsutil.BucketSecretDir(in.Bucket),
+			ReadOnly:  true,
+		})
+		return nil
+	})
+
 	volumes = append(volumes, v1.Volume{
 		Name: "pach-bin",
 		VolumeSource: v1.VolumeSource{
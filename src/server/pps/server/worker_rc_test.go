@@ -73,6 +73,9 @@ func mergeDefaultOptions(project, name string, add *workerOptions) *workerOption
 		base.resourceRequests = r
 	}
 	base.tolerations = append(base.tolerations, add.tolerations...)
+	// Volumes from the pipeline spec come before the defaults.
+	base.volumes = append(add.volumes, base.volumes...)
+	base.volumeMounts = append(add.volumeMounts, base.volumeMounts...)
 	return base
 }
 
@@ -194,6 +197,39 @@ func TestGetWorkerOptions(t *testing.T) {
 				},
 			}),
 		},
+		{
+			name: "bucket secrets",
+			pipeline: &pps.PipelineInfo{
+				Pipeline: &pps.Pipeline{
+					Project: &pfs.Project{
+						Name: "default",
+					},
+					Name: "buckets",
+				},
+				Details: &pps.PipelineInfo_Details{
+					Transform: &pps.Transform{},
+					Input: &pps.Input{
+						Cross: []*pps.Input{
+							{Bucket: &pps.BucketInput{Name: "a", Url: "s3://a/prefix", Secret: "s3.creds"}},
+							{Bucket: &pps.BucketInput{Name: "b", Url: "s3://b/prefix", Secret: "s3.creds"}},
+							{Bucket: &pps.BucketInput{Name: "c", Url: "local://tmp/prefix"}},
+						},
+					},
+				},
+				SpecCommit: &pfs.Commit{},
+			},
+			wantOptions: mergeDefaultOptions("default", "buckets", &workerOptions{
+				volumes: []v1.Volume{
+					{
+						Name:         "bucket-secret-0",
+						VolumeSource: v1.VolumeSource{Secret: &v1.SecretVolumeSource{SecretName: "s3.creds"}},
+					},
+				},
+				volumeMounts: []v1.VolumeMount{
+					{Name: "bucket-secret-0", MountPath: "/pach-bucket-secrets/s3.creds", ReadOnly: true},
+				},
+			}),
+		},
 		{
 			name: "invalid toleration",
 			pipeline: &pps.PipelineInfo{
@@ -0,0 +1,283 @@
+package cmds
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/cmdutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachctl"
+	"github.com/pachyderm/pachyderm/v2/src/internal/tabwriter"
+	"github.com/pachyderm/pachyderm/v2/src/server/webhook/pretty"
+	"github.com/pachyderm/pachyderm/v2/src/webhook"
+)
+
+// Cmds returns the set of commands used for managing webhooks with the Pachyderm CLI tool
+// pachctl.
+func Cmds(mainCtx context.Context, pachctlCfg *pachctl.Config) []*cobra.Command {
+	var commands []*cobra.Command
+
+	var raw bool
+	var output string
+	outputFlags := cmdutil.OutputFlags(&raw, &output)
+
+	webhookDocs := &cobra.Command{
+		Short: "Webhooks notify HTTP endpoints of PFS and PPS events.",
+		Long: "A webhook is an HTTP endpoint that is sent a JSON POST request whenever an event it subscribes to happens: " +
+			"a commit finishing, a job failing or a pipeline changing state. " +
+			"Deliveries that fail are retried with backoff, and every delivery is kept in the delivery history for a while. " +
+			"Managing webhooks requires the webhookAdmin role.",
+	}
+	commands = append(commands, cmdutil.CreateDocsAlias(webhookDocs, "webhook", " webhook(-delivery)?$"))
+
+	var url, secretFile, project, repo, pipeline string
+	var events []string
+	var update bool
+	createWebhook := &cobra.Command{
+		Use:   "{{alias}} <name>",
+		Short: "Create a webhook.",
+		Long: "This command creates a webhook, which is sent every event of the given types that matches its filters. " +
+			"The events are " + strings.Join(eventNames(), ", ") + ". " +
+			"`--repo` only filters commit events and `--pipeline` only filters job and pipeline events. " +
+			"If a secret is given, each request carries an X-Pachyderm-Signature header of the form " +
+			"`sha256=<hex HMAC-SHA256 of the body>` so that the endpoint can verify it came from Pachyderm.",
+		Example: `
+# Notify an endpoint whenever a commit to images@master finishes
+$ {{alias}} images-ci --url https://ci.example.com/hooks/pachyderm --event commit-finished --repo images@master
+
+# Notify an endpoint of failed jobs and pipeline state changes, signing each request
+$ {{alias}} alerts --url https://alerts.example.com/pachyderm --event job-failed --event pipeline-state-changed --secret-file secret.txt`,
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			req := &webhook.CreateWebhookRequest{
+				Name:     args[0],
+				Url:      url,
+				Project:  project,
+				Pipeline: pipeline,
+				Update:   update,
+			}
+			for _, name := range events {
+				e, err := parseEvent(name)
+				if err != nil {
+					return err
+				}
+				req.Events = append(req.Events, e)
+			}
+			if repo != "" {
+				req.Repo, req.Branch, _ = strings.Cut(repo, "@")
+			}
+			if secretFile != "" {
+				secret, err := readSecret(secretFile)
+				if err != nil {
+					return err
+				}
+				req.Secret = secret
+			}
+			c, err := pachctlCfg.NewOnUserMachine(mainCtx, false)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			if _, err := c.Webhook.CreateWebhook(c.Ctx(), req); err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			return nil
+		}),
+	}
+	createWebhook.Flags().StringVar(&url, "url", "", "The HTTP or HTTPS endpoint to POST events to.")
+	createWebhook.Flags().StringArrayVar(&events, "event", nil, "An event type to subscribe to; may be given more than once.")
+	createWebhook.Flags().StringVar(&secretFile, "secret-file", "", "A file containing the secret that requests are signed with.  \"-\" reads from stdin.")
+	createWebhook.Flags().StringVar(&project, "project", "", "Only send events in this project.")
+	createWebhook.Flags().StringVar(&repo, "repo", "", "Only send commit events for this repo, given as repo or repo@branch.")
+	createWebhook.Flags().StringVar(&pipeline, "pipeline", "", "Only send job and pipeline events for this pipeline.")
+	createWebhook.Flags().BoolVar(&update, "update", false, "Replace the webhook if it already exists, keeping its delivery history.")
+	commands = append(commands, cmdutil.CreateAlias(createWebhook, "create webhook"))
+
+	inspectWebhook := &cobra.Command{
+		Use:   "{{alias}} <name>",
+		Short: "Return info about a webhook.",
+		Long:  "This command returns info about a webhook.  Its secret is never returned.",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			c, err := pachctlCfg.NewOnUserMachine(mainCtx, false)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			info, err := c.Webhook.InspectWebhook(c.Ctx(), &webhook.InspectWebhookRequest{Name: args[0]})
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			if raw {
+				return errors.EnsureStack(cmdutil.Encoder(output, os.Stdout).EncodeProto(info))
+			} else if output != "" {
+				return errors.New("cannot set --output (-o) without --raw")
+			}
+			pretty.PrintDetailedWebhookInfo(os.Stdout, info)
+			return nil
+		}),
+	}
+	inspectWebhook.Flags().AddFlagSet(outputFlags)
+	commands = append(commands, cmdutil.CreateAlias(inspectWebhook, "inspect webhook"))
+
+	listWebhook := &cobra.Command{
+		Short: "Return all webhooks.",
+		Long:  "This command returns all webhooks.",
+		Run: cmdutil.RunFixedArgs(0, func([]string) error {
+			if !raw && output != "" {
+				return errors.New("cannot set --output (-o) without --raw")
+			}
+			c, err := pachctlCfg.NewOnUserMachine(mainCtx, false)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			webhooks, err := c.Webhook.ListWebhook(c.Ctx(), &webhook.ListWebhookRequest{})
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			encoder := cmdutil.Encoder(output, os.Stdout)
+			writer := tabwriter.NewWriter(os.Stdout, pretty.WebhookHeader)
+			for {
+				info, err := webhooks.Recv()
+				if errors.Is(err, io.EOF) {
+					break
+				} else if err != nil {
+					return grpcutil.ScrubGRPC(err)
+				}
+				if raw {
+					if err := encoder.EncodeProto(info); err != nil {
+						return errors.EnsureStack(err)
+					}
+					continue
+				}
+				pretty.PrintWebhookInfo(writer, info)
+			}
+			if raw {
+				return nil
+			}
+			return writer.Flush()
+		}),
+	}
+	listWebhook.Flags().AddFlagSet(outputFlags)
+	commands = append(commands, cmdutil.CreateAlias(listWebhook, "list webhook"))
+
+	deleteWebhook := &cobra.Command{
+		Use:   "{{alias}} <name>",
+		Short: "Delete a webhook.",
+		Long:  "This command deletes a webhook, along with its pending deliveries and its delivery history.",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			c, err := pachctlCfg.NewOnUserMachine(mainCtx, false)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			if _, err := c.Webhook.DeleteWebhook(c.Ctx(), &webhook.DeleteWebhookRequest{Name: args[0]}); err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			return nil
+		}),
+	}
+	commands = append(commands, cmdutil.CreateAlias(deleteWebhook, "delete webhook"))
+
+	var limit int64
+	listDelivery := &cobra.Command{
+		Use:   "{{alias}} [<webhook>]",
+		Short: "Return the webhook delivery history.",
+		Long: "This command returns the deliveries of events to a webhook, or to every webhook if none is given, newest first. " +
+			"Pending deliveries are retried with backoff until they succeed or run out of attempts.",
+		Example: `
+# Return the last 100 deliveries to every webhook
+$ {{alias}}
+
+# Return every delivery to the alerts webhook, as JSON
+$ {{alias}} alerts --limit 0 --raw`,
+		Run: cmdutil.RunBoundedArgs(0, 1, func(args []string) error {
+			if !raw && output != "" {
+				return errors.New("cannot set --output (-o) without --raw")
+			}
+			req := &webhook.ListDeliveryRequest{Limit: limit}
+			if len(args) > 0 {
+				req.Webhook = args[0]
+			}
+			c, err := pachctlCfg.NewOnUserMachine(mainCtx, false)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			deliveries, err := c.Webhook.ListDelivery(c.Ctx(), req)
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			encoder := cmdutil.Encoder(output, os.Stdout)
+			writer := tabwriter.NewWriter(os.Stdout, pretty.DeliveryHeader)
+			for {
+				d, err := deliveries.Recv()
+				if errors.Is(err, io.EOF) {
+					break
+				} else if err != nil {
+					return grpcutil.ScrubGRPC(err)
+				}
+				if raw {
+					if err := encoder.EncodeProto(d); err != nil {
+						return errors.EnsureStack(err)
+					}
+					continue
+				}
+				pretty.PrintDelivery(writer, d)
+			}
+			if raw {
+				return nil
+			}
+			return writer.Flush()
+		}),
+	}
+	listDelivery.Flags().Int64Var(&limit, "limit", 100, "Return at most this many deliveries. 0 returns every delivery in the history.")
+	listDelivery.Flags().AddFlagSet(outputFlags)
+	commands = append(commands, cmdutil.CreateAlias(listDelivery, "list webhook-delivery"))
+
+	return commands
+}
+
+// eventNames returns the names of the event types that can be subscribed to.
+func eventNames() []string {
+	var names []string
+	for i := int32(1); i < int32(len(webhook.EventType_name)); i++ {
+		names = append(names, pretty.EventName(webhook.EventType(i)))
+	}
+	return names
+}
+
+// parseEvent parses an event type given as in pretty.EventName, e.g. job-failed.
+func parseEvent(name string) (webhook.EventType, error) {
+	v, ok := webhook.EventType_value[strings.ToUpper(strings.ReplaceAll(name, "-", "_"))]
+	if !ok || v == int32(webhook.EventType_EVENT_TYPE_UNKNOWN) {
+		return 0, errors.Errorf("unknown event type %q; the event types are %s", name, strings.Join(eventNames(), ", "))
+	}
+	return webhook.EventType(v), nil
+}
+
+// readSecret reads a secret from a file, or from stdin if path is "-".  Surrounding whitespace,
+// such as a trailing newline, is removed.
+func readSecret(path string) (string, error) {
+	var data []byte
+	var err error
+	if path == "-" {
+		fmt.Fprintln(os.Stderr, "Reading webhook secret from stdin.")
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return "", errors.Wrapf(err, "read secret from %q", path)
+	}
+	secret := strings.TrimSpace(string(data))
+	if secret == "" {
+		return "", errors.Errorf("secret file %q is empty", path)
+	}
+	return secret, nil
+}
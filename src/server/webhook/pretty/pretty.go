@@ -0,0 +1,100 @@
+package pretty
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/pretty"
+	"github.com/pachyderm/pachyderm/v2/src/webhook"
+)
+
+const (
+	// WebhookHeader is the header for webhooks.
+	WebhookHeader = "NAME\tURL\tEVENTS\tFILTER\tCREATED\t\n"
+	// DeliveryHeader is the header for webhook deliveries.
+	DeliveryHeader = "ID\tWEBHOOK\tEVENT\tSTATE\tATTEMPTS\tLAST STATUS\tCREATED\t\n"
+)
+
+// EventName returns the name of an event type as it is given to pachctl, e.g. commit-finished.
+func EventName(e webhook.EventType) string {
+	return strings.ReplaceAll(strings.ToLower(e.String()), "_", "-")
+}
+
+func eventNames(events []webhook.EventType) string {
+	var names []string
+	for _, e := range events {
+		names = append(names, EventName(e))
+	}
+	return strings.Join(names, ",")
+}
+
+// filter summarizes the filters of a webhook, e.g. "default/images@master".
+func filter(w *webhook.WebhookInfo) string {
+	var parts []string
+	if w.Repo != "" {
+		repo := w.Repo
+		if w.Project != "" {
+			repo = w.Project + "/" + repo
+		}
+		if w.Branch != "" {
+			repo += "@" + w.Branch
+		}
+		parts = append(parts, "repo="+repo)
+	} else if w.Project != "" {
+		parts = append(parts, "project="+w.Project)
+	}
+	if w.Pipeline != "" {
+		parts = append(parts, "pipeline="+w.Pipeline)
+	}
+	if len(parts) == 0 {
+		return "-"
+	}
+	return strings.Join(parts, " ")
+}
+
+// PrintWebhookInfo prints a short summary of a webhook to the provided device.
+func PrintWebhookInfo(w io.Writer, info *webhook.WebhookInfo) {
+	fmt.Fprintf(w, "%s\t", info.Name)
+	fmt.Fprintf(w, "%s\t", info.Url)
+	fmt.Fprintf(w, "%s\t", eventNames(info.Events))
+	fmt.Fprintf(w, "%s\t", filter(info))
+	fmt.Fprintf(w, "%s\t", pretty.Ago(info.Created))
+	fmt.Fprintln(w)
+}
+
+// PrintDetailedWebhookInfo prints the details of a webhook to the provided device.
+func PrintDetailedWebhookInfo(w io.Writer, info *webhook.WebhookInfo) {
+	fmt.Fprintf(w, "Name: %s\n", info.Name)
+	fmt.Fprintf(w, "URL: %s\n", info.Url)
+	fmt.Fprintf(w, "Events: %s\n", eventNames(info.Events))
+	fmt.Fprintf(w, "Filter: %s\n", filter(info))
+	fmt.Fprintf(w, "Signed: %t\n", info.Signed)
+	fmt.Fprintf(w, "Created: %s\n", pretty.Ago(info.Created))
+}
+
+// PrintDelivery prints a short summary of a webhook delivery to the provided device.
+func PrintDelivery(w io.Writer, d *webhook.Delivery) {
+	fmt.Fprintf(w, "%d\t", d.Id)
+	fmt.Fprintf(w, "%s\t", d.Webhook)
+	fmt.Fprintf(w, "%s\t", EventName(d.Event.GetType()))
+	fmt.Fprintf(w, "%s\t", strings.ToLower(strings.TrimPrefix(d.State.String(), "DELIVERY_")))
+	fmt.Fprintf(w, "%d\t", d.Attempts)
+	fmt.Fprintf(w, "%s\t", lastStatus(d))
+	fmt.Fprintf(w, "%s\t", pretty.Ago(d.Created))
+	fmt.Fprintln(w)
+}
+
+// lastStatus describes the result of the last attempt to make a delivery.
+func lastStatus(d *webhook.Delivery) string {
+	switch {
+	case d.Attempts == 0:
+		return "-"
+	case d.LastStatusCode != 0:
+		return strconv.FormatInt(d.LastStatusCode, 10)
+	case d.LastError != "":
+		return d.LastError
+	}
+	return "-"
+}
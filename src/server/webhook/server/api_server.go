@@ -0,0 +1,104 @@
+// Package server implements the webhook API, which manages webhooks and serves their delivery
+// history, and the Dispatcher, which delivers events to them.
+package server
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+	"github.com/pachyderm/pachyderm/v2/src/internal/webhookdb"
+	"github.com/pachyderm/pachyderm/v2/src/webhook"
+)
+
+type apiServer struct {
+	webhook.UnsafeAPIServer
+	env Env
+}
+
+// NewAPIServer creates a new webhook API server.
+func NewAPIServer(env Env) webhook.APIServer {
+	return &apiServer{env: env}
+}
+
+// CreateWebhook implements the webhook.CreateWebhook RPC.
+func (a *apiServer) CreateWebhook(ctx context.Context, req *webhook.CreateWebhookRequest) (*emptypb.Empty, error) {
+	if err := validateWebhook(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := dbutil.WithTx(ctx, a.env.DB, func(ctx context.Context, tx *pachsql.Tx) error {
+		return webhookdb.PutWebhook(ctx, tx, webhookdb.FromRequest(req, time.Now()), req.Update)
+	}); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func validateWebhook(req *webhook.CreateWebhookRequest) error {
+	if req.Name == "" {
+		return errors.New("webhook name must be set")
+	}
+	u, err := url.Parse(req.Url)
+	if err != nil {
+		return errors.Wrapf(err, "parse webhook url %q", req.Url)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errors.Errorf("webhook url %q must be an absolute http or https url", req.Url)
+	}
+	if len(req.Events) == 0 {
+		return errors.New("a webhook must subscribe to at least one event")
+	}
+	for _, e := range req.Events {
+		if _, ok := webhook.EventType_name[int32(e)]; !ok || e == webhook.EventType_EVENT_TYPE_UNKNOWN {
+			return errors.Errorf("unknown event type %v", e)
+		}
+	}
+	if req.Branch != "" && req.Repo == "" {
+		return errors.New("a webhook that filters on branch must also filter on repo")
+	}
+	return nil
+}
+
+// InspectWebhook implements the webhook.InspectWebhook RPC.
+func (a *apiServer) InspectWebhook(ctx context.Context, req *webhook.InspectWebhookRequest) (*webhook.WebhookInfo, error) {
+	w, err := webhookdb.GetWebhook(ctx, a.env.DB, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	return w.ToProto(), nil
+}
+
+// ListWebhook implements the webhook.ListWebhook RPC.
+func (a *apiServer) ListWebhook(req *webhook.ListWebhookRequest, srv webhook.API_ListWebhookServer) error {
+	return webhookdb.ListWebhooks(srv.Context(), a.env.DB, func(w *webhookdb.Webhook) error {
+		return errors.EnsureStack(srv.Send(w.ToProto()))
+	})
+}
+
+// DeleteWebhook implements the webhook.DeleteWebhook RPC.
+func (a *apiServer) DeleteWebhook(ctx context.Context, req *webhook.DeleteWebhookRequest) (*emptypb.Empty, error) {
+	if err := dbutil.WithTx(ctx, a.env.DB, func(ctx context.Context, tx *pachsql.Tx) error {
+		return webhookdb.DeleteWebhook(ctx, tx, req.Name)
+	}); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// ListDelivery implements the webhook.ListDelivery RPC.
+func (a *apiServer) ListDelivery(req *webhook.ListDeliveryRequest, srv webhook.API_ListDeliveryServer) error {
+	return webhookdb.ListDeliveries(srv.Context(), a.env.DB, req.Webhook, req.Limit, func(d *webhookdb.Delivery) error {
+		pb, err := d.ToProto()
+		if err != nil {
+			return err
+		}
+		return errors.EnsureStack(srv.Send(pb))
+	})
+}
@@ -0,0 +1,23 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/webhook"
+)
+
+func TestValidateWebhook(t *testing.T) {
+	events := []webhook.EventType{webhook.EventType_COMMIT_FINISHED}
+	require.NoError(t, validateWebhook(&webhook.CreateWebhookRequest{Name: "a", Url: "https://example.com/hook", Events: events}))
+	for _, req := range []*webhook.CreateWebhookRequest{
+		{Url: "https://example.com/hook", Events: events},
+		{Name: "a", Url: "example.com/hook", Events: events},
+		{Name: "a", Url: "ftp://example.com/hook", Events: events},
+		{Name: "a", Url: "https://example.com/hook"},
+		{Name: "a", Url: "https://example.com/hook", Events: []webhook.EventType{webhook.EventType_EVENT_TYPE_UNKNOWN}},
+		{Name: "a", Url: "https://example.com/hook", Events: events, Branch: "master"},
+	} {
+		require.YesError(t, validateWebhook(req), "%v", req)
+	}
+}
@@ -0,0 +1,194 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/backoff"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/log"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+	"github.com/pachyderm/pachyderm/v2/src/internal/webhookdb"
+	"github.com/pachyderm/pachyderm/v2/src/webhook"
+)
+
+// The headers set on every delivery.
+const (
+	DeliveryHeader  = "X-Pachyderm-Delivery"
+	EventHeader     = "X-Pachyderm-Event"
+	SignatureHeader = "X-Pachyderm-Signature"
+)
+
+const (
+	dispatchBatchSize   = 100
+	dispatchParallelism = 8
+	// maxResponseBytes is how much of a failed response's body is kept as its error.
+	maxResponseBytes = 512
+)
+
+// DispatcherConfig configures a Dispatcher.
+type DispatcherConfig struct {
+	// Period is how often due deliveries are looked for.
+	Period time.Duration
+	// Timeout bounds each attempt.
+	Timeout time.Duration
+	// MaxAttempts is the number of attempts made before a delivery fails.
+	MaxAttempts int64
+	// History is how long finished deliveries are kept.
+	History time.Duration
+}
+
+// Dispatcher delivers pending webhook events.  Only one Dispatcher should run at a time.
+type Dispatcher struct {
+	db     *pachsql.DB
+	client *http.Client
+	config DispatcherConfig
+}
+
+// NewDispatcher creates a Dispatcher that delivers the events enqueued in db.
+func NewDispatcher(db *pachsql.DB, config DispatcherConfig) *Dispatcher {
+	return &Dispatcher{
+		db:     db,
+		client: &http.Client{Timeout: config.Timeout},
+		config: config,
+	}
+}
+
+// RunForever delivers due events, and prunes the delivery history, every period until ctx is
+// done.
+func (d *Dispatcher) RunForever(ctx context.Context) error {
+	ticker := time.NewTicker(d.config.Period)
+	defer ticker.Stop()
+	for {
+		if err := d.RunOnce(ctx); err != nil {
+			select {
+			case <-ctx.Done():
+				return err
+			default:
+			}
+			log.Error(ctx, "error delivering webhook events", zap.Error(err))
+		}
+		select {
+		case <-ctx.Done():
+			return errors.EnsureStack(context.Cause(ctx))
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce prunes the delivery history, then attempts every delivery that is due.
+func (d *Dispatcher) RunOnce(ctx context.Context) error {
+	if d.config.History > 0 {
+		n, err := webhookdb.PruneDeliveries(ctx, d.db, time.Now().Add(-d.config.History))
+		if err != nil {
+			return err
+		}
+		if n > 0 {
+			log.Debug(ctx, "pruned webhook delivery history", zap.Int64("deliveries", n))
+		}
+	}
+	for {
+		due, err := webhookdb.ListDue(ctx, d.db, time.Now(), dispatchBatchSize)
+		if err != nil {
+			return err
+		}
+		eg, ctx := errgroup.WithContext(ctx)
+		eg.SetLimit(dispatchParallelism)
+		for _, pd := range due {
+			pd := pd
+			eg.Go(func() error {
+				return webhookdb.RecordAttempt(ctx, d.db, pd.ID, d.attempt(ctx, pd), time.Now())
+			})
+		}
+		if err := eg.Wait(); err != nil {
+			return errors.EnsureStack(err)
+		}
+		if len(due) < dispatchBatchSize {
+			return nil
+		}
+	}
+}
+
+// attempt makes one attempt to deliver pd, and decides when to retry it if the attempt fails.
+func (d *Dispatcher) attempt(ctx context.Context, pd *webhookdb.PendingDelivery) webhookdb.Attempt {
+	code, err := d.post(ctx, pd)
+	a := webhookdb.Attempt{StatusCode: int64(code), Err: err}
+	if err != nil {
+		log.Info(ctx, "webhook delivery attempt failed", zap.String("webhook", pd.Webhook), zap.Int64("delivery", pd.ID), zap.Int64("attempts", pd.Attempts+1), zap.Error(err))
+		if pd.Attempts+1 < d.config.MaxAttempts {
+			a.Retry = time.Now().Add(RetryDelay(pd.Attempts + 1))
+		}
+	}
+	return a
+}
+
+// post sends the payload of pd to its webhook, and returns the status code of the response.  A
+// response with a non-2xx status is an error.
+func (d *Dispatcher) post(ctx context.Context, pd *webhookdb.PendingDelivery) (int, error) {
+	body := []byte(pd.Payload)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, pd.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, errors.Wrap(err, "create request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Pachyderm-Webhook")
+	req.Header.Set(DeliveryHeader, strconv.FormatInt(pd.ID, 10))
+	req.Header.Set(EventHeader, eventType(body))
+	if pd.Secret != "" {
+		req.Header.Set(SignatureHeader, Sign(pd.Secret, body))
+	}
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, errors.EnsureStack(err)
+	}
+	defer resp.Body.Close()
+	msg, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseBytes))
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, errors.Errorf("%s: %s", resp.Status, bytes.TrimSpace(msg))
+	}
+	return resp.StatusCode, nil
+}
+
+// eventType returns the type of the event in payload, as set in the X-Pachyderm-Event header.
+func eventType(payload []byte) string {
+	e := &webhook.Event{}
+	if err := protojson.Unmarshal(payload, e); err != nil {
+		return webhook.EventType_EVENT_TYPE_UNKNOWN.String()
+	}
+	return e.Type.String()
+}
+
+// Sign returns the signature of body, as set in the X-Pachyderm-Signature header: "sha256="
+// followed by the hex-encoded HMAC-SHA256 of body, keyed with secret.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// RetryDelay returns how long to wait before retrying a delivery that has failed 'attempts'
+// times.  It grows exponentially, with jitter, from ten seconds to an hour.
+func RetryDelay(attempts int64) time.Duration {
+	b := backoff.NewExponentialBackOff()
+	b.InitialInterval = 10 * time.Second
+	b.Multiplier = 2
+	b.MaxInterval = time.Hour
+	b.MaxElapsedTime = 0
+	b.Reset()
+	var delay time.Duration
+	for i := int64(0); i < attempts; i++ {
+		delay = b.NextBackOff()
+	}
+	return delay
+}
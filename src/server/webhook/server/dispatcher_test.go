@@ -0,0 +1,77 @@
+package server
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/internal/webhookdb"
+	"github.com/pachyderm/pachyderm/v2/src/webhook"
+)
+
+func TestSign(t *testing.T) {
+	// From RFC 4231, test case 2.
+	require.Equal(t,
+		"sha256=5bdcc146bf60754e6a042426089575c75a003f089d2739839dec58b964ec3843",
+		Sign("Jefe", []byte("what do ya want for nothing?")))
+}
+
+func TestRetryDelay(t *testing.T) {
+	// The delay is 10s * 2^(attempts-1), +/- 50%, up to an hour.
+	for attempts, base := range map[int64]time.Duration{
+		1:  10 * time.Second,
+		2:  20 * time.Second,
+		5:  160 * time.Second,
+		20: time.Hour,
+	} {
+		d := RetryDelay(attempts)
+		require.True(t, d >= base/2 && d <= base*3/2+1, "attempt %d: %v", attempts, d)
+	}
+}
+
+func TestPost(t *testing.T) {
+	payload, err := protojson.Marshal(&webhook.Event{Type: webhook.EventType_JOB_FAILED})
+	require.NoError(t, err)
+	var status int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.Equal(t, string(payload), string(body))
+		require.Equal(t, "JOB_FAILED", r.Header.Get(EventHeader))
+		require.Equal(t, "7", r.Header.Get(DeliveryHeader))
+		require.Equal(t, Sign("secret", body), r.Header.Get(SignatureHeader))
+		w.WriteHeader(status)
+		w.Write([]byte("no thanks\n")) //nolint:errcheck
+	}))
+	defer srv.Close()
+	d := NewDispatcher(nil, DispatcherConfig{Timeout: time.Minute, MaxAttempts: 2})
+	pd := &webhookdb.PendingDelivery{
+		Delivery: webhookdb.Delivery{ID: 7, Webhook: "w", Payload: string(payload)},
+		URL:      srv.URL,
+		Secret:   "secret",
+	}
+	ctx := context.Background()
+
+	status = http.StatusNoContent
+	a := d.attempt(ctx, pd)
+	require.NoError(t, a.Err)
+	require.Equal(t, int64(http.StatusNoContent), a.StatusCode)
+
+	// A failed attempt is retried until it runs out of attempts.
+	status = http.StatusServiceUnavailable
+	a = d.attempt(ctx, pd)
+	require.YesError(t, a.Err)
+	require.Matches(t, "no thanks", a.Err.Error())
+	require.Equal(t, int64(http.StatusServiceUnavailable), a.StatusCode)
+	require.False(t, a.Retry.IsZero())
+	pd.Attempts = 1
+	a = d.attempt(ctx, pd)
+	require.YesError(t, a.Err)
+	require.True(t, a.Retry.IsZero())
+}
@@ -0,0 +1,10 @@
+package server
+
+import (
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+)
+
+// Env is the set of dependencies required by a webhook API server.
+type Env struct {
+	DB *pachsql.DB
+}
@@ -29,6 +29,13 @@ const (
 	TTL         = 15 * time.Minute
 )
 
+// DatumOutputCacheTag returns the tag of the datum output cache entries that
+// hold the output of the datum with the given ID in a pipeline of project.
+// PFS clears them when it purges the datum.
+func DatumOutputCacheTag(project, id string) string {
+	return path.Join("datum-output", project, id) + "/"
+}
+
 func MetaFilePath(id string) string {
 	return path.Join(MetaPrefix, id, MetaFileName)
 }
@@ -46,6 +53,12 @@ func DatumID(inputs []*Input) string {
 		_ = binary.Write(hash, binary.BigEndian, int64(len(input.Branch)))
 		hash.Write([]byte(input.FileInfo.File.Path))
 		_ = binary.Write(hash, binary.BigEndian, int64(len(input.FileInfo.File.Path)))
+		// A windowed input has a version of the file from each commit in
+		// its window.
+		if input.Window {
+			hash.Write([]byte(file.Commit.Id))
+			_ = binary.Write(hash, binary.BigEndian, int64(len(file.Commit.Id)))
+		}
 	}
 	return hex.EncodeToString(hash.Sum(nil))
 }
@@ -34,7 +34,9 @@ type Input struct {
 	Lazy       bool          `protobuf:"varint,7,opt,name=lazy,proto3" json:"lazy,omitempty"`
 	Branch     string        `protobuf:"bytes,8,opt,name=branch,proto3" json:"branch,omitempty"`
 	EmptyFiles bool          `protobuf:"varint,10,opt,name=empty_files,json=emptyFiles,proto3" json:"empty_files,omitempty"`
-	S3         bool          `protobuf:"varint,11,opt,name=s3,proto3" json:"s3,omitempty"` // If set, workers won't create an input directory for this input
+	S3         bool          `protobuf:"varint,11,opt,name=s3,proto3" json:"s3,omitempty"`         // If set, workers won't create an input directory for this input
+	Window     bool          `protobuf:"varint,12,opt,name=window,proto3" json:"window,omitempty"` // If set, the file's commit is part of the datum's identity
+	Bucket     bool          `protobuf:"varint,13,opt,name=bucket,proto3" json:"bucket,omitempty"` // If set, the file is a reference to an object that workers fetch
 }
 
 func (x *Input) Reset() {
@@ -132,13 +134,27 @@ func (x *Input) GetS3() bool {
 	return false
 }
 
+func (x *Input) GetWindow() bool {
+	if x != nil {
+		return x.Window
+	}
+	return false
+}
+
+func (x *Input) GetBucket() bool {
+	if x != nil {
+		return x.Bucket
+	}
+	return false
+}
+
 var File_server_worker_common_common_proto protoreflect.FileDescriptor
 
 var file_server_worker_common_common_proto_rawDesc = []byte{
 	0x0a, 0x21, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x2f,
 	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x12, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x1a, 0x0d, 0x70, 0x66, 0x73,
-	0x2f, 0x70, 0x66, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x86, 0x02, 0x0a, 0x05, 0x49,
+	0x2f, 0x70, 0x66, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb6, 0x02, 0x0a, 0x05, 0x49,
 	0x6e, 0x70, 0x75, 0x74, 0x12, 0x2d, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x6e, 0x66,
 	0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32,
 	0x2e, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x49,
@@ -154,7 +170,10 @@ var file_server_worker_common_common_proto_rawDesc = []byte{
 	0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x5f,
 	0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x65, 0x6d, 0x70,
 	0x74, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x73, 0x33, 0x18, 0x0b, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x02, 0x73, 0x33, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x4a, 0x04, 0x08,
+	0x01, 0x28, 0x08, 0x52, 0x02, 0x73, 0x33, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12,
+	0x16, 0x0a, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x4a, 0x04, 0x08,
 	0x09, 0x10, 0x0a, 0x42, 0x3c, 0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
 	0x6d, 0x2f, 0x70, 0x61, 0x63, 0x68, 0x79, 0x64, 0x65, 0x72, 0x6d, 0x2f, 0x70, 0x61, 0x63, 0x68,
 	0x79, 0x64, 0x65, 0x72, 0x6d, 0x2f, 0x76, 0x32, 0x2f, 0x73, 0x72, 0x63, 0x2f, 0x73, 0x65, 0x72,
@@ -101,6 +101,10 @@ func (m *Input) validate(all bool) error {
 
 	// no validation rules for S3
 
+	// no validation rules for Window
+
+	// no validation rules for Bucket
+
 	if len(errors) > 0 {
 		return InputMultiError(errors)
 	}
@@ -21,5 +21,7 @@ func (x *Input) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	enc.AddString("branch", x.Branch)
 	enc.AddBool("empty_files", x.EmptyFiles)
 	enc.AddBool("s3", x.S3)
+	enc.AddBool("window", x.Window)
+	enc.AddBool("bucket", x.Bucket)
 	return nil
 }
@@ -0,0 +1,77 @@
+package datum
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/obj"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ppsutil"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+)
+
+// fetchBucketObjects replaces each of the reference files that bucket input in
+// downloaded to dir with the object it references, and returns the number of
+// bytes fetched.  Objects are only fetched from in's bucket, with the
+// credentials in its secret, and only from the key under its prefix that the
+// reference file's path was listed from, so the content of a reference file
+// can't point a worker anywhere else.
+func fetchBucketObjects(ctx context.Context, dir string, in *pps.BucketInput) (int64, error) {
+	u, err := obj.ParseURL(in.Url)
+	if err != nil {
+		return 0, errors.EnsureStack(err)
+	}
+	secret, err := ppsutil.ReadBucketSecret(in)
+	if err != nil {
+		return 0, err
+	}
+	c, err := obj.NewBucketClient(ctx, u, secret)
+	if err != nil {
+		return 0, errors.Wrapf(err, "create client for bucket %s", in.Url)
+	}
+	var fetched int64
+	if err := filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return errors.EnsureStack(err)
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return errors.EnsureStack(err)
+		}
+		data, err := os.ReadFile(p)
+		if err != nil {
+			return errors.EnsureStack(err)
+		}
+		o, err := ppsutil.ParseBucketObject(data)
+		if err != nil {
+			return errors.Wrapf(err, "read reference file %s", p)
+		}
+		if !strings.HasPrefix(o.Key, u.Object) || ppsutil.BucketObjectPath(u.Object, o.Key) != ppsutil.BucketObjectPath("", filepath.ToSlash(rel)) {
+			return errors.Errorf("reference file %s names object %s, which isn't at its path under %s", p, o.Key, in.Url)
+		}
+		f, err := os.Create(p)
+		if err != nil {
+			return errors.EnsureStack(err)
+		}
+		if err := c.Get(ctx, o.Key, f); err != nil {
+			f.Close()
+			return errors.Wrapf(err, "fetch object %s from bucket %s", o.Key, in.Url)
+		}
+		fi, err := f.Stat()
+		if err != nil {
+			f.Close()
+			return errors.EnsureStack(err)
+		}
+		fetched += fi.Size()
+		return errors.EnsureStack(f.Close())
+	}); err != nil {
+		return 0, err
+	}
+	return fetched, nil
+}
@@ -0,0 +1,61 @@
+package datum
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/obj"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pctx"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ppsutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+)
+
+func TestFetchBucketObjects(t *testing.T) {
+	ctx := pctx.TestContext(t)
+	root := t.TempDir()
+	objects := map[string]string{"prefix/a": "foo", "prefix/b/c": "foo bar", "secret": "baz"}
+	for name, data := range objects {
+		require.NoError(t, os.MkdirAll(filepath.Join(root, filepath.Dir(name)), 0700))
+		require.NoError(t, os.WriteFile(filepath.Join(root, name), []byte(data), 0600))
+	}
+	c, err := obj.NewDirClient(root)
+	require.NoError(t, err)
+	u := c.BucketURL()
+	u.Object = "prefix"
+	in := &pps.BucketInput{Url: u.String()}
+	refs, err := ppsutil.ListBucket(ctx, c, in)
+	require.NoError(t, err)
+
+	// Lay out the reference files the way a bucket input's datum downloads
+	// them.
+	dir := t.TempDir()
+	for p, o := range refs {
+		data, err := json.Marshal(o)
+		require.NoError(t, err)
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, filepath.Dir(p)), 0700))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, p), data, 0600))
+	}
+	n, err := fetchBucketObjects(ctx, dir, in)
+	require.NoError(t, err)
+	require.Equal(t, int64(10), n)
+	for p, want := range map[string]string{"a": "foo", "b/c": "foo bar"} {
+		got, err := os.ReadFile(filepath.Join(dir, p))
+		require.NoError(t, err)
+		require.Equal(t, want, string(got))
+	}
+
+	// A reference file can't name an object outside of the input's prefix,
+	// nor one other than the object it was listed from.
+	for _, key := range []string{"secret", "prefix/a"} {
+		dir := t.TempDir()
+		data, err := json.Marshal(&ppsutil.BucketObject{URL: "s3://elsewhere/prefix", Key: key})
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "c"), data, 0600))
+		_, err = fetchBucketObjects(ctx, dir, in)
+		require.YesError(t, err)
+		require.Matches(t, "isn't at its path", err.Error())
+	}
+}
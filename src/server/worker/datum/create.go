@@ -7,6 +7,8 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/auth"
 	"github.com/pachyderm/pachyderm/v2/src/internal/client"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/log"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/renew"
 	"github.com/pachyderm/pachyderm/v2/src/internal/task"
@@ -15,13 +17,14 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/server/worker/common"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 )
 
 func Create(ctx context.Context, c pfs.APIClient, taskDoer task.Doer, input *pps.Input) (string, error) {
 	switch {
 	case input.Pfs != nil:
-		return createPFS(ctx, c, taskDoer, input.Pfs)
+		return createPFS(ctx, c, taskDoer, input.Pfs, false)
 	case input.Union != nil:
 		return createUnion(ctx, c, taskDoer, input.Union)
 	case input.Cross != nil:
@@ -32,38 +35,63 @@ func Create(ctx context.Context, c pfs.APIClient, taskDoer task.Doer, input *pps
 		return createGroup(ctx, c, taskDoer, input.Group)
 	case input.Cron != nil:
 		return createCron(ctx, c, taskDoer, input.Cron)
+	case input.Bucket != nil:
+		return createBucket(ctx, c, taskDoer, input.Bucket)
 	default:
 		return "", errors.Errorf("unrecognized input type: %v", input)
 	}
 }
 
-func createPFS(ctx context.Context, c pfs.APIClient, taskDoer task.Doer, input *pps.PFSInput) (string, error) {
+// createPFS creates the datums of a PFS input.  If bucket is set, the input's
+// files are references to the objects of a bucket input.
+func createPFS(ctx context.Context, c pfs.APIClient, taskDoer task.Doer, input *pps.PFSInput, bucket bool) (string, error) {
 	authToken := getAuthToken(ctx)
 	var outputFileSetID string
 	if err := client.WithRenewer(ctx, c, func(ctx context.Context, renewer *renew.StringSet) error {
-		fileSetID, err := client.GetFileSet(ctx, c, input.Project, input.Repo, input.Branch, input.Commit)
-		if err != nil {
-			return err
-		}
-		if err := renewer.Add(ctx, fileSetID); err != nil {
-			return err
-		}
-		shards, err := client.ShardFileSet(ctx, c, fileSetID)
-		if err != nil {
-			return err
+		// A windowed input is the union of the changes made by each commit in
+		// its window.
+		commitInputs := []*pps.PFSInput{input}
+		parentCommits := []*pfs.Commit{nil}
+		if input.Window != nil {
+			cis, err := windowCommits(ctx, c, input)
+			if err != nil {
+				return err
+			}
+			commitInputs, parentCommits = nil, nil
+			for _, ci := range cis {
+				commitInput := proto.Clone(input).(*pps.PFSInput)
+				commitInput.Commit = ci.Commit.Id
+				commitInputs = append(commitInputs, commitInput)
+				parentCommits = append(parentCommits, ci.ParentCommit)
+			}
 		}
 		var inputs []*anypb.Any
-		for i, shard := range shards {
-			input, err := serializePFSTask(&PFSTask{
-				Input:     input,
-				PathRange: shard,
-				BaseIndex: createBaseIndex(int64(i)),
-				AuthToken: authToken,
-			})
+		for i, input := range commitInputs {
+			fileSetID, err := client.GetFileSet(ctx, c, input.Project, input.Repo, input.Branch, input.Commit)
 			if err != nil {
 				return err
 			}
-			inputs = append(inputs, input)
+			if err := renewer.Add(ctx, fileSetID); err != nil {
+				return err
+			}
+			shards, err := client.ShardFileSet(ctx, c, fileSetID)
+			if err != nil {
+				return err
+			}
+			for _, shard := range shards {
+				input, err := serializePFSTask(&PFSTask{
+					Input:        input,
+					PathRange:    shard,
+					BaseIndex:    createBaseIndex(int64(len(inputs))),
+					AuthToken:    authToken,
+					ParentCommit: parentCommits[i],
+					Bucket:       bucket,
+				})
+				if err != nil {
+					return err
+				}
+				inputs = append(inputs, input)
+			}
 		}
 		resultFileSetIDs := make([]string, len(inputs))
 		if err := task.DoBatch(ctx, taskDoer, inputs, func(i int64, output *anypb.Any, err error) error {
@@ -82,6 +110,7 @@ func createPFS(ctx context.Context, c pfs.APIClient, taskDoer task.Doer, input *
 		}); err != nil {
 			return err
 		}
+		var err error
 		outputFileSetID, err = ComposeFileSets(ctx, c, taskDoer, resultFileSetIDs)
 		return err
 	}); err != nil {
@@ -90,6 +119,62 @@ func createPFS(ctx context.Context, c pfs.APIClient, taskDoer task.Doer, input *
 	return outputFileSetID, nil
 }
 
+// windowCommits returns the commits in the window of a PFS input, newest
+// first.
+func windowCommits(ctx context.Context, c pfs.APIClient, input *pps.PFSInput) ([]*pfs.CommitInfo, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	commit := client.NewCommit(input.Project, input.Repo, input.Branch, input.Commit)
+	listClient, err := c.ListCommit(ctx, &pfs.ListCommitRequest{
+		Repo:   commit.Repo,
+		To:     commit,
+		Number: input.Window.Commits,
+	})
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	var cis []*pfs.CommitInfo
+	if err := grpcutil.ForEach[*pfs.CommitInfo](listClient, func(ci *pfs.CommitInfo) error {
+		if input.Window.Duration != nil && len(cis) > 0 {
+			if cis[0].Started.AsTime().Sub(ci.Started.AsTime()) > input.Window.Duration.AsDuration() {
+				return errutil.ErrBreak
+			}
+		}
+		cis = append(cis, ci)
+		return nil
+	}); err != nil && !errors.Is(err, errutil.ErrBreak) {
+		return nil, errors.EnsureStack(err)
+	}
+	return cis, nil
+}
+
+// WindowInputs returns the commits read by the windowed PFS inputs in input,
+// one job input per input and commit.
+func WindowInputs(ctx context.Context, c pfs.APIClient, input *pps.Input) ([]*pps.JobInput, error) {
+	var jobInputs []*pps.JobInput
+	if err := pps.VisitInput(input, func(input *pps.Input) error {
+		if input.Pfs == nil || input.Pfs.Window == nil {
+			return nil
+		}
+		cis, err := windowCommits(ctx, c, input.Pfs)
+		if err != nil {
+			return err
+		}
+		for _, ci := range cis {
+			jobInputs = append(jobInputs, &pps.JobInput{
+				Name:   input.Pfs.Name,
+				Commit: ci.Commit,
+				Glob:   input.Pfs.Glob,
+				Lazy:   input.Pfs.Lazy,
+			})
+		}
+		return nil
+	}); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	return jobInputs, nil
+}
+
 func createBaseIndex(index int64) int64 {
 	return index * int64(math.Pow(float64(10), float64(16)))
 }
@@ -370,7 +455,18 @@ func createCron(ctx context.Context, c pfs.APIClient, taskDoer task.Doer, input
 		Branch:  "master",
 		Commit:  input.Commit,
 		Glob:    "/*",
-	})
+	}, false)
+}
+
+func createBucket(ctx context.Context, c pfs.APIClient, taskDoer task.Doer, input *pps.BucketInput) (string, error) {
+	return createPFS(ctx, c, taskDoer, &pps.PFSInput{
+		Name:    input.Name,
+		Project: input.Project,
+		Repo:    input.Repo,
+		Branch:  "master",
+		Commit:  input.Commit,
+		Glob:    input.Glob,
+	}, true)
 }
 
 func serializePFSTask(task *PFSTask) (*anypb.Any, error) {
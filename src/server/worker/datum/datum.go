@@ -6,7 +6,9 @@ import (
 	"bytes"
 	"context"
 	io "io"
+	"math"
 	"os"
+	"os/exec"
 	"path"
 	"path/filepath"
 	"strings"
@@ -96,7 +98,9 @@ type Set struct {
 	cacheClient                       *pfssync.CacheClient
 	storageRoot                       string
 	metaOutputClient, pfsOutputClient client.ModifyFile
+	outputCache                       OutputCache
 	stats                             *Stats
+	bucketInputs                      map[string]*pps.BucketInput
 }
 
 // WithSet provides a scoped environment for a datum set.
@@ -126,16 +130,34 @@ func (s *Set) UploadMeta(meta *Meta, opts ...Option) error {
 	return d.uploadMetaFile(d.set.metaOutputClient)
 }
 
+// OutputCache caches the output of datums, so that a datum whose output is cached doesn't need to
+// be processed.
+type OutputCache interface {
+	// Get returns the ID of the file set holding the output cached under key, if there is one.
+	Get(key string) (fileSetID string, ok bool)
+	// Put caches the output of the datum with ID datumID, written by cb, under key.
+	Put(key, datumID string, cb func(client.ModifyFile) error) error
+}
+
 // WithDatum provides a scoped environment for a datum within the datum set.
 // TODO: Handle datum concurrency here, and potentially move symlinking here.
 func (s *Set) WithDatum(meta *Meta, cb func(*Datum) error, opts ...Option) error {
 	d := newDatum(s, meta, opts...)
+	if s.outputCache != nil && d.cacheKey != "" {
+		if fileSetID, ok := s.outputCache.Get(d.cacheKey); ok {
+			return d.finishCached(fileSetID)
+		}
+		s.stats.CacheMisses++
+	}
 
 	var err error
 	for i := 0; i <= d.numRetries; i++ {
+		d.delay = d.retryDelay(i)
+		var finished bool
 		err = d.withData(func() (retErr error) {
 			defer func() {
-				if retErr == nil || i == d.numRetries {
+				if retErr == nil || i == d.numRetries || !d.retryable(retErr) {
+					finished = true
 					retErr = d.finish(retErr)
 				}
 				duration := time.Duration(d.meta.Stats.ProcessTime.GetNanos()) + time.Duration(d.meta.Stats.ProcessTime.GetSeconds())*time.Second
@@ -146,13 +168,52 @@ func (s *Set) WithDatum(meta *Meta, cb func(*Datum) error, opts ...Option) error
 			}()
 			return cb(d)
 		})
-		if err == nil {
-			return nil
+		if err == nil || finished {
+			return err
 		}
 	}
 	return err
 }
 
+// retryDelay returns how long to wait before the given try of the datum, where the first try is
+// zero.
+func (d *Datum) retryDelay(try int) time.Duration {
+	if try == 0 || d.retryPolicy.GetBackoff() == nil {
+		return 0
+	}
+	delay := float64(d.retryPolicy.Backoff.AsDuration())
+	if d.retryPolicy.BackoffMultiplier > 1 {
+		delay *= math.Pow(d.retryPolicy.BackoffMultiplier, float64(try-1))
+	}
+	if maxBackoff := d.retryPolicy.GetMaxBackoff(); maxBackoff != nil && delay > float64(maxBackoff.AsDuration()) {
+		return maxBackoff.AsDuration()
+	}
+	if delay >= math.MaxInt64 {
+		return math.MaxInt64
+	}
+	return time.Duration(delay)
+}
+
+// retryable returns whether a try of the datum that failed with err should be followed by another.
+// If the retry policy lists exit codes, the user code exiting with any other code is not retried.
+func (d *Datum) retryable(err error) bool {
+	codes := d.retryPolicy.GetRetryExitCodes()
+	if len(codes) == 0 {
+		return true
+	}
+	exitErr := &exec.ExitError{}
+	if !errors.As(err, &exitErr) || exitErr.ExitCode() < 0 {
+		// The user code didn't exit on its own, e.g. it was killed when the datum timed out.
+		return true
+	}
+	for _, code := range codes {
+		if int(code) == exitErr.ExitCode() {
+			return true
+		}
+	}
+	return false
+}
+
 // Datum manages a datum.
 type Datum struct {
 	set              *Set
@@ -160,6 +221,9 @@ type Datum struct {
 	meta             *Meta
 	storageRoot      string
 	numRetries       int
+	retryPolicy      *pps.DatumRetryPolicy
+	delay            time.Duration
+	cacheKey         string
 	recoveryCallback func(context.Context) error
 	timeout          time.Duration
 	IDPrefix         string
@@ -199,7 +263,34 @@ func (d *Datum) finish(err error) (retErr error) {
 		return d.uploadMetaOutput()
 	}
 	d.set.stats.Processed++
-	return d.uploadOutput()
+	if err := d.uploadOutput(); err != nil {
+		return err
+	}
+	return d.cacheOutput()
+}
+
+// finishCached finishes the datum with the output cached in the given file set, rather than by
+// processing it.
+func (d *Datum) finishCached(fileSetID string) error {
+	d.set.stats.Processed++
+	d.set.stats.CacheHits++
+	if d.set.pfsOutputClient != nil {
+		src := client.NewRepo(pfs.DefaultProjectName, client.FileSetsRepoName).NewCommit("", fileSetID).NewFile("/")
+		if err := d.set.pfsOutputClient.CopyFile("/", src, client.WithAppendCopyFile(), client.WithDatumCopyFile(d.ID)); err != nil {
+			return errors.Wrap(err, "copy cached output")
+		}
+	}
+	return d.uploadMetaOutput()
+}
+
+// cacheOutput stores the output of the datum in its output cache.
+func (d *Datum) cacheOutput() error {
+	if d.set.outputCache == nil || d.cacheKey == "" || d.set.pfsOutputClient == nil {
+		return nil
+	}
+	return d.set.outputCache.Put(d.cacheKey, d.ID, func(mf client.ModifyFile) error {
+		return d.upload(mf, path.Join(d.PFSStorageRoot(), common.OutputPrefix))
+	})
 }
 
 func (d *Datum) handleFailed(err error) {
@@ -207,6 +298,12 @@ func (d *Datum) handleFailed(err error) {
 		d.set.stats.Recovered++
 		return
 	}
+	if d.retryPolicy.GetOnFailure() == pps.DatumRetryPolicy_QUARANTINE {
+		d.meta.State = State_QUARANTINED
+		d.meta.Reason = err.Error()
+		d.set.stats.Quarantined++
+		return
+	}
 	d.meta.State = State_FAILED
 	d.meta.Reason = err.Error()
 	d.set.stats.Failed++
@@ -269,13 +366,16 @@ func (d *Datum) downloadData(downloader pfssync.Downloader) error {
 		}
 		// TODO: Need some validation to catch lazy & empty since they are incompatible.
 		// Probably should catch this at the input validation during pipeline creation?
-		opts := []pfssync.DownloadOption{
-			pfssync.WithHeaderCallback(func(hdr *tar.Header) error {
+		var opts []pfssync.DownloadOption
+		// The files of a bucket input only reference its objects, so the
+		// objects' bytes are counted when they're fetched instead.
+		if !input.Bucket {
+			opts = append(opts, pfssync.WithHeaderCallback(func(hdr *tar.Header) error {
 				mu.Lock()
 				defer mu.Unlock()
 				d.meta.Stats.DownloadBytes += hdr.Size
 				return nil
-			}),
+			}))
 		}
 		if input.Lazy {
 			opts = append(opts, pfssync.WithLazy())
@@ -286,12 +386,33 @@ func (d *Datum) downloadData(downloader pfssync.Downloader) error {
 		if err := downloader.Download(inputPath, input.FileInfo.File, opts...); err != nil {
 			return errors.EnsureStack(err)
 		}
+		if input.Bucket {
+			in, ok := d.set.bucketInputs[input.Name]
+			if !ok {
+				return errors.Errorf("no bucket input named %s", input.Name)
+			}
+			n, err := fetchBucketObjects(d.set.cacheClient.Ctx(), inputPath, in)
+			if err != nil {
+				return err
+			}
+			mu.Lock()
+			d.meta.Stats.DownloadBytes += n
+			mu.Unlock()
+		}
 	}
 	return nil
 }
 
-// Run provides a scoped environment for the processing of a datum.
+// Run provides a scoped environment for the processing of a datum.  If the datum is being
+// retried, Run first waits out the backoff of its retry policy.
 func (d *Datum) Run(ctx context.Context, cb func(ctx context.Context) error) error {
+	if d.delay > 0 {
+		select {
+		case <-time.After(d.delay):
+		case <-ctx.Done():
+			return errors.EnsureStack(context.Cause(ctx))
+		}
+	}
 	start := time.Now()
 	defer func() {
 		d.meta.Stats.ProcessTime = durationpb.New(time.Since(start))
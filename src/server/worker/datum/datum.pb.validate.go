@@ -297,6 +297,12 @@ func (m *Stats) validate(all bool) error {
 
 	// no validation rules for FailedId
 
+	// no validation rules for Quarantined
+
+	// no validation rules for CacheHits
+
+	// no validation rules for CacheMisses
+
 	if len(errors) > 0 {
 		return StatsMultiError(errors)
 	}
@@ -457,6 +463,37 @@ func (m *PFSTask) validate(all bool) error {
 
 	// no validation rules for AuthToken
 
+	if all {
+		switch v := interface{}(m.GetParentCommit()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PFSTaskValidationError{
+					field:  "ParentCommit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PFSTaskValidationError{
+					field:  "ParentCommit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetParentCommit()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PFSTaskValidationError{
+				field:  "ParentCommit",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Bucket
+
 	if len(errors) > 0 {
 		return PFSTaskMultiError(errors)
 	}
@@ -44,6 +44,9 @@ func (x *Stats) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	enc.AddInt64("failed", x.Failed)
 	enc.AddInt64("recovered", x.Recovered)
 	enc.AddString("failed_id", x.FailedId)
+	enc.AddInt64("quarantined", x.Quarantined)
+	enc.AddInt64("cache_hits", x.CacheHits)
+	enc.AddInt64("cache_misses", x.CacheMisses)
 	return nil
 }
 
@@ -55,6 +58,8 @@ func (x *PFSTask) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	enc.AddObject("path_range", x.PathRange)
 	enc.AddInt64("base_index", x.BaseIndex)
 	enc.AddString("auth_token", x.AuthToken)
+	enc.AddObject("parent_commit", x.ParentCommit)
+	enc.AddBool("bucket", x.Bucket)
 	return nil
 }
 
@@ -2,13 +2,18 @@ package datum
 
 import (
 	"os"
+	"os/exec"
 	"path/filepath"
 	"regexp"
+	"strconv"
 	"testing"
+	"time"
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
 	"github.com/pachyderm/pachyderm/v2/src/pps"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 func TestUploadOutput(t *testing.T) {
@@ -64,6 +69,74 @@ func TestUploadOutput(t *testing.T) {
 	}
 }
 
+func TestRetryDelay(t *testing.T) {
+	d := &Datum{retryPolicy: &pps.DatumRetryPolicy{
+		Backoff:           durationpb.New(time.Second),
+		BackoffMultiplier: 3,
+		MaxBackoff:        durationpb.New(20 * time.Second),
+	}}
+	for try, want := range []time.Duration{0, time.Second, 3 * time.Second, 9 * time.Second, 20 * time.Second, 20 * time.Second} {
+		if got := d.retryDelay(try); got != want {
+			t.Errorf("retryDelay(%d): got %v, want %v", try, got, want)
+		}
+	}
+	d.retryPolicy.BackoffMultiplier = 0
+	if got, want := d.retryDelay(4), time.Second; got != want {
+		t.Errorf("retryDelay(4) without a multiplier: got %v, want %v", got, want)
+	}
+	if got := (&Datum{}).retryDelay(4); got != 0 {
+		t.Errorf("retryDelay(4) without a policy: got %v, want 0", got)
+	}
+}
+
+func TestRetryable(t *testing.T) {
+	exitErr := func(code int) error {
+		err := exec.Command("sh", "-c", "exit "+strconv.Itoa(code)).Run()
+		if err == nil {
+			t.Fatalf("exit %d: expected an error", code)
+		}
+		return errors.EnsureStack(err)
+	}
+	d := &Datum{}
+	if !d.retryable(exitErr(1)) {
+		t.Error("without a policy, every failure should be retried")
+	}
+	d.retryPolicy = &pps.DatumRetryPolicy{RetryExitCodes: []int32{2, 3}}
+	if !d.retryable(exitErr(2)) {
+		t.Error("exit code 2 should be retried")
+	}
+	if d.retryable(exitErr(1)) {
+		t.Error("exit code 1 should not be retried")
+	}
+	if !d.retryable(errors.New("datum timed out")) {
+		t.Error("failures that aren't an exit of the user code should be retried")
+	}
+}
+
+func TestQuarantine(t *testing.T) {
+	newDatum := func(policy *pps.DatumRetryPolicy) *Datum {
+		return &Datum{
+			ID:          "id",
+			retryPolicy: policy,
+			set:         &Set{stats: &Stats{ProcessStats: &pps.ProcessStats{}}},
+			meta:        &Meta{},
+		}
+	}
+	d := newDatum(&pps.DatumRetryPolicy{OnFailure: pps.DatumRetryPolicy_QUARANTINE})
+	d.handleFailed(errors.New("boom"))
+	if d.meta.State != State_QUARANTINED || d.meta.Reason != "boom" {
+		t.Errorf("got state %v and reason %q, want QUARANTINED and \"boom\"", d.meta.State, d.meta.Reason)
+	}
+	if d.set.stats.Quarantined != 1 || d.set.stats.Failed != 0 || d.set.stats.FailedId != "" {
+		t.Errorf("a quarantined datum should not fail the job: %v", d.set.stats)
+	}
+	d = newDatum(nil)
+	d.handleFailed(errors.New("boom"))
+	if d.meta.State != State_FAILED || d.set.stats.FailedId != "id" {
+		t.Errorf("without a policy, the datum should fail: %v %v", d.meta.State, d.set.stats)
+	}
+}
+
 // TODO: This test needs to be reworked.
 //func TestSet(t *testing.T) {
 //	t.Parallel()
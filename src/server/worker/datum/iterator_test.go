@@ -7,12 +7,14 @@ import (
 	"sort"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/client"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
 	"github.com/pachyderm/pachyderm/v2/src/pps"
 	"github.com/pachyderm/pachyderm/v2/src/server/worker/datum"
 	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/dockertestenv"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
@@ -388,6 +390,41 @@ func TestJoinTrailingSlash(t *testing.T) {
 	)
 }
 
+func TestWindow(t *testing.T) {
+	t.Parallel()
+	ctx := pctx.TestContext(t)
+	env := realenv.NewRealEnv(ctx, t, dockertestenv.NewTestDBConfig(t).PachConfigOption)
+	taskDoer := createTaskDoer(t, env)
+	c := env.PachClient
+	repo := tu.UniqueString(t.Name())
+	require.NoError(t, c.CreateRepo(pfs.DefaultProjectName, repo))
+	// Each commit adds a file and overwrites another.
+	var commit *pfs.Commit
+	for i := 0; i < 4; i++ {
+		var err error
+		commit, err = c.StartCommit(pfs.DefaultProjectName, repo, "master")
+		require.NoError(t, err)
+		require.NoError(t, c.PutFile(commit, fmt.Sprintf("/day-%v", i), strings.NewReader("data")))
+		require.NoError(t, c.PutFile(commit, "/latest", strings.NewReader(fmt.Sprint(i))))
+		require.NoError(t, c.FinishCommit(pfs.DefaultProjectName, repo, commit.Branch.Name, commit.Id))
+	}
+	in := client.NewPFSInput(pfs.DefaultProjectName, repo, "/*")
+	in.Pfs.Commit = commit.Id
+	in.Pfs.Window = &pps.PFSWindow{Commits: 2}
+	itr, err := datum.NewIterator(ctx, c.PfsAPIClient, taskDoer, in)
+	require.NoError(t, err)
+	validateDI(t, itr, "/day-2", "/day-3", "/latest", "/latest")
+	jobInputs, err := datum.WindowInputs(ctx, c.PfsAPIClient, in)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(jobInputs))
+	require.Equal(t, commit.Id, jobInputs[0].Commit.Id)
+
+	in.Pfs.Window = &pps.PFSWindow{Duration: durationpb.New(time.Hour)}
+	itr, err = datum.NewIterator(ctx, c.PfsAPIClient, taskDoer, in)
+	require.NoError(t, err)
+	validateDI(t, itr, "/day-0", "/day-1", "/day-2", "/day-3", "/latest", "/latest", "/latest", "/latest")
+}
+
 func validateDI(t testing.TB, di datum.Iterator, datums ...string) {
 	t.Helper()
 	datumMap := make(map[string]int)
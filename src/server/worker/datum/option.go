@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/client"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
 )
 
 // SetOption configures a set.
@@ -32,6 +33,27 @@ func WithStats(stats *Stats) SetOption {
 	}
 }
 
+// WithOutputCache sets the cache that the output of datums with a cache key is reused from.
+func WithOutputCache(cache OutputCache) SetOption {
+	return func(s *Set) {
+		s.outputCache = cache
+	}
+}
+
+// WithBucketInputs sets the bucket inputs, by name, that the objects of bucket
+// input datums are fetched from.
+func WithBucketInputs(input *pps.Input) SetOption {
+	return func(s *Set) {
+		s.bucketInputs = make(map[string]*pps.BucketInput)
+		pps.VisitInput(input, func(in *pps.Input) error { //nolint:errcheck
+			if in.Bucket != nil {
+				s.bucketInputs[in.Bucket.Name] = in.Bucket
+			}
+			return nil
+		})
+	}
+}
+
 // Option configures a datum.
 type Option func(*Datum)
 
@@ -42,6 +64,22 @@ func WithRetry(numRetries int) Option {
 	}
 }
 
+// WithRetryPolicy sets the policy that decides when, and whether, a failed datum is retried, and
+// what happens to it once it has failed its last try.
+func WithRetryPolicy(policy *pps.DatumRetryPolicy) Option {
+	return func(d *Datum) {
+		d.retryPolicy = policy
+	}
+}
+
+// WithCacheKey sets the key that the output of the datum is looked up under in the output cache of
+// its set, and stored under once the datum has been processed.
+func WithCacheKey(key string) Option {
+	return func(d *Datum) {
+		d.cacheKey = key
+	}
+}
+
 // WithRecoveryCallback sets the recovery callback.
 func WithRecoveryCallback(cb func(context.Context) error) Option {
 	return func(d *Datum) {
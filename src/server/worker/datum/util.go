@@ -19,6 +19,9 @@ func MergeStats(x, y *Stats) {
 	x.Skipped += y.Skipped
 	x.Failed += y.Failed
 	x.Recovered += y.Recovered
+	x.Quarantined += y.Quarantined
+	x.CacheHits += y.CacheHits
+	x.CacheMisses += y.CacheMisses
 	if x.FailedId == "" {
 		x.FailedId = y.FailedId
 	}
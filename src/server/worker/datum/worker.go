@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/hex"
+	"io"
 	"strings"
 
 	glob "github.com/pachyderm/ohmyglob"
@@ -126,8 +127,20 @@ func processPFSTask(ctx context.Context, c client.PfsAPIClient, task *PFSTask) (
 		if err != nil {
 			return errors.EnsureStack(err)
 		}
+		changed := func(*pfs.FileInfo) (bool, error) { return true, nil }
+		if task.Input.Window != nil && task.ParentCommit != nil {
+			ctx, cancel := context.WithCancel(ctx)
+			defer cancel()
+			changed, err = newChangeFilter(ctx, c, task)
+			if err != nil {
+				return err
+			}
+		}
 		index := task.BaseIndex
 		return grpcutil.ForEach[*pfs.FileInfo](client, func(fi *pfs.FileInfo) error {
+			if ok, err := changed(fi); err != nil || !ok {
+				return err
+			}
 			g := glob.MustCompile(pfsfile.CleanPath(task.Input.Glob), '/')
 			// Remove the trailing slash to support glob replace on directory paths.
 			p := strings.TrimRight(fi.File.Path, "/")
@@ -145,6 +158,8 @@ func processPFSTask(ctx context.Context, c client.PfsAPIClient, task *PFSTask) (
 						Branch:     task.Input.Branch,
 						EmptyFiles: task.Input.EmptyFiles,
 						S3:         task.Input.S3,
+						Window:     task.Input.Window != nil,
+						Bucket:     task.Bucket,
 					},
 				},
 				Index: index,
@@ -159,6 +174,37 @@ func processPFSTask(ctx context.Context, c client.PfsAPIClient, task *PFSTask) (
 	return serializePFSTaskResult(&PFSTaskResult{FileSetId: fileSetID})
 }
 
+// newChangeFilter returns a function that reports whether a file matched by
+// a windowed input's glob differs from the file at the same path in the
+// parent of the input's commit.  It must be called with files in path order.
+func newChangeFilter(ctx context.Context, c client.PfsAPIClient, task *PFSTask) (func(*pfs.FileInfo) (bool, error), error) {
+	parentClient, err := c.GlobFile(
+		ctx,
+		&pfs.GlobFileRequest{
+			Commit:    task.ParentCommit,
+			Pattern:   task.Input.Glob,
+			PathRange: task.PathRange,
+		},
+	)
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	var parent *pfs.FileInfo
+	var done bool
+	return func(fi *pfs.FileInfo) (bool, error) {
+		for !done && (parent == nil || parent.File.Path < fi.File.Path) {
+			parent, err = parentClient.Recv()
+			if err != nil {
+				if !errors.Is(err, io.EOF) {
+					return false, errors.EnsureStack(err)
+				}
+				parent, done = nil, true
+			}
+		}
+		return parent == nil || parent.File.Path != fi.File.Path || !bytes.Equal(parent.Hash, fi.Hash), nil
+	}, nil
+}
+
 func processCrossTask(ctx context.Context, c pfs.APIClient, task *CrossTask) (*anypb.Any, error) {
 	index := task.BaseIndex
 	fileSetID, err := WithCreateFileSet(ctx, c, "pachyderm-datums-cross", func(s *Set) error {
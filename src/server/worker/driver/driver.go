@@ -495,7 +495,7 @@ func (d *driver) UpdateJobState(job *pps.Job, state pps.JobState, reason string)
 		if err := d.Jobs().ReadWrite(sqlTx).Get(ppsdb.JobKey(job), jobInfo); err != nil {
 			return errors.EnsureStack(err)
 		}
-		return errors.EnsureStack(ppsutil.UpdateJobState(d.Pipelines().ReadWrite(sqlTx), d.Jobs().ReadWrite(sqlTx), jobInfo, state, reason))
+		return errors.EnsureStack(ppsutil.UpdateJobState(ctx, sqlTx, d.Pipelines().ReadWrite(sqlTx), d.Jobs().ReadWrite(sqlTx), jobInfo, state, reason))
 	})
 }
 
@@ -536,7 +536,7 @@ func (d *driver) UserCodeEnv(
 	inputs []*common.Input,
 	pachToken string,
 ) []string {
-	result := os.Environ()
+	result := d.userCodeBaseEnv()
 
 	for _, input := range inputs {
 		result = append(result, fmt.Sprintf("%s=%s", input.Name, filepath.Join(d.InputDir(), input.Name, input.FileInfo.File.Path)))
@@ -599,7 +599,38 @@ func (d *driver) UserCodeEnv(
 	return result
 }
 
+// userCodeBaseEnv returns the environment that user code starts from.  In a pod
+// that is the worker container's, which the kubeDriver builds explicitly, but
+// local workers share pachd's host, so their user code only gets the host
+// variables in ppsutil.LocalHostEnv, the pipeline's identity and the
+// transform's.
+func (d *driver) userCodeBaseEnv() []string {
+	if d.env.Config().WorkerRoot == "" {
+		return os.Environ()
+	}
+	env := append(ppsutil.HostEnv(ppsutil.LocalHostEnv), ppsutil.HostEnv([]string{
+		"PACH_IN_WORKER",
+		client.PPSPodNameEnv,
+		client.PPSSpecCommitEnv,
+		client.PPSProjectNameEnv,
+		client.PPSPipelineNameEnv,
+	})...)
+	for name, value := range d.PipelineInfo().Details.Transform.Env {
+		env = append(env, name+"="+value)
+	}
+	return env
+}
+
+// localImageID is the image ID reported by workers that pachd runs as local
+// processes.  It doesn't identify the user code, so pipelines with local
+// workers can't cache datum output.
+const localImageID = "local"
+
 func (d *driver) GetContainerImageID(ctx context.Context, containerName string) (string, error) {
+	if d.env.Config().WorkerRoot != "" {
+		// Local workers run on pachd's host rather than in a container.
+		return localImageID, nil
+	}
 	pod, err := d.env.GetKubeClient().CoreV1().Pods(d.env.Config().Namespace).Get(
 		ctx,
 		d.env.Config().WorkerSpecificConfiguration.PodName,
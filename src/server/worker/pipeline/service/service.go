@@ -76,7 +76,7 @@ func Run(driver driver.Driver, logger logs.TaggedLogger) error {
 					return errors.EnsureStack(err)
 				})
 
-			})
+			}, datum.WithBucketInputs(driver.PipelineInfo().Details.Input))
 		})
 	})
 }
@@ -2,14 +2,29 @@ package transform
 
 import (
 	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/client"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/renew"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+	"github.com/pachyderm/pachyderm/v2/src/server/worker/common"
 	"github.com/pachyderm/pachyderm/v2/src/server/worker/datum"
+	"github.com/pachyderm/pachyderm/v2/src/server/worker/logs"
 	"google.golang.org/protobuf/types/known/anypb"
 )
 
+const (
+	// outputCacheKeyPrefix prefixes the keys of the datum output cache, keeping them apart from
+	// the task IDs that the job caches are keyed by.
+	outputCacheKeyPrefix = "datum-output/"
+)
+
 type cache struct {
 	pachClient *client.APIClient
 	tag        string
@@ -70,6 +85,123 @@ func (c *cache) Put(ctx context.Context, key string, output *anypb.Any) error {
 	return errors.EnsureStack(err)
 }
 
+// outputCache is the datum output cache, which is shared by the pipelines of a project with
+// cache_datum_output set.  Its entries are evicted along with the rest of the PFS cache.
+type outputCache struct {
+	pachClient *client.APIClient
+	renewer    *renew.StringSet
+	logger     logs.TaggedLogger
+	project    string
+}
+
+func newOutputCache(pachClient *client.APIClient, renewer *renew.StringSet, logger logs.TaggedLogger, project string) *outputCache {
+	return &outputCache{
+		pachClient: pachClient,
+		renewer:    renewer,
+		logger:     logger,
+		project:    project,
+	}
+}
+
+// Get returns the file set holding the output cached under key.  As with the task cache, any
+// error is treated as a miss.
+func (c *outputCache) Get(key string) (string, bool) {
+	ctx := c.pachClient.Ctx()
+	resp, err := c.pachClient.PfsAPIClient.GetCache(ctx, &pfs.GetCacheRequest{Key: key})
+	if err != nil {
+		return "", false
+	}
+	entry := &DatumOutputCacheEntry{}
+	if err := resp.Value.UnmarshalTo(entry); err != nil {
+		return "", false
+	}
+	// Keep the file set around while it's copied, even if its entry is evicted.
+	if err := c.renewer.Add(ctx, entry.FileSetId); err != nil {
+		return "", false
+	}
+	return entry.FileSetId, true
+}
+
+// Put caches the output of the datum with ID datumID, written by cb, under key.  Unlike the entries
+// of a job's cache, the entry outlives the job, and even the pipeline, that created it; it's tagged
+// with the datum so that purging the datum clears it.  The output has already been written to the
+// job by then, so failing to add the entry, e.g. because another worker just added one for the same
+// key, is logged rather than returned.
+func (c *outputCache) Put(key, datumID string, cb func(client.ModifyFile) error) error {
+	resp, err := c.pachClient.WithCreateFileSetClient(cb)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	value, err := anypb.New(&DatumOutputCacheEntry{FileSetId: resp.FileSetId})
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	if _, err := c.pachClient.PfsAPIClient.PutCache(c.pachClient.Ctx(), &pfs.PutCacheRequest{
+		Key:        key,
+		Value:      value,
+		FileSetIds: []string{resp.FileSetId},
+		Tag:        common.DatumOutputCacheTag(c.project, datumID),
+	}); err != nil {
+		c.logger.Logf("could not cache datum output: %v", err)
+	}
+	return nil
+}
+
+// outputCacheKey returns the key that the output of a datum is cached under.  It covers what
+// determines the output of a transform whose output depends only on its inputs: the digest of the
+// user code's image, everything in the transform that user code is run with, the pod spec and
+// patch, and the datum's inputs.  The contents of secrets are not known to the worker, so only
+// where they're mounted is covered.  It doesn't cover the pipeline, so that pipelines with
+// identical transforms share cached output, but it is scoped to the pipeline's project, so that
+// output is never shared with pipelines that can't read it.
+func outputCacheKey(imageID string, pipelineInfo *pps.PipelineInfo, inputs []*common.Input) string {
+	details := pipelineInfo.Details
+	transform := details.Transform
+	hash := pfs.NewHash()
+	write := func(s string) {
+		hash.Write([]byte(s))
+		_ = binary.Write(hash, binary.BigEndian, int64(len(s)))
+	}
+	writeAll := func(ss []string) {
+		for _, s := range ss {
+			write(s)
+		}
+		_ = binary.Write(hash, binary.BigEndian, int64(len(ss)))
+	}
+	write(imageID)
+	writeAll(transform.Cmd)
+	writeAll(transform.Stdin)
+	writeAll(transform.ErrCmd)
+	writeAll(transform.ErrStdin)
+	write(transform.WorkingDir)
+	write(transform.User)
+	var env []string
+	for k, v := range transform.Env {
+		env = append(env, k+"="+v)
+	}
+	sort.Strings(env)
+	writeAll(env)
+	var secrets []string
+	for _, s := range transform.Secrets {
+		var secret []string
+		for _, f := range []string{s.Name, s.Key, s.MountPath, s.EnvVar} {
+			secret = append(secret, strconv.Itoa(len(f))+":"+f)
+		}
+		secrets = append(secrets, strings.Join(secret, ""))
+	}
+	sort.Strings(secrets)
+	writeAll(secrets)
+	var codes []string
+	for _, c := range transform.AcceptReturnCode {
+		codes = append(codes, strconv.FormatInt(c, 10))
+	}
+	writeAll(codes)
+	write(details.PodSpec)
+	write(details.PodPatch)
+	write(common.HashDatum("", inputs))
+	return outputCacheKeyPrefix + pipelineInfo.Pipeline.GetProject().GetName() + "/" + hex.EncodeToString(hash.Sum(nil))
+}
+
 func (c *cache) clear(ctx context.Context) error {
 	_, err := c.pachClient.PfsAPIClient.ClearCache(ctx, &pfs.ClearCacheRequest{
 		TagPrefix: c.tag,
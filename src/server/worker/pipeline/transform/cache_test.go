@@ -0,0 +1,65 @@
+package transform
+
+import (
+	"testing"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+	"github.com/pachyderm/pachyderm/v2/src/server/worker/common"
+)
+
+func TestOutputCacheKey(t *testing.T) {
+	inputs := func(hash string) []*common.Input {
+		return []*common.Input{{
+			Name: "in",
+			FileInfo: &pfs.FileInfo{
+				File: client.NewFile(pfs.DefaultProjectName, "in", "master", "", "/a"),
+				Hash: []byte(hash),
+			},
+		}}
+	}
+	pipelineInfo := func() *pps.PipelineInfo {
+		return &pps.PipelineInfo{
+			Pipeline: &pps.Pipeline{Project: &pfs.Project{Name: pfs.DefaultProjectName}, Name: "p"},
+			Details: &pps.PipelineInfo_Details{
+				Transform: &pps.Transform{
+					Cmd: []string{"bash"},
+					Env: map[string]string{"A": "1", "B": "2"},
+				},
+			},
+		}
+	}
+	key := outputCacheKey("sha256:abc", pipelineInfo(), inputs("x"))
+	require.Equal(t, key, outputCacheKey("sha256:abc", pipelineInfo(), inputs("x")))
+
+	other := pipelineInfo()
+	other.Pipeline.Name = "q"
+	require.Equal(t, key, outputCacheKey("sha256:abc", other, inputs("x")), "pipelines share output")
+	other = pipelineInfo()
+	other.Details.Transform.Env = map[string]string{"B": "2", "A": "1"}
+	require.Equal(t, key, outputCacheKey("sha256:abc", other, inputs("x")), "env order doesn't matter")
+
+	require.NotEqual(t, key, outputCacheKey("sha256:def", pipelineInfo(), inputs("x")), "image")
+	require.NotEqual(t, key, outputCacheKey("sha256:abc", pipelineInfo(), inputs("y")), "input content")
+	for name, change := range map[string]func(*pps.PipelineInfo){
+		"project": func(pi *pps.PipelineInfo) { pi.Pipeline.Project.Name = "other" },
+		"cmd":     func(pi *pps.PipelineInfo) { pi.Details.Transform.Cmd = []string{"bash", "-c"} },
+		"cmd and stdin are kept apart": func(pi *pps.PipelineInfo) {
+			pi.Details.Transform.Cmd, pi.Details.Transform.Stdin = nil, []string{"bash"}
+		},
+		"err cmd": func(pi *pps.PipelineInfo) { pi.Details.Transform.ErrCmd = []string{"true"} },
+		"env":     func(pi *pps.PipelineInfo) { pi.Details.Transform.Env["A"] = "3" },
+		"user":    func(pi *pps.PipelineInfo) { pi.Details.Transform.User = "root" },
+		"secrets": func(pi *pps.PipelineInfo) {
+			pi.Details.Transform.Secrets = []*pps.SecretMount{{Name: "s", Key: "k", EnvVar: "TOKEN"}}
+		},
+		"pod patch": func(pi *pps.PipelineInfo) { pi.Details.PodPatch = `[{"op": "add"}]` },
+		"pod spec":  func(pi *pps.PipelineInfo) { pi.Details.PodSpec = `{"hostNetwork": true}` },
+	} {
+		other := pipelineInfo()
+		change(other)
+		require.NotEqual(t, key, outputCacheKey("sha256:abc", other, inputs("x")), name)
+	}
+}
@@ -48,6 +48,9 @@ func (pj *pendingJob) saveJobStats(stats *datum.Stats) {
 	pj.ji.DataTotal += stats.Total
 	pj.ji.DataFailed += stats.Failed
 	pj.ji.DataRecovered += stats.Recovered
+	pj.ji.DataQuarantined += stats.Quarantined
+	pj.ji.DataCacheHits += stats.CacheHits
+	pj.ji.DataCacheMisses += stats.CacheMisses
 }
 
 func (pj *pendingJob) load() error {
@@ -133,6 +136,10 @@ func (pj *pendingJob) load() error {
 	if err != nil {
 		return err
 	}
+	pj.ji.WindowInputs, err = datum.WindowInputs(pachClient.Ctx(), pachClient.PfsAPIClient, pj.ji.Details.Input)
+	if err != nil {
+		return err
+	}
 	pj.clearJobStats()
 	return nil
 }
@@ -143,6 +150,9 @@ func (pj *pendingJob) clearJobStats() {
 	pj.ji.DataSkipped = 0
 	pj.ji.DataFailed = 0
 	pj.ji.DataRecovered = 0
+	pj.ji.DataQuarantined = 0
+	pj.ji.DataCacheHits = 0
+	pj.ji.DataCacheMisses = 0
 	pj.ji.DataTotal = 0
 }
 
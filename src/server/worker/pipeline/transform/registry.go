@@ -379,6 +379,9 @@ func (reg *registry) processDatums(pachClient *client.APIClient, pj *pendingJob,
 		}
 		return errutil.ErrBreak
 	}
+	if stats.Quarantined > 0 {
+		pj.logger.Logf("quarantined %d datums that failed every try", stats.Quarantined)
+	}
 	return nil
 }
 
@@ -496,6 +496,54 @@ func (x *DatumSetTask) GetOutputCommit() *pfs.Commit {
 	return nil
 }
 
+// DatumOutputCacheEntry is the value of an entry in the datum output cache.
+type DatumOutputCacheEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FileSetId string `protobuf:"bytes,1,opt,name=file_set_id,json=fileSetId,proto3" json:"file_set_id,omitempty"`
+}
+
+func (x *DatumOutputCacheEntry) Reset() {
+	*x = DatumOutputCacheEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_worker_pipeline_transform_transform_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DatumOutputCacheEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatumOutputCacheEntry) ProtoMessage() {}
+
+func (x *DatumOutputCacheEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_server_worker_pipeline_transform_transform_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatumOutputCacheEntry.ProtoReflect.Descriptor instead.
+func (*DatumOutputCacheEntry) Descriptor() ([]byte, []int) {
+	return file_server_worker_pipeline_transform_transform_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DatumOutputCacheEntry) GetFileSetId() string {
+	if x != nil {
+		return x.FileSetId
+	}
+	return ""
+}
+
 type DatumSetTaskResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -509,7 +557,7 @@ type DatumSetTaskResult struct {
 func (x *DatumSetTaskResult) Reset() {
 	*x = DatumSetTaskResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_server_worker_pipeline_transform_transform_proto_msgTypes[7]
+		mi := &file_server_worker_pipeline_transform_transform_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -522,7 +570,7 @@ func (x *DatumSetTaskResult) String() string {
 func (*DatumSetTaskResult) ProtoMessage() {}
 
 func (x *DatumSetTaskResult) ProtoReflect() protoreflect.Message {
-	mi := &file_server_worker_pipeline_transform_transform_proto_msgTypes[7]
+	mi := &file_server_worker_pipeline_transform_transform_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -535,7 +583,7 @@ func (x *DatumSetTaskResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DatumSetTaskResult.ProtoReflect.Descriptor instead.
 func (*DatumSetTaskResult) Descriptor() ([]byte, []int) {
-	return file_server_worker_pipeline_transform_transform_proto_rawDescGZIP(), []int{7}
+	return file_server_worker_pipeline_transform_transform_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *DatumSetTaskResult) GetOutputFileSetId() string {
@@ -644,21 +692,25 @@ var file_server_worker_pipeline_transform_transform_proto_rawDesc = []byte{
 	0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x33, 0x0a, 0x0d, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f,
 	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70,
 	0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x0c, 0x6f, 0x75,
-	0x74, 0x70, 0x75, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x22, 0x8e, 0x01, 0x0a, 0x12, 0x44,
-	0x61, 0x74, 0x75, 0x6d, 0x53, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x12, 0x2b, 0x0a, 0x12, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x66, 0x69, 0x6c, 0x65,
-	0x5f, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6f,
-	0x75, 0x74, 0x70, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x49, 0x64, 0x12, 0x27,
-	0x0a, 0x10, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x5f,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6d, 0x65, 0x74, 0x61, 0x46, 0x69,
-	0x6c, 0x65, 0x53, 0x65, 0x74, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x2e, 0x53,
-	0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x42, 0x48, 0x5a, 0x46, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x61, 0x63, 0x68, 0x79, 0x64,
-	0x65, 0x72, 0x6d, 0x2f, 0x70, 0x61, 0x63, 0x68, 0x79, 0x64, 0x65, 0x72, 0x6d, 0x2f, 0x76, 0x32,
-	0x2f, 0x73, 0x72, 0x63, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x77, 0x6f, 0x72, 0x6b,
-	0x65, 0x72, 0x2f, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x2f, 0x74, 0x72, 0x61, 0x6e,
-	0x73, 0x66, 0x6f, 0x72, 0x6d, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x70, 0x75, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x22, 0x37, 0x0a, 0x15, 0x44, 0x61,
+	0x74, 0x75, 0x6d, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x1e, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x65,
+	0x74, 0x49, 0x64, 0x22, 0x8e, 0x01, 0x0a, 0x12, 0x44, 0x61, 0x74, 0x75, 0x6d, 0x53, 0x65, 0x74,
+	0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2b, 0x0a, 0x12, 0x6f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x46, 0x69,
+	0x6c, 0x65, 0x53, 0x65, 0x74, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x10, 0x6d, 0x65, 0x74, 0x61, 0x5f,
+	0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x6d, 0x65, 0x74, 0x61, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x49, 0x64,
+	0x12, 0x22, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x73, 0x42, 0x48, 0x5a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x70, 0x61, 0x63, 0x68, 0x79, 0x64, 0x65, 0x72, 0x6d, 0x2f, 0x70, 0x61, 0x63,
+	0x68, 0x79, 0x64, 0x65, 0x72, 0x6d, 0x2f, 0x76, 0x32, 0x2f, 0x73, 0x72, 0x63, 0x2f, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x2f, 0x70, 0x69, 0x70, 0x65,
+	0x6c, 0x69, 0x6e, 0x65, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -673,7 +725,7 @@ func file_server_worker_pipeline_transform_transform_proto_rawDescGZIP() []byte
 	return file_server_worker_pipeline_transform_transform_proto_rawDescData
 }
 
-var file_server_worker_pipeline_transform_transform_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_server_worker_pipeline_transform_transform_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_server_worker_pipeline_transform_transform_proto_goTypes = []interface{}{
 	(*CreateParallelDatumsTask)(nil),       // 0: pachyderm.worker.pipeline.transform.CreateParallelDatumsTask
 	(*CreateParallelDatumsTaskResult)(nil), // 1: pachyderm.worker.pipeline.transform.CreateParallelDatumsTaskResult
@@ -682,28 +734,29 @@ var file_server_worker_pipeline_transform_transform_proto_goTypes = []interface{
 	(*CreateDatumSetsTask)(nil),            // 4: pachyderm.worker.pipeline.transform.CreateDatumSetsTask
 	(*CreateDatumSetsTaskResult)(nil),      // 5: pachyderm.worker.pipeline.transform.CreateDatumSetsTaskResult
 	(*DatumSetTask)(nil),                   // 6: pachyderm.worker.pipeline.transform.DatumSetTask
-	(*DatumSetTaskResult)(nil),             // 7: pachyderm.worker.pipeline.transform.DatumSetTaskResult
-	(*pps.Job)(nil),                        // 8: pps_v2.Job
-	(*pfs.PathRange)(nil),                  // 9: pfs_v2.PathRange
-	(*datum.Stats)(nil),                    // 10: datum.Stats
-	(*pfs.Commit)(nil),                     // 11: pfs_v2.Commit
-	(*datum.SetSpec)(nil),                  // 12: datum.SetSpec
+	(*DatumOutputCacheEntry)(nil),          // 7: pachyderm.worker.pipeline.transform.DatumOutputCacheEntry
+	(*DatumSetTaskResult)(nil),             // 8: pachyderm.worker.pipeline.transform.DatumSetTaskResult
+	(*pps.Job)(nil),                        // 9: pps_v2.Job
+	(*pfs.PathRange)(nil),                  // 10: pfs_v2.PathRange
+	(*datum.Stats)(nil),                    // 11: datum.Stats
+	(*pfs.Commit)(nil),                     // 12: pfs_v2.Commit
+	(*datum.SetSpec)(nil),                  // 13: datum.SetSpec
 }
 var file_server_worker_pipeline_transform_transform_proto_depIdxs = []int32{
-	8,  // 0: pachyderm.worker.pipeline.transform.CreateParallelDatumsTask.job:type_name -> pps_v2.Job
-	9,  // 1: pachyderm.worker.pipeline.transform.CreateParallelDatumsTask.path_range:type_name -> pfs_v2.PathRange
-	10, // 2: pachyderm.worker.pipeline.transform.CreateParallelDatumsTaskResult.stats:type_name -> datum.Stats
-	8,  // 3: pachyderm.worker.pipeline.transform.CreateSerialDatumsTask.job:type_name -> pps_v2.Job
-	11, // 4: pachyderm.worker.pipeline.transform.CreateSerialDatumsTask.base_meta_commit:type_name -> pfs_v2.Commit
-	9,  // 5: pachyderm.worker.pipeline.transform.CreateSerialDatumsTask.path_range:type_name -> pfs_v2.PathRange
-	10, // 6: pachyderm.worker.pipeline.transform.CreateSerialDatumsTaskResult.stats:type_name -> datum.Stats
-	9,  // 7: pachyderm.worker.pipeline.transform.CreateDatumSetsTask.path_range:type_name -> pfs_v2.PathRange
-	12, // 8: pachyderm.worker.pipeline.transform.CreateDatumSetsTask.set_spec:type_name -> datum.SetSpec
-	9,  // 9: pachyderm.worker.pipeline.transform.CreateDatumSetsTaskResult.datum_sets:type_name -> pfs_v2.PathRange
-	8,  // 10: pachyderm.worker.pipeline.transform.DatumSetTask.job:type_name -> pps_v2.Job
-	9,  // 11: pachyderm.worker.pipeline.transform.DatumSetTask.path_range:type_name -> pfs_v2.PathRange
-	11, // 12: pachyderm.worker.pipeline.transform.DatumSetTask.output_commit:type_name -> pfs_v2.Commit
-	10, // 13: pachyderm.worker.pipeline.transform.DatumSetTaskResult.stats:type_name -> datum.Stats
+	9,  // 0: pachyderm.worker.pipeline.transform.CreateParallelDatumsTask.job:type_name -> pps_v2.Job
+	10, // 1: pachyderm.worker.pipeline.transform.CreateParallelDatumsTask.path_range:type_name -> pfs_v2.PathRange
+	11, // 2: pachyderm.worker.pipeline.transform.CreateParallelDatumsTaskResult.stats:type_name -> datum.Stats
+	9,  // 3: pachyderm.worker.pipeline.transform.CreateSerialDatumsTask.job:type_name -> pps_v2.Job
+	12, // 4: pachyderm.worker.pipeline.transform.CreateSerialDatumsTask.base_meta_commit:type_name -> pfs_v2.Commit
+	10, // 5: pachyderm.worker.pipeline.transform.CreateSerialDatumsTask.path_range:type_name -> pfs_v2.PathRange
+	11, // 6: pachyderm.worker.pipeline.transform.CreateSerialDatumsTaskResult.stats:type_name -> datum.Stats
+	10, // 7: pachyderm.worker.pipeline.transform.CreateDatumSetsTask.path_range:type_name -> pfs_v2.PathRange
+	13, // 8: pachyderm.worker.pipeline.transform.CreateDatumSetsTask.set_spec:type_name -> datum.SetSpec
+	10, // 9: pachyderm.worker.pipeline.transform.CreateDatumSetsTaskResult.datum_sets:type_name -> pfs_v2.PathRange
+	9,  // 10: pachyderm.worker.pipeline.transform.DatumSetTask.job:type_name -> pps_v2.Job
+	10, // 11: pachyderm.worker.pipeline.transform.DatumSetTask.path_range:type_name -> pfs_v2.PathRange
+	12, // 12: pachyderm.worker.pipeline.transform.DatumSetTask.output_commit:type_name -> pfs_v2.Commit
+	11, // 13: pachyderm.worker.pipeline.transform.DatumSetTaskResult.stats:type_name -> datum.Stats
 	14, // [14:14] is the sub-list for method output_type
 	14, // [14:14] is the sub-list for method input_type
 	14, // [14:14] is the sub-list for extension type_name
@@ -802,6 +855,18 @@ func file_server_worker_pipeline_transform_transform_proto_init() {
 			}
 		}
 		file_server_worker_pipeline_transform_transform_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DatumOutputCacheEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_worker_pipeline_transform_transform_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DatumSetTaskResult); i {
 			case 0:
 				return &v.state
@@ -820,7 +885,7 @@ func file_server_worker_pipeline_transform_transform_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_server_worker_pipeline_transform_transform_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
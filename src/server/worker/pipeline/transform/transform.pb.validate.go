@@ -1154,6 +1154,110 @@ var _ interface {
 	ErrorName() string
 } = DatumSetTaskValidationError{}
 
+// Validate checks the field values on DatumOutputCacheEntry with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DatumOutputCacheEntry) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DatumOutputCacheEntry with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DatumOutputCacheEntryMultiError, or nil if none found.
+func (m *DatumOutputCacheEntry) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DatumOutputCacheEntry) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for FileSetId
+
+	if len(errors) > 0 {
+		return DatumOutputCacheEntryMultiError(errors)
+	}
+
+	return nil
+}
+
+// DatumOutputCacheEntryMultiError is an error wrapping multiple validation
+// errors returned by DatumOutputCacheEntry.ValidateAll() if the designated
+// constraints aren't met.
+type DatumOutputCacheEntryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DatumOutputCacheEntryMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DatumOutputCacheEntryMultiError) AllErrors() []error { return m }
+
+// DatumOutputCacheEntryValidationError is the validation error returned by
+// DatumOutputCacheEntry.Validate if the designated constraints aren't met.
+type DatumOutputCacheEntryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DatumOutputCacheEntryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DatumOutputCacheEntryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DatumOutputCacheEntryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DatumOutputCacheEntryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DatumOutputCacheEntryValidationError) ErrorName() string {
+	return "DatumOutputCacheEntryValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DatumOutputCacheEntryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDatumOutputCacheEntry.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DatumOutputCacheEntryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DatumOutputCacheEntryValidationError{}
+
 // Validate checks the field values on DatumSetTaskResult with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -88,6 +88,14 @@ func (x *DatumSetTask) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	return nil
 }
 
+func (x *DatumOutputCacheEntry) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddString("file_set_id", x.FileSetId)
+	return nil
+}
+
 func (x *DatumSetTaskResult) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	if x == nil {
 		return nil
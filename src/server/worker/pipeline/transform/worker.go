@@ -277,6 +277,10 @@ func handleDatumSet(driver driver.Driver, logger logs.TaggedLogger, task *DatumS
 					datum.WithMetaOutput(mfMeta),
 					datum.WithPFSOutput(mfPFS),
 					datum.WithStats(stats),
+					datum.WithBucketInputs(driver.PipelineInfo().Details.Input),
+				}
+				if driver.PipelineInfo().Details.CacheDatumOutput {
+					opts = append(opts, datum.WithOutputCache(newOutputCache(pachClient, renewer, logger, driver.PipelineInfo().Pipeline.GetProject().GetName())))
 				}
 				if driver.PipelineInfo().Details.Transform.DatumBatching {
 					return handleDatumSetBatching(ctx, driver, logger, task, status, cacheClient, di, opts)
@@ -403,6 +407,12 @@ func forEachDatum(ctx context.Context, driver driver.Driver, baseLogger logs.Tag
 			if driver.PipelineInfo().Details.DatumTries > 0 {
 				opts = append(opts, datum.WithRetry(int(driver.PipelineInfo().Details.DatumTries)-1))
 			}
+			if driver.PipelineInfo().Details.DatumRetryPolicy != nil {
+				opts = append(opts, datum.WithRetryPolicy(driver.PipelineInfo().Details.DatumRetryPolicy))
+			}
+			if driver.PipelineInfo().Details.CacheDatumOutput {
+				opts = append(opts, datum.WithCacheKey(outputCacheKey(userImageID, driver.PipelineInfo(), inputs)))
+			}
 			if driver.PipelineInfo().Details.Transform.ErrCmd != nil {
 				opts = append(opts, datum.WithRecoveryCallback(func(runCtx context.Context) error {
 					return errors.EnsureStack(driver.RunUserErrorHandlingCode(runCtx, logger, env))
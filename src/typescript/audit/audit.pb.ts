@@ -0,0 +1,32 @@
+/* eslint-disable */
+// @ts-nocheck
+/*
+* This file is a generated Typescript file for GRPC Gateway, DO NOT MODIFY
+*/
+
+import * as fm from "../fetch.pb"
+import * as GoogleProtobufTimestamp from "../google/protobuf/timestamp.pb"
+export type Event = {
+  id?: string
+  time?: GoogleProtobufTimestamp.Timestamp
+  principal?: string
+  method?: string
+  resource?: string
+  peer?: string
+  code?: string
+  error?: string
+}
+
+export type ListEventRequest = {
+  principal?: string
+  resource?: string
+  since?: GoogleProtobufTimestamp.Timestamp
+  until?: GoogleProtobufTimestamp.Timestamp
+  limit?: string
+}
+
+export class API {
+  static ListEvent(req: ListEventRequest, entityNotifier?: fm.NotifyStreamEntityArrival<Event>, initReq?: fm.InitReq): Promise<void> {
+    return fm.fetchStreamingRequest<ListEventRequest, Event>(`/audit_v2.API/ListEvent`, entityNotifier, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
+}
\ No newline at end of file
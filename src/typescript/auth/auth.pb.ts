@@ -13,6 +13,7 @@ export enum Permission {
   CLUSTER_GET_BINDINGS = "CLUSTER_GET_BINDINGS",
   CLUSTER_GET_PACHD_LOGS = "CLUSTER_GET_PACHD_LOGS",
   CLUSTER_GET_LOKI_LOGS = "CLUSTER_GET_LOKI_LOGS",
+  CLUSTER_GET_AUDIT_LOG = "CLUSTER_GET_AUDIT_LOG",
   CLUSTER_AUTH_ACTIVATE = "CLUSTER_AUTH_ACTIVATE",
   CLUSTER_AUTH_DEACTIVATE = "CLUSTER_AUTH_DEACTIVATE",
   CLUSTER_AUTH_GET_CONFIG = "CLUSTER_AUTH_GET_CONFIG",
@@ -27,6 +28,8 @@ export enum Permission {
   CLUSTER_AUTH_DELETE_EXPIRED_TOKENS = "CLUSTER_AUTH_DELETE_EXPIRED_TOKENS",
   CLUSTER_AUTH_REVOKE_USER_TOKENS = "CLUSTER_AUTH_REVOKE_USER_TOKENS",
   CLUSTER_AUTH_ROTATE_ROOT_TOKEN = "CLUSTER_AUTH_ROTATE_ROOT_TOKEN",
+  CLUSTER_AUTH_CREATE_ROLE = "CLUSTER_AUTH_CREATE_ROLE",
+  CLUSTER_AUTH_DELETE_ROLE = "CLUSTER_AUTH_DELETE_ROLE",
   CLUSTER_ENTERPRISE_ACTIVATE = "CLUSTER_ENTERPRISE_ACTIVATE",
   CLUSTER_ENTERPRISE_HEARTBEAT = "CLUSTER_ENTERPRISE_HEARTBEAT",
   CLUSTER_ENTERPRISE_GET_CODE = "CLUSTER_ENTERPRISE_GET_CODE",
@@ -55,6 +58,12 @@ export enum Permission {
   CLUSTER_LIST_SECRETS = "CLUSTER_LIST_SECRETS",
   SECRET_DELETE = "SECRET_DELETE",
   SECRET_INSPECT = "SECRET_INSPECT",
+  CLUSTER_CREATE_WEBHOOK = "CLUSTER_CREATE_WEBHOOK",
+  CLUSTER_LIST_WEBHOOKS = "CLUSTER_LIST_WEBHOOKS",
+  CLUSTER_DELETE_WEBHOOK = "CLUSTER_DELETE_WEBHOOK",
+  CLUSTER_PJS_WRITE_JOB = "CLUSTER_PJS_WRITE_JOB",
+  CLUSTER_PJS_READ_JOB = "CLUSTER_PJS_READ_JOB",
+  CLUSTER_PJS_PROCESS_QUEUE = "CLUSTER_PJS_PROCESS_QUEUE",
   CLUSTER_DELETE_ALL = "CLUSTER_DELETE_ALL",
   REPO_READ = "REPO_READ",
   REPO_WRITE = "REPO_WRITE",
@@ -71,6 +80,9 @@ export enum Permission {
   REPO_ADD_PIPELINE_READER = "REPO_ADD_PIPELINE_READER",
   REPO_REMOVE_PIPELINE_READER = "REPO_REMOVE_PIPELINE_READER",
   REPO_ADD_PIPELINE_WRITER = "REPO_ADD_PIPELINE_WRITER",
+  REPO_CREATE_TAG = "REPO_CREATE_TAG",
+  REPO_LIST_TAG = "REPO_LIST_TAG",
+  REPO_DELETE_TAG = "REPO_DELETE_TAG",
   PIPELINE_LIST_JOB = "PIPELINE_LIST_JOB",
   CLUSTER_SET_DEFAULTS = "CLUSTER_SET_DEFAULTS",
   PROJECT_SET_DEFAULTS = "PROJECT_SET_DEFAULTS",
@@ -167,6 +179,27 @@ export type GetRolesForPermissionResponse = {
   roles?: Role[]
 }
 
+export type CreateRoleRequest = {
+  role?: Role
+}
+
+export type CreateRoleResponse = {
+}
+
+export type ListRoleRequest = {
+}
+
+export type ListRoleResponse = {
+  roles?: Role[]
+}
+
+export type DeleteRoleRequest = {
+  name?: string
+}
+
+export type DeleteRoleResponse = {
+}
+
 export type Roles = {
   roles?: {[key: string]: boolean}
 }
@@ -193,6 +226,7 @@ export type Role = {
   permissions?: Permission[]
   canBeBoundTo?: ResourceType[]
   returnedFor?: ResourceType[]
+  custom?: boolean
 }
 
 export type AuthorizeRequest = {
@@ -364,6 +398,15 @@ export class API {
   static GetRolesForPermission(req: GetRolesForPermissionRequest, initReq?: fm.InitReq): Promise<GetRolesForPermissionResponse> {
     return fm.fetchReq<GetRolesForPermissionRequest, GetRolesForPermissionResponse>(`/auth_v2.API/GetRolesForPermission`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
   }
+  static CreateRole(req: CreateRoleRequest, initReq?: fm.InitReq): Promise<CreateRoleResponse> {
+    return fm.fetchReq<CreateRoleRequest, CreateRoleResponse>(`/auth_v2.API/CreateRole`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
+  static ListRole(req: ListRoleRequest, initReq?: fm.InitReq): Promise<ListRoleResponse> {
+    return fm.fetchReq<ListRoleRequest, ListRoleResponse>(`/auth_v2.API/ListRole`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
+  static DeleteRole(req: DeleteRoleRequest, initReq?: fm.InitReq): Promise<DeleteRoleResponse> {
+    return fm.fetchReq<DeleteRoleRequest, DeleteRoleResponse>(`/auth_v2.API/DeleteRole`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
   static ModifyRoleBinding(req: ModifyRoleBindingRequest, initReq?: fm.InitReq): Promise<ModifyRoleBindingResponse> {
     return fm.fetchReq<ModifyRoleBindingRequest, ModifyRoleBindingResponse>(`/auth_v2.API/ModifyRoleBinding`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
   }
@@ -7,6 +7,8 @@
 export enum CompressionAlgo {
   NONE = "NONE",
   GZIP_BEST_SPEED = "GZIP_BEST_SPEED",
+  ZSTD = "ZSTD",
+  LZ4 = "LZ4",
 }
 
 export enum EncryptionAlgo {
@@ -28,4 +30,5 @@ export type Ref = {
   dek?: Uint8Array
   encryptionAlgo?: EncryptionAlgo
   compressionAlgo?: CompressionAlgo
+  kekId?: string
 }
\ No newline at end of file
@@ -22,4 +22,10 @@ export type Range = {
 export type File = {
   datum?: string
   dataRefs?: ChunkChunk.DataRef[]
+  metadata?: FileMetadata
+}
+
+export type FileMetadata = {
+  contentType?: string
+  attributes?: {[key: string]: string}
 }
\ No newline at end of file
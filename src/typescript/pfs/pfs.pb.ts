@@ -51,6 +51,11 @@ export enum Delimiter {
   CSV = "CSV",
 }
 
+export enum SQLDatabaseEgressMode {
+  REPLACE = "REPLACE",
+  UPSERT = "UPSERT",
+}
+
 export enum SQLDatabaseEgressFileFormatType {
   UNKNOWN = "UNKNOWN",
   CSV = "CSV",
@@ -69,6 +74,11 @@ export type Branch = {
   name?: string
 }
 
+export type Tag = {
+  repo?: Repo
+  name?: string
+}
+
 export type File = {
   commit?: Commit
   path?: string
@@ -87,6 +97,8 @@ export type RepoInfo = {
   branches?: Branch[]
   authInfo?: AuthInfo
   details?: RepoInfoDetails
+  metadata?: {[key: string]: string}
+  retentionPolicy?: RetentionPolicy
 }
 
 export type AuthInfo = {
@@ -101,6 +113,14 @@ export type BranchInfo = {
   subvenance?: Branch[]
   directProvenance?: Branch[]
   trigger?: Trigger
+  metadata?: {[key: string]: string}
+  retentionPolicy?: RetentionPolicy
+}
+
+export type RetentionPolicy = {
+  keepLast?: string
+  keepNewerThan?: GoogleProtobufDuration.Duration
+  keepMetadata?: {[key: string]: string}
 }
 
 export type Trigger = {
@@ -141,6 +161,7 @@ export type CommitInfo = {
   error?: string
   sizeBytesUpperBound?: string
   details?: CommitInfoDetails
+  metadata?: {[key: string]: string}
 }
 
 export type CommitSet = {
@@ -158,6 +179,8 @@ export type FileInfo = {
   committed?: GoogleProtobufTimestamp.Timestamp
   sizeBytes?: string
   hash?: Uint8Array
+  contentType?: string
+  metadata?: {[key: string]: string}
 }
 
 export type Project = {
@@ -169,6 +192,7 @@ export type ProjectInfo = {
   description?: string
   authInfo?: AuthInfo
   createdAt?: GoogleProtobufTimestamp.Timestamp
+  metadata?: {[key: string]: string}
 }
 
 export type CreateRepoRequest = {
@@ -184,6 +208,7 @@ export type InspectRepoRequest = {
 export type ListRepoRequest = {
   type?: string
   projects?: Project[]
+  metadata?: {[key: string]: string}
 }
 
 export type DeleteRepoRequest = {
@@ -232,6 +257,7 @@ export type ListCommitRequest = {
   all?: boolean
   originKind?: OriginKind
   startedTime?: GoogleProtobufTimestamp.Timestamp
+  metadata?: {[key: string]: string}
 }
 
 export type InspectCommitSetRequest = {
@@ -316,6 +342,29 @@ export type DeleteBranchRequest = {
   force?: boolean
 }
 
+export type TagInfo = {
+  tag?: Tag
+  commit?: Commit
+  created?: GoogleProtobufTimestamp.Timestamp
+}
+
+export type CreateTagRequest = {
+  tag?: Tag
+  commit?: Commit
+}
+
+export type InspectTagRequest = {
+  tag?: Tag
+}
+
+export type ListTagRequest = {
+  repo?: Repo
+}
+
+export type DeleteTagRequest = {
+  tag?: Tag
+}
+
 export type CreateProjectRequest = {
   project?: Project
   description?: string
@@ -334,6 +383,75 @@ export type DeleteProjectRequest = {
   force?: boolean
 }
 
+export type EditMetadataRequestEditReplace = {
+  replacement?: {[key: string]: string}
+}
+
+export type EditMetadataRequestEditAddKey = {
+  key?: string
+  value?: string
+}
+
+export type EditMetadataRequestEditEditKey = {
+  key?: string
+  value?: string
+}
+
+export type EditMetadataRequestEditDeleteKey = {
+  key?: string
+}
+
+
+type BaseEditMetadataRequestEdit = {
+}
+
+export type EditMetadataRequestEdit = BaseEditMetadataRequestEdit
+  & OneOf<{ project: Project; repo: Repo; branch: Branch; commit: Commit }>
+  & OneOf<{ replace: EditMetadataRequestEditReplace; addKey: EditMetadataRequestEditAddKey; editKey: EditMetadataRequestEditEditKey; deleteKey: EditMetadataRequestEditDeleteKey }>
+
+export type EditMetadataRequest = {
+  edits?: EditMetadataRequestEdit[]
+}
+
+export type EditMetadataResponse = {
+}
+
+
+type BaseSetRetentionPolicyRequest = {
+  policy?: RetentionPolicy
+}
+
+export type SetRetentionPolicyRequest = BaseSetRetentionPolicyRequest
+  & OneOf<{ repo: Repo; branch: Branch }>
+
+
+type BaseApplyRetentionPolicyRequest = {
+  dryRun?: boolean
+}
+
+export type ApplyRetentionPolicyRequest = BaseApplyRetentionPolicyRequest
+  & OneOf<{ repo: Repo; branch: Branch }>
+
+export type ApplyRetentionPolicyResponse = {
+  expired?: Commit[]
+}
+
+export type PurgePathRequest = {
+  repo?: Repo
+  path?: string
+}
+
+export type PurgedDatums = {
+  repo?: Repo
+  datums?: string[]
+}
+
+export type PurgePathResponse = {
+  commits?: Commit[]
+  datums?: PurgedDatums[]
+  unreferencedChunks?: string[]
+}
+
 export type AddFileURLSource = {
   uRL?: string
   recursive?: boolean
@@ -344,6 +462,8 @@ export type AddFileURLSource = {
 type BaseAddFile = {
   path?: string
   datum?: string
+  contentType?: string
+  metadata?: {[key: string]: string}
 }
 
 export type AddFile = BaseAddFile
@@ -373,6 +493,7 @@ export type GetFileRequest = {
   uRL?: string
   offset?: string
   pathRange?: PathRange
+  sizeBytes?: string
 }
 
 export type InspectFileRequest = {
@@ -464,10 +585,60 @@ export type CheckStorageRequest = {
   readChunkData?: boolean
   chunkBegin?: Uint8Array
   chunkEnd?: Uint8Array
+  checkConsistency?: boolean
+  consistencyLimit?: string
+}
+
+export type StorageConsistency = {
+  missingObjectCount?: string
+  missingObjects?: string[]
+  untrackedEntryCount?: string
+  untrackedEntries?: string[]
+  orphanedObjectCount?: string
+  orphanedObjects?: string[]
+  garbageObjectCount?: string
+  garbageBytes?: string
 }
 
 export type CheckStorageResponse = {
   chunkObjectCount?: string
+  consistency?: StorageConsistency
+}
+
+export type InspectStorageRequest = {
+  limit?: string
+}
+
+export type StorageStats = {
+  logicalBytes?: string
+  physicalBytes?: string
+  uniqueBytes?: string
+  chunkCount?: string
+}
+
+export type RepoStorageStats = {
+  repo?: Repo
+  stats?: StorageStats
+}
+
+export type ProjectStorageStats = {
+  project?: Project
+  stats?: StorageStats
+}
+
+export type SharedStorageStats = {
+  repoA?: Repo
+  repoB?: Repo
+  sharedBytes?: string
+  sharedRatio?: number
+}
+
+export type InspectStorageResponse = {
+  total?: StorageStats
+  projects?: ProjectStorageStats[]
+  repos?: RepoStorageStats[]
+  shared?: SharedStorageStats[]
+  computedAt?: GoogleProtobufTimestamp.Timestamp
 }
 
 export type PutCacheRequest = {
@@ -509,10 +680,16 @@ export type SQLDatabaseEgressSecret = {
   key?: string
 }
 
+export type SQLDatabaseEgressPrimaryKey = {
+  columns?: string[]
+}
+
 export type SQLDatabaseEgress = {
   url?: string
   fileFormat?: SQLDatabaseEgressFileFormat
   secret?: SQLDatabaseEgressSecret
+  mode?: SQLDatabaseEgressMode
+  primaryKeys?: {[key: string]: SQLDatabaseEgressPrimaryKey}
 }
 
 
@@ -529,6 +706,10 @@ export type EgressResponseObjectStorageResult = {
 
 export type EgressResponseSQLDatabaseResult = {
   rowsWritten?: {[key: string]: string}
+  rowsInserted?: {[key: string]: string}
+  rowsUpdated?: {[key: string]: string}
+  rowsDeleted?: {[key: string]: string}
+  baseCommit?: Commit
 }
 
 
@@ -605,6 +786,18 @@ export class API {
   static DeleteBranch(req: DeleteBranchRequest, initReq?: fm.InitReq): Promise<GoogleProtobufEmpty.Empty> {
     return fm.fetchReq<DeleteBranchRequest, GoogleProtobufEmpty.Empty>(`/pfs_v2.API/DeleteBranch`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
   }
+  static CreateTag(req: CreateTagRequest, initReq?: fm.InitReq): Promise<GoogleProtobufEmpty.Empty> {
+    return fm.fetchReq<CreateTagRequest, GoogleProtobufEmpty.Empty>(`/pfs_v2.API/CreateTag`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
+  static InspectTag(req: InspectTagRequest, initReq?: fm.InitReq): Promise<TagInfo> {
+    return fm.fetchReq<InspectTagRequest, TagInfo>(`/pfs_v2.API/InspectTag`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
+  static ListTag(req: ListTagRequest, entityNotifier?: fm.NotifyStreamEntityArrival<TagInfo>, initReq?: fm.InitReq): Promise<void> {
+    return fm.fetchStreamingRequest<ListTagRequest, TagInfo>(`/pfs_v2.API/ListTag`, entityNotifier, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
+  static DeleteTag(req: DeleteTagRequest, initReq?: fm.InitReq): Promise<GoogleProtobufEmpty.Empty> {
+    return fm.fetchReq<DeleteTagRequest, GoogleProtobufEmpty.Empty>(`/pfs_v2.API/DeleteTag`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
   static GetFile(req: GetFileRequest, entityNotifier?: fm.NotifyStreamEntityArrival<GoogleProtobufWrappers.BytesValue>, initReq?: fm.InitReq): Promise<void> {
     return fm.fetchStreamingRequest<GetFileRequest, GoogleProtobufWrappers.BytesValue>(`/pfs_v2.API/GetFile`, entityNotifier, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
   }
@@ -653,6 +846,9 @@ export class API {
   static CheckStorage(req: CheckStorageRequest, initReq?: fm.InitReq): Promise<CheckStorageResponse> {
     return fm.fetchReq<CheckStorageRequest, CheckStorageResponse>(`/pfs_v2.API/CheckStorage`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
   }
+  static InspectStorage(req: InspectStorageRequest, initReq?: fm.InitReq): Promise<InspectStorageResponse> {
+    return fm.fetchReq<InspectStorageRequest, InspectStorageResponse>(`/pfs_v2.API/InspectStorage`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
   static PutCache(req: PutCacheRequest, initReq?: fm.InitReq): Promise<GoogleProtobufEmpty.Empty> {
     return fm.fetchReq<PutCacheRequest, GoogleProtobufEmpty.Empty>(`/pfs_v2.API/PutCache`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
   }
@@ -680,4 +876,16 @@ export class API {
   static DeleteProject(req: DeleteProjectRequest, initReq?: fm.InitReq): Promise<GoogleProtobufEmpty.Empty> {
     return fm.fetchReq<DeleteProjectRequest, GoogleProtobufEmpty.Empty>(`/pfs_v2.API/DeleteProject`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
   }
+  static EditMetadata(req: EditMetadataRequest, initReq?: fm.InitReq): Promise<EditMetadataResponse> {
+    return fm.fetchReq<EditMetadataRequest, EditMetadataResponse>(`/pfs_v2.API/EditMetadata`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
+  static SetRetentionPolicy(req: SetRetentionPolicyRequest, initReq?: fm.InitReq): Promise<GoogleProtobufEmpty.Empty> {
+    return fm.fetchReq<SetRetentionPolicyRequest, GoogleProtobufEmpty.Empty>(`/pfs_v2.API/SetRetentionPolicy`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
+  static ApplyRetentionPolicy(req: ApplyRetentionPolicyRequest, initReq?: fm.InitReq): Promise<ApplyRetentionPolicyResponse> {
+    return fm.fetchReq<ApplyRetentionPolicyRequest, ApplyRetentionPolicyResponse>(`/pfs_v2.API/ApplyRetentionPolicy`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
+  static PurgePath(req: PurgePathRequest, initReq?: fm.InitReq): Promise<PurgePathResponse> {
+    return fm.fetchReq<PurgePathRequest, PurgePathResponse>(`/pfs_v2.API/PurgePath`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
 }
\ No newline at end of file
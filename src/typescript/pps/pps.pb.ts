@@ -41,6 +41,7 @@ export enum DatumState {
   SKIPPED = "SKIPPED",
   STARTING = "STARTING",
   RECOVERED = "RECOVERED",
+  QUARANTINED = "QUARANTINED",
 }
 
 export enum WorkerState {
@@ -81,6 +82,11 @@ export enum PipelineInfoPipelineType {
   PIPELINE_TYPE_SERVICE = "PIPELINE_TYPE_SERVICE",
 }
 
+export enum DatumRetryPolicyAction {
+  FAIL = "FAIL",
+  QUARANTINE = "QUARANTINE",
+}
+
 export type SecretMount = {
   name?: string
   key?: string
@@ -158,6 +164,12 @@ export type PFSInput = {
   emptyFiles?: boolean
   s3?: boolean
   trigger?: Pfs_v2Pfs.Trigger
+  window?: PFSWindow
+}
+
+export type PFSWindow = {
+  commits?: string
+  duration?: GoogleProtobufDuration.Duration
 }
 
 export type CronInput = {
@@ -170,6 +182,17 @@ export type CronInput = {
   start?: GoogleProtobufTimestamp.Timestamp
 }
 
+export type BucketInput = {
+  name?: string
+  project?: string
+  repo?: string
+  commit?: string
+  url?: string
+  spec?: string
+  glob?: string
+  secret?: string
+}
+
 export type Input = {
   pfs?: PFSInput
   join?: Input[]
@@ -177,6 +200,7 @@ export type Input = {
   cross?: Input[]
   union?: Input[]
   cron?: CronInput
+  bucket?: BucketInput
 }
 
 export type JobInput = {
@@ -281,6 +305,8 @@ export type JobInfoDetails = {
   podSpec?: string
   podPatch?: string
   sidecarResourceRequests?: ResourceSpec
+  datumRetryPolicy?: DatumRetryPolicy
+  cacheDatumOutput?: boolean
 }
 
 export type JobInfo = {
@@ -293,6 +319,10 @@ export type JobInfo = {
   dataTotal?: string
   dataFailed?: string
   dataRecovered?: string
+  dataQuarantined?: string
+  dataCacheHits?: string
+  dataCacheMisses?: string
+  windowInputs?: JobInput[]
   stats?: ProcessStats
   state?: JobState
   reason?: string
@@ -358,6 +388,8 @@ export type PipelineInfoDetails = {
   determined?: Determined
   maximumExpectedUptime?: GoogleProtobufDuration.Duration
   workersStartedAt?: GoogleProtobufTimestamp.Timestamp
+  datumRetryPolicy?: DatumRetryPolicy
+  cacheDatumOutput?: boolean
 }
 
 export type PipelineInfo = {
@@ -442,6 +474,10 @@ export type UpdateJobStateRequest = {
   dataRecovered?: string
   dataTotal?: string
   stats?: ProcessStats
+  dataQuarantined?: string
+  dataCacheHits?: string
+  dataCacheMisses?: string
+  windowInputs?: JobInput[]
 }
 
 export type GetLogsRequest = {
@@ -497,6 +533,14 @@ export type DatumSetSpec = {
   perWorker?: string
 }
 
+export type DatumRetryPolicy = {
+  backoff?: GoogleProtobufDuration.Duration
+  backoffMultiplier?: number
+  maxBackoff?: GoogleProtobufDuration.Duration
+  retryExitCodes?: number[]
+  onFailure?: DatumRetryPolicyAction
+}
+
 export type SchedulingSpec = {
   nodeSelector?: {[key: string]: string}
   priorityClassName?: string
@@ -541,6 +585,8 @@ export type CreatePipelineRequest = {
   dryRun?: boolean
   determined?: Determined
   maximumExpectedUptime?: GoogleProtobufDuration.Duration
+  datumRetryPolicy?: DatumRetryPolicy
+  cacheDatumOutput?: boolean
 }
 
 export type CreatePipelineV2Request = {
@@ -15,4 +15,6 @@ export type Input = {
   branch?: string
   emptyFiles?: boolean
   s3?: boolean
+  window?: boolean
+  bucket?: boolean
 }
\ No newline at end of file
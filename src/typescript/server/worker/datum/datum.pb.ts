@@ -12,6 +12,7 @@ export enum State {
   PROCESSED = "PROCESSED",
   FAILED = "FAILED",
   RECOVERED = "RECOVERED",
+  QUARANTINED = "QUARANTINED",
 }
 
 export enum KeyTaskType {
@@ -43,6 +44,9 @@ export type Stats = {
   failed?: string
   recovered?: string
   failedId?: string
+  quarantined?: string
+  cacheHits?: string
+  cacheMisses?: string
 }
 
 export type PFSTask = {
@@ -50,6 +54,8 @@ export type PFSTask = {
   pathRange?: Pfs_v2Pfs.PathRange
   baseIndex?: string
   authToken?: string
+  parentCommit?: Pfs_v2Pfs.Commit
+  bucket?: boolean
 }
 
 export type PFSTaskResult = {
@@ -53,6 +53,10 @@ export type DatumSetTask = {
   outputCommit?: Pfs_v2Pfs.Commit
 }
 
+export type DatumOutputCacheEntry = {
+  fileSetId?: string
+}
+
 export type DatumSetTaskResult = {
   outputFileSetId?: string
   metaFileSetId?: string
@@ -0,0 +1,109 @@
+/* eslint-disable */
+// @ts-nocheck
+/*
+* This file is a generated Typescript file for GRPC Gateway, DO NOT MODIFY
+*/
+
+import * as fm from "../fetch.pb"
+import * as GoogleProtobufEmpty from "../google/protobuf/empty.pb"
+import * as GoogleProtobufTimestamp from "../google/protobuf/timestamp.pb"
+import * as Pfs_v2Pfs from "../pfs/pfs.pb"
+import * as Pps_v2Pps from "../pps/pps.pb"
+
+export enum EventType {
+  EVENT_TYPE_UNKNOWN = "EVENT_TYPE_UNKNOWN",
+  COMMIT_FINISHED = "COMMIT_FINISHED",
+  JOB_FAILED = "JOB_FAILED",
+  PIPELINE_STATE_CHANGED = "PIPELINE_STATE_CHANGED",
+}
+
+export enum DeliveryState {
+  DELIVERY_STATE_UNKNOWN = "DELIVERY_STATE_UNKNOWN",
+  DELIVERY_PENDING = "DELIVERY_PENDING",
+  DELIVERY_SUCCEEDED = "DELIVERY_SUCCEEDED",
+  DELIVERY_FAILED = "DELIVERY_FAILED",
+}
+
+export type Event = {
+  type?: EventType
+  time?: GoogleProtobufTimestamp.Timestamp
+  commit?: Pfs_v2Pfs.Commit
+  commitError?: string
+  job?: Pps_v2Pps.Job
+  jobState?: Pps_v2Pps.JobState
+  pipeline?: Pps_v2Pps.Pipeline
+  pipelineState?: Pps_v2Pps.PipelineState
+  previousPipelineState?: Pps_v2Pps.PipelineState
+  reason?: string
+}
+
+export type WebhookInfo = {
+  name?: string
+  url?: string
+  events?: EventType[]
+  project?: string
+  repo?: string
+  branch?: string
+  pipeline?: string
+  signed?: boolean
+  created?: GoogleProtobufTimestamp.Timestamp
+}
+
+export type CreateWebhookRequest = {
+  name?: string
+  url?: string
+  secret?: string
+  events?: EventType[]
+  project?: string
+  repo?: string
+  branch?: string
+  pipeline?: string
+  update?: boolean
+}
+
+export type InspectWebhookRequest = {
+  name?: string
+}
+
+export type ListWebhookRequest = {
+}
+
+export type DeleteWebhookRequest = {
+  name?: string
+}
+
+export type Delivery = {
+  id?: string
+  webhook?: string
+  event?: Event
+  state?: DeliveryState
+  attempts?: string
+  lastStatusCode?: string
+  lastError?: string
+  created?: GoogleProtobufTimestamp.Timestamp
+  nextAttempt?: GoogleProtobufTimestamp.Timestamp
+  finished?: GoogleProtobufTimestamp.Timestamp
+}
+
+export type ListDeliveryRequest = {
+  webhook?: string
+  limit?: string
+}
+
+export class API {
+  static CreateWebhook(req: CreateWebhookRequest, initReq?: fm.InitReq): Promise<GoogleProtobufEmpty.Empty> {
+    return fm.fetchReq<CreateWebhookRequest, GoogleProtobufEmpty.Empty>(`/webhook_v2.API/CreateWebhook`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
+  static InspectWebhook(req: InspectWebhookRequest, initReq?: fm.InitReq): Promise<WebhookInfo> {
+    return fm.fetchReq<InspectWebhookRequest, WebhookInfo>(`/webhook_v2.API/InspectWebhook`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
+  static ListWebhook(req: ListWebhookRequest, entityNotifier?: fm.NotifyStreamEntityArrival<WebhookInfo>, initReq?: fm.InitReq): Promise<void> {
+    return fm.fetchStreamingRequest<ListWebhookRequest, WebhookInfo>(`/webhook_v2.API/ListWebhook`, entityNotifier, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
+  static DeleteWebhook(req: DeleteWebhookRequest, initReq?: fm.InitReq): Promise<GoogleProtobufEmpty.Empty> {
+    return fm.fetchReq<DeleteWebhookRequest, GoogleProtobufEmpty.Empty>(`/webhook_v2.API/DeleteWebhook`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
+  static ListDelivery(req: ListDeliveryRequest, entityNotifier?: fm.NotifyStreamEntityArrival<Delivery>, initReq?: fm.InitReq): Promise<void> {
+    return fm.fetchStreamingRequest<ListDeliveryRequest, Delivery>(`/webhook_v2.API/ListDelivery`, entityNotifier, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
+}
\ No newline at end of file
@@ -0,0 +1,120 @@
+// Code generated by protoc-gen-zap (etc/proto/protoc-gen-zap). DO NOT EDIT.
+//
+// source: webhook/webhook.proto
+
+package webhook
+
+import (
+	protoextensions "github.com/pachyderm/pachyderm/v2/src/protoextensions"
+	zapcore "go.uber.org/zap/zapcore"
+)
+
+func (x *Event) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddString("type", x.Type.String())
+	protoextensions.AddTimestamp(enc, "time", x.Time)
+	enc.AddObject("commit", x.Commit)
+	enc.AddString("commit_error", x.CommitError)
+	enc.AddObject("job", x.Job)
+	enc.AddString("job_state", x.JobState.String())
+	enc.AddObject("pipeline", x.Pipeline)
+	enc.AddString("pipeline_state", x.PipelineState.String())
+	enc.AddString("previous_pipeline_state", x.PreviousPipelineState.String())
+	enc.AddString("reason", x.Reason)
+	return nil
+}
+
+func (x *WebhookInfo) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddString("name", x.Name)
+	enc.AddString("url", x.Url)
+	eventsArrMarshaller := func(enc zapcore.ArrayEncoder) error {
+		for _, v := range x.Events {
+			enc.AppendString(v.String())
+		}
+		return nil
+	}
+	enc.AddArray("events", zapcore.ArrayMarshalerFunc(eventsArrMarshaller))
+	enc.AddString("project", x.Project)
+	enc.AddString("repo", x.Repo)
+	enc.AddString("branch", x.Branch)
+	enc.AddString("pipeline", x.Pipeline)
+	enc.AddBool("signed", x.Signed)
+	protoextensions.AddTimestamp(enc, "created", x.Created)
+	return nil
+}
+
+func (x *CreateWebhookRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddString("name", x.Name)
+	enc.AddString("url", x.Url)
+	enc.AddString("secret", x.Secret)
+	eventsArrMarshaller := func(enc zapcore.ArrayEncoder) error {
+		for _, v := range x.Events {
+			enc.AppendString(v.String())
+		}
+		return nil
+	}
+	enc.AddArray("events", zapcore.ArrayMarshalerFunc(eventsArrMarshaller))
+	enc.AddString("project", x.Project)
+	enc.AddString("repo", x.Repo)
+	enc.AddString("branch", x.Branch)
+	enc.AddString("pipeline", x.Pipeline)
+	enc.AddBool("update", x.Update)
+	return nil
+}
+
+func (x *InspectWebhookRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddString("name", x.Name)
+	return nil
+}
+
+func (x *ListWebhookRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	return nil
+}
+
+func (x *DeleteWebhookRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddString("name", x.Name)
+	return nil
+}
+
+func (x *Delivery) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddInt64("id", x.Id)
+	enc.AddString("webhook", x.Webhook)
+	enc.AddObject("event", x.Event)
+	enc.AddString("state", x.State.String())
+	enc.AddInt64("attempts", x.Attempts)
+	enc.AddInt64("last_status_code", x.LastStatusCode)
+	enc.AddString("last_error", x.LastError)
+	protoextensions.AddTimestamp(enc, "created", x.Created)
+	protoextensions.AddTimestamp(enc, "next_attempt", x.NextAttempt)
+	protoextensions.AddTimestamp(enc, "finished", x.Finished)
+	return nil
+}
+
+func (x *ListDeliveryRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddString("webhook", x.Webhook)
+	enc.AddInt64("limit", x.Limit)
+	return nil
+}